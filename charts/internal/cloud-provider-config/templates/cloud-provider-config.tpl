@@ -9,7 +9,16 @@ vnetName: "{{ .Values.vnetName }}"
 {{- if hasKey .Values "vnetResourceGroup" }}
 vnetResourceGroup: "{{ .Values.vnetResourceGroup }}"
 {{- end }}
+{{- if hasKey .Values "networkResourceGroup" }}
+routeTableResourceGroup: "{{ .Values.networkResourceGroup }}"
+{{- end }}
+{{- if hasKey .Values "securityGroupResourceGroup" }}
+securityGroupResourceGroup: "{{ .Values.securityGroupResourceGroup }}"
+{{- end }}
 loadBalancerSku: "standard"
+{{- if .Values.disableOutboundSNAT }}
+disableOutboundSNAT: true
+{{- end }}
 {{- if hasKey .Values "vmType" }}
 vmType: "{{ .Values.vmType }}"
 {{- end }}
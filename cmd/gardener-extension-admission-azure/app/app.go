@@ -56,6 +56,9 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
 
+		machineImageExpirationOpts = &admissioncmd.MachineImageExpirationOptions{}
+		reservedVNetCIDRsOpts      = &admissioncmd.ReservedVNetCIDRsOptions{}
+
 		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
 		webhookOptions  = webhookcmd.NewAddToManagerOptions(
 			AdmissionName,
@@ -69,6 +72,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			webhookOptions,
+			machineImageExpirationOpts,
+			reservedVNetCIDRsOpts,
 		)
 	)
 
@@ -86,6 +91,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			if err := aggOption.Complete(); err != nil {
 				return fmt.Errorf("error completing options: %w", err)
 			}
+			machineImageExpirationOpts.Apply()
+			reservedVNetCIDRsOpts.Apply()
 
 			managerOptions := mgrOpts.Completed().Options()
 			// Operators can enable the source cluster option via SOURCE_CLUSTER environment variable.
@@ -216,14 +216,27 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&azureseedprovider.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyQuotaMetrics(&healthcheck.DefaultQuotaMetricsOptions.RefreshInterval)
+			configFileOpts.Completed().ApplyResourceSKUs(&healthcheck.DefaultResourceSKUsOptions.CacheTTL, &healthcheck.DefaultResourceSKUsOptions.TolerateUnavailability)
+			if err := configFileOpts.Completed().ApplyAzureClient(ctx); err != nil {
+				return fmt.Errorf("failed to configure the Azure clients: %w", err)
+			}
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&azurebackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&azurebackupentry.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyMaxConcurrentReconciles("backupentry", &azurebackupentry.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyBackupEntryNotificationWebhook(&azurebackupentry.DefaultAddOptions.NotificationWebhook)
 			bastionCtrlOpts.Completed().Apply(&azurebastion.DefaultAddOptions.Controller)
 			controlPlaneCtrlOpts.Completed().Apply(&azurecontrolplane.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyMaxConcurrentReconciles("controlplane", &azurecontrolplane.DefaultAddOptions.Controller)
 			dnsRecordCtrlOpts.Completed().Apply(&azurednsrecord.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyMaxConcurrentReconciles("dnsrecord", &azurednsrecord.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&azureinfrastructure.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyMaxConcurrentReconciles("infrastructure", &azureinfrastructure.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyInfrastructureTaskTimeouts(&azureinfrastructure.DefaultAddOptions.TaskTimeouts)
+			configFileOpts.Completed().ApplyInfrastructureCheckpointTTL(&azureinfrastructure.DefaultAddOptions.CheckpointTTL)
+			configFileOpts.Completed().ApplyInfrastructureEgressReport(&azureinfrastructure.DefaultAddOptions.EgressReport)
 			reconcileOpts.Completed().Apply(&azureinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation, &azureinfrastructure.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&azurecontrolplane.DefaultAddOptions.IgnoreOperationAnnotation, &azurecontrolplane.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&azureworker.DefaultAddOptions.IgnoreOperationAnnotation, &azureworker.DefaultAddOptions.ExtensionClass)
@@ -232,6 +245,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&azurebackupentry.DefaultAddOptions.IgnoreOperationAnnotation, &azurebackupentry.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&azurednsrecord.DefaultAddOptions.IgnoreOperationAnnotation, &azurednsrecord.DefaultAddOptions.ExtensionClass)
 			workerCtrlOpts.Completed().Apply(&azureworker.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyMaxConcurrentReconciles("worker", &azureworker.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyWorkerMarketplaceAgreements(&azureworker.DefaultAddOptions.MarketplaceAgreements)
 			azureworker.DefaultAddOptions.GardenCluster = gardenCluster
 			azureworker.DefaultAddOptions.AutonomousShootCluster = generalOpts.Completed().AutonomousShootCluster
 
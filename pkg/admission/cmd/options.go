@@ -5,7 +5,12 @@
 package cmd
 
 import (
+	"fmt"
+	"net/netip"
+	"time"
+
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/validator"
@@ -19,3 +24,72 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+// MachineImageExpirationOptions are command line options for the validation of the machine image versions which are
+// selected by Shoots against their expiration dates in the CloudProfile.
+type MachineImageExpirationOptions struct {
+	// Policy is the policy with which Shoots are handled that select a machine image version which is deprecated,
+	// expired or expires within the window. The validation is disabled if it is empty.
+	Policy string
+	// Window is the duration before the expiration date of a machine image version in which it is reported.
+	Window time.Duration
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *MachineImageExpirationOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Policy, "machine-image-expiration-policy", o.Policy, fmt.Sprintf("Policy for Shoots which select a deprecated or soon expiring machine image version, one of %q or %q. The validation is disabled if not set.",
+		validator.MachineImageExpirationPolicyWarn, validator.MachineImageExpirationPolicyReject))
+	fs.DurationVar(&o.Window, "machine-image-expiration-window", o.Window, "Duration before the expiration date of a machine image version in which Shoots selecting it are reported.")
+}
+
+// Complete implements Completer.Complete.
+func (o *MachineImageExpirationOptions) Complete() error {
+	switch validator.MachineImageExpirationPolicy(o.Policy) {
+	case "", validator.MachineImageExpirationPolicyWarn, validator.MachineImageExpirationPolicyReject:
+	default:
+		return fmt.Errorf("unsupported machine image expiration policy %q, supported policies are %q and %q",
+			o.Policy, validator.MachineImageExpirationPolicyWarn, validator.MachineImageExpirationPolicyReject)
+	}
+	if o.Window < 0 {
+		return fmt.Errorf("the machine image expiration window must not be negative")
+	}
+	return nil
+}
+
+// Apply applies the options to the Shoot validator.
+func (o *MachineImageExpirationOptions) Apply() {
+	validator.SetMachineImageExpiration(validator.MachineImageExpirationPolicy(o.Policy), o.Window)
+}
+
+// ReservedVNetCIDRsOptions are command line options for the validation of the VNet CIDRs of Shoots against the ranges
+// of a shared address space, e.g. of a hub-spoke network whose VNets are peered.
+type ReservedVNetCIDRsOptions struct {
+	// CIDRs are the reserved ranges which the VNets of Shoots must not overlap with. The validation is disabled if it is
+	// empty.
+	CIDRs []string
+
+	ranges []netip.Prefix
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *ReservedVNetCIDRsOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.CIDRs, "reserved-vnet-cidrs", o.CIDRs, "Comma-separated list of reserved ranges of a shared address space which the VNets of Shoots must not overlap with. The validation is disabled if not set.")
+}
+
+// Complete implements Completer.Complete.
+func (o *ReservedVNetCIDRsOptions) Complete() error {
+	o.ranges = make([]netip.Prefix, 0, len(o.CIDRs))
+	for _, cidr := range o.CIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid reserved vnet cidr %q: %w", cidr, err)
+		}
+		o.ranges = append(o.ranges, prefix.Masked())
+	}
+	return nil
+}
+
+// Apply applies the options to the Shoot validator.
+func (o *ReservedVNetCIDRsOptions) Apply() {
+	validator.SetReservedVNetCIDRs(o.ranges)
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"sync"
+	"time"
+)
+
+// MachineImageExpirationPolicy is the policy with which Shoots are handled that select a machine image version which is
+// deprecated or expires soon.
+type MachineImageExpirationPolicy string
+
+const (
+	// MachineImageExpirationPolicyWarn admits the Shoot and logs a warning.
+	MachineImageExpirationPolicyWarn MachineImageExpirationPolicy = "Warn"
+	// MachineImageExpirationPolicyReject rejects the Shoot.
+	MachineImageExpirationPolicyReject MachineImageExpirationPolicy = "Reject"
+)
+
+var (
+	machineImageExpirationMutex  sync.RWMutex
+	machineImageExpirationPolicy MachineImageExpirationPolicy
+	machineImageExpirationWindow time.Duration
+)
+
+// SetMachineImageExpiration sets the policy with which Shoots are handled that select a machine image version which is
+// deprecated, expired or expires within the given window. An empty policy disables the validation.
+func SetMachineImageExpiration(policy MachineImageExpirationPolicy, window time.Duration) {
+	machineImageExpirationMutex.Lock()
+	defer machineImageExpirationMutex.Unlock()
+
+	machineImageExpirationPolicy, machineImageExpirationWindow = policy, max(window, 0)
+}
+
+// machineImageExpiration returns the policy and the window of the validation of the machine image expiration dates.
+func machineImageExpiration() (MachineImageExpirationPolicy, time.Duration) {
+	machineImageExpirationMutex.RLock()
+	defer machineImageExpirationMutex.RUnlock()
+
+	return machineImageExpirationPolicy, machineImageExpirationWindow
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+)
+
+var (
+	reservedVNetCIDRsMutex sync.RWMutex
+	reservedVNetCIDRs      []netip.Prefix
+)
+
+// SetReservedVNetCIDRs sets the ranges of the shared address space which the VNets of Shoots must not overlap with. No
+// ranges disable the validation.
+func SetReservedVNetCIDRs(ranges []netip.Prefix) {
+	reservedVNetCIDRsMutex.Lock()
+	defer reservedVNetCIDRsMutex.Unlock()
+
+	reservedVNetCIDRs = slices.Clone(ranges)
+}
+
+// reservedVNetRanges returns the ranges of the shared address space which the VNets of Shoots must not overlap with.
+func reservedVNetRanges() []netip.Prefix {
+	reservedVNetCIDRsMutex.RLock()
+	defer reservedVNetCIDRsMutex.RUnlock()
+
+	return reservedVNetCIDRs
+}
@@ -22,6 +22,7 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azurevalidation "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
 )
 
 var (
@@ -99,10 +100,10 @@ func (s *shoot) validateCreation(_ context.Context, shoot *core.Shoot, cloudProf
 		}
 	}
 
-	return s.validateShoot(shoot, nil, infraConfig, cloudProfileSpec, cpConfig).ToAggregate()
+	return s.validateShoot(shoot, nil, nil, infraConfig, cloudProfileSpec, cpConfig).ToAggregate()
 }
 
-func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *api.InfrastructureConfig, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cpConfig *api.ControlPlaneConfig) field.ErrorList {
+func (s *shoot) validateShoot(shoot *core.Shoot, oldWorkers []core.Worker, oldInfraConfig, infraConfig *api.InfrastructureConfig, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cpConfig *api.ControlPlaneConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	// Network validation
@@ -113,27 +114,120 @@ func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *ap
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigAgainstCloudProfile(oldInfraConfig, infraConfig, shoot.Spec.Region, cloudProfileSpec, infraConfigPath)...)
 		// Provider validation
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfig(infraConfig, shoot, infraConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigAgainstControlPlaneConfig(infraConfig, cpConfig, shoot, infraConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateVNetCIDRsAgainstReservedRanges(oldInfraConfig, infraConfig, reservedVNetRanges(), infraConfigPath)...)
+
+		// existing private links are still allowed, so that their shoots can be updated if the feature gate is disabled.
+		privateLinkAdded := infraConfig.Networks.PrivateLink != nil && (oldInfraConfig == nil || oldInfraConfig.Networks.PrivateLink == nil)
+		if privateLinkAdded && !features.ExtensionFeatureGate.Enabled(features.EnablePrivateLinkConnectivity) {
+			allErrs = append(allErrs, field.Forbidden(infraConfigPath.Child("networks", "privateLink"),
+				fmt.Sprintf("private link can only be configured if the %q feature gate is enabled", features.EnablePrivateLinkConnectivity)))
+		}
 	}
 	if cpConfig != nil {
-		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, cpConfigPath)...)
+		var maxNodes int32
+		for _, worker := range shoot.Spec.Provider.Workers {
+			maxNodes += worker.Maximum
+		}
+		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, maxNodes, cpConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfigAgainstWorkers(cpConfig, shoot.Spec.Provider.Workers, cpConfigPath)...)
+		if infraConfig != nil {
+			allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfigAgainstInfrastructure(cpConfig, infraConfig, cpConfigPath)...)
+		}
 	}
 
 	// Shoot workers
 	allErrs = append(allErrs, azurevalidation.ValidateWorkers(shoot.Spec.Provider.Workers, infraConfig, workersPath)...)
 
+	var cloudProfileConfig *api.CloudProfileConfig
+	if cloudProfileSpec.ProviderConfig != nil {
+		var err error
+		if cloudProfileConfig, err = decodeCloudProfileConfig(s.lenientDecoder, cloudProfileSpec.ProviderConfig); err != nil {
+			allErrs = append(allErrs, field.InternalError(workersPath, fmt.Errorf("could not decode the providerConfig of the CloudProfile: %w", err)))
+		}
+	}
+
 	for i, worker := range shoot.Spec.Provider.Workers {
 		workerFldPath := workersPath.Index(i)
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerLabels(worker.Labels, oldWorkerLabels(oldWorkers, worker.Name), workerFldPath.Child("labels"))...)
+		allErrs = append(allErrs, azurevalidation.ValidateVNetEncryption(worker, infraConfig, cloudProfileConfig, workerFldPath.Child("machine", "type"))...)
 		workerConfig, err := decodeWorkerConfig(s.decoder, worker.ProviderConfig)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(workerFldPath.Child("providerConfig"), err, "invalid providerConfig"))
 		} else {
 			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfig(workerConfig, worker.DataVolumes, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateSinglePlacementGroup(workerConfig, worker, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateFaultDomainCount(workerConfig, infraConfig, shoot.Spec.Region, cloudProfileConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateSubnetName(workerConfig, worker, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateFallbackZones(workerConfig, worker, infraConfig, shoot.Spec.Region, cloudProfileSpec, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateAutomaticRepairs(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateScaleInPolicy(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateCapacityPolicy(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateAzureMonitorAgent(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateUserDataEncryption(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateOSDiskCaching(workerConfig, worker, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidatePrePullImages(workerConfig, worker, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateRollingUpdate(workerConfig, worker, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateMachineImageVersion(workerConfig, s.oldWorkerConfig(oldWorkers, worker.Name), worker, cloudProfileSpec, cloudProfileConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateLicenseType(workerConfig, worker, cloudProfileConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, s.validateMachineImageExpiration(shoot, workerConfig, oldWorkers, worker, cloudProfileSpec, workerFldPath)...)
 		}
 	}
 
 	return allErrs
 }
 
+// validateMachineImageExpiration validates the expiration of the machine image version of the given worker pool according
+// to the configured policy. With the warn policy, the findings are only logged, since the webhook cannot return
+// admission warnings.
+func (s *shoot) validateMachineImageExpiration(shoot *core.Shoot, workerConfig *api.WorkerConfig, oldWorkers []core.Worker, worker core.Worker, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
+	policy, window := machineImageExpiration()
+	if policy == "" {
+		return nil
+	}
+
+	var oldWorker *core.Worker
+	for i := range oldWorkers {
+		if oldWorkers[i].Name == worker.Name {
+			oldWorker = &oldWorkers[i]
+		}
+	}
+
+	allErrs := azurevalidation.ValidateMachineImageExpiration(workerConfig, s.oldWorkerConfig(oldWorkers, worker.Name), worker, oldWorker, cloudProfileSpec, window, fldPath)
+	if policy == MachineImageExpirationPolicyReject {
+		return allErrs
+	}
+	for _, err := range allErrs {
+		logger.Info("Shoot selects a machine image version which is deprecated or expires soon", "shoot", client.ObjectKeyFromObject(shoot), "warning", err.Error())
+	}
+	return nil
+}
+
+// oldWorkerConfig returns the WorkerConfig of the old worker pool with the given name or nil if there is none or it
+// cannot be decoded.
+func (s *shoot) oldWorkerConfig(oldWorkers []core.Worker, name string) *api.WorkerConfig {
+	for _, worker := range oldWorkers {
+		if worker.Name != name {
+			continue
+		}
+		workerConfig, err := decodeWorkerConfig(s.lenientDecoder, worker.ProviderConfig)
+		if err != nil {
+			return nil
+		}
+		return workerConfig
+	}
+	return nil
+}
+
+func oldWorkerLabels(oldWorkers []core.Worker, name string) map[string]string {
+	for _, worker := range oldWorkers {
+		if worker.Name == name {
+			return worker.Labels
+		}
+	}
+	return nil
+}
+
 func (s *shoot) validateUpdate(oldShoot, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) error {
 	// Decode the new infrastructure config.
 	if shoot.Spec.Provider.InfrastructureConfig == nil {
@@ -169,7 +263,7 @@ func (s *shoot) validateUpdate(oldShoot, shoot *core.Shoot, cloudProfileSpec *ga
 
 	allErrs = append(allErrs, azurevalidation.ValidateWorkersUpdate(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers, workersPath)...)
 
-	allErrs = append(allErrs, s.validateShoot(shoot, oldInfraConfig, infraConfig, cloudProfileSpec, cpConfig)...)
+	allErrs = append(allErrs, s.validateShoot(shoot, oldShoot.Spec.Provider.Workers, oldInfraConfig, infraConfig, cloudProfileSpec, cpConfig)...)
 
 	return allErrs.ToAggregate()
 }
@@ -7,10 +7,13 @@ package validator_test
 import (
 	"context"
 	"encoding/json"
+	"net/netip"
+	"time"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
@@ -28,6 +31,7 @@ import (
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apisazurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -247,6 +251,165 @@ var _ = Describe("Shoot validator", func() {
 				}))))
 			})
 
+			It("should return err when a worker pool uses a zone which is not configured in the infrastructure", func() {
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisazurev1alpha1.NetworkConfig{
+							VNet:  apisazurev1alpha1.VNet{CIDR: ptr.To("10.250.0.0/16")},
+							Zones: []apisazurev1alpha1.Zone{{Name: 1, CIDR: "10.250.0.0/24"}},
+						},
+						Zoned: true,
+					}),
+				}
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.provider.workers[0].zones[1]"),
+					"Detail": ContainSubstring(`zone "2" of worker pool "worker-1"`),
+				}))))
+			})
+
+			It("should return err when a worker pool pins an expired machine image version", func() {
+				cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{{
+					Name: imageName,
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: imageVersion}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "0.9.0", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}}},
+					},
+				}}
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						MachineImageVersion: ptr.To("0.9.0"),
+					}),
+				}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.workers[0].providerConfig.machineImageVersion"),
+					"Detail": ContainSubstring("is expired"),
+				}))))
+			})
+
+			Context("machine image expiration", func() {
+				BeforeEach(func() {
+					cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{{
+						Name: imageName,
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: imageVersion, Classification: ptr.To(gardencorev1beta1.ClassificationDeprecated)}},
+						},
+					}}
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				})
+
+				It("should return err when the policy rejects a deprecated machine image version", func() {
+					validator.SetMachineImageExpiration(validator.MachineImageExpirationPolicyReject, 0)
+					DeferCleanup(validator.SetMachineImageExpiration, validator.MachineImageExpirationPolicy(""), time.Duration(0))
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.provider.workers[0].machine.image.version"),
+						"Detail": Equal(`version "1.0.0" of machine image "Foo" of worker pool "worker-1" is classified as deprecated`),
+					}))))
+				})
+
+				It("should succeed when the policy only warns about a deprecated machine image version", func() {
+					validator.SetMachineImageExpiration(validator.MachineImageExpirationPolicyWarn, 0)
+					DeferCleanup(validator.SetMachineImageExpiration, validator.MachineImageExpirationPolicy(""), time.Duration(0))
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should succeed when the validation is disabled", func() {
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+			})
+
+			Context("reserved vnet cidrs", func() {
+				BeforeEach(func() {
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				})
+
+				It("should return err when the vnet overlaps with a reserved range", func() {
+					validator.SetReservedVNetCIDRs([]netip.Prefix{netip.MustParsePrefix("10.250.128.0/17")})
+					DeferCleanup(validator.SetReservedVNetCIDRs, []netip.Prefix(nil))
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.provider.infrastructureConfig.networks.workers"),
+						"Detail": ContainSubstring(`must not overlap with the reserved range "10.250.128.0/17"`),
+					}))))
+				})
+
+				It("should succeed when the vnet does not overlap with the reserved ranges", func() {
+					validator.SetReservedVNetCIDRs([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/16")})
+					DeferCleanup(validator.SetReservedVNetCIDRs, []netip.Prefix(nil))
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+			})
+
+			Context("private link", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+						Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+								Kind:       "InfrastructureConfig",
+							},
+							Networks: apisazurev1alpha1.NetworkConfig{
+								VNet:    apisazurev1alpha1.VNet{CIDR: ptr.To("10.250.0.0/16")},
+								Workers: ptr.To("10.250.0.0/19"),
+								PrivateLink: &apisazurev1alpha1.PrivateLinkConfig{
+									CIDR:             "10.250.32.0/24",
+									EndpointSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed/subnets/private-endpoints",
+								},
+							},
+							Zoned: true,
+						}),
+					}
+				})
+
+				It("should return err when the feature gate is disabled", func() {
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.infrastructureConfig.networks.privateLink"),
+					}))))
+				})
+
+				It("should succeed when the feature gate is enabled", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.EnablePrivateLinkConnectivity, true))
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should succeed for an existing private link when the feature gate is disabled", func() {
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					err := shootValidator.Validate(ctx, shoot, shoot.DeepCopy())
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
 			It("should succeed for valid Shoot", func() {
 				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
 
@@ -17,9 +17,10 @@ var (
 	rateLimitsExceededRegexp            = regexp.MustCompile(`(?i)(RequestLimitExceeded|Throttling|Too many requests)`)
 	dependenciesRegexp                  = regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|Conflict|inactive billing state|ReadOnlyDisabledSubscription|is already being used|InUseSubnetCannotBeDeleted|VnetInUse|InUseRouteTableCannotBeDeleted|timeout while waiting for state to become|InvalidCidrBlock|already busy for|InternalServerError|internal server error|A resource with the ID|VnetAddressSpaceCannotChangeDueToPeerings|InternalBillingError|NetcfgSubnetRangesOverlap)`)
 	retryableDependenciesRegexp         = regexp.MustCompile(`(?i)(RetryableError)`)
-	resourcesDepletedRegexp             = regexp.MustCompile(`(?i)(not available in the current hardware cluster|SkuNotAvailable|ZonalAllocationFailed|out of stock)`)
-	configurationProblemRegexp          = regexp.MustCompile(`(?i)(AzureBastionSubnet|not supported in your requested Availability Zone|InvalidParameter|notFound|NetcfgInvalidSubnet|Invalid value|violates constraint|no attached internet gateway found|Your query returned no results|PrivateEndpointNetworkPoliciesCannotBeEnabledOnPrivateEndpointSubnet|invalid VPC attributes|PrivateLinkServiceNetworkPoliciesCannotBeEnabledOnPrivateLinkServiceSubnet|unrecognized feature gate|runtime-config invalid key|LoadBalancingRuleMustDisableSNATSinceSameFrontendIPConfigurationIsReferencedByOutboundRule|strict decoder error|not allowed to configure an unsupported|error during apply of object .* is invalid:|duplicate zones|overlapping zones)`)
-	retryableConfigurationProblemRegexp = regexp.MustCompile(`(?i)(OverconstrainedZonalAllocationRequest|is misconfigured and requires zero voluntary evictions|SDK.CanNotResolveEndpoint|The requested configuration is currently not supported)`)
+	resourcesDepletedRegexp             = regexp.MustCompile(`(?i)(not available in the current hardware cluster|SkuNotAvailable|AllocationFailed|out of stock)`)
+	configurationProblemRegexp          = regexp.MustCompile(`(?i)(AzureBastionSubnet|not supported in your requested Availability Zone|InvalidParameter|notFound|NetcfgInvalidSubnet|Invalid value|violates constraint|no attached internet gateway found|Your query returned no results|PrivateEndpointNetworkPoliciesCannotBeEnabledOnPrivateEndpointSubnet|invalid VPC attributes|PrivateLinkServiceNetworkPoliciesCannotBeEnabledOnPrivateLinkServiceSubnet|unrecognized feature gate|runtime-config invalid key|LoadBalancingRuleMustDisableSNATSinceSameFrontendIPConfigurationIsReferencedByOutboundRule|strict decoder error|not allowed to configure an unsupported|error during apply of object .* is invalid:|duplicate zones|overlapping zones|MissingSubscriptionRegistration)`)
+	retryableConfigurationProblemRegexp = regexp.MustCompile(`(?i)(OverconstrainedZonalAllocationRequest|OverconstrainedAllocationRequest|is misconfigured and requires zero voluntary evictions|SDK.CanNotResolveEndpoint|The requested configuration is currently not supported)`)
+	allocationFailedRegexp              = regexp.MustCompile(`(?i)(AllocationFailed|OverconstrainedAllocationRequest|OverconstrainedZonalAllocationRequest)`)
 
 	// KnownCodes maps Gardener error codes to respective regex.
 	KnownCodes = map[gardencorev1beta1.ErrorCode]func(string) bool{
@@ -34,3 +35,9 @@ var (
 		gardencorev1beta1.ErrorRetryableConfigurationProblem: retryableConfigurationProblemRegexp.MatchString,
 	}
 )
+
+// IsAllocationFailure returns true if the given message reports that Azure could not allocate capacity for a virtual machine
+// in the requested availability set, scale set or zone.
+func IsAllocationFailure(message string) bool {
+	return allocationFailedRegexp.MatchString(message)
+}
@@ -6,6 +6,8 @@ package helper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/utils/ptr"
@@ -122,7 +124,29 @@ func InfrastructureZoneToString(zone int32) string {
 	return fmt.Sprintf("%d", zone)
 }
 
+// PublicIPDNSLabel renders the DNS name label of the public IP with the given zero-based index of a NAT gateway from
+// the given template. The zone is empty for NAT gateways which are not zonal.
+func PublicIPDNSLabel(template, shootName, zone string, index int) string {
+	return strings.NewReplacer("{shoot}", shootName, "{zone}", zone, "{index}", strconv.Itoa(index+1)).Replace(template)
+}
+
 // IsUsingSingleSubnetLayout returns true if the infrastructure configuration is using a network setup with a single subnet.
 func IsUsingSingleSubnetLayout(config *api.InfrastructureConfig) bool {
 	return len(config.Networks.Zones) == 0
 }
+
+// AdditionalSubnetName returns the name of the Azure subnet of the additional subnet with the given name. The prefix is
+// the technical name of the shoot, which is also the name of its resource group.
+func AdditionalSubnetName(prefix, name string) string {
+	return fmt.Sprintf("%s-nodes-%s", prefix, name)
+}
+
+// FindAdditionalSubnet returns the additional subnet with the given name or nil if there is none.
+func FindAdditionalSubnet(config *api.InfrastructureConfig, name string) *api.AdditionalSubnet {
+	for i, subnet := range config.Networks.AdditionalSubnets {
+		if subnet.Name == name {
+			return &config.Networks.AdditionalSubnets[i]
+		}
+	}
+	return nil
+}
@@ -103,6 +103,29 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.DomainCount{{Region: "bar", Count: int32(1)}}, "bar", 1, false),
 	)
 
+	DescribeTable("#IsAllocationFailure",
+		func(message string, expected bool) {
+			Expect(IsAllocationFailure(message)).To(Equal(expected))
+		},
+
+		Entry("empty message", "", false),
+		Entry("unrelated error", "Code=\"InvalidParameter\" Message=\"The value of parameter imageReference is invalid.\"", false),
+		Entry("allocation failed", "Code=\"AllocationFailed\" Message=\"Allocation failed. We do not have sufficient capacity for the requested VM size in this region.\"", true),
+		Entry("zonal allocation failed", "Code=\"ZonalAllocationFailed\" Message=\"Allocation failed. We do not have sufficient capacity for the requested VM size in this zone.\"", true),
+		Entry("overconstrained allocation", "Code=\"OverconstrainedAllocationRequest\"", true),
+		Entry("overconstrained zonal allocation", "Code=\"OverconstrainedZonalAllocationRequest\"", true),
+	)
+
+	DescribeTable("#PublicIPDNSLabel",
+		func(template, zone string, index int, expected string) {
+			Expect(PublicIPDNSLabel(template, "bar", zone, index)).To(Equal(expected))
+		},
+
+		Entry("without placeholders", "egress", "1", 0, "egress"),
+		Entry("with all placeholders", "egress-{shoot}-z{zone}-{index}", "2", 1, "egress-bar-z2-2"),
+		Entry("without zone", "egress-{shoot}{zone}-{index}", "", 0, "egress-bar-1"),
+	)
+
 	DescribeTable("#FindImage",
 		func(profileImages []api.MachineImages, imageName, version string, architecture *string, expectedImage *api.MachineImage) {
 			cfg := &api.CloudProfileConfig{}
@@ -60,6 +60,54 @@ func InfrastructureConfigFromInfrastructure(infra *extensionsv1alpha1.Infrastruc
 	return nil, fmt.Errorf("provider config is not set on the infrastructure resource")
 }
 
+// InfrastructureConfigFromCluster extracts the InfrastructureConfig from the Shoot of the given cluster. An empty
+// config is returned if none is set.
+func InfrastructureConfigFromCluster(cluster *controller.Cluster) (*api.InfrastructureConfig, error) {
+	config := &api.InfrastructureConfig{}
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw != nil {
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ControlPlaneConfigFromControlPlane extracts the ControlPlaneConfig from the
+// ProviderConfig section of the given ControlPlane. An empty config is returned if none is set.
+func ControlPlaneConfigFromControlPlane(cp *extensionsv1alpha1.ControlPlane) (*api.ControlPlaneConfig, error) {
+	config := &api.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil && cp.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// ControlPlaneStatusFromControlPlane extracts the ControlPlaneStatus from the ProviderStatus section of the given
+// ControlPlane. An empty status is returned if none is set.
+func ControlPlaneStatusFromControlPlane(cp *extensionsv1alpha1.ControlPlane) (*api.ControlPlaneStatus, error) {
+	status := &api.ControlPlaneStatus{}
+	if cp.Status.ProviderStatus != nil && cp.Status.ProviderStatus.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(cp.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// WorkerStatusFromWorker extracts the WorkerStatus from the ProviderStatus section of the given Worker. An empty status
+// is returned if none is set.
+func WorkerStatusFromWorker(worker *extensionsv1alpha1.Worker) (*api.WorkerStatus, error) {
+	status := &api.WorkerStatus{}
+	if worker.Status.ProviderStatus != nil && worker.Status.ProviderStatus.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(worker.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
 // InfrastructureStatusFromRaw extracts the InfrastructureStatus from the
 // ProviderStatus section of the given Infrastructure.
 func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.InfrastructureStatus, error) {
@@ -112,6 +160,18 @@ func BackupConfigFromProviderConfig(config *runtime.RawExtension) (api.BackupBuc
 	return backupConfig, err
 }
 
+// DNSRecordConfigFromDNSRecord decodes the provider specific config from a given DNSRecord object. An empty config is
+// returned if none is set.
+func DNSRecordConfigFromDNSRecord(dns *extensionsv1alpha1.DNSRecord) (*api.DNSRecordConfig, error) {
+	config := &api.DNSRecordConfig{}
+	if dns != nil && dns.Spec.ProviderConfig != nil && dns.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(dns.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // HasFlowState returns true if the group version of the State field in the provided
 // `extensionsv1alpha1.InfrastructureStatus` is azure.provider.extensions.gardener.cloud/v1alpha1.
 func HasFlowState(status extensionsv1alpha1.InfrastructureStatus) (bool, error) {
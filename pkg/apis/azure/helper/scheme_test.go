@@ -8,7 +8,9 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -75,4 +77,33 @@ var _ = Describe("Scheme", func() {
 		Entry("when raw.Raw is nil", &runtime.RawExtension{Raw: nil}, nil, true),
 		Entry("when raw is valid", &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkloadIdentityConfig"}`)}, &api.WorkloadIdentityConfig{}, false),
 	)
+
+	DescribeTable("#DNSRecordConfigFromDNSRecord",
+		func(providerConfig *runtime.RawExtension, expectedConfig *api.DNSRecordConfig, expectedErr bool) {
+			dns := &extensionsv1alpha1.DNSRecord{
+				Spec: extensionsv1alpha1.DNSRecordSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: providerConfig},
+				},
+			}
+			result, err := DNSRecordConfigFromDNSRecord(dns)
+			if expectedErr {
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(expectedConfig))
+			}
+		},
+		Entry("when providerConfig is nil", nil, &api.DNSRecordConfig{}, false),
+		Entry("when providerConfig is invalid json", &runtime.RawExtension{Raw: []byte(`invalid-json`)}, nil, true),
+		Entry("when providerConfig is valid", &runtime.RawExtension{Raw: []byte(`{
+			"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1",
+			"kind":"DNSRecordConfig",
+			"resourceGroup":"dns-rg",
+			"secretRef":{"name":"dns-credentials","namespace":"garden"}
+		}`)}, &api.DNSRecordConfig{
+			ResourceGroup: ptr.To("dns-rg"),
+			SecretRef:     &corev1.SecretReference{Name: "dns-credentials", Namespace: "garden"},
+		}, false),
+	)
 })
@@ -40,10 +40,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureStatus{},
 		&InfrastructureState{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerStatus{},
 		&WorkerConfig{},
 		&WorkloadIdentityConfig{},
 		&BackupBucketConfig{},
+		&DNSRecordConfig{},
 	)
 	return nil
 }
@@ -69,8 +69,23 @@ type MachineImageVersion struct {
 	AcceleratedNetworking *bool
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
+	// OperatingSystem is the operating system of the image, i.e. Windows, RHEL or SLES. It determines the license type
+	// with which Azure Hybrid Benefit can be applied to the machines of the image.
+	OperatingSystem *OperatingSystem
 }
 
+// OperatingSystem is the operating system of a machine image.
+type OperatingSystem string
+
+const (
+	// OperatingSystemWindows is the operating system of Windows Server images.
+	OperatingSystemWindows OperatingSystem = "Windows"
+	// OperatingSystemRHEL is the operating system of Red Hat Enterprise Linux images.
+	OperatingSystemRHEL OperatingSystem = "RHEL"
+	// OperatingSystemSLES is the operating system of SUSE Linux Enterprise Server images.
+	OperatingSystemSLES OperatingSystem = "SLES"
+)
+
 // MachineType contains provider specific information to a machine type.
 type MachineType struct {
 	// Name is the name of the machine type.
@@ -84,6 +99,9 @@ const (
 	AzureChinaCloudName  string = "AzureChina"
 	AzureGovCloudName    string = "AzureGovernment"
 	AzurePublicCloudName string = "AzurePublic"
+	// AzureStackHubCloudName is the name of the Azure Stack Hub whose Azure Resource Manager endpoint is configured in
+	// the controller configuration.
+	AzureStackHubCloudName string = "AzureStackHub"
 )
 
 // The known prefixes in of region names for the various instances.
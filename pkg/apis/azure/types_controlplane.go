@@ -5,6 +5,8 @@
 package azure
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,15 +20,92 @@ type ControlPlaneConfig struct {
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig
 
+	// CloudNodeManager contains configuration settings for the cloud-node-manager, which runs on every node of the
+	// shoot cluster.
+	// +optional
+	CloudNodeManager *CloudNodeManagerConfig
+
+	// CSISnapshotController contains configuration settings for the csi-snapshot-controller, which runs in the control
+	// plane and takes the volume snapshots of the shoot cluster.
+	// +optional
+	CSISnapshotController *CSISnapshotControllerConfig
+
 	// Storage contains configuration for storage in the cluster.
 	// +optional
 	Storage *Storage
+
+	// LoadBalancer contains configuration settings for the load balancer of the shoot cluster.
+	// +optional
+	LoadBalancer *LoadBalancerConfig
+}
+
+// LoadBalancerConfig contains configuration settings for the load balancer of the shoot cluster.
+type LoadBalancerConfig struct {
+	// OutboundRules configures dedicated outbound rules with distinct SNAT port allocations per protocol.
+	// +optional
+	OutboundRules *OutboundRules
+	// GatewayLoadBalancer chains a Gateway Load Balancer, e.g. of a network virtual appliance, to the frontend IP
+	// configurations of the outbound rules, so that the egress traffic of the nodes passes through it.
+	// Requires OutboundRules.
+	// +optional
+	GatewayLoadBalancer *GatewayLoadBalancer
+}
+
+// GatewayLoadBalancer references the frontend IP configuration of a Gateway Load Balancer.
+type GatewayLoadBalancer struct {
+	// FrontendIPConfigurationID is the ID of the frontend IP configuration of the Gateway Load Balancer, e.g.
+	// /subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.Network/loadBalancers/<name>/frontendIPConfigurations/<frontend>.
+	FrontendIPConfigurationID string
+}
+
+// OutboundRules contains the SNAT port allocations of the outbound rules of the load balancer.
+type OutboundRules struct {
+	// FrontendIPCount is the number of public IP addresses dedicated to outbound connections.
+	// Each public IP address provides 64000 SNAT ports per protocol.
+	FrontendIPCount *int32
+	// TCP is the SNAT port allocation of the outbound rule for TCP traffic.
+	TCP *OutboundRuleAllocation
+	// UDP is the SNAT port allocation of the outbound rule for UDP traffic.
+	UDP *OutboundRuleAllocation
+}
+
+// OutboundRuleAllocation contains the SNAT port allocation of an outbound rule.
+type OutboundRuleAllocation struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated to each node.
+	AllocatedOutboundPorts int32
+	// IdleTimeoutMinutes is the idle timeout of the outbound connections in minutes.
+	IdleTimeoutMinutes *int32
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// Resources overrides the resource requests and limits of the cloud-controller-manager. If the vertical pod
+	// autoscaler is enabled for the shoot, it still adapts the requests.
+	Resources *corev1.ResourceRequirements
+}
+
+// CloudNodeManagerConfig contains configuration settings for the cloud-node-manager.
+type CloudNodeManagerConfig struct {
+	// Resources overrides the resource requests and limits of the cloud-node-manager, e.g. for large clusters. If the
+	// vertical pod autoscaler is enabled for the shoot, it still adapts the requests.
+	Resources *corev1.ResourceRequirements
+}
+
+// CSISnapshotControllerConfig contains configuration settings for the csi-snapshot-controller.
+type CSISnapshotControllerConfig struct {
+	// Enabled controls if the csi-snapshot-controller is running, e.g. it can be disabled if another snapshot controller
+	// is deployed to the shoot cluster. Volume snapshots are not taken while it is disabled.
+	// Defaults to true.
+	Enabled *bool
+	// Replicas is the number of replicas of the csi-snapshot-controller while the control plane is not scaled down. It
+	// takes precedence over the replicas of the high availability configuration of the control plane.
+	// Defaults to the replicas of the high availability configuration.
+	Replicas *int32
+	// Resources overrides the resource requests and limits of the csi-snapshot-controller. If the vertical pod
+	// autoscaler is enabled for the shoot, it still adapts the requests.
+	Resources *corev1.ResourceRequirements
 }
 
 // Storage contains configuration for storage in the cluster.
@@ -41,4 +120,59 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool
+	// DefaultStorageClassVolumeBindingMode is the volume binding mode of the 'default' StorageClass. With
+	// WaitForFirstConsumer, volumes are provisioned in the zone of the node of their first pod. With Immediate, volumes
+	// are provisioned once they are claimed, in one of the zones of the worker pools.
+	// Defaults to WaitForFirstConsumer.
+	// +optional
+	DefaultStorageClassVolumeBindingMode *storagev1.VolumeBindingMode
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the resources the extension manages for the control plane.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta
+
+	// PrivateLink contains information about the Private Link connectivity between the control plane and the nodes.
+	PrivateLink *PrivateLinkStatus
+	// GatewayLoadBalancer contains information about the Gateway Load Balancer which is chained to the outbound frontend
+	// IP configurations of the load balancer of the cloud-controller-manager.
+	GatewayLoadBalancer *GatewayLoadBalancerStatus
+	// OutboundRules contains information about the outbound rules which the extension created on the load balancer of
+	// the cloud-controller-manager.
+	OutboundRules *OutboundRulesStatus
+}
+
+// OutboundRulesStatus contains information about the outbound rules which the extension created on the load balancer
+// of the cloud-controller-manager. Outbound SNAT is only disabled for the load balancing rules of the
+// cloud-controller-manager once the outbound rules exist.
+type OutboundRulesStatus struct {
+	// Names are the names of the outbound rules.
+	Names []string
+	// PublicIPAddressNames are the names of the public IP addresses of the frontend IP configurations of the outbound
+	// rules.
+	PublicIPAddressNames []string
+}
+
+// GatewayLoadBalancerStatus contains information about the Gateway Load Balancer which is chained to the outbound
+// frontend IP configurations of the load balancer of the cloud-controller-manager.
+type GatewayLoadBalancerStatus struct {
+	// FrontendIPConfigurationID is the resource ID of the frontend IP configuration of the chained Gateway Load Balancer.
+	FrontendIPConfigurationID string
+}
+
+// PrivateLinkStatus contains information about the Private Link connectivity between the control plane and the nodes.
+type PrivateLinkStatus struct {
+	// ServiceName is the name of the Private Link Service which the Private Endpoint connects to.
+	ServiceName string
+	// SubnetName is the name of the subnet which provides the NAT IP addresses of the Private Link Service.
+	SubnetName string
+	// EndpointID is the resource ID of the Private Endpoint. It is only set once the Private Link Service exists.
+	EndpointID *string
+	// EndpointIPAddresses are the private IP addresses of the Private Endpoint in the endpoint subnet.
+	EndpointIPAddresses []string
+	// ConnectionState is the state of the connection of the Private Endpoint to the Private Link Service, e.g. Approved
+	// or Pending.
+	ConnectionState *string
 }
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig is the provider-specific configuration for DNS records.
+type DNSRecordConfig struct {
+	metav1.TypeMeta
+	// ResourceGroup is the name of the resource group which contains the DNS zone. If set, the DNS zone is only looked up
+	// in this resource group.
+	ResourceGroup *string
+	// SecretRef is a reference to a secret with the credentials used for the DNS operations. If not set, the credentials
+	// referenced by the DNSRecord are used. The secret must be in the namespace of the DNSRecord.
+	SecretRef *corev1.SecretReference
+	// ZoneType is the type of the DNS zone of the record, i.e. Public (default) or Private. It selects in which of a public
+	// and a private DNS zone with the same name the record is managed, e.g. for split-horizon DNS. Private DNS zones
+	// are looked up in the resource group, which is required for them.
+	ZoneType *DNSZoneType
+	// Tags are set as metadata on the recordset of the DNSRecord. Tags which are removed from the config are removed from
+	// the recordset, while the metadata managed by Gardener, i.e. with keys starting with "gardener", is kept.
+	Tags map[string]string
+	// TagZone also adds the tags to the DNS zone of the record. The zone is shared with other records, hence its tags are
+	// only added or updated, but never removed. Defaults to false.
+	TagZone *bool
+}
+
+// DNSZoneType is the type of a DNS zone.
+type DNSZoneType string
+
+const (
+	// DNSZoneTypePublic is a public Azure DNS zone.
+	DNSZoneTypePublic DNSZoneType = "Public"
+	// DNSZoneTypePrivate is an Azure private DNS zone.
+	DNSZoneTypePrivate DNSZoneType = "Private"
+)
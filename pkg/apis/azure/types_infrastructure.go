@@ -15,6 +15,14 @@ type InfrastructureConfig struct {
 	metav1.TypeMeta
 	// ResourceGroup is azure resource group
 	ResourceGroup *ResourceGroup
+	// ResourceGroupLocation is the location of the metadata of the shoot's resource group. It defaults to the region of
+	// the shoot, the resources in the resource group are always created in the region of the shoot.
+	ResourceGroupLocation *string
+	// NetworkResourceGroup is an existing resource group in the region of the shoot in which the network resources of
+	// the shoot are created, i.e. the VNet with its subnets, the security group, the route table and the NAT gateways.
+	// The compute resources like the machines, their disks and the load balancers stay in the resource group of the
+	// shoot. The resource group is not deleted with the shoot, only the network resources created by the extension are.
+	NetworkResourceGroup *ResourceGroup
 	// Networks is the network configuration (VNets, subnets, etc.)
 	Networks NetworkConfig
 	// Identity contains configuration for the assigned managed identity.
@@ -39,8 +47,158 @@ type NetworkConfig struct {
 	NatGateway *NatGatewayConfig
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the worker subnet.
 	ServiceEndpoints []string
+	// ServiceEndpointPolicies is a list of resource IDs of existing Azure service endpoint policies which should be associated with the worker subnet.
+	ServiceEndpointPolicies []string
+	// PrivateEndpointNetworkPolicies controls whether network security groups and route tables apply to the private
+	// endpoints in the worker subnet. It is either Enabled or Disabled.
+	PrivateEndpointNetworkPolicies *string
+	// PrivateLinkServiceNetworkPolicies controls whether network policies apply to the private link services in the
+	// worker subnet. It is either Enabled or Disabled.
+	PrivateLinkServiceNetworkPolicies *string
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone
+	// Pods is the configuration of a subnet for the pods which is delegated to an Azure service.
+	Pods *PodSubnetConfig
+	// FlowLogs is the configuration of the virtual network flow logs of the VNet of the shoot.
+	FlowLogs *FlowLogsConfig
+	// AdditionalSubnets is a list of further subnets for the nodes, which worker pools can be assigned to.
+	AdditionalSubnets []AdditionalSubnet
+	// PrivateLink is the configuration of the Private Link connectivity between the control plane and the nodes.
+	PrivateLink *PrivateLinkConfig
+	// InternalLoadBalancer is the configuration of a dedicated subnet for the internal load balancers of the shoot.
+	InternalLoadBalancer *InternalLoadBalancerConfig
+	// GatewaySubnet is the configuration of the subnet named GatewaySubnet, which is required to deploy a VPN gateway or
+	// an ExpressRoute gateway into the VNet. The extension only manages the subnet, not the gateway.
+	GatewaySubnet *GatewaySubnetConfig
+	// RouteServerSubnet is the configuration of the subnet named RouteServerSubnet, which is required to deploy an Azure
+	// Route Server into the VNet, e.g. for dynamic routing with network virtual appliances. The extension only manages
+	// the subnet, not the Route Server.
+	RouteServerSubnet *RouteServerSubnetConfig
+	// PrivateDNSZone is the configuration of a private DNS zone which is linked to the VNet.
+	PrivateDNSZone *PrivateDNSZoneConfig
+	// OutboundLoadBalancer is the configuration of a load balancer which provides the outbound connectivity of the
+	// machines of all worker pools.
+	OutboundLoadBalancer *OutboundLoadBalancerConfig
+	// NetworkWatcher is the configuration of the Network Watcher of the region of the shoot.
+	NetworkWatcher *NetworkWatcherConfig
+	// DeletionProtection protects the VNet and the subnets of the shoot against deletions and recreations while the
+	// infrastructure is reconciled. They are only deleted once confirmed with an annotation of the shoot.
+	DeletionProtection *bool
+	// SecurityGroup references an existing network security group which is associated with the subnets of the nodes
+	// instead of a security group created by the extension. The security group is neither created nor deleted by the
+	// extension.
+	SecurityGroup *SecurityGroupReference
+}
+
+// SecurityGroupReference references an existing network security group.
+type SecurityGroupReference struct {
+	// Name is the name of the security group.
+	Name string
+	// ResourceGroup is the name of the resource group of the security group.
+	ResourceGroup string
+}
+
+// NetworkWatcherConfig contains the configuration of the Network Watcher of the region of the shoot.
+type NetworkWatcherConfig struct {
+	// Create allows the creation of a Network Watcher if there is none in the region of the shoot.
+	Create *bool
+}
+
+// OutboundLoadBalancerConfig contains the configuration of an outbound-only load balancer. Its backend pool is shared by
+// the machines of all worker pools, so that their SNAT ports are allocated from the same public IPs.
+type OutboundLoadBalancerConfig struct {
+	// PublicIPCount is the number of public IPs which are created as frontends of the load balancer. Defaults to 1.
+	PublicIPCount *int32
+	// AllocatedOutboundPorts is the number of SNAT ports which are allocated to each machine. If it is not set, Azure
+	// allocates the ports based on the size of the backend pool.
+	AllocatedOutboundPorts *int32
+	// IdleTimeoutMinutes is the idle timeout of outbound connections in minutes. Defaults to 4 minutes.
+	IdleTimeoutMinutes *int32
+}
+
+// PrivateDNSZoneConfig contains the configuration of a private DNS zone which is linked to the VNet of the shoot.
+type PrivateDNSZoneConfig struct {
+	// Name is the name of the private DNS zone, e.g. internal.example.com.
+	Name string
+	// RegistrationEnabled enables the automatic registration of the records of the machines in the VNet in the zone.
+	RegistrationEnabled bool
+}
+
+// PrivateLinkConfig contains the configuration of the Private Link connectivity between the control plane and the nodes.
+type PrivateLinkConfig struct {
+	// CIDR is the CIDR range of the subnet which provides the NAT IP addresses of the Private Link Service.
+	CIDR string
+	// EndpointSubnetID is the resource ID of an existing subnet the Private Endpoint is placed in, usually a subnet of
+	// the network of the seed.
+	EndpointSubnetID string
+}
+
+// InternalLoadBalancerConfig contains the configuration of the subnet in which the cloud-controller-manager creates the
+// frontend IPs of internal load balancers.
+type InternalLoadBalancerConfig struct {
+	// CIDR is the CIDR range of the subnet of the internal load balancers.
+	CIDR string
+}
+
+// GatewaySubnetConfig contains the configuration of the subnet of the virtual network gateways.
+type GatewaySubnetConfig struct {
+	// CIDR is the CIDR range of the gateway subnet. Azure requires a prefix length of at most 29.
+	CIDR string
+}
+
+// RouteServerSubnetConfig contains the configuration of the subnet of the Azure Route Server.
+type RouteServerSubnetConfig struct {
+	// CIDR is the CIDR range of the route server subnet. Azure requires a prefix length of at most 27.
+	CIDR string
+}
+
+// AdditionalSubnet is a subnet for the nodes in addition to the worker subnet or the subnets of the zones.
+type AdditionalSubnet struct {
+	// Name is the name of the subnet which worker pools reference.
+	Name string
+	// CIDR is the CIDR range used for the subnet.
+	CIDR string
+	// Zone binds the subnet to a zone of the multiple subnet layout, so that it shares the NAT gateway of the zone.
+	// Only worker pools of this zone can be assigned to the subnet.
+	Zone *int32
+	// AttachNatGateway controls whether the NAT gateway which the subnet shares is attached to it. A subnet without NAT
+	// gateway needs another egress path, e.g. the outbound rules of the load balancer. Defaults to true.
+	AttachNatGateway *bool
+}
+
+// FlowLogsConfig contains the configuration of the virtual network flow logs of the VNet of the shoot.
+type FlowLogsConfig struct {
+	// StorageAccountID is the resource ID of the storage account the flow logs are written to.
+	StorageAccountID string
+	// RetentionDays is the number of days the flow logs are retained in the storage account.
+	RetentionDays *int32
+	// TrafficAnalytics is the configuration of the traffic analytics of the flow logs.
+	TrafficAnalytics *TrafficAnalyticsConfig
+	// CreateNetworkWatcher allows the creation of a Network Watcher if there is none in the region of the shoot.
+	CreateNetworkWatcher *bool
+}
+
+// TrafficAnalyticsConfig contains the configuration of the traffic analytics of the flow logs.
+type TrafficAnalyticsConfig struct {
+	// WorkspaceResourceID is the resource ID of the Log Analytics workspace.
+	WorkspaceResourceID string
+	// WorkspaceID is the GUID of the Log Analytics workspace.
+	WorkspaceID string
+	// WorkspaceRegion is the region of the Log Analytics workspace.
+	WorkspaceRegion string
+	// IntervalMinutes is the interval in minutes in which the flow logs are processed.
+	IntervalMinutes *int32
+}
+
+// DefaultPodSubnetDelegation is the service the pod subnet is delegated to if no delegation is configured.
+const DefaultPodSubnetDelegation = "Microsoft.ContainerService/managedClusters"
+
+// PodSubnetConfig contains the configuration for a subnet that provides the IP addresses of the pods, e.g. for the Azure CNI.
+type PodSubnetConfig struct {
+	// CIDR is the CIDR range used for the pod subnet.
+	CIDR string
+	// Delegation is the name of the service the pod subnet is delegated to.
+	Delegation *string
 }
 
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
@@ -53,6 +211,18 @@ type NatGatewayConfig struct {
 	Zone *int32
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	IPAddresses []PublicIPReference
+	// PublicIPCount is the number of public IPs which are created and assigned to the NAT gateway if no IPAddresses are
+	// given. The count can be changed without recreating the NAT gateway. Defaults to 1.
+	PublicIPCount *int32
+	// PublicIPDNSLabelTemplate is the template of the DNS name label of the public IPs which are created for the NAT
+	// gateway, e.g. for a stable reverse DNS entry of the egress IPs. The placeholders {shoot}, {zone} and {index} are
+	// replaced with the name of the shoot, the zone of the NAT gateway and the one-based index of the public IP. The
+	// labels have to be unique within the region.
+	PublicIPDNSLabelTemplate *string
+	// PublicIPPrefix is a reference to an existing public IP prefix from which the public IPs which are created for the
+	// NAT gateway are allocated, e.g. an IPv4 prefix which is shared by several shoots to keep the number of egress
+	// CIDRs small. The prefix is neither created nor deleted by the extension. It cannot be combined with IPAddresses.
+	PublicIPPrefix *PublicIPPrefixReference
 }
 
 // PublicIPReference contains information about a public ip.
@@ -73,6 +243,14 @@ type Zone struct {
 	CIDR string
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the zone's subnet.
 	ServiceEndpoints []string
+	// ServiceEndpointPolicies is a list of resource IDs of existing Azure service endpoint policies which should be associated with the zone's subnet.
+	ServiceEndpointPolicies []string
+	// PrivateEndpointNetworkPolicies controls whether network security groups and route tables apply to the private
+	// endpoints in the zone's subnet. It is either Enabled or Disabled.
+	PrivateEndpointNetworkPolicies *string
+	// PrivateLinkServiceNetworkPolicies controls whether network policies apply to the private link services in the
+	// zone's subnet. It is either Enabled or Disabled.
+	PrivateLinkServiceNetworkPolicies *string
 	// NatGateway contains the configuration for the NatGateway associated with this subnet.
 	NatGateway *ZonedNatGatewayConfig
 }
@@ -85,6 +263,18 @@ type ZonedNatGatewayConfig struct {
 	IdleConnectionTimeoutMinutes *int32
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	IPAddresses []ZonedPublicIPReference
+	// PublicIPCount is the number of public IPs which are created and assigned to the NAT gateway if no IPAddresses are
+	// given. The count can be changed without recreating the NAT gateway. Defaults to 1.
+	PublicIPCount *int32
+	// PublicIPDNSLabelTemplate is the template of the DNS name label of the public IPs which are created for the NAT
+	// gateway, e.g. for a stable reverse DNS entry of the egress IPs. The placeholders {shoot}, {zone} and {index} are
+	// replaced with the name of the shoot, the zone of the NAT gateway and the one-based index of the public IP. The
+	// labels have to be unique within the region.
+	PublicIPDNSLabelTemplate *string
+	// PublicIPPrefix is a reference to an existing public IP prefix from which the public IPs which are created for the
+	// NAT gateway are allocated, e.g. an IPv4 prefix which is shared by several shoots to keep the number of egress
+	// CIDRs small. The prefix is neither created nor deleted by the extension. It cannot be combined with IPAddresses.
+	PublicIPPrefix *PublicIPPrefixReference
 }
 
 // ZonedPublicIPReference contains information about a public ip.
@@ -95,6 +285,14 @@ type ZonedPublicIPReference struct {
 	ResourceGroup string
 }
 
+// PublicIPPrefixReference is a reference to an existing public IP prefix.
+type PublicIPPrefixReference struct {
+	// Name is the name of the public IP prefix.
+	Name string
+	// ResourceGroup is the name of the resource group of the public IP prefix.
+	ResourceGroup string
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -124,6 +322,40 @@ type NetworkStatus struct {
 	Layout NetworkLayout
 	// OutboundAccessType is the type of outbound access configured for the shoot. It indicates how egress traffic flows outside the shoot.
 	OutboundAccessType OutboundAccessType
+	// PrivateDNSZone is the status of the private DNS zone linked to the VNet.
+	PrivateDNSZone *PrivateDNSZoneStatus
+	// OutboundLoadBalancer is the status of the load balancer which provides the outbound connectivity of the machines.
+	OutboundLoadBalancer *OutboundLoadBalancerStatus
+	// NetworkWatcher is the status of the Network Watcher of the region of the shoot.
+	NetworkWatcher *NetworkWatcherStatus
+	// ResourceGroup is the resource group of the network resources of the shoot, i.e. of the security group and the
+	// route table, if they are not located in the resource group of the shoot.
+	ResourceGroup *string
+}
+
+// NetworkWatcherStatus is the status of the Network Watcher of the region of the shoot.
+type NetworkWatcherStatus struct {
+	// ID is the resource ID of the Network Watcher.
+	ID string
+	// Managed is true if the Network Watcher was created by the extension. It is not deleted together with the
+	// infrastructure either, since it is shared with the other resources of the subscription.
+	Managed bool
+}
+
+// OutboundLoadBalancerStatus is the status of the outbound load balancer shared by the worker pools.
+type OutboundLoadBalancerStatus struct {
+	// ID is the resource ID of the load balancer.
+	ID string
+	// BackendAddressPoolID is the resource ID of the backend pool which the network interfaces of the machines are added to.
+	BackendAddressPoolID string
+}
+
+// PrivateDNSZoneStatus is the status of the private DNS zone linked to the VNet.
+type PrivateDNSZoneStatus struct {
+	// Name is the name of the private DNS zone.
+	Name string
+	// ID is the resource ID of the private DNS zone.
+	ID string
 }
 
 // Purpose is a purpose of a subnet.
@@ -134,6 +366,16 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposePods is a Purpose for the delegated subnet of the pods.
+	PurposePods Purpose = "pods"
+	// PurposePrivateLink is a Purpose for the subnet of the Private Link Service.
+	PurposePrivateLink Purpose = "privateLink"
+	// PurposeInternalLoadBalancer is a Purpose for the subnet of the internal load balancers.
+	PurposeInternalLoadBalancer Purpose = "internalLoadBalancer"
+	// PurposeGateway is a Purpose for the subnet of the virtual network gateways.
+	PurposeGateway Purpose = "gateway"
+	// PurposeRouteServer is a Purpose for the subnet of the Azure Route Server.
+	PurposeRouteServer Purpose = "routeServer"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -172,6 +414,10 @@ type Subnet struct {
 	// NatGatewayID is the ID of the NATGateway associated with the subnet.
 	// +optional
 	NatGatewayID *string
+	// NatGatewayPublicIPCount is the number of public IPs assigned to the NATGateway associated with the subnet.
+	NatGatewayPublicIPCount *int32
+	// ID is the ID of the subnet. It is only set for the subnet with purpose pods.
+	ID *string
 }
 
 // RouteTable is the azure route table
@@ -188,6 +434,9 @@ type SecurityGroup struct {
 	Purpose Purpose
 	// Name is the name of the security group
 	Name string
+	// ResourceGroup is the resource group of the security group if it is an existing security group which is referenced
+	// by the InfrastructureConfig.
+	ResourceGroup *string
 }
 
 // VNet contains information about the VNet and some related resources.
@@ -198,10 +447,34 @@ type VNet struct {
 	ResourceGroup *string
 	// CIDR is the VNet CIDR
 	CIDR *string
+	// AdditionalCIDRs are further address prefixes of the VNet besides its CIDR.
+	AdditionalCIDRs []string
 	// DDosProtectionPlanID is the id of a ddos protection plan assigned to the vnet.
 	DDosProtectionPlanID *string
+	// Encryption configures the encryption of the traffic between the virtual machines in the vnet.
+	Encryption *VNetEncryption
 }
 
+// VNetEncryption configures the encryption of the traffic between the virtual machines in a vnet.
+type VNetEncryption struct {
+	// Enabled enables the encryption.
+	Enabled bool
+	// Enforcement configures whether the vnet allows virtual machines which do not support encryption. Defaults to
+	// DropUnencrypted.
+	Enforcement *VNetEncryptionEnforcement
+}
+
+// VNetEncryptionEnforcement is the handling of virtual machines in an encrypted vnet which do not support encryption.
+type VNetEncryptionEnforcement string
+
+const (
+	// VNetEncryptionEnforcementAllowUnencrypted allows the unencrypted traffic of virtual machines which do not support
+	// encryption.
+	VNetEncryptionEnforcementAllowUnencrypted VNetEncryptionEnforcement = "AllowUnencrypted"
+	// VNetEncryptionEnforcementDropUnencrypted drops the traffic of virtual machines which do not support encryption.
+	VNetEncryptionEnforcementDropUnencrypted VNetEncryptionEnforcement = "DropUnencrypted"
+)
+
 // VNetStatus contains the VNet name.
 type VNetStatus struct {
 	// Name is the VNet name.
@@ -218,6 +491,32 @@ type IdentityConfig struct {
 	ResourceGroup string
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	ACRAccess *bool
+	// RoleAssignments are the roles which are assigned to the identity.
+	RoleAssignments []IdentityRoleAssignment
+	// FederatedCredentials are the federated identity credentials of the identity which are created for service accounts
+	// of the shoot, so that pods using these service accounts can authenticate as the identity.
+	FederatedCredentials []IdentityFederatedCredential
+}
+
+// IdentityRoleAssignment is a role which is assigned to the identity of the worker nodes.
+type IdentityRoleAssignment struct {
+	// RoleDefinitionID is the resource ID of the role definition, e.g.
+	// /subscriptions/<subscription-id>/providers/Microsoft.Authorization/roleDefinitions/<role-id>.
+	RoleDefinitionID string
+	// Scope is the resource ID of the scope at which the role is assigned. It must be the resource group of the shoot or
+	// a resource in it. Defaults to the resource group of the shoot.
+	Scope *string
+}
+
+// IdentityFederatedCredential is a federated identity credential of the identity which trusts the tokens of a service
+// account of the shoot, which are issued by the service account issuer of the shoot.
+type IdentityFederatedCredential struct {
+	// Name is the name of the federated identity credential.
+	Name string
+	// ServiceAccount is the service account of the shoot in the format <namespace>/<name>.
+	ServiceAccount string
+	// Audiences are the audiences of the service account tokens. Defaults to api://AzureADTokenExchange.
+	Audiences []string
 }
 
 // IdentityStatus contains the status information of the created managed identity.
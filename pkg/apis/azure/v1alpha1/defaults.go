@@ -31,6 +31,20 @@ func SetDefaults_Storage(obj *Storage) {
 	}
 }
 
+// SetDefaults_OutboundRules sets the default number of frontend IP addresses of the outbound rules.
+func SetDefaults_OutboundRules(obj *OutboundRules) {
+	if obj.FrontendIPCount == nil {
+		obj.FrontendIPCount = ptr.To[int32](1)
+	}
+}
+
+// SetDefaults_OutboundRuleAllocation sets the default idle timeout of an outbound rule.
+func SetDefaults_OutboundRuleAllocation(obj *OutboundRuleAllocation) {
+	if obj.IdleTimeoutMinutes == nil {
+		obj.IdleTimeoutMinutes = ptr.To[int32](4)
+	}
+}
+
 // SetDefaults_OutboundAccessType sets the default outbound access type.
 func SetDefaults_OutboundAccessType(obj *OutboundAccessType) {
 	*obj = ptr.Deref(obj, OutboundAccessTypeLoadBalancer)
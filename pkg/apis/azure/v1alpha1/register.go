@@ -34,9 +34,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureStatus{},
 		&InfrastructureState{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerConfig{},
 		&WorkerStatus{},
 		&BackupBucketConfig{},
+		&DNSRecordConfig{},
 		&WorkloadIdentityConfig{},
 	)
 	return nil
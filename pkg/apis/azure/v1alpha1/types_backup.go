@@ -31,6 +31,41 @@ type BackupBucketConfig struct {
 	// RotationConfig controls the behavior for the rotation of storage account keys.
 	// +optional
 	RotationConfig *RotationConfig `json:"rotationConfig,omitempty"`
+	// GeoReplication enables the read-access geo-zone-redundant replication of the storage account to the secondary
+	// region and the verification of its sync status.
+	// +optional
+	GeoReplication *GeoReplicationConfig `json:"geoReplication,omitempty"`
+	// DiagnosticSettings routes the logs and metrics of the storage account to a Log Analytics workspace or a storage account.
+	// +optional
+	DiagnosticSettings *DiagnosticSettingsConfig `json:"diagnosticSettings,omitempty"`
+	// StorageAccountNamePrefix is the prefix of the name of the storage account which is created for the backup bucket.
+	// The prefix is followed by a hash of the backup bucket name. Changing the prefix does not rename an existing
+	// storage account. Defaults to "bkp".
+	// +optional
+	StorageAccountNamePrefix *string `json:"storageAccountNamePrefix,omitempty"`
+	// PublicAccess enables the verification that public access to the blobs of the backup bucket is disallowed on each
+	// reconciliation.
+	// +optional
+	PublicAccess *PublicAccessConfig `json:"publicAccess,omitempty"`
+	// SecondaryStorageAccount enables a second storage account to which the backups are replicated. It cannot be removed
+	// once it is configured.
+	// +optional
+	SecondaryStorageAccount *SecondaryStorageAccountConfig `json:"secondaryStorageAccount,omitempty"`
+	// RequireInfrastructureEncryption enables a second layer of encryption of the data at rest of the storage accounts
+	// with platform-managed keys. It can only be configured when the storage accounts are created and cannot be changed
+	// afterwards.
+	// +optional
+	RequireInfrastructureEncryption *bool `json:"requireInfrastructureEncryption,omitempty"`
+	// ChangeFeed controls the change feed of the storage account, which records the changes of its blobs. The change
+	// feed is not changed if it is not set.
+	// +optional
+	ChangeFeed *ChangeFeedConfig `json:"changeFeed,omitempty"`
+	// SFTP enables the SFTP endpoint of the storage account with a local user which may read the backups, e.g. for
+	// external disaster recovery tools. SFTP requires a hierarchical namespace, which Azure only enables when a storage
+	// account is created, hence it can only be configured for new backup buckets. The hierarchical namespace does not support
+	// the blob index tags of the delayed deletion of immutable backups, hence SFTP cannot be combined with Immutability.
+	// +optional
+	SFTP *SFTPConfig `json:"sftp,omitempty"`
 }
 
 // ImmutableConfig represents the immutability configuration for a backup bucket.
@@ -55,3 +90,66 @@ type RotationConfig struct {
 	// ExpirationPeriod sets the policy on the storage account to expire stale storage account keys. Can only be configured if `rotationPeriod` is configured.
 	ExpirationPeriodDays *int32 `json:"expirationPeriodDays,omitempty"`
 }
+
+// GeoReplicationConfig controls the geo-replication of the storage account to the secondary region.
+type GeoReplicationConfig struct {
+	// MaxSyncLag is the maximum duration the last sync time of the secondary region may lag behind before the backup
+	// bucket is reported as degraded. Defaults to 1h.
+	// +optional
+	MaxSyncLag *metav1.Duration `json:"maxSyncLag,omitempty"`
+}
+
+// DiagnosticSettingsConfig contains the destinations of the diagnostic settings of the backup storage account.
+// At least one destination must be set.
+type DiagnosticSettingsConfig struct {
+	// WorkspaceID is the resource ID of the Log Analytics workspace the logs and metrics are sent to.
+	// +optional
+	WorkspaceID *string `json:"workspaceID,omitempty"`
+	// StorageAccountID is the resource ID of the storage account the logs and metrics are archived in.
+	// It must not be the backup storage account itself.
+	// +optional
+	StorageAccountID *string `json:"storageAccountID,omitempty"`
+}
+
+// PublicAccessMode defines how drift of the public access settings of the backup bucket is handled.
+type PublicAccessMode string
+
+const (
+	// PublicAccessModeVerify reports drift of the public access settings in the conditions of the backup bucket.
+	PublicAccessModeVerify PublicAccessMode = "Verify"
+	// PublicAccessModeEnforce additionally disallows public access to the container again if drift is detected.
+	PublicAccessModeEnforce PublicAccessMode = "Enforce"
+)
+
+// PublicAccessConfig controls the verification of the public access settings of the storage account and the container
+// of the backup bucket.
+type PublicAccessConfig struct {
+	// Mode is the handling of drift, either "Verify" or "Enforce".
+	Mode PublicAccessMode `json:"mode"`
+}
+
+// SecondaryStorageAccountConfig controls the secondary storage account of the backup bucket. The blobs of the backup
+// bucket are replicated asynchronously to a container of the same name in the secondary storage account by an object
+// replication policy.
+type SecondaryStorageAccountConfig struct {
+	// Region is the region of the secondary storage account. It cannot be changed.
+	Region string `json:"region"`
+}
+
+// ChangeFeedConfig controls the change feed of the storage account of the backup bucket.
+type ChangeFeedConfig struct {
+	// Enabled enables the change feed. It cannot be disabled if a secondary storage account is configured, since the
+	// object replication requires it.
+	Enabled bool `json:"enabled"`
+	// RetentionDays is the number of days after which the records of the change feed are deleted, between 1 and
+	// 146000. The records are kept forever if it is not set.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+}
+
+// SFTPConfig configures the SFTP endpoint of the storage account of the backup bucket.
+type SFTPConfig struct {
+	// SSHAuthorizedKeys are the public SSH keys of the local user. The local user may only read and list the blobs of
+	// the backup container, and cannot authenticate with a password.
+	SSHAuthorizedKeys []string `json:"sshAuthorizedKeys"`
+}
@@ -79,8 +79,24 @@ type MachineImageVersion struct {
 	// Architecture is the CPU architecture of the machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// OperatingSystem is the operating system of the image, i.e. Windows, RHEL or SLES. It determines the license type
+	// with which Azure Hybrid Benefit can be applied to the machines of the image.
+	// +optional
+	OperatingSystem *OperatingSystem `json:"operatingSystem,omitempty"`
 }
 
+// OperatingSystem is the operating system of a machine image.
+type OperatingSystem string
+
+const (
+	// OperatingSystemWindows is the operating system of Windows Server images.
+	OperatingSystemWindows OperatingSystem = "Windows"
+	// OperatingSystemRHEL is the operating system of Red Hat Enterprise Linux images.
+	OperatingSystemRHEL OperatingSystem = "RHEL"
+	// OperatingSystemSLES is the operating system of SUSE Linux Enterprise Server images.
+	OperatingSystemSLES OperatingSystem = "SLES"
+)
+
 // MachineType contains provider specific information to a machine type.
 type MachineType struct {
 	// Name is the name of the machine type.
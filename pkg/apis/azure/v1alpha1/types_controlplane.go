@@ -5,6 +5,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,8 +21,66 @@ type ControlPlaneConfig struct {
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
 
+	// CloudNodeManager contains configuration settings for the cloud-node-manager, which runs on every node of the
+	// shoot cluster.
+	// +optional
+	CloudNodeManager *CloudNodeManagerConfig `json:"cloudNodeManager,omitempty"`
+
+	// CSISnapshotController contains configuration settings for the csi-snapshot-controller, which runs in the control
+	// plane and takes the volume snapshots of the shoot cluster.
+	// +optional
+	CSISnapshotController *CSISnapshotControllerConfig `json:"csiSnapshotController,omitempty"`
+
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage `json:"storage,omitempty"`
+
+	// LoadBalancer contains configuration settings for the load balancer of the shoot cluster.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+}
+
+// LoadBalancerConfig contains configuration settings for the load balancer of the shoot cluster.
+type LoadBalancerConfig struct {
+	// OutboundRules configures dedicated outbound rules with distinct SNAT port allocations per protocol.
+	// +optional
+	OutboundRules *OutboundRules `json:"outboundRules,omitempty"`
+	// GatewayLoadBalancer chains a Gateway Load Balancer, e.g. of a network virtual appliance, to the frontend IP
+	// configurations of the outbound rules, so that the egress traffic of the nodes passes through it.
+	// Requires OutboundRules.
+	// +optional
+	GatewayLoadBalancer *GatewayLoadBalancer `json:"gatewayLoadBalancer,omitempty"`
+}
+
+// GatewayLoadBalancer references the frontend IP configuration of a Gateway Load Balancer.
+type GatewayLoadBalancer struct {
+	// FrontendIPConfigurationID is the ID of the frontend IP configuration of the Gateway Load Balancer, e.g.
+	// /subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.Network/loadBalancers/<name>/frontendIPConfigurations/<frontend>.
+	FrontendIPConfigurationID string `json:"frontendIPConfigurationID"`
+}
+
+// OutboundRules contains the SNAT port allocations of the outbound rules of the load balancer.
+type OutboundRules struct {
+	// FrontendIPCount is the number of public IP addresses dedicated to outbound connections.
+	// Each public IP address provides 64000 SNAT ports per protocol.
+	// Defaults to 1.
+	// +optional
+	FrontendIPCount *int32 `json:"frontendIPCount,omitempty"`
+	// TCP is the SNAT port allocation of the outbound rule for TCP traffic.
+	// +optional
+	TCP *OutboundRuleAllocation `json:"tcp,omitempty"`
+	// UDP is the SNAT port allocation of the outbound rule for UDP traffic.
+	// +optional
+	UDP *OutboundRuleAllocation `json:"udp,omitempty"`
+}
+
+// OutboundRuleAllocation contains the SNAT port allocation of an outbound rule.
+type OutboundRuleAllocation struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated to each node. Must be a multiple of 8.
+	AllocatedOutboundPorts int32 `json:"allocatedOutboundPorts"`
+	// IdleTimeoutMinutes is the idle timeout of the outbound connections in minutes.
+	// Defaults to 4.
+	// +optional
+	IdleTimeoutMinutes *int32 `json:"idleTimeoutMinutes,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -28,6 +88,36 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Resources overrides the resource requests and limits of the cloud-controller-manager. If the vertical pod
+	// autoscaler is enabled for the shoot, it still adapts the requests.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// CloudNodeManagerConfig contains configuration settings for the cloud-node-manager.
+type CloudNodeManagerConfig struct {
+	// Resources overrides the resource requests and limits of the cloud-node-manager, e.g. for large clusters. If the
+	// vertical pod autoscaler is enabled for the shoot, it still adapts the requests.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// CSISnapshotControllerConfig contains configuration settings for the csi-snapshot-controller.
+type CSISnapshotControllerConfig struct {
+	// Enabled controls if the csi-snapshot-controller is running, e.g. it can be disabled if another snapshot controller
+	// is deployed to the shoot cluster. Volume snapshots are not taken while it is disabled.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Replicas is the number of replicas of the csi-snapshot-controller while the control plane is not scaled down. It
+	// takes precedence over the replicas of the high availability configuration of the control plane.
+	// Defaults to the replicas of the high availability configuration.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources overrides the resource requests and limits of the csi-snapshot-controller. If the vertical pod
+	// autoscaler is enabled for the shoot, it still adapts the requests.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // Storage contains configuration for storage in the cluster.
@@ -42,4 +132,65 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool `json:"managedDefaultVolumeSnapshotClass,omitempty"`
+	// DefaultStorageClassVolumeBindingMode is the volume binding mode of the 'default' StorageClass. With
+	// WaitForFirstConsumer, volumes are provisioned in the zone of the node of their first pod. With Immediate, volumes
+	// are provisioned once they are claimed, in one of the zones of the worker pools.
+	// Defaults to WaitForFirstConsumer.
+	// +optional
+	DefaultStorageClassVolumeBindingMode *storagev1.VolumeBindingMode `json:"defaultStorageClassVolumeBindingMode,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the resources the extension manages for the control plane.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// PrivateLink contains information about the Private Link connectivity between the control plane and the nodes.
+	// +optional
+	PrivateLink *PrivateLinkStatus `json:"privateLink,omitempty"`
+	// GatewayLoadBalancer contains information about the Gateway Load Balancer which is chained to the outbound frontend
+	// IP configurations of the load balancer of the cloud-controller-manager.
+	// +optional
+	GatewayLoadBalancer *GatewayLoadBalancerStatus `json:"gatewayLoadBalancer,omitempty"`
+	// OutboundRules contains information about the outbound rules which the extension created on the load balancer of
+	// the cloud-controller-manager.
+	// +optional
+	OutboundRules *OutboundRulesStatus `json:"outboundRules,omitempty"`
+}
+
+// OutboundRulesStatus contains information about the outbound rules which the extension created on the load balancer
+// of the cloud-controller-manager. Outbound SNAT is only disabled for the load balancing rules of the
+// cloud-controller-manager once the outbound rules exist.
+type OutboundRulesStatus struct {
+	// Names are the names of the outbound rules.
+	Names []string `json:"names"`
+	// PublicIPAddressNames are the names of the public IP addresses of the frontend IP configurations of the outbound
+	// rules.
+	PublicIPAddressNames []string `json:"publicIPAddressNames"`
+}
+
+// GatewayLoadBalancerStatus contains information about the Gateway Load Balancer which is chained to the outbound
+// frontend IP configurations of the load balancer of the cloud-controller-manager.
+type GatewayLoadBalancerStatus struct {
+	// FrontendIPConfigurationID is the resource ID of the frontend IP configuration of the chained Gateway Load Balancer.
+	FrontendIPConfigurationID string `json:"frontendIPConfigurationID"`
+}
+
+// PrivateLinkStatus contains information about the Private Link connectivity between the control plane and the nodes.
+type PrivateLinkStatus struct {
+	// ServiceName is the name of the Private Link Service which the Private Endpoint connects to.
+	ServiceName string `json:"serviceName"`
+	// SubnetName is the name of the subnet which provides the NAT IP addresses of the Private Link Service.
+	SubnetName string `json:"subnetName"`
+	// EndpointID is the resource ID of the Private Endpoint. It is only set once the Private Link Service exists.
+	// +optional
+	EndpointID *string `json:"endpointID,omitempty"`
+	// EndpointIPAddresses are the private IP addresses of the Private Endpoint in the endpoint subnet.
+	// +optional
+	EndpointIPAddresses []string `json:"endpointIPAddresses,omitempty"`
+	// ConnectionState is the state of the connection of the Private Endpoint to the Private Link Service, e.g. Approved
+	// or Pending.
+	// +optional
+	ConnectionState *string `json:"connectionState,omitempty"`
 }
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig is the provider-specific configuration for DNS records.
+type DNSRecordConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// ResourceGroup is the name of the resource group which contains the DNS zone. If set, the DNS zone is only looked up
+	// in this resource group.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+	// SecretRef is a reference to a secret with the credentials used for the DNS operations. If not set, the credentials
+	// referenced by the DNSRecord are used. The secret must be in the namespace of the DNSRecord.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+	// ZoneType is the type of the DNS zone of the record, i.e. Public (default) or Private. It selects in which of a public
+	// and a private DNS zone with the same name the record is managed, e.g. for split-horizon DNS. Private DNS zones
+	// are looked up in the resource group, which is required for them.
+	// +optional
+	ZoneType *DNSZoneType `json:"zoneType,omitempty"`
+	// Tags are set as metadata on the recordset of the DNSRecord. Tags which are removed from the config are removed from
+	// the recordset, while the metadata managed by Gardener, i.e. with keys starting with "gardener", is kept.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// TagZone also adds the tags to the DNS zone of the record. The zone is shared with other records, hence its tags are
+	// only added or updated, but never removed. Defaults to false.
+	// +optional
+	TagZone *bool `json:"tagZone,omitempty"`
+}
+
+// DNSZoneType is the type of a DNS zone.
+type DNSZoneType string
+
+const (
+	// DNSZoneTypePublic is a public Azure DNS zone.
+	DNSZoneTypePublic DNSZoneType = "Public"
+	// DNSZoneTypePrivate is an Azure private DNS zone.
+	DNSZoneTypePrivate DNSZoneType = "Private"
+)
@@ -16,6 +16,16 @@ type InfrastructureConfig struct {
 	// ResourceGroup is azure resource group.
 	// +optional
 	ResourceGroup *ResourceGroup `json:"resourceGroup,omitempty"`
+	// ResourceGroupLocation is the location of the metadata of the shoot's resource group. It defaults to the region of
+	// the shoot, the resources in the resource group are always created in the region of the shoot.
+	// +optional
+	ResourceGroupLocation *string `json:"resourceGroupLocation,omitempty"`
+	// NetworkResourceGroup is an existing resource group in the region of the shoot in which the network resources of
+	// the shoot are created, i.e. the VNet with its subnets, the security group, the route table and the NAT gateways.
+	// The compute resources like the machines, their disks and the load balancers stay in the resource group of the
+	// shoot. The resource group is not deleted with the shoot, only the network resources created by the extension are.
+	// +optional
+	NetworkResourceGroup *ResourceGroup `json:"networkResourceGroup,omitempty"`
 	// Networks is the network configuration (VNet, subnets, etc.).
 	Networks NetworkConfig `json:"networks"`
 	// Identity contains configuration for the assigned managed identity.
@@ -45,8 +55,189 @@ type NetworkConfig struct {
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the worker subnet.
 	// +optional
 	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
+	// ServiceEndpointPolicies is a list of resource IDs of existing Azure service endpoint policies which should be associated with the worker subnet.
+	// +optional
+	ServiceEndpointPolicies []string `json:"serviceEndpointPolicies,omitempty"`
+	// PrivateEndpointNetworkPolicies controls whether network security groups and route tables apply to the private
+	// endpoints in the worker subnet. It is either Enabled or Disabled.
+	// +optional
+	PrivateEndpointNetworkPolicies *string `json:"privateEndpointNetworkPolicies,omitempty"`
+	// PrivateLinkServiceNetworkPolicies controls whether network policies apply to the private link services in the
+	// worker subnet. It is either Enabled or Disabled.
+	// +optional
+	PrivateLinkServiceNetworkPolicies *string `json:"privateLinkServiceNetworkPolicies,omitempty"`
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone `json:"zones,omitempty"`
+	// Pods is the configuration of a subnet for the pods which is delegated to an Azure service.
+	// +optional
+	Pods *PodSubnetConfig `json:"pods,omitempty"`
+	// FlowLogs is the configuration of the virtual network flow logs of the VNet of the shoot.
+	// +optional
+	FlowLogs *FlowLogsConfig `json:"flowLogs,omitempty"`
+	// AdditionalSubnets is a list of further subnets for the nodes, which worker pools can be assigned to.
+	// +optional
+	AdditionalSubnets []AdditionalSubnet `json:"additionalSubnets,omitempty"`
+	// PrivateLink is the configuration of the Private Link connectivity between the control plane and the nodes.
+	// +optional
+	PrivateLink *PrivateLinkConfig `json:"privateLink,omitempty"`
+	// InternalLoadBalancer is the configuration of a dedicated subnet for the internal load balancers of the shoot. If
+	// it is not set, the internal load balancers are placed in the worker subnet.
+	// +optional
+	InternalLoadBalancer *InternalLoadBalancerConfig `json:"internalLoadBalancer,omitempty"`
+	// GatewaySubnet is the configuration of the subnet named GatewaySubnet, which is required to deploy a VPN gateway or
+	// an ExpressRoute gateway into the VNet. The extension only manages the subnet, not the gateway.
+	// +optional
+	GatewaySubnet *GatewaySubnetConfig `json:"gatewaySubnet,omitempty"`
+	// RouteServerSubnet is the configuration of the subnet named RouteServerSubnet, which is required to deploy an Azure
+	// Route Server into the VNet, e.g. for dynamic routing with network virtual appliances. The extension only manages
+	// the subnet, not the Route Server.
+	// +optional
+	RouteServerSubnet *RouteServerSubnetConfig `json:"routeServerSubnet,omitempty"`
+	// PrivateDNSZone is the configuration of a private DNS zone which is linked to the VNet.
+	// +optional
+	PrivateDNSZone *PrivateDNSZoneConfig `json:"privateDNSZone,omitempty"`
+	// OutboundLoadBalancer is the configuration of a load balancer which provides the outbound connectivity of the
+	// machines of all worker pools.
+	// +optional
+	OutboundLoadBalancer *OutboundLoadBalancerConfig `json:"outboundLoadBalancer,omitempty"`
+	// NetworkWatcher is the configuration of the Network Watcher of the region of the shoot. If set, the extension
+	// ensures that a Network Watcher exists in the region.
+	// +optional
+	NetworkWatcher *NetworkWatcherConfig `json:"networkWatcher,omitempty"`
+	// DeletionProtection protects the VNet and the subnets of the shoot against deletions and recreations while the
+	// infrastructure is reconciled. They are only deleted once confirmed with an annotation of the shoot.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+	// SecurityGroup references an existing network security group which is associated with the subnets of the nodes
+	// instead of a security group created by the extension. The security group is neither created nor deleted by the
+	// extension.
+	// +optional
+	SecurityGroup *SecurityGroupReference `json:"securityGroup,omitempty"`
+}
+
+// SecurityGroupReference references an existing network security group.
+type SecurityGroupReference struct {
+	// Name is the name of the security group.
+	Name string `json:"name"`
+	// ResourceGroup is the name of the resource group of the security group.
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+// NetworkWatcherConfig contains the configuration of the Network Watcher of the region of the shoot.
+type NetworkWatcherConfig struct {
+	// Create allows the creation of a Network Watcher if there is none in the region of the shoot. Defaults to false.
+	// +optional
+	Create *bool `json:"create,omitempty"`
+}
+
+// OutboundLoadBalancerConfig contains the configuration of an outbound-only load balancer. Its backend pool is shared by
+// the machines of all worker pools, so that their SNAT ports are allocated from the same public IPs.
+type OutboundLoadBalancerConfig struct {
+	// PublicIPCount is the number of public IPs which are created as frontends of the load balancer. Defaults to 1.
+	// +optional
+	PublicIPCount *int32 `json:"publicIPCount,omitempty"`
+	// AllocatedOutboundPorts is the number of SNAT ports which are allocated to each machine. If it is not set, Azure
+	// allocates the ports based on the size of the backend pool.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+	// IdleTimeoutMinutes is the idle timeout of outbound connections in minutes. Defaults to 4 minutes.
+	// +optional
+	IdleTimeoutMinutes *int32 `json:"idleTimeoutMinutes,omitempty"`
+}
+
+// PrivateDNSZoneConfig contains the configuration of a private DNS zone which is linked to the VNet of the shoot.
+type PrivateDNSZoneConfig struct {
+	// Name is the name of the private DNS zone, e.g. internal.example.com.
+	Name string `json:"name"`
+	// RegistrationEnabled enables the automatic registration of the records of the machines in the VNet in the zone.
+	// +optional
+	RegistrationEnabled bool `json:"registrationEnabled,omitempty"`
+}
+
+// PrivateLinkConfig contains the configuration of the Private Link connectivity between the control plane and the nodes.
+type PrivateLinkConfig struct {
+	// CIDR is the CIDR range of the subnet which provides the NAT IP addresses of the Private Link Service.
+	CIDR string `json:"cidr"`
+	// EndpointSubnetID is the resource ID of an existing subnet the Private Endpoint is placed in, usually a subnet of
+	// the network of the seed.
+	EndpointSubnetID string `json:"endpointSubnetID"`
+}
+
+// InternalLoadBalancerConfig contains the configuration of the subnet in which the cloud-controller-manager creates the
+// frontend IPs of internal load balancers.
+type InternalLoadBalancerConfig struct {
+	// CIDR is the CIDR range of the subnet of the internal load balancers.
+	CIDR string `json:"cidr"`
+}
+
+// GatewaySubnetConfig contains the configuration of the subnet of the virtual network gateways.
+type GatewaySubnetConfig struct {
+	// CIDR is the CIDR range of the gateway subnet. Azure requires a prefix length of at most 29.
+	CIDR string `json:"cidr"`
+}
+
+// RouteServerSubnetConfig contains the configuration of the subnet of the Azure Route Server.
+type RouteServerSubnetConfig struct {
+	// CIDR is the CIDR range of the route server subnet. Azure requires a prefix length of at most 27.
+	CIDR string `json:"cidr"`
+}
+
+// AdditionalSubnet is a subnet for the nodes in addition to the worker subnet or the subnets of the zones.
+type AdditionalSubnet struct {
+	// Name is the name of the subnet which worker pools reference.
+	Name string `json:"name"`
+	// CIDR is the CIDR range used for the subnet.
+	CIDR string `json:"cidr"`
+	// Zone binds the subnet to a zone of the multiple subnet layout, so that it shares the NAT gateway of the zone.
+	// Only worker pools of this zone can be assigned to the subnet.
+	// +optional
+	Zone *int32 `json:"zone,omitempty"`
+	// AttachNatGateway controls whether the NAT gateway which the subnet shares is attached to it. A subnet without NAT
+	// gateway needs another egress path, e.g. the outbound rules of the load balancer. Defaults to true.
+	// +optional
+	AttachNatGateway *bool `json:"attachNatGateway,omitempty"`
+}
+
+// FlowLogsConfig contains the configuration of the virtual network flow logs of the VNet of the shoot.
+type FlowLogsConfig struct {
+	// StorageAccountID is the resource ID of the storage account the flow logs are written to.
+	// The storage account must be located in the region of the shoot.
+	StorageAccountID string `json:"storageAccountID"`
+	// RetentionDays is the number of days the flow logs are retained in the storage account.
+	// Flow logs are retained indefinitely if not set.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+	// TrafficAnalytics is the configuration of the traffic analytics of the flow logs.
+	// +optional
+	TrafficAnalytics *TrafficAnalyticsConfig `json:"trafficAnalytics,omitempty"`
+	// CreateNetworkWatcher allows the creation of a Network Watcher if there is none in the region of the shoot.
+	// Defaults to false.
+	// +optional
+	CreateNetworkWatcher *bool `json:"createNetworkWatcher,omitempty"`
+}
+
+// TrafficAnalyticsConfig contains the configuration of the traffic analytics of the flow logs.
+type TrafficAnalyticsConfig struct {
+	// WorkspaceResourceID is the resource ID of the Log Analytics workspace.
+	WorkspaceResourceID string `json:"workspaceResourceID"`
+	// WorkspaceID is the GUID of the Log Analytics workspace.
+	WorkspaceID string `json:"workspaceID"`
+	// WorkspaceRegion is the region of the Log Analytics workspace.
+	WorkspaceRegion string `json:"workspaceRegion"`
+	// IntervalMinutes is the interval in minutes in which the flow logs are processed. Must be 10 or 60.
+	// Defaults to 60.
+	// +optional
+	IntervalMinutes *int32 `json:"intervalMinutes,omitempty"`
+}
+
+// PodSubnetConfig contains the configuration for a subnet that provides the IP addresses of the pods, e.g. for the Azure CNI.
+type PodSubnetConfig struct {
+	// CIDR is the CIDR range used for the pod subnet.
+	CIDR string `json:"cidr"`
+	// Delegation is the name of the service the pod subnet is delegated to.
+	// Defaults to "Microsoft.ContainerService/managedClusters".
+	// +optional
+	Delegation *string `json:"delegation,omitempty"`
 }
 
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
@@ -62,6 +253,21 @@ type NatGatewayConfig struct {
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	// +optional
 	IPAddresses []PublicIPReference `json:"ipAddresses,omitempty"`
+	// PublicIPCount is the number of public IPs which are created and assigned to the NAT gateway if no IPAddresses are
+	// given. The count can be changed without recreating the NAT gateway. Defaults to 1.
+	// +optional
+	PublicIPCount *int32 `json:"publicIPCount,omitempty"`
+	// PublicIPDNSLabelTemplate is the template of the DNS name label of the public IPs which are created for the NAT
+	// gateway, e.g. for a stable reverse DNS entry of the egress IPs. The placeholders {shoot}, {zone} and {index} are
+	// replaced with the name of the shoot, the zone of the NAT gateway and the one-based index of the public IP. The
+	// labels have to be unique within the region.
+	// +optional
+	PublicIPDNSLabelTemplate *string `json:"publicIPDNSLabelTemplate,omitempty"`
+	// PublicIPPrefix is a reference to an existing public IP prefix from which the public IPs which are created for the
+	// NAT gateway are allocated, e.g. an IPv4 prefix which is shared by several shoots to keep the number of egress
+	// CIDRs small. The prefix is neither created nor deleted by the extension. It cannot be combined with IPAddresses.
+	// +optional
+	PublicIPPrefix *PublicIPPrefixReference `json:"publicIPPrefix,omitempty"`
 }
 
 // PublicIPReference contains information about a public ip.
@@ -83,6 +289,17 @@ type Zone struct {
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the zone's subnet.
 	// +optional
 	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
+	// ServiceEndpointPolicies is a list of resource IDs of existing Azure service endpoint policies which should be associated with the zone's subnet.
+	// +optional
+	ServiceEndpointPolicies []string `json:"serviceEndpointPolicies,omitempty"`
+	// PrivateEndpointNetworkPolicies controls whether network security groups and route tables apply to the private
+	// endpoints in the zone's subnet. It is either Enabled or Disabled.
+	// +optional
+	PrivateEndpointNetworkPolicies *string `json:"privateEndpointNetworkPolicies,omitempty"`
+	// PrivateLinkServiceNetworkPolicies controls whether network policies apply to the private link services in the
+	// zone's subnet. It is either Enabled or Disabled.
+	// +optional
+	PrivateLinkServiceNetworkPolicies *string `json:"privateLinkServiceNetworkPolicies,omitempty"`
 	// NatGateway contains the configuration for the NatGateway associated with this subnet.
 	// +optional
 	NatGateway *ZonedNatGatewayConfig `json:"natGateway,omitempty"`
@@ -98,6 +315,21 @@ type ZonedNatGatewayConfig struct {
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	// +optional
 	IPAddresses []ZonedPublicIPReference `json:"ipAddresses,omitempty"`
+	// PublicIPCount is the number of public IPs which are created and assigned to the NAT gateway if no IPAddresses are
+	// given. The count can be changed without recreating the NAT gateway. Defaults to 1.
+	// +optional
+	PublicIPCount *int32 `json:"publicIPCount,omitempty"`
+	// PublicIPDNSLabelTemplate is the template of the DNS name label of the public IPs which are created for the NAT
+	// gateway, e.g. for a stable reverse DNS entry of the egress IPs. The placeholders {shoot}, {zone} and {index} are
+	// replaced with the name of the shoot, the zone of the NAT gateway and the one-based index of the public IP. The
+	// labels have to be unique within the region.
+	// +optional
+	PublicIPDNSLabelTemplate *string `json:"publicIPDNSLabelTemplate,omitempty"`
+	// PublicIPPrefix is a reference to an existing public IP prefix from which the public IPs which are created for the
+	// NAT gateway are allocated, e.g. an IPv4 prefix which is shared by several shoots to keep the number of egress
+	// CIDRs small. The prefix is neither created nor deleted by the extension. It cannot be combined with IPAddresses.
+	// +optional
+	PublicIPPrefix *PublicIPPrefixReference `json:"publicIPPrefix,omitempty"`
 }
 
 // ZonedPublicIPReference contains information about a public ip.
@@ -108,6 +340,14 @@ type ZonedPublicIPReference struct {
 	ResourceGroup string `json:"resourceGroup"`
 }
 
+// PublicIPPrefixReference is a reference to an existing public IP prefix.
+type PublicIPPrefixReference struct {
+	// Name is the name of the public IP prefix.
+	Name string `json:"name"`
+	// ResourceGroup is the name of the resource group of the public IP prefix.
+	ResourceGroup string `json:"resourceGroup"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -142,6 +382,45 @@ type NetworkStatus struct {
 
 	// OutboundAccessType is the type of outbound access configured for the shoot. It indicates how egress traffic flows outside the shoot.
 	OutboundAccessType OutboundAccessType `json:"outboundAccessType"`
+
+	// PrivateDNSZone is the status of the private DNS zone linked to the VNet.
+	// +optional
+	PrivateDNSZone *PrivateDNSZoneStatus `json:"privateDNSZone,omitempty"`
+	// OutboundLoadBalancer is the status of the load balancer which provides the outbound connectivity of the machines.
+	// +optional
+	OutboundLoadBalancer *OutboundLoadBalancerStatus `json:"outboundLoadBalancer,omitempty"`
+	// NetworkWatcher is the status of the Network Watcher of the region of the shoot.
+	// +optional
+	NetworkWatcher *NetworkWatcherStatus `json:"networkWatcher,omitempty"`
+	// ResourceGroup is the resource group of the network resources of the shoot, i.e. of the security group and the
+	// route table, if they are not located in the resource group of the shoot.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+}
+
+// NetworkWatcherStatus is the status of the Network Watcher of the region of the shoot.
+type NetworkWatcherStatus struct {
+	// ID is the resource ID of the Network Watcher.
+	ID string `json:"id"`
+	// Managed is true if the Network Watcher was created by the extension. It is not deleted together with the
+	// infrastructure either, since it is shared with the other resources of the subscription.
+	Managed bool `json:"managed"`
+}
+
+// OutboundLoadBalancerStatus is the status of the outbound load balancer shared by the worker pools.
+type OutboundLoadBalancerStatus struct {
+	// ID is the resource ID of the load balancer.
+	ID string `json:"id"`
+	// BackendAddressPoolID is the resource ID of the backend pool which the network interfaces of the machines are added to.
+	BackendAddressPoolID string `json:"backendAddressPoolID"`
+}
+
+// PrivateDNSZoneStatus is the status of the private DNS zone linked to the VNet.
+type PrivateDNSZoneStatus struct {
+	// Name is the name of the private DNS zone.
+	Name string `json:"name"`
+	// ID is the resource ID of the private DNS zone.
+	ID string `json:"id"`
 }
 
 // Purpose is a purpose of a subnet.
@@ -152,6 +431,16 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposePods is a Purpose for the delegated subnet of the pods.
+	PurposePods Purpose = "pods"
+	// PurposePrivateLink is a Purpose for the subnet of the Private Link Service.
+	PurposePrivateLink Purpose = "privateLink"
+	// PurposeInternalLoadBalancer is a Purpose for the subnet of the internal load balancers.
+	PurposeInternalLoadBalancer Purpose = "internalLoadBalancer"
+	// PurposeGateway is a Purpose for the subnet of the virtual network gateways.
+	PurposeGateway Purpose = "gateway"
+	// PurposeRouteServer is a Purpose for the subnet of the Azure Route Server.
+	PurposeRouteServer Purpose = "routeServer"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -191,6 +480,12 @@ type Subnet struct {
 	// NatGatewayID is the ID of the NATGateway associated with the subnet.
 	// +optional
 	NatGatewayID *string `json:"natGatewayId,omitempty"`
+	// NatGatewayPublicIPCount is the number of public IPs assigned to the NATGateway associated with the subnet.
+	// +optional
+	NatGatewayPublicIPCount *int32 `json:"natGatewayPublicIPCount,omitempty"`
+	// ID is the ID of the subnet. It is only set for the subnet with purpose pods.
+	// +optional
+	ID *string `json:"id,omitempty"`
 }
 
 // RouteTable is the azure route table
@@ -207,6 +502,10 @@ type SecurityGroup struct {
 	Purpose Purpose `json:"purpose"`
 	// Name is the name of the security group
 	Name string `json:"name"`
+	// ResourceGroup is the resource group of the security group if it is an existing security group which is referenced
+	// by the InfrastructureConfig.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
 }
 
 // VNet contains information about the VNet and some related resources.
@@ -220,11 +519,39 @@ type VNet struct {
 	// CIDR is the VNet CIDR
 	// +optional
 	CIDR *string `json:"cidr,omitempty"`
+	// AdditionalCIDRs are further address prefixes of the VNet besides its CIDR. Subnets cannot span multiple address
+	// prefixes, hence every subnet has to be within the CIDR or within one of the additional CIDRs.
+	// +optional
+	AdditionalCIDRs []string `json:"additionalCIDRs,omitempty"`
 	// DDosProtectionPlanID is the id of a ddos protection plan assigned to the vnet.
 	// +optional
 	DDosProtectionPlanID *string `json:"ddosProtectionPlanID,omitempty"`
+	// Encryption configures the encryption of the traffic between the virtual machines in the vnet.
+	// +optional
+	Encryption *VNetEncryption `json:"encryption,omitempty"`
 }
 
+// VNetEncryption configures the encryption of the traffic between the virtual machines in a vnet.
+type VNetEncryption struct {
+	// Enabled enables the encryption.
+	Enabled bool `json:"enabled"`
+	// Enforcement configures whether the vnet allows virtual machines which do not support encryption. Defaults to
+	// DropUnencrypted.
+	// +optional
+	Enforcement *VNetEncryptionEnforcement `json:"enforcement,omitempty"`
+}
+
+// VNetEncryptionEnforcement is the handling of virtual machines in an encrypted vnet which do not support encryption.
+type VNetEncryptionEnforcement string
+
+const (
+	// VNetEncryptionEnforcementAllowUnencrypted allows the unencrypted traffic of virtual machines which do not support
+	// encryption.
+	VNetEncryptionEnforcementAllowUnencrypted VNetEncryptionEnforcement = "AllowUnencrypted"
+	// VNetEncryptionEnforcementDropUnencrypted drops the traffic of virtual machines which do not support encryption.
+	VNetEncryptionEnforcementDropUnencrypted VNetEncryptionEnforcement = "DropUnencrypted"
+)
+
 // VNetStatus contains the VNet name.
 type VNetStatus struct {
 	// Name is the VNet name.
@@ -243,6 +570,36 @@ type IdentityConfig struct {
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	// +optional
 	ACRAccess *bool `json:"acrAccess,omitempty"`
+	// RoleAssignments are the roles which are assigned to the identity.
+	// +optional
+	RoleAssignments []IdentityRoleAssignment `json:"roleAssignments,omitempty"`
+	// FederatedCredentials are the federated identity credentials of the identity which are created for service accounts
+	// of the shoot, so that pods using these service accounts can authenticate as the identity.
+	// +optional
+	FederatedCredentials []IdentityFederatedCredential `json:"federatedCredentials,omitempty"`
+}
+
+// IdentityRoleAssignment is a role which is assigned to the identity of the worker nodes.
+type IdentityRoleAssignment struct {
+	// RoleDefinitionID is the resource ID of the role definition, e.g.
+	// /subscriptions/<subscription-id>/providers/Microsoft.Authorization/roleDefinitions/<role-id>.
+	RoleDefinitionID string `json:"roleDefinitionID"`
+	// Scope is the resource ID of the scope at which the role is assigned. It must be the resource group of the shoot or
+	// a resource in it. Defaults to the resource group of the shoot.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+}
+
+// IdentityFederatedCredential is a federated identity credential of the identity which trusts the tokens of a service
+// account of the shoot, which are issued by the service account issuer of the shoot.
+type IdentityFederatedCredential struct {
+	// Name is the name of the federated identity credential.
+	Name string `json:"name"`
+	// ServiceAccount is the service account of the shoot in the format <namespace>/<name>.
+	ServiceAccount string `json:"serviceAccount"`
+	// Audiences are the audiences of the service account tokens. Defaults to api://AzureADTokenExchange.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
 }
 
 // IdentityStatus contains the status information of the created managed identity.
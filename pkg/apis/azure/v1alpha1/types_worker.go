@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
@@ -31,6 +32,243 @@ type WorkerConfig struct {
 	// DataVolumes contains configuration for the additional disks attached to VMs.
 	// +optional
 	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+
+	// SinglePlacementGroup restricts the VMSS Flex of the worker pool to a single placement group, which supports at most
+	// 100 machines. It only applies to non-zonal clusters. Defaults to false.
+	// +optional
+	SinglePlacementGroup *bool `json:"singlePlacementGroup,omitempty"`
+
+	// FaultDomainCount is the number of fault domains of the VMSS Flex of the worker pool, which spreads the machines
+	// evenly across them. It only applies to non-zonal clusters and must not exceed the fault domain count of the region
+	// in the CloudProfile. Defaults to the fault domain count of the region.
+	// +optional
+	FaultDomainCount *int32 `json:"faultDomainCount,omitempty"`
+
+	// ScaleInPolicy configures which machines the VMSS Flex of the worker pool removes first when it is scaled in. It only
+	// applies to non-zonal clusters. The machine-controller-manager deletes the virtual machines individually and never
+	// scales in the VMSS Flex, hence the policy only applies if its capacity is reduced outside of the
+	// machine-controller-manager.
+	// +optional
+	ScaleInPolicy *ScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
+	// SubnetName is the name of an additional subnet of the InfrastructureConfig the machines of the worker pool are
+	// placed in. If not set, the machines are placed in the worker subnet or the subnet of their zone.
+	// +optional
+	SubnetName *string `json:"subnetName,omitempty"`
+
+	// EnableIPForwarding enables IP forwarding on the network interfaces of the machines of the worker pool, so that they
+	// can send and receive packets of other IP addresses, e.g. if they act as routers. Defaults to false.
+	// +optional
+	EnableIPForwarding *bool `json:"enableIPForwarding,omitempty"`
+
+	// PrePullImages is a list of container images which are pulled on a best-effort basis when a machine boots, so that
+	// pods using them start faster.
+	// +optional
+	PrePullImages []string `json:"prePullImages,omitempty"`
+
+	// KubeletConfig overrides settings of the kubelet configuration of the machines of the worker pool, e.g. to adapt
+	// the maximum number of pods or the reserved resources to the machine type.
+	// +optional
+	KubeletConfig *KubeletConfig `json:"kubeletConfig,omitempty"`
+
+	// Time configures the time synchronization and the timezone of the machines of the worker pool, e.g. to use the
+	// NTP servers of a corporate network. The default settings of the machine image are kept for the fields which are
+	// not set.
+	// +optional
+	Time *TimeConfig `json:"time,omitempty"`
+
+	// CompressUserData enables the gzip compression of the user data of the machines, which allows user data beyond the
+	// custom data size limit of Azure. It must only be enabled if the machine image decompresses the user data at boot,
+	// e.g. with cloud-init.
+	// +optional
+	CompressUserData *bool `json:"compressUserData,omitempty"`
+
+	// LicenseType applies Azure Hybrid Benefit to the machines of the worker pool with the given license type, i.e.
+	// Windows_Server, RHEL_BYOS or SLES_BYOS, if licenses of the operating system of the machine image are brought in.
+	// The license type must match the operating system of the machine image in the CloudProfile.
+	// +optional
+	LicenseType *LicenseType `json:"licenseType,omitempty"`
+
+	// AutomaticRepairs configures the automatic repairs of the VMSS Flex of the worker pool, which replaces machines
+	// that are reported as unhealthy by the application health extension. It only applies to non-zonal clusters.
+	// +optional
+	AutomaticRepairs *AutomaticRepairs `json:"automaticRepairs,omitempty"`
+
+	// HealthProbe configures the application health extension which is installed on the machines of the worker pool.
+	// It can only be set if automatic repairs are enabled.
+	// +optional
+	HealthProbe *HealthProbe `json:"healthProbe,omitempty"`
+
+	// AzureMonitorAgent installs the Azure Monitor Agent on the machines of the worker pool, which sends the data defined
+	// by the referenced data collection rule. It only applies to non-zonal clusters.
+	// +optional
+	AzureMonitorAgent *AzureMonitorAgent `json:"azureMonitorAgent,omitempty"`
+	// UserDataEncryption encrypts the user data of the machines with a key of a Key Vault. The machines decrypt it at
+	// boot with their managed identity.
+	// +optional
+	UserDataEncryption *UserDataEncryption `json:"userDataEncryption,omitempty"`
+	// MachineImageVersion pins the version of the machine image of the worker pool. It overrides the version of the
+	// machine image in the Shoot, e.g. to keep the worker pool on a validated version while a newer version is rolled
+	// out to the other worker pools. The version must be offered by the CloudProfile and must not be expired.
+	// +optional
+	MachineImageVersion *string `json:"machineImageVersion,omitempty"`
+	// RollingUpdate overrides the number of machines of the worker pool which are replaced concurrently during a rolling
+	// update, e.g. to speed up the rollout of large worker pools. The values of the worker pool in the Shoot are used for
+	// the fields which are not set.
+	// +optional
+	RollingUpdate *RollingUpdate `json:"rollingUpdate,omitempty"`
+	// FallbackZones are additional zones into which the worker pool expands when Azure cannot allocate machines in the
+	// zones of the worker pool, e.g. during a capacity shortage. They are taken into use one at a time in their order
+	// and stay in use until they are removed from the list.
+	// +optional
+	FallbackZones []string `json:"fallbackZones,omitempty"`
+
+	// CapacityPolicy configures how a drift of the capacity of the VMSS Flex of the worker pool from the number of
+	// machines which the machine-controller-manager expects is handled, e.g. after Azure adjusted the capacity during a
+	// failed scale-out. The capacity itself is never changed by the extension. It only applies to non-zonal clusters.
+	// Defaults to Ignore.
+	// +optional
+	CapacityPolicy *CapacityPolicy `json:"capacityPolicy,omitempty"`
+}
+
+// KubeletConfig contains the settings of the kubelet configuration which are overridden for the machines of a worker
+// pool. The settings take precedence over the kubelet configuration of the worker pool in the Shoot.
+type KubeletConfig struct {
+	// MaxPods is the maximum number of pods which can run on a machine.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+	// EvictionHard maps eviction signals, e.g. memory.available, to the quantities or percentages at which pods are
+	// evicted immediately.
+	// +optional
+	EvictionHard map[string]string `json:"evictionHard,omitempty"`
+	// SystemReserved maps resource names, i.e. cpu, memory, ephemeral-storage and pid, to the quantities which are
+	// reserved for the system daemons.
+	// +optional
+	SystemReserved map[string]string `json:"systemReserved,omitempty"`
+	// KubeReserved maps resource names, i.e. cpu, memory, ephemeral-storage and pid, to the quantities which are
+	// reserved for the Kubernetes components.
+	// +optional
+	KubeReserved map[string]string `json:"kubeReserved,omitempty"`
+}
+
+// TimeConfig contains the time settings of the machines of a worker pool.
+type TimeConfig struct {
+	// NTPServers are the host names or IP addresses of the NTP servers the machines synchronize their clocks with. They
+	// replace the NTP servers of the machine image.
+	// +optional
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Timezone is the name of the timezone of the machines in the IANA time zone database, e.g. Europe/Berlin.
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// RollingUpdate contains the configuration of the rolling update of the machines of a worker pool.
+type RollingUpdate struct {
+	// MaxSurge is the maximum number or percentage of machines which are created on top of the desired number of machines
+	// during a rolling update.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number or percentage of machines which can be unavailable during a rolling update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// UserDataEncryption contains the configuration of the encryption of the user data.
+type UserDataEncryption struct {
+	// KeyID is the identifier of the RSA key of a Key Vault with which the user data is encrypted, e.g.
+	// https://my-vault.vault.azure.net/keys/my-key. If it contains no version, the current version of the key is used.
+	KeyID string `json:"keyID"`
+}
+
+// AzureMonitorAgent contains the configuration of the Azure Monitor Agent VM extension.
+type AzureMonitorAgent struct {
+	// DataCollectionRuleID is the resource ID of the data collection rule which the virtual machines of the worker pool
+	// are associated with.
+	DataCollectionRuleID string `json:"dataCollectionRuleID"`
+}
+
+// AutomaticRepairs contains the configuration of the automatic repairs of a VMSS Flex.
+type AutomaticRepairs struct {
+	// Enabled enables the automatic repairs.
+	Enabled bool `json:"enabled"`
+	// GracePeriodMinutes is the time in minutes for which automatic repairs are suspended after a machine was created
+	// or its state changed. Azure defaults it to 10 minutes.
+	// +optional
+	GracePeriodMinutes *int32 `json:"gracePeriodMinutes,omitempty"`
+}
+
+// CapacityPolicy is the policy with which the capacity of a VMSS Flex is handled.
+type CapacityPolicy string
+
+const (
+	// CapacityPolicyIgnore leaves the capacity of the VMSS Flex to Azure.
+	CapacityPolicyIgnore CapacityPolicy = "Ignore"
+	// CapacityPolicyReport reports a drift of the capacity of the VMSS Flex from the number of machines which the
+	// machine-controller-manager expects in the CapacityDrift condition of the Worker.
+	CapacityPolicyReport CapacityPolicy = "Report"
+)
+
+// LicenseType is the license type with which Azure Hybrid Benefit is applied to a virtual machine.
+type LicenseType string
+
+const (
+	// LicenseTypeWindowsServer applies Azure Hybrid Benefit to virtual machines with a Windows Server license.
+	LicenseTypeWindowsServer LicenseType = "Windows_Server"
+	// LicenseTypeRHELBYOS applies Azure Hybrid Benefit to virtual machines with a Red Hat Enterprise Linux subscription.
+	LicenseTypeRHELBYOS LicenseType = "RHEL_BYOS"
+	// LicenseTypeSLESBYOS applies Azure Hybrid Benefit to virtual machines with a SUSE Linux Enterprise Server
+	// subscription.
+	LicenseTypeSLESBYOS LicenseType = "SLES_BYOS"
+)
+
+// ScaleInPolicyRule is the rule with which a VMSS Flex chooses the machines which are removed when it is scaled in.
+type ScaleInPolicyRule string
+
+const (
+	// ScaleInPolicyRuleDefault balances the machines across the fault domains and removes the newest machines first.
+	ScaleInPolicyRuleDefault ScaleInPolicyRule = "Default"
+	// ScaleInPolicyRuleNewestVM removes the newest machines first.
+	ScaleInPolicyRuleNewestVM ScaleInPolicyRule = "NewestVM"
+	// ScaleInPolicyRuleOldestVM removes the oldest machines first.
+	ScaleInPolicyRuleOldestVM ScaleInPolicyRule = "OldestVM"
+)
+
+// ScaleInPolicy contains the configuration of the scale-in policy of a VMSS Flex.
+type ScaleInPolicy struct {
+	// Rule is the rule with which the machines to remove are chosen. Defaults to Default.
+	// +optional
+	Rule *ScaleInPolicyRule `json:"rule,omitempty"`
+	// ForceDeletion deletes the removed machines forcefully, i.e. without waiting for their graceful shutdown. Defaults
+	// to false.
+	// +optional
+	ForceDeletion *bool `json:"forceDeletion,omitempty"`
+}
+
+// HealthProbeProtocol is the protocol of a health probe.
+type HealthProbeProtocol string
+
+const (
+	// HealthProbeProtocolHTTP probes the health of a machine with HTTP requests.
+	HealthProbeProtocolHTTP HealthProbeProtocol = "HTTP"
+	// HealthProbeProtocolHTTPS probes the health of a machine with HTTPS requests.
+	HealthProbeProtocolHTTPS HealthProbeProtocol = "HTTPS"
+	// HealthProbeProtocolTCP probes the health of a machine by opening a TCP connection.
+	HealthProbeProtocolTCP HealthProbeProtocol = "TCP"
+)
+
+// HealthProbe contains the configuration of the probe which the application health extension uses to determine the
+// health of a machine.
+type HealthProbe struct {
+	// Protocol is the protocol of the probe.
+	Protocol HealthProbeProtocol `json:"protocol"`
+	// Port is the port of the machine which is probed.
+	Port int32 `json:"port"`
+	// Path is the path of the HTTP or HTTPS request. It must not be set for the TCP protocol.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// IntervalSeconds is the interval between two probes in seconds. Azure defaults it to 5 seconds.
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
 }
 
 // +genclient
@@ -51,6 +289,35 @@ type WorkerStatus struct {
 	// VmoDependencies is a list of external VirtualMachineScaleSet Orchestration Mode VM (VMO) dependencies.
 	// +optional
 	VmoDependencies []VmoDependency `json:"vmoDependencies,omitempty"`
+
+	// FallbackZones is a list of the fallback zones which the worker pools use in addition to their zones.
+	// +optional
+	FallbackZones []WorkerPoolFallbackZones `json:"fallbackZones,omitempty"`
+
+	// BootDiagnosticsStorageAccounts are the storage accounts in which a lifecycle rule deletes the old boot diagnostics
+	// blobs of the worker pools.
+	// +optional
+	BootDiagnosticsStorageAccounts []StorageAccountReference `json:"bootDiagnosticsStorageAccounts,omitempty"`
+}
+
+// StorageAccountReference is a reference to a storage account.
+type StorageAccountReference struct {
+	// Name is the name of the storage account.
+	Name string `json:"name"`
+	// ResourceGroup is the resource group of the storage account.
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+// WorkerPoolFallbackZones are the fallback zones which a worker pool uses because Azure could not allocate machines in
+// its zones.
+type WorkerPoolFallbackZones struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Zones are the fallback zones which are used by the worker pool.
+	Zones []string `json:"zones"`
+	// Demand is the number of machines which the fallback zones get because the zones of the worker pool miss them.
+	// +optional
+	Demand int32 `json:"demand,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -96,6 +363,10 @@ type VmoDependency struct {
 	ID string `json:"id"`
 	// Name is the name of the VMO resource on Azure.
 	Name string `json:"name"`
+	// TagKeys are the keys of the tags which the extension manages on the VMO and its virtual machines. Tags with other
+	// keys were added by other means and are kept.
+	// +optional
+	TagKeys []string `json:"tagKeys,omitempty"`
 }
 
 // DiagnosticsProfile specifies boot diagnostic options.
@@ -105,6 +376,17 @@ type DiagnosticsProfile struct {
 	// StorageURI is the URI of the storage account to use for storing console output and screenshot.
 	// If not specified azure managed storage will be used.
 	StorageURI *string `json:"storageURI,omitempty"`
+	// Retention configures the deletion of old boot diagnostics blobs from the storage account of StorageURI.
+	// +optional
+	Retention *BootDiagnosticsRetention `json:"retention,omitempty"`
+}
+
+// BootDiagnosticsRetention configures the deletion of old boot diagnostics blobs from a storage account.
+type BootDiagnosticsRetention struct {
+	// ResourceGroup is the resource group of the storage account.
+	ResourceGroup string `json:"resourceGroup"`
+	// Days is the number of days after their last modification after which the boot diagnostics blobs are deleted.
+	Days int32 `json:"days"`
 }
 
 // DataVolume contains configuration for data volumes attached to VMs.
@@ -114,6 +396,14 @@ type DataVolume struct {
 	// ImageRef defines the dataVolume source image.
 	// +optional
 	ImageRef *Image `json:"imageRef,omitempty"`
+	// ProvisionedIops is the number of IOPS provisioned for the data disk. It can only be set for Ultra disks and is
+	// adjusted on the existing disks without replacing the machines.
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+	// ProvisionedThroughput is the throughput in MBps provisioned for the data disk. It can only be set for Ultra disks
+	// and is adjusted on the existing disks without replacing the machines.
+	// +optional
+	ProvisionedThroughput *int64 `json:"provisionedThroughput,omitempty"`
 }
 
 // Volume contains configuration for the root disk of a VM.
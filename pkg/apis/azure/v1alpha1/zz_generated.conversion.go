@@ -14,8 +14,12 @@ import (
 
 	azure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -25,6 +29,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdditionalSubnet)(nil), (*azure.AdditionalSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(a.(*AdditionalSubnet), b.(*azure.AdditionalSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AdditionalSubnet)(nil), (*AdditionalSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(a.(*azure.AdditionalSubnet), b.(*AdditionalSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AutomaticRepairs)(nil), (*azure.AutomaticRepairs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutomaticRepairs_To_azure_AutomaticRepairs(a.(*AutomaticRepairs), b.(*azure.AutomaticRepairs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AutomaticRepairs)(nil), (*AutomaticRepairs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AutomaticRepairs_To_v1alpha1_AutomaticRepairs(a.(*azure.AutomaticRepairs), b.(*AutomaticRepairs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzureMonitorAgent)(nil), (*azure.AzureMonitorAgent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AzureMonitorAgent_To_azure_AzureMonitorAgent(a.(*AzureMonitorAgent), b.(*azure.AzureMonitorAgent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AzureMonitorAgent)(nil), (*AzureMonitorAgent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AzureMonitorAgent_To_v1alpha1_AzureMonitorAgent(a.(*azure.AzureMonitorAgent), b.(*AzureMonitorAgent), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureResource)(nil), (*azure.AzureResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AzureResource_To_azure_AzureResource(a.(*AzureResource), b.(*azure.AzureResource), scope)
 	}); err != nil {
@@ -45,6 +79,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BootDiagnosticsRetention)(nil), (*azure.BootDiagnosticsRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BootDiagnosticsRetention_To_azure_BootDiagnosticsRetention(a.(*BootDiagnosticsRetention), b.(*azure.BootDiagnosticsRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BootDiagnosticsRetention)(nil), (*BootDiagnosticsRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BootDiagnosticsRetention_To_v1alpha1_BootDiagnosticsRetention(a.(*azure.BootDiagnosticsRetention), b.(*BootDiagnosticsRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSISnapshotControllerConfig)(nil), (*azure.CSISnapshotControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSISnapshotControllerConfig_To_azure_CSISnapshotControllerConfig(a.(*CSISnapshotControllerConfig), b.(*azure.CSISnapshotControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CSISnapshotControllerConfig)(nil), (*CSISnapshotControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CSISnapshotControllerConfig_To_v1alpha1_CSISnapshotControllerConfig(a.(*azure.CSISnapshotControllerConfig), b.(*CSISnapshotControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChangeFeedConfig)(nil), (*azure.ChangeFeedConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChangeFeedConfig_To_azure_ChangeFeedConfig(a.(*ChangeFeedConfig), b.(*azure.ChangeFeedConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ChangeFeedConfig)(nil), (*ChangeFeedConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ChangeFeedConfig_To_v1alpha1_ChangeFeedConfig(a.(*azure.ChangeFeedConfig), b.(*ChangeFeedConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudConfiguration)(nil), (*azure.CloudConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(a.(*CloudConfiguration), b.(*azure.CloudConfiguration), scope)
 	}); err != nil {
@@ -65,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudNodeManagerConfig)(nil), (*azure.CloudNodeManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNodeManagerConfig_To_azure_CloudNodeManagerConfig(a.(*CloudNodeManagerConfig), b.(*azure.CloudNodeManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CloudNodeManagerConfig)(nil), (*CloudNodeManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CloudNodeManagerConfig_To_v1alpha1_CloudNodeManagerConfig(a.(*azure.CloudNodeManagerConfig), b.(*CloudNodeManagerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileConfig)(nil), (*azure.CloudProfileConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(a.(*CloudProfileConfig), b.(*azure.CloudProfileConfig), scope)
 	}); err != nil {
@@ -85,6 +159,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneStatus)(nil), (*azure.ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneStatus_To_azure_ControlPlaneStatus(a.(*ControlPlaneStatus), b.(*azure.ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ControlPlaneStatus)(nil), (*ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(a.(*azure.ControlPlaneStatus), b.(*ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*azure.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(a.(*DNSRecordConfig), b.(*azure.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*azure.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*azure.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataVolume_To_azure_DataVolume(a.(*DataVolume), b.(*azure.DataVolume), scope)
 	}); err != nil {
@@ -95,6 +189,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DiagnosticSettingsConfig)(nil), (*azure.DiagnosticSettingsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiagnosticSettingsConfig_To_azure_DiagnosticSettingsConfig(a.(*DiagnosticSettingsConfig), b.(*azure.DiagnosticSettingsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DiagnosticSettingsConfig)(nil), (*DiagnosticSettingsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DiagnosticSettingsConfig_To_v1alpha1_DiagnosticSettingsConfig(a.(*azure.DiagnosticSettingsConfig), b.(*DiagnosticSettingsConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DiagnosticsProfile)(nil), (*azure.DiagnosticsProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(a.(*DiagnosticsProfile), b.(*azure.DiagnosticsProfile), scope)
 	}); err != nil {
@@ -115,6 +219,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FlowLogsConfig)(nil), (*azure.FlowLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(a.(*FlowLogsConfig), b.(*azure.FlowLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.FlowLogsConfig)(nil), (*FlowLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(a.(*azure.FlowLogsConfig), b.(*FlowLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayLoadBalancer)(nil), (*azure.GatewayLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GatewayLoadBalancer_To_azure_GatewayLoadBalancer(a.(*GatewayLoadBalancer), b.(*azure.GatewayLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.GatewayLoadBalancer)(nil), (*GatewayLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_GatewayLoadBalancer_To_v1alpha1_GatewayLoadBalancer(a.(*azure.GatewayLoadBalancer), b.(*GatewayLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayLoadBalancerStatus)(nil), (*azure.GatewayLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GatewayLoadBalancerStatus_To_azure_GatewayLoadBalancerStatus(a.(*GatewayLoadBalancerStatus), b.(*azure.GatewayLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.GatewayLoadBalancerStatus)(nil), (*GatewayLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_GatewayLoadBalancerStatus_To_v1alpha1_GatewayLoadBalancerStatus(a.(*azure.GatewayLoadBalancerStatus), b.(*GatewayLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewaySubnetConfig)(nil), (*azure.GatewaySubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GatewaySubnetConfig_To_azure_GatewaySubnetConfig(a.(*GatewaySubnetConfig), b.(*azure.GatewaySubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.GatewaySubnetConfig)(nil), (*GatewaySubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_GatewaySubnetConfig_To_v1alpha1_GatewaySubnetConfig(a.(*azure.GatewaySubnetConfig), b.(*GatewaySubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeoReplicationConfig)(nil), (*azure.GeoReplicationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeoReplicationConfig_To_azure_GeoReplicationConfig(a.(*GeoReplicationConfig), b.(*azure.GeoReplicationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.GeoReplicationConfig)(nil), (*GeoReplicationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_GeoReplicationConfig_To_v1alpha1_GeoReplicationConfig(a.(*azure.GeoReplicationConfig), b.(*GeoReplicationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HealthProbe)(nil), (*azure.HealthProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthProbe_To_azure_HealthProbe(a.(*HealthProbe), b.(*azure.HealthProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.HealthProbe)(nil), (*HealthProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_HealthProbe_To_v1alpha1_HealthProbe(a.(*azure.HealthProbe), b.(*HealthProbe), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IdentityConfig)(nil), (*azure.IdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(a.(*IdentityConfig), b.(*azure.IdentityConfig), scope)
 	}); err != nil {
@@ -125,6 +289,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IdentityFederatedCredential)(nil), (*azure.IdentityFederatedCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityFederatedCredential_To_azure_IdentityFederatedCredential(a.(*IdentityFederatedCredential), b.(*azure.IdentityFederatedCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.IdentityFederatedCredential)(nil), (*IdentityFederatedCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_IdentityFederatedCredential_To_v1alpha1_IdentityFederatedCredential(a.(*azure.IdentityFederatedCredential), b.(*IdentityFederatedCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IdentityRoleAssignment)(nil), (*azure.IdentityRoleAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(a.(*IdentityRoleAssignment), b.(*azure.IdentityRoleAssignment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.IdentityRoleAssignment)(nil), (*IdentityRoleAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(a.(*azure.IdentityRoleAssignment), b.(*IdentityRoleAssignment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IdentityStatus)(nil), (*azure.IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(a.(*IdentityStatus), b.(*azure.IdentityStatus), scope)
 	}); err != nil {
@@ -185,6 +369,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InternalLoadBalancerConfig)(nil), (*azure.InternalLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InternalLoadBalancerConfig_To_azure_InternalLoadBalancerConfig(a.(*InternalLoadBalancerConfig), b.(*azure.InternalLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.InternalLoadBalancerConfig)(nil), (*InternalLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(a.(*azure.InternalLoadBalancerConfig), b.(*InternalLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*azure.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(a.(*KubeletConfig), b.(*azure.KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.KubeletConfig)(nil), (*KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(a.(*azure.KubeletConfig), b.(*KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*azure.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*azure.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*azure.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*azure.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_azure_MachineImage(a.(*MachineImage), b.(*azure.MachineImage), scope)
 	}); err != nil {
@@ -255,767 +469,1901 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PublicIPReference)(nil), (*azure.PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(a.(*PublicIPReference), b.(*azure.PublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*NetworkWatcherConfig)(nil), (*azure.NetworkWatcherConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkWatcherConfig_To_azure_NetworkWatcherConfig(a.(*NetworkWatcherConfig), b.(*azure.NetworkWatcherConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.PublicIPReference)(nil), (*PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(a.(*azure.PublicIPReference), b.(*PublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.NetworkWatcherConfig)(nil), (*NetworkWatcherConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NetworkWatcherConfig_To_v1alpha1_NetworkWatcherConfig(a.(*azure.NetworkWatcherConfig), b.(*NetworkWatcherConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*azure.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(a.(*ResourceGroup), b.(*azure.ResourceGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*NetworkWatcherStatus)(nil), (*azure.NetworkWatcherStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkWatcherStatus_To_azure_NetworkWatcherStatus(a.(*NetworkWatcherStatus), b.(*azure.NetworkWatcherStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.ResourceGroup)(nil), (*ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(a.(*azure.ResourceGroup), b.(*ResourceGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.NetworkWatcherStatus)(nil), (*NetworkWatcherStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NetworkWatcherStatus_To_v1alpha1_NetworkWatcherStatus(a.(*azure.NetworkWatcherStatus), b.(*NetworkWatcherStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RotationConfig)(nil), (*azure.RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_RotationConfig_To_azure_RotationConfig(a.(*RotationConfig), b.(*azure.RotationConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*OutboundLoadBalancerConfig)(nil), (*azure.OutboundLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(a.(*OutboundLoadBalancerConfig), b.(*azure.OutboundLoadBalancerConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.RotationConfig)(nil), (*RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_RotationConfig_To_v1alpha1_RotationConfig(a.(*azure.RotationConfig), b.(*RotationConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundLoadBalancerConfig)(nil), (*OutboundLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(a.(*azure.OutboundLoadBalancerConfig), b.(*OutboundLoadBalancerConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RouteTable)(nil), (*azure.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_RouteTable_To_azure_RouteTable(a.(*RouteTable), b.(*azure.RouteTable), scope)
+	if err := s.AddGeneratedConversionFunc((*OutboundLoadBalancerStatus)(nil), (*azure.OutboundLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(a.(*OutboundLoadBalancerStatus), b.(*azure.OutboundLoadBalancerStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.RouteTable)(nil), (*RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_RouteTable_To_v1alpha1_RouteTable(a.(*azure.RouteTable), b.(*RouteTable), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundLoadBalancerStatus)(nil), (*OutboundLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(a.(*azure.OutboundLoadBalancerStatus), b.(*OutboundLoadBalancerStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*azure.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(a.(*SecurityGroup), b.(*azure.SecurityGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*OutboundRuleAllocation)(nil), (*azure.OutboundRuleAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundRuleAllocation_To_azure_OutboundRuleAllocation(a.(*OutboundRuleAllocation), b.(*azure.OutboundRuleAllocation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(a.(*azure.SecurityGroup), b.(*SecurityGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundRuleAllocation)(nil), (*OutboundRuleAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundRuleAllocation_To_v1alpha1_OutboundRuleAllocation(a.(*azure.OutboundRuleAllocation), b.(*OutboundRuleAllocation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*azure.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Storage_To_azure_Storage(a.(*Storage), b.(*azure.Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*OutboundRules)(nil), (*azure.OutboundRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundRules_To_azure_OutboundRules(a.(*OutboundRules), b.(*azure.OutboundRules), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_Storage_To_v1alpha1_Storage(a.(*azure.Storage), b.(*Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundRules)(nil), (*OutboundRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundRules_To_v1alpha1_OutboundRules(a.(*azure.OutboundRules), b.(*OutboundRules), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*azure.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Subnet_To_azure_Subnet(a.(*Subnet), b.(*azure.Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*OutboundRulesStatus)(nil), (*azure.OutboundRulesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundRulesStatus_To_azure_OutboundRulesStatus(a.(*OutboundRulesStatus), b.(*azure.OutboundRulesStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_Subnet_To_v1alpha1_Subnet(a.(*azure.Subnet), b.(*Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundRulesStatus)(nil), (*OutboundRulesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundRulesStatus_To_v1alpha1_OutboundRulesStatus(a.(*azure.OutboundRulesStatus), b.(*OutboundRulesStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VNet)(nil), (*azure.VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VNet_To_azure_VNet(a.(*VNet), b.(*azure.VNet), scope)
+	if err := s.AddGeneratedConversionFunc((*PodSubnetConfig)(nil), (*azure.PodSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(a.(*PodSubnetConfig), b.(*azure.PodSubnetConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.VNet)(nil), (*VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_VNet_To_v1alpha1_VNet(a.(*azure.VNet), b.(*VNet), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PodSubnetConfig)(nil), (*PodSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(a.(*azure.PodSubnetConfig), b.(*PodSubnetConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VNetStatus)(nil), (*azure.VNetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(a.(*VNetStatus), b.(*azure.VNetStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*PrivateDNSZoneConfig)(nil), (*azure.PrivateDNSZoneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateDNSZoneConfig_To_azure_PrivateDNSZoneConfig(a.(*PrivateDNSZoneConfig), b.(*azure.PrivateDNSZoneConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.VNetStatus)(nil), (*VNetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(a.(*azure.VNetStatus), b.(*VNetStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PrivateDNSZoneConfig)(nil), (*PrivateDNSZoneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PrivateDNSZoneConfig_To_v1alpha1_PrivateDNSZoneConfig(a.(*azure.PrivateDNSZoneConfig), b.(*PrivateDNSZoneConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VmoDependency)(nil), (*azure.VmoDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VmoDependency_To_azure_VmoDependency(a.(*VmoDependency), b.(*azure.VmoDependency), scope)
+	if err := s.AddGeneratedConversionFunc((*PrivateDNSZoneStatus)(nil), (*azure.PrivateDNSZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateDNSZoneStatus_To_azure_PrivateDNSZoneStatus(a.(*PrivateDNSZoneStatus), b.(*azure.PrivateDNSZoneStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.VmoDependency)(nil), (*VmoDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_VmoDependency_To_v1alpha1_VmoDependency(a.(*azure.VmoDependency), b.(*VmoDependency), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PrivateDNSZoneStatus)(nil), (*PrivateDNSZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PrivateDNSZoneStatus_To_v1alpha1_PrivateDNSZoneStatus(a.(*azure.PrivateDNSZoneStatus), b.(*PrivateDNSZoneStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*azure.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Volume_To_azure_Volume(a.(*Volume), b.(*azure.Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*PrivateLinkConfig)(nil), (*azure.PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateLinkConfig_To_azure_PrivateLinkConfig(a.(*PrivateLinkConfig), b.(*azure.PrivateLinkConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_Volume_To_v1alpha1_Volume(a.(*azure.Volume), b.(*Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PrivateLinkConfig)(nil), (*PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(a.(*azure.PrivateLinkConfig), b.(*PrivateLinkConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*PrivateLinkStatus)(nil), (*azure.PrivateLinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateLinkStatus_To_azure_PrivateLinkStatus(a.(*PrivateLinkStatus), b.(*azure.PrivateLinkStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PrivateLinkStatus)(nil), (*PrivateLinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(a.(*azure.PrivateLinkStatus), b.(*PrivateLinkStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*azure.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(a.(*WorkerStatus), b.(*azure.WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*PublicAccessConfig)(nil), (*azure.PublicAccessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicAccessConfig_To_azure_PublicAccessConfig(a.(*PublicAccessConfig), b.(*azure.PublicAccessConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*azure.WorkerStatus), b.(*WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PublicAccessConfig)(nil), (*PublicAccessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicAccessConfig_To_v1alpha1_PublicAccessConfig(a.(*azure.PublicAccessConfig), b.(*PublicAccessConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkloadIdentityConfig)(nil), (*azure.WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkloadIdentityConfig_To_azure_WorkloadIdentityConfig(a.(*WorkloadIdentityConfig), b.(*azure.WorkloadIdentityConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*PublicIPPrefixReference)(nil), (*azure.PublicIPPrefixReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPPrefixReference_To_azure_PublicIPPrefixReference(a.(*PublicIPPrefixReference), b.(*azure.PublicIPPrefixReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.WorkloadIdentityConfig)(nil), (*WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(a.(*azure.WorkloadIdentityConfig), b.(*WorkloadIdentityConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPPrefixReference)(nil), (*PublicIPPrefixReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPPrefixReference_To_v1alpha1_PublicIPPrefixReference(a.(*azure.PublicIPPrefixReference), b.(*PublicIPPrefixReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*azure.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Zone_To_azure_Zone(a.(*Zone), b.(*azure.Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*PublicIPReference)(nil), (*azure.PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(a.(*PublicIPReference), b.(*azure.PublicIPReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_Zone_To_v1alpha1_Zone(a.(*azure.Zone), b.(*Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPReference)(nil), (*PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(a.(*azure.PublicIPReference), b.(*PublicIPReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ZonedNatGatewayConfig)(nil), (*azure.ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(a.(*ZonedNatGatewayConfig), b.(*azure.ZonedNatGatewayConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*azure.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(a.(*ResourceGroup), b.(*azure.ResourceGroup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.ZonedNatGatewayConfig)(nil), (*ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(a.(*azure.ZonedNatGatewayConfig), b.(*ZonedNatGatewayConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.ResourceGroup)(nil), (*ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(a.(*azure.ResourceGroup), b.(*ResourceGroup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ZonedPublicIPReference)(nil), (*azure.ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ZonedPublicIPReference_To_azure_ZonedPublicIPReference(a.(*ZonedPublicIPReference), b.(*azure.ZonedPublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*RollingUpdate)(nil), (*azure.RollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollingUpdate_To_azure_RollingUpdate(a.(*RollingUpdate), b.(*azure.RollingUpdate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.ZonedPublicIPReference)(nil), (*ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_ZonedPublicIPReference_To_v1alpha1_ZonedPublicIPReference(a.(*azure.ZonedPublicIPReference), b.(*ZonedPublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.RollingUpdate)(nil), (*RollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RollingUpdate_To_v1alpha1_RollingUpdate(a.(*azure.RollingUpdate), b.(*RollingUpdate), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-func autoConvert_v1alpha1_AzureResource_To_azure_AzureResource(in *AzureResource, out *azure.AzureResource, s conversion.Scope) error {
-	out.Kind = in.Kind
-	out.ID = in.ID
-	return nil
-}
-
-// Convert_v1alpha1_AzureResource_To_azure_AzureResource is an autogenerated conversion function.
-func Convert_v1alpha1_AzureResource_To_azure_AzureResource(in *AzureResource, out *azure.AzureResource, s conversion.Scope) error {
-	return autoConvert_v1alpha1_AzureResource_To_azure_AzureResource(in, out, s)
-}
-
-func autoConvert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResource, out *AzureResource, s conversion.Scope) error {
-	out.Kind = in.Kind
-	out.ID = in.ID
-	return nil
-}
-
-// Convert_azure_AzureResource_To_v1alpha1_AzureResource is an autogenerated conversion function.
-func Convert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResource, out *AzureResource, s conversion.Scope) error {
-	return autoConvert_azure_AzureResource_To_v1alpha1_AzureResource(in, out, s)
-}
-
-func autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
-	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
-	out.Immutability = (*azure.ImmutableConfig)(unsafe.Pointer(in.Immutability))
-	out.RotationConfig = (*azure.RotationConfig)(unsafe.Pointer(in.RotationConfig))
-	return nil
-}
-
-// Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig is an autogenerated conversion function.
-func Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in, out, s)
-}
-
-func autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
-	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
-	out.Immutability = (*ImmutableConfig)(unsafe.Pointer(in.Immutability))
-	out.RotationConfig = (*RotationConfig)(unsafe.Pointer(in.RotationConfig))
-	return nil
-}
-
-// Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
-func Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
-	return autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
-}
-
-func autoConvert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in *CloudConfiguration, out *azure.CloudConfiguration, s conversion.Scope) error {
-	out.Name = in.Name
-	return nil
-}
-
+	if err := s.AddGeneratedConversionFunc((*RotationConfig)(nil), (*azure.RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RotationConfig_To_azure_RotationConfig(a.(*RotationConfig), b.(*azure.RotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RotationConfig)(nil), (*RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RotationConfig_To_v1alpha1_RotationConfig(a.(*azure.RotationConfig), b.(*RotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RouteServerSubnetConfig)(nil), (*azure.RouteServerSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteServerSubnetConfig_To_azure_RouteServerSubnetConfig(a.(*RouteServerSubnetConfig), b.(*azure.RouteServerSubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RouteServerSubnetConfig)(nil), (*RouteServerSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RouteServerSubnetConfig_To_v1alpha1_RouteServerSubnetConfig(a.(*azure.RouteServerSubnetConfig), b.(*RouteServerSubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RouteTable)(nil), (*azure.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTable_To_azure_RouteTable(a.(*RouteTable), b.(*azure.RouteTable), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RouteTable)(nil), (*RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RouteTable_To_v1alpha1_RouteTable(a.(*azure.RouteTable), b.(*RouteTable), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SFTPConfig)(nil), (*azure.SFTPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SFTPConfig_To_azure_SFTPConfig(a.(*SFTPConfig), b.(*azure.SFTPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SFTPConfig)(nil), (*SFTPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SFTPConfig_To_v1alpha1_SFTPConfig(a.(*azure.SFTPConfig), b.(*SFTPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScaleInPolicy)(nil), (*azure.ScaleInPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScaleInPolicy_To_azure_ScaleInPolicy(a.(*ScaleInPolicy), b.(*azure.ScaleInPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ScaleInPolicy)(nil), (*ScaleInPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ScaleInPolicy_To_v1alpha1_ScaleInPolicy(a.(*azure.ScaleInPolicy), b.(*ScaleInPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecondaryStorageAccountConfig)(nil), (*azure.SecondaryStorageAccountConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecondaryStorageAccountConfig_To_azure_SecondaryStorageAccountConfig(a.(*SecondaryStorageAccountConfig), b.(*azure.SecondaryStorageAccountConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecondaryStorageAccountConfig)(nil), (*SecondaryStorageAccountConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecondaryStorageAccountConfig_To_v1alpha1_SecondaryStorageAccountConfig(a.(*azure.SecondaryStorageAccountConfig), b.(*SecondaryStorageAccountConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*azure.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(a.(*SecurityGroup), b.(*azure.SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(a.(*azure.SecurityGroup), b.(*SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupReference)(nil), (*azure.SecurityGroupReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupReference_To_azure_SecurityGroupReference(a.(*SecurityGroupReference), b.(*azure.SecurityGroupReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecurityGroupReference)(nil), (*SecurityGroupReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecurityGroupReference_To_v1alpha1_SecurityGroupReference(a.(*azure.SecurityGroupReference), b.(*SecurityGroupReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*azure.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_azure_Storage(a.(*Storage), b.(*azure.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Storage_To_v1alpha1_Storage(a.(*azure.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageAccountReference)(nil), (*azure.StorageAccountReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageAccountReference_To_azure_StorageAccountReference(a.(*StorageAccountReference), b.(*azure.StorageAccountReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.StorageAccountReference)(nil), (*StorageAccountReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_StorageAccountReference_To_v1alpha1_StorageAccountReference(a.(*azure.StorageAccountReference), b.(*StorageAccountReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*azure.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Subnet_To_azure_Subnet(a.(*Subnet), b.(*azure.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Subnet_To_v1alpha1_Subnet(a.(*azure.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TimeConfig)(nil), (*azure.TimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TimeConfig_To_azure_TimeConfig(a.(*TimeConfig), b.(*azure.TimeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.TimeConfig)(nil), (*TimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_TimeConfig_To_v1alpha1_TimeConfig(a.(*azure.TimeConfig), b.(*TimeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficAnalyticsConfig)(nil), (*azure.TrafficAnalyticsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(a.(*TrafficAnalyticsConfig), b.(*azure.TrafficAnalyticsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.TrafficAnalyticsConfig)(nil), (*TrafficAnalyticsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(a.(*azure.TrafficAnalyticsConfig), b.(*TrafficAnalyticsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UserDataEncryption)(nil), (*azure.UserDataEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserDataEncryption_To_azure_UserDataEncryption(a.(*UserDataEncryption), b.(*azure.UserDataEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.UserDataEncryption)(nil), (*UserDataEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_UserDataEncryption_To_v1alpha1_UserDataEncryption(a.(*azure.UserDataEncryption), b.(*UserDataEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VNet)(nil), (*azure.VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VNet_To_azure_VNet(a.(*VNet), b.(*azure.VNet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VNet)(nil), (*VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VNet_To_v1alpha1_VNet(a.(*azure.VNet), b.(*VNet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VNetEncryption)(nil), (*azure.VNetEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VNetEncryption_To_azure_VNetEncryption(a.(*VNetEncryption), b.(*azure.VNetEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VNetEncryption)(nil), (*VNetEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VNetEncryption_To_v1alpha1_VNetEncryption(a.(*azure.VNetEncryption), b.(*VNetEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VNetStatus)(nil), (*azure.VNetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(a.(*VNetStatus), b.(*azure.VNetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VNetStatus)(nil), (*VNetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(a.(*azure.VNetStatus), b.(*VNetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VmoDependency)(nil), (*azure.VmoDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VmoDependency_To_azure_VmoDependency(a.(*VmoDependency), b.(*azure.VmoDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VmoDependency)(nil), (*VmoDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VmoDependency_To_v1alpha1_VmoDependency(a.(*azure.VmoDependency), b.(*VmoDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*azure.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_azure_Volume(a.(*Volume), b.(*azure.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Volume_To_v1alpha1_Volume(a.(*azure.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolFallbackZones)(nil), (*azure.WorkerPoolFallbackZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolFallbackZones_To_azure_WorkerPoolFallbackZones(a.(*WorkerPoolFallbackZones), b.(*azure.WorkerPoolFallbackZones), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerPoolFallbackZones)(nil), (*WorkerPoolFallbackZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerPoolFallbackZones_To_v1alpha1_WorkerPoolFallbackZones(a.(*azure.WorkerPoolFallbackZones), b.(*WorkerPoolFallbackZones), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*azure.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(a.(*WorkerStatus), b.(*azure.WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*azure.WorkerStatus), b.(*WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadIdentityConfig)(nil), (*azure.WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadIdentityConfig_To_azure_WorkloadIdentityConfig(a.(*WorkloadIdentityConfig), b.(*azure.WorkloadIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkloadIdentityConfig)(nil), (*WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(a.(*azure.WorkloadIdentityConfig), b.(*WorkloadIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*azure.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Zone_To_azure_Zone(a.(*Zone), b.(*azure.Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_Zone_To_v1alpha1_Zone(a.(*azure.Zone), b.(*Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZonedNatGatewayConfig)(nil), (*azure.ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(a.(*ZonedNatGatewayConfig), b.(*azure.ZonedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ZonedNatGatewayConfig)(nil), (*ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(a.(*azure.ZonedNatGatewayConfig), b.(*ZonedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZonedPublicIPReference)(nil), (*azure.ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZonedPublicIPReference_To_azure_ZonedPublicIPReference(a.(*ZonedPublicIPReference), b.(*azure.ZonedPublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ZonedPublicIPReference)(nil), (*ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZonedPublicIPReference_To_v1alpha1_ZonedPublicIPReference(a.(*azure.ZonedPublicIPReference), b.(*ZonedPublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in *AdditionalSubnet, out *azure.AdditionalSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.AttachNatGateway = (*bool)(unsafe.Pointer(in.AttachNatGateway))
+	return nil
+}
+
+// Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet is an autogenerated conversion function.
+func Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in *AdditionalSubnet, out *azure.AdditionalSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in, out, s)
+}
+
+func autoConvert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in *azure.AdditionalSubnet, out *AdditionalSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.AttachNatGateway = (*bool)(unsafe.Pointer(in.AttachNatGateway))
+	return nil
+}
+
+// Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet is an autogenerated conversion function.
+func Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in *azure.AdditionalSubnet, out *AdditionalSubnet, s conversion.Scope) error {
+	return autoConvert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_AutomaticRepairs_To_azure_AutomaticRepairs(in *AutomaticRepairs, out *azure.AutomaticRepairs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.GracePeriodMinutes = (*int32)(unsafe.Pointer(in.GracePeriodMinutes))
+	return nil
+}
+
+// Convert_v1alpha1_AutomaticRepairs_To_azure_AutomaticRepairs is an autogenerated conversion function.
+func Convert_v1alpha1_AutomaticRepairs_To_azure_AutomaticRepairs(in *AutomaticRepairs, out *azure.AutomaticRepairs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutomaticRepairs_To_azure_AutomaticRepairs(in, out, s)
+}
+
+func autoConvert_azure_AutomaticRepairs_To_v1alpha1_AutomaticRepairs(in *azure.AutomaticRepairs, out *AutomaticRepairs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.GracePeriodMinutes = (*int32)(unsafe.Pointer(in.GracePeriodMinutes))
+	return nil
+}
+
+// Convert_azure_AutomaticRepairs_To_v1alpha1_AutomaticRepairs is an autogenerated conversion function.
+func Convert_azure_AutomaticRepairs_To_v1alpha1_AutomaticRepairs(in *azure.AutomaticRepairs, out *AutomaticRepairs, s conversion.Scope) error {
+	return autoConvert_azure_AutomaticRepairs_To_v1alpha1_AutomaticRepairs(in, out, s)
+}
+
+func autoConvert_v1alpha1_AzureMonitorAgent_To_azure_AzureMonitorAgent(in *AzureMonitorAgent, out *azure.AzureMonitorAgent, s conversion.Scope) error {
+	out.DataCollectionRuleID = in.DataCollectionRuleID
+	return nil
+}
+
+// Convert_v1alpha1_AzureMonitorAgent_To_azure_AzureMonitorAgent is an autogenerated conversion function.
+func Convert_v1alpha1_AzureMonitorAgent_To_azure_AzureMonitorAgent(in *AzureMonitorAgent, out *azure.AzureMonitorAgent, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureMonitorAgent_To_azure_AzureMonitorAgent(in, out, s)
+}
+
+func autoConvert_azure_AzureMonitorAgent_To_v1alpha1_AzureMonitorAgent(in *azure.AzureMonitorAgent, out *AzureMonitorAgent, s conversion.Scope) error {
+	out.DataCollectionRuleID = in.DataCollectionRuleID
+	return nil
+}
+
+// Convert_azure_AzureMonitorAgent_To_v1alpha1_AzureMonitorAgent is an autogenerated conversion function.
+func Convert_azure_AzureMonitorAgent_To_v1alpha1_AzureMonitorAgent(in *azure.AzureMonitorAgent, out *AzureMonitorAgent, s conversion.Scope) error {
+	return autoConvert_azure_AzureMonitorAgent_To_v1alpha1_AzureMonitorAgent(in, out, s)
+}
+
+func autoConvert_v1alpha1_AzureResource_To_azure_AzureResource(in *AzureResource, out *azure.AzureResource, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_AzureResource_To_azure_AzureResource is an autogenerated conversion function.
+func Convert_v1alpha1_AzureResource_To_azure_AzureResource(in *AzureResource, out *azure.AzureResource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureResource_To_azure_AzureResource(in, out, s)
+}
+
+func autoConvert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResource, out *AzureResource, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_azure_AzureResource_To_v1alpha1_AzureResource is an autogenerated conversion function.
+func Convert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResource, out *AzureResource, s conversion.Scope) error {
+	return autoConvert_azure_AzureResource_To_v1alpha1_AzureResource(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.Immutability = (*azure.ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.RotationConfig = (*azure.RotationConfig)(unsafe.Pointer(in.RotationConfig))
+	out.GeoReplication = (*azure.GeoReplicationConfig)(unsafe.Pointer(in.GeoReplication))
+	out.DiagnosticSettings = (*azure.DiagnosticSettingsConfig)(unsafe.Pointer(in.DiagnosticSettings))
+	out.StorageAccountNamePrefix = (*string)(unsafe.Pointer(in.StorageAccountNamePrefix))
+	out.PublicAccess = (*azure.PublicAccessConfig)(unsafe.Pointer(in.PublicAccess))
+	out.SecondaryStorageAccount = (*azure.SecondaryStorageAccountConfig)(unsafe.Pointer(in.SecondaryStorageAccount))
+	out.RequireInfrastructureEncryption = (*bool)(unsafe.Pointer(in.RequireInfrastructureEncryption))
+	out.ChangeFeed = (*azure.ChangeFeedConfig)(unsafe.Pointer(in.ChangeFeed))
+	out.SFTP = (*azure.SFTPConfig)(unsafe.Pointer(in.SFTP))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.Immutability = (*ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.RotationConfig = (*RotationConfig)(unsafe.Pointer(in.RotationConfig))
+	out.GeoReplication = (*GeoReplicationConfig)(unsafe.Pointer(in.GeoReplication))
+	out.DiagnosticSettings = (*DiagnosticSettingsConfig)(unsafe.Pointer(in.DiagnosticSettings))
+	out.StorageAccountNamePrefix = (*string)(unsafe.Pointer(in.StorageAccountNamePrefix))
+	out.PublicAccess = (*PublicAccessConfig)(unsafe.Pointer(in.PublicAccess))
+	out.SecondaryStorageAccount = (*SecondaryStorageAccountConfig)(unsafe.Pointer(in.SecondaryStorageAccount))
+	out.RequireInfrastructureEncryption = (*bool)(unsafe.Pointer(in.RequireInfrastructureEncryption))
+	out.ChangeFeed = (*ChangeFeedConfig)(unsafe.Pointer(in.ChangeFeed))
+	out.SFTP = (*SFTPConfig)(unsafe.Pointer(in.SFTP))
+	return nil
+}
+
+// Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BootDiagnosticsRetention_To_azure_BootDiagnosticsRetention(in *BootDiagnosticsRetention, out *azure.BootDiagnosticsRetention, s conversion.Scope) error {
+	out.ResourceGroup = in.ResourceGroup
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_v1alpha1_BootDiagnosticsRetention_To_azure_BootDiagnosticsRetention is an autogenerated conversion function.
+func Convert_v1alpha1_BootDiagnosticsRetention_To_azure_BootDiagnosticsRetention(in *BootDiagnosticsRetention, out *azure.BootDiagnosticsRetention, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BootDiagnosticsRetention_To_azure_BootDiagnosticsRetention(in, out, s)
+}
+
+func autoConvert_azure_BootDiagnosticsRetention_To_v1alpha1_BootDiagnosticsRetention(in *azure.BootDiagnosticsRetention, out *BootDiagnosticsRetention, s conversion.Scope) error {
+	out.ResourceGroup = in.ResourceGroup
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_azure_BootDiagnosticsRetention_To_v1alpha1_BootDiagnosticsRetention is an autogenerated conversion function.
+func Convert_azure_BootDiagnosticsRetention_To_v1alpha1_BootDiagnosticsRetention(in *azure.BootDiagnosticsRetention, out *BootDiagnosticsRetention, s conversion.Scope) error {
+	return autoConvert_azure_BootDiagnosticsRetention_To_v1alpha1_BootDiagnosticsRetention(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSISnapshotControllerConfig_To_azure_CSISnapshotControllerConfig(in *CSISnapshotControllerConfig, out *azure.CSISnapshotControllerConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_CSISnapshotControllerConfig_To_azure_CSISnapshotControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSISnapshotControllerConfig_To_azure_CSISnapshotControllerConfig(in *CSISnapshotControllerConfig, out *azure.CSISnapshotControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSISnapshotControllerConfig_To_azure_CSISnapshotControllerConfig(in, out, s)
+}
+
+func autoConvert_azure_CSISnapshotControllerConfig_To_v1alpha1_CSISnapshotControllerConfig(in *azure.CSISnapshotControllerConfig, out *CSISnapshotControllerConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_azure_CSISnapshotControllerConfig_To_v1alpha1_CSISnapshotControllerConfig is an autogenerated conversion function.
+func Convert_azure_CSISnapshotControllerConfig_To_v1alpha1_CSISnapshotControllerConfig(in *azure.CSISnapshotControllerConfig, out *CSISnapshotControllerConfig, s conversion.Scope) error {
+	return autoConvert_azure_CSISnapshotControllerConfig_To_v1alpha1_CSISnapshotControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ChangeFeedConfig_To_azure_ChangeFeedConfig(in *ChangeFeedConfig, out *azure.ChangeFeedConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	return nil
+}
+
+// Convert_v1alpha1_ChangeFeedConfig_To_azure_ChangeFeedConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ChangeFeedConfig_To_azure_ChangeFeedConfig(in *ChangeFeedConfig, out *azure.ChangeFeedConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChangeFeedConfig_To_azure_ChangeFeedConfig(in, out, s)
+}
+
+func autoConvert_azure_ChangeFeedConfig_To_v1alpha1_ChangeFeedConfig(in *azure.ChangeFeedConfig, out *ChangeFeedConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	return nil
+}
+
+// Convert_azure_ChangeFeedConfig_To_v1alpha1_ChangeFeedConfig is an autogenerated conversion function.
+func Convert_azure_ChangeFeedConfig_To_v1alpha1_ChangeFeedConfig(in *azure.ChangeFeedConfig, out *ChangeFeedConfig, s conversion.Scope) error {
+	return autoConvert_azure_ChangeFeedConfig_To_v1alpha1_ChangeFeedConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in *CloudConfiguration, out *azure.CloudConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
 // Convert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration is an autogenerated conversion function.
 func Convert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in *CloudConfiguration, out *azure.CloudConfiguration, s conversion.Scope) error {
 	return autoConvert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in, out, s)
 }
 
-func autoConvert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.CloudConfiguration, out *CloudConfiguration, s conversion.Scope) error {
-	out.Name = in.Name
+func autoConvert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.CloudConfiguration, out *CloudConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration is an autogenerated conversion function.
+func Convert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.CloudConfiguration, out *CloudConfiguration, s conversion.Scope) error {
+	return autoConvert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in, out, s)
+}
+
+func autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudNodeManagerConfig_To_azure_CloudNodeManagerConfig(in *CloudNodeManagerConfig, out *azure.CloudNodeManagerConfig, s conversion.Scope) error {
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNodeManagerConfig_To_azure_CloudNodeManagerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNodeManagerConfig_To_azure_CloudNodeManagerConfig(in *CloudNodeManagerConfig, out *azure.CloudNodeManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNodeManagerConfig_To_azure_CloudNodeManagerConfig(in, out, s)
+}
+
+func autoConvert_azure_CloudNodeManagerConfig_To_v1alpha1_CloudNodeManagerConfig(in *azure.CloudNodeManagerConfig, out *CloudNodeManagerConfig, s conversion.Scope) error {
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_azure_CloudNodeManagerConfig_To_v1alpha1_CloudNodeManagerConfig is an autogenerated conversion function.
+func Convert_azure_CloudNodeManagerConfig_To_v1alpha1_CloudNodeManagerConfig(in *azure.CloudNodeManagerConfig, out *CloudNodeManagerConfig, s conversion.Scope) error {
+	return autoConvert_azure_CloudNodeManagerConfig_To_v1alpha1_CloudNodeManagerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in *CloudProfileConfig, out *azure.CloudProfileConfig, s conversion.Scope) error {
+	out.CountUpdateDomains = *(*[]azure.DomainCount)(unsafe.Pointer(&in.CountUpdateDomains))
+	out.CountFaultDomains = *(*[]azure.DomainCount)(unsafe.Pointer(&in.CountFaultDomains))
+	out.MachineImages = *(*[]azure.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]azure.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in *CloudProfileConfig, out *azure.CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	out.CountUpdateDomains = *(*[]DomainCount)(unsafe.Pointer(&in.CountUpdateDomains))
+	out.CountFaultDomains = *(*[]DomainCount)(unsafe.Pointer(&in.CountFaultDomains))
+	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	return nil
+}
+
+// Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
+func Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
+	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudNodeManager = (*azure.CloudNodeManagerConfig)(unsafe.Pointer(in.CloudNodeManager))
+	out.CSISnapshotController = (*azure.CSISnapshotControllerConfig)(unsafe.Pointer(in.CSISnapshotController))
+	out.Storage = (*azure.Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*azure.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CloudNodeManager = (*CloudNodeManagerConfig)(unsafe.Pointer(in.CloudNodeManager))
+	out.CSISnapshotController = (*CSISnapshotControllerConfig)(unsafe.Pointer(in.CSISnapshotController))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	return nil
+}
+
+// Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneStatus_To_azure_ControlPlaneStatus(in *ControlPlaneStatus, out *azure.ControlPlaneStatus, s conversion.Scope) error {
+	out.PrivateLink = (*azure.PrivateLinkStatus)(unsafe.Pointer(in.PrivateLink))
+	out.GatewayLoadBalancer = (*azure.GatewayLoadBalancerStatus)(unsafe.Pointer(in.GatewayLoadBalancer))
+	out.OutboundRules = (*azure.OutboundRulesStatus)(unsafe.Pointer(in.OutboundRules))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneStatus_To_azure_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneStatus_To_azure_ControlPlaneStatus(in *ControlPlaneStatus, out *azure.ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneStatus_To_azure_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_azure_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *azure.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	out.PrivateLink = (*PrivateLinkStatus)(unsafe.Pointer(in.PrivateLink))
+	out.GatewayLoadBalancer = (*GatewayLoadBalancerStatus)(unsafe.Pointer(in.GatewayLoadBalancer))
+	out.OutboundRules = (*OutboundRulesStatus)(unsafe.Pointer(in.OutboundRules))
+	return nil
+}
+
+// Convert_azure_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_azure_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *azure.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_azure_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in *DNSRecordConfig, out *azure.DNSRecordConfig, s conversion.Scope) error {
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ZoneType = (*azure.DNSZoneType)(unsafe.Pointer(in.ZoneType))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.TagZone = (*bool)(unsafe.Pointer(in.TagZone))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in *DNSRecordConfig, out *azure.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *azure.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ZoneType = (*DNSZoneType)(unsafe.Pointer(in.ZoneType))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.TagZone = (*bool)(unsafe.Pointer(in.TagZone))
+	return nil
+}
+
+// Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *azure.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ImageRef = (*azure.Image)(unsafe.Pointer(in.ImageRef))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_azure_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in, out, s)
+}
+
+func autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ImageRef = (*Image)(unsafe.Pointer(in.ImageRef))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	return nil
+}
+
+// Convert_azure_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiagnosticSettingsConfig_To_azure_DiagnosticSettingsConfig(in *DiagnosticSettingsConfig, out *azure.DiagnosticSettingsConfig, s conversion.Scope) error {
+	out.WorkspaceID = (*string)(unsafe.Pointer(in.WorkspaceID))
+	out.StorageAccountID = (*string)(unsafe.Pointer(in.StorageAccountID))
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticSettingsConfig_To_azure_DiagnosticSettingsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticSettingsConfig_To_azure_DiagnosticSettingsConfig(in *DiagnosticSettingsConfig, out *azure.DiagnosticSettingsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticSettingsConfig_To_azure_DiagnosticSettingsConfig(in, out, s)
+}
+
+func autoConvert_azure_DiagnosticSettingsConfig_To_v1alpha1_DiagnosticSettingsConfig(in *azure.DiagnosticSettingsConfig, out *DiagnosticSettingsConfig, s conversion.Scope) error {
+	out.WorkspaceID = (*string)(unsafe.Pointer(in.WorkspaceID))
+	out.StorageAccountID = (*string)(unsafe.Pointer(in.StorageAccountID))
+	return nil
+}
+
+// Convert_azure_DiagnosticSettingsConfig_To_v1alpha1_DiagnosticSettingsConfig is an autogenerated conversion function.
+func Convert_azure_DiagnosticSettingsConfig_To_v1alpha1_DiagnosticSettingsConfig(in *azure.DiagnosticSettingsConfig, out *DiagnosticSettingsConfig, s conversion.Scope) error {
+	return autoConvert_azure_DiagnosticSettingsConfig_To_v1alpha1_DiagnosticSettingsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in *DiagnosticsProfile, out *azure.DiagnosticsProfile, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	out.Retention = (*azure.BootDiagnosticsRetention)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in *DiagnosticsProfile, out *azure.DiagnosticsProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in, out, s)
+}
+
+func autoConvert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in *azure.DiagnosticsProfile, out *DiagnosticsProfile, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	out.Retention = (*BootDiagnosticsRetention)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile is an autogenerated conversion function.
+func Convert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in *azure.DiagnosticsProfile, out *DiagnosticsProfile, s conversion.Scope) error {
+	return autoConvert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_DomainCount_To_azure_DomainCount(in *DomainCount, out *azure.DomainCount, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_DomainCount_To_azure_DomainCount is an autogenerated conversion function.
+func Convert_v1alpha1_DomainCount_To_azure_DomainCount(in *DomainCount, out *azure.DomainCount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DomainCount_To_azure_DomainCount(in, out, s)
+}
+
+func autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, out *DomainCount, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_azure_DomainCount_To_v1alpha1_DomainCount is an autogenerated conversion function.
+func Convert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, out *DomainCount, s conversion.Scope) error {
+	return autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in *FlowLogsConfig, out *azure.FlowLogsConfig, s conversion.Scope) error {
+	out.StorageAccountID = in.StorageAccountID
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.TrafficAnalytics = (*azure.TrafficAnalyticsConfig)(unsafe.Pointer(in.TrafficAnalytics))
+	out.CreateNetworkWatcher = (*bool)(unsafe.Pointer(in.CreateNetworkWatcher))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in *FlowLogsConfig, out *azure.FlowLogsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in, out, s)
+}
+
+func autoConvert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in *azure.FlowLogsConfig, out *FlowLogsConfig, s conversion.Scope) error {
+	out.StorageAccountID = in.StorageAccountID
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.TrafficAnalytics = (*TrafficAnalyticsConfig)(unsafe.Pointer(in.TrafficAnalytics))
+	out.CreateNetworkWatcher = (*bool)(unsafe.Pointer(in.CreateNetworkWatcher))
+	return nil
+}
+
+// Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig is an autogenerated conversion function.
+func Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in *azure.FlowLogsConfig, out *FlowLogsConfig, s conversion.Scope) error {
+	return autoConvert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_GatewayLoadBalancer_To_azure_GatewayLoadBalancer(in *GatewayLoadBalancer, out *azure.GatewayLoadBalancer, s conversion.Scope) error {
+	out.FrontendIPConfigurationID = in.FrontendIPConfigurationID
+	return nil
+}
+
+// Convert_v1alpha1_GatewayLoadBalancer_To_azure_GatewayLoadBalancer is an autogenerated conversion function.
+func Convert_v1alpha1_GatewayLoadBalancer_To_azure_GatewayLoadBalancer(in *GatewayLoadBalancer, out *azure.GatewayLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GatewayLoadBalancer_To_azure_GatewayLoadBalancer(in, out, s)
+}
+
+func autoConvert_azure_GatewayLoadBalancer_To_v1alpha1_GatewayLoadBalancer(in *azure.GatewayLoadBalancer, out *GatewayLoadBalancer, s conversion.Scope) error {
+	out.FrontendIPConfigurationID = in.FrontendIPConfigurationID
+	return nil
+}
+
+// Convert_azure_GatewayLoadBalancer_To_v1alpha1_GatewayLoadBalancer is an autogenerated conversion function.
+func Convert_azure_GatewayLoadBalancer_To_v1alpha1_GatewayLoadBalancer(in *azure.GatewayLoadBalancer, out *GatewayLoadBalancer, s conversion.Scope) error {
+	return autoConvert_azure_GatewayLoadBalancer_To_v1alpha1_GatewayLoadBalancer(in, out, s)
+}
+
+func autoConvert_v1alpha1_GatewayLoadBalancerStatus_To_azure_GatewayLoadBalancerStatus(in *GatewayLoadBalancerStatus, out *azure.GatewayLoadBalancerStatus, s conversion.Scope) error {
+	out.FrontendIPConfigurationID = in.FrontendIPConfigurationID
+	return nil
+}
+
+// Convert_v1alpha1_GatewayLoadBalancerStatus_To_azure_GatewayLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_GatewayLoadBalancerStatus_To_azure_GatewayLoadBalancerStatus(in *GatewayLoadBalancerStatus, out *azure.GatewayLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GatewayLoadBalancerStatus_To_azure_GatewayLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_azure_GatewayLoadBalancerStatus_To_v1alpha1_GatewayLoadBalancerStatus(in *azure.GatewayLoadBalancerStatus, out *GatewayLoadBalancerStatus, s conversion.Scope) error {
+	out.FrontendIPConfigurationID = in.FrontendIPConfigurationID
+	return nil
+}
+
+// Convert_azure_GatewayLoadBalancerStatus_To_v1alpha1_GatewayLoadBalancerStatus is an autogenerated conversion function.
+func Convert_azure_GatewayLoadBalancerStatus_To_v1alpha1_GatewayLoadBalancerStatus(in *azure.GatewayLoadBalancerStatus, out *GatewayLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_azure_GatewayLoadBalancerStatus_To_v1alpha1_GatewayLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_GatewaySubnetConfig_To_azure_GatewaySubnetConfig(in *GatewaySubnetConfig, out *azure.GatewaySubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_GatewaySubnetConfig_To_azure_GatewaySubnetConfig is an autogenerated conversion function.
+func Convert_v1alpha1_GatewaySubnetConfig_To_azure_GatewaySubnetConfig(in *GatewaySubnetConfig, out *azure.GatewaySubnetConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GatewaySubnetConfig_To_azure_GatewaySubnetConfig(in, out, s)
+}
+
+func autoConvert_azure_GatewaySubnetConfig_To_v1alpha1_GatewaySubnetConfig(in *azure.GatewaySubnetConfig, out *GatewaySubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_azure_GatewaySubnetConfig_To_v1alpha1_GatewaySubnetConfig is an autogenerated conversion function.
+func Convert_azure_GatewaySubnetConfig_To_v1alpha1_GatewaySubnetConfig(in *azure.GatewaySubnetConfig, out *GatewaySubnetConfig, s conversion.Scope) error {
+	return autoConvert_azure_GatewaySubnetConfig_To_v1alpha1_GatewaySubnetConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeoReplicationConfig_To_azure_GeoReplicationConfig(in *GeoReplicationConfig, out *azure.GeoReplicationConfig, s conversion.Scope) error {
+	out.MaxSyncLag = (*v1.Duration)(unsafe.Pointer(in.MaxSyncLag))
+	return nil
+}
+
+// Convert_v1alpha1_GeoReplicationConfig_To_azure_GeoReplicationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_GeoReplicationConfig_To_azure_GeoReplicationConfig(in *GeoReplicationConfig, out *azure.GeoReplicationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeoReplicationConfig_To_azure_GeoReplicationConfig(in, out, s)
+}
+
+func autoConvert_azure_GeoReplicationConfig_To_v1alpha1_GeoReplicationConfig(in *azure.GeoReplicationConfig, out *GeoReplicationConfig, s conversion.Scope) error {
+	out.MaxSyncLag = (*v1.Duration)(unsafe.Pointer(in.MaxSyncLag))
+	return nil
+}
+
+// Convert_azure_GeoReplicationConfig_To_v1alpha1_GeoReplicationConfig is an autogenerated conversion function.
+func Convert_azure_GeoReplicationConfig_To_v1alpha1_GeoReplicationConfig(in *azure.GeoReplicationConfig, out *GeoReplicationConfig, s conversion.Scope) error {
+	return autoConvert_azure_GeoReplicationConfig_To_v1alpha1_GeoReplicationConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HealthProbe_To_azure_HealthProbe(in *HealthProbe, out *azure.HealthProbe, s conversion.Scope) error {
+	out.Protocol = azure.HealthProbeProtocol(in.Protocol)
+	out.Port = in.Port
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalSeconds = (*int32)(unsafe.Pointer(in.IntervalSeconds))
+	return nil
+}
+
+// Convert_v1alpha1_HealthProbe_To_azure_HealthProbe is an autogenerated conversion function.
+func Convert_v1alpha1_HealthProbe_To_azure_HealthProbe(in *HealthProbe, out *azure.HealthProbe, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthProbe_To_azure_HealthProbe(in, out, s)
+}
+
+func autoConvert_azure_HealthProbe_To_v1alpha1_HealthProbe(in *azure.HealthProbe, out *HealthProbe, s conversion.Scope) error {
+	out.Protocol = HealthProbeProtocol(in.Protocol)
+	out.Port = in.Port
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.IntervalSeconds = (*int32)(unsafe.Pointer(in.IntervalSeconds))
+	return nil
+}
+
+// Convert_azure_HealthProbe_To_v1alpha1_HealthProbe is an autogenerated conversion function.
+func Convert_azure_HealthProbe_To_v1alpha1_HealthProbe(in *azure.HealthProbe, out *HealthProbe, s conversion.Scope) error {
+	return autoConvert_azure_HealthProbe_To_v1alpha1_HealthProbe(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.RoleAssignments = *(*[]azure.IdentityRoleAssignment)(unsafe.Pointer(&in.RoleAssignments))
+	out.FederatedCredentials = *(*[]azure.IdentityFederatedCredential)(unsafe.Pointer(&in.FederatedCredentials))
+	return nil
+}
+
+// Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in, out, s)
+}
+
+func autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.RoleAssignments = *(*[]IdentityRoleAssignment)(unsafe.Pointer(&in.RoleAssignments))
+	out.FederatedCredentials = *(*[]IdentityFederatedCredential)(unsafe.Pointer(&in.FederatedCredentials))
+	return nil
+}
+
+// Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig is an autogenerated conversion function.
+func Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
+	return autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityFederatedCredential_To_azure_IdentityFederatedCredential(in *IdentityFederatedCredential, out *azure.IdentityFederatedCredential, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ServiceAccount = in.ServiceAccount
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_v1alpha1_IdentityFederatedCredential_To_azure_IdentityFederatedCredential is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityFederatedCredential_To_azure_IdentityFederatedCredential(in *IdentityFederatedCredential, out *azure.IdentityFederatedCredential, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityFederatedCredential_To_azure_IdentityFederatedCredential(in, out, s)
+}
+
+func autoConvert_azure_IdentityFederatedCredential_To_v1alpha1_IdentityFederatedCredential(in *azure.IdentityFederatedCredential, out *IdentityFederatedCredential, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ServiceAccount = in.ServiceAccount
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_azure_IdentityFederatedCredential_To_v1alpha1_IdentityFederatedCredential is an autogenerated conversion function.
+func Convert_azure_IdentityFederatedCredential_To_v1alpha1_IdentityFederatedCredential(in *azure.IdentityFederatedCredential, out *IdentityFederatedCredential, s conversion.Scope) error {
+	return autoConvert_azure_IdentityFederatedCredential_To_v1alpha1_IdentityFederatedCredential(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in *IdentityRoleAssignment, out *azure.IdentityRoleAssignment, s conversion.Scope) error {
+	out.RoleDefinitionID = in.RoleDefinitionID
+	out.Scope = (*string)(unsafe.Pointer(in.Scope))
+	return nil
+}
+
+// Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in *IdentityRoleAssignment, out *azure.IdentityRoleAssignment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in, out, s)
+}
+
+func autoConvert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in *azure.IdentityRoleAssignment, out *IdentityRoleAssignment, s conversion.Scope) error {
+	out.RoleDefinitionID = in.RoleDefinitionID
+	out.Scope = (*string)(unsafe.Pointer(in.Scope))
+	return nil
+}
+
+// Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment is an autogenerated conversion function.
+func Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in *azure.IdentityRoleAssignment, out *IdentityRoleAssignment, s conversion.Scope) error {
+	return autoConvert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in, out, s)
+}
+
+func autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ClientID = in.ClientID
+	out.ACRAccess = in.ACRAccess
+	return nil
+}
+
+// Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in, out, s)
+}
+
+func autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ClientID = in.ClientID
+	out.ACRAccess = in.ACRAccess
+	return nil
+}
+
+// Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus is an autogenerated conversion function.
+func Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
+	return autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Image_To_azure_Image(in *Image, out *azure.Image, s conversion.Scope) error {
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	return nil
+}
+
+// Convert_v1alpha1_Image_To_azure_Image is an autogenerated conversion function.
+func Convert_v1alpha1_Image_To_azure_Image(in *Image, out *azure.Image, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Image_To_azure_Image(in, out, s)
+}
+
+func autoConvert_azure_Image_To_v1alpha1_Image(in *azure.Image, out *Image, s conversion.Scope) error {
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	return nil
+}
+
+// Convert_azure_Image_To_v1alpha1_Image is an autogenerated conversion function.
+func Convert_azure_Image_To_v1alpha1_Image(in *azure.Image, out *Image, s conversion.Scope) error {
+	return autoConvert_azure_Image_To_v1alpha1_Image(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in *ImmutableConfig, out *azure.ImmutableConfig, s conversion.Scope) error {
+	out.RetentionType = azure.RetentionType(in.RetentionType)
+	out.RetentionPeriod = in.RetentionPeriod
+	out.Locked = in.Locked
 	return nil
 }
 
-// Convert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration is an autogenerated conversion function.
-func Convert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.CloudConfiguration, out *CloudConfiguration, s conversion.Scope) error {
-	return autoConvert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in, out, s)
+// Convert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in *ImmutableConfig, out *azure.ImmutableConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+func autoConvert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *azure.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
+	out.RetentionType = RetentionType(in.RetentionType)
+	out.RetentionPeriod = in.RetentionPeriod
+	out.Locked = in.Locked
 	return nil
 }
 
-// Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in, out, s)
+// Convert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig is an autogenerated conversion function.
+func Convert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *azure.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
+	return autoConvert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in, out, s)
 }
 
-func autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
+	out.ResourceGroup = (*azure.ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
+	out.ResourceGroupLocation = (*string)(unsafe.Pointer(in.ResourceGroupLocation))
+	out.NetworkResourceGroup = (*azure.ResourceGroup)(unsafe.Pointer(in.NetworkResourceGroup))
+	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.Identity = (*azure.IdentityConfig)(unsafe.Pointer(in.Identity))
+	out.Zoned = in.Zoned
 	return nil
 }
 
-// Convert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig is an autogenerated conversion function.
-func Convert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
-	return autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
+// Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in *CloudProfileConfig, out *azure.CloudProfileConfig, s conversion.Scope) error {
-	out.CountUpdateDomains = *(*[]azure.DomainCount)(unsafe.Pointer(&in.CountUpdateDomains))
-	out.CountFaultDomains = *(*[]azure.DomainCount)(unsafe.Pointer(&in.CountFaultDomains))
-	out.MachineImages = *(*[]azure.MachineImages)(unsafe.Pointer(&in.MachineImages))
-	out.MachineTypes = *(*[]azure.MachineType)(unsafe.Pointer(&in.MachineTypes))
-	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azure.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	out.ResourceGroup = (*ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
+	out.ResourceGroupLocation = (*string)(unsafe.Pointer(in.ResourceGroupLocation))
+	out.NetworkResourceGroup = (*ResourceGroup)(unsafe.Pointer(in.NetworkResourceGroup))
+	if err := Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.Identity = (*IdentityConfig)(unsafe.Pointer(in.Identity))
+	out.Zoned = in.Zoned
 	return nil
 }
 
-// Convert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in *CloudProfileConfig, out *azure.CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudProfileConfig_To_azure_CloudProfileConfig(in, out, s)
+// Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
+func Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azure.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
 }
 
-func autoConvert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	out.CountUpdateDomains = *(*[]DomainCount)(unsafe.Pointer(&in.CountUpdateDomains))
-	out.CountFaultDomains = *(*[]DomainCount)(unsafe.Pointer(&in.CountFaultDomains))
-	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
-	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
-	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+func autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.ManagedItems = *(*[]azure.AzureResource)(unsafe.Pointer(&in.ManagedItems))
 	return nil
 }
 
-// Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
-func Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+// Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in, out, s)
 }
 
-func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
-	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.Storage = (*azure.Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.ManagedItems = *(*[]AzureResource)(unsafe.Pointer(&in.ManagedItems))
 	return nil
 }
 
-// Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in, out, s)
+// Convert_azure_InfrastructureState_To_v1alpha1_InfrastructureState is an autogenerated conversion function.
+func Convert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
+	return autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in, out, s)
 }
 
-func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in *InfrastructureStatus, out *azure.InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(&in.ResourceGroup, &out.ResourceGroup, s); err != nil {
+		return err
+	}
+	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
+	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Identity = (*azure.IdentityStatus)(unsafe.Pointer(in.Identity))
+	out.Zoned = in.Zoned
 	return nil
 }
 
-// Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+// Convert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in *InfrastructureStatus, out *azure.InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
+func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azure.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	if err := Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(&in.ResourceGroup, &out.ResourceGroup, s); err != nil {
+		return err
+	}
+	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
+	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Identity = (*IdentityStatus)(unsafe.Pointer(in.Identity))
+	out.Zoned = in.Zoned
+	return nil
+}
+
+// Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
+func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azure.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_InternalLoadBalancerConfig_To_azure_InternalLoadBalancerConfig(in *InternalLoadBalancerConfig, out *azure.InternalLoadBalancerConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_InternalLoadBalancerConfig_To_azure_InternalLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InternalLoadBalancerConfig_To_azure_InternalLoadBalancerConfig(in *InternalLoadBalancerConfig, out *azure.InternalLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InternalLoadBalancerConfig_To_azure_InternalLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_azure_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in *azure.InternalLoadBalancerConfig, out *InternalLoadBalancerConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_azure_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in *azure.InternalLoadBalancerConfig, out *InternalLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in *KubeletConfig, out *azure.KubeletConfig, s conversion.Scope) error {
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
+	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
+	return nil
+}
+
+// Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in *KubeletConfig, out *azure.KubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in, out, s)
+}
+
+func autoConvert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in *azure.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.SystemReserved = *(*map[string]string)(unsafe.Pointer(&in.SystemReserved))
+	out.KubeReserved = *(*map[string]string)(unsafe.Pointer(&in.KubeReserved))
+	return nil
+}
+
+// Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig is an autogenerated conversion function.
+func Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in *azure.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	return autoConvert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	out.OutboundRules = (*azure.OutboundRules)(unsafe.Pointer(in.OutboundRules))
+	out.GatewayLoadBalancer = (*azure.GatewayLoadBalancer)(unsafe.Pointer(in.GatewayLoadBalancer))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.OutboundRules = (*OutboundRules)(unsafe.Pointer(in.OutboundRules))
+	out.GatewayLoadBalancer = (*GatewayLoadBalancer)(unsafe.Pointer(in.GatewayLoadBalancer))
+	return nil
+}
+
+// Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	if err := Convert_v1alpha1_Image_To_azure_Image(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineImage_To_azure_MachineImage is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in, out, s)
+}
+
+func autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineImage, out *MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	if err := Convert_azure_Image_To_v1alpha1_Image(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_azure_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
+func Convert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineImage, out *MachineImage, s conversion.Scope) error {
+	return autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *MachineImageVersion, out *azure.MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.OperatingSystem = (*azure.OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *MachineImageVersion, out *azure.MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *azure.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.OperatingSystem = (*OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
+	return nil
+}
+
+// Convert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
+func Convert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *azure.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImages_To_azure_MachineImages(in *MachineImages, out *azure.MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]azure.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImages_To_azure_MachineImages is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImages_To_azure_MachineImages(in *MachineImages, out *azure.MachineImages, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImages_To_azure_MachineImages(in, out, s)
+}
+
+func autoConvert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImages, out *MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_azure_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
+func Convert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImages, out *MachineImages, s conversion.Scope) error {
+	return autoConvert_azure_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
-	out.ImageRef = (*azure.Image)(unsafe.Pointer(in.ImageRef))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	return nil
 }
 
-// Convert_v1alpha1_DataVolume_To_azure_DataVolume is an autogenerated conversion function.
-func Convert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in, out, s)
+// Convert_v1alpha1_MachineType_To_azure_MachineType is an autogenerated conversion function.
+func Convert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineType_To_azure_MachineType(in, out, s)
 }
 
-func autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
+func autoConvert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
 	out.Name = in.Name
-	out.ImageRef = (*Image)(unsafe.Pointer(in.ImageRef))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	return nil
 }
 
-// Convert_azure_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
-func Convert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
-	return autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+// Convert_azure_MachineType_To_v1alpha1_MachineType is an autogenerated conversion function.
+func Convert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
+	return autoConvert_azure_MachineType_To_v1alpha1_MachineType(in, out, s)
 }
 
-func autoConvert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in *DiagnosticsProfile, out *azure.DiagnosticsProfile, s conversion.Scope) error {
+func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.IPAddresses = *(*[]azure.PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPDNSLabelTemplate = (*string)(unsafe.Pointer(in.PublicIPDNSLabelTemplate))
+	out.PublicIPPrefix = (*azure.PublicIPPrefixReference)(unsafe.Pointer(in.PublicIPPrefix))
 	return nil
 }
 
-// Convert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile is an autogenerated conversion function.
-func Convert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in *DiagnosticsProfile, out *azure.DiagnosticsProfile, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DiagnosticsProfile_To_azure_DiagnosticsProfile(in, out, s)
+// Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in, out, s)
 }
 
-func autoConvert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in *azure.DiagnosticsProfile, out *DiagnosticsProfile, s conversion.Scope) error {
+func autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.IPAddresses = *(*[]PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPDNSLabelTemplate = (*string)(unsafe.Pointer(in.PublicIPDNSLabelTemplate))
+	out.PublicIPPrefix = (*PublicIPPrefixReference)(unsafe.Pointer(in.PublicIPPrefix))
 	return nil
 }
 
-// Convert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile is an autogenerated conversion function.
-func Convert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in *azure.DiagnosticsProfile, out *DiagnosticsProfile, s conversion.Scope) error {
-	return autoConvert_azure_DiagnosticsProfile_To_v1alpha1_DiagnosticsProfile(in, out, s)
+// Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig is an autogenerated conversion function.
+func Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_DomainCount_To_azure_DomainCount(in *DomainCount, out *azure.DomainCount, s conversion.Scope) error {
-	out.Region = in.Region
-	out.Count = in.Count
+func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VNet_To_azure_VNet(&in.VNet, &out.VNet, s); err != nil {
+		return err
+	}
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.ServiceEndpointPolicies = *(*[]string)(unsafe.Pointer(&in.ServiceEndpointPolicies))
+	out.PrivateEndpointNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateEndpointNetworkPolicies))
+	out.PrivateLinkServiceNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateLinkServiceNetworkPolicies))
+	out.Zones = *(*[]azure.Zone)(unsafe.Pointer(&in.Zones))
+	out.Pods = (*azure.PodSubnetConfig)(unsafe.Pointer(in.Pods))
+	out.FlowLogs = (*azure.FlowLogsConfig)(unsafe.Pointer(in.FlowLogs))
+	out.AdditionalSubnets = *(*[]azure.AdditionalSubnet)(unsafe.Pointer(&in.AdditionalSubnets))
+	out.PrivateLink = (*azure.PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.InternalLoadBalancer = (*azure.InternalLoadBalancerConfig)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.GatewaySubnet = (*azure.GatewaySubnetConfig)(unsafe.Pointer(in.GatewaySubnet))
+	out.RouteServerSubnet = (*azure.RouteServerSubnetConfig)(unsafe.Pointer(in.RouteServerSubnet))
+	out.PrivateDNSZone = (*azure.PrivateDNSZoneConfig)(unsafe.Pointer(in.PrivateDNSZone))
+	out.OutboundLoadBalancer = (*azure.OutboundLoadBalancerConfig)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.NetworkWatcher = (*azure.NetworkWatcherConfig)(unsafe.Pointer(in.NetworkWatcher))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.SecurityGroup = (*azure.SecurityGroupReference)(unsafe.Pointer(in.SecurityGroup))
 	return nil
 }
 
-// Convert_v1alpha1_DomainCount_To_azure_DomainCount is an autogenerated conversion function.
-func Convert_v1alpha1_DomainCount_To_azure_DomainCount(in *DomainCount, out *azure.DomainCount, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DomainCount_To_azure_DomainCount(in, out, s)
+// Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in, out, s)
 }
 
-func autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, out *DomainCount, s conversion.Scope) error {
-	out.Region = in.Region
-	out.Count = in.Count
+func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	if err := Convert_azure_VNet_To_v1alpha1_VNet(&in.VNet, &out.VNet, s); err != nil {
+		return err
+	}
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.ServiceEndpointPolicies = *(*[]string)(unsafe.Pointer(&in.ServiceEndpointPolicies))
+	out.PrivateEndpointNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateEndpointNetworkPolicies))
+	out.PrivateLinkServiceNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateLinkServiceNetworkPolicies))
+	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.Pods = (*PodSubnetConfig)(unsafe.Pointer(in.Pods))
+	out.FlowLogs = (*FlowLogsConfig)(unsafe.Pointer(in.FlowLogs))
+	out.AdditionalSubnets = *(*[]AdditionalSubnet)(unsafe.Pointer(&in.AdditionalSubnets))
+	out.PrivateLink = (*PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.InternalLoadBalancer = (*InternalLoadBalancerConfig)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.GatewaySubnet = (*GatewaySubnetConfig)(unsafe.Pointer(in.GatewaySubnet))
+	out.RouteServerSubnet = (*RouteServerSubnetConfig)(unsafe.Pointer(in.RouteServerSubnet))
+	out.PrivateDNSZone = (*PrivateDNSZoneConfig)(unsafe.Pointer(in.PrivateDNSZone))
+	out.OutboundLoadBalancer = (*OutboundLoadBalancerConfig)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.NetworkWatcher = (*NetworkWatcherConfig)(unsafe.Pointer(in.NetworkWatcher))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.SecurityGroup = (*SecurityGroupReference)(unsafe.Pointer(in.SecurityGroup))
 	return nil
 }
 
-// Convert_azure_DomainCount_To_v1alpha1_DomainCount is an autogenerated conversion function.
-func Convert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, out *DomainCount, s conversion.Scope) error {
-	return autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in, out, s)
+// Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig is an autogenerated conversion function.
+func Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	return autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
-	out.Name = in.Name
-	out.ResourceGroup = in.ResourceGroup
-	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus, out *azure.NetworkStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(&in.VNet, &out.VNet, s); err != nil {
+		return err
+	}
+	out.Subnets = *(*[]azure.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.Layout = azure.NetworkLayout(in.Layout)
+	out.OutboundAccessType = azure.OutboundAccessType(in.OutboundAccessType)
+	out.PrivateDNSZone = (*azure.PrivateDNSZoneStatus)(unsafe.Pointer(in.PrivateDNSZone))
+	out.OutboundLoadBalancer = (*azure.OutboundLoadBalancerStatus)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.NetworkWatcher = (*azure.NetworkWatcherStatus)(unsafe.Pointer(in.NetworkWatcher))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
-// Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig is an autogenerated conversion function.
-func Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in, out, s)
+// Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus, out *azure.NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in, out, s)
 }
 
-func autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
-	out.Name = in.Name
-	out.ResourceGroup = in.ResourceGroup
-	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+func autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	if err := Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(&in.VNet, &out.VNet, s); err != nil {
+		return err
+	}
+	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.Layout = NetworkLayout(in.Layout)
+	out.OutboundAccessType = OutboundAccessType(in.OutboundAccessType)
+	out.PrivateDNSZone = (*PrivateDNSZoneStatus)(unsafe.Pointer(in.PrivateDNSZone))
+	out.OutboundLoadBalancer = (*OutboundLoadBalancerStatus)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.NetworkWatcher = (*NetworkWatcherStatus)(unsafe.Pointer(in.NetworkWatcher))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
-// Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig is an autogenerated conversion function.
-func Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityConfig, out *IdentityConfig, s conversion.Scope) error {
-	return autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in, out, s)
+// Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
+func Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
-	out.ID = in.ID
-	out.ClientID = in.ClientID
-	out.ACRAccess = in.ACRAccess
+func autoConvert_v1alpha1_NetworkWatcherConfig_To_azure_NetworkWatcherConfig(in *NetworkWatcherConfig, out *azure.NetworkWatcherConfig, s conversion.Scope) error {
+	out.Create = (*bool)(unsafe.Pointer(in.Create))
 	return nil
 }
 
-// Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus is an autogenerated conversion function.
-func Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in, out, s)
+// Convert_v1alpha1_NetworkWatcherConfig_To_azure_NetworkWatcherConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkWatcherConfig_To_azure_NetworkWatcherConfig(in *NetworkWatcherConfig, out *azure.NetworkWatcherConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkWatcherConfig_To_azure_NetworkWatcherConfig(in, out, s)
 }
 
-func autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
-	out.ID = in.ID
-	out.ClientID = in.ClientID
-	out.ACRAccess = in.ACRAccess
+func autoConvert_azure_NetworkWatcherConfig_To_v1alpha1_NetworkWatcherConfig(in *azure.NetworkWatcherConfig, out *NetworkWatcherConfig, s conversion.Scope) error {
+	out.Create = (*bool)(unsafe.Pointer(in.Create))
 	return nil
 }
 
-// Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus is an autogenerated conversion function.
-func Convert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.IdentityStatus, out *IdentityStatus, s conversion.Scope) error {
-	return autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in, out, s)
+// Convert_azure_NetworkWatcherConfig_To_v1alpha1_NetworkWatcherConfig is an autogenerated conversion function.
+func Convert_azure_NetworkWatcherConfig_To_v1alpha1_NetworkWatcherConfig(in *azure.NetworkWatcherConfig, out *NetworkWatcherConfig, s conversion.Scope) error {
+	return autoConvert_azure_NetworkWatcherConfig_To_v1alpha1_NetworkWatcherConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_Image_To_azure_Image(in *Image, out *azure.Image, s conversion.Scope) error {
-	out.URN = (*string)(unsafe.Pointer(in.URN))
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
-	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+func autoConvert_v1alpha1_NetworkWatcherStatus_To_azure_NetworkWatcherStatus(in *NetworkWatcherStatus, out *azure.NetworkWatcherStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
-// Convert_v1alpha1_Image_To_azure_Image is an autogenerated conversion function.
-func Convert_v1alpha1_Image_To_azure_Image(in *Image, out *azure.Image, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Image_To_azure_Image(in, out, s)
+// Convert_v1alpha1_NetworkWatcherStatus_To_azure_NetworkWatcherStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkWatcherStatus_To_azure_NetworkWatcherStatus(in *NetworkWatcherStatus, out *azure.NetworkWatcherStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkWatcherStatus_To_azure_NetworkWatcherStatus(in, out, s)
 }
 
-func autoConvert_azure_Image_To_v1alpha1_Image(in *azure.Image, out *Image, s conversion.Scope) error {
-	out.URN = (*string)(unsafe.Pointer(in.URN))
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
-	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+func autoConvert_azure_NetworkWatcherStatus_To_v1alpha1_NetworkWatcherStatus(in *azure.NetworkWatcherStatus, out *NetworkWatcherStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
-// Convert_azure_Image_To_v1alpha1_Image is an autogenerated conversion function.
-func Convert_azure_Image_To_v1alpha1_Image(in *azure.Image, out *Image, s conversion.Scope) error {
-	return autoConvert_azure_Image_To_v1alpha1_Image(in, out, s)
+// Convert_azure_NetworkWatcherStatus_To_v1alpha1_NetworkWatcherStatus is an autogenerated conversion function.
+func Convert_azure_NetworkWatcherStatus_To_v1alpha1_NetworkWatcherStatus(in *azure.NetworkWatcherStatus, out *NetworkWatcherStatus, s conversion.Scope) error {
+	return autoConvert_azure_NetworkWatcherStatus_To_v1alpha1_NetworkWatcherStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in *ImmutableConfig, out *azure.ImmutableConfig, s conversion.Scope) error {
-	out.RetentionType = azure.RetentionType(in.RetentionType)
-	out.RetentionPeriod = in.RetentionPeriod
-	out.Locked = in.Locked
+func autoConvert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in *OutboundLoadBalancerConfig, out *azure.OutboundLoadBalancerConfig, s conversion.Scope) error {
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutMinutes))
 	return nil
 }
 
-// Convert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig is an autogenerated conversion function.
-func Convert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in *ImmutableConfig, out *azure.ImmutableConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ImmutableConfig_To_azure_ImmutableConfig(in, out, s)
+// Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in *OutboundLoadBalancerConfig, out *azure.OutboundLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in, out, s)
 }
 
-func autoConvert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *azure.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
-	out.RetentionType = RetentionType(in.RetentionType)
-	out.RetentionPeriod = in.RetentionPeriod
-	out.Locked = in.Locked
+func autoConvert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in *azure.OutboundLoadBalancerConfig, out *OutboundLoadBalancerConfig, s conversion.Scope) error {
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutMinutes))
 	return nil
 }
 
-// Convert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig is an autogenerated conversion function.
-func Convert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *azure.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
-	return autoConvert_azure_ImmutableConfig_To_v1alpha1_ImmutableConfig(in, out, s)
+// Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in *azure.OutboundLoadBalancerConfig, out *OutboundLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
-	out.ResourceGroup = (*azure.ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
-	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.Identity = (*azure.IdentityConfig)(unsafe.Pointer(in.Identity))
-	out.Zoned = in.Zoned
+func autoConvert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in *OutboundLoadBalancerStatus, out *azure.OutboundLoadBalancerStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.BackendAddressPoolID = in.BackendAddressPoolID
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in, out, s)
+// Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in *OutboundLoadBalancerStatus, out *azure.OutboundLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in, out, s)
 }
 
-func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azure.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	out.ResourceGroup = (*ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
-	if err := Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.Identity = (*IdentityConfig)(unsafe.Pointer(in.Identity))
-	out.Zoned = in.Zoned
+func autoConvert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in *azure.OutboundLoadBalancerStatus, out *OutboundLoadBalancerStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.BackendAddressPoolID = in.BackendAddressPoolID
 	return nil
 }
 
-// Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
-func Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azure.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
+// Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus is an autogenerated conversion function.
+func Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in *azure.OutboundLoadBalancerStatus, out *OutboundLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.ManagedItems = *(*[]azure.AzureResource)(unsafe.Pointer(&in.ManagedItems))
+func autoConvert_v1alpha1_OutboundRuleAllocation_To_azure_OutboundRuleAllocation(in *OutboundRuleAllocation, out *azure.OutboundRuleAllocation, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = in.AllocatedOutboundPorts
+	out.IdleTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutMinutes))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in, out, s)
+// Convert_v1alpha1_OutboundRuleAllocation_To_azure_OutboundRuleAllocation is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundRuleAllocation_To_azure_OutboundRuleAllocation(in *OutboundRuleAllocation, out *azure.OutboundRuleAllocation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundRuleAllocation_To_azure_OutboundRuleAllocation(in, out, s)
 }
 
-func autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.ManagedItems = *(*[]AzureResource)(unsafe.Pointer(&in.ManagedItems))
+func autoConvert_azure_OutboundRuleAllocation_To_v1alpha1_OutboundRuleAllocation(in *azure.OutboundRuleAllocation, out *OutboundRuleAllocation, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = in.AllocatedOutboundPorts
+	out.IdleTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutMinutes))
 	return nil
 }
 
-// Convert_azure_InfrastructureState_To_v1alpha1_InfrastructureState is an autogenerated conversion function.
-func Convert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
-	return autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in, out, s)
+// Convert_azure_OutboundRuleAllocation_To_v1alpha1_OutboundRuleAllocation is an autogenerated conversion function.
+func Convert_azure_OutboundRuleAllocation_To_v1alpha1_OutboundRuleAllocation(in *azure.OutboundRuleAllocation, out *OutboundRuleAllocation, s conversion.Scope) error {
+	return autoConvert_azure_OutboundRuleAllocation_To_v1alpha1_OutboundRuleAllocation(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in *InfrastructureStatus, out *azure.InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(&in.ResourceGroup, &out.ResourceGroup, s); err != nil {
-		return err
-	}
-	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
-	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
-	out.Identity = (*azure.IdentityStatus)(unsafe.Pointer(in.Identity))
-	out.Zoned = in.Zoned
+func autoConvert_v1alpha1_OutboundRules_To_azure_OutboundRules(in *OutboundRules, out *azure.OutboundRules, s conversion.Scope) error {
+	out.FrontendIPCount = (*int32)(unsafe.Pointer(in.FrontendIPCount))
+	out.TCP = (*azure.OutboundRuleAllocation)(unsafe.Pointer(in.TCP))
+	out.UDP = (*azure.OutboundRuleAllocation)(unsafe.Pointer(in.UDP))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in *InfrastructureStatus, out *azure.InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in, out, s)
+// Convert_v1alpha1_OutboundRules_To_azure_OutboundRules is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundRules_To_azure_OutboundRules(in *OutboundRules, out *azure.OutboundRules, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundRules_To_azure_OutboundRules(in, out, s)
 }
 
-func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azure.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	if err := Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(&in.ResourceGroup, &out.ResourceGroup, s); err != nil {
-		return err
-	}
-	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
-	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
-	out.Identity = (*IdentityStatus)(unsafe.Pointer(in.Identity))
-	out.Zoned = in.Zoned
+func autoConvert_azure_OutboundRules_To_v1alpha1_OutboundRules(in *azure.OutboundRules, out *OutboundRules, s conversion.Scope) error {
+	out.FrontendIPCount = (*int32)(unsafe.Pointer(in.FrontendIPCount))
+	out.TCP = (*OutboundRuleAllocation)(unsafe.Pointer(in.TCP))
+	out.UDP = (*OutboundRuleAllocation)(unsafe.Pointer(in.UDP))
 	return nil
 }
 
-// Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
-func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azure.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+// Convert_azure_OutboundRules_To_v1alpha1_OutboundRules is an autogenerated conversion function.
+func Convert_azure_OutboundRules_To_v1alpha1_OutboundRules(in *azure.OutboundRules, out *OutboundRules, s conversion.Scope) error {
+	return autoConvert_azure_OutboundRules_To_v1alpha1_OutboundRules(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Version = in.Version
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
-	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
-	if err := Convert_v1alpha1_Image_To_azure_Image(&in.Image, &out.Image, s); err != nil {
-		return err
-	}
+func autoConvert_v1alpha1_OutboundRulesStatus_To_azure_OutboundRulesStatus(in *OutboundRulesStatus, out *azure.OutboundRulesStatus, s conversion.Scope) error {
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.PublicIPAddressNames = *(*[]string)(unsafe.Pointer(&in.PublicIPAddressNames))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImage_To_azure_MachineImage is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in, out, s)
+// Convert_v1alpha1_OutboundRulesStatus_To_azure_OutboundRulesStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundRulesStatus_To_azure_OutboundRulesStatus(in *OutboundRulesStatus, out *azure.OutboundRulesStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundRulesStatus_To_azure_OutboundRulesStatus(in, out, s)
 }
 
-func autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineImage, out *MachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Version = in.Version
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
-	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
-	if err := Convert_azure_Image_To_v1alpha1_Image(&in.Image, &out.Image, s); err != nil {
-		return err
-	}
+func autoConvert_azure_OutboundRulesStatus_To_v1alpha1_OutboundRulesStatus(in *azure.OutboundRulesStatus, out *OutboundRulesStatus, s conversion.Scope) error {
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.PublicIPAddressNames = *(*[]string)(unsafe.Pointer(&in.PublicIPAddressNames))
 	return nil
 }
-
-// Convert_azure_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
-func Convert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineImage, out *MachineImage, s conversion.Scope) error {
-	return autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in, out, s)
-}
-
-func autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *MachineImageVersion, out *azure.MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.URN = (*string)(unsafe.Pointer(in.URN))
-	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
-	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+
+// Convert_azure_OutboundRulesStatus_To_v1alpha1_OutboundRulesStatus is an autogenerated conversion function.
+func Convert_azure_OutboundRulesStatus_To_v1alpha1_OutboundRulesStatus(in *azure.OutboundRulesStatus, out *OutboundRulesStatus, s conversion.Scope) error {
+	return autoConvert_azure_OutboundRulesStatus_To_v1alpha1_OutboundRulesStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in *PodSubnetConfig, out *azure.PodSubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.Delegation = (*string)(unsafe.Pointer(in.Delegation))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *MachineImageVersion, out *azure.MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in, out, s)
+// Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in *PodSubnetConfig, out *azure.PodSubnetConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in, out, s)
 }
 
-func autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *azure.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.URN = (*string)(unsafe.Pointer(in.URN))
-	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
-	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in *azure.PodSubnetConfig, out *PodSubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.Delegation = (*string)(unsafe.Pointer(in.Delegation))
 	return nil
 }
 
-// Convert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
-func Convert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *azure.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+// Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig is an autogenerated conversion function.
+func Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in *azure.PodSubnetConfig, out *PodSubnetConfig, s conversion.Scope) error {
+	return autoConvert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImages_To_azure_MachineImages(in *MachineImages, out *azure.MachineImages, s conversion.Scope) error {
+func autoConvert_v1alpha1_PrivateDNSZoneConfig_To_azure_PrivateDNSZoneConfig(in *PrivateDNSZoneConfig, out *azure.PrivateDNSZoneConfig, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Versions = *(*[]azure.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	out.RegistrationEnabled = in.RegistrationEnabled
 	return nil
 }
 
-// Convert_v1alpha1_MachineImages_To_azure_MachineImages is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImages_To_azure_MachineImages(in *MachineImages, out *azure.MachineImages, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImages_To_azure_MachineImages(in, out, s)
+// Convert_v1alpha1_PrivateDNSZoneConfig_To_azure_PrivateDNSZoneConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateDNSZoneConfig_To_azure_PrivateDNSZoneConfig(in *PrivateDNSZoneConfig, out *azure.PrivateDNSZoneConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateDNSZoneConfig_To_azure_PrivateDNSZoneConfig(in, out, s)
 }
 
-func autoConvert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImages, out *MachineImages, s conversion.Scope) error {
+func autoConvert_azure_PrivateDNSZoneConfig_To_v1alpha1_PrivateDNSZoneConfig(in *azure.PrivateDNSZoneConfig, out *PrivateDNSZoneConfig, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	out.RegistrationEnabled = in.RegistrationEnabled
 	return nil
 }
 
-// Convert_azure_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
-func Convert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImages, out *MachineImages, s conversion.Scope) error {
-	return autoConvert_azure_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+// Convert_azure_PrivateDNSZoneConfig_To_v1alpha1_PrivateDNSZoneConfig is an autogenerated conversion function.
+func Convert_azure_PrivateDNSZoneConfig_To_v1alpha1_PrivateDNSZoneConfig(in *azure.PrivateDNSZoneConfig, out *PrivateDNSZoneConfig, s conversion.Scope) error {
+	return autoConvert_azure_PrivateDNSZoneConfig_To_v1alpha1_PrivateDNSZoneConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
+func autoConvert_v1alpha1_PrivateDNSZoneStatus_To_azure_PrivateDNSZoneStatus(in *PrivateDNSZoneStatus, out *azure.PrivateDNSZoneStatus, s conversion.Scope) error {
 	out.Name = in.Name
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.ID = in.ID
 	return nil
 }
 
-// Convert_v1alpha1_MachineType_To_azure_MachineType is an autogenerated conversion function.
-func Convert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineType_To_azure_MachineType(in, out, s)
+// Convert_v1alpha1_PrivateDNSZoneStatus_To_azure_PrivateDNSZoneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateDNSZoneStatus_To_azure_PrivateDNSZoneStatus(in *PrivateDNSZoneStatus, out *azure.PrivateDNSZoneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateDNSZoneStatus_To_azure_PrivateDNSZoneStatus(in, out, s)
 }
 
-func autoConvert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
+func autoConvert_azure_PrivateDNSZoneStatus_To_v1alpha1_PrivateDNSZoneStatus(in *azure.PrivateDNSZoneStatus, out *PrivateDNSZoneStatus, s conversion.Scope) error {
 	out.Name = in.Name
-	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.ID = in.ID
 	return nil
 }
 
-// Convert_azure_MachineType_To_v1alpha1_MachineType is an autogenerated conversion function.
-func Convert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
-	return autoConvert_azure_MachineType_To_v1alpha1_MachineType(in, out, s)
+// Convert_azure_PrivateDNSZoneStatus_To_v1alpha1_PrivateDNSZoneStatus is an autogenerated conversion function.
+func Convert_azure_PrivateDNSZoneStatus_To_v1alpha1_PrivateDNSZoneStatus(in *azure.PrivateDNSZoneStatus, out *PrivateDNSZoneStatus, s conversion.Scope) error {
+	return autoConvert_azure_PrivateDNSZoneStatus_To_v1alpha1_PrivateDNSZoneStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
-	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
-	out.IPAddresses = *(*[]azure.PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+func autoConvert_v1alpha1_PrivateLinkConfig_To_azure_PrivateLinkConfig(in *PrivateLinkConfig, out *azure.PrivateLinkConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.EndpointSubnetID = in.EndpointSubnetID
 	return nil
 }
 
-// Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig is an autogenerated conversion function.
-func Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in, out, s)
+// Convert_v1alpha1_PrivateLinkConfig_To_azure_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateLinkConfig_To_azure_PrivateLinkConfig(in *PrivateLinkConfig, out *azure.PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateLinkConfig_To_azure_PrivateLinkConfig(in, out, s)
 }
 
-func autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
-	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
-	out.IPAddresses = *(*[]PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+func autoConvert_azure_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *azure.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.EndpointSubnetID = in.EndpointSubnetID
 	return nil
 }
 
-// Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig is an autogenerated conversion function.
-func Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
-	return autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in, out, s)
+// Convert_azure_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_azure_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *azure.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_azure_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
-	if err := Convert_v1alpha1_VNet_To_azure_VNet(&in.VNet, &out.VNet, s); err != nil {
-		return err
-	}
-	out.Workers = (*string)(unsafe.Pointer(in.Workers))
-	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
-	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
-	out.Zones = *(*[]azure.Zone)(unsafe.Pointer(&in.Zones))
+func autoConvert_v1alpha1_PrivateLinkStatus_To_azure_PrivateLinkStatus(in *PrivateLinkStatus, out *azure.PrivateLinkStatus, s conversion.Scope) error {
+	out.ServiceName = in.ServiceName
+	out.SubnetName = in.SubnetName
+	out.EndpointID = (*string)(unsafe.Pointer(in.EndpointID))
+	out.EndpointIPAddresses = *(*[]string)(unsafe.Pointer(&in.EndpointIPAddresses))
+	out.ConnectionState = (*string)(unsafe.Pointer(in.ConnectionState))
 	return nil
 }
 
-// Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig is an autogenerated conversion function.
-func Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in, out, s)
+// Convert_v1alpha1_PrivateLinkStatus_To_azure_PrivateLinkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateLinkStatus_To_azure_PrivateLinkStatus(in *PrivateLinkStatus, out *azure.PrivateLinkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateLinkStatus_To_azure_PrivateLinkStatus(in, out, s)
 }
 
-func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
-	if err := Convert_azure_VNet_To_v1alpha1_VNet(&in.VNet, &out.VNet, s); err != nil {
-		return err
-	}
-	out.Workers = (*string)(unsafe.Pointer(in.Workers))
-	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
-	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
-	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+func autoConvert_azure_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in *azure.PrivateLinkStatus, out *PrivateLinkStatus, s conversion.Scope) error {
+	out.ServiceName = in.ServiceName
+	out.SubnetName = in.SubnetName
+	out.EndpointID = (*string)(unsafe.Pointer(in.EndpointID))
+	out.EndpointIPAddresses = *(*[]string)(unsafe.Pointer(&in.EndpointIPAddresses))
+	out.ConnectionState = (*string)(unsafe.Pointer(in.ConnectionState))
 	return nil
 }
 
-// Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig is an autogenerated conversion function.
-func Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
-	return autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
+// Convert_azure_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus is an autogenerated conversion function.
+func Convert_azure_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in *azure.PrivateLinkStatus, out *PrivateLinkStatus, s conversion.Scope) error {
+	return autoConvert_azure_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus, out *azure.NetworkStatus, s conversion.Scope) error {
-	if err := Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(&in.VNet, &out.VNet, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]azure.Subnet)(unsafe.Pointer(&in.Subnets))
-	out.Layout = azure.NetworkLayout(in.Layout)
-	out.OutboundAccessType = azure.OutboundAccessType(in.OutboundAccessType)
+func autoConvert_v1alpha1_PublicAccessConfig_To_azure_PublicAccessConfig(in *PublicAccessConfig, out *azure.PublicAccessConfig, s conversion.Scope) error {
+	out.Mode = azure.PublicAccessMode(in.Mode)
 	return nil
 }
 
-// Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus is an autogenerated conversion function.
-func Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus, out *azure.NetworkStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in, out, s)
+// Convert_v1alpha1_PublicAccessConfig_To_azure_PublicAccessConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PublicAccessConfig_To_azure_PublicAccessConfig(in *PublicAccessConfig, out *azure.PublicAccessConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicAccessConfig_To_azure_PublicAccessConfig(in, out, s)
 }
 
-func autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	if err := Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(&in.VNet, &out.VNet, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
-	out.Layout = NetworkLayout(in.Layout)
-	out.OutboundAccessType = OutboundAccessType(in.OutboundAccessType)
+func autoConvert_azure_PublicAccessConfig_To_v1alpha1_PublicAccessConfig(in *azure.PublicAccessConfig, out *PublicAccessConfig, s conversion.Scope) error {
+	out.Mode = PublicAccessMode(in.Mode)
 	return nil
 }
 
-// Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
-func Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	return autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
+// Convert_azure_PublicAccessConfig_To_v1alpha1_PublicAccessConfig is an autogenerated conversion function.
+func Convert_azure_PublicAccessConfig_To_v1alpha1_PublicAccessConfig(in *azure.PublicAccessConfig, out *PublicAccessConfig, s conversion.Scope) error {
+	return autoConvert_azure_PublicAccessConfig_To_v1alpha1_PublicAccessConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPPrefixReference_To_azure_PublicIPPrefixReference(in *PublicIPPrefixReference, out *azure.PublicIPPrefixReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPPrefixReference_To_azure_PublicIPPrefixReference is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPPrefixReference_To_azure_PublicIPPrefixReference(in *PublicIPPrefixReference, out *azure.PublicIPPrefixReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPPrefixReference_To_azure_PublicIPPrefixReference(in, out, s)
+}
+
+func autoConvert_azure_PublicIPPrefixReference_To_v1alpha1_PublicIPPrefixReference(in *azure.PublicIPPrefixReference, out *PublicIPPrefixReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_azure_PublicIPPrefixReference_To_v1alpha1_PublicIPPrefixReference is an autogenerated conversion function.
+func Convert_azure_PublicIPPrefixReference_To_v1alpha1_PublicIPPrefixReference(in *azure.PublicIPPrefixReference, out *PublicIPPrefixReference, s conversion.Scope) error {
+	return autoConvert_azure_PublicIPPrefixReference_To_v1alpha1_PublicIPPrefixReference(in, out, s)
 }
 
 func autoConvert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in *PublicIPReference, out *azure.PublicIPReference, s conversion.Scope) error {
@@ -1062,6 +2410,28 @@ func Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in *azure.ResourceGro
 	return autoConvert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollingUpdate_To_azure_RollingUpdate(in *RollingUpdate, out *azure.RollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1alpha1_RollingUpdate_To_azure_RollingUpdate is an autogenerated conversion function.
+func Convert_v1alpha1_RollingUpdate_To_azure_RollingUpdate(in *RollingUpdate, out *azure.RollingUpdate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollingUpdate_To_azure_RollingUpdate(in, out, s)
+}
+
+func autoConvert_azure_RollingUpdate_To_v1alpha1_RollingUpdate(in *azure.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_azure_RollingUpdate_To_v1alpha1_RollingUpdate is an autogenerated conversion function.
+func Convert_azure_RollingUpdate_To_v1alpha1_RollingUpdate(in *azure.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
+	return autoConvert_azure_RollingUpdate_To_v1alpha1_RollingUpdate(in, out, s)
+}
+
 func autoConvert_v1alpha1_RotationConfig_To_azure_RotationConfig(in *RotationConfig, out *azure.RotationConfig, s conversion.Scope) error {
 	out.RotationPeriodDays = in.RotationPeriodDays
 	out.ExpirationPeriodDays = (*int32)(unsafe.Pointer(in.ExpirationPeriodDays))
@@ -1084,6 +2454,26 @@ func Convert_azure_RotationConfig_To_v1alpha1_RotationConfig(in *azure.RotationC
 	return autoConvert_azure_RotationConfig_To_v1alpha1_RotationConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_RouteServerSubnetConfig_To_azure_RouteServerSubnetConfig(in *RouteServerSubnetConfig, out *azure.RouteServerSubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_RouteServerSubnetConfig_To_azure_RouteServerSubnetConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RouteServerSubnetConfig_To_azure_RouteServerSubnetConfig(in *RouteServerSubnetConfig, out *azure.RouteServerSubnetConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteServerSubnetConfig_To_azure_RouteServerSubnetConfig(in, out, s)
+}
+
+func autoConvert_azure_RouteServerSubnetConfig_To_v1alpha1_RouteServerSubnetConfig(in *azure.RouteServerSubnetConfig, out *RouteServerSubnetConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_azure_RouteServerSubnetConfig_To_v1alpha1_RouteServerSubnetConfig is an autogenerated conversion function.
+func Convert_azure_RouteServerSubnetConfig_To_v1alpha1_RouteServerSubnetConfig(in *azure.RouteServerSubnetConfig, out *RouteServerSubnetConfig, s conversion.Scope) error {
+	return autoConvert_azure_RouteServerSubnetConfig_To_v1alpha1_RouteServerSubnetConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_RouteTable_To_azure_RouteTable(in *RouteTable, out *azure.RouteTable, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
@@ -1106,9 +2496,72 @@ func Convert_azure_RouteTable_To_v1alpha1_RouteTable(in *azure.RouteTable, out *
 	return autoConvert_azure_RouteTable_To_v1alpha1_RouteTable(in, out, s)
 }
 
+func autoConvert_v1alpha1_SFTPConfig_To_azure_SFTPConfig(in *SFTPConfig, out *azure.SFTPConfig, s conversion.Scope) error {
+	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
+	return nil
+}
+
+// Convert_v1alpha1_SFTPConfig_To_azure_SFTPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SFTPConfig_To_azure_SFTPConfig(in *SFTPConfig, out *azure.SFTPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SFTPConfig_To_azure_SFTPConfig(in, out, s)
+}
+
+func autoConvert_azure_SFTPConfig_To_v1alpha1_SFTPConfig(in *azure.SFTPConfig, out *SFTPConfig, s conversion.Scope) error {
+	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
+	return nil
+}
+
+// Convert_azure_SFTPConfig_To_v1alpha1_SFTPConfig is an autogenerated conversion function.
+func Convert_azure_SFTPConfig_To_v1alpha1_SFTPConfig(in *azure.SFTPConfig, out *SFTPConfig, s conversion.Scope) error {
+	return autoConvert_azure_SFTPConfig_To_v1alpha1_SFTPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScaleInPolicy_To_azure_ScaleInPolicy(in *ScaleInPolicy, out *azure.ScaleInPolicy, s conversion.Scope) error {
+	out.Rule = (*azure.ScaleInPolicyRule)(unsafe.Pointer(in.Rule))
+	out.ForceDeletion = (*bool)(unsafe.Pointer(in.ForceDeletion))
+	return nil
+}
+
+// Convert_v1alpha1_ScaleInPolicy_To_azure_ScaleInPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ScaleInPolicy_To_azure_ScaleInPolicy(in *ScaleInPolicy, out *azure.ScaleInPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScaleInPolicy_To_azure_ScaleInPolicy(in, out, s)
+}
+
+func autoConvert_azure_ScaleInPolicy_To_v1alpha1_ScaleInPolicy(in *azure.ScaleInPolicy, out *ScaleInPolicy, s conversion.Scope) error {
+	out.Rule = (*ScaleInPolicyRule)(unsafe.Pointer(in.Rule))
+	out.ForceDeletion = (*bool)(unsafe.Pointer(in.ForceDeletion))
+	return nil
+}
+
+// Convert_azure_ScaleInPolicy_To_v1alpha1_ScaleInPolicy is an autogenerated conversion function.
+func Convert_azure_ScaleInPolicy_To_v1alpha1_ScaleInPolicy(in *azure.ScaleInPolicy, out *ScaleInPolicy, s conversion.Scope) error {
+	return autoConvert_azure_ScaleInPolicy_To_v1alpha1_ScaleInPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecondaryStorageAccountConfig_To_azure_SecondaryStorageAccountConfig(in *SecondaryStorageAccountConfig, out *azure.SecondaryStorageAccountConfig, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha1_SecondaryStorageAccountConfig_To_azure_SecondaryStorageAccountConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SecondaryStorageAccountConfig_To_azure_SecondaryStorageAccountConfig(in *SecondaryStorageAccountConfig, out *azure.SecondaryStorageAccountConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecondaryStorageAccountConfig_To_azure_SecondaryStorageAccountConfig(in, out, s)
+}
+
+func autoConvert_azure_SecondaryStorageAccountConfig_To_v1alpha1_SecondaryStorageAccountConfig(in *azure.SecondaryStorageAccountConfig, out *SecondaryStorageAccountConfig, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_azure_SecondaryStorageAccountConfig_To_v1alpha1_SecondaryStorageAccountConfig is an autogenerated conversion function.
+func Convert_azure_SecondaryStorageAccountConfig_To_v1alpha1_SecondaryStorageAccountConfig(in *azure.SecondaryStorageAccountConfig, out *SecondaryStorageAccountConfig, s conversion.Scope) error {
+	return autoConvert_azure_SecondaryStorageAccountConfig_To_v1alpha1_SecondaryStorageAccountConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(in *SecurityGroup, out *azure.SecurityGroup, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
@@ -1120,6 +2573,7 @@ func Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(in *SecurityGroup, ou
 func autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
 	out.Purpose = Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
@@ -1128,9 +2582,32 @@ func Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGro
 	return autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecurityGroupReference_To_azure_SecurityGroupReference(in *SecurityGroupReference, out *azure.SecurityGroupReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupReference_To_azure_SecurityGroupReference is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupReference_To_azure_SecurityGroupReference(in *SecurityGroupReference, out *azure.SecurityGroupReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupReference_To_azure_SecurityGroupReference(in, out, s)
+}
+
+func autoConvert_azure_SecurityGroupReference_To_v1alpha1_SecurityGroupReference(in *azure.SecurityGroupReference, out *SecurityGroupReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_azure_SecurityGroupReference_To_v1alpha1_SecurityGroupReference is an autogenerated conversion function.
+func Convert_azure_SecurityGroupReference_To_v1alpha1_SecurityGroupReference(in *azure.SecurityGroupReference, out *SecurityGroupReference, s conversion.Scope) error {
+	return autoConvert_azure_SecurityGroupReference_To_v1alpha1_SecurityGroupReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.DefaultStorageClassVolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.DefaultStorageClassVolumeBindingMode))
 	return nil
 }
 
@@ -1142,6 +2619,7 @@ func Convert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage,
 func autoConvert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.DefaultStorageClassVolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.DefaultStorageClassVolumeBindingMode))
 	return nil
 }
 
@@ -1150,12 +2628,36 @@ func Convert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage,
 	return autoConvert_azure_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageAccountReference_To_azure_StorageAccountReference(in *StorageAccountReference, out *azure.StorageAccountReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_v1alpha1_StorageAccountReference_To_azure_StorageAccountReference is an autogenerated conversion function.
+func Convert_v1alpha1_StorageAccountReference_To_azure_StorageAccountReference(in *StorageAccountReference, out *azure.StorageAccountReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageAccountReference_To_azure_StorageAccountReference(in, out, s)
+}
+
+func autoConvert_azure_StorageAccountReference_To_v1alpha1_StorageAccountReference(in *azure.StorageAccountReference, out *StorageAccountReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_azure_StorageAccountReference_To_v1alpha1_StorageAccountReference is an autogenerated conversion function.
+func Convert_azure_StorageAccountReference_To_v1alpha1_StorageAccountReference(in *azure.StorageAccountReference, out *StorageAccountReference, s conversion.Scope) error {
+	return autoConvert_azure_StorageAccountReference_To_v1alpha1_StorageAccountReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_azure_Subnet(in *Subnet, out *azure.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
+	out.NatGatewayPublicIPCount = (*int32)(unsafe.Pointer(in.NatGatewayPublicIPCount))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
 	return nil
 }
 
@@ -1170,6 +2672,8 @@ func autoConvert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet,
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
+	out.NatGatewayPublicIPCount = (*int32)(unsafe.Pointer(in.NatGatewayPublicIPCount))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
 	return nil
 }
 
@@ -1178,11 +2682,81 @@ func Convert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet, s co
 	return autoConvert_azure_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
+func autoConvert_v1alpha1_TimeConfig_To_azure_TimeConfig(in *TimeConfig, out *azure.TimeConfig, s conversion.Scope) error {
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.Timezone = (*string)(unsafe.Pointer(in.Timezone))
+	return nil
+}
+
+// Convert_v1alpha1_TimeConfig_To_azure_TimeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TimeConfig_To_azure_TimeConfig(in *TimeConfig, out *azure.TimeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TimeConfig_To_azure_TimeConfig(in, out, s)
+}
+
+func autoConvert_azure_TimeConfig_To_v1alpha1_TimeConfig(in *azure.TimeConfig, out *TimeConfig, s conversion.Scope) error {
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.Timezone = (*string)(unsafe.Pointer(in.Timezone))
+	return nil
+}
+
+// Convert_azure_TimeConfig_To_v1alpha1_TimeConfig is an autogenerated conversion function.
+func Convert_azure_TimeConfig_To_v1alpha1_TimeConfig(in *azure.TimeConfig, out *TimeConfig, s conversion.Scope) error {
+	return autoConvert_azure_TimeConfig_To_v1alpha1_TimeConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in *TrafficAnalyticsConfig, out *azure.TrafficAnalyticsConfig, s conversion.Scope) error {
+	out.WorkspaceResourceID = in.WorkspaceResourceID
+	out.WorkspaceID = in.WorkspaceID
+	out.WorkspaceRegion = in.WorkspaceRegion
+	out.IntervalMinutes = (*int32)(unsafe.Pointer(in.IntervalMinutes))
+	return nil
+}
+
+// Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in *TrafficAnalyticsConfig, out *azure.TrafficAnalyticsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in, out, s)
+}
+
+func autoConvert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in *azure.TrafficAnalyticsConfig, out *TrafficAnalyticsConfig, s conversion.Scope) error {
+	out.WorkspaceResourceID = in.WorkspaceResourceID
+	out.WorkspaceID = in.WorkspaceID
+	out.WorkspaceRegion = in.WorkspaceRegion
+	out.IntervalMinutes = (*int32)(unsafe.Pointer(in.IntervalMinutes))
+	return nil
+}
+
+// Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig is an autogenerated conversion function.
+func Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in *azure.TrafficAnalyticsConfig, out *TrafficAnalyticsConfig, s conversion.Scope) error {
+	return autoConvert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_UserDataEncryption_To_azure_UserDataEncryption(in *UserDataEncryption, out *azure.UserDataEncryption, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	return nil
+}
+
+// Convert_v1alpha1_UserDataEncryption_To_azure_UserDataEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_UserDataEncryption_To_azure_UserDataEncryption(in *UserDataEncryption, out *azure.UserDataEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UserDataEncryption_To_azure_UserDataEncryption(in, out, s)
+}
+
+func autoConvert_azure_UserDataEncryption_To_v1alpha1_UserDataEncryption(in *azure.UserDataEncryption, out *UserDataEncryption, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	return nil
+}
+
+// Convert_azure_UserDataEncryption_To_v1alpha1_UserDataEncryption is an autogenerated conversion function.
+func Convert_azure_UserDataEncryption_To_v1alpha1_UserDataEncryption(in *azure.UserDataEncryption, out *UserDataEncryption, s conversion.Scope) error {
+	return autoConvert_azure_UserDataEncryption_To_v1alpha1_UserDataEncryption(in, out, s)
+}
+
 func autoConvert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
 	out.DDosProtectionPlanID = (*string)(unsafe.Pointer(in.DDosProtectionPlanID))
+	out.Encryption = (*azure.VNetEncryption)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -1195,7 +2769,9 @@ func autoConvert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conver
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
 	out.DDosProtectionPlanID = (*string)(unsafe.Pointer(in.DDosProtectionPlanID))
+	out.Encryption = (*VNetEncryption)(unsafe.Pointer(in.Encryption))
 	return nil
 }
 
@@ -1204,6 +2780,28 @@ func Convert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conversion
 	return autoConvert_azure_VNet_To_v1alpha1_VNet(in, out, s)
 }
 
+func autoConvert_v1alpha1_VNetEncryption_To_azure_VNetEncryption(in *VNetEncryption, out *azure.VNetEncryption, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Enforcement = (*azure.VNetEncryptionEnforcement)(unsafe.Pointer(in.Enforcement))
+	return nil
+}
+
+// Convert_v1alpha1_VNetEncryption_To_azure_VNetEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_VNetEncryption_To_azure_VNetEncryption(in *VNetEncryption, out *azure.VNetEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VNetEncryption_To_azure_VNetEncryption(in, out, s)
+}
+
+func autoConvert_azure_VNetEncryption_To_v1alpha1_VNetEncryption(in *azure.VNetEncryption, out *VNetEncryption, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Enforcement = (*VNetEncryptionEnforcement)(unsafe.Pointer(in.Enforcement))
+	return nil
+}
+
+// Convert_azure_VNetEncryption_To_v1alpha1_VNetEncryption is an autogenerated conversion function.
+func Convert_azure_VNetEncryption_To_v1alpha1_VNetEncryption(in *azure.VNetEncryption, out *VNetEncryption, s conversion.Scope) error {
+	return autoConvert_azure_VNetEncryption_To_v1alpha1_VNetEncryption(in, out, s)
+}
+
 func autoConvert_v1alpha1_VNetStatus_To_azure_VNetStatus(in *VNetStatus, out *azure.VNetStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
@@ -1230,6 +2828,7 @@ func autoConvert_v1alpha1_VmoDependency_To_azure_VmoDependency(in *VmoDependency
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.TagKeys = *(*[]string)(unsafe.Pointer(&in.TagKeys))
 	return nil
 }
 
@@ -1242,6 +2841,7 @@ func autoConvert_azure_VmoDependency_To_v1alpha1_VmoDependency(in *azure.VmoDepe
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.TagKeys = *(*[]string)(unsafe.Pointer(&in.TagKeys))
 	return nil
 }
 
@@ -1275,6 +2875,24 @@ func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, o
 	out.DiagnosticsProfile = (*azure.DiagnosticsProfile)(unsafe.Pointer(in.DiagnosticsProfile))
 	out.Volume = (*azure.Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]azure.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SinglePlacementGroup = (*bool)(unsafe.Pointer(in.SinglePlacementGroup))
+	out.FaultDomainCount = (*int32)(unsafe.Pointer(in.FaultDomainCount))
+	out.ScaleInPolicy = (*azure.ScaleInPolicy)(unsafe.Pointer(in.ScaleInPolicy))
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.EnableIPForwarding = (*bool)(unsafe.Pointer(in.EnableIPForwarding))
+	out.PrePullImages = *(*[]string)(unsafe.Pointer(&in.PrePullImages))
+	out.KubeletConfig = (*azure.KubeletConfig)(unsafe.Pointer(in.KubeletConfig))
+	out.Time = (*azure.TimeConfig)(unsafe.Pointer(in.Time))
+	out.CompressUserData = (*bool)(unsafe.Pointer(in.CompressUserData))
+	out.LicenseType = (*azure.LicenseType)(unsafe.Pointer(in.LicenseType))
+	out.AutomaticRepairs = (*azure.AutomaticRepairs)(unsafe.Pointer(in.AutomaticRepairs))
+	out.HealthProbe = (*azure.HealthProbe)(unsafe.Pointer(in.HealthProbe))
+	out.AzureMonitorAgent = (*azure.AzureMonitorAgent)(unsafe.Pointer(in.AzureMonitorAgent))
+	out.UserDataEncryption = (*azure.UserDataEncryption)(unsafe.Pointer(in.UserDataEncryption))
+	out.MachineImageVersion = (*string)(unsafe.Pointer(in.MachineImageVersion))
+	out.RollingUpdate = (*azure.RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	out.FallbackZones = *(*[]string)(unsafe.Pointer(&in.FallbackZones))
+	out.CapacityPolicy = (*azure.CapacityPolicy)(unsafe.Pointer(in.CapacityPolicy))
 	return nil
 }
 
@@ -1288,6 +2906,24 @@ func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerCon
 	out.DiagnosticsProfile = (*DiagnosticsProfile)(unsafe.Pointer(in.DiagnosticsProfile))
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.SinglePlacementGroup = (*bool)(unsafe.Pointer(in.SinglePlacementGroup))
+	out.FaultDomainCount = (*int32)(unsafe.Pointer(in.FaultDomainCount))
+	out.ScaleInPolicy = (*ScaleInPolicy)(unsafe.Pointer(in.ScaleInPolicy))
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.EnableIPForwarding = (*bool)(unsafe.Pointer(in.EnableIPForwarding))
+	out.PrePullImages = *(*[]string)(unsafe.Pointer(&in.PrePullImages))
+	out.KubeletConfig = (*KubeletConfig)(unsafe.Pointer(in.KubeletConfig))
+	out.Time = (*TimeConfig)(unsafe.Pointer(in.Time))
+	out.CompressUserData = (*bool)(unsafe.Pointer(in.CompressUserData))
+	out.LicenseType = (*LicenseType)(unsafe.Pointer(in.LicenseType))
+	out.AutomaticRepairs = (*AutomaticRepairs)(unsafe.Pointer(in.AutomaticRepairs))
+	out.HealthProbe = (*HealthProbe)(unsafe.Pointer(in.HealthProbe))
+	out.AzureMonitorAgent = (*AzureMonitorAgent)(unsafe.Pointer(in.AzureMonitorAgent))
+	out.UserDataEncryption = (*UserDataEncryption)(unsafe.Pointer(in.UserDataEncryption))
+	out.MachineImageVersion = (*string)(unsafe.Pointer(in.MachineImageVersion))
+	out.RollingUpdate = (*RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	out.FallbackZones = *(*[]string)(unsafe.Pointer(&in.FallbackZones))
+	out.CapacityPolicy = (*CapacityPolicy)(unsafe.Pointer(in.CapacityPolicy))
 	return nil
 }
 
@@ -1296,9 +2932,35 @@ func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig,
 	return autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerPoolFallbackZones_To_azure_WorkerPoolFallbackZones(in *WorkerPoolFallbackZones, out *azure.WorkerPoolFallbackZones, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Demand = in.Demand
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolFallbackZones_To_azure_WorkerPoolFallbackZones is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolFallbackZones_To_azure_WorkerPoolFallbackZones(in *WorkerPoolFallbackZones, out *azure.WorkerPoolFallbackZones, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolFallbackZones_To_azure_WorkerPoolFallbackZones(in, out, s)
+}
+
+func autoConvert_azure_WorkerPoolFallbackZones_To_v1alpha1_WorkerPoolFallbackZones(in *azure.WorkerPoolFallbackZones, out *WorkerPoolFallbackZones, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Demand = in.Demand
+	return nil
+}
+
+// Convert_azure_WorkerPoolFallbackZones_To_v1alpha1_WorkerPoolFallbackZones is an autogenerated conversion function.
+func Convert_azure_WorkerPoolFallbackZones_To_v1alpha1_WorkerPoolFallbackZones(in *azure.WorkerPoolFallbackZones, out *WorkerPoolFallbackZones, s conversion.Scope) error {
+	return autoConvert_azure_WorkerPoolFallbackZones_To_v1alpha1_WorkerPoolFallbackZones(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *azure.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]azure.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.VmoDependencies = *(*[]azure.VmoDependency)(unsafe.Pointer(&in.VmoDependencies))
+	out.FallbackZones = *(*[]azure.WorkerPoolFallbackZones)(unsafe.Pointer(&in.FallbackZones))
+	out.BootDiagnosticsStorageAccounts = *(*[]azure.StorageAccountReference)(unsafe.Pointer(&in.BootDiagnosticsStorageAccounts))
 	return nil
 }
 
@@ -1310,6 +2972,8 @@ func Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *
 func autoConvert_azure_WorkerStatus_To_v1alpha1_WorkerStatus(in *azure.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.VmoDependencies = *(*[]VmoDependency)(unsafe.Pointer(&in.VmoDependencies))
+	out.FallbackZones = *(*[]WorkerPoolFallbackZones)(unsafe.Pointer(&in.FallbackZones))
+	out.BootDiagnosticsStorageAccounts = *(*[]StorageAccountReference)(unsafe.Pointer(&in.BootDiagnosticsStorageAccounts))
 	return nil
 }
 
@@ -1346,6 +3010,9 @@ func autoConvert_v1alpha1_Zone_To_azure_Zone(in *Zone, out *azure.Zone, s conver
 	out.Name = in.Name
 	out.CIDR = in.CIDR
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.ServiceEndpointPolicies = *(*[]string)(unsafe.Pointer(&in.ServiceEndpointPolicies))
+	out.PrivateEndpointNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateEndpointNetworkPolicies))
+	out.PrivateLinkServiceNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateLinkServiceNetworkPolicies))
 	out.NatGateway = (*azure.ZonedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
@@ -1359,6 +3026,9 @@ func autoConvert_azure_Zone_To_v1alpha1_Zone(in *azure.Zone, out *Zone, s conver
 	out.Name = in.Name
 	out.CIDR = in.CIDR
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.ServiceEndpointPolicies = *(*[]string)(unsafe.Pointer(&in.ServiceEndpointPolicies))
+	out.PrivateEndpointNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateEndpointNetworkPolicies))
+	out.PrivateLinkServiceNetworkPolicies = (*string)(unsafe.Pointer(in.PrivateLinkServiceNetworkPolicies))
 	out.NatGateway = (*ZonedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
@@ -1372,6 +3042,9 @@ func autoConvert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(i
 	out.Enabled = in.Enabled
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.IPAddresses = *(*[]azure.ZonedPublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPDNSLabelTemplate = (*string)(unsafe.Pointer(in.PublicIPDNSLabelTemplate))
+	out.PublicIPPrefix = (*azure.PublicIPPrefixReference)(unsafe.Pointer(in.PublicIPPrefix))
 	return nil
 }
 
@@ -1384,6 +3057,9 @@ func autoConvert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(i
 	out.Enabled = in.Enabled
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.IPAddresses = *(*[]ZonedPublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPDNSLabelTemplate = (*string)(unsafe.Pointer(in.PublicIPDNSLabelTemplate))
+	out.PublicIPPrefix = (*PublicIPPrefixReference)(unsafe.Pointer(in.PublicIPPrefix))
 	return nil
 }
 
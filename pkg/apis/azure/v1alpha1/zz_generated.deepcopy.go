@@ -11,9 +11,76 @@ package v1alpha1
 
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalSubnet) DeepCopyInto(out *AdditionalSubnet) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AttachNatGateway != nil {
+		in, out := &in.AttachNatGateway, &out.AttachNatGateway
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalSubnet.
+func (in *AdditionalSubnet) DeepCopy() *AdditionalSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomaticRepairs) DeepCopyInto(out *AutomaticRepairs) {
+	*out = *in
+	if in.GracePeriodMinutes != nil {
+		in, out := &in.GracePeriodMinutes, &out.GracePeriodMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomaticRepairs.
+func (in *AutomaticRepairs) DeepCopy() *AutomaticRepairs {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomaticRepairs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMonitorAgent) DeepCopyInto(out *AzureMonitorAgent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMonitorAgent.
+func (in *AzureMonitorAgent) DeepCopy() *AzureMonitorAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMonitorAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureResource) DeepCopyInto(out *AzureResource) {
 	*out = *in
@@ -49,6 +116,46 @@ func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 		*out = new(RotationConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GeoReplication != nil {
+		in, out := &in.GeoReplication, &out.GeoReplication
+		*out = new(GeoReplicationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiagnosticSettings != nil {
+		in, out := &in.DiagnosticSettings, &out.DiagnosticSettings
+		*out = new(DiagnosticSettingsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageAccountNamePrefix != nil {
+		in, out := &in.StorageAccountNamePrefix, &out.StorageAccountNamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicAccess != nil {
+		in, out := &in.PublicAccess, &out.PublicAccess
+		*out = new(PublicAccessConfig)
+		**out = **in
+	}
+	if in.SecondaryStorageAccount != nil {
+		in, out := &in.SecondaryStorageAccount, &out.SecondaryStorageAccount
+		*out = new(SecondaryStorageAccountConfig)
+		**out = **in
+	}
+	if in.RequireInfrastructureEncryption != nil {
+		in, out := &in.RequireInfrastructureEncryption, &out.RequireInfrastructureEncryption
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChangeFeed != nil {
+		in, out := &in.ChangeFeed, &out.ChangeFeed
+		*out = new(ChangeFeedConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SFTP != nil {
+		in, out := &in.SFTP, &out.SFTP
+		*out = new(SFTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -70,6 +177,74 @@ func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootDiagnosticsRetention) DeepCopyInto(out *BootDiagnosticsRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootDiagnosticsRetention.
+func (in *BootDiagnosticsRetention) DeepCopy() *BootDiagnosticsRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BootDiagnosticsRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSISnapshotControllerConfig) DeepCopyInto(out *CSISnapshotControllerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSISnapshotControllerConfig.
+func (in *CSISnapshotControllerConfig) DeepCopy() *CSISnapshotControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CSISnapshotControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeFeedConfig) DeepCopyInto(out *ChangeFeedConfig) {
+	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeFeedConfig.
+func (in *ChangeFeedConfig) DeepCopy() *ChangeFeedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeFeedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudConfiguration) DeepCopyInto(out *CloudConfiguration) {
 	*out = *in
@@ -96,6 +271,11 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -109,6 +289,27 @@ func (in *CloudControllerManagerConfig) DeepCopy() *CloudControllerManagerConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNodeManagerConfig) DeepCopyInto(out *CloudNodeManagerConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNodeManagerConfig.
+func (in *CloudNodeManagerConfig) DeepCopy() *CloudNodeManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNodeManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 	*out = *in
@@ -172,11 +373,26 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CloudControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CloudNodeManager != nil {
+		in, out := &in.CloudNodeManager, &out.CloudNodeManager
+		*out = new(CloudNodeManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSISnapshotController != nil {
+		in, out := &in.CSISnapshotController, &out.CSISnapshotController
+		*out = new(CSISnapshotControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -199,448 +415,773 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
 	*out = *in
-	if in.ImageRef != nil {
-		in, out := &in.ImageRef, &out.ImageRef
-		*out = new(Image)
+	out.TypeMeta = in.TypeMeta
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLinkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayLoadBalancer != nil {
+		in, out := &in.GatewayLoadBalancer, &out.GatewayLoadBalancer
+		*out = new(GatewayLoadBalancerStatus)
+		**out = **in
+	}
+	if in.OutboundRules != nil {
+		in, out := &in.OutboundRules, &out.OutboundRules
+		*out = new(OutboundRulesStatus)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
-func (in *DataVolume) DeepCopy() *DataVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneStatus.
+func (in *ControlPlaneStatus) DeepCopy() *ControlPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DataVolume)
+	out := new(ControlPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiagnosticsProfile) DeepCopyInto(out *DiagnosticsProfile) {
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
 	*out = *in
-	if in.StorageURI != nil {
-		in, out := &in.StorageURI, &out.StorageURI
+	out.TypeMeta = in.TypeMeta
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.ZoneType != nil {
+		in, out := &in.ZoneType, &out.ZoneType
+		*out = new(DNSZoneType)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TagZone != nil {
+		in, out := &in.TagZone, &out.TagZone
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsProfile.
-func (in *DiagnosticsProfile) DeepCopy() *DiagnosticsProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(DiagnosticsProfile)
+	out := new(DNSRecordConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainCount) DeepCopyInto(out *DomainCount) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCount.
-func (in *DomainCount) DeepCopy() *DomainCount {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(DomainCount)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityConfig) DeepCopyInto(out *IdentityConfig) {
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
-	if in.ACRAccess != nil {
-		in, out := &in.ACRAccess, &out.ACRAccess
-		*out = new(bool)
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(Image)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisionedIops != nil {
+		in, out := &in.ProvisionedIops, &out.ProvisionedIops
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProvisionedThroughput != nil {
+		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
+		*out = new(int64)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityConfig.
-func (in *IdentityConfig) DeepCopy() *IdentityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityConfig)
+	out := new(DataVolume)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
+func (in *DiagnosticSettingsConfig) DeepCopyInto(out *DiagnosticSettingsConfig) {
 	*out = *in
+	if in.WorkspaceID != nil {
+		in, out := &in.WorkspaceID, &out.WorkspaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAccountID != nil {
+		in, out := &in.StorageAccountID, &out.StorageAccountID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
-func (in *IdentityStatus) DeepCopy() *IdentityStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticSettingsConfig.
+func (in *DiagnosticSettingsConfig) DeepCopy() *DiagnosticSettingsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityStatus)
+	out := new(DiagnosticSettingsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *DiagnosticsProfile) DeepCopyInto(out *DiagnosticsProfile) {
 	*out = *in
-	if in.URN != nil {
-		in, out := &in.URN, &out.URN
-		*out = new(string)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CommunityGalleryImageID != nil {
-		in, out := &in.CommunityGalleryImageID, &out.CommunityGalleryImageID
+	if in.StorageURI != nil {
+		in, out := &in.StorageURI, &out.StorageURI
 		*out = new(string)
 		**out = **in
 	}
-	if in.SharedGalleryImageID != nil {
-		in, out := &in.SharedGalleryImageID, &out.SharedGalleryImageID
-		*out = new(string)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BootDiagnosticsRetention)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsProfile.
+func (in *DiagnosticsProfile) DeepCopy() *DiagnosticsProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(DiagnosticsProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImmutableConfig) DeepCopyInto(out *ImmutableConfig) {
+func (in *DomainCount) DeepCopyInto(out *DomainCount) {
 	*out = *in
-	out.RetentionPeriod = in.RetentionPeriod
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableConfig.
-func (in *ImmutableConfig) DeepCopy() *ImmutableConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCount.
+func (in *DomainCount) DeepCopy() *DomainCount {
 	if in == nil {
 		return nil
 	}
-	out := new(ImmutableConfig)
+	out := new(DomainCount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+func (in *FlowLogsConfig) DeepCopyInto(out *FlowLogsConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	if in.ResourceGroup != nil {
-		in, out := &in.ResourceGroup, &out.ResourceGroup
-		*out = new(ResourceGroup)
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
 		**out = **in
 	}
-	in.Networks.DeepCopyInto(&out.Networks)
-	if in.Identity != nil {
-		in, out := &in.Identity, &out.Identity
-		*out = new(IdentityConfig)
+	if in.TrafficAnalytics != nil {
+		in, out := &in.TrafficAnalytics, &out.TrafficAnalytics
+		*out = new(TrafficAnalyticsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CreateNetworkWatcher != nil {
+		in, out := &in.CreateNetworkWatcher, &out.CreateNetworkWatcher
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
-func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogsConfig.
+func (in *FlowLogsConfig) DeepCopy() *FlowLogsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureConfig)
+	out := new(FlowLogsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayLoadBalancer) DeepCopyInto(out *GatewayLoadBalancer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLoadBalancer.
+func (in *GatewayLoadBalancer) DeepCopy() *GatewayLoadBalancer {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(GatewayLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureState) DeepCopyInto(out *InfrastructureState) {
+func (in *GatewayLoadBalancerStatus) DeepCopyInto(out *GatewayLoadBalancerStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ManagedItems != nil {
-		in, out := &in.ManagedItems, &out.ManagedItems
-		*out = make([]AzureResource, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureState.
-func (in *InfrastructureState) DeepCopy() *InfrastructureState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLoadBalancerStatus.
+func (in *GatewayLoadBalancerStatus) DeepCopy() *GatewayLoadBalancerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureState)
+	out := new(GatewayLoadBalancerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureState) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewaySubnetConfig) DeepCopyInto(out *GatewaySubnetConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaySubnetConfig.
+func (in *GatewaySubnetConfig) DeepCopy() *GatewaySubnetConfig {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(GatewaySubnetConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+func (in *GeoReplicationConfig) DeepCopyInto(out *GeoReplicationConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.Networks.DeepCopyInto(&out.Networks)
-	out.ResourceGroup = in.ResourceGroup
-	if in.RouteTables != nil {
-		in, out := &in.RouteTables, &out.RouteTables
-		*out = make([]RouteTable, len(*in))
-		copy(*out, *in)
-	}
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]SecurityGroup, len(*in))
-		copy(*out, *in)
-	}
-	if in.Identity != nil {
-		in, out := &in.Identity, &out.Identity
-		*out = new(IdentityStatus)
+	if in.MaxSyncLag != nil {
+		in, out := &in.MaxSyncLag, &out.MaxSyncLag
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
-func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoReplicationConfig.
+func (in *GeoReplicationConfig) DeepCopy() *GeoReplicationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureStatus)
+	out := new(GeoReplicationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
 	*out = *in
-	if in.AcceleratedNetworking != nil {
-		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
 		*out = new(string)
 		**out = **in
 	}
-	if in.SkipMarketplaceAgreement != nil {
-		in, out := &in.SkipMarketplaceAgreement, &out.SkipMarketplaceAgreement
-		*out = new(bool)
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
 		**out = **in
 	}
-	in.Image.DeepCopyInto(&out.Image)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
-func (in *MachineImage) DeepCopy() *MachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImage)
+	out := new(HealthProbe)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+func (in *IdentityConfig) DeepCopyInto(out *IdentityConfig) {
 	*out = *in
-	if in.URN != nil {
-		in, out := &in.URN, &out.URN
-		*out = new(string)
-		**out = **in
-	}
-	if in.SkipMarketplaceAgreement != nil {
-		in, out := &in.SkipMarketplaceAgreement, &out.SkipMarketplaceAgreement
+	if in.ACRAccess != nil {
+		in, out := &in.ACRAccess, &out.ACRAccess
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CommunityGalleryImageID != nil {
-		in, out := &in.CommunityGalleryImageID, &out.CommunityGalleryImageID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SharedGalleryImageID != nil {
-		in, out := &in.SharedGalleryImageID, &out.SharedGalleryImageID
-		*out = new(string)
-		**out = **in
-	}
-	if in.AcceleratedNetworking != nil {
-		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
-		*out = new(bool)
-		**out = **in
+	if in.RoleAssignments != nil {
+		in, out := &in.RoleAssignments, &out.RoleAssignments
+		*out = make([]IdentityRoleAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
-		*out = new(string)
-		**out = **in
+	if in.FederatedCredentials != nil {
+		in, out := &in.FederatedCredentials, &out.FederatedCredentials
+		*out = make([]IdentityFederatedCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
-func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityConfig.
+func (in *IdentityConfig) DeepCopy() *IdentityConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImageVersion)
+	out := new(IdentityConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+func (in *IdentityFederatedCredential) DeepCopyInto(out *IdentityFederatedCredential) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]MachineImageVersion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
-func (in *MachineImages) DeepCopy() *MachineImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityFederatedCredential.
+func (in *IdentityFederatedCredential) DeepCopy() *IdentityFederatedCredential {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImages)
+	out := new(IdentityFederatedCredential)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineType) DeepCopyInto(out *MachineType) {
+func (in *IdentityRoleAssignment) DeepCopyInto(out *IdentityRoleAssignment) {
 	*out = *in
-	if in.AcceleratedNetworking != nil {
-		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
-		*out = new(bool)
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
-func (in *MachineType) DeepCopy() *MachineType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityRoleAssignment.
+func (in *IdentityRoleAssignment) DeepCopy() *IdentityRoleAssignment {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineType)
+	out := new(IdentityRoleAssignment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
+func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
 	*out = *in
-	if in.IdleConnectionTimeoutMinutes != nil {
-		in, out := &in.IdleConnectionTimeoutMinutes, &out.IdleConnectionTimeoutMinutes
-		*out = new(int32)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
+func (in *IdentityStatus) DeepCopy() *IdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	if in.URN != nil {
+		in, out := &in.URN, &out.URN
+		*out = new(string)
 		**out = **in
 	}
-	if in.Zone != nil {
-		in, out := &in.Zone, &out.Zone
-		*out = new(int32)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
 		**out = **in
 	}
-	if in.IPAddresses != nil {
-		in, out := &in.IPAddresses, &out.IPAddresses
-		*out = make([]PublicIPReference, len(*in))
-		copy(*out, *in)
+	if in.CommunityGalleryImageID != nil {
+		in, out := &in.CommunityGalleryImageID, &out.CommunityGalleryImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedGalleryImageID != nil {
+		in, out := &in.SharedGalleryImageID, &out.SharedGalleryImageID
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayConfig.
-func (in *NatGatewayConfig) DeepCopy() *NatGatewayConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
 	if in == nil {
 		return nil
 	}
-	out := new(NatGatewayConfig)
+	out := new(Image)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+func (in *ImmutableConfig) DeepCopyInto(out *ImmutableConfig) {
 	*out = *in
-	in.VNet.DeepCopyInto(&out.VNet)
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
+	out.RetentionPeriod = in.RetentionPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableConfig.
+func (in *ImmutableConfig) DeepCopy() *ImmutableConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutableConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(ResourceGroup)
+		**out = **in
+	}
+	if in.ResourceGroupLocation != nil {
+		in, out := &in.ResourceGroupLocation, &out.ResourceGroupLocation
 		*out = new(string)
 		**out = **in
 	}
-	if in.NatGateway != nil {
-		in, out := &in.NatGateway, &out.NatGateway
-		*out = new(NatGatewayConfig)
+	if in.NetworkResourceGroup != nil {
+		in, out := &in.NetworkResourceGroup, &out.NetworkResourceGroup
+		*out = new(ResourceGroup)
+		**out = **in
+	}
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(IdentityConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ServiceEndpoints != nil {
-		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
-		*out = make([]string, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
+func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureState) DeepCopyInto(out *InfrastructureState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ManagedItems != nil {
+		in, out := &in.ManagedItems, &out.ManagedItems
+		*out = make([]AzureResource, len(*in))
 		copy(*out, *in)
 	}
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]Zone, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureState.
+func (in *InfrastructureState) DeepCopy() *InfrastructureState {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Networks.DeepCopyInto(&out.Networks)
+	out.ResourceGroup = in.ResourceGroup
+	if in.RouteTables != nil {
+		in, out := &in.RouteTables, &out.RouteTables
+		*out = make([]RouteTable, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]SecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(IdentityStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
+func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalLoadBalancerConfig) DeepCopyInto(out *InternalLoadBalancerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalLoadBalancerConfig.
+func (in *InternalLoadBalancerConfig) DeepCopy() *InternalLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvictionHard != nil {
+		in, out := &in.EvictionHard, &out.EvictionHard
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SystemReserved != nil {
+		in, out := &in.SystemReserved, &out.SystemReserved
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KubeReserved != nil {
+		in, out := &in.KubeReserved, &out.KubeReserved
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.OutboundRules != nil {
+		in, out := &in.OutboundRules, &out.OutboundRules
+		*out = new(OutboundRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayLoadBalancer != nil {
+		in, out := &in.GatewayLoadBalancer, &out.GatewayLoadBalancer
+		*out = new(GatewayLoadBalancer)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	if in.AcceleratedNetworking != nil {
+		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipMarketplaceAgreement != nil {
+		in, out := &in.SkipMarketplaceAgreement, &out.SkipMarketplaceAgreement
+		*out = new(bool)
+		**out = **in
+	}
+	in.Image.DeepCopyInto(&out.Image)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+	*out = *in
+	if in.URN != nil {
+		in, out := &in.URN, &out.URN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipMarketplaceAgreement != nil {
+		in, out := &in.SkipMarketplaceAgreement, &out.SkipMarketplaceAgreement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommunityGalleryImageID != nil {
+		in, out := &in.CommunityGalleryImageID, &out.CommunityGalleryImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedGalleryImageID != nil {
+		in, out := &in.SharedGalleryImageID, &out.SharedGalleryImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AcceleratedNetworking != nil {
+		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(OperatingSystem)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
+func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MachineImageVersion, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -648,105 +1189,707 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
+func (in *MachineImages) DeepCopy() *MachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineType) DeepCopyInto(out *MachineType) {
+	*out = *in
+	if in.AcceleratedNetworking != nil {
+		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
+func (in *MachineType) DeepCopy() *MachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
+	*out = *in
+	if in.IdleConnectionTimeoutMinutes != nil {
+		in, out := &in.IdleConnectionTimeoutMinutes, &out.IdleConnectionTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]PublicIPReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicIPCount != nil {
+		in, out := &in.PublicIPCount, &out.PublicIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PublicIPDNSLabelTemplate != nil {
+		in, out := &in.PublicIPDNSLabelTemplate, &out.PublicIPDNSLabelTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPPrefix != nil {
+		in, out := &in.PublicIPPrefix, &out.PublicIPPrefix
+		*out = new(PublicIPPrefixReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayConfig.
+func (in *NatGatewayConfig) DeepCopy() *NatGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+	*out = *in
+	in.VNet.DeepCopyInto(&out.VNet)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(NatGatewayConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceEndpointPolicies != nil {
+		in, out := &in.ServiceEndpointPolicies, &out.ServiceEndpointPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateEndpointNetworkPolicies != nil {
+		in, out := &in.PrivateEndpointNetworkPolicies, &out.PrivateEndpointNetworkPolicies
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateLinkServiceNetworkPolicies != nil {
+		in, out := &in.PrivateLinkServiceNetworkPolicies, &out.PrivateLinkServiceNetworkPolicies
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(PodSubnetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalSubnets != nil {
+		in, out := &in.AdditionalSubnets, &out.AdditionalSubnets
+		*out = make([]AdditionalSubnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLinkConfig)
+		**out = **in
+	}
+	if in.InternalLoadBalancer != nil {
+		in, out := &in.InternalLoadBalancer, &out.InternalLoadBalancer
+		*out = new(InternalLoadBalancerConfig)
+		**out = **in
+	}
+	if in.GatewaySubnet != nil {
+		in, out := &in.GatewaySubnet, &out.GatewaySubnet
+		*out = new(GatewaySubnetConfig)
+		**out = **in
+	}
+	if in.RouteServerSubnet != nil {
+		in, out := &in.RouteServerSubnet, &out.RouteServerSubnet
+		*out = new(RouteServerSubnetConfig)
+		**out = **in
+	}
+	if in.PrivateDNSZone != nil {
+		in, out := &in.PrivateDNSZone, &out.PrivateDNSZone
+		*out = new(PrivateDNSZoneConfig)
+		**out = **in
+	}
+	if in.OutboundLoadBalancer != nil {
+		in, out := &in.OutboundLoadBalancer, &out.OutboundLoadBalancer
+		*out = new(OutboundLoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkWatcher != nil {
+		in, out := &in.NetworkWatcher, &out.NetworkWatcher
+		*out = new(NetworkWatcherConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityGroup != nil {
+		in, out := &in.SecurityGroup, &out.SecurityGroup
+		*out = new(SecurityGroupReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	in.VNet.DeepCopyInto(&out.VNet)
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrivateDNSZone != nil {
+		in, out := &in.PrivateDNSZone, &out.PrivateDNSZone
+		*out = new(PrivateDNSZoneStatus)
+		**out = **in
+	}
+	if in.OutboundLoadBalancer != nil {
+		in, out := &in.OutboundLoadBalancer, &out.OutboundLoadBalancer
+		*out = new(OutboundLoadBalancerStatus)
+		**out = **in
+	}
+	if in.NetworkWatcher != nil {
+		in, out := &in.NetworkWatcher, &out.NetworkWatcher
+		*out = new(NetworkWatcherStatus)
+		**out = **in
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkWatcherConfig) DeepCopyInto(out *NetworkWatcherConfig) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkWatcherConfig.
+func (in *NetworkWatcherConfig) DeepCopy() *NetworkWatcherConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkWatcherConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkWatcherStatus) DeepCopyInto(out *NetworkWatcherStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkWatcherStatus.
+func (in *NetworkWatcherStatus) DeepCopy() *NetworkWatcherStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkWatcherStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundLoadBalancerConfig) DeepCopyInto(out *OutboundLoadBalancerConfig) {
+	*out = *in
+	if in.PublicIPCount != nil {
+		in, out := &in.PublicIPCount, &out.PublicIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdleTimeoutMinutes != nil {
+		in, out := &in.IdleTimeoutMinutes, &out.IdleTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundLoadBalancerConfig.
+func (in *OutboundLoadBalancerConfig) DeepCopy() *OutboundLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundLoadBalancerStatus) DeepCopyInto(out *OutboundLoadBalancerStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundLoadBalancerStatus.
+func (in *OutboundLoadBalancerStatus) DeepCopy() *OutboundLoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundLoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundRuleAllocation) DeepCopyInto(out *OutboundRuleAllocation) {
+	*out = *in
+	if in.IdleTimeoutMinutes != nil {
+		in, out := &in.IdleTimeoutMinutes, &out.IdleTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRuleAllocation.
+func (in *OutboundRuleAllocation) DeepCopy() *OutboundRuleAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundRuleAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundRules) DeepCopyInto(out *OutboundRules) {
+	*out = *in
+	if in.FrontendIPCount != nil {
+		in, out := &in.FrontendIPCount, &out.FrontendIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(OutboundRuleAllocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UDP != nil {
+		in, out := &in.UDP, &out.UDP
+		*out = new(OutboundRuleAllocation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRules.
+func (in *OutboundRules) DeepCopy() *OutboundRules {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundRulesStatus) DeepCopyInto(out *OutboundRulesStatus) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicIPAddressNames != nil {
+		in, out := &in.PublicIPAddressNames, &out.PublicIPAddressNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRulesStatus.
+func (in *OutboundRulesStatus) DeepCopy() *OutboundRulesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundRulesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSubnetConfig) DeepCopyInto(out *PodSubnetConfig) {
+	*out = *in
+	if in.Delegation != nil {
+		in, out := &in.Delegation, &out.Delegation
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSubnetConfig.
+func (in *PodSubnetConfig) DeepCopy() *PodSubnetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSubnetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSZoneConfig) DeepCopyInto(out *PrivateDNSZoneConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneConfig.
+func (in *PrivateDNSZoneConfig) DeepCopy() *PrivateDNSZoneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSZoneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSZoneStatus) DeepCopyInto(out *PrivateDNSZoneStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneStatus.
+func (in *PrivateDNSZoneStatus) DeepCopy() *PrivateDNSZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkConfig) DeepCopyInto(out *PrivateLinkConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkConfig.
+func (in *PrivateLinkConfig) DeepCopy() *PrivateLinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkStatus) DeepCopyInto(out *PrivateLinkStatus) {
+	*out = *in
+	if in.EndpointID != nil {
+		in, out := &in.EndpointID, &out.EndpointID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndpointIPAddresses != nil {
+		in, out := &in.EndpointIPAddresses, &out.EndpointIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectionState != nil {
+		in, out := &in.ConnectionState, &out.ConnectionState
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkStatus.
+func (in *PrivateLinkStatus) DeepCopy() *PrivateLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAccessConfig) DeepCopyInto(out *PublicAccessConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessConfig.
+func (in *PublicAccessConfig) DeepCopy() *PublicAccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPPrefixReference) DeepCopyInto(out *PublicIPPrefixReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPPrefixReference.
+func (in *PublicIPPrefixReference) DeepCopy() *PublicIPPrefixReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPPrefixReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPReference.
+func (in *PublicIPReference) DeepCopy() *PublicIPReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroup.
+func (in *ResourceGroup) DeepCopy() *ResourceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdate) DeepCopyInto(out *RollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdate.
+func (in *RollingUpdate) DeepCopy() *RollingUpdate {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkConfig)
+	out := new(RollingUpdate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *RotationConfig) DeepCopyInto(out *RotationConfig) {
 	*out = *in
-	in.VNet.DeepCopyInto(&out.VNet)
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]Subnet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ExpirationPeriodDays != nil {
+		in, out := &in.ExpirationPeriodDays, &out.ExpirationPeriodDays
+		*out = new(int32)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationConfig.
+func (in *RotationConfig) DeepCopy() *RotationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(RotationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
+func (in *RouteServerSubnetConfig) DeepCopyInto(out *RouteServerSubnetConfig) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPReference.
-func (in *PublicIPReference) DeepCopy() *PublicIPReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteServerSubnetConfig.
+func (in *RouteServerSubnetConfig) DeepCopy() *RouteServerSubnetConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicIPReference)
+	out := new(RouteServerSubnetConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroup.
-func (in *ResourceGroup) DeepCopy() *ResourceGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceGroup)
+	out := new(RouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RotationConfig) DeepCopyInto(out *RotationConfig) {
+func (in *SFTPConfig) DeepCopyInto(out *SFTPConfig) {
 	*out = *in
-	if in.ExpirationPeriodDays != nil {
-		in, out := &in.ExpirationPeriodDays, &out.ExpirationPeriodDays
-		*out = new(int32)
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SFTPConfig.
+func (in *SFTPConfig) DeepCopy() *SFTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SFTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleInPolicy) DeepCopyInto(out *ScaleInPolicy) {
+	*out = *in
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(ScaleInPolicyRule)
+		**out = **in
+	}
+	if in.ForceDeletion != nil {
+		in, out := &in.ForceDeletion, &out.ForceDeletion
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationConfig.
-func (in *RotationConfig) DeepCopy() *RotationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleInPolicy.
+func (in *ScaleInPolicy) DeepCopy() *ScaleInPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RotationConfig)
+	out := new(ScaleInPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *SecondaryStorageAccountConfig) DeepCopyInto(out *SecondaryStorageAccountConfig) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryStorageAccountConfig.
+func (in *SecondaryStorageAccountConfig) DeepCopy() *SecondaryStorageAccountConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(SecondaryStorageAccountConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -754,6 +1897,11 @@ func (in *RouteTable) DeepCopy() *RouteTable {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -767,6 +1915,22 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupReference) DeepCopyInto(out *SecurityGroupReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupReference.
+func (in *SecurityGroupReference) DeepCopy() *SecurityGroupReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
@@ -780,6 +1944,11 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DefaultStorageClassVolumeBindingMode != nil {
+		in, out := &in.DefaultStorageClassVolumeBindingMode, &out.DefaultStorageClassVolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
 	return
 }
 
@@ -793,6 +1962,22 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageAccountReference) DeepCopyInto(out *StorageAccountReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAccountReference.
+func (in *StorageAccountReference) DeepCopy() *StorageAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -806,6 +1991,16 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NatGatewayPublicIPCount != nil {
+		in, out := &in.NatGatewayPublicIPCount, &out.NatGatewayPublicIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -819,6 +2014,69 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeConfig) DeepCopyInto(out *TimeConfig) {
+	*out = *in
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeConfig.
+func (in *TimeConfig) DeepCopy() *TimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficAnalyticsConfig) DeepCopyInto(out *TrafficAnalyticsConfig) {
+	*out = *in
+	if in.IntervalMinutes != nil {
+		in, out := &in.IntervalMinutes, &out.IntervalMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficAnalyticsConfig.
+func (in *TrafficAnalyticsConfig) DeepCopy() *TrafficAnalyticsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficAnalyticsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDataEncryption) DeepCopyInto(out *UserDataEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDataEncryption.
+func (in *UserDataEncryption) DeepCopy() *UserDataEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDataEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNet) DeepCopyInto(out *VNet) {
 	*out = *in
@@ -837,11 +2095,21 @@ func (in *VNet) DeepCopyInto(out *VNet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalCIDRs != nil {
+		in, out := &in.AdditionalCIDRs, &out.AdditionalCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.DDosProtectionPlanID != nil {
 		in, out := &in.DDosProtectionPlanID, &out.DDosProtectionPlanID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(VNetEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -855,6 +2123,27 @@ func (in *VNet) DeepCopy() *VNet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNetEncryption) DeepCopyInto(out *VNetEncryption) {
+	*out = *in
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(VNetEncryptionEnforcement)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNetEncryption.
+func (in *VNetEncryption) DeepCopy() *VNetEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(VNetEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNetStatus) DeepCopyInto(out *VNetStatus) {
 	*out = *in
@@ -879,6 +2168,11 @@ func (in *VNetStatus) DeepCopy() *VNetStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VmoDependency) DeepCopyInto(out *VmoDependency) {
 	*out = *in
+	if in.TagKeys != nil {
+		in, out := &in.TagKeys, &out.TagKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -939,6 +2233,96 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SinglePlacementGroup != nil {
+		in, out := &in.SinglePlacementGroup, &out.SinglePlacementGroup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FaultDomainCount != nil {
+		in, out := &in.FaultDomainCount, &out.FaultDomainCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleInPolicy != nil {
+		in, out := &in.ScaleInPolicy, &out.ScaleInPolicy
+		*out = new(ScaleInPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetName != nil {
+		in, out := &in.SubnetName, &out.SubnetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableIPForwarding != nil {
+		in, out := &in.EnableIPForwarding, &out.EnableIPForwarding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrePullImages != nil {
+		in, out := &in.PrePullImages, &out.PrePullImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletConfig != nil {
+		in, out := &in.KubeletConfig, &out.KubeletConfig
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(TimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompressUserData != nil {
+		in, out := &in.CompressUserData, &out.CompressUserData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LicenseType != nil {
+		in, out := &in.LicenseType, &out.LicenseType
+		*out = new(LicenseType)
+		**out = **in
+	}
+	if in.AutomaticRepairs != nil {
+		in, out := &in.AutomaticRepairs, &out.AutomaticRepairs
+		*out = new(AutomaticRepairs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(HealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureMonitorAgent != nil {
+		in, out := &in.AzureMonitorAgent, &out.AzureMonitorAgent
+		*out = new(AzureMonitorAgent)
+		**out = **in
+	}
+	if in.UserDataEncryption != nil {
+		in, out := &in.UserDataEncryption, &out.UserDataEncryption
+		*out = new(UserDataEncryption)
+		**out = **in
+	}
+	if in.MachineImageVersion != nil {
+		in, out := &in.MachineImageVersion, &out.MachineImageVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FallbackZones != nil {
+		in, out := &in.FallbackZones, &out.FallbackZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CapacityPolicy != nil {
+		in, out := &in.CapacityPolicy, &out.CapacityPolicy
+		*out = new(CapacityPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -960,6 +2344,27 @@ func (in *WorkerConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolFallbackZones) DeepCopyInto(out *WorkerPoolFallbackZones) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolFallbackZones.
+func (in *WorkerPoolFallbackZones) DeepCopy() *WorkerPoolFallbackZones {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolFallbackZones)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -974,6 +2379,20 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	if in.VmoDependencies != nil {
 		in, out := &in.VmoDependencies, &out.VmoDependencies
 		*out = make([]VmoDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FallbackZones != nil {
+		in, out := &in.FallbackZones, &out.FallbackZones
+		*out = make([]WorkerPoolFallbackZones, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootDiagnosticsStorageAccounts != nil {
+		in, out := &in.BootDiagnosticsStorageAccounts, &out.BootDiagnosticsStorageAccounts
+		*out = make([]StorageAccountReference, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -1030,6 +2449,21 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceEndpointPolicies != nil {
+		in, out := &in.ServiceEndpointPolicies, &out.ServiceEndpointPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateEndpointNetworkPolicies != nil {
+		in, out := &in.PrivateEndpointNetworkPolicies, &out.PrivateEndpointNetworkPolicies
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateLinkServiceNetworkPolicies != nil {
+		in, out := &in.PrivateLinkServiceNetworkPolicies, &out.PrivateLinkServiceNetworkPolicies
+		*out = new(string)
+		**out = **in
+	}
 	if in.NatGateway != nil {
 		in, out := &in.NatGateway, &out.NatGateway
 		*out = new(ZonedNatGatewayConfig)
@@ -1061,6 +2495,21 @@ func (in *ZonedNatGatewayConfig) DeepCopyInto(out *ZonedNatGatewayConfig) {
 		*out = make([]ZonedPublicIPReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.PublicIPCount != nil {
+		in, out := &in.PublicIPCount, &out.PublicIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PublicIPDNSLabelTemplate != nil {
+		in, out := &in.PublicIPDNSLabelTemplate, &out.PublicIPDNSLabelTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPPrefix != nil {
+		in, out := &in.PublicIPPrefix, &out.PublicIPPrefix
+		*out = new(PublicIPPrefixReference)
+		**out = **in
+	}
 	return
 }
 
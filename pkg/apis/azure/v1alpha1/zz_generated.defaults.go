@@ -37,6 +37,17 @@ func SetObjectDefaults_ControlPlaneConfig(in *ControlPlaneConfig) {
 	if in.Storage != nil {
 		SetDefaults_Storage(in.Storage)
 	}
+	if in.LoadBalancer != nil {
+		if in.LoadBalancer.OutboundRules != nil {
+			SetDefaults_OutboundRules(in.LoadBalancer.OutboundRules)
+			if in.LoadBalancer.OutboundRules.TCP != nil {
+				SetDefaults_OutboundRuleAllocation(in.LoadBalancer.OutboundRules.TCP)
+			}
+			if in.LoadBalancer.OutboundRules.UDP != nil {
+				SetDefaults_OutboundRuleAllocation(in.LoadBalancer.OutboundRules.UDP)
+			}
+		}
+	}
 }
 
 func SetObjectDefaults_InfrastructureStatus(in *InfrastructureStatus) {
@@ -6,9 +6,11 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,8 +25,20 @@ var (
 
 	allowedGVKs = sets.New(secretGVK, workloadIdentityGVK)
 	validGVKs   = []string{secretGVK.String(), workloadIdentityGVK.String()}
+
+	storageAccountNamePrefixRegex = regexp.MustCompile(`^[a-z0-9]+$`)
+
+	supportedPublicAccessModes = sets.New(apisazure.PublicAccessModeVerify, apisazure.PublicAccessModeEnforce)
 )
 
+// maxStorageAccountNamePrefixLength is the maximum length of the prefix of the storage account name. Storage account
+// names have at most 24 characters, and at least 8 of them are needed for the hash of the backup bucket name to keep
+// the names of different backup buckets apart.
+const maxStorageAccountNamePrefixLength = 16
+
+// maxChangeFeedRetentionDays is the maximum retention of the change feed of a storage account accepted by Azure.
+const maxChangeFeedRetentionDays = 146000
+
 // ValidateBackupBucketConfig validates a BackupBucketConfig object.
 func ValidateBackupBucketConfig(backupBucketConfig *apisazure.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -34,7 +48,137 @@ func ValidateBackupBucketConfig(backupBucketConfig *apisazure.BackupBucketConfig
 
 	allErrs = append(allErrs, validateImmutability(backupBucketConfig.Immutability, fldPath.Child("immutability"))...)
 	allErrs = append(allErrs, validateKeyRotation(backupBucketConfig.RotationConfig, fldPath.Child("rotationConfig"))...)
+	allErrs = append(allErrs, validateGeoReplication(backupBucketConfig.GeoReplication, fldPath.Child("geoReplication"))...)
+	allErrs = append(allErrs, validateDiagnosticSettings(backupBucketConfig.DiagnosticSettings, fldPath.Child("diagnosticSettings"))...)
+	allErrs = append(allErrs, validateStorageAccountNamePrefix(backupBucketConfig.StorageAccountNamePrefix, fldPath.Child("storageAccountNamePrefix"))...)
+	allErrs = append(allErrs, validatePublicAccess(backupBucketConfig.PublicAccess, fldPath.Child("publicAccess"))...)
+	allErrs = append(allErrs, validateSecondaryStorageAccount(backupBucketConfig.SecondaryStorageAccount, fldPath.Child("secondaryStorageAccount"))...)
+	allErrs = append(allErrs, validateChangeFeed(backupBucketConfig.ChangeFeed, fldPath.Child("changeFeed"))...)
+	allErrs = append(allErrs, validateSFTP(backupBucketConfig.SFTP, fldPath.Child("sftp"))...)
+
+	// object replication is not supported for containers with a container-level immutability policy.
+	if backupBucketConfig.SecondaryStorageAccount != nil && backupBucketConfig.Immutability != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryStorageAccount"), "a secondary storage account cannot be combined with an immutability policy"))
+	}
+
+	// object replication requires the change feed of the source storage account.
+	if backupBucketConfig.SecondaryStorageAccount != nil && backupBucketConfig.ChangeFeed != nil && !backupBucketConfig.ChangeFeed.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("changeFeed", "enabled"), "the change feed cannot be disabled if a secondary storage account is configured"))
+	}
+
+	// SFTP requires a hierarchical namespace, which supports neither object replication nor the change feed.
+	if backupBucketConfig.SFTP != nil && backupBucketConfig.SecondaryStorageAccount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sftp"), "SFTP cannot be combined with a secondary storage account"))
+	}
+	if backupBucketConfig.SFTP != nil && backupBucketConfig.ChangeFeed != nil && backupBucketConfig.ChangeFeed.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sftp"), "SFTP cannot be combined with the change feed"))
+	}
+	// The delayed deletion of immutable backups tags the blobs, but a hierarchical namespace does not support blob index
+	// tags.
+	if backupBucketConfig.SFTP != nil && backupBucketConfig.Immutability != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sftp"), "SFTP cannot be combined with an immutability policy"))
+	}
+
+	return allErrs
+}
+
+func validateSFTP(cfg *apisazure.SFTPConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil {
+		return allErrs
+	}
+
+	if len(cfg.SSHAuthorizedKeys) == 0 {
+		return append(allErrs, field.Required(fldPath.Child("sshAuthorizedKeys"), "at least one SSH public key must be specified"))
+	}
+	for i, key := range cfg.SSHAuthorizedKeys {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sshAuthorizedKeys").Index(i), key, fmt.Sprintf("must be an SSH public key in the authorized_keys format: %v", err)))
+		}
+	}
+	return allErrs
+}
 
+func validateChangeFeed(cfg *apisazure.ChangeFeedConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil || cfg.RetentionDays == nil {
+		return allErrs
+	}
+
+	if !cfg.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("retentionDays"), "the retention can only be configured if the change feed is enabled"))
+	} else if *cfg.RetentionDays < 1 || *cfg.RetentionDays > maxChangeFeedRetentionDays {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *cfg.RetentionDays, fmt.Sprintf("must be between 1 and %d", maxChangeFeedRetentionDays)))
+	}
+	return allErrs
+}
+
+func validateSecondaryStorageAccount(cfg *apisazure.SecondaryStorageAccountConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.Region == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "the region of the secondary storage account must be specified"))
+	}
+	return allErrs
+}
+
+func validatePublicAccess(cfg *apisazure.PublicAccessConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil {
+		return allErrs
+	}
+
+	if !supportedPublicAccessModes.Has(cfg.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), cfg.Mode, sets.List(supportedPublicAccessModes)))
+	}
+	return allErrs
+}
+
+func validateStorageAccountNamePrefix(prefix *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if prefix == nil {
+		return allErrs
+	}
+
+	if len(*prefix) == 0 || len(*prefix) > maxStorageAccountNamePrefixLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, *prefix, fmt.Sprintf("must be between 1 and %d characters long", maxStorageAccountNamePrefixLength)))
+	}
+	if !storageAccountNamePrefixRegex.MatchString(*prefix) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *prefix, "must only consist of lowercase letters and digits"))
+	}
+	return allErrs
+}
+
+func validateGeoReplication(cfg *apisazure.GeoReplicationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil || cfg.MaxSyncLag == nil {
+		return allErrs
+	}
+
+	if cfg.MaxSyncLag.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSyncLag"), cfg.MaxSyncLag.String(), "must be a positive duration"))
+	}
+	return allErrs
+}
+
+func validateDiagnosticSettings(cfg *apisazure.DiagnosticSettingsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.WorkspaceID == nil && cfg.StorageAccountID == nil {
+		return append(allErrs, field.Required(fldPath, "at least one of workspaceID and storageAccountID must be specified"))
+	}
+	if cfg.WorkspaceID != nil {
+		allErrs = append(allErrs, validateResourceIDOfType(*cfg.WorkspaceID, "Microsoft.OperationalInsights/workspaces", fldPath.Child("workspaceID"))...)
+	}
+	if cfg.StorageAccountID != nil {
+		allErrs = append(allErrs, validateResourceIDOfType(*cfg.StorageAccountID, "Microsoft.Storage/storageAccounts", fldPath.Child("storageAccountID"))...)
+	}
 	return allErrs
 }
 
@@ -88,6 +232,10 @@ func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisazure.BackupBuck
 		immutabilityPath = fldPath.Child("immutability")
 	)
 
+	allErrs = append(allErrs, validateSecondaryStorageAccountUpdate(oldConfig.SecondaryStorageAccount, newConfig, fldPath.Child("secondaryStorageAccount"))...)
+	allErrs = append(allErrs, validateInfrastructureEncryptionUpdate(oldConfig.RequireInfrastructureEncryption, newConfig, fldPath.Child("requireInfrastructureEncryption"))...)
+	allErrs = append(allErrs, validateSFTPUpdate(oldConfig.SFTP, newConfig, fldPath.Child("sftp"))...)
+
 	if oldConfig.Immutability == nil || !oldConfig.Immutability.Locked {
 		return allErrs
 	}
@@ -111,6 +259,48 @@ func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisazure.BackupBuck
 	return allErrs
 }
 
+// validateSecondaryStorageAccountUpdate forbids removing the secondary storage account and changing its region, since
+// the storage account holds copies of the backups and cannot be moved.
+func validateSecondaryStorageAccountUpdate(oldSecondary *apisazure.SecondaryStorageAccountConfig, newConfig *apisazure.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if oldSecondary == nil {
+		return allErrs
+	}
+
+	if newConfig == nil || newConfig.SecondaryStorageAccount == nil {
+		return append(allErrs, field.Forbidden(fldPath, "the secondary storage account cannot be removed once it is configured"))
+	}
+	if newConfig.SecondaryStorageAccount.Region != oldSecondary.Region {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), newConfig.SecondaryStorageAccount.Region, "the region of the secondary storage account cannot be changed"))
+	}
+	return allErrs
+}
+
+// validateInfrastructureEncryptionUpdate forbids enabling and disabling the infrastructure encryption, since Azure only
+// applies it when the storage accounts are created.
+func validateInfrastructureEncryptionUpdate(oldInfrastructureEncryption *bool, newConfig *apisazure.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var newInfrastructureEncryption *bool
+	if newConfig != nil {
+		newInfrastructureEncryption = newConfig.RequireInfrastructureEncryption
+	}
+	if ptr.Deref(oldInfrastructureEncryption, false) != ptr.Deref(newInfrastructureEncryption, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "infrastructure encryption cannot be changed once the storage accounts are created"))
+	}
+	return allErrs
+}
+
+// validateSFTPUpdate forbids enabling SFTP for existing backup buckets, since SFTP requires a hierarchical namespace
+// which Azure only enables when the storage account is created.
+func validateSFTPUpdate(oldSFTP *apisazure.SFTPConfig, newConfig *apisazure.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if oldSFTP != nil || newConfig == nil || newConfig.SFTP == nil {
+		return allErrs
+	}
+	return append(allErrs, field.Forbidden(fldPath, "SFTP can only be enabled when the backup bucket is created, since it requires a hierarchical namespace"))
+}
+
 // ValidateBackupBucketCredentialsRef validates credentialsRef is set to supported kind of credentials.
 func ValidateBackupBucketCredentialsRef(credentialsRef *corev1.ObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -18,6 +18,8 @@ import (
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 )
 
+const sshPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJAcJYRs8BrAqU4lufafpsby9fhKcKQOHL/kE7ZK4YZn"
+
 var _ = Describe("BackupBucket", func() {
 	Describe("ValidateBackupBucketConfig", func() {
 		var fldPath *field.Path
@@ -134,6 +136,222 @@ var _ = Describe("BackupBucket", func() {
 					}, true, "must be a positive integer multiple of 24h"),
 			)
 		})
+		Context("geo-replication", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("default maxSyncLag", &apisazure.BackupBucketConfig{
+					GeoReplication: &apisazure.GeoReplicationConfig{},
+				}, false, ""),
+				Entry("valid maxSyncLag", &apisazure.BackupBucketConfig{
+					GeoReplication: &apisazure.GeoReplicationConfig{
+						MaxSyncLag: &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				}, false, ""),
+				Entry("zero maxSyncLag", &apisazure.BackupBucketConfig{
+					GeoReplication: &apisazure.GeoReplicationConfig{
+						MaxSyncLag: &metav1.Duration{},
+					},
+				}, true, "must be a positive duration"),
+				Entry("negative maxSyncLag", &apisazure.BackupBucketConfig{
+					GeoReplication: &apisazure.GeoReplicationConfig{
+						MaxSyncLag: &metav1.Duration{Duration: -time.Minute},
+					},
+				}, true, "must be a positive duration"),
+			)
+		})
+		Context("diagnostic settings", func() {
+			const (
+				workspaceID      = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.OperationalInsights/workspaces/audit"
+				storageAccountID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/audit"
+			)
+
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("workspace and storage account", &apisazure.BackupBucketConfig{
+					DiagnosticSettings: &apisazure.DiagnosticSettingsConfig{WorkspaceID: ptr.To(workspaceID), StorageAccountID: ptr.To(storageAccountID)},
+				}, false, ""),
+				Entry("workspace only", &apisazure.BackupBucketConfig{
+					DiagnosticSettings: &apisazure.DiagnosticSettingsConfig{WorkspaceID: ptr.To(workspaceID)},
+				}, false, ""),
+				Entry("no destination", &apisazure.BackupBucketConfig{
+					DiagnosticSettings: &apisazure.DiagnosticSettingsConfig{},
+				}, true, "at least one of workspaceID and storageAccountID must be specified"),
+				Entry("workspace of wrong type", &apisazure.BackupBucketConfig{
+					DiagnosticSettings: &apisazure.DiagnosticSettingsConfig{WorkspaceID: ptr.To(storageAccountID)},
+				}, true, "must be the ID of a Microsoft.OperationalInsights/workspaces resource"),
+				Entry("invalid storage account", &apisazure.BackupBucketConfig{
+					DiagnosticSettings: &apisazure.DiagnosticSettingsConfig{StorageAccountID: ptr.To("audit")},
+				}, true, "invalid resource ID"),
+			)
+		})
+		Context("storage account name prefix", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("team prefix", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To("teamabkp"),
+				}, false, ""),
+				Entry("longest prefix", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To("abcdefghij012345"),
+				}, false, ""),
+				Entry("empty prefix", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To(""),
+				}, true, "must be between 1 and 16 characters long"),
+				Entry("too long prefix", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To("abcdefghij0123456"),
+				}, true, "must be between 1 and 16 characters long"),
+				Entry("uppercase letters", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To("TeamA"),
+				}, true, "must only consist of lowercase letters and digits"),
+				Entry("hyphen", &apisazure.BackupBucketConfig{
+					StorageAccountNamePrefix: ptr.To("team-a"),
+				}, true, "must only consist of lowercase letters and digits"),
+			)
+		})
+		Context("public access", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("verify", &apisazure.BackupBucketConfig{
+					PublicAccess: &apisazure.PublicAccessConfig{Mode: apisazure.PublicAccessModeVerify},
+				}, false, ""),
+				Entry("enforce", &apisazure.BackupBucketConfig{
+					PublicAccess: &apisazure.PublicAccessConfig{Mode: apisazure.PublicAccessModeEnforce},
+				}, false, ""),
+				Entry("missing mode", &apisazure.BackupBucketConfig{
+					PublicAccess: &apisazure.PublicAccessConfig{},
+				}, true, "Unsupported value"),
+				Entry("unknown mode", &apisazure.BackupBucketConfig{
+					PublicAccess: &apisazure.PublicAccessConfig{Mode: "Ignore"},
+				}, true, "Unsupported value"),
+			)
+		})
+		Context("secondary storage account", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("region", &apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+				}, false, ""),
+				Entry("missing region", &apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{},
+				}, true, "the region of the secondary storage account must be specified"),
+				Entry("immutability", &apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+					Immutability: &apisazure.ImmutableConfig{
+						RetentionType:   apisazure.BucketLevelImmutability,
+						RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+					},
+				}, true, "a secondary storage account cannot be combined with an immutability policy"),
+			)
+		})
+		Context("change feed", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("enabled with retention", &apisazure.BackupBucketConfig{
+					ChangeFeed: &apisazure.ChangeFeedConfig{Enabled: true, RetentionDays: ptr.To[int32](90)},
+				}, false, ""),
+				Entry("disabled", &apisazure.BackupBucketConfig{
+					ChangeFeed: &apisazure.ChangeFeedConfig{},
+				}, false, ""),
+				Entry("retention out of range", &apisazure.BackupBucketConfig{
+					ChangeFeed: &apisazure.ChangeFeedConfig{Enabled: true, RetentionDays: ptr.To[int32](0)},
+				}, true, "must be between 1 and 146000"),
+				Entry("retention of a disabled change feed", &apisazure.BackupBucketConfig{
+					ChangeFeed: &apisazure.ChangeFeedConfig{RetentionDays: ptr.To[int32](90)},
+				}, true, "the retention can only be configured if the change feed is enabled"),
+				Entry("disabled with secondary storage account", &apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+					ChangeFeed:              &apisazure.ChangeFeedConfig{},
+				}, true, "the change feed cannot be disabled if a secondary storage account is configured"),
+			)
+		})
+		Context("sftp", func() {
+			DescribeTable("validation cases",
+				func(config *apisazure.BackupBucketConfig, wantErr bool, errMsg string) {
+					errs := ValidateBackupBucketConfig(config, fldPath)
+					if wantErr {
+						Expect(errs).NotTo(BeEmpty())
+						Expect(errs[0].Error()).To(ContainSubstring(errMsg))
+					} else {
+						Expect(errs).To(BeEmpty())
+					}
+				},
+				Entry("ssh authorized keys", &apisazure.BackupBucketConfig{
+					SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey + " backup@example.com"}},
+				}, false, ""),
+				Entry("missing ssh authorized keys", &apisazure.BackupBucketConfig{
+					SFTP: &apisazure.SFTPConfig{},
+				}, true, "at least one SSH public key must be specified"),
+				Entry("invalid ssh authorized key", &apisazure.BackupBucketConfig{
+					SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA"}},
+				}, true, "must be an SSH public key in the authorized_keys format"),
+				Entry("secondary storage account", &apisazure.BackupBucketConfig{
+					SFTP:                    &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}},
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+				}, true, "SFTP cannot be combined with a secondary storage account"),
+				Entry("enabled change feed", &apisazure.BackupBucketConfig{
+					SFTP:       &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}},
+					ChangeFeed: &apisazure.ChangeFeedConfig{Enabled: true},
+				}, true, "SFTP cannot be combined with the change feed"),
+				Entry("immutability", &apisazure.BackupBucketConfig{
+					SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}},
+					Immutability: &apisazure.ImmutableConfig{
+						RetentionType:   apisazure.BucketLevelImmutability,
+						RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+					},
+				}, true, "SFTP cannot be combined with an immutability policy"),
+				Entry("disabled change feed", &apisazure.BackupBucketConfig{
+					SFTP:       &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}},
+					ChangeFeed: &apisazure.ChangeFeedConfig{},
+				}, false, ""),
+			)
+		})
 	})
 
 	Describe("ValidateBackupBucketConfigUpdate", func() {
@@ -294,6 +512,44 @@ var _ = Describe("BackupBucket", func() {
 				&apisazure.BackupBucketConfig{
 					Immutability: nil,
 				}, true, "immutability cannot be disabled once it is locked"),
+			Entry("valid config update: secondary storage account addition",
+				&apisazure.BackupBucketConfig{},
+				&apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+				}, false, ""),
+			Entry("invalid config update: secondary storage account removal",
+				&apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+				},
+				&apisazure.BackupBucketConfig{}, true, "the secondary storage account cannot be removed once it is configured"),
+			Entry("invalid config update: secondary storage account region change",
+				&apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "northeurope"},
+				},
+				&apisazure.BackupBucketConfig{
+					SecondaryStorageAccount: &apisazure.SecondaryStorageAccountConfig{Region: "westeurope"},
+				}, true, "the region of the secondary storage account cannot be changed"),
+			Entry("valid config update: unchanged infrastructure encryption",
+				&apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(true)},
+				&apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(true)}, false, ""),
+			Entry("valid config update: explicitly disabled infrastructure encryption",
+				&apisazure.BackupBucketConfig{},
+				&apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(false)}, false, ""),
+			Entry("invalid config update: infrastructure encryption enabled",
+				&apisazure.BackupBucketConfig{},
+				&apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(true)}, true, "infrastructure encryption cannot be changed once the storage accounts are created"),
+			Entry("invalid config update: infrastructure encryption disabled",
+				&apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(true)},
+				&apisazure.BackupBucketConfig{}, true, "infrastructure encryption cannot be changed once the storage accounts are created"),
+			Entry("valid config update: ssh authorized keys change",
+				&apisazure.BackupBucketConfig{SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{"old"}}},
+				&apisazure.BackupBucketConfig{SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}}}, false, ""),
+			Entry("valid config update: sftp removal",
+				&apisazure.BackupBucketConfig{SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}}},
+				&apisazure.BackupBucketConfig{}, false, ""),
+			Entry("invalid config update: sftp addition",
+				&apisazure.BackupBucketConfig{},
+				&apisazure.BackupBucketConfig{SFTP: &apisazure.SFTPConfig{SSHAuthorizedKeys: []string{sshPublicKey}}}, true, "SFTP can only be enabled when the backup bucket is created"),
 		)
 	})
 
@@ -100,6 +100,10 @@ func ValidateProviderMachineImage(validationPath *field.Path, machineImage apisa
 		if !slices.Contains(v1beta1constants.ValidArchitectures, *version.Architecture) {
 			allErrs = append(allErrs, field.NotSupported(jdxPath.Child("architecture"), *version.Architecture, v1beta1constants.ValidArchitectures))
 		}
+
+		if version.OperatingSystem != nil && !slices.Contains(availableOperatingSystems, string(*version.OperatingSystem)) {
+			allErrs = append(allErrs, field.NotSupported(jdxPath.Child("operatingSystem"), *version.OperatingSystem, availableOperatingSystems))
+		}
 	}
 
 	return allErrs
@@ -122,6 +122,22 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				}))))
 			})
 
+			It("should allow the operating system of a machine image", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].OperatingSystem = ptr.To(apisazure.OperatingSystemRHEL)
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, cloudProfileMachineImages, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid an unsupported operating system of a machine image", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].OperatingSystem = ptr.To(apisazure.OperatingSystem("Ubuntu"))
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, cloudProfileMachineImages, nilPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("machineImages[0].versions[0].operatingSystem"),
+				}))))
+			})
+
 			DescribeTable("forbid unsupported machine image urn",
 				func(urn string, matcher gomegatypes.GomegaMatcher) {
 					cloudProfileConfig.MachineImages[0].Versions[0].URN = &urn
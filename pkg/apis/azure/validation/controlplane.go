@@ -5,18 +5,240 @@
 package validation
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/gardener/gardener/pkg/apis/core"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 )
 
-// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
-func ValidateControlPlaneConfig(controlPlaneConfig *apisazure.ControlPlaneConfig, version string, fldPath *field.Path) field.ErrorList {
+const (
+	// snatPortsPerFrontendIP is the number of SNAT ports each frontend IP address of a load balancer provides per protocol.
+	snatPortsPerFrontendIP = 64000
+	// maxOutboundRuleFrontendIPs is the maximum number of frontend IP addresses that can be used by an outbound rule.
+	maxOutboundRuleFrontendIPs = 16
+	// gatewayLoadBalancerFrontendResourceType is the resource type of the frontend IP configurations of Gateway Load Balancers.
+	gatewayLoadBalancerFrontendResourceType = "Microsoft.Network/loadBalancers/frontendIPConfigurations"
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object. maxNodes is the maximum number of nodes of the
+// cluster, i.e. the sum of the maximum of all worker pools.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisazure.ControlPlaneConfig, version string, maxNodes int32, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+		allErrs = append(allErrs, validateResourceRequirements(controlPlaneConfig.CloudControllerManager.Resources, fldPath.Child("cloudControllerManager", "resources"))...)
+	}
+
+	if controlPlaneConfig.CloudNodeManager != nil {
+		allErrs = append(allErrs, validateResourceRequirements(controlPlaneConfig.CloudNodeManager.Resources, fldPath.Child("cloudNodeManager", "resources"))...)
+	}
+
+	if controlPlaneConfig.CSISnapshotController != nil {
+		allErrs = append(allErrs, validateCSISnapshotController(controlPlaneConfig.CSISnapshotController, fldPath.Child("csiSnapshotController"))...)
+	}
+
+	if controlPlaneConfig.LoadBalancer != nil && controlPlaneConfig.LoadBalancer.OutboundRules != nil {
+		allErrs = append(allErrs, validateOutboundRules(controlPlaneConfig.LoadBalancer.OutboundRules, maxNodes, fldPath.Child("loadBalancer", "outboundRules"))...)
+	}
+
+	if controlPlaneConfig.LoadBalancer != nil && controlPlaneConfig.LoadBalancer.GatewayLoadBalancer != nil {
+		allErrs = append(allErrs, validateGatewayLoadBalancer(controlPlaneConfig.LoadBalancer, fldPath.Child("loadBalancer", "gatewayLoadBalancer"))...)
+	}
+
+	if controlPlaneConfig.Storage != nil && controlPlaneConfig.Storage.DefaultStorageClassVolumeBindingMode != nil {
+		if mode := *controlPlaneConfig.Storage.DefaultStorageClassVolumeBindingMode; !slices.Contains(supportedVolumeBindingModes, string(mode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("storage", "defaultStorageClassVolumeBindingMode"), mode, supportedVolumeBindingModes))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigAgainstWorkers validates a ControlPlaneConfig object against the worker pools of the shoot.
+func ValidateControlPlaneConfigAgainstWorkers(controlPlaneConfig *apisazure.ControlPlaneConfig, workers []core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.Storage == nil || ptr.Deref(controlPlaneConfig.Storage.DefaultStorageClassVolumeBindingMode, storagev1.VolumeBindingWaitForFirstConsumer) != storagev1.VolumeBindingImmediate {
+		return allErrs
+	}
+
+	// Azure disks can only be attached to machines in their zone. A volume which is bound immediately is provisioned in
+	// any of the zones of the worker pools, hence its pod could not be scheduled if the worker pools span several zones.
+	zones := sets.New[string]()
+	for _, worker := range workers {
+		zones.Insert(worker.Zones...)
+	}
+	if zones.Len() > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("storage", "defaultStorageClassVolumeBindingMode"),
+			fmt.Sprintf("%s volume binding is only supported if the worker pools use at most one zone, but they use the zones %s", storagev1.VolumeBindingImmediate, strings.Join(sets.List(zones), ", "))))
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigAgainstInfrastructure validates a ControlPlaneConfig object against the InfrastructureConfig
+// of the shoot.
+func ValidateControlPlaneConfigAgainstInfrastructure(controlPlaneConfig *apisazure.ControlPlaneConfig, infraConfig *apisazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.LoadBalancer == nil || controlPlaneConfig.LoadBalancer.GatewayLoadBalancer == nil {
+		return allErrs
+	}
+
+	// the NAT gateway takes precedence over the outbound rules for the egress traffic of a subnet, hence the egress
+	// traffic would bypass the Gateway Load Balancer.
+	natGatewayEnabled := infraConfig.Networks.NatGateway != nil && infraConfig.Networks.NatGateway.Enabled
+	for _, zone := range infraConfig.Networks.Zones {
+		natGatewayEnabled = natGatewayEnabled || (zone.NatGateway != nil && zone.NatGateway.Enabled)
+	}
+	if natGatewayEnabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("loadBalancer", "gatewayLoadBalancer"), "a Gateway Load Balancer cannot be chained if the egress traffic of the worker subnets uses a NAT gateway"))
+	}
+
+	return allErrs
+}
+
+var supportedVolumeBindingModes = []string{
+	string(storagev1.VolumeBindingImmediate),
+	string(storagev1.VolumeBindingWaitForFirstConsumer),
+}
+
+var supportedResourceNames = []string{
+	string(corev1.ResourceCPU),
+	string(corev1.ResourceMemory),
+}
+
+func validateCSISnapshotController(config *apisazure.CSISnapshotControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !ptr.Deref(config.Enabled, true) {
+		if config.Replicas != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("replicas"), "replicas cannot be configured if the csi-snapshot-controller is disabled"))
+		}
+		if config.Resources != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("resources"), "resources cannot be configured if the csi-snapshot-controller is disabled"))
+		}
+		return allErrs
+	}
+
+	if config.Replicas != nil && *config.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *config.Replicas, "must be at least 1, disable the csi-snapshot-controller instead"))
+	}
+	allErrs = append(allErrs, validateResourceRequirements(config.Resources, fldPath.Child("resources"))...)
+
+	return allErrs
+}
+
+// validateResourceRequirements validates the resource overrides of a control plane component. The quantities are
+// already parsed when the ControlPlaneConfig is decoded, hence only their values are validated.
+func validateResourceRequirements(resources *corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resources == nil {
+		return allErrs
+	}
+
+	for _, list := range []struct {
+		name      string
+		resources corev1.ResourceList
+	}{{"requests", resources.Requests}, {"limits", resources.Limits}} {
+		for _, name := range slices.Sorted(maps.Keys(list.resources)) {
+			quantityPath := fldPath.Child(list.name, string(name))
+			if !slices.Contains(supportedResourceNames, string(name)) {
+				allErrs = append(allErrs, field.NotSupported(quantityPath, name, supportedResourceNames))
+				continue
+			}
+			if quantity := list.resources[name]; quantity.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(quantityPath, quantity.String(), "must be greater than or equal to 0"))
+			}
+		}
+	}
+
+	for name, limit := range resources.Limits {
+		if request, ok := resources.Requests[name]; ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests", string(name)), request.String(), fmt.Sprintf("must be less than or equal to the %s limit of %s", name, limit.String())))
+		}
+	}
+
+	if len(resources.Claims) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("claims"), "resource claims are not supported"))
+	}
+
+	return allErrs
+}
+
+func validateGatewayLoadBalancer(lb *apisazure.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lb.OutboundRules == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "requires outbound rules, as the Gateway Load Balancer is chained to their frontend IP configurations"))
+	}
+
+	idPath := fldPath.Child("frontendIPConfigurationID")
+	resourceID, err := arm.ParseResourceID(lb.GatewayLoadBalancer.FrontendIPConfigurationID)
+	if err != nil {
+		return append(allErrs, field.Invalid(idPath, lb.GatewayLoadBalancer.FrontendIPConfigurationID, fmt.Sprintf("invalid frontend IP configuration ID: %v", err)))
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), gatewayLoadBalancerFrontendResourceType) {
+		allErrs = append(allErrs, field.Invalid(idPath, lb.GatewayLoadBalancer.FrontendIPConfigurationID, fmt.Sprintf("must be the ID of a resource of type %s", gatewayLoadBalancerFrontendResourceType)))
+	}
+	allErrs = append(allErrs, validateResourceID(resourceID, nil, idPath)...)
+
+	return allErrs
+}
+
+func validateOutboundRules(rules *apisazure.OutboundRules, maxNodes int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rules.TCP == nil && rules.UDP == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of 'tcp' or 'udp' must be configured"))
+	}
+
+	frontendIPCount := ptr.Deref(rules.FrontendIPCount, 1)
+	if frontendIPCount < 1 || frontendIPCount > maxOutboundRuleFrontendIPs {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("frontendIPCount"), frontendIPCount, fmt.Sprintf("must be between 1 and %d", maxOutboundRuleFrontendIPs)))
+	}
+
+	// the SNAT ports of the frontend IPs are shared by all nodes of the cluster.
+	budget := int64(frontendIPCount) * int64(snatPortsPerFrontendIP)
+	if rules.TCP != nil {
+		allErrs = append(allErrs, validateOutboundRuleAllocation(rules.TCP, maxNodes, budget, fldPath.Child("tcp"))...)
+	}
+	if rules.UDP != nil {
+		allErrs = append(allErrs, validateOutboundRuleAllocation(rules.UDP, maxNodes, budget, fldPath.Child("udp"))...)
+	}
+
+	return allErrs
+}
+
+func validateOutboundRuleAllocation(allocation *apisazure.OutboundRuleAllocation, maxNodes int32, budget int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ports := allocation.AllocatedOutboundPorts
+	portsPath := fldPath.Child("allocatedOutboundPorts")
+	switch {
+	case ports <= 0 || ports > snatPortsPerFrontendIP:
+		allErrs = append(allErrs, field.Invalid(portsPath, ports, fmt.Sprintf("must be between 1 and %d", snatPortsPerFrontendIP)))
+	case ports%8 != 0:
+		allErrs = append(allErrs, field.Invalid(portsPath, ports, "must be a multiple of 8"))
+	case int64(ports)*int64(maxNodes) > budget:
+		allErrs = append(allErrs, field.Invalid(portsPath, ports, fmt.Sprintf("allocating %d ports to each of the maximum %d nodes exceeds the %d SNAT ports available on the frontend IPs", ports, maxNodes, budget)))
+	}
+
+	if timeout := allocation.IdleTimeoutMinutes; timeout != nil && (*timeout < 4 || *timeout > 120) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutMinutes"), *timeout, "must be between 4 and 120"))
 	}
 
 	return allErrs
@@ -5,10 +5,15 @@
 package validation_test
 
 import (
+	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
@@ -26,7 +31,7 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 
 	Describe("#ValidateControlPlaneConfig", func() {
 		It("should return no errors for a valid configuration", func() {
-			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+			Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(BeEmpty())
 		})
 
 		It("should fail with invalid CCM feature gates", func() {
@@ -37,7 +42,7 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				},
 			}
 
-			errorList := ValidateControlPlaneConfig(controlPlane, "1.32.0", fldPath)
+			errorList := ValidateControlPlaneConfig(controlPlane, "1.32.0", 0, fldPath)
 
 			Expect(errorList).To(ConsistOf(
 				PointTo(MatchFields(IgnoreExtras, Fields{
@@ -46,5 +51,327 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		Context("resources", func() {
+			It("should allow resource overrides of the cloud-controller-manager and cloud-node-manager", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					},
+				}
+				controlPlane.CloudNodeManager = &apisazure.CloudNodeManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("20m")},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid resource overrides", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi"), corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					},
+				}
+				controlPlane.CloudNodeManager = &apisazure.CloudNodeManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-10m")},
+						Claims:   []corev1.ResourceClaim{{Name: "foo"}},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("cloudControllerManager.resources.requests.ephemeral-storage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudControllerManager.resources.requests.memory"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudNodeManager.resources.requests.cpu"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("cloudNodeManager.resources.claims"),
+					})),
+				))
+			})
+		})
+
+		Context("csi-snapshot-controller", func() {
+			It("should allow configuring the replicas and resources", func() {
+				controlPlane.CSISnapshotController = &apisazure.CSISnapshotControllerConfig{
+					Replicas: ptr.To[int32](2),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(BeEmpty())
+			})
+
+			It("should allow disabling the csi-snapshot-controller", func() {
+				controlPlane.CSISnapshotController = &apisazure.CSISnapshotControllerConfig{Enabled: ptr.To(false)}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid replicas and resources", func() {
+				controlPlane.CSISnapshotController = &apisazure.CSISnapshotControllerConfig{
+					Replicas: ptr.To[int32](0),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiSnapshotController.replicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiSnapshotController.resources.requests.memory"),
+					})),
+				))
+			})
+
+			It("should forbid configuring a disabled csi-snapshot-controller", func() {
+				controlPlane.CSISnapshotController = &apisazure.CSISnapshotControllerConfig{
+					Enabled:   ptr.To(false),
+					Replicas:  ptr.To[int32](2),
+					Resources: &corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("csiSnapshotController.replicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("csiSnapshotController.resources"),
+					})),
+				))
+			})
+		})
+
+		Context("outbound rules", func() {
+			BeforeEach(func() {
+				controlPlane.LoadBalancer = &apisazure.LoadBalancerConfig{
+					OutboundRules: &apisazure.OutboundRules{
+						FrontendIPCount: ptr.To[int32](2),
+						TCP:             &apisazure.OutboundRuleAllocation{AllocatedOutboundPorts: 1024, IdleTimeoutMinutes: ptr.To[int32](4)},
+						UDP:             &apisazure.OutboundRuleAllocation{AllocatedOutboundPorts: 256},
+					},
+				}
+			})
+
+			It("should allow allocations within the port budget", func() {
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 100, fldPath)).To(BeEmpty())
+			})
+
+			It("should require at least one protocol", func() {
+				controlPlane.LoadBalancer.OutboundRules.TCP = nil
+				controlPlane.LoadBalancer.OutboundRules.UDP = nil
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 100, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("loadBalancer.outboundRules"),
+					})),
+				))
+			})
+
+			It("should forbid invalid allocations", func() {
+				controlPlane.LoadBalancer.OutboundRules.FrontendIPCount = ptr.To[int32](0)
+				controlPlane.LoadBalancer.OutboundRules.TCP.AllocatedOutboundPorts = 1000
+				controlPlane.LoadBalancer.OutboundRules.TCP.IdleTimeoutMinutes = ptr.To[int32](2)
+				controlPlane.LoadBalancer.OutboundRules.UDP.AllocatedOutboundPorts = 0
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 1, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.outboundRules.frontendIPCount"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.outboundRules.tcp.allocatedOutboundPorts"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.outboundRules.tcp.idleTimeoutMinutes"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.outboundRules.udp.allocatedOutboundPorts"),
+					})),
+				))
+			})
+
+			It("should forbid allocations exceeding the port budget", func() {
+				// 2 frontend IPs * 64000 ports = 128000 ports, 126 nodes * 1024 ports = 129024 ports
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 126, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.outboundRules.tcp.allocatedOutboundPorts"),
+					})),
+				))
+			})
+		})
+
+		Context("gateway load balancer", func() {
+			const frontendID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/nva/providers/Microsoft.Network/loadBalancers/gwlb/frontendIPConfigurations/frontend"
+
+			BeforeEach(func() {
+				controlPlane.LoadBalancer = &apisazure.LoadBalancerConfig{
+					OutboundRules:       &apisazure.OutboundRules{TCP: &apisazure.OutboundRuleAllocation{AllocatedOutboundPorts: 1024}},
+					GatewayLoadBalancer: &apisazure.GatewayLoadBalancer{FrontendIPConfigurationID: frontendID},
+				}
+			})
+
+			It("should allow a frontend IP configuration of a load balancer", func() {
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 10, fldPath)).To(BeEmpty())
+			})
+
+			It("should require outbound rules", func() {
+				controlPlane.LoadBalancer.OutboundRules = nil
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 10, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("loadBalancer.gatewayLoadBalancer"),
+					})),
+				))
+			})
+
+			It("should forbid invalid IDs", func() {
+				controlPlane.LoadBalancer.GatewayLoadBalancer.FrontendIPConfigurationID = "gwlb"
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 10, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.gatewayLoadBalancer.frontendIPConfigurationID"),
+					})),
+				))
+			})
+
+			It("should forbid IDs of other resource types", func() {
+				controlPlane.LoadBalancer.GatewayLoadBalancer.FrontendIPConfigurationID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/nva/providers/Microsoft.Network/loadBalancers/gwlb"
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 10, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.gatewayLoadBalancer.frontendIPConfigurationID"),
+					})),
+				))
+			})
+		})
+
+		Context("storage", func() {
+			It("should allow the supported volume binding modes", func() {
+				for _, mode := range []storagev1.VolumeBindingMode{storagev1.VolumeBindingImmediate, storagev1.VolumeBindingWaitForFirstConsumer} {
+					controlPlane.Storage = &apisazure.Storage{DefaultStorageClassVolumeBindingMode: ptr.To(mode)}
+
+					Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(BeEmpty())
+				}
+			})
+
+			It("should forbid unsupported volume binding modes", func() {
+				controlPlane.Storage = &apisazure.Storage{DefaultStorageClassVolumeBindingMode: ptr.To[storagev1.VolumeBindingMode]("Later")}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, "", 0, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.defaultStorageClassVolumeBindingMode"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigAgainstWorkers", func() {
+		var workers []core.Worker
+
+		BeforeEach(func() {
+			controlPlane.Storage = &apisazure.Storage{DefaultStorageClassVolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate)}
+			workers = []core.Worker{{Name: "pool-a", Zones: []string{"1"}}, {Name: "pool-b", Zones: []string{"1"}}}
+		})
+
+		It("should allow immediate volume binding if the worker pools use one zone", func() {
+			Expect(ValidateControlPlaneConfigAgainstWorkers(controlPlane, workers, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow immediate volume binding for non-zonal worker pools", func() {
+			workers = []core.Worker{{Name: "pool-a"}}
+
+			Expect(ValidateControlPlaneConfigAgainstWorkers(controlPlane, workers, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid immediate volume binding if the worker pools use several zones", func() {
+			workers[1].Zones = []string{"1", "2"}
+
+			Expect(ValidateControlPlaneConfigAgainstWorkers(controlPlane, workers, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("storage.defaultStorageClassVolumeBindingMode"),
+					"Detail": ContainSubstring("they use the zones 1, 2"),
+				})),
+			))
+		})
+
+		It("should allow waiting for the first consumer if the worker pools use several zones", func() {
+			controlPlane.Storage.DefaultStorageClassVolumeBindingMode = nil
+			workers[1].Zones = []string{"2", "3"}
+
+			Expect(ValidateControlPlaneConfigAgainstWorkers(controlPlane, workers, fldPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigAgainstInfrastructure", func() {
+		var infra *apisazure.InfrastructureConfig
+
+		BeforeEach(func() {
+			controlPlane.LoadBalancer = &apisazure.LoadBalancerConfig{
+				GatewayLoadBalancer: &apisazure.GatewayLoadBalancer{FrontendIPConfigurationID: "frontend"},
+			}
+			infra = &apisazure.InfrastructureConfig{}
+		})
+
+		It("should allow a gateway load balancer without NAT gateways", func() {
+			Expect(ValidateControlPlaneConfigAgainstInfrastructure(controlPlane, infra, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a gateway load balancer with a NAT gateway", func() {
+			infra.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructure(controlPlane, infra, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancer.gatewayLoadBalancer"),
+				})),
+			))
+		})
+
+		It("should forbid a gateway load balancer with a zonal NAT gateway", func() {
+			infra.Networks.Zones = []apisazure.Zone{{Name: 1}, {Name: 2, NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}}}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructure(controlPlane, infra, fldPath)).To(HaveLen(1))
+		})
+
+		It("should allow NAT gateways without a gateway load balancer", func() {
+			controlPlane.LoadBalancer = nil
+			infra.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructure(controlPlane, infra, fldPath)).To(BeEmpty())
+		})
 	})
 })
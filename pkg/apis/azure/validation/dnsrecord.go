@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+var availableDNSZoneTypes = []string{
+	string(apisazure.DNSZoneTypePublic),
+	string(apisazure.DNSZoneTypePrivate),
+}
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object of a DNSRecord in the given namespace.
+func ValidateDNSRecordConfig(config *apisazure.DNSRecordConfig, namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	if config.ResourceGroup != nil {
+		allErrs = append(allErrs, validateResourceGroupName(*config.ResourceGroup, fldPath.Child("resourceGroup"))...)
+	}
+
+	if ref := config.SecretRef; ref != nil {
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "must provide the name of the secret with the DNS credentials"))
+		}
+		if len(ref.Namespace) > 0 && ref.Namespace != namespace {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretRef", "namespace"), "must be the namespace of the DNSRecord"))
+		}
+	}
+
+	if zoneType := config.ZoneType; zoneType != nil {
+		if !slices.Contains(availableDNSZoneTypes, string(*zoneType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("zoneType"), *zoneType, availableDNSZoneTypes))
+		}
+		if *zoneType == apisazure.DNSZoneTypePrivate && config.ResourceGroup == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup"), "must provide the resource group of the private DNS zone"))
+		}
+	}
+
+	for key := range config.Tags {
+		if key == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tags"), key, "tag keys must not be empty"))
+		} else if strings.HasPrefix(strings.ToLower(key), azure.DNSMetadataGardenerPrefix) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tags").Key(key), "tag keys starting with \""+azure.DNSMetadataGardenerPrefix+"\" are reserved for the metadata managed by Gardener"))
+		}
+	}
+	if ptr.Deref(config.TagZone, false) && len(config.Tags) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tags"), "must provide the tags which are added to the DNS zone"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	const namespace = "garden"
+
+	var fldPath = field.NewPath("providerConfig")
+
+	Describe("#ValidateDNSRecordConfig", func() {
+		It("should allow an empty config", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{}, namespace, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a resource group and secret reference", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				ResourceGroup: ptr.To("dns-rg"),
+				SecretRef:     &corev1.SecretReference{Name: "dns-credentials", Namespace: "garden"},
+			}, namespace, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid resource group name", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				ResourceGroup: ptr.To("dns rg!"),
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.resourceGroup"),
+			}))))
+		})
+
+		It("should require the name of the secret", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				SecretRef: &corev1.SecretReference{Namespace: "garden"},
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.secretRef.name"),
+			}))))
+		})
+
+		It("should allow a secret reference without a namespace", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				SecretRef: &corev1.SecretReference{Name: "dns-credentials"},
+			}, namespace, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a secret in another namespace", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				SecretRef: &corev1.SecretReference{Name: "dns-credentials", Namespace: "other"},
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.secretRef.namespace"),
+			}))))
+		})
+
+		It("should allow a private zone in a resource group", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				ResourceGroup: ptr.To("dns-rg"),
+				ZoneType:      ptr.To(apisazure.DNSZoneTypePrivate),
+			}, namespace, fldPath)).To(BeEmpty())
+		})
+
+		It("should require the resource group of a private zone", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				ZoneType: ptr.To(apisazure.DNSZoneTypePrivate),
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.resourceGroup"),
+			}))))
+		})
+
+		It("should forbid an unsupported zone type", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				ZoneType: ptr.To(apisazure.DNSZoneType("Internal")),
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.zoneType"),
+			}))))
+		})
+
+		It("should allow tags for the recordset and the zone", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				Tags:    map[string]string{"cost-center": "1234"},
+				TagZone: ptr.To(true),
+			}, namespace, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid empty and reserved tag keys", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				Tags: map[string]string{"": "empty", "Gardener_owner": "shoot"},
+			}, namespace, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.tags"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.tags[Gardener_owner]"),
+				})),
+			))
+		})
+
+		It("should require tags if the zone is tagged", func() {
+			Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{
+				TagZone: ptr.To(true),
+			}, namespace, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.tags"),
+			}))))
+		})
+	})
+})
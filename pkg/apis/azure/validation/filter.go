@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -19,22 +20,38 @@ import (
 var (
 	resourceGroupNameRegex       = `^[A-Za-z0-9_().-]{1,89}[A-Za-z0-9_()-]$`
 	vnetNameRegex                = `^[A-Za-z0-9][\w.-]*[\w]$`
+	securityGroupNameRegex       = `^[A-Za-z0-9]([\w.-]*\w)?$`
 	genericAzureNameRegex        = `^[A-Za-z0-9][\w-]*$`
 	serviceEndpointsRegex        = `^Microsoft\.[A-Za-z0-9.]+$`
+	subnetDelegationRegex        = `^Microsoft\.[A-Za-z0-9.]+/[A-Za-z0-9]+$`
 	storageURIRegex              = `^https://[a-z0-9-]{3,24}\.blob\.core[^\s]+/[^\s]+$`
 	urnRegex                     = `^[\w-]+:[\w-]+:[\w.-]+:[\w.-]+$`
 	sharedGalleryImageIDRegex    = `^/SharedGalleries/[\w-]+/Images/[\w-]+/Versions/[\w.-]+$`
 	communityGalleryImageIDRegex = `^/CommunityGalleries/[\w-]+/Images/[\w-]+/Versions/[\w.-]+$`
+	additionalSubnetNameRegex    = `^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	locationRegex                = `^[a-z][a-z0-9]*$`
+	timezoneRegex                = `^[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9_+-]+)*$`
+	// imageReferenceRegex follows the reference grammar of the distribution project: an optional registry host with port,
+	// the repository path and an optional tag and digest.
+	imageReferenceRegex = `^(([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)(\.([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?))*(:[0-9]+)?/)?[a-z0-9]+(([._]|__|-+)[a-z0-9]+)*(/[a-z0-9]+(([._]|__|-+)[a-z0-9]+)*)*(:[\w][\w.-]{0,127})?(@sha256:[a-f0-9]{64})?$`
 
 	validateServiceEndpoint           = combineValidationFuncs(regex(serviceEndpointsRegex), minLength(9), maxLength(120))
+	validateSubnetDelegation          = combineValidationFuncs(regex(subnetDelegationRegex), maxLength(120))
 	validateResourceGroupName         = combineValidationFuncs(regex(resourceGroupNameRegex), notEmpty, maxLength(90))
 	validateVnetName                  = combineValidationFuncs(regex(vnetNameRegex), minLength(2), maxLength(64))
+	validateSecurityGroupName         = combineValidationFuncs(regex(securityGroupNameRegex), notEmpty, maxLength(80))
 	validateGenericName               = combineValidationFuncs(regex(genericAzureNameRegex), minLength(3), maxLength(120))
 	validatePublicIPName              = combineValidationFuncs(regex(genericAzureNameRegex), notEmpty, maxLength(80))
+	validatePublicIPPrefixName        = combineValidationFuncs(regex(genericAzureNameRegex), notEmpty, maxLength(80))
 	storageURIValidation              = combineValidationFuncs(urlFilter, regex(storageURIRegex), notEmpty)
 	urnValidation                     = combineValidationFuncs(regex(urnRegex), notEmpty, maxLength(256))
 	sharedGalleryImageIDValidation    = combineValidationFuncs(regex(sharedGalleryImageIDRegex), notEmpty, maxLength(512))
 	communityGalleryImageIDValidation = combineValidationFuncs(regex(communityGalleryImageIDRegex), notEmpty, maxLength(512))
+	// the name is appended to the technical name of the shoot, which leaves 32 of the 80 characters of a subnet name.
+	validateAdditionalSubnetName = combineValidationFuncs(regex(additionalSubnetNameRegex), notEmpty, maxLength(32))
+	validateImageReference       = combineValidationFuncs(regex(imageReferenceRegex), notEmpty, maxLength(512))
+	validateLocation             = combineValidationFuncs(regex(locationRegex), notEmpty, maxLength(64))
+	validateTimezoneName         = combineValidationFuncs(regex(timezoneRegex), notEmpty, maxLength(64))
 )
 
 type validateFunc[T any] func(T, *field.Path) field.ErrorList
@@ -121,3 +138,17 @@ func validateResourceID(rid *arm.ResourceID, resourceType *string, fld *field.Pa
 
 	return allErrs
 }
+
+func validateResourceIDOfType(id, resourceType string, fldPath *field.Path) field.ErrorList {
+	if id == "" {
+		return field.ErrorList{field.Required(fldPath, fmt.Sprintf("the ID of a %s resource must be specified", resourceType))}
+	}
+	resourceID, err := arm.ParseResourceID(id)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, id, fmt.Sprintf("invalid resource ID: %v", err))}
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), resourceType) {
+		return field.ErrorList{field.Invalid(fldPath, id, fmt.Sprintf("must be the ID of a %s resource", resourceType))}
+	}
+	return validateResourceID(resourceID, nil, fldPath)
+}
@@ -6,25 +6,41 @@ package validation
 
 import (
 	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	"github.com/google/uuid"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 const (
 	natGatewayMinTimeoutInMinutes int32 = 4
 	natGatewayMaxTimeoutInMinutes int32 = 120
+	// natGatewayMaxPublicIPs is the maximum number of public IPs Azure allows to assign to a NAT gateway.
+	natGatewayMaxPublicIPs int32 = 16
+
+	// defaultMaxPods is the default maximum number of pods per node of the kubelet.
+	defaultMaxPods int32 = 110
+	// subnetReservedIPAddresses is the number of IP addresses Azure reserves in each subnet.
+	subnetReservedIPAddresses = 5
 )
 
 // ValidateInfrastructureConfigAgainstCloudProfile validates the InfrastructureConfig against the CloudProfile.
@@ -110,19 +126,187 @@ func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, shoot *
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroup"), infra.ResourceGroup, "specifying an existing resource group is not supported yet"))
 	}
 
-	allErrs = append(allErrs, validateNetworkConfig(infra, nodes, pods, services, fldPath)...)
+	if infra.ResourceGroupLocation != nil {
+		allErrs = append(allErrs, validateResourceGroupLocation(*infra.ResourceGroupLocation, shoot.Spec.Region, fldPath.Child("resourceGroupLocation"))...)
+	}
+
+	if infra.NetworkResourceGroup != nil {
+		allErrs = append(allErrs, validateResourceGroupName(infra.NetworkResourceGroup.Name, fldPath.Child("networkResourceGroup", "name"))...)
+		// the network resources are either managed in the network resource group or taken from the existing VNet.
+		if isExternalVnetUsed(&infra.Networks.VNet) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("networkResourceGroup"), "a network resource group cannot be combined with an existing vnet"))
+		}
+	}
+
+	allErrs = append(allErrs, validateNetworkConfig(infra, shoot, nodes, pods, services, fldPath)...)
 
 	if infra.Identity != nil {
 		path := fldPath.Child("identity")
 		allErrs = append(allErrs, validateResourceGroupName(infra.Identity.ResourceGroup, path.Child("resourceGroup"))...)
 		allErrs = append(allErrs, validateGenericName(infra.Identity.Name, path.Child("name"))...)
+		allErrs = append(allErrs, validateIdentityRoleAssignments(infra.Identity.RoleAssignments, path.Child("roleAssignments"))...)
+		allErrs = append(allErrs, validateIdentityFederatedCredentials(infra.Identity.FederatedCredentials, shoot, path.Child("federatedCredentials"))...)
+	}
+
+	return allErrs
+}
+
+// roleDefinitionResourceType is the resource type of Azure role definitions.
+const roleDefinitionResourceType = "Microsoft.Authorization/roleDefinitions"
+
+// validateIdentityRoleAssignments validates the role assignments of the identity. Whether the scopes are located in the
+// resource group of the shoot is checked by the infrastructure controller, since its name is not known yet on creation.
+func validateIdentityRoleAssignments(assignments []apisazure.IdentityRoleAssignment, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		assigned = sets.New[string]()
+	)
+
+	for i, assignment := range assignments {
+		idxPath := fldPath.Index(i)
+
+		roleDefinitionPath := idxPath.Child("roleDefinitionID")
+		if assignment.RoleDefinitionID == "" {
+			allErrs = append(allErrs, field.Required(roleDefinitionPath, "the role definition must be set"))
+		} else if id, err := arm.ParseResourceID(assignment.RoleDefinitionID); err != nil {
+			allErrs = append(allErrs, field.Invalid(roleDefinitionPath, assignment.RoleDefinitionID, fmt.Sprintf("must be a valid resource ID: %v", err)))
+		} else if !strings.EqualFold(id.ResourceType.String(), roleDefinitionResourceType) {
+			allErrs = append(allErrs, field.Invalid(roleDefinitionPath, assignment.RoleDefinitionID, fmt.Sprintf("must be the resource ID of a resource of type %s", roleDefinitionResourceType)))
+		}
+
+		if assignment.Scope != nil {
+			scopePath := idxPath.Child("scope")
+			if id, err := arm.ParseResourceID(*assignment.Scope); err != nil {
+				allErrs = append(allErrs, field.Invalid(scopePath, *assignment.Scope, fmt.Sprintf("must be a valid resource ID: %v", err)))
+			} else if id.ResourceGroupName == "" {
+				allErrs = append(allErrs, field.Invalid(scopePath, *assignment.Scope, "must be the resource ID of the resource group of the shoot or of a resource in it"))
+			}
+		}
+
+		key := strings.ToLower(assignment.RoleDefinitionID + "@" + ptr.Deref(assignment.Scope, ""))
+		if assigned.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, assignment))
+		}
+		assigned.Insert(key)
+	}
+
+	return allErrs
+}
+
+// maxIdentityFederatedCredentials is the maximum number of federated identity credentials Azure allows per managed
+// identity.
+const maxIdentityFederatedCredentials = 20
+
+// validateIdentityFederatedCredentials validates the federated identity credentials of the identity. They require a
+// service account issuer which is known to be publicly reachable, i.e. a managed or a custom issuer.
+func validateIdentityFederatedCredentials(credentials []apisazure.IdentityFederatedCredential, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(credentials) == 0 {
+		return allErrs
+	}
+
+	if !hasKnownServiceAccountIssuer(shoot) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("federated identity credentials require a managed service account issuer (annotation %s=%s) or a custom one",
+			v1beta1constants.AnnotationAuthenticationIssuer, v1beta1constants.AnnotationAuthenticationIssuerManaged)))
+	}
+	if len(credentials) > maxIdentityFederatedCredentials {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(credentials), maxIdentityFederatedCredentials))
+	}
+
+	var (
+		names           = sets.New[string]()
+		serviceAccounts = sets.New[string]()
+	)
+	for i, credential := range credentials {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, validateGenericName(credential.Name, idxPath.Child("name"))...)
+		if names.Has(strings.ToLower(credential.Name)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), credential.Name))
+		}
+		names.Insert(strings.ToLower(credential.Name))
+
+		serviceAccountPath := idxPath.Child("serviceAccount")
+		if namespace, name, ok := strings.Cut(credential.ServiceAccount, "/"); !ok {
+			allErrs = append(allErrs, field.Invalid(serviceAccountPath, credential.ServiceAccount, "must be in the format <namespace>/<name>"))
+		} else {
+			for _, msg := range k8svalidation.IsDNS1123Label(namespace) {
+				allErrs = append(allErrs, field.Invalid(serviceAccountPath, credential.ServiceAccount, "invalid namespace: "+msg))
+			}
+			for _, msg := range k8svalidation.IsDNS1123Subdomain(name) {
+				allErrs = append(allErrs, field.Invalid(serviceAccountPath, credential.ServiceAccount, "invalid name: "+msg))
+			}
+		}
+		if serviceAccounts.Has(credential.ServiceAccount) {
+			allErrs = append(allErrs, field.Duplicate(serviceAccountPath, credential.ServiceAccount))
+		}
+		serviceAccounts.Insert(credential.ServiceAccount)
+
+		// Azure accepts only a single audience per federated identity credential.
+		if len(credential.Audiences) > 1 {
+			allErrs = append(allErrs, field.TooMany(idxPath.Child("audiences"), len(credential.Audiences), 1))
+		}
+		for j, audience := range credential.Audiences {
+			if audience == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("audiences").Index(j), "the audience must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func hasKnownServiceAccountIssuer(shoot *core.Shoot) bool {
+	if shoot.Annotations[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged {
+		return true
 	}
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	return kubeAPIServer != nil && kubeAPIServer.ServiceAccountConfig != nil && ptr.Deref(kubeAPIServer.ServiceAccountConfig.Issuer, "") != ""
+}
+
+// withoutIdentityAssignments returns a copy of the given identity configuration without its role assignments and
+// federated identity credentials, which can be changed without replacing the nodes.
+func withoutIdentityAssignments(identity *apisazure.IdentityConfig) *apisazure.IdentityConfig {
+	if identity == nil {
+		return nil
+	}
+	identity = identity.DeepCopy()
+	identity.RoleAssignments = nil
+	identity.FederatedCredentials = nil
+	return identity
+}
 
+// validateResourceGroupLocation validates that the location of the resource group is a region of the same Azure cloud
+// as the region of the shoot, because a resource group cannot hold resources of another cloud.
+func validateResourceGroupLocation(location, region string, fldPath *field.Path) field.ErrorList {
+	allErrs := validateLocation(location, fldPath)
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	if cloud, regionCloud := cloudOfRegion(location), cloudOfRegion(region); cloud != regionCloud {
+		allErrs = append(allErrs, field.Invalid(fldPath, location, fmt.Sprintf("must be a region of the %s cloud like the region %q of the shoot", regionCloud, region)))
+	}
 	return allErrs
 }
 
+const azurePublicCloud = "Azure public"
+
+// cloudOfRegion returns the Azure cloud of the given region based on the prefixes of the sovereign cloud regions.
+func cloudOfRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "china"):
+		return "Azure China"
+	case strings.HasPrefix(region, "usgov"), strings.HasPrefix(region, "usdod"):
+		return "Azure Government"
+	default:
+		return azurePublicCloud
+	}
+}
+
 func validateNetworkConfig(
 	infra *apisazure.InfrastructureConfig,
+	shoot *core.Shoot,
 	nodes cidrvalidation.CIDR,
 	pods cidrvalidation.CIDR,
 	services cidrvalidation.CIDR,
@@ -154,7 +338,25 @@ func validateNetworkConfig(
 		workerCIDR = cidrvalidation.NewCIDR(*config.Workers, workersPath)
 	}
 
+	if config.Pods != nil {
+		allErrs = append(allErrs, validatePodSubnet(infra, shoot, workerCIDR, nodes, pods, services, networksPath)...)
+		// the pods get their IP addresses from the delegated pod subnet, hence the pod network is part of the VNet.
+		pods = nil
+	}
+
 	allErrs = append(allErrs, validateVnetConfig(&config, infra.ResourceGroup, workerCIDR, nodes, pods, services, zonesPath, vNetPath)...)
+	allErrs = append(allErrs, validateVNetEncryption(config.VNet.Encryption, shoot.Spec.Region, vNetPath.Child("encryption"))...)
+	allErrs = append(allErrs, validateFlowLogs(config.FlowLogs, networksPath.Child("flowLogs"))...)
+	allErrs = append(allErrs, validateSecurityGroupReference(infra, shoot, networksPath)...)
+	allErrs = append(allErrs, validateAdditionalSubnets(infra, workerCIDR, nodes, pods, services, networksPath)...)
+	allErrs = append(allErrs, validatePrivateLink(infra, workerCIDR, nodes, pods, services, networksPath)...)
+	allErrs = append(allErrs, validateInternalLoadBalancer(infra, workerCIDR, pods, services, networksPath)...)
+	allErrs = append(allErrs, validateGatewaySubnet(infra, workerCIDR, pods, services, networksPath)...)
+	allErrs = append(allErrs, validateRouteServerSubnet(infra, workerCIDR, pods, services, networksPath)...)
+	allErrs = append(allErrs, validatePrivateDNSZone(config.PrivateDNSZone, networksPath.Child("privateDNSZone"))...)
+	allErrs = append(allErrs, validateOutboundLoadBalancer(&config, networksPath.Child("outboundLoadBalancer"))...)
+	allErrs = append(allErrs, validateEgressStrategies(&config, networksPath)...)
+	allErrs = append(allErrs, validatePublicIPDNSLabels(&config, shoot.Name, networksPath)...)
 
 	// handle single subnet layout validation.
 	if helper.IsUsingSingleSubnetLayout(infra) {
@@ -170,6 +372,8 @@ func validateNetworkConfig(
 		for idx := range config.ServiceEndpoints {
 			allErrs = append(allErrs, validateServiceEndpoint(config.ServiceEndpoints[idx], networksPath.Child("serviceEndpoints").Index(idx))...)
 		}
+		allErrs = append(allErrs, validateServiceEndpointPolicies(config.ServiceEndpointPolicies, networksPath.Child("serviceEndpointPolicies"))...)
+		allErrs = append(allErrs, validateSubnetNetworkPolicies(config.PrivateEndpointNetworkPolicies, config.PrivateLinkServiceNetworkPolicies, networksPath)...)
 		return allErrs
 	}
 
@@ -185,11 +389,247 @@ func validateNetworkConfig(
 		allErrs = append(allErrs, field.Forbidden(workersPath, "serviceEndpoints cannot be specified when workers field is missing"))
 	}
 
+	if len(config.ServiceEndpointPolicies) > 0 {
+		allErrs = append(allErrs, field.Forbidden(workersPath, "serviceEndpointPolicies cannot be specified when workers field is missing"))
+	}
+
+	if config.PrivateEndpointNetworkPolicies != nil {
+		allErrs = append(allErrs, field.Forbidden(workersPath, "privateEndpointNetworkPolicies cannot be specified when workers field is missing"))
+	}
+
+	if config.PrivateLinkServiceNetworkPolicies != nil {
+		allErrs = append(allErrs, field.Forbidden(workersPath, "privateLinkServiceNetworkPolicies cannot be specified when workers field is missing"))
+	}
+
 	allErrs = append(allErrs, validateZones(config.Zones, nodes, pods, services, zonesPath)...)
 
 	return allErrs
 }
 
+// subnetNetworkPolicies are the supported values of the private endpoint and private link service network policies of
+// a subnet.
+var subnetNetworkPolicies = sets.New("Enabled", "Disabled")
+
+func validateSubnetNetworkPolicies(privateEndpointNetworkPolicies, privateLinkServiceNetworkPolicies *string, fld *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if privateEndpointNetworkPolicies != nil && !subnetNetworkPolicies.Has(*privateEndpointNetworkPolicies) {
+		allErrs = append(allErrs, field.NotSupported(fld.Child("privateEndpointNetworkPolicies"), *privateEndpointNetworkPolicies, sets.List(subnetNetworkPolicies)))
+	}
+	if privateLinkServiceNetworkPolicies != nil && !subnetNetworkPolicies.Has(*privateLinkServiceNetworkPolicies) {
+		allErrs = append(allErrs, field.NotSupported(fld.Child("privateLinkServiceNetworkPolicies"), *privateLinkServiceNetworkPolicies, sets.List(subnetNetworkPolicies)))
+	}
+	return allErrs
+}
+
+func validateServiceEndpointPolicies(policyIDs []string, fld *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		ids     = sets.New[string]()
+	)
+
+	for idx, id := range policyIDs {
+		idxPath := fld.Index(idx)
+		resourceID, err := arm.ParseResourceID(id)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, id, fmt.Sprintf("invalid service endpoint policy ID: %v", err)))
+			continue
+		}
+		if !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/serviceEndpointPolicies") {
+			allErrs = append(allErrs, field.Invalid(idxPath, id, "must be the ID of a Microsoft.Network/serviceEndpointPolicies resource"))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceID(resourceID, nil, idxPath)...)
+		allErrs = append(allErrs, validateGenericName(resourceID.Name, idxPath)...)
+
+		if ids.Has(strings.ToLower(id)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, id))
+		}
+		ids.Insert(strings.ToLower(id))
+	}
+
+	return allErrs
+}
+
+func validateFlowLogs(config *apisazure.FlowLogsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateResourceIDOfType(config.StorageAccountID, "Microsoft.Storage/storageAccounts", fldPath.Child("storageAccountID"))...)
+
+	if config.RetentionDays != nil && (*config.RetentionDays < 0 || *config.RetentionDays > 365) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *config.RetentionDays, "must be between 0 and 365"))
+	}
+
+	if ta := config.TrafficAnalytics; ta != nil {
+		taPath := fldPath.Child("trafficAnalytics")
+		allErrs = append(allErrs, validateResourceIDOfType(ta.WorkspaceResourceID, "Microsoft.OperationalInsights/workspaces", taPath.Child("workspaceResourceID"))...)
+		if _, err := uuid.Parse(ta.WorkspaceID); err != nil {
+			allErrs = append(allErrs, field.Invalid(taPath.Child("workspaceID"), ta.WorkspaceID, "must be the GUID of the Log Analytics workspace"))
+		}
+		if ta.WorkspaceRegion == "" {
+			allErrs = append(allErrs, field.Required(taPath.Child("workspaceRegion"), "the region of the Log Analytics workspace must be specified"))
+		}
+		if ta.IntervalMinutes != nil && *ta.IntervalMinutes != 10 && *ta.IntervalMinutes != 60 {
+			allErrs = append(allErrs, field.NotSupported(taPath.Child("intervalMinutes"), *ta.IntervalMinutes, []string{"10", "60"}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSecurityGroupReference(infra *apisazure.InfrastructureConfig, shoot *core.Shoot, networksPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	config := infra.Networks.SecurityGroup
+	if config == nil {
+		return allErrs
+	}
+	fldPath := networksPath.Child("securityGroup")
+
+	allErrs = append(allErrs, validateSecurityGroupName(config.Name, fldPath.Child("name"))...)
+	allErrs = append(allErrs, validateResourceGroupName(config.ResourceGroup, fldPath.Child("resourceGroup"))...)
+	// the resource group of the shoot is deleted together with all of its resources.
+	if shoot != nil && shoot.Status.TechnicalID != "" && strings.EqualFold(config.ResourceGroup, shoot.Status.TechnicalID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroup"), config.ResourceGroup, "must not be the resource group of the shoot"))
+	}
+	return allErrs
+}
+
+const (
+	minPrivateDNSZoneLabels = 2
+	maxPrivateDNSZoneLabels = 34
+)
+
+func validatePrivateDNSZone(config *apisazure.PrivateDNSZoneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	namePath := fldPath.Child("name")
+	if config.Name == "" {
+		return append(allErrs, field.Required(namePath, "the name of the private DNS zone must be specified"))
+	}
+	for _, msg := range k8svalidation.IsDNS1123Subdomain(config.Name) {
+		allErrs = append(allErrs, field.Invalid(namePath, config.Name, msg))
+	}
+	if labels := len(strings.Split(config.Name, ".")); labels < minPrivateDNSZoneLabels || labels > maxPrivateDNSZoneLabels {
+		allErrs = append(allErrs, field.Invalid(namePath, config.Name, fmt.Sprintf("must consist of %d to %d labels", minPrivateDNSZoneLabels, maxPrivateDNSZoneLabels)))
+	}
+
+	return allErrs
+}
+
+const (
+	maxOutboundLoadBalancerPublicIPs = 16
+	maxAllocatedOutboundPorts        = 64000
+	minOutboundIdleTimeoutMinutes    = 4
+	maxOutboundIdleTimeoutMinutes    = 100
+)
+
+// validateOutboundLoadBalancer validates the configuration of the outbound load balancer.
+func validateOutboundLoadBalancer(config *apisazure.NetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	lb := config.OutboundLoadBalancer
+	if lb == nil {
+		return allErrs
+	}
+
+	if count := lb.PublicIPCount; count != nil && (*count < 1 || *count > maxOutboundLoadBalancerPublicIPs) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("publicIPCount"), *count, fmt.Sprintf("must be between 1 and %d", maxOutboundLoadBalancerPublicIPs)))
+	}
+	if ports := lb.AllocatedOutboundPorts; ports != nil {
+		if *ports < 8 || *ports > maxAllocatedOutboundPorts || *ports%8 != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allocatedOutboundPorts"), *ports, fmt.Sprintf("must be a multiple of 8 between 8 and %d", maxAllocatedOutboundPorts)))
+		}
+	}
+	if timeout := lb.IdleTimeoutMinutes; timeout != nil && (*timeout < minOutboundIdleTimeoutMinutes || *timeout > maxOutboundIdleTimeoutMinutes) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutMinutes"), *timeout, fmt.Sprintf("must be between %d and %d", minOutboundIdleTimeoutMinutes, maxOutboundIdleTimeoutMinutes)))
+	}
+
+	return allErrs
+}
+
+// egressStrategy is a way for the nodes to reach the internet together with the fields which configure it.
+type egressStrategy struct {
+	name   string
+	fields []*field.Path
+}
+
+// validateEgressStrategies validates that at most one egress strategy is configured. The nodes can only have one
+// outbound path, so combining strategies would only fail late during the reconciliation of the infrastructure. If no
+// strategy is configured, the nodes use the outbound rules of the load balancer of the cloud-controller-manager.
+func validateEgressStrategies(config *apisazure.NetworkConfig, networksPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	natGateway := egressStrategy{name: "NAT gateway"}
+	if config.NatGateway != nil && config.NatGateway.Enabled {
+		natGateway.fields = append(natGateway.fields, networksPath.Child("natGateway"))
+	}
+	for i, zone := range config.Zones {
+		if zone.NatGateway != nil && zone.NatGateway.Enabled {
+			natGateway.fields = append(natGateway.fields, networksPath.Child("zones").Index(i).Child("natGateway"))
+		}
+	}
+	outboundLoadBalancer := egressStrategy{name: "outbound load balancer"}
+	if config.OutboundLoadBalancer != nil {
+		outboundLoadBalancer.fields = append(outboundLoadBalancer.fields, networksPath.Child("outboundLoadBalancer"))
+	}
+
+	var (
+		configured []egressStrategy
+		conflicts  []string
+	)
+	for _, strategy := range []egressStrategy{natGateway, outboundLoadBalancer} {
+		if len(strategy.fields) == 0 {
+			continue
+		}
+		configured = append(configured, strategy)
+		fields := make([]string, 0, len(strategy.fields))
+		for _, fldPath := range strategy.fields {
+			fields = append(fields, fldPath.String())
+		}
+		conflicts = append(conflicts, fmt.Sprintf("%s (%s)", strategy.name, strings.Join(fields, ", ")))
+	}
+	if len(configured) < 2 {
+		return allErrs
+	}
+
+	// The conflict is reported on the fields of all but the first strategy.
+	for _, strategy := range configured[1:] {
+		allErrs = append(allErrs, field.Forbidden(strategy.fields[0], fmt.Sprintf("only one egress strategy can be configured, but found conflicting fields: %s", strings.Join(conflicts, ", "))))
+	}
+	return allErrs
+}
+
+var availableVNetEncryptionEnforcements = []string{
+	string(apisazure.VNetEncryptionEnforcementAllowUnencrypted),
+	string(apisazure.VNetEncryptionEnforcementDropUnencrypted),
+}
+
+// validateVNetEncryption validates the encryption of the vnet. Azure only offers the encryption of vnets in the regions
+// of its public cloud.
+func validateVNetEncryption(encryption *apisazure.VNetEncryption, region string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if encryption == nil {
+		return allErrs
+	}
+
+	if enforcement := encryption.Enforcement; enforcement != nil && !slices.Contains(availableVNetEncryptionEnforcements, string(*enforcement)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcement"), *enforcement, availableVNetEncryptionEnforcements))
+	}
+	if encryption.Enabled {
+		if cloud := cloudOfRegion(region); cloud != azurePublicCloud {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), fmt.Sprintf("vnet encryption is not available in the region %q of the %s cloud", region, cloud)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupConfig *apisazure.ResourceGroup, workers, nodes, pods, services cidrvalidation.CIDR, zonesPath, vNetPath *field.Path) field.ErrorList {
 	var (
 		allErrs    = field.ErrorList{}
@@ -227,6 +667,10 @@ func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupCon
 			allErrs = append(allErrs, field.Invalid(vNetPath.Child("cidr"), vnetConfig, "specifying a cidr for an existing vnet is not possible"))
 		}
 
+		if len(networkConfig.VNet.AdditionalCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("additionalCIDRs"), "specifying additional cidrs for an existing vnet is not possible"))
+		}
+
 		if resourceGroupConfig != nil && *networkConfig.VNet.ResourceGroup == resourceGroupConfig.Name {
 			allErrs = append(allErrs, field.Invalid(vNetPath.Child("resourceGroup"), *vnetConfig.ResourceGroup, "the vnet resource group must not be the same as the cluster resource group"))
 		}
@@ -234,10 +678,18 @@ func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupCon
 		if networkConfig.VNet.DDosProtectionPlanID != nil {
 			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("ddosProtectionPlanID"), "cannot assign a ddos protection plan to a vnet not managed by Gardener"))
 		}
+
+		if networkConfig.VNet.Encryption != nil {
+			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("encryption"), "cannot configure the encryption of a vnet not managed by Gardener"))
+		}
 		return allErrs
 	}
 
 	if isDefaultVnetConfig(&networkConfig.VNet) {
+		if len(networkConfig.VNet.AdditionalCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("additionalCIDRs"), "a vnet cidr must be specified when specifying additional cidrs"))
+		}
+
 		if workers == nil {
 			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("cidr"), "a vnet cidr or vnet reference must be specified when the workers field is not set"))
 			return allErrs
@@ -248,22 +700,66 @@ func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupCon
 		return allErrs
 	}
 
-	vnetCIDR := cidrvalidation.NewCIDR(*networkConfig.VNet.CIDR, vNetPath.Child("cidr"))
-	allErrs = append(allErrs, vnetCIDR.ValidateParse()...)
-	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(vNetPath.Child("cidr"), *vnetConfig.CIDR)...)
-	allErrs = append(allErrs, vnetCIDR.ValidateSubset(nodes)...)
-	allErrs = append(allErrs, vnetCIDR.ValidateNotOverlap(pods, services)...)
+	vnetCIDRs := vnetAddressPrefixes(&networkConfig.VNet, vNetPath)
+	for _, vnetCIDR := range vnetCIDRs {
+		if errs := vnetCIDR.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(vnetCIDR.GetFieldPath(), vnetCIDR.GetCIDR())...)
+		allErrs = append(allErrs, vnetCIDR.ValidateNotOverlap(pods, services)...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(vnetCIDRs, false)...)
+	// the nodes network may span multiple address prefixes of the vnet, hence it only has to be a subset of a vnet with a
+	// single address prefix.
+	if len(vnetCIDRs) == 1 {
+		allErrs = append(allErrs, vnetCIDRs[0].ValidateSubset(nodes)...)
+	}
 	if workers != nil {
-		allErrs = append(allErrs, vnetCIDR.ValidateSubset(workers)...)
+		allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetCIDRs, workers)...)
 	}
 	for index, zone := range networkConfig.Zones {
 		zoneCIDR := cidrvalidation.NewCIDR(zone.CIDR, zonesPath.Index(index).Child("cidr"))
-		allErrs = append(allErrs, vnetCIDR.ValidateSubset(zoneCIDR)...)
+		allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetCIDRs, zoneCIDR)...)
 	}
 
 	return allErrs
 }
 
+// vnetAddressPrefixes returns the CIDR and the additional CIDRs of the given VNet. It returns nothing if the VNet has no
+// explicit CIDR.
+func vnetAddressPrefixes(vnet *apisazure.VNet, vNetPath *field.Path) []cidrvalidation.CIDR {
+	if vnet.CIDR == nil {
+		return nil
+	}
+
+	prefixes := []cidrvalidation.CIDR{cidrvalidation.NewCIDR(*vnet.CIDR, vNetPath.Child("cidr"))}
+	for i, cidr := range vnet.AdditionalCIDRs {
+		prefixes = append(prefixes, cidrvalidation.NewCIDR(cidr, vNetPath.Child("additionalCIDRs").Index(i)))
+	}
+	return prefixes
+}
+
+// validateSubsetOfVnetAddressPrefixes validates that the given subnet is within one of the address prefixes of the
+// VNet, as Azure does not allow a subnet to span multiple address prefixes.
+func validateSubsetOfVnetAddressPrefixes(prefixes []cidrvalidation.CIDR, subnet cidrvalidation.CIDR) field.ErrorList {
+	switch len(prefixes) {
+	case 0:
+		return nil
+	case 1:
+		return prefixes[0].ValidateSubset(subnet)
+	}
+
+	cidrs := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if len(prefix.ValidateSubset(subnet)) == 0 {
+			return nil
+		}
+		cidrs = append(cidrs, fmt.Sprintf("%q", prefix.GetCIDR()))
+	}
+	return field.ErrorList{field.Invalid(subnet.GetFieldPath(), subnet.GetCIDR(), fmt.Sprintf("must be a subset of one of the vnet address prefixes (%s)", strings.Join(cidrs, ", ")))}
+}
+
 func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.CIDR, fld *field.Path) field.ErrorList {
 	var (
 		allErrs   = field.ErrorList{}
@@ -287,6 +783,8 @@ func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.
 		for idx, se := range zone.ServiceEndpoints {
 			allErrs = append(allErrs, validateServiceEndpoint(se, fld.Child("serviceEndpoints").Index(idx))...)
 		}
+		allErrs = append(allErrs, validateServiceEndpointPolicies(zone.ServiceEndpointPolicies, zonePath.Child("serviceEndpointPolicies"))...)
+		allErrs = append(allErrs, validateSubnetNetworkPolicies(zone.PrivateEndpointNetworkPolicies, zone.PrivateLinkServiceNetworkPolicies, zonePath)...)
 
 		// NAT validation
 		allErrs = append(allErrs, validateZonedNatGatewayConfig(zone.NatGateway, zonePath.Child("natGateway"))...)
@@ -307,6 +805,387 @@ func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.
 	return allErrs
 }
 
+// zoneSubnetNameRegex matches the names which are reserved for the subnets of the zones.
+var zoneSubnetNameRegex = regexp.MustCompile(`^z[0-9]+$`)
+
+func validateAdditionalSubnets(infra *apisazure.InfrastructureConfig, workers, nodes, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		config      = infra.Networks
+		subnetsPath = networksPath.Child("additionalSubnets")
+		names       = sets.New[string]()
+		zones       = sets.New[int32]()
+		cidrs       []cidrvalidation.CIDR
+	)
+
+	if len(config.AdditionalSubnets) == 0 {
+		return allErrs
+	}
+	if isDefaultVnetConfig(&config.VNet) {
+		return append(allErrs, field.Forbidden(subnetsPath, "a vnet cidr or vnet reference must be specified when using additional subnets"))
+	}
+
+	for _, zone := range config.Zones {
+		zones.Insert(zone.Name)
+	}
+
+	for i, subnet := range config.AdditionalSubnets {
+		subnetPath := subnetsPath.Index(i)
+
+		allErrs = append(allErrs, validateAdditionalSubnetName(subnet.Name, subnetPath.Child("name"))...)
+		if zoneSubnetNameRegex.MatchString(subnet.Name) {
+			allErrs = append(allErrs, field.Invalid(subnetPath.Child("name"), subnet.Name, "name is reserved for the subnets of the zones"))
+		}
+		if names.Has(subnet.Name) {
+			allErrs = append(allErrs, field.Duplicate(subnetPath.Child("name"), subnet.Name))
+		}
+		names.Insert(subnet.Name)
+
+		if subnet.Zone != nil {
+			if helper.IsUsingSingleSubnetLayout(infra) {
+				allErrs = append(allErrs, field.Forbidden(subnetPath.Child("zone"), "zone can only be specified for the multiple subnet layout"))
+			} else if !zones.Has(*subnet.Zone) {
+				allErrs = append(allErrs, field.Invalid(subnetPath.Child("zone"), *subnet.Zone, "zone must be specified in \"networks.zones\""))
+			}
+		}
+
+		cidr := cidrvalidation.NewCIDR(subnet.CIDR, subnetPath.Child("cidr"))
+		if errs := cidr.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+		allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), cidr)...)
+		if nodes != nil {
+			allErrs = append(allErrs, nodes.ValidateSubset(cidr)...)
+		}
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(workers, pods, services)...)
+		for index, zone := range config.Zones {
+			allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+		}
+		if config.Pods != nil {
+			allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.Pods.CIDR, networksPath.Child("pods", "cidr")))...)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(cidrs, false)...)
+
+	return allErrs
+}
+
+// ValidateVNetCIDRsAgainstReservedRanges validates that the address prefixes of a VNet managed by Gardener do not overlap
+// with the given reserved ranges, e.g. the address spaces of the hub and the spokes of a hub-spoke network which are
+// peered with the VNet. Existing VNets are not validated, since their address space is not managed by Gardener. Only
+// address prefixes which are not yet used by the old InfrastructureConfig are validated, so that existing Shoots are not
+// blocked if the reserved ranges change. The old InfrastructureConfig may be nil.
+func ValidateVNetCIDRsAgainstReservedRanges(oldInfra, infra *apisazure.InfrastructureConfig, reservedRanges []netip.Prefix, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(reservedRanges) == 0 || infra.Networks.VNet.Name != nil {
+		return allErrs
+	}
+
+	usedPrefixes := sets.New[string]()
+	if oldInfra != nil {
+		for _, prefix := range managedVNetAddressPrefixes(oldInfra, fldPath) {
+			usedPrefixes.Insert(prefix.GetCIDR())
+		}
+	}
+
+	for _, prefix := range managedVNetAddressPrefixes(infra, fldPath) {
+		if usedPrefixes.Has(prefix.GetCIDR()) {
+			continue
+		}
+		parsed, err := netip.ParsePrefix(prefix.GetCIDR())
+		if err != nil {
+			// invalid CIDRs are reported by the validation of the InfrastructureConfig.
+			continue
+		}
+		for _, reserved := range reservedRanges {
+			if parsed.Overlaps(reserved) {
+				allErrs = append(allErrs, field.Invalid(prefix.GetFieldPath(), prefix.GetCIDR(), fmt.Sprintf("must not overlap with the reserved range %q of the shared address space", reserved.String())))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// managedVNetAddressPrefixes returns the address prefixes of the VNet managed by Gardener, which is created with the
+// workers CIDR if the VNet has no explicit CIDR.
+func managedVNetAddressPrefixes(infra *apisazure.InfrastructureConfig, fldPath *field.Path) []cidrvalidation.CIDR {
+	networksPath := fldPath.Child("networks")
+	if infra.Networks.VNet.CIDR == nil {
+		if infra.Networks.Workers == nil {
+			return nil
+		}
+		return []cidrvalidation.CIDR{cidrvalidation.NewCIDR(*infra.Networks.Workers, networksPath.Child("workers"))}
+	}
+	return vnetAddressPrefixes(&infra.Networks.VNet, networksPath.Child("vnet"))
+}
+
+// ValidateInfrastructureConfigAgainstControlPlaneConfig validates that the nodes in the additional subnets which are
+// excluded from the NAT gateway have another egress path, and that the outbound load balancer is not combined with the
+// outbound rules of the load balancer of the cloud-controller-manager. The control plane config may be nil.
+func ValidateInfrastructureConfigAgainstControlPlaneConfig(infra *apisazure.InfrastructureConfig, cpConfig *apisazure.ControlPlaneConfig, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// the outbound rules provide dedicated public IPs for the egress traffic of all nodes, otherwise the nodes fall back
+	// to the default outbound access of their subnet unless it is disabled.
+	outboundRules := cpConfig != nil && cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.OutboundRules != nil
+
+	// a network interface can only be in the backend pool of one public load balancer, hence the nodes cannot be in the
+	// backend pools of both the outbound load balancer and the load balancer of the cloud-controller-manager.
+	if infra.Networks.OutboundLoadBalancer != nil && outboundRules {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "outboundLoadBalancer"),
+			"the outbound load balancer cannot be combined with the outbound rules of the load balancer of the cloud-controller-manager in the ControlPlaneConfig (loadBalancer.outboundRules), because the nodes can only be in the backend pool of one public load balancer"))
+	}
+
+	defaultOutboundAccessDisabled, _ := strconv.ParseBool(shoot.Annotations[azure.DisableDefaultOutboundAccessAnnotation])
+	if outboundRules || !defaultOutboundAccessDisabled {
+		return allErrs
+	}
+
+	for i, subnet := range infra.Networks.AdditionalSubnets {
+		if ptr.Deref(subnet.AttachNatGateway, true) {
+			continue
+		}
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "additionalSubnets").Index(i).Child("attachNatGateway"),
+			fmt.Sprintf("the subnet can only be excluded from the NAT gateway if its nodes have another egress path, i.e. the outbound rules of the load balancer in the ControlPlaneConfig or the default outbound access, which is disabled by the annotation %s", azure.DisableDefaultOutboundAccessAnnotation)))
+	}
+	return allErrs
+}
+
+func validatePrivateLink(infra *apisazure.InfrastructureConfig, workers, nodes, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		config          = infra.Networks
+		privateLinkPath = networksPath.Child("privateLink")
+	)
+
+	if config.PrivateLink == nil {
+		return allErrs
+	}
+	if isDefaultVnetConfig(&config.VNet) {
+		return append(allErrs, field.Forbidden(privateLinkPath, "a vnet cidr or vnet reference must be specified when using private link"))
+	}
+
+	allErrs = append(allErrs, validateResourceIDOfType(config.PrivateLink.EndpointSubnetID, "Microsoft.Network/virtualNetworks/subnets", privateLinkPath.Child("endpointSubnetID"))...)
+
+	cidr := cidrvalidation.NewCIDR(config.PrivateLink.CIDR, privateLinkPath.Child("cidr"))
+	if errs := cidr.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+	allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), cidr)...)
+	if nodes != nil {
+		allErrs = append(allErrs, nodes.ValidateSubset(cidr)...)
+	}
+	allErrs = append(allErrs, cidr.ValidateNotOverlap(workers, pods, services)...)
+	for index, zone := range config.Zones {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+	}
+	if config.Pods != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.Pods.CIDR, networksPath.Child("pods", "cidr")))...)
+	}
+	for index, subnet := range config.AdditionalSubnets {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(subnet.CIDR, networksPath.Child("additionalSubnets").Index(index).Child("cidr")))...)
+	}
+
+	return allErrs
+}
+
+func validateInternalLoadBalancer(infra *apisazure.InfrastructureConfig, workers, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		config  = infra.Networks
+		ilbPath = networksPath.Child("internalLoadBalancer")
+	)
+
+	if config.InternalLoadBalancer == nil {
+		return allErrs
+	}
+	if isDefaultVnetConfig(&config.VNet) {
+		return append(allErrs, field.Forbidden(ilbPath, "a vnet cidr or vnet reference must be specified when using a dedicated subnet for the internal load balancers"))
+	}
+
+	cidr := cidrvalidation.NewCIDR(config.InternalLoadBalancer.CIDR, ilbPath.Child("cidr"))
+	if errs := cidr.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+	allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), cidr)...)
+	allErrs = append(allErrs, cidr.ValidateNotOverlap(workers, pods, services)...)
+	for index, zone := range config.Zones {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+	}
+	if config.Pods != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.Pods.CIDR, networksPath.Child("pods", "cidr")))...)
+	}
+	for index, subnet := range config.AdditionalSubnets {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(subnet.CIDR, networksPath.Child("additionalSubnets").Index(index).Child("cidr")))...)
+	}
+	if config.PrivateLink != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.PrivateLink.CIDR, networksPath.Child("privateLink", "cidr")))...)
+	}
+
+	return allErrs
+}
+
+// minGatewaySubnetPrefixLength is the largest prefix length, i.e. the smallest subnet, which Azure accepts for the
+// gateway subnet.
+const minGatewaySubnetPrefixLength = 29
+
+func validateGatewaySubnet(infra *apisazure.InfrastructureConfig, workers, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		config      = infra.Networks
+		gatewayPath = networksPath.Child("gatewaySubnet")
+	)
+
+	if config.GatewaySubnet == nil {
+		return allErrs
+	}
+	// the gateway subnet of an existing vnet is managed by its owner, hence the extension must neither adopt nor delete it.
+	if isExternalVnetUsed(&config.VNet) || config.VNet.CIDR == nil {
+		return append(allErrs, field.Forbidden(gatewayPath, "a gateway subnet can only be created in a vnet which is managed by the extension and specifies a cidr"))
+	}
+
+	cidr := cidrvalidation.NewCIDR(config.GatewaySubnet.CIDR, gatewayPath.Child("cidr"))
+	if errs := cidr.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+	if ones, _ := cidr.GetIPNet().Mask.Size(); ones > minGatewaySubnetPrefixLength {
+		allErrs = append(allErrs, field.Invalid(cidr.GetFieldPath(), cidr.GetCIDR(), fmt.Sprintf("the gateway subnet must have a prefix length of at most %d", minGatewaySubnetPrefixLength)))
+	}
+	allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), cidr)...)
+	allErrs = append(allErrs, cidr.ValidateNotOverlap(workers, pods, services)...)
+	for index, zone := range config.Zones {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+	}
+	if config.Pods != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.Pods.CIDR, networksPath.Child("pods", "cidr")))...)
+	}
+	for index, subnet := range config.AdditionalSubnets {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(subnet.CIDR, networksPath.Child("additionalSubnets").Index(index).Child("cidr")))...)
+	}
+	if config.PrivateLink != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.PrivateLink.CIDR, networksPath.Child("privateLink", "cidr")))...)
+	}
+	if config.InternalLoadBalancer != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.InternalLoadBalancer.CIDR, networksPath.Child("internalLoadBalancer", "cidr")))...)
+	}
+
+	return allErrs
+}
+
+// minRouteServerSubnetPrefixLength is the largest prefix length, i.e. the smallest subnet, which Azure accepts for the
+// route server subnet.
+const minRouteServerSubnetPrefixLength = 27
+
+func validateRouteServerSubnet(infra *apisazure.InfrastructureConfig, workers, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		config          = infra.Networks
+		routeServerPath = networksPath.Child("routeServerSubnet")
+	)
+
+	if config.RouteServerSubnet == nil {
+		return allErrs
+	}
+	// like the gateway subnet, the route server subnet of an existing vnet is managed by its owner.
+	if isExternalVnetUsed(&config.VNet) || config.VNet.CIDR == nil {
+		return append(allErrs, field.Forbidden(routeServerPath, "a route server subnet can only be created in a vnet which is managed by the extension and specifies a cidr"))
+	}
+
+	cidr := cidrvalidation.NewCIDR(config.RouteServerSubnet.CIDR, routeServerPath.Child("cidr"))
+	if errs := cidr.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+	if ones, _ := cidr.GetIPNet().Mask.Size(); ones > minRouteServerSubnetPrefixLength {
+		allErrs = append(allErrs, field.Invalid(cidr.GetFieldPath(), cidr.GetCIDR(), fmt.Sprintf("the route server subnet must have a prefix length of at most %d", minRouteServerSubnetPrefixLength)))
+	}
+	allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), cidr)...)
+	allErrs = append(allErrs, cidr.ValidateNotOverlap(workers, pods, services)...)
+	for index, zone := range config.Zones {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+	}
+	if config.Pods != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.Pods.CIDR, networksPath.Child("pods", "cidr")))...)
+	}
+	for index, subnet := range config.AdditionalSubnets {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(subnet.CIDR, networksPath.Child("additionalSubnets").Index(index).Child("cidr")))...)
+	}
+	if config.PrivateLink != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.PrivateLink.CIDR, networksPath.Child("privateLink", "cidr")))...)
+	}
+	if config.InternalLoadBalancer != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.InternalLoadBalancer.CIDR, networksPath.Child("internalLoadBalancer", "cidr")))...)
+	}
+	if config.GatewaySubnet != nil {
+		allErrs = append(allErrs, cidr.ValidateNotOverlap(cidrvalidation.NewCIDR(config.GatewaySubnet.CIDR, networksPath.Child("gatewaySubnet", "cidr")))...)
+	}
+
+	return allErrs
+}
+
+func validatePodSubnet(infra *apisazure.InfrastructureConfig, shoot *core.Shoot, workers, nodes, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs       = field.ErrorList{}
+		config        = infra.Networks
+		podSubnetPath = networksPath.Child("pods")
+		podSubnet     = cidrvalidation.NewCIDR(config.Pods.CIDR, podSubnetPath.Child("cidr"))
+	)
+
+	if config.Pods.Delegation != nil {
+		allErrs = append(allErrs, validateSubnetDelegation(*config.Pods.Delegation, podSubnetPath.Child("delegation"))...)
+	}
+
+	if errs := podSubnet.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(podSubnet.GetFieldPath(), podSubnet.GetCIDR())...)
+
+	if isDefaultVnetConfig(&config.VNet) {
+		allErrs = append(allErrs, field.Forbidden(podSubnetPath, "a vnet cidr or vnet reference must be specified when using a pod subnet"))
+	} else if config.VNet.CIDR != nil {
+		allErrs = append(allErrs, validateSubsetOfVnetAddressPrefixes(vnetAddressPrefixes(&config.VNet, networksPath.Child("vnet")), podSubnet)...)
+	}
+
+	allErrs = append(allErrs, podSubnet.ValidateSubset(pods)...)
+	allErrs = append(allErrs, podSubnet.ValidateNotOverlap(workers, nodes, services)...)
+	for index, zone := range config.Zones {
+		allErrs = append(allErrs, podSubnet.ValidateNotOverlap(cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))...)
+	}
+
+	// the pod subnet must provide an IP address for every pod on the maximum number of nodes.
+	var requiredIPAddresses int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		requiredIPAddresses += int64(worker.Maximum) * int64(maxPods(shoot, worker))
+	}
+	ones, bits := podSubnet.GetIPNet().Mask.Size()
+	if bits-ones < 63 {
+		if available := int64(1)<<(bits-ones) - subnetReservedIPAddresses; available < requiredIPAddresses {
+			allErrs = append(allErrs, field.Invalid(podSubnet.GetFieldPath(), podSubnet.GetCIDR(),
+				fmt.Sprintf("pod subnet provides %d IP addresses but %d are required for the maximum number of pods on the maximum number of nodes", available, requiredIPAddresses)))
+		}
+	}
+
+	return allErrs
+}
+
+// maxPods returns the maximum number of pods per node of the given worker pool.
+func maxPods(shoot *core.Shoot, worker core.Worker) int32 {
+	if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.MaxPods != nil {
+		return *worker.Kubernetes.Kubelet.MaxPods
+	}
+	if shoot.Spec.Kubernetes.Kubelet != nil && shoot.Spec.Kubernetes.Kubelet.MaxPods != nil {
+		return *shoot.Spec.Kubernetes.Kubelet.MaxPods
+	}
+	return defaultMaxPods
+}
+
 func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, natGatewayPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -315,7 +1194,7 @@ func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, natG
 	}
 
 	if !natGatewayConfig.Enabled {
-		if natGatewayConfig.Zone != nil || natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil {
+		if natGatewayConfig.Zone != nil || natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil || natGatewayConfig.PublicIPCount != nil || natGatewayConfig.PublicIPDNSLabelTemplate != nil || natGatewayConfig.PublicIPPrefix != nil {
 			return append(allErrs, field.Invalid(natGatewayPath, natGatewayConfig, "NatGateway is disabled but additional NatGateway config is passed"))
 		}
 		return nil
@@ -324,6 +1203,8 @@ func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, natG
 	if natGatewayConfig.IdleConnectionTimeoutMinutes != nil && (*natGatewayConfig.IdleConnectionTimeoutMinutes < natGatewayMinTimeoutInMinutes || *natGatewayConfig.IdleConnectionTimeoutMinutes > natGatewayMaxTimeoutInMinutes) {
 		allErrs = append(allErrs, field.Invalid(natGatewayPath.Child("idleConnectionTimeoutMinutes"), *natGatewayConfig.IdleConnectionTimeoutMinutes, fmt.Sprintf("idleConnectionTimeoutMinutes values must range between %d and %d", natGatewayMinTimeoutInMinutes, natGatewayMaxTimeoutInMinutes)))
 	}
+	allErrs = append(allErrs, validateNatGatewayPublicIPs(natGatewayConfig.PublicIPCount, len(natGatewayConfig.IPAddresses), natGatewayPath)...)
+	allErrs = append(allErrs, validateNatGatewayPublicIPPrefix(natGatewayConfig.PublicIPPrefix, len(natGatewayConfig.IPAddresses), natGatewayPath)...)
 
 	if natGatewayConfig.Zone == nil {
 		if len(natGatewayConfig.IPAddresses) > 0 {
@@ -359,16 +1240,116 @@ func validateZonedNatGatewayConfig(natGatewayConfig *apisazure.ZonedNatGatewayCo
 	}
 
 	if !natGatewayConfig.Enabled {
-		if natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil {
+		if natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil || natGatewayConfig.PublicIPCount != nil || natGatewayConfig.PublicIPDNSLabelTemplate != nil || natGatewayConfig.PublicIPPrefix != nil {
 			return append(allErrs, field.Invalid(natGatewayPath, natGatewayConfig, "NatGateway is disabled but additional NatGateway config is passed"))
 		}
 		return nil
 	}
 
+	allErrs = append(allErrs, validateNatGatewayPublicIPs(natGatewayConfig.PublicIPCount, len(natGatewayConfig.IPAddresses), natGatewayPath)...)
+	allErrs = append(allErrs, validateNatGatewayPublicIPPrefix(natGatewayConfig.PublicIPPrefix, len(natGatewayConfig.IPAddresses), natGatewayPath)...)
 	allErrs = append(allErrs, validateZonedPublicIPReference(natGatewayConfig.IPAddresses, natGatewayPath.Child("ipAddresses"))...)
 	return allErrs
 }
 
+// publicIPDNSLabelRegex matches the DNS name labels Azure allows for public IPs.
+var publicIPDNSLabelRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{1,61}[a-z0-9]$`)
+
+// validatePublicIPDNSLabels validates the DNS name labels of the managed public IPs of the NAT gateways. Azure requires the
+// labels to be unique within the region, hence the labels rendered for the public IPs of the shoot must not collide.
+// Collisions with public IPs of other subscriptions are only detected when the public IPs are created.
+func validatePublicIPDNSLabels(config *apisazure.NetworkConfig, shootName string, networksPath *field.Path) field.ErrorList {
+	type natGateway struct {
+		template    *string
+		zone        string
+		count       *int32
+		ipAddresses int
+		fldPath     *field.Path
+	}
+
+	var natGateways []natGateway
+	if nat := config.NatGateway; nat != nil && nat.Enabled {
+		var zone string
+		if nat.Zone != nil {
+			zone = helper.InfrastructureZoneToString(*nat.Zone)
+		}
+		natGateways = append(natGateways, natGateway{nat.PublicIPDNSLabelTemplate, zone, nat.PublicIPCount, len(nat.IPAddresses), networksPath.Child("natGateway")})
+	}
+	for i, z := range config.Zones {
+		if nat := z.NatGateway; nat != nil && nat.Enabled {
+			natGateways = append(natGateways, natGateway{nat.PublicIPDNSLabelTemplate, helper.InfrastructureZoneToString(z.Name), nat.PublicIPCount, len(nat.IPAddresses), networksPath.Child("zones").Index(i).Child("natGateway")})
+		}
+	}
+
+	var (
+		allErrs = field.ErrorList{}
+		labels  = sets.New[string]()
+	)
+	for _, nat := range natGateways {
+		if nat.template == nil {
+			continue
+		}
+
+		fldPath := nat.fldPath.Child("publicIPDNSLabelTemplate")
+		if nat.ipAddresses > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "publicIPDNSLabelTemplate cannot be set if ipAddresses are given"))
+			continue
+		}
+
+		for i := range int(ptr.Deref(nat.count, 1)) {
+			label := helper.PublicIPDNSLabel(*nat.template, shootName, nat.zone, i)
+			if !publicIPDNSLabelRegex.MatchString(label) {
+				allErrs = append(allErrs, field.Invalid(fldPath, *nat.template, fmt.Sprintf("the rendered label %q must consist of 3 to 63 lower case alphanumeric characters or '-', start with a letter and end with an alphanumeric character", label)))
+				break
+			}
+			if labels.Has(label) {
+				allErrs = append(allErrs, field.Invalid(fldPath, *nat.template, fmt.Sprintf("the rendered label %q is not unique, use the {zone} and {index} placeholders to distinguish the public IPs", label)))
+				break
+			}
+			labels.Insert(label)
+		}
+	}
+	return allErrs
+}
+
+// validateNatGatewayPublicIPs validates the number of public IPs of a NAT gateway. The managed public IPs are only created
+// if no public IPs are referenced, hence the count cannot be combined with references.
+func validateNatGatewayPublicIPs(publicIPCount *int32, ipReferences int, natGatewayPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ipReferences > int(natGatewayMaxPublicIPs) {
+		allErrs = append(allErrs, field.TooMany(natGatewayPath.Child("ipAddresses"), ipReferences, int(natGatewayMaxPublicIPs)))
+	}
+	if publicIPCount == nil {
+		return allErrs
+	}
+
+	fldPath := natGatewayPath.Child("publicIPCount")
+	if ipReferences > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "publicIPCount cannot be set if ipAddresses are given"))
+	}
+	if *publicIPCount < 1 || *publicIPCount > natGatewayMaxPublicIPs {
+		allErrs = append(allErrs, field.Invalid(fldPath, *publicIPCount, fmt.Sprintf("publicIPCount must range between 1 and %d", natGatewayMaxPublicIPs)))
+	}
+	return allErrs
+}
+
+// validateNatGatewayPublicIPPrefix validates the reference to the public IP prefix from which the managed public IPs of
+// a NAT gateway are allocated, hence the prefix cannot be combined with references to public IPs.
+func validateNatGatewayPublicIPPrefix(prefix *apisazure.PublicIPPrefixReference, ipReferences int, natGatewayPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if prefix == nil {
+		return allErrs
+	}
+
+	fldPath := natGatewayPath.Child("publicIPPrefix")
+	if ipReferences > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "publicIPPrefix cannot be set if ipAddresses are given"))
+	}
+	allErrs = append(allErrs, validateResourceGroupName(prefix.ResourceGroup, fldPath.Child("resourceGroup"))...)
+	allErrs = append(allErrs, validatePublicIPPrefixName(prefix.Name, fldPath.Child("name"))...)
+	return allErrs
+}
+
 func validateZonedPublicIPReference(publicIPReferences []apisazure.ZonedPublicIPReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for idx, ipRef := range publicIPReferences {
@@ -384,15 +1365,55 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.Infrastr
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.ResourceGroup, oldConfig.ResourceGroup, providerPath.Child("resourceGroup"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.NetworkResourceGroup, oldConfig.NetworkResourceGroup, providerPath.Child("networkResourceGroup"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.SecurityGroup, oldConfig.Networks.SecurityGroup, providerPath.Child("networks", "securityGroup"))...)
+
+	// Azure cannot move a resource group to another location, hence only the default may be set explicitly.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(
+		ptr.Deref(newConfig.ResourceGroupLocation, shoot.Spec.Region),
+		ptr.Deref(oldConfig.ResourceGroupLocation, shoot.Spec.Region),
+		providerPath.Child("resourceGroupLocation"))...)
 
 	if oldConfig.Networks.Workers != nil && newConfig.Networks.Workers != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Workers, oldConfig.Networks.Workers, providerPath.Child("networks").Child("workers"))...)
 	}
 
+	// the delegated pod subnet cannot be changed while pods use its IP addresses, but it can be added or removed.
+	if oldConfig.Networks.Pods != nil && newConfig.Networks.Pods != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Pods, oldConfig.Networks.Pods, providerPath.Child("networks").Child("pods"))...)
+	}
+
+	// additional subnets can be added or removed, but their machines would have to be recreated to change them. Only the
+	// NAT gateway can be attached and detached in place.
+	for i, newSubnet := range newConfig.Networks.AdditionalSubnets {
+		if oldSubnet := helper.FindAdditionalSubnet(oldConfig, newSubnet.Name); oldSubnet != nil {
+			oldSubnet, newSubnet := *oldSubnet, newSubnet
+			oldSubnet.AttachNatGateway, newSubnet.AttachNatGateway = nil, nil
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSubnet, oldSubnet, providerPath.Child("networks", "additionalSubnets").Index(i))...)
+		}
+	}
+
+	// the subnet of the Private Link Service cannot be changed while the service uses its IP addresses.
+	if oldConfig.Networks.PrivateLink != nil && newConfig.Networks.PrivateLink != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.PrivateLink, oldConfig.Networks.PrivateLink, providerPath.Child("networks", "privateLink"))...)
+	}
+
+	// the subnet of the internal load balancers cannot be changed while their frontends use its IP addresses.
+	if oldConfig.Networks.InternalLoadBalancer != nil && newConfig.Networks.InternalLoadBalancer != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.InternalLoadBalancer, oldConfig.Networks.InternalLoadBalancer, providerPath.Child("networks", "internalLoadBalancer"))...)
+	}
+	if oldConfig.Networks.GatewaySubnet != nil && newConfig.Networks.GatewaySubnet != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.GatewaySubnet, oldConfig.Networks.GatewaySubnet, providerPath.Child("networks", "gatewaySubnet"))...)
+	}
+	if oldConfig.Networks.RouteServerSubnet != nil && newConfig.Networks.RouteServerSubnet != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.RouteServerSubnet, oldConfig.Networks.RouteServerSubnet, providerPath.Child("networks", "routeServerSubnet"))...)
+	}
+
 	for _, worker := range shoot.Spec.Provider.Workers {
 		if gardencorehelper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
-			// identity configuration is immutable, if there is worker with in-place update strategy
-			if !apiequality.Semantic.DeepEqual(oldConfig.Identity, newConfig.Identity) {
+			// identity configuration is immutable, if there is worker with in-place update strategy. The role assignments
+			// and federated identity credentials of the identity do not affect the nodes.
+			if !apiequality.Semantic.DeepEqual(withoutIdentityAssignments(oldConfig.Identity), withoutIdentityAssignments(newConfig.Identity)) {
 				allErrs = append(allErrs, field.Invalid(providerPath.Child("identity"), newConfig.Identity, "field is immutable when there is a worker with in-place update strategy"))
 			}
 
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"net/netip"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -16,6 +18,7 @@ import (
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 var _ = Describe("InfrastructureConfig validation", func() {
@@ -150,6 +153,109 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Detail": ContainSubstring("does not match expected regex"),
 				}))))
 			})
+			It("should allow specifying service endpoint policies", func() {
+				infrastructureConfig.Networks.ServiceEndpointPolicies = []string{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/storage-policy",
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+			It("should forbid specifying invalid service endpoint policies", func() {
+				infrastructureConfig.Networks.ServiceEndpointPolicies = []string{
+					"storage-policy",
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/natGateways/nat",
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/storage-policy",
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/storage-policy",
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.serviceEndpointPolicies[0]"),
+					"Detail": ContainSubstring("invalid service endpoint policy ID"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.serviceEndpointPolicies[1]"),
+					"Detail": ContainSubstring("Microsoft.Network/serviceEndpointPolicies"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.serviceEndpointPolicies[3]"),
+				}))
+			})
+			It("should allow specifying the network policies of the worker subnet", func() {
+				infrastructureConfig.Networks.PrivateEndpointNetworkPolicies = ptr.To("Enabled")
+				infrastructureConfig.Networks.PrivateLinkServiceNetworkPolicies = ptr.To("Disabled")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+			It("should forbid specifying unsupported network policies of the worker subnet", func() {
+				infrastructureConfig.Networks.PrivateEndpointNetworkPolicies = ptr.To("RouteTableEnabled")
+				infrastructureConfig.Networks.PrivateLinkServiceNetworkPolicies = ptr.To("disabled")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.privateEndpointNetworkPolicies"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.privateLinkServiceNetworkPolicies"),
+				}))
+			})
+		})
+
+		Context("flow logs", func() {
+			const storageAccountID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/flowlogs"
+
+			It("should allow configuring flow logs with traffic analytics", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{
+					StorageAccountID: storageAccountID,
+					RetentionDays:    ptr.To[int32](30),
+					TrafficAnalytics: &apisazure.TrafficAnalyticsConfig{
+						WorkspaceResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.OperationalInsights/workspaces/ws",
+						WorkspaceID:         "11111111-1111-1111-1111-111111111111",
+						WorkspaceRegion:     "westeurope",
+						IntervalMinutes:     ptr.To[int32](10),
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid flow log configuration", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{
+					StorageAccountID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.Network/natGateways/nat",
+					RetentionDays:    ptr.To[int32](400),
+					TrafficAnalytics: &apisazure.TrafficAnalyticsConfig{
+						WorkspaceResourceID: "ws",
+						WorkspaceID:         "ws",
+						IntervalMinutes:     ptr.To[int32](30),
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.storageAccountID"),
+					"Detail": ContainSubstring("Microsoft.Storage/storageAccounts"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.flowLogs.retentionDays"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.trafficAnalytics.workspaceResourceID"),
+					"Detail": ContainSubstring("invalid resource ID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.flowLogs.trafficAnalytics.workspaceID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.flowLogs.trafficAnalytics.workspaceRegion"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.flowLogs.trafficAnalytics.intervalMinutes"),
+				}))
+			})
+
+			It("should require the storage account", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.flowLogs.storageAccountID"),
+				}))
+			})
 		})
 
 		It("should forbid specifying a resource group configuration", func() {
@@ -166,6 +272,117 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 		})
 
+		Context("resource group location", func() {
+			BeforeEach(func() {
+				shoot.Spec.Region = "westeurope"
+				DeferCleanup(func() { shoot.Spec.Region = "" })
+			})
+
+			It("should allow a resource group location different from the region", func() {
+				infrastructureConfig.ResourceGroupLocation = ptr.To("northeurope")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid locations", func() {
+				infrastructureConfig.ResourceGroupLocation = ptr.To("North Europe")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resourceGroupLocation"),
+				}))
+
+				infrastructureConfig.ResourceGroupLocation = ptr.To("")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("resourceGroupLocation"),
+				}))
+			})
+
+			It("should forbid locations of another Azure cloud", func() {
+				infrastructureConfig.ResourceGroupLocation = ptr.To("chinanorth3")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("resourceGroupLocation"),
+					"Detail": ContainSubstring("Azure public cloud"),
+				}))
+
+				shoot.Spec.Region = "usgovvirginia"
+				infrastructureConfig.ResourceGroupLocation = ptr.To("usgovarizona")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+		})
+
+		Context("network resource group", func() {
+			It("should allow placing the network resources in an existing resource group", func() {
+				infrastructureConfig.NetworkResourceGroup = &apisazure.ResourceGroup{Name: "network-rg"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid resource group names", func() {
+				infrastructureConfig.NetworkResourceGroup = &apisazure.ResourceGroup{Name: "network rg?"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networkResourceGroup.name"),
+				}))
+			})
+
+			It("should forbid combining the network resource group with an existing vnet", func() {
+				infrastructureConfig.NetworkResourceGroup = &apisazure.ResourceGroup{Name: "network-rg"}
+				infrastructureConfig.Networks.VNet = apisazure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networkResourceGroup"),
+				}))
+			})
+		})
+
+		Context("security group", func() {
+			It("should allow referencing an existing security group", func() {
+				infrastructureConfig.Networks.SecurityGroup = &apisazure.SecurityGroupReference{Name: "existing-nsg", ResourceGroup: "security-rg"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid names", func() {
+				infrastructureConfig.Networks.SecurityGroup = &apisazure.SecurityGroupReference{Name: "existing nsg", ResourceGroup: ""}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.securityGroup.name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.securityGroup.resourceGroup"),
+					})),
+				))
+			})
+
+			It("should forbid a security group in the resource group of the shoot", func() {
+				shoot.Status.TechnicalID = "shoot--foo--bar"
+				infrastructureConfig.Networks.SecurityGroup = &apisazure.SecurityGroupReference{Name: "existing-nsg", ResourceGroup: "shoot--foo--bar"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityGroup.resourceGroup"),
+				}))
+			})
+
+			It("should allow flow logs for an existing security group", func() {
+				infrastructureConfig.Networks.SecurityGroup = &apisazure.SecurityGroupReference{Name: "existing-nsg", ResourceGroup: "security-rg"}
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{
+					StorageAccountID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/flowlogs",
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+		})
+
 		Context("vnet", func() {
 			It("should forbid specifying a vnet name without resource group", func() {
 				vnetName := "existing-vnet"
@@ -288,6 +505,123 @@ var _ = Describe("InfrastructureConfig validation", func() {
 						"Field": Equal("networks.vnet.ddosProtectionPlanID"),
 					}))
 			})
+
+			Context("VNet encryption", func() {
+				BeforeEach(func() {
+					shoot.Spec.Region = "westeurope"
+					DeferCleanup(func() { shoot.Spec.Region = "" })
+				})
+
+				It("should allow to enable the encryption of a managed vnet", func() {
+					infrastructureConfig.Networks.VNet.Encryption = &apisazure.VNetEncryption{
+						Enabled:     true,
+						Enforcement: ptr.To(apisazure.VNetEncryptionEnforcementAllowUnencrypted),
+					}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should forbid an unsupported enforcement", func() {
+					infrastructureConfig.Networks.VNet.Encryption = &apisazure.VNetEncryption{
+						Enabled:     true,
+						Enforcement: ptr.To(apisazure.VNetEncryptionEnforcement("Drop")),
+					}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("networks.vnet.encryption.enforcement"),
+					}))
+				})
+
+				It("should forbid the encryption in the regions of the sovereign clouds", func() {
+					shoot.Spec.Region = "chinanorth3"
+					infrastructureConfig.Networks.VNet.Encryption = &apisazure.VNetEncryption{Enabled: true}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("networks.vnet.encryption.enabled"),
+						"Detail": ContainSubstring("not available in the region \"chinanorth3\" of the Azure China cloud"),
+					}))
+				})
+
+				It("should forbid configuring the encryption of an existing vnet", func() {
+					infrastructureConfig.Networks.VNet = apisazure.VNet{
+						Name:          ptr.To("existing-vnet"),
+						ResourceGroup: &resourceGroup,
+						Encryption:    &apisazure.VNetEncryption{Enabled: true},
+					}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.vnet.encryption"),
+					}))
+				})
+			})
+
+			It("should allow a managed vnet with multiple address prefixes", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					CIDR:            ptr.To("10.0.0.0/16"),
+					AdditionalCIDRs: []string{"10.250.0.0/16"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid subnets which are not within one of the address prefixes of the vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					CIDR:            ptr.To("10.0.0.0/16"),
+					AdditionalCIDRs: []string{"172.16.0.0/16"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+
+				Expect(errorList).To(ConsistOfFields(
+					Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.workers"),
+						"Detail": Equal(`must be a subset of one of the vnet address prefixes ("10.0.0.0/16", "172.16.0.0/16")`),
+					}))
+			})
+
+			It("should forbid overlapping and invalid address prefixes of the vnet", func() {
+				infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"10.250.0.0/16", "10.250.1.0/24", invalidCIDR}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+
+				Expect(errorList).To(ContainElements(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.vnet.additionalCIDRs[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.vnet.additionalCIDRs[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.vnet.additionalCIDRs[2]"),
+					})),
+				))
+			})
+
+			It("should forbid additional address prefixes without a vnet cidr", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{AdditionalCIDRs: []string{"172.16.0.0/16"}}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vnet.additionalCIDRs"),
+				}))))
+			})
+
+			It("should forbid additional address prefixes for an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					Name:            ptr.To("existing-vnet"),
+					ResourceGroup:   ptr.To("existing-vnet-rg"),
+					AdditionalCIDRs: []string{"172.16.0.0/16"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+
+				Expect(errorList).To(ConsistOfFields(
+					Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.vnet.additionalCIDRs"),
+					}))
+			})
 		})
 
 		Context("CIDR", func() {
@@ -425,6 +759,130 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Field": Equal("identity.resourceGroup"),
 				}))
 			})
+
+			It("should allow role assignments of the identity", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identity",
+					ResourceGroup: "identity-resource-group",
+					RoleAssignments: []apisazure.IdentityRoleAssignment{
+						{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"},
+						{
+							RoleDefinitionID: "/subscriptions/sub/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7",
+							Scope:            ptr.To("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar"),
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid role assignments of the identity", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identity",
+					ResourceGroup: "identity-resource-group",
+					RoleAssignments: []apisazure.IdentityRoleAssignment{
+						{},
+						{RoleDefinitionID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet"},
+						{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/role", Scope: ptr.To("/subscriptions/sub")},
+						{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/role", Scope: ptr.To("invalid")},
+						{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/role"},
+						{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/ROLE"},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.roleAssignments[0].roleDefinitionID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.roleAssignments[1].roleDefinitionID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.roleAssignments[2].scope"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.roleAssignments[3].scope"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("identity.roleAssignments[5]"),
+				}))
+			})
+
+			It("should allow federated identity credentials of the identity if the shoot has a managed service account issuer", func() {
+				managedIssuerShoot := shoot.DeepCopy()
+				managedIssuerShoot.Annotations = map[string]string{"authentication.gardener.cloud/issuer": "managed"}
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identity",
+					ResourceGroup: "identity-resource-group",
+					FederatedCredentials: []apisazure.IdentityFederatedCredential{
+						{Name: "app", ServiceAccount: "default/app"},
+						{Name: "backup", ServiceAccount: "kube-system/backup", Audiences: []string{"api://AzureADTokenExchange"}},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, managedIssuerShoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should allow federated identity credentials of the identity if the shoot has a custom service account issuer", func() {
+				customIssuerShoot := shoot.DeepCopy()
+				customIssuerShoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+					ServiceAccountConfig: &core.ServiceAccountConfig{Issuer: ptr.To("https://issuer.example.com")},
+				}
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:                 "test-identity",
+					ResourceGroup:        "identity-resource-group",
+					FederatedCredentials: []apisazure.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, customIssuerShoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid federated identity credentials of the identity if the service account issuer is not known", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:                 "test-identity",
+					ResourceGroup:        "identity-resource-group",
+					FederatedCredentials: []apisazure.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("identity.federatedCredentials"),
+				}))
+			})
+
+			It("should forbid invalid federated identity credentials of the identity", func() {
+				managedIssuerShoot := shoot.DeepCopy()
+				managedIssuerShoot.Annotations = map[string]string{"authentication.gardener.cloud/issuer": "managed"}
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identity",
+					ResourceGroup: "identity-resource-group",
+					FederatedCredentials: []apisazure.IdentityFederatedCredential{
+						{Name: "-app", ServiceAccount: "default"},
+						{Name: "app", ServiceAccount: "Default/app"},
+						{Name: "APP", ServiceAccount: "default/app", Audiences: []string{"a", ""}},
+						{Name: "other", ServiceAccount: "default/app"},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, managedIssuerShoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.federatedCredentials[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.federatedCredentials[0].serviceAccount"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.federatedCredentials[1].serviceAccount"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("identity.federatedCredentials[2].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("identity.federatedCredentials[2].audiences"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.federatedCredentials[2].audiences[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("identity.federatedCredentials[3].serviceAccount"),
+				}))
+			})
 		})
 
 		Context("NatGateway", func() {
@@ -545,135 +1003,1004 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
 				})
 			})
-		})
 
-		Context("Zones", func() {
-			var (
-				zoneName  int32 = 1
-				zoneName1 int32 = 2
-				zoneCIDR        = "10.250.0.0/24"
-				zoneCIDR1       = "10.250.1.0/24"
-			)
+			Context("PublicIPCount", func() {
+				It("should succeed for a count within the limit", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](16)
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
 
-			BeforeEach(func() {
-				infrastructureConfig = &apisazure.InfrastructureConfig{
-					Zoned: true,
-					Networks: apisazure.NetworkConfig{
-						VNet: apisazure.VNet{
-							CIDR: &vnetCIDR,
-						},
-						Zones: []apisazure.Zone{
-							{
-								Name: zoneName,
-								CIDR: zoneCIDR,
-							},
-							{
-								Name: zoneName1,
-								CIDR: zoneCIDR1,
-							},
-						},
-					},
-				}
-			})
+				It("should forbid a count of zero", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](0)
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.publicIPCount"),
+						"Detail": Equal("publicIPCount must range between 1 and 16"),
+					}))
+				})
 
-			It("should succeed", func() {
-				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
-			})
+				It("should forbid a count above the limit of Azure", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](17)
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.publicIPCount"),
+						"Detail": Equal("publicIPCount must range between 1 and 16"),
+					}))
+				})
+
+				It("should forbid a count together with public IP references", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](2)
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{
+						Name:          "public-ip-name",
+						ResourceGroup: "public-ip-resource-group",
+						Zone:          1,
+					}}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.publicIPCount"),
+					}))
+				})
+
+				It("should forbid a count if the NatGateway is disabled", func() {
+					infrastructureConfig.Networks.NatGateway.Enabled = false
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](2)
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway"),
+					}))
+				})
+			})
+
+			Context("PublicIPPrefix", func() {
+				It("should succeed for a reference to a public IP prefix", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](2)
+					infrastructureConfig.Networks.NatGateway.PublicIPPrefix = &apisazure.PublicIPPrefixReference{Name: "egress-prefix", ResourceGroup: "egress"}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should forbid an incomplete reference", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPPrefix = &apisazure.PublicIPPrefixReference{}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ContainElements(
+						PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("networks.natGateway.publicIPPrefix.name")})),
+						PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("networks.natGateway.publicIPPrefix.resourceGroup")})),
+					))
+				})
+
+				It("should forbid a public IP prefix together with public IP references", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.PublicIPPrefix = &apisazure.PublicIPPrefixReference{Name: "egress-prefix", ResourceGroup: "egress"}
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{
+						Name:          "public-ip-name",
+						ResourceGroup: "public-ip-resource-group",
+						Zone:          1,
+					}}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.publicIPPrefix"),
+					}))
+				})
+
+				It("should forbid a public IP prefix if the NatGateway is disabled", func() {
+					infrastructureConfig.Networks.NatGateway.Enabled = false
+					infrastructureConfig.Networks.NatGateway.PublicIPPrefix = &apisazure.PublicIPPrefixReference{Name: "egress-prefix", ResourceGroup: "egress"}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway"),
+					}))
+				})
+			})
+
+			Context("PublicIPDNSLabelTemplate", func() {
+				BeforeEach(func() {
+					shoot.Name = "bar"
+					DeferCleanup(func() { shoot.Name = "" })
+				})
+
+				It("should succeed for a template which renders unique labels", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](2)
+					infrastructureConfig.Networks.NatGateway.PublicIPDNSLabelTemplate = ptr.To("egress-{shoot}-{index}")
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should forbid a template which renders invalid labels", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPDNSLabelTemplate = ptr.To("Egress_{shoot}")
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.publicIPDNSLabelTemplate"),
+						"Detail": ContainSubstring(`the rendered label "Egress_bar" must consist of 3 to 63 lower case alphanumeric characters`),
+					}))
+				})
+
+				It("should forbid a template which renders the same label for multiple public IPs", func() {
+					infrastructureConfig.Networks.NatGateway.PublicIPCount = ptr.To[int32](2)
+					infrastructureConfig.Networks.NatGateway.PublicIPDNSLabelTemplate = ptr.To("egress-{shoot}")
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.publicIPDNSLabelTemplate"),
+						"Detail": ContainSubstring(`the rendered label "egress-bar" is not unique`),
+					}))
+				})
+
+				It("should forbid a template together with public IP references", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.PublicIPDNSLabelTemplate = ptr.To("egress-{shoot}")
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{
+						Name:          "public-ip-name",
+						ResourceGroup: "public-ip-resource-group",
+						Zone:          1,
+					}}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.publicIPDNSLabelTemplate"),
+					}))
+				})
+
+				It("should forbid templates which render the same label in multiple zones", func() {
+					infrastructureConfig.Networks.NatGateway = nil
+					infrastructureConfig.Networks.Workers = nil
+					infrastructureConfig.Networks.Zones = []apisazure.Zone{
+						{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true, PublicIPDNSLabelTemplate: ptr.To("egress-{shoot}-{zone}")}},
+						{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true, PublicIPDNSLabelTemplate: ptr.To("egress-{shoot}-1")}},
+					}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.zones[1].natGateway.publicIPDNSLabelTemplate"),
+						"Detail": ContainSubstring(`the rendered label "egress-bar-1" is not unique`),
+					}))
+				})
+			})
+		})
+
+		Context("Zones", func() {
+			var (
+				zoneName  int32 = 1
+				zoneName1 int32 = 2
+				zoneCIDR        = "10.250.0.0/24"
+				zoneCIDR1       = "10.250.1.0/24"
+			)
+
+			BeforeEach(func() {
+				infrastructureConfig = &apisazure.InfrastructureConfig{
+					Zoned: true,
+					Networks: apisazure.NetworkConfig{
+						VNet: apisazure.VNet{
+							CIDR: &vnetCIDR,
+						},
+						Zones: []apisazure.Zone{
+							{
+								Name: zoneName,
+								CIDR: zoneCIDR,
+							},
+							{
+								Name: zoneName1,
+								CIDR: zoneCIDR1,
+							},
+						},
+					},
+				}
+			})
+
+			It("should succeed", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should succeed for nil service and pod CIDR", func() {
+				networking.Pods = nil
+				networking.Services = nil
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should succeed with NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled: true,
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled: true,
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should succeed with NAT Gateway and  public IPs", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled: true,
+					IPAddresses: []apisazure.ZonedPublicIPReference{
+						{
+							Name:          "public-ip-name",
+							ResourceGroup: "public-ip-resource-group",
+						},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should succeed with NAT Gateway and a public IP count", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:       true,
+					PublicIPCount: ptr.To[int32](4),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should succeed with NAT Gateway and a public IP prefix", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:        true,
+					PublicIPPrefix: &apisazure.PublicIPPrefixReference{Name: "egress-prefix", ResourceGroup: "egress"},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a public IP prefix together with public IP references for a zonal NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:        true,
+					PublicIPPrefix: &apisazure.PublicIPPrefixReference{Name: "egress-prefix", ResourceGroup: "egress"},
+					IPAddresses:    []apisazure.ZonedPublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group"}},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.publicIPPrefix"),
+				}))
+			})
+
+			It("should forbid a public IP count of zero for a zonal NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:       true,
+					PublicIPCount: ptr.To[int32](0),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway.publicIPCount"),
+				}))
+			})
+
+			It("should forbid an idle timeout out of range for a zonal NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:                      true,
+					IdleConnectionTimeoutMinutes: ptr.To[int32](3),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:                      true,
+					IdleConnectionTimeoutMinutes: ptr.To[int32](121),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].natGateway.idleConnectionTimeoutMinutes"),
+					"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].natGateway.idleConnectionTimeoutMinutes"),
+					"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+				}))
+			})
+
+			It("should forbid non canonical CIDRs", func() {
+				infrastructureConfig.Networks.Zones[0].CIDR = "10.250.0.1/24"
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(HaveLen(1))
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].cidr"),
+				}))
+			})
+
+			It("should forbid overlapping zone CIDRs", func() {
+				infrastructureConfig.Networks.Zones[0].CIDR = zoneCIDR1
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(HaveLen(1))
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].cidr"),
+					"Detail": ContainSubstring("must not overlap"),
+				}))
+			})
+
+			It("should forbid not specifying VNet when using Zones", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(HaveLen(1))
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.vnet.cidr"),
+					"Detail": Equal("a vnet cidr or vnet reference must be specified when the workers field is not set"),
+				}))
+			})
+
+			It("should forbid zone CIDRs which are not in Vnet and Nodes CIDR", func() {
+				vpcCIDR := "10.0.0.0/8"
+				networking.Nodes = ptr.To("10.150.0.0/16")
+
+				infrastructureConfig.Networks.VNet.CIDR = &vpcCIDR
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+
+				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(HaveLen(2))
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].cidr"),
+					"Detail": ContainSubstring("subset of"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].cidr"),
+					"Detail": ContainSubstring("subset of"),
+				}))
+			})
+
+			It("should allow specifying service endpoint policies for a zone", func() {
+				infrastructureConfig.Networks.Zones[0].ServiceEndpointPolicies = []string{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/storage-policy",
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid specifying invalid service endpoint policies for a zone", func() {
+				infrastructureConfig.Networks.Zones[1].ServiceEndpointPolicies = []string{"storage-policy"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1].serviceEndpointPolicies[0]"),
+				}))
+			})
+
+			It("should forbid specifying service endpoint policies when the workers field is missing", func() {
+				infrastructureConfig.Networks.ServiceEndpointPolicies = []string{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/storage-policy",
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("serviceEndpointPolicies cannot be specified when workers field is missing"),
+				}))
+			})
+
+			It("should allow specifying the network policies of a zone's subnet", func() {
+				infrastructureConfig.Networks.Zones[0].PrivateEndpointNetworkPolicies = ptr.To("Disabled")
+				infrastructureConfig.Networks.Zones[0].PrivateLinkServiceNetworkPolicies = ptr.To("Enabled")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid specifying unsupported network policies of a zone's subnet", func() {
+				infrastructureConfig.Networks.Zones[1].PrivateEndpointNetworkPolicies = ptr.To("On")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[1].privateEndpointNetworkPolicies"),
+				}))
+			})
+
+			It("should forbid specifying the network policies of the worker subnet when the workers field is missing", func() {
+				infrastructureConfig.Networks.PrivateEndpointNetworkPolicies = ptr.To("Disabled")
+				infrastructureConfig.Networks.PrivateLinkServiceNetworkPolicies = ptr.To("Disabled")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("privateEndpointNetworkPolicies cannot be specified when workers field is missing"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("privateLinkServiceNetworkPolicies cannot be specified when workers field is missing"),
+				}))
+			})
+
+			It("should forbid specifying zone multiple times", func() {
+				infrastructureConfig.Networks.Zones[0].Name = zoneName1
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(HaveLen(1))
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1]"),
+					"Detail": Equal("the same zone cannot be specified multiple times"),
+				}))
+			})
+		})
+
+		Context("Pod subnet", func() {
+			BeforeEach(func() {
+				networking.Pods = ptr.To("10.1.0.0/16")
+				infrastructureConfig.Networks.Pods = &apisazure.PodSubnetConfig{
+					CIDR: "10.1.0.0/16",
+				}
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "worker", Maximum: 10}}
+			})
+
+			AfterEach(func() {
+				shoot.Spec.Provider.Workers = nil
+			})
+
+			It("should succeed for a valid pod subnet", func() {
+				infrastructureConfig.Networks.Pods.Delegation = ptr.To("Microsoft.ContainerService/managedClusters")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a pod subnet in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.pods"),
+				}))))
+			})
+
+			It("should forbid an invalid delegation", func() {
+				infrastructureConfig.Networks.Pods.Delegation = ptr.To("ContainerService")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.pods.delegation"),
+				}))
+			})
+
+			It("should forbid a pod subnet outside of the vnet or overlapping with the workers", func() {
+				networking.Pods = nil
+				infrastructureConfig.Networks.Pods.CIDR = "10.250.3.0/24"
+				infrastructureConfig.Networks.VNet.CIDR = ptr.To("10.250.0.0/16")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.pods.cidr\""),
+				}))))
+			})
+
+			It("should forbid a pods network which is not part of the pod subnet", func() {
+				networking.Pods = ptr.To("10.2.0.0/16")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.pods"),
+					"Detail": ContainSubstring("must be a subset of \"networks.pods.cidr\""),
+				}))
+			})
+
+			It("should forbid a pod subnet which is too small for the maximum number of pods", func() {
+				networking.Pods = ptr.To("10.1.0.0/22")
+				infrastructureConfig.Networks.Pods.CIDR = "10.1.0.0/22"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{
+					Name:    "worker-2",
+					Maximum: 10,
+					Kubernetes: &core.WorkerKubernetes{
+						Kubelet: &core.KubeletConfig{MaxPods: ptr.To[int32](10)},
+					},
+				})
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.pods.cidr"),
+					"Detail": Equal("pod subnet provides 1019 IP addresses but 1200 are required for the maximum number of pods on the maximum number of nodes"),
+				}))
+			})
+		})
+
+		Context("Additional subnets", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{
+					{Name: "gpu", CIDR: "10.250.4.0/24"},
+					{Name: "batch", CIDR: "10.250.5.0/24"},
+				}
+			})
+
+			It("should succeed for valid additional subnets", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid additional subnets in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalSubnets"),
+				}))))
+			})
+
+			It("should forbid invalid, reserved and duplicate names", func() {
+				infrastructureConfig.Networks.AdditionalSubnets[0].Name = "GPU_pool"
+				infrastructureConfig.Networks.AdditionalSubnets[1].Name = "z1"
+				infrastructureConfig.Networks.AdditionalSubnets = append(infrastructureConfig.Networks.AdditionalSubnets,
+					apisazure.AdditionalSubnet{Name: "z1", CIDR: "10.250.6.0/24"})
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[0].name"),
+					"Detail": ContainSubstring("does not match expected regex"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[1].name"),
+					"Detail": Equal("name is reserved for the subnets of the zones"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[2].name"),
+					"Detail": Equal("name is reserved for the subnets of the zones"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.additionalSubnets[2].name"),
+				}))
+			})
+
+			It("should forbid a zone for the single subnet layout", func() {
+				infrastructureConfig.Networks.AdditionalSubnets[0].Zone = ptr.To[int32](1)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalSubnets[0].zone"),
+				}))
+			})
+
+			It("should forbid a zone which is not configured", func() {
+				infrastructureConfig.Zoned = true
+				infrastructureConfig.Networks.Workers = nil
+				infrastructureConfig.Networks.Zones = []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}}
+				infrastructureConfig.Networks.AdditionalSubnets[0].Zone = ptr.To[int32](1)
+				infrastructureConfig.Networks.AdditionalSubnets[1].Zone = ptr.To[int32](2)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalSubnets[1].zone"),
+				}))
+			})
+
+			It("should forbid overlapping CIDRs", func() {
+				infrastructureConfig.Networks.AdditionalSubnets[0].CIDR = workers
+				infrastructureConfig.Networks.AdditionalSubnets[1].CIDR = "10.250.3.0/25"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.additionalSubnets[0].cidr\""),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.additionalSubnets[1].cidr\""),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[1].cidr"),
+					"Detail": ContainSubstring("must not overlap with \"networks.additionalSubnets[0].cidr\""),
+				}))
+			})
+
+			It("should forbid a CIDR outside of the nodes network", func() {
+				infrastructureConfig.Networks.AdditionalSubnets[0].CIDR = "10.1.0.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[0].cidr"),
+					"Detail": ContainSubstring("must be a subset of"),
+				}))
+			})
+		})
+
+		Context("Private DNS zone", func() {
+			It("should succeed for a valid private DNS zone", func() {
+				infrastructureConfig.Networks.PrivateDNSZone = &apisazure.PrivateDNSZoneConfig{Name: "internal.example.com", RegistrationEnabled: true}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should require the name of the private DNS zone", func() {
+				infrastructureConfig.Networks.PrivateDNSZone = &apisazure.PrivateDNSZoneConfig{}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.privateDNSZone.name"),
+				}))))
+			})
+
+			DescribeTable("should forbid invalid zone names",
+				func(name string) {
+					infrastructureConfig.Networks.PrivateDNSZone = &apisazure.PrivateDNSZoneConfig{Name: name}
+
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.privateDNSZone.name"),
+					}))))
+				},
+				Entry("single label", "internal"),
+				Entry("upper case letters", "Internal.Example.com"),
+				Entry("trailing dot", "internal.example.com."),
+				Entry("invalid characters", "internal_zone.example.com"),
+			)
+		})
+
+		Context("Outbound load balancer", func() {
+			It("should succeed for a valid outbound load balancer", func() {
+				infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{
+					PublicIPCount:          ptr.To[int32](2),
+					AllocatedOutboundPorts: ptr.To[int32](1024),
+					IdleTimeoutMinutes:     ptr.To[int32](30),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			DescribeTable("should forbid invalid settings",
+				func(config apisazure.OutboundLoadBalancerConfig, fieldName string) {
+					infrastructureConfig.Networks.OutboundLoadBalancer = &config
+
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.outboundLoadBalancer." + fieldName),
+					}))))
+				},
+				Entry("no public IPs", apisazure.OutboundLoadBalancerConfig{PublicIPCount: ptr.To[int32](0)}, "publicIPCount"),
+				Entry("too many public IPs", apisazure.OutboundLoadBalancerConfig{PublicIPCount: ptr.To[int32](17)}, "publicIPCount"),
+				Entry("ports not a multiple of 8", apisazure.OutboundLoadBalancerConfig{AllocatedOutboundPorts: ptr.To[int32](1004)}, "allocatedOutboundPorts"),
+				Entry("too many ports", apisazure.OutboundLoadBalancerConfig{AllocatedOutboundPorts: ptr.To[int32](64008)}, "allocatedOutboundPorts"),
+				Entry("too short idle timeout", apisazure.OutboundLoadBalancerConfig{IdleTimeoutMinutes: ptr.To[int32](3)}, "idleTimeoutMinutes"),
+				Entry("too long idle timeout", apisazure.OutboundLoadBalancerConfig{IdleTimeoutMinutes: ptr.To[int32](101)}, "idleTimeoutMinutes"),
+			)
+		})
+
+		Context("Egress strategies", func() {
+			zonedConfig := func(zones ...apisazure.Zone) *apisazure.InfrastructureConfig {
+				return &apisazure.InfrastructureConfig{
+					Zoned: true,
+					Networks: apisazure.NetworkConfig{
+						VNet:  apisazure.VNet{CIDR: &vnetCIDR},
+						Zones: zones,
+					},
+				}
+			}
+
+			DescribeTable("should allow a single egress strategy",
+				func(mutate func()) {
+					mutate()
+
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				},
+				Entry("default outbound access", func() {}),
+				Entry("NAT gateway", func() {
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+				}),
+				Entry("NAT gateways of several zones", func() {
+					infrastructureConfig = zonedConfig(
+						apisazure.Zone{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}},
+						apisazure.Zone{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}},
+					)
+				}),
+				Entry("outbound load balancer", func() {
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}),
+				Entry("outbound load balancer with a disabled NAT gateway", func() {
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: false}
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}),
+				Entry("outbound load balancer with disabled zonal NAT gateways", func() {
+					infrastructureConfig = zonedConfig(
+						apisazure.Zone{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: false}},
+					)
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}),
+			)
+
+			DescribeTable("should forbid conflicting egress strategies",
+				func(mutate func(), detail string) {
+					mutate()
+
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("networks.outboundLoadBalancer"),
+						"Detail": Equal("only one egress strategy can be configured, but found conflicting fields: " + detail),
+					}))))
+				},
+				Entry("NAT gateway and outbound load balancer", func() {
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}, "NAT gateway (networks.natGateway), outbound load balancer (networks.outboundLoadBalancer)"),
+				Entry("zonal NAT gateway and outbound load balancer", func() {
+					infrastructureConfig = zonedConfig(
+						apisazure.Zone{Name: 1, CIDR: "10.250.0.0/24"},
+						apisazure.Zone{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}},
+					)
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}, "NAT gateway (networks.zones[1].natGateway), outbound load balancer (networks.outboundLoadBalancer)"),
+				Entry("NAT gateways of several zones and outbound load balancer", func() {
+					infrastructureConfig = zonedConfig(
+						apisazure.Zone{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}},
+						apisazure.Zone{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &apisazure.ZonedNatGatewayConfig{Enabled: true}},
+					)
+					infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+				}, "NAT gateway (networks.zones[0].natGateway, networks.zones[1].natGateway), outbound load balancer (networks.outboundLoadBalancer)"),
+			)
+		})
+
+		Context("Private link", func() {
+			const endpointSubnetID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed/subnets/private-endpoints"
+
+			BeforeEach(func() {
+				infrastructureConfig.Networks.PrivateLink = &apisazure.PrivateLinkConfig{
+					CIDR:             "10.250.6.0/24",
+					EndpointSubnetID: endpointSubnetID,
+				}
+			})
+
+			It("should succeed for a valid private link configuration", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid private link in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.privateLink"),
+				}))))
+			})
+
+			It("should forbid an endpoint subnet ID which is not the ID of a subnet", func() {
+				infrastructureConfig.Networks.PrivateLink.EndpointSubnetID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.privateLink.endpointSubnetID"),
+					"Detail": Equal("must be the ID of a Microsoft.Network/virtualNetworks/subnets resource"),
+				}))
+			})
+
+			It("should forbid a CIDR which overlaps with the other subnets", func() {
+				infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{{Name: "gpu", CIDR: "10.250.6.0/25"}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalSubnets[0].cidr"),
+					"Detail": ContainSubstring("must not overlap with \"networks.privateLink.cidr\""),
+				}))
+
+				infrastructureConfig.Networks.AdditionalSubnets = nil
+				infrastructureConfig.Networks.PrivateLink.CIDR = "10.250.2.0/23"
+
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.privateLink.cidr\""),
+				}))
+			})
+
+			It("should forbid a CIDR outside of the nodes network", func() {
+				infrastructureConfig.Networks.PrivateLink.CIDR = "10.1.0.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.privateLink.cidr"),
+					"Detail": ContainSubstring("must be a subset of"),
+				}))
+			})
+		})
+
+		Context("Internal load balancer", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.InternalLoadBalancer = &apisazure.InternalLoadBalancerConfig{CIDR: "10.251.0.0/24"}
+			})
+
+			It("should succeed for a dedicated subnet of the internal load balancers", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a dedicated subnet in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.internalLoadBalancer"),
+				}))))
+			})
+
+			It("should forbid an invalid CIDR", func() {
+				infrastructureConfig.Networks.InternalLoadBalancer.CIDR = invalidCIDR
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.internalLoadBalancer.cidr"),
+				}))
+			})
+
+			It("should forbid a CIDR outside of the vnet", func() {
+				infrastructureConfig.Networks.InternalLoadBalancer.CIDR = "172.16.0.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internalLoadBalancer.cidr"),
+					"Detail": Equal(`must be a subset of "networks.vnet.cidr" ("10.0.0.0/8")`),
+				}))
+			})
+
+			It("should forbid a CIDR which overlaps with the worker subnets", func() {
+				infrastructureConfig.Networks.InternalLoadBalancer.CIDR = "10.250.2.0/23"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.internalLoadBalancer.cidr\""),
+				}))
+
+				infrastructureConfig.Zoned = true
+				infrastructureConfig.Networks.Workers = nil
+				infrastructureConfig.Networks.Zones = []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}}
+				infrastructureConfig.Networks.InternalLoadBalancer.CIDR = "10.250.0.128/25"
+
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].cidr"),
+					"Detail": ContainSubstring("must not overlap with \"networks.internalLoadBalancer.cidr\""),
+				}))))
+			})
+		})
 
-			It("should succeed for nil service and pod CIDR", func() {
-				networking.Pods = nil
-				networking.Services = nil
-				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+		Context("Gateway subnet", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.GatewaySubnet = &apisazure.GatewaySubnetConfig{CIDR: "10.252.0.0/27"}
 			})
 
-			It("should succeed with NAT Gateway", func() {
-				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
-					Enabled: true,
-				}
-				infrastructureConfig.Networks.Zones[1].NatGateway = &apisazure.ZonedNatGatewayConfig{
-					Enabled: true,
-				}
+			It("should succeed for a gateway subnet in a managed vnet", func() {
 				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
 			})
 
-			It("should succeed with NAT Gateway and  public IPs", func() {
-				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
-					Enabled: true,
-					IPAddresses: []apisazure.ZonedPublicIPReference{
-						{
-							Name:          "public-ip-name",
-							ResourceGroup: "public-ip-resource-group",
-						},
-					},
-				}
-				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			It("should forbid a gateway subnet in an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.gatewaySubnet"),
+				}))
 			})
 
-			It("should forbid non canonical CIDRs", func() {
-				infrastructureConfig.Networks.Zones[0].CIDR = "10.250.0.1/24"
+			It("should forbid a gateway subnet in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.gatewaySubnet"),
+				}))))
+			})
+
+			It("should forbid a gateway subnet which is smaller than /29", func() {
+				infrastructureConfig.Networks.GatewaySubnet.CIDR = "10.252.0.0/30"
+
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-				Expect(errorList).NotTo(BeEmpty())
-				Expect(errorList).To(HaveLen(1))
 				Expect(errorList).To(ConsistOfFields(Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[0].cidr"),
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.gatewaySubnet.cidr"),
+					"Detail": Equal("the gateway subnet must have a prefix length of at most 29"),
 				}))
 			})
 
-			It("should forbid overlapping zone CIDRs", func() {
-				infrastructureConfig.Networks.Zones[0].CIDR = zoneCIDR1
+			It("should forbid a CIDR outside of the vnet", func() {
+				infrastructureConfig.Networks.GatewaySubnet.CIDR = "172.16.0.0/27"
+
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-				Expect(errorList).NotTo(BeEmpty())
-				Expect(errorList).To(HaveLen(1))
 				Expect(errorList).To(ConsistOfFields(Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
-					"Field":  Equal("networks.zones[1].cidr"),
-					"Detail": ContainSubstring("must not overlap"),
+					"Field":  Equal("networks.gatewaySubnet.cidr"),
+					"Detail": Equal(`must be a subset of "networks.vnet.cidr" ("10.0.0.0/8")`),
 				}))
 			})
 
-			It("should forbid not specifying VNet when using Zones", func() {
-				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+			It("should forbid a CIDR which overlaps with the worker subnet or the internal load balancers", func() {
+				infrastructureConfig.Networks.GatewaySubnet.CIDR = "10.250.3.0/27"
+
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-				Expect(errorList).NotTo(BeEmpty())
-				Expect(errorList).To(HaveLen(1))
 				Expect(errorList).To(ConsistOfFields(Fields{
-					"Type":   Equal(field.ErrorTypeForbidden),
-					"Field":  Equal("networks.vnet.cidr"),
-					"Detail": Equal("a vnet cidr or vnet reference must be specified when the workers field is not set"),
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.gatewaySubnet.cidr\""),
+				}))
+
+				infrastructureConfig.Networks.InternalLoadBalancer = &apisazure.InternalLoadBalancerConfig{CIDR: "10.251.0.0/24"}
+				infrastructureConfig.Networks.GatewaySubnet.CIDR = "10.251.0.0/27"
+
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internalLoadBalancer.cidr"),
+					"Detail": ContainSubstring("must not overlap with \"networks.gatewaySubnet.cidr\""),
 				}))
 			})
+		})
 
-			It("should forbid zone CIDRs which are not in Vnet and Nodes CIDR", func() {
-				vpcCIDR := "10.0.0.0/8"
-				networking.Nodes = ptr.To("10.150.0.0/16")
+		Context("Route server subnet", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.RouteServerSubnet = &apisazure.RouteServerSubnetConfig{CIDR: "10.252.1.0/27"}
+			})
+
+			It("should succeed for a route server subnet in a managed vnet", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a route server subnet in an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")}
 
-				infrastructureConfig.Networks.VNet.CIDR = &vpcCIDR
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.routeServerSubnet"),
+				}))
+			})
 
-				Expect(errorList).NotTo(BeEmpty())
-				Expect(errorList).To(HaveLen(2))
+			It("should forbid a route server subnet which is smaller than /27", func() {
+				infrastructureConfig.Networks.RouteServerSubnet.CIDR = "10.252.1.0/28"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
 				Expect(errorList).To(ConsistOfFields(Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
-					"Field":  Equal("networks.zones[0].cidr"),
-					"Detail": ContainSubstring("subset of"),
-				}, Fields{
+					"Field":  Equal("networks.routeServerSubnet.cidr"),
+					"Detail": Equal("the route server subnet must have a prefix length of at most 27"),
+				}))
+			})
+
+			It("should forbid a CIDR outside of the vnet", func() {
+				infrastructureConfig.Networks.RouteServerSubnet.CIDR = "172.16.0.0/27"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
-					"Field":  Equal("networks.zones[1].cidr"),
-					"Detail": ContainSubstring("subset of"),
+					"Field":  Equal("networks.routeServerSubnet.cidr"),
+					"Detail": Equal(`must be a subset of "networks.vnet.cidr" ("10.0.0.0/8")`),
 				}))
 			})
 
-			It("should forbid specifying zone multiple times", func() {
-				infrastructureConfig.Networks.Zones[0].Name = zoneName1
+			It("should forbid a CIDR which overlaps with the worker subnet or the gateway subnet", func() {
+				infrastructureConfig.Networks.RouteServerSubnet.CIDR = "10.250.3.0/27"
 
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-				Expect(errorList).NotTo(BeEmpty())
-				Expect(errorList).To(HaveLen(1))
 				Expect(errorList).To(ConsistOfFields(Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
-					"Field":  Equal("networks.zones[1]"),
-					"Detail": Equal("the same zone cannot be specified multiple times"),
+					"Field":  Equal("networks.workers"),
+					"Detail": ContainSubstring("must not overlap with \"networks.routeServerSubnet.cidr\""),
+				}))
+
+				infrastructureConfig.Networks.GatewaySubnet = &apisazure.GatewaySubnetConfig{CIDR: "10.252.0.0/26"}
+				infrastructureConfig.Networks.RouteServerSubnet.CIDR = "10.252.0.0/27"
+
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.gatewaySubnet.cidr"),
+					"Detail": ContainSubstring("must not overlap with \"networks.routeServerSubnet.cidr\""),
 				}))
 			})
 		})
@@ -740,6 +2067,124 @@ var _ = Describe("InfrastructureConfig validation", func() {
 		})
 	})
 
+	Describe("#ValidateInfrastructureConfigAgainstControlPlaneConfig", func() {
+		var (
+			shoot   *core.Shoot
+			fldPath = field.NewPath("providerConfig")
+		)
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{}
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+			infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{
+				{Name: "gpu", CIDR: "10.250.4.0/24"},
+				{Name: "dmz", CIDR: "10.250.5.0/24", AttachNatGateway: ptr.To(false)},
+			}
+		})
+
+		It("should allow excluding a subnet from the NAT gateway if the default outbound access is enabled", func() {
+			Expect(ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, nil, shoot, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow excluding a subnet from the NAT gateway if the load balancer has outbound rules", func() {
+			shoot.Annotations = map[string]string{azure.DisableDefaultOutboundAccessAnnotation: "true"}
+			cpConfig := &apisazure.ControlPlaneConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{OutboundRules: &apisazure.OutboundRules{}},
+			}
+
+			Expect(ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, cpConfig, shoot, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid excluding a subnet from the NAT gateway if its nodes have no other egress path", func() {
+			shoot.Annotations = map[string]string{azure.DisableDefaultOutboundAccessAnnotation: "true"}
+
+			errorList := ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, &apisazure.ControlPlaneConfig{}, shoot, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.networks.additionalSubnets[1].attachNatGateway"),
+				"Detail": ContainSubstring("the subnet can only be excluded from the NAT gateway if its nodes have another egress path"),
+			}))
+		})
+
+		It("should forbid combining the outbound load balancer with the outbound rules of the load balancer", func() {
+			infrastructureConfig.Networks.NatGateway = nil
+			infrastructureConfig.Networks.AdditionalSubnets = nil
+			infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+			cpConfig := &apisazure.ControlPlaneConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{OutboundRules: &apisazure.OutboundRules{}},
+			}
+
+			errorList := ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, cpConfig, shoot, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.networks.outboundLoadBalancer"),
+				"Detail": ContainSubstring("the nodes can only be in the backend pool of one public load balancer"),
+			}))
+		})
+
+		It("should allow the outbound load balancer without outbound rules of the load balancer", func() {
+			infrastructureConfig.Networks.NatGateway = nil
+			infrastructureConfig.Networks.AdditionalSubnets = nil
+			infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+
+			Expect(ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, &apisazure.ControlPlaneConfig{}, shoot, fldPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateVNetCIDRsAgainstReservedRanges", func() {
+		var fldPath = field.NewPath("providerConfig")
+
+		It("should allow a vnet which does not overlap with the reserved ranges", func() {
+			reserved := []netip.Prefix{netip.MustParsePrefix("172.16.0.0/16")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(nil, infrastructureConfig, reserved, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a vnet which overlaps with a reserved range", func() {
+			reserved := []netip.Prefix{netip.MustParsePrefix("172.16.0.0/16"), netip.MustParsePrefix("10.20.0.0/16")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(nil, infrastructureConfig, reserved, fldPath)).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.networks.vnet.cidr"),
+				"Detail": Equal(`must not overlap with the reserved range "10.20.0.0/16" of the shared address space`),
+			}))
+		})
+
+		It("should forbid additional cidrs which overlap with a reserved range", func() {
+			infrastructureConfig.Networks.VNet.CIDR = ptr.To("10.250.0.0/16")
+			infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16"}
+			reserved := []netip.Prefix{netip.MustParsePrefix("172.16.8.0/24")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(nil, infrastructureConfig, reserved, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networks.vnet.additionalCIDRs[0]"),
+			}))
+		})
+
+		It("should validate the workers cidr if the vnet has no explicit cidr", func() {
+			infrastructureConfig.Networks.VNet = apisazure.VNet{}
+			reserved := []netip.Prefix{netip.MustParsePrefix("10.250.0.0/16")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(nil, infrastructureConfig, reserved, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networks.workers"),
+			}))
+		})
+
+		It("should allow cidrs which are already used by the old config", func() {
+			reserved := []netip.Prefix{netip.MustParsePrefix("10.20.0.0/16")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(infrastructureConfig.DeepCopy(), infrastructureConfig, reserved, fldPath)).To(BeEmpty())
+		})
+
+		It("should not validate existing vnets", func() {
+			infrastructureConfig.Networks.VNet = apisazure.VNet{Name: ptr.To("existing"), ResourceGroup: ptr.To("vnet-rg")}
+			reserved := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+			Expect(ValidateVNetCIDRsAgainstReservedRanges(nil, infrastructureConfig, reserved, fldPath)).To(BeEmpty())
+		})
+	})
+
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
 		var newInfrastructureConfig *apisazure.InfrastructureConfig
 
@@ -763,6 +2208,47 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))))
 		})
 
+		It("should forbid changing the network resource group", func() {
+			newInfrastructureConfig.NetworkResourceGroup = &apisazure.ResourceGroup{Name: "network-rg"}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networkResourceGroup"),
+			}))))
+		})
+
+		It("should forbid changing the existing security group", func() {
+			newInfrastructureConfig.Networks.SecurityGroup = &apisazure.SecurityGroupReference{Name: "existing-nsg", ResourceGroup: "security-rg"}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.securityGroup"),
+			}))))
+		})
+
+		It("should allow setting the default resource group location explicitly", func() {
+			shoot.Spec.Region = "westeurope"
+			newInfrastructureConfig.ResourceGroupLocation = ptr.To("westeurope")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the resource group location", func() {
+			shoot.Spec.Region = "westeurope"
+			newInfrastructureConfig.ResourceGroupLocation = ptr.To("northeurope")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("resourceGroupLocation"),
+			}))))
+		})
+
 		It("should forbid changing the indentity config if there is worker with inplace update strategy", func() {
 			shoot.Spec.Provider = core.Provider{
 				Workers: []core.Worker{
@@ -785,6 +2271,117 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))))
 		})
 
+		It("should allow changing the role assignments and federated identity credentials of the identity if there is worker with inplace update strategy", func() {
+			shoot.Spec.Provider = core.Provider{
+				Workers: []core.Worker{
+					{
+						UpdateStrategy: ptr.To(core.AutoInPlaceUpdate),
+					},
+				},
+			}
+			infrastructureConfig.Identity = &apisazure.IdentityConfig{Name: "test-identity", ResourceGroup: "identity-resource-group"}
+
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Identity.RoleAssignments = []apisazure.IdentityRoleAssignment{
+				{RoleDefinitionID: "/providers/Microsoft.Authorization/roleDefinitions/role"},
+			}
+			newInfrastructureConfig.Identity.FederatedCredentials = []apisazure.IdentityFederatedCredential{
+				{Name: "app", ServiceAccount: "default/app"},
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the pod subnet", func() {
+			infrastructureConfig.Networks.Pods = &apisazure.PodSubnetConfig{CIDR: "10.1.0.0/16"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Pods.CIDR = "10.1.0.0/15"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.pods"),
+			}))))
+		})
+
+		It("should forbid changing an additional subnet but allow adding new ones", func() {
+			infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{{Name: "gpu", CIDR: "10.250.4.0/24"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{
+				{Name: "batch", CIDR: "10.250.5.0/24"},
+				{Name: "gpu", CIDR: "10.250.4.0/23"},
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.additionalSubnets[1]"),
+			}))))
+		})
+
+		It("should allow attaching and detaching the NAT gateway of an additional subnet", func() {
+			infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{
+				{Name: "gpu", CIDR: "10.250.4.0/24"},
+				{Name: "dmz", CIDR: "10.250.5.0/24", AttachNatGateway: ptr.To(false)},
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.AdditionalSubnets[0].AttachNatGateway = ptr.To(false)
+			newInfrastructureConfig.Networks.AdditionalSubnets[1].AttachNatGateway = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the private link configuration", func() {
+			infrastructureConfig.Networks.PrivateLink = &apisazure.PrivateLinkConfig{
+				CIDR:             "10.250.6.0/24",
+				EndpointSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed/subnets/private-endpoints",
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.PrivateLink.CIDR = "10.250.7.0/24"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.privateLink"),
+			}))))
+		})
+
+		It("should forbid changing the gateway subnet", func() {
+			infrastructureConfig.Networks.GatewaySubnet = &apisazure.GatewaySubnetConfig{CIDR: "10.252.0.0/27"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.GatewaySubnet.CIDR = "10.252.0.0/26"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.gatewaySubnet"),
+			}))))
+		})
+
+		It("should forbid changing the route server subnet", func() {
+			infrastructureConfig.Networks.RouteServerSubnet = &apisazure.RouteServerSubnetConfig{CIDR: "10.252.1.0/27"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.RouteServerSubnet.CIDR = "10.252.1.0/26"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.routeServerSubnet"),
+			}))))
+		})
+
+		It("should forbid changing the subnet of the internal load balancers", func() {
+			infrastructureConfig.Networks.InternalLoadBalancer = &apisazure.InternalLoadBalancerConfig{CIDR: "10.251.0.0/24"}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.InternalLoadBalancer.CIDR = "10.251.1.0/24"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &shoot, providerPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.internalLoadBalancer"),
+			}))))
+		})
+
 		Context("vnet config update", func() {
 			It("should allow to resize the vnet cidr", func() {
 				newInfrastructureConfig := infrastructureConfig.DeepCopy()
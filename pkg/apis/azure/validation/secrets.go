@@ -17,6 +17,8 @@ import (
 
 var (
 	guidRegex = regexp.MustCompile("^[0-9A-Fa-f]{8}-([0-9A-Fa-f]{4}-){3}[0-9A-Fa-f]{12}$")
+	// see https://learn.microsoft.com/en-us/azure/key-vault/general/about-keys-secrets-certificates#objects-identifiers-and-versioning
+	keyVaultObjectNameRegex = regexp.MustCompile("^[0-9A-Za-z-]{1,127}$")
 )
 
 // ValidateCloudProviderSecret checks whether the given secret contains a valid Azure client credentials.
@@ -40,6 +42,14 @@ func ValidateCloudProviderSecret(secret, oldSecret *corev1.Secret) error {
 		}
 	}
 
+	// The credentials of shoot clusters are also handed to the cloud-controller-manager, the CSI drivers and the
+	// machine-controller-manager, which cannot read the client secret from a Key Vault.
+	for _, key := range []string{azure.KeyVaultURIKey, azure.KeyVaultSecretNameKey, azure.KeyVaultIdentityClientIDKey} {
+		if _, ok := secret.Data[key]; ok {
+			return fmt.Errorf("field %q in secret %s is not supported for the credentials of shoot clusters, the client secret must be provided in field %q", key, secretKey, azure.ClientSecretKey)
+		}
+	}
+
 	if clientID, ok := secret.Data[azure.ClientIDKey]; ok {
 		if _, ok := secret.Data[azure.ClientSecretKey]; !ok {
 			return fmt.Errorf("if field %q is passed also field %q must be provided", azure.ClientIDKey, azure.ClientSecretKey)
@@ -250,5 +250,30 @@ var _ = Describe("Secret validation", func() {
 			},
 			BeNil(),
 		),
+
+		Entry("should return error when the client secret is referenced in a Key Vault",
+			map[string][]byte{
+				azure.SubscriptionIDKey:           []byte(subscriptionID),
+				azure.TenantIDKey:                 []byte(tenantID),
+				azure.ClientIDKey:                 []byte(clientID),
+				azure.KeyVaultURIKey:              []byte("https://my-vault.vault.azure.net"),
+				azure.KeyVaultSecretNameKey:       []byte("client-secret"),
+				azure.KeyVaultIdentityClientIDKey: []byte(clientID),
+			},
+			nil,
+			MatchError(ContainSubstring("is not supported for the credentials of shoot clusters")),
+		),
+
+		Entry("should return error when a Key Vault reference is provided in addition to the client secret",
+			map[string][]byte{
+				azure.SubscriptionIDKey:     []byte(subscriptionID),
+				azure.TenantIDKey:           []byte(tenantID),
+				azure.ClientIDKey:           []byte(clientID),
+				azure.ClientSecretKey:       []byte(clientSecret),
+				azure.KeyVaultSecretNameKey: []byte("client-secret"),
+			},
+			nil,
+			MatchError(ContainSubstring("is not supported for the credentials of shoot clusters")),
+		),
 	)
 })
@@ -6,11 +6,14 @@ package validation
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardenercorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +22,7 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 const maxDataVolumeCount = 64
@@ -122,7 +126,9 @@ func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fld
 
 			for zoneIndex, workerZone := range worker.Zones {
 				if !infraZones.Has(workerZone) {
-					allErrs = append(allErrs, field.Invalid(path.Child("zones").Index(zoneIndex), workerZone, "zone configuration must be specified in \"infrastructureConfig.networks.zones\""))
+					allErrs = append(allErrs, field.Invalid(path.Child("zones").Index(zoneIndex), workerZone,
+						fmt.Sprintf("zone %q of worker pool %q must be specified in \"infrastructureConfig.networks.zones\", configured zones are: %s",
+							workerZone, worker.Name, strings.Join(sets.List(infraZones), ", "))))
 				}
 			}
 		}
@@ -160,6 +166,25 @@ func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.
 	return allErrs
 }
 
+// managedNodeLabels are the node labels which the extension derives from the configuration of the worker pool.
+var managedNodeLabels = sets.New(azure.CapacityTypeLabel, corev1.LabelInstanceTypeStable, corev1.LabelTopologyZone)
+
+// ValidateWorkerLabels validates that the labels of a worker pool do not set the node labels which are managed by the
+// extension. Only labels which are added or changed compared to the given old labels are validated, so that existing
+// worker pools which already set them can still be updated.
+func ValidateWorkerLabels(labels, oldLabels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for key, value := range labels {
+		if oldValue, ok := oldLabels[key]; ok && oldValue == value {
+			continue
+		}
+		if managedNodeLabels.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "label is managed by the Azure extension and must not be set"))
+		}
+	}
+	return allErrs
+}
+
 func validateVolume(vol *core.Volume, fldPath *field.Path) field.ErrorList {
 	return validateVolumeFunc(vol.Type, vol.VolumeSize, vol.Encrypted, fldPath)
 }
@@ -10,12 +10,14 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 var _ = Describe("Shoot validation", func() {
@@ -274,6 +276,39 @@ var _ = Describe("Shoot validation", func() {
 						))
 					})
 
+					It("should name the worker pool and the zone which is not configured in infrastructure", func() {
+						workers[1].Name = "pool-b"
+						workers[1].Zones = []string{"1", "3"}
+						errorList := ValidateWorkers(workers,
+							infraConfig, field.NewPath("workers"))
+
+						Expect(errorList).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":     Equal(field.ErrorTypeInvalid),
+								"Field":    Equal("workers[1].zones[1]"),
+								"BadValue": Equal("3"),
+								"Detail":   Equal(`zone "3" of worker pool "pool-b" must be specified in "infrastructureConfig.networks.zones", configured zones are: 1, 2`),
+							})),
+						))
+					})
+
+					It("should report every zone which is not configured in infrastructure", func() {
+						workers[0].Zones = []string{"3", "4"}
+						errorList := ValidateWorkers(workers,
+							infraConfig, field.NewPath("workers"))
+
+						Expect(errorList).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("workers[0].zones[0]"),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("workers[0].zones[1]"),
+							})),
+						))
+					})
+
 					It("should allow zones when configured in infrastructure", func() {
 						errorList := ValidateWorkers(workers,
 							infraConfig, field.NewPath("workers"))
@@ -468,6 +503,39 @@ var _ = Describe("Shoot validation", func() {
 			})
 		})
 	})
+
+	Describe("#ValidateWorkerLabels", func() {
+		It("should forbid labels which are managed by the extension", func() {
+			labels := map[string]string{"component": "TiDB", azure.CapacityTypeLabel: "spot", corev1.LabelTopologyZone: "westeurope-1"}
+
+			Expect(ValidateWorkerLabels(labels, nil, field.NewPath("labels"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("labels[azure.provider.extensions.gardener.cloud/capacity-type]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("labels[topology.kubernetes.io/zone]"),
+				})),
+			))
+		})
+
+		It("should only forbid managed labels which are added or changed", func() {
+			labels := map[string]string{corev1.LabelInstanceTypeStable: "Standard_D4s_v5", corev1.LabelTopologyZone: "westeurope-2", azure.CapacityTypeLabel: "spot"}
+			oldLabels := map[string]string{corev1.LabelInstanceTypeStable: "Standard_D4s_v5", corev1.LabelTopologyZone: "westeurope-1"}
+
+			Expect(ValidateWorkerLabels(labels, oldLabels, field.NewPath("labels"))).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("labels[topology.kubernetes.io/zone]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("labels[azure.provider.extensions.gardener.cloud/capacity-type]"),
+				})),
+			))
+		})
+	})
 })
 
 func copyWorkers(workers []core.Worker) []core.Worker {
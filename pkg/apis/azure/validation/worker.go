@@ -6,19 +6,34 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// the timezones are validated against the embedded time zone database, since the images of the extension do not
+	// contain one.
+	_ "time/tzdata"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 )
 
 // ValidateWorkerConfig validates a WorkerConfig object.
@@ -33,11 +48,875 @@ func ValidateWorkerConfig(workerConfig *apiazure.WorkerConfig, dataVolumes []cor
 		if diagnosticsProfile.StorageURI != nil {
 			allErrs = append(allErrs, storageURIValidation(*diagnosticsProfile.StorageURI, fldPath.Child("diagnosticsProfile").Child("storageURI"))...)
 		}
+		allErrs = append(allErrs, validateBootDiagnosticsRetention(diagnosticsProfile, fldPath.Child("diagnosticsProfile"))...)
 	}
 
 	allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, fldPath.Child("nodeTemplate"))...)
 	allErrs = append(allErrs, validateDataVolumeConf(workerConfig.DataVolumes, dataVolumes, fldPath.Child("dataVolumes"))...)
 	allErrs = append(allErrs, validateOSDiskConf(workerConfig.Volume, fldPath.Child("volume"))...)
+	allErrs = append(allErrs, validatePrePullImages(workerConfig.PrePullImages, fldPath.Child("prePullImages"))...)
+	allErrs = append(allErrs, validateKubeletConfig(workerConfig.KubeletConfig, fldPath.Child("kubeletConfig"))...)
+	allErrs = append(allErrs, validateTimeConfig(workerConfig.Time, fldPath.Child("time"))...)
+	allErrs = append(allErrs, validateAutomaticRepairs(workerConfig.AutomaticRepairs, workerConfig.HealthProbe, fldPath)...)
+	allErrs = append(allErrs, validateScaleInPolicy(workerConfig.ScaleInPolicy, fldPath.Child("scaleInPolicy"))...)
+	allErrs = append(allErrs, validateCapacityPolicy(workerConfig.CapacityPolicy, fldPath.Child("capacityPolicy"))...)
+	allErrs = append(allErrs, validateAzureMonitorAgent(workerConfig.AzureMonitorAgent, fldPath.Child("azureMonitorAgent"))...)
+	allErrs = append(allErrs, validateUserDataEncryption(workerConfig.UserDataEncryption, fldPath.Child("userDataEncryption"))...)
+	allErrs = append(allErrs, validateLicenseType(workerConfig.LicenseType, fldPath.Child("licenseType"))...)
+
+	return allErrs
+}
+
+const (
+	minAutomaticRepairsGracePeriodMinutes = 10
+	maxAutomaticRepairsGracePeriodMinutes = 90
+	minHealthProbeIntervalSeconds         = 5
+	maxHealthProbeIntervalSeconds         = 60
+)
+
+var availableHealthProbeProtocols = []string{
+	string(apiazure.HealthProbeProtocolHTTP),
+	string(apiazure.HealthProbeProtocolHTTPS),
+	string(apiazure.HealthProbeProtocolTCP),
+}
+
+func validateAutomaticRepairs(automaticRepairs *apiazure.AutomaticRepairs, healthProbe *apiazure.HealthProbe, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	enabled := automaticRepairs != nil && automaticRepairs.Enabled
+	if enabled && healthProbe == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("healthProbe"), "a health probe is required if automatic repairs are enabled"))
+	}
+	if !enabled && healthProbe != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "a health probe can only be configured if automatic repairs are enabled"))
+	}
+
+	if automaticRepairs != nil && automaticRepairs.GracePeriodMinutes != nil {
+		if gracePeriod := *automaticRepairs.GracePeriodMinutes; gracePeriod < minAutomaticRepairsGracePeriodMinutes || gracePeriod > maxAutomaticRepairsGracePeriodMinutes {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("automaticRepairs", "gracePeriodMinutes"), gracePeriod,
+				fmt.Sprintf("must be between %d and %d", minAutomaticRepairsGracePeriodMinutes, maxAutomaticRepairsGracePeriodMinutes)))
+		}
+	}
+
+	if healthProbe != nil {
+		allErrs = append(allErrs, validateHealthProbe(healthProbe, fldPath.Child("healthProbe"))...)
+	}
+
+	return allErrs
+}
+
+func validateHealthProbe(healthProbe *apiazure.HealthProbe, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !slices.Contains(availableHealthProbeProtocols, string(healthProbe.Protocol)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), healthProbe.Protocol, availableHealthProbeProtocols))
+	}
+
+	if healthProbe.Port < 1 || healthProbe.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), healthProbe.Port, "must be a valid port number between 1 and 65535"))
+	}
+
+	pathFldPath := fldPath.Child("path")
+	switch healthProbe.Protocol {
+	case apiazure.HealthProbeProtocolHTTP, apiazure.HealthProbeProtocolHTTPS:
+		if healthProbe.Path == nil || *healthProbe.Path == "" {
+			allErrs = append(allErrs, field.Required(pathFldPath, fmt.Sprintf("a path is required for the %s protocol", healthProbe.Protocol)))
+		} else if !strings.HasPrefix(*healthProbe.Path, "/") {
+			allErrs = append(allErrs, field.Invalid(pathFldPath, *healthProbe.Path, "must start with '/'"))
+		}
+	case apiazure.HealthProbeProtocolTCP:
+		if healthProbe.Path != nil {
+			allErrs = append(allErrs, field.Forbidden(pathFldPath, "a path must not be set for the TCP protocol"))
+		}
+	}
+
+	if healthProbe.IntervalSeconds != nil {
+		if interval := *healthProbe.IntervalSeconds; interval < minHealthProbeIntervalSeconds || interval > maxHealthProbeIntervalSeconds {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalSeconds"), interval,
+				fmt.Sprintf("must be between %d and %d", minHealthProbeIntervalSeconds, maxHealthProbeIntervalSeconds)))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePrePullImages(images []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, image := range images {
+		idxPath := fldPath.Index(i)
+		allErrs = append(allErrs, validateImageReference(image, idxPath)...)
+		if seen.Has(image) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, image))
+		}
+		seen.Insert(image)
+	}
+
+	return allErrs
+}
+
+func validateTimeConfig(timeConfig *apiazure.TimeConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if timeConfig == nil {
+		return allErrs
+	}
+
+	seen := sets.New[string]()
+	for i, server := range timeConfig.NTPServers {
+		idxPath := fldPath.Child("ntpServers").Index(i)
+		if net.ParseIP(server) == nil && len(validation.IsDNS1123Subdomain(strings.ToLower(server))) > 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, server, "must be a valid host name or IP address"))
+		}
+		if seen.Has(server) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, server))
+		}
+		seen.Insert(server)
+	}
+
+	if timeConfig.Timezone != nil {
+		timezonePath := fldPath.Child("timezone")
+		if errs := validateTimezoneName(*timeConfig.Timezone, timezonePath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else if _, err := time.LoadLocation(*timeConfig.Timezone); err != nil || *timeConfig.Timezone == "Local" {
+			allErrs = append(allErrs, field.Invalid(timezonePath, *timeConfig.Timezone, "must be the name of a timezone of the IANA time zone database"))
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	minBootDiagnosticsRetentionDays = 1
+	// maxBootDiagnosticsRetentionDays is the maximum age which the lifecycle rules of storage accounts support.
+	maxBootDiagnosticsRetentionDays = 99999
+)
+
+// validateBootDiagnosticsRetention validates the retention of the boot diagnostics. Only the boot diagnostics in a
+// storage account of the user can be deleted, since the managed storage accounts of Azure are not accessible.
+func validateBootDiagnosticsRetention(diagnosticsProfile *apiazure.DiagnosticsProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	retention := diagnosticsProfile.Retention
+	if retention == nil {
+		return allErrs
+	}
+	retentionPath := fldPath.Child("retention")
+
+	if !diagnosticsProfile.Enabled || diagnosticsProfile.StorageURI == nil {
+		allErrs = append(allErrs, field.Forbidden(retentionPath, "a retention requires enabled boot diagnostics in the storage account of storageURI"))
+	}
+	allErrs = append(allErrs, validateResourceGroupName(retention.ResourceGroup, retentionPath.Child("resourceGroup"))...)
+	if retention.Days < minBootDiagnosticsRetentionDays || retention.Days > maxBootDiagnosticsRetentionDays {
+		allErrs = append(allErrs, field.Invalid(retentionPath.Child("days"), retention.Days,
+			fmt.Sprintf("must be between %d and %d", minBootDiagnosticsRetentionDays, maxBootDiagnosticsRetentionDays)))
+	}
+
+	return allErrs
+}
+
+var (
+	availableEvictionSignals = []string{
+		"memory.available",
+		"nodefs.available",
+		"nodefs.inodesFree",
+		"imagefs.available",
+		"imagefs.inodesFree",
+		"pid.available",
+	}
+	availableReservedResources = []string{
+		string(corev1.ResourceCPU),
+		string(corev1.ResourceMemory),
+		string(corev1.ResourceEphemeralStorage),
+		"pid",
+	}
+)
+
+func validateKubeletConfig(kubeletConfig *apiazure.KubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kubeletConfig == nil {
+		return allErrs
+	}
+
+	if kubeletConfig.MaxPods != nil && *kubeletConfig.MaxPods <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPods"), *kubeletConfig.MaxPods, "must be greater than 0"))
+	}
+
+	evictionHardPath := fldPath.Child("evictionHard")
+	for signal, threshold := range kubeletConfig.EvictionHard {
+		if !slices.Contains(availableEvictionSignals, signal) {
+			allErrs = append(allErrs, field.NotSupported(evictionHardPath, signal, availableEvictionSignals))
+			continue
+		}
+		allErrs = append(allErrs, validateEvictionThreshold(threshold, evictionHardPath.Key(signal))...)
+	}
+
+	allErrs = append(allErrs, validateReservedResources(kubeletConfig.SystemReserved, fldPath.Child("systemReserved"))...)
+	allErrs = append(allErrs, validateReservedResources(kubeletConfig.KubeReserved, fldPath.Child("kubeReserved"))...)
+
+	return allErrs
+}
+
+func validateEvictionThreshold(threshold string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if percentage, ok := strings.CutSuffix(threshold, "%"); ok {
+		if value, err := strconv.ParseFloat(percentage, 64); err != nil || value < 0 || value > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, threshold, "must be a percentage between 0% and 100%"))
+		}
+		return allErrs
+	}
+
+	if quantity, err := resource.ParseQuantity(threshold); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, threshold, fmt.Sprintf("must be a quantity or a percentage: %v", err)))
+	} else if quantity.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, threshold, "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateReservedResources(reserved map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, value := range reserved {
+		if !slices.Contains(availableReservedResources, name) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, name, availableReservedResources))
+			continue
+		}
+		if quantity, err := resource.ParseQuantity(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), value, fmt.Sprintf("must be a quantity: %v", err)))
+		} else if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), value, "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// maxSinglePlacementGroupSize is the maximum number of machines in a scale set with a single placement group.
+const maxSinglePlacementGroupSize = 100
+
+// ValidateSinglePlacementGroup validates the single placement group setting of a WorkerConfig against the worker pool
+// and the infrastructure.
+func ValidateSinglePlacementGroup(workerConfig *apiazure.WorkerConfig, worker core.Worker, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.SinglePlacementGroup == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("singlePlacementGroup")
+
+	if infra != nil && infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "single placement group can only be configured for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+	if *workerConfig.SinglePlacementGroup && worker.Maximum > maxSinglePlacementGroupSize {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("a single placement group supports at most %d machines, but the worker pool has a maximum of %d machines", maxSinglePlacementGroupSize, worker.Maximum)))
+	}
+
+	return allErrs
+}
+
+// maxPlatformFaultDomainCount is the maximum number of fault domains of a scale set in Flexible orchestration mode.
+const maxPlatformFaultDomainCount = 3
+
+// ValidateFaultDomainCount validates the fault domain count of a WorkerConfig against the infrastructure and the
+// fault domain count of the region in the CloudProfileConfig.
+func ValidateFaultDomainCount(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, region string, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.FaultDomainCount == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("faultDomainCount")
+	count := *workerConfig.FaultDomainCount
+
+	if infra != nil && infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "fault domain count can only be configured for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+	if count < 1 {
+		return append(allErrs, field.Invalid(fldPath, count, "must be at least 1"))
+	}
+	if count > maxPlatformFaultDomainCount {
+		return append(allErrs, field.Invalid(fldPath, count, fmt.Sprintf("must not exceed %d, the maximum fault domain count of a VMSS Flex", maxPlatformFaultDomainCount)))
+	}
+	if cloudProfileConfig != nil {
+		if regionCount, err := helper.FindDomainCountByRegion(cloudProfileConfig.CountFaultDomains, region); err == nil && count > regionCount {
+			allErrs = append(allErrs, field.Invalid(fldPath, count, fmt.Sprintf("must not exceed the fault domain count %d of region %q", regionCount, region)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAutomaticRepairs validates the automatic repairs setting of a WorkerConfig against the infrastructure.
+func ValidateAutomaticRepairs(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.AutomaticRepairs == nil || !workerConfig.AutomaticRepairs.Enabled {
+		return allErrs
+	}
+
+	if infra != nil && infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("automaticRepairs"), "automatic repairs can only be configured for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+
+	return allErrs
+}
+
+var availableScaleInPolicyRules = []string{
+	string(apiazure.ScaleInPolicyRuleDefault),
+	string(apiazure.ScaleInPolicyRuleNewestVM),
+	string(apiazure.ScaleInPolicyRuleOldestVM),
+}
+
+func validateScaleInPolicy(scaleInPolicy *apiazure.ScaleInPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if scaleInPolicy == nil || scaleInPolicy.Rule == nil {
+		return allErrs
+	}
+	if !slices.Contains(availableScaleInPolicyRules, string(*scaleInPolicy.Rule)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("rule"), *scaleInPolicy.Rule, availableScaleInPolicyRules))
+	}
+
+	return allErrs
+}
+
+// ValidateScaleInPolicy validates the scale-in policy of a WorkerConfig against the infrastructure. Zonal clusters do
+// not place their machines in a VMSS Flex, hence there is no scale set whose policy could be configured.
+func ValidateScaleInPolicy(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.ScaleInPolicy == nil {
+		return allErrs
+	}
+
+	if infra != nil && infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleInPolicy"), "a scale-in policy can only be configured for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+
+	return allErrs
+}
+
+var availableCapacityPolicies = []string{
+	string(apiazure.CapacityPolicyIgnore),
+	string(apiazure.CapacityPolicyReport),
+}
+
+func validateCapacityPolicy(capacityPolicy *apiazure.CapacityPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if capacityPolicy != nil && !slices.Contains(availableCapacityPolicies, string(*capacityPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *capacityPolicy, availableCapacityPolicies))
+	}
+
+	return allErrs
+}
+
+var availableLicenseTypes = []string{
+	string(apiazure.LicenseTypeWindowsServer),
+	string(apiazure.LicenseTypeRHELBYOS),
+	string(apiazure.LicenseTypeSLESBYOS),
+}
+
+var availableOperatingSystems = []string{
+	string(apiazure.OperatingSystemWindows),
+	string(apiazure.OperatingSystemRHEL),
+	string(apiazure.OperatingSystemSLES),
+}
+
+// licenseTypeOperatingSystems are the operating systems of the machine images the license types can be applied to.
+var licenseTypeOperatingSystems = map[apiazure.LicenseType]apiazure.OperatingSystem{
+	apiazure.LicenseTypeWindowsServer: apiazure.OperatingSystemWindows,
+	apiazure.LicenseTypeRHELBYOS:      apiazure.OperatingSystemRHEL,
+	apiazure.LicenseTypeSLESBYOS:      apiazure.OperatingSystemSLES,
+}
+
+func validateLicenseType(licenseType *apiazure.LicenseType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if licenseType != nil && !slices.Contains(availableLicenseTypes, string(*licenseType)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *licenseType, availableLicenseTypes))
+	}
+
+	return allErrs
+}
+
+// ValidateCapacityPolicy validates the capacity policy of a WorkerConfig against the infrastructure. Zonal clusters do
+// not place their machines in a VMSS Flex, hence there is no capacity which could drift.
+func ValidateCapacityPolicy(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || ptr.Deref(workerConfig.CapacityPolicy, apiazure.CapacityPolicyIgnore) != apiazure.CapacityPolicyReport {
+		return allErrs
+	}
+
+	if infra != nil && infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacityPolicy"), "a capacity drift can only be reported for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+
+	return allErrs
+}
+
+// dataCollectionRuleResourceType is the resource type of Azure Monitor data collection rules.
+const dataCollectionRuleResourceType = "Microsoft.Insights/dataCollectionRules"
+
+func validateAzureMonitorAgent(agent *apiazure.AzureMonitorAgent, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if agent == nil {
+		return allErrs
+	}
+
+	idPath := fldPath.Child("dataCollectionRuleID")
+	if agent.DataCollectionRuleID == "" {
+		return append(allErrs, field.Required(idPath, "the data collection rule must be set"))
+	}
+	id, err := arm.ParseResourceID(agent.DataCollectionRuleID)
+	if err != nil {
+		return append(allErrs, field.Invalid(idPath, agent.DataCollectionRuleID, fmt.Sprintf("must be a valid resource ID: %v", err)))
+	}
+	if !strings.EqualFold(id.ResourceType.String(), dataCollectionRuleResourceType) {
+		allErrs = append(allErrs, field.Invalid(idPath, agent.DataCollectionRuleID, fmt.Sprintf("must be the resource ID of a resource of type %s", dataCollectionRuleResourceType)))
+	}
+
+	return allErrs
+}
+
+// ValidateAzureMonitorAgent validates the Azure Monitor Agent setting of a WorkerConfig against the infrastructure.
+func ValidateAzureMonitorAgent(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.AzureMonitorAgent == nil || infra == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("azureMonitorAgent")
+
+	if infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the Azure Monitor Agent can only be configured for non-zonal clusters, which place their machines in a VMSS Flex"))
+	}
+	if infra.Identity == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the Azure Monitor Agent authenticates with the managed identity of the InfrastructureConfig, which must be configured"))
+	}
+
+	return allErrs
+}
+
+var keyVaultKeyVersionRegex = regexp.MustCompile("^[0-9a-fA-F]{32}$")
+
+func validateUserDataEncryption(encryption *apiazure.UserDataEncryption, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if encryption == nil {
+		return allErrs
+	}
+
+	idPath := fldPath.Child("keyID")
+	if encryption.KeyID == "" {
+		return append(allErrs, field.Required(idPath, "the key must be set"))
+	}
+	u, err := url.Parse(encryption.KeyID)
+	if err != nil {
+		return append(allErrs, field.Invalid(idPath, encryption.KeyID, fmt.Sprintf("must be a valid URL: %v", err)))
+	}
+	if u.Scheme != "https" || !strings.Contains(u.Host, ".") || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return append(allErrs, field.Invalid(idPath, encryption.KeyID, "must be the https URL of a key of a Key Vault, e.g. https://my-vault.vault.azure.net/keys/my-key"))
+	}
+
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	switch {
+	case len(segments) < 2 || len(segments) > 3 || segments[0] != "keys":
+		allErrs = append(allErrs, field.Invalid(idPath, encryption.KeyID, "must have the path /keys/<name> or /keys/<name>/<version>"))
+	case !keyVaultObjectNameRegex.MatchString(segments[1]):
+		allErrs = append(allErrs, field.Invalid(idPath, encryption.KeyID, "the key name must consist of 1 to 127 alphanumeric characters or dashes"))
+	case len(segments) == 3 && !keyVaultKeyVersionRegex.MatchString(segments[2]):
+		allErrs = append(allErrs, field.Invalid(idPath, encryption.KeyID, "the key version must consist of 32 hexadecimal characters"))
+	}
+
+	return allErrs
+}
+
+// ValidateUserDataEncryption validates the user data encryption setting of a WorkerConfig against the infrastructure.
+func ValidateUserDataEncryption(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.UserDataEncryption == nil || infra == nil {
+		return allErrs
+	}
+
+	if infra.Identity == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("userDataEncryption"), "the machines decrypt the user data with the managed identity of the InfrastructureConfig, which must be configured"))
+	}
+
+	return allErrs
+}
+
+// ValidateVNetEncryption validates the machine type of a worker pool against the encryption of the vnet. A vnet which
+// drops unencrypted traffic only forwards the traffic of machines with accelerated networking, hence the machine type
+// must support it.
+func ValidateVNetEncryption(worker core.Worker, infra *apiazure.InfrastructureConfig, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infra == nil || cloudProfileConfig == nil {
+		return allErrs
+	}
+	encryption := infra.Networks.VNet.Encryption
+	if encryption == nil || !encryption.Enabled ||
+		ptr.Deref(encryption.Enforcement, apiazure.VNetEncryptionEnforcementDropUnencrypted) != apiazure.VNetEncryptionEnforcementDropUnencrypted {
+		return allErrs
+	}
+
+	idx := slices.IndexFunc(cloudProfileConfig.MachineTypes, func(mt apiazure.MachineType) bool { return mt.Name == worker.Machine.Type })
+	if idx < 0 || !ptr.Deref(cloudProfileConfig.MachineTypes[idx].AcceleratedNetworking, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("machine type %q does not support accelerated networking, which the encrypted vnet requires to not drop the traffic of the machines", worker.Machine.Type)))
+	}
+
+	return allErrs
+}
+
+// nonShellUserDataImages are the machine images whose operating system extension does not generate a shell script as
+// user data, but a cloud-config or an Ignition config.
+var nonShellUserDataImages = []string{"coreos", "flatcar"}
+
+// ValidatePrePullImages validates that the user data of the machine image of the worker pool is a shell script if the
+// WorkerConfig pre-pulls images, since the pre-pull unit is added to the script.
+func ValidatePrePullImages(workerConfig *apiazure.WorkerConfig, worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || len(workerConfig.PrePullImages) == 0 || worker.Machine.Image == nil {
+		return allErrs
+	}
+
+	if slices.Contains(nonShellUserDataImages, worker.Machine.Image.Name) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("prePullImages"),
+			fmt.Sprintf("images cannot be pre-pulled for machine image %q, because its user data is not a shell script", worker.Machine.Image.Name)))
+	}
+
+	return allErrs
+}
+
+// noHostCachingDiskTypes are the disk types which do not support host caching.
+var noHostCachingDiskTypes = []string{string(armcompute.DiskStorageAccountTypesUltraSSDLRS), string(armcompute.DiskStorageAccountTypesPremiumV2LRS)}
+
+// ValidateOSDiskCaching validates the OS disk caching of a WorkerConfig against the OS disk type of the worker pool.
+func ValidateOSDiskCaching(workerConfig *apiazure.WorkerConfig, worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.Volume == nil || workerConfig.Volume.Caching == nil || worker.Volume == nil || worker.Volume.Type == nil {
+		return allErrs
+	}
+
+	caching, diskType := *workerConfig.Volume.Caching, *worker.Volume.Type
+	if caching != string(armcompute.CachingTypesNone) && slices.Contains(noHostCachingDiskTypes, diskType) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("volume", "caching"),
+			fmt.Sprintf("disk type %q of the OS disk does not support host caching, the caching must be %q", diskType, armcompute.CachingTypesNone)))
+	}
+
+	return allErrs
+}
+
+// ValidateMachineImageVersion validates that the machine image version pinned by a WorkerConfig is offered by the
+// CloudProfile for the machine image and architecture of the worker pool and is not expired. An expired version remains
+// allowed if the old WorkerConfig already pinned it, so that Shoots with a pinned version can still be updated.
+func ValidateMachineImageVersion(workerConfig, oldWorkerConfig *apiazure.WorkerConfig, worker core.Worker, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.MachineImageVersion == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("machineImageVersion")
+
+	version := *workerConfig.MachineImageVersion
+	if version == "" {
+		return append(allErrs, field.Required(fldPath, "the pinned version must not be empty"))
+	}
+	if worker.Machine.Image == nil || worker.Machine.Image.Name == "" {
+		return append(allErrs, field.Forbidden(fldPath, "a version can only be pinned if the machine image of the worker pool is set"))
+	}
+	if cloudProfileSpec == nil {
+		return allErrs
+	}
+
+	imageName := worker.Machine.Image.Name
+	imageVersion, ok := gardencorev1beta1helper.FindMachineImageVersion(cloudProfileSpec.MachineImages, imageName, version)
+	if !ok {
+		var activeVersions []string
+		for _, image := range cloudProfileSpec.MachineImages {
+			if image.Name != imageName {
+				continue
+			}
+			for _, v := range image.Versions {
+				if gardencorev1beta1helper.CurrentLifecycleClassification(v.ExpirableVersion).IsActive() {
+					activeVersions = append(activeVersions, v.Version)
+				}
+			}
+		}
+		return append(allErrs, field.NotSupported(fldPath, version, activeVersions))
+	}
+
+	switch classification := gardencorev1beta1helper.CurrentLifecycleClassification(imageVersion.ExpirableVersion); {
+	case classification == gardencorev1beta1.ClassificationExpired:
+		if oldWorkerConfig == nil || ptr.Deref(oldWorkerConfig.MachineImageVersion, "") != version {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("version %q of machine image %q is expired", version, imageName)))
+		}
+	case !classification.IsActive():
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("version %q of machine image %q is classified as %s", version, imageName, classification)))
+	}
+
+	if cloudProfileConfig != nil {
+		architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+		if _, err := helper.FindImageFromCloudProfile(cloudProfileConfig, imageName, version, &architecture); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, version, fmt.Sprintf("the providerConfig of the CloudProfile has no image for this version and architecture %q", architecture)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMachineImageExpiration validates that the machine image version which is selected for a worker pool is
+// neither deprecated nor expired and does not expire within the given window. The version pinned by the WorkerConfig
+// takes precedence over the version of the worker pool. A version which the old worker pool already used is not
+// validated again, so that Shoots can still be updated after their version was deprecated.
+func ValidateMachineImageExpiration(workerConfig, oldWorkerConfig *apiazure.WorkerConfig, worker core.Worker, oldWorker *core.Worker, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, window time.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	imageName, version, versionPath := selectedMachineImageVersion(workerConfig, worker, fldPath)
+	if imageName == "" || version == "" || cloudProfileSpec == nil {
+		return allErrs
+	}
+	if oldWorker != nil {
+		if oldImageName, oldVersion, _ := selectedMachineImageVersion(oldWorkerConfig, *oldWorker, fldPath); oldImageName == imageName && oldVersion == version {
+			return allErrs
+		}
+	}
+
+	imageVersion, ok := gardencorev1beta1helper.FindMachineImageVersion(cloudProfileSpec.MachineImages, imageName, version)
+	if !ok {
+		return allErrs
+	}
+
+	now := time.Now()
+	expirationDate := imageVersion.ExpirationDate
+	switch {
+	case expirationDate != nil && !now.Before(expirationDate.Time):
+		allErrs = append(allErrs, field.Forbidden(versionPath, fmt.Sprintf("version %q of machine image %q of worker pool %q expired on %s",
+			version, imageName, worker.Name, expirationDate.UTC().Format(time.RFC3339))))
+	case ptr.Deref(imageVersion.Classification, "") == gardencorev1beta1.ClassificationDeprecated:
+		detail := fmt.Sprintf("version %q of machine image %q of worker pool %q is classified as %s", version, imageName, worker.Name, gardencorev1beta1.ClassificationDeprecated)
+		if expirationDate != nil {
+			detail += fmt.Sprintf(" and expires on %s", expirationDate.UTC().Format(time.RFC3339))
+		}
+		allErrs = append(allErrs, field.Forbidden(versionPath, detail))
+	case expirationDate != nil && expirationDate.Time.Before(now.Add(window)):
+		allErrs = append(allErrs, field.Forbidden(versionPath, fmt.Sprintf("version %q of machine image %q of worker pool %q expires on %s, which is within %s",
+			version, imageName, worker.Name, expirationDate.UTC().Format(time.RFC3339), window)))
+	}
+
+	return allErrs
+}
+
+// selectedMachineImageVersion returns the name and version of the machine image which is selected for the given worker
+// pool and the field path of the version.
+func selectedMachineImageVersion(workerConfig *apiazure.WorkerConfig, worker core.Worker, fldPath *field.Path) (string, string, *field.Path) {
+	if worker.Machine.Image == nil {
+		return "", "", nil
+	}
+	if workerConfig != nil && workerConfig.MachineImageVersion != nil {
+		return worker.Machine.Image.Name, *workerConfig.MachineImageVersion, fldPath.Child("providerConfig", "machineImageVersion")
+	}
+	return worker.Machine.Image.Name, worker.Machine.Image.Version, fldPath.Child("machine", "image", "version")
+}
+
+// ValidateLicenseType validates that the license type of a WorkerConfig matches the operating system of the machine
+// image version which is selected for the worker pool, as it is declared in the CloudProfileConfig.
+func ValidateLicenseType(workerConfig *apiazure.WorkerConfig, worker core.Worker, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.LicenseType == nil || cloudProfileConfig == nil {
+		return allErrs
+	}
+	operatingSystem, ok := licenseTypeOperatingSystems[*workerConfig.LicenseType]
+	if !ok {
+		return allErrs
+	}
+	imageName, version, _ := selectedMachineImageVersion(workerConfig, worker, fldPath)
+	if imageName == "" || version == "" {
+		return allErrs
+	}
+
+	architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+	for _, image := range cloudProfileConfig.MachineImages {
+		if image.Name != imageName {
+			continue
+		}
+		for _, imageVersion := range image.Versions {
+			if imageVersion.Version != version || ptr.Deref(imageVersion.Architecture, v1beta1constants.ArchitectureAMD64) != architecture {
+				continue
+			}
+			if ptr.Deref(imageVersion.OperatingSystem, "") != operatingSystem {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("licenseType"), fmt.Sprintf("license type %q can only be applied to images with operating system %q, but version %q of machine image %q is not marked with it in the CloudProfile", *workerConfig.LicenseType, operatingSystem, version, imageName)))
+			}
+			return allErrs
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateRollingUpdate validates the rolling update of a WorkerConfig. The resulting maximum surge and maximum
+// unavailability, which fall back to the values of the worker pool, must not both be zero, since the machines could not
+// be replaced otherwise.
+func ValidateRollingUpdate(workerConfig *apiazure.WorkerConfig, worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.RollingUpdate == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("rollingUpdate")
+	rollingUpdate := workerConfig.RollingUpdate
+
+	if gardencorev1beta1helper.IsUpdateStrategyInPlace((*gardencorev1beta1.MachineUpdateStrategy)(worker.UpdateStrategy)) {
+		return append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can only be set if the update strategy of the worker pool is %q", core.AutoRollingUpdate)))
+	}
+
+	allErrs = append(allErrs, validateIntOrPercent(rollingUpdate.MaxSurge, false, fldPath.Child("maxSurge"))...)
+	allErrs = append(allErrs, validateIntOrPercent(rollingUpdate.MaxUnavailable, true, fldPath.Child("maxUnavailable"))...)
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	maxSurge, maxUnavailable := worker.MaxSurge, worker.MaxUnavailable
+	if rollingUpdate.MaxSurge != nil {
+		maxSurge = rollingUpdate.MaxSurge
+	}
+	if rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = rollingUpdate.MaxUnavailable
+	}
+	if isZeroIntOrPercent(maxSurge) && isZeroIntOrPercent(maxUnavailable) {
+		allErrs = append(allErrs, field.Invalid(fldPath, rollingUpdate, "maxSurge and maxUnavailable of the worker pool must not both be 0"))
+	}
+
+	return allErrs
+}
+
+// validateIntOrPercent validates that the given value is a non-negative number or percentage. Percentages above 100% are
+// only allowed if atMost100Percent is false.
+func validateIntOrPercent(value *intstr.IntOrString, atMost100Percent bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value == nil {
+		return allErrs
+	}
+
+	if value.Type == intstr.String {
+		if len(validation.IsValidPercent(value.StrVal)) > 0 {
+			return append(allErrs, field.Invalid(fldPath, value.StrVal, "must be an integer or percentage (e.g '5%')"))
+		}
+		if percent, _ := intstr.GetScaledValueFromIntOrPercent(value, 100, false); atMost100Percent && percent > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "must not be greater than 100%"))
+		}
+		return allErrs
+	}
+	if value.IntVal < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value.IntVal, "must not be negative"))
+	}
+	return allErrs
+}
+
+// isZeroIntOrPercent returns whether the given number or percentage is unset or zero.
+func isZeroIntOrPercent(value *intstr.IntOrString) bool {
+	if value == nil {
+		return true
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, false)
+	return err == nil && scaled == 0
+}
+
+// ValidateSubnetName validates that the subnet referenced by a WorkerConfig is an additional subnet of the infrastructure
+// which can host the machines of all zones of the worker pool.
+func ValidateSubnetName(workerConfig *apiazure.WorkerConfig, worker core.Worker, infra *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.SubnetName == nil || infra == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("subnetName")
+
+	subnet := helper.FindAdditionalSubnet(infra, *workerConfig.SubnetName)
+	if subnet == nil {
+		return append(allErrs, field.NotFound(fldPath, *workerConfig.SubnetName))
+	}
+	if subnet.Zone == nil {
+		return allErrs
+	}
+
+	zone := helper.InfrastructureZoneToString(*subnet.Zone)
+	for _, workerZone := range worker.Zones {
+		if workerZone != zone {
+			allErrs = append(allErrs, field.Invalid(fldPath, *workerConfig.SubnetName,
+				fmt.Sprintf("subnet belongs to zone %q, but worker pool %q also uses zone %q", zone, worker.Name, workerZone)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateFallbackZones validates the fallback zones of a WorkerConfig. They are only supported for worker pools with
+// zones and must be zones of the region which are not used by the worker pool already. The machines of a fallback zone
+// need a subnet for the zone in case the infrastructure uses a subnet per zone.
+func ValidateFallbackZones(workerConfig *apiazure.WorkerConfig, worker core.Worker, infra *apiazure.InfrastructureConfig, region string, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || len(workerConfig.FallbackZones) == 0 {
+		return allErrs
+	}
+	fldPath = fldPath.Child("fallbackZones")
+
+	if len(worker.Zones) == 0 {
+		return append(allErrs, field.Forbidden(fldPath, "fallback zones are only supported for worker pools with zones"))
+	}
+	if workerConfig.SubnetName != nil && infra != nil {
+		if subnet := helper.FindAdditionalSubnet(infra, *workerConfig.SubnetName); subnet != nil && subnet.Zone != nil {
+			return append(allErrs, field.Forbidden(fldPath, "fallback zones cannot be combined with a subnet of a single zone"))
+		}
+	}
+
+	var regionZones []string
+	if cloudProfileSpec != nil {
+		for _, r := range cloudProfileSpec.Regions {
+			if r.Name == region {
+				for _, zone := range r.Zones {
+					regionZones = append(regionZones, zone.Name)
+				}
+			}
+		}
+	}
+
+	var infraZones []string
+	if infra != nil && workerConfig.SubnetName == nil && !helper.IsUsingSingleSubnetLayout(infra) {
+		for _, zone := range infra.Networks.Zones {
+			infraZones = append(infraZones, helper.InfrastructureZoneToString(zone.Name))
+		}
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range workerConfig.FallbackZones {
+		idxPath := fldPath.Index(i)
+		switch {
+		case zones.Has(zone):
+			allErrs = append(allErrs, field.Duplicate(idxPath, zone))
+		case slices.Contains(worker.Zones, zone):
+			allErrs = append(allErrs, field.Invalid(idxPath, zone, "zone is already used by the worker pool"))
+		case len(regionZones) > 0 && !slices.Contains(regionZones, zone):
+			allErrs = append(allErrs, field.NotSupported(idxPath, zone, regionZones))
+		case infraZones != nil && !slices.Contains(infraZones, zone):
+			allErrs = append(allErrs, field.Invalid(idxPath, zone, "the infrastructure has no subnet for the zone"))
+		}
+		zones.Insert(zone)
+	}
 
 	return allErrs
 }
@@ -79,6 +958,15 @@ func validateDataVolumeConf(dataVolumeConfigs []apiazure.DataVolume, dataVolumes
 		dvPath := fldPath.Index(idx)
 		imgRefPath := dvPath.Child("imageRef")
 
+		if dataVolumeConf.ProvisionedIops != nil || dataVolumeConf.ProvisionedThroughput != nil {
+			volumeIdx := slices.IndexFunc(dataVolumes, func(dataVolume core.DataVolume) bool { return dataVolume.Name == dataVolumeConf.Name })
+			if volumeIdx < 0 {
+				allErrs = append(allErrs, field.Invalid(dvPath.Child("name"), dataVolumeConf.Name, "no dataVolume with this name exists"))
+			} else {
+				allErrs = append(allErrs, validateProvisionedPerformance(dataVolumeConf, dataVolumes[volumeIdx], dvPath)...)
+			}
+		}
+
 		if imgRef := dataVolumeConf.ImageRef; imgRef != nil {
 			if !slices.Contains(dataVolumeNames, dataVolumeConf.Name) {
 				allErrs = append(allErrs, field.Invalid(dvPath.Child("name"), dataVolumeConf.Name, "no dataVolume with this name exists"))
@@ -109,6 +997,62 @@ func validateDataVolumeConf(dataVolumeConfigs []apiazure.DataVolume, dataVolumes
 	return allErrs
 }
 
+const (
+	// ultraDiskMinIops is the minimum number of IOPS of an Ultra disk.
+	ultraDiskMinIops = 100
+	// ultraDiskMaxIops is the maximum number of IOPS of an Ultra disk.
+	ultraDiskMaxIops = 400000
+	// ultraDiskMaxIopsPerGiB is the maximum number of IOPS per GiB of the size of an Ultra disk.
+	ultraDiskMaxIopsPerGiB = 300
+	// ultraDiskMinThroughput is the minimum throughput of an Ultra disk in MBps.
+	ultraDiskMinThroughput = 1
+	// ultraDiskMaxThroughput is the maximum throughput of an Ultra disk in MBps.
+	ultraDiskMaxThroughput = 10000
+	// ultraDiskMaxIopsPerThroughput is the number of provisioned IOPS which allow one MBps of throughput of an Ultra disk.
+	ultraDiskMaxIopsPerThroughput = 4
+)
+
+// validateProvisionedPerformance validates the provisioned IOPS and throughput of a data volume against the limits which
+// Azure allows for an Ultra disk of its size.
+func validateProvisionedPerformance(dataVolumeConf apiazure.DataVolume, dataVolume core.DataVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ptr.Deref(dataVolume.Type, "") != string(armcompute.DiskStorageAccountTypesUltraSSDLRS) {
+		msg := fmt.Sprintf("can only be set for data volumes of type %q", armcompute.DiskStorageAccountTypesUltraSSDLRS)
+		if dataVolumeConf.ProvisionedIops != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedIops"), msg))
+		}
+		if dataVolumeConf.ProvisionedThroughput != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedThroughput"), msg))
+		}
+		return allErrs
+	}
+
+	if iops := dataVolumeConf.ProvisionedIops; iops != nil {
+		maxIops := int64(ultraDiskMaxIops)
+		if size, err := resource.ParseQuantity(dataVolume.VolumeSize); err == nil {
+			maxIops = min(maxIops, ultraDiskMaxIopsPerGiB*(size.Value()>>30))
+		}
+		if *iops < ultraDiskMinIops || *iops > maxIops {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("provisionedIops"), *iops,
+				fmt.Sprintf("must be between %d and %d for an Ultra disk of size %s", ultraDiskMinIops, maxIops, dataVolume.VolumeSize)))
+		}
+	}
+
+	if throughput := dataVolumeConf.ProvisionedThroughput; throughput != nil {
+		maxThroughput := int64(ultraDiskMaxThroughput)
+		if iops := dataVolumeConf.ProvisionedIops; iops != nil {
+			maxThroughput = min(maxThroughput, *iops/ultraDiskMaxIopsPerThroughput)
+		}
+		if *throughput < ultraDiskMinThroughput || *throughput > maxThroughput {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("provisionedThroughput"), *throughput,
+				fmt.Sprintf("must be between %d and %d MBps for an Ultra disk with the provisioned IOPS", ultraDiskMinThroughput, maxThroughput)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateOSDiskConf(osDiskConf *apiazure.Volume, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
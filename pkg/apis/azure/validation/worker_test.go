@@ -5,13 +5,20 @@
 package validation
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -51,6 +58,45 @@ var _ = Describe("ValidateWorkerConfig", func() {
 					"Detail": ContainSubstring("does not match expected regex"),
 				}))))
 		})
+
+		It("should accept a valid retention", func() {
+			workerCfg.DiagnosticsProfile = &apisazure.DiagnosticsProfile{
+				Enabled:    true,
+				StorageURI: ptr.To("https://mystorageaccount.blob.core.windows.net/mycontainer"),
+				Retention:  &apisazure.BootDiagnosticsRetention{ResourceGroup: "my-rg", Days: 30},
+			}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a retention without a storage account of the user", func() {
+			workerCfg.DiagnosticsProfile = &apisazure.DiagnosticsProfile{
+				Enabled:   true,
+				Retention: &apisazure.BootDiagnosticsRetention{ResourceGroup: "my-rg", Days: 30},
+			}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("config.diagnosticsProfile.retention"),
+			}))))
+		})
+
+		It("should reject an invalid resource group and days", func() {
+			workerCfg.DiagnosticsProfile = &apisazure.DiagnosticsProfile{
+				Enabled:    true,
+				StorageURI: ptr.To("https://mystorageaccount.blob.core.windows.net/mycontainer"),
+				Retention:  &apisazure.BootDiagnosticsRetention{Days: 0},
+			}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.diagnosticsProfile.retention.resourceGroup"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("config.diagnosticsProfile.retention.days"),
+					"Detail": Equal("must be between 1 and 99999"),
+				})),
+			))
+		})
 	})
 
 	Describe("NodeTemplate", func() {
@@ -118,6 +164,77 @@ var _ = Describe("ValidateWorkerConfig", func() {
 			Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(BeEmpty())
 		})
 
+		Context("provisioned performance", func() {
+			var dataVolumes []core.DataVolume
+
+			BeforeEach(func() {
+				dataVolumes = []core.DataVolume{{Name: "ultra", Type: ptr.To("UltraSSD_LRS"), VolumeSize: "100Gi"}}
+			})
+
+			It("should allow the provisioned performance of an Ultra disk within its limits", func() {
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "ultra", ProvisionedIops: ptr.To[int64](30000), ProvisionedThroughput: ptr.To[int64](7500)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath.Child("dataVolumes"))).To(BeEmpty())
+			})
+
+			It("should forbid the provisioned performance for other disk types", func() {
+				dataVolumes[0].Type = ptr.To("Premium_LRS")
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "ultra", ProvisionedIops: ptr.To[int64](1000), ProvisionedThroughput: ptr.To[int64](100)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath.Child("dataVolumes"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("config.dataVolumes[0].provisionedIops"),
+						"Detail": Equal(`can only be set for data volumes of type "UltraSSD_LRS"`),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.dataVolumes[0].provisionedThroughput"),
+					})),
+				))
+			})
+
+			DescribeTable("should forbid a provisioned performance beyond the limits of the disk",
+				func(iops, throughput *int64, fieldName, detail string) {
+					dataVolumeConfigs := []apisazure.DataVolume{{Name: "ultra", ProvisionedIops: iops, ProvisionedThroughput: throughput}}
+
+					Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath.Child("dataVolumes"))).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Field":  Equal(fieldName),
+							"Detail": Equal(detail),
+						})),
+					))
+				},
+				Entry("too few IOPS", ptr.To[int64](50), nil, "config.dataVolumes[0].provisionedIops", "must be between 100 and 30000 for an Ultra disk of size 100Gi"),
+				Entry("more IOPS than the size allows", ptr.To[int64](30001), nil, "config.dataVolumes[0].provisionedIops", "must be between 100 and 30000 for an Ultra disk of size 100Gi"),
+				Entry("no throughput", nil, ptr.To[int64](0), "config.dataVolumes[0].provisionedThroughput", "must be between 1 and 10000 MBps for an Ultra disk with the provisioned IOPS"),
+				Entry("more throughput than the IOPS allow", ptr.To[int64](1000), ptr.To[int64](251), "config.dataVolumes[0].provisionedThroughput", "must be between 1 and 250 MBps for an Ultra disk with the provisioned IOPS"),
+			)
+
+			It("should limit the IOPS of large disks to the maximum of Ultra disks", func() {
+				dataVolumes[0].VolumeSize = "4Ti"
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "ultra", ProvisionedIops: ptr.To[int64](400001)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath.Child("dataVolumes"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Field":  Equal("config.dataVolumes[0].provisionedIops"),
+						"Detail": Equal("must be between 100 and 400000 for an Ultra disk of size 4Ti"),
+					})),
+				))
+			})
+
+			It("should forbid the provisioned performance of a data volume which does not exist", func() {
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "premium", ProvisionedIops: ptr.To[int64](1000)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath.Child("dataVolumes"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[0].name"),
+					})),
+				))
+			})
+		})
+
 		It("should forbid config of none existing DataVolume", func() {
 			var dataVolumes []core.DataVolume
 			dataVolumeConfigs := []apisazure.DataVolume{{
@@ -243,4 +360,1328 @@ var _ = Describe("ValidateWorkerConfig", func() {
 			Expect(validateOSDiskConf(osDiskConf, nil)).To(BeEmpty())
 		})
 	})
+
+	Describe("PrePullImages", func() {
+		It("should allow valid image references", func() {
+			workerCfg.PrePullImages = []string{
+				"busybox",
+				"nvcr.io/nvidia/pytorch:24.01-py3",
+				"localhost:5000/team/base@sha256:" + strings.Repeat("a", 64),
+				"europe-docker.pkg.dev/gardener-project/releases/gardener/node-agent:v1.100.0",
+			}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate image references", func() {
+			workerCfg.PrePullImages = []string{
+				"",
+				"Docker.io/Library/BusyBox",
+				"busybox:latest; rm -rf /",
+				"busybox",
+				"busybox",
+			}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.prePullImages[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.prePullImages[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.prePullImages[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.prePullImages[4]"),
+				})),
+			))
+		})
+	})
+
+	Describe("Time", func() {
+		It("should allow valid NTP servers and timezones", func() {
+			workerCfg.Time = &apisazure.TimeConfig{
+				NTPServers: []string{"ntp.example.com", "Time.Windows.com", "10.0.0.1", "fd00::1"},
+				Timezone:   ptr.To("America/Port-au-Prince"),
+			}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+
+			workerCfg.Time = &apisazure.TimeConfig{Timezone: ptr.To("Etc/GMT+5")}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate NTP servers", func() {
+			workerCfg.Time = &apisazure.TimeConfig{
+				NTPServers: []string{"ntp.example.com", "ntp.example.com; reboot", "", "ntp.example.com"},
+			}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.time.ntpServers[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.time.ntpServers[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.time.ntpServers[3]"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid invalid timezones",
+			func(timezone string) {
+				workerCfg.Time = &apisazure.TimeConfig{Timezone: ptr.To(timezone)}
+
+				Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Field": Equal("config.time.timezone"),
+					})),
+				))
+			},
+			Entry("unknown timezone", "Europe/Atlantis"),
+			Entry("local timezone", "Local"),
+			Entry("empty timezone", ""),
+			Entry("path traversal", "../../etc/passwd"),
+			Entry("shell metacharacters", "Europe/Berlin'; reboot"),
+		)
+	})
+
+	Describe("KubeletConfig", func() {
+		It("should allow a valid kubelet configuration", func() {
+			workerCfg.KubeletConfig = &apisazure.KubeletConfig{
+				MaxPods:        ptr.To[int32](250),
+				EvictionHard:   map[string]string{"memory.available": "200Mi", "nodefs.available": "10%", "pid.available": "5.5%"},
+				SystemReserved: map[string]string{"cpu": "100m", "memory": "1Gi", "ephemeral-storage": "2Gi", "pid": "1000"},
+				KubeReserved:   map[string]string{"memory": "512Mi"},
+			}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unknown keys and invalid values", func() {
+			workerCfg.KubeletConfig = &apisazure.KubeletConfig{
+				MaxPods:        ptr.To[int32](0),
+				EvictionHard:   map[string]string{"memory.free": "200Mi", "nodefs.available": "110%", "imagefs.available": "-1Gi"},
+				SystemReserved: map[string]string{"gpu": "1", "memory": "lots"},
+				KubeReserved:   map[string]string{"cpu": "-100m"},
+			}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.kubeletConfig.maxPods"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("config.kubeletConfig.evictionHard"),
+					"BadValue": Equal("memory.free"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.kubeletConfig.evictionHard[nodefs.available]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.kubeletConfig.evictionHard[imagefs.available]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("config.kubeletConfig.systemReserved"),
+					"BadValue": Equal("gpu"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.kubeletConfig.systemReserved[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.kubeletConfig.kubeReserved[cpu]"),
+				})),
+			))
+		})
+	})
+
+	Describe("ScaleInPolicy", func() {
+		It("should allow the supported scale-in rules", func() {
+			for _, rule := range []apisazure.ScaleInPolicyRule{apisazure.ScaleInPolicyRuleDefault, apisazure.ScaleInPolicyRuleNewestVM, apisazure.ScaleInPolicyRuleOldestVM} {
+				workerCfg.ScaleInPolicy = &apisazure.ScaleInPolicy{Rule: ptr.To(rule), ForceDeletion: ptr.To(true)}
+
+				Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+			}
+		})
+
+		It("should allow a scale-in policy without a rule", func() {
+			workerCfg.ScaleInPolicy = &apisazure.ScaleInPolicy{ForceDeletion: ptr.To(true)}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported scale-in rule", func() {
+			workerCfg.ScaleInPolicy = &apisazure.ScaleInPolicy{Rule: ptr.To(apisazure.ScaleInPolicyRule("RandomVM"))}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.scaleInPolicy.rule"),
+				})),
+			))
+		})
+	})
+
+	Describe("CapacityPolicy", func() {
+		It("should allow the supported capacity policies", func() {
+			for _, policy := range []apisazure.CapacityPolicy{apisazure.CapacityPolicyIgnore, apisazure.CapacityPolicyReport} {
+				workerCfg.CapacityPolicy = ptr.To(policy)
+
+				Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid an unsupported capacity policy", func() {
+			workerCfg.CapacityPolicy = ptr.To(apisazure.CapacityPolicy("Scale"))
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.capacityPolicy"),
+				})),
+			))
+		})
+	})
+
+	Describe("LicenseType", func() {
+		It("should allow the supported license types", func() {
+			for _, licenseType := range []apisazure.LicenseType{apisazure.LicenseTypeWindowsServer, apisazure.LicenseTypeRHELBYOS, apisazure.LicenseTypeSLESBYOS} {
+				workerCfg.LicenseType = ptr.To(licenseType)
+
+				Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid an unsupported license type", func() {
+			workerCfg.LicenseType = ptr.To(apisazure.LicenseType("Windows_Client"))
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.licenseType"),
+				})),
+			))
+		})
+	})
+
+	Describe("HealthProbe", func() {
+		BeforeEach(func() {
+			workerCfg.AutomaticRepairs = &apisazure.AutomaticRepairs{Enabled: true, GracePeriodMinutes: ptr.To[int32](30)}
+		})
+
+		It("should allow a HTTP health probe", func() {
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolHTTP, Port: 8080, Path: ptr.To("/healthz"), IntervalSeconds: ptr.To[int32](10)}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a TCP health probe", func() {
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolTCP, Port: 22}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should require a health probe if automatic repairs are enabled", func() {
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.healthProbe"),
+				})),
+			))
+		})
+
+		It("should forbid a health probe if automatic repairs are disabled", func() {
+			workerCfg.AutomaticRepairs.Enabled = false
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolTCP, Port: 22}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.healthProbe"),
+				})),
+			))
+		})
+
+		It("should forbid invalid health probe parameters", func() {
+			workerCfg.AutomaticRepairs.GracePeriodMinutes = ptr.To[int32](5)
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolHTTP, Port: 70000, IntervalSeconds: ptr.To[int32](120)}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.automaticRepairs.gracePeriodMinutes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.healthProbe.port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.healthProbe.path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.healthProbe.intervalSeconds"),
+				})),
+			))
+		})
+
+		It("should forbid relative HTTP paths and paths for TCP probes", func() {
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolHTTPS, Port: 443, Path: ptr.To("healthz")}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.healthProbe.path"),
+				})),
+			))
+
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: apisazure.HealthProbeProtocolTCP, Port: 22, Path: ptr.To("/healthz")}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.healthProbe.path"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported protocols", func() {
+			workerCfg.HealthProbe = &apisazure.HealthProbe{Protocol: "UDP", Port: 53}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.healthProbe.protocol"),
+				})),
+			))
+		})
+	})
+
+	Describe("AzureMonitorAgent", func() {
+		It("should allow the resource ID of a data collection rule", func() {
+			workerCfg.AzureMonitorAgent = &apisazure.AzureMonitorAgent{DataCollectionRuleID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Insights/dataCollectionRules/dcr"}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should require a data collection rule", func() {
+			workerCfg.AzureMonitorAgent = &apisazure.AzureMonitorAgent{}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.azureMonitorAgent.dataCollectionRuleID"),
+				})),
+			))
+		})
+
+		It("should forbid invalid resource IDs and other resource types", func() {
+			workerCfg.AzureMonitorAgent = &apisazure.AzureMonitorAgent{DataCollectionRuleID: "dcr"}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.azureMonitorAgent.dataCollectionRuleID"),
+				})),
+			))
+
+			workerCfg.AzureMonitorAgent.DataCollectionRuleID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Insights/dataCollectionEndpoints/dce"
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.azureMonitorAgent.dataCollectionRuleID"),
+				})),
+			))
+		})
+	})
+
+	Describe("UserDataEncryption", func() {
+		It("should allow versioned and unversioned key identifiers", func() {
+			workerCfg.UserDataEncryption = &apisazure.UserDataEncryption{KeyID: "https://my-vault.vault.azure.net/keys/my-key"}
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+
+			workerCfg.UserDataEncryption.KeyID = "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef"
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should require a key", func() {
+			workerCfg.UserDataEncryption = &apisazure.UserDataEncryption{}
+
+			Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.userDataEncryption.keyID"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid invalid key identifiers",
+			func(keyID string) {
+				workerCfg.UserDataEncryption = &apisazure.UserDataEncryption{KeyID: keyID}
+
+				Expect(ValidateWorkerConfig(workerCfg, nil, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.userDataEncryption.keyID"),
+					})),
+				))
+			},
+			Entry("no URL", "my-key"),
+			Entry("http URL", "http://my-vault.vault.azure.net/keys/my-key"),
+			Entry("query", "https://my-vault.vault.azure.net/keys/my-key?api-version=7.4"),
+			Entry("secret", "https://my-vault.vault.azure.net/secrets/my-secret"),
+			Entry("missing key name", "https://my-vault.vault.azure.net/keys"),
+			Entry("invalid key name", "https://my-vault.vault.azure.net/keys/my_key"),
+			Entry("invalid key version", "https://my-vault.vault.azure.net/keys/my-key/latest"),
+			Entry("additional path segments", "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef/wrapkey"),
+		)
+	})
+
+})
+
+var _ = Describe("ValidateSinglePlacementGroup", func() {
+	var (
+		fldPath *field.Path
+		worker  core.Worker
+		infra   *apisazure.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Maximum: 100}
+		infra = &apisazure.InfrastructureConfig{}
+	})
+
+	It("should allow an unset single placement group", func() {
+		worker.Maximum = 500
+		infra.Zoned = true
+
+		Expect(ValidateSinglePlacementGroup(&apisazure.WorkerConfig{}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a single placement group for up to 100 machines", func() {
+		Expect(ValidateSinglePlacementGroup(&apisazure.WorkerConfig{SinglePlacementGroup: ptr.To(true)}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow disabling the single placement group for large worker pools", func() {
+		worker.Maximum = 1000
+
+		Expect(ValidateSinglePlacementGroup(&apisazure.WorkerConfig{SinglePlacementGroup: ptr.To(false)}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a single placement group for more than 100 machines", func() {
+		worker.Maximum = 101
+
+		Expect(ValidateSinglePlacementGroup(&apisazure.WorkerConfig{SinglePlacementGroup: ptr.To(true)}, worker, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.singlePlacementGroup"),
+				"Detail": Equal("a single placement group supports at most 100 machines, but the worker pool has a maximum of 101 machines"),
+			})),
+		))
+	})
+
+	It("should forbid the single placement group for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateSinglePlacementGroup(&apisazure.WorkerConfig{SinglePlacementGroup: ptr.To(false)}, worker, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.singlePlacementGroup"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateFaultDomainCount", func() {
+	var (
+		fldPath            *field.Path
+		infra              *apisazure.InfrastructureConfig
+		cloudProfileConfig *apisazure.CloudProfileConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{}
+		cloudProfileConfig = &apisazure.CloudProfileConfig{
+			CountFaultDomains: []apisazure.DomainCount{
+				{Region: "westeurope", Count: 3},
+				{Region: "limited", Count: 2},
+			},
+		}
+	})
+
+	It("should allow an unset fault domain count", func() {
+		infra.Zoned = true
+
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{}, infra, "limited", cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a fault domain count up to the count of the region", func() {
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](3)}, infra, "westeurope", cloudProfileConfig, fldPath)).To(BeEmpty())
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](1)}, infra, "limited", cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a fault domain count above the count of a fault domain limited region", func() {
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](3)}, infra, "limited", cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.faultDomainCount"),
+				"Detail": Equal(`must not exceed the fault domain count 2 of region "limited"`),
+			})),
+		))
+	})
+
+	It("should forbid a fault domain count above the maximum of Azure in a region unknown to the CloudProfile", func() {
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](4)}, infra, "unknown", cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.faultDomainCount"),
+				"Detail": Equal("must not exceed 3, the maximum fault domain count of a VMSS Flex"),
+			})),
+		))
+	})
+
+	It("should allow a fault domain count in a region unknown to the CloudProfile", func() {
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](2)}, infra, "unknown", cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a non-positive fault domain count", func() {
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](0)}, infra, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.faultDomainCount"),
+				"Detail": Equal("must be at least 1"),
+			})),
+		))
+	})
+
+	It("should forbid the fault domain count for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateFaultDomainCount(&apisazure.WorkerConfig{FaultDomainCount: ptr.To[int32](2)}, infra, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.faultDomainCount"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateAutomaticRepairs", func() {
+	var (
+		fldPath      *field.Path
+		infra        *apisazure.InfrastructureConfig
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{}
+		workerConfig = &apisazure.WorkerConfig{AutomaticRepairs: &apisazure.AutomaticRepairs{Enabled: true}}
+	})
+
+	It("should allow automatic repairs for non-zonal clusters", func() {
+		Expect(ValidateAutomaticRepairs(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid automatic repairs for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateAutomaticRepairs(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.automaticRepairs"),
+			})),
+		))
+	})
+
+	It("should allow disabled automatic repairs for zonal clusters", func() {
+		infra.Zoned = true
+		workerConfig.AutomaticRepairs.Enabled = false
+
+		Expect(ValidateAutomaticRepairs(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ValidateScaleInPolicy", func() {
+	var (
+		fldPath      *field.Path
+		infra        *apisazure.InfrastructureConfig
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{}
+		workerConfig = &apisazure.WorkerConfig{ScaleInPolicy: &apisazure.ScaleInPolicy{Rule: ptr.To(apisazure.ScaleInPolicyRuleOldestVM)}}
+	})
+
+	It("should allow a scale-in policy for non-zonal clusters", func() {
+		Expect(ValidateScaleInPolicy(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a scale-in policy for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateScaleInPolicy(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.scaleInPolicy"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateCapacityPolicy", func() {
+	var (
+		fldPath      *field.Path
+		infra        *apisazure.InfrastructureConfig
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{}
+		workerConfig = &apisazure.WorkerConfig{CapacityPolicy: ptr.To(apisazure.CapacityPolicyReport)}
+	})
+
+	It("should allow reporting a capacity drift for non-zonal clusters", func() {
+		Expect(ValidateCapacityPolicy(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow to ignore the capacity for zonal clusters", func() {
+		infra.Zoned = true
+		workerConfig.CapacityPolicy = ptr.To(apisazure.CapacityPolicyIgnore)
+
+		Expect(ValidateCapacityPolicy(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid reporting a capacity drift for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateCapacityPolicy(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.capacityPolicy"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateAzureMonitorAgent", func() {
+	var (
+		fldPath      *field.Path
+		infra        *apisazure.InfrastructureConfig
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{Identity: &apisazure.IdentityConfig{Name: "identity", ResourceGroup: "rg"}}
+		workerConfig = &apisazure.WorkerConfig{AzureMonitorAgent: &apisazure.AzureMonitorAgent{DataCollectionRuleID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Insights/dataCollectionRules/dcr"}}
+	})
+
+	It("should allow the azure monitor agent for non-zonal clusters with an identity", func() {
+		Expect(ValidateAzureMonitorAgent(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid the azure monitor agent for zonal clusters", func() {
+		infra.Zoned = true
+
+		Expect(ValidateAzureMonitorAgent(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.azureMonitorAgent"),
+			})),
+		))
+	})
+
+	It("should forbid the azure monitor agent without an identity", func() {
+		infra.Identity = nil
+
+		Expect(ValidateAzureMonitorAgent(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.azureMonitorAgent"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateUserDataEncryption", func() {
+	var (
+		fldPath      *field.Path
+		infra        *apisazure.InfrastructureConfig
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		infra = &apisazure.InfrastructureConfig{Identity: &apisazure.IdentityConfig{Name: "identity", ResourceGroup: "rg"}}
+		workerConfig = &apisazure.WorkerConfig{UserDataEncryption: &apisazure.UserDataEncryption{KeyID: "https://my-vault.vault.azure.net/keys/my-key"}}
+	})
+
+	It("should allow the user data encryption with an identity", func() {
+		Expect(ValidateUserDataEncryption(workerConfig, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid the user data encryption without an identity", func() {
+		infra.Identity = nil
+
+		Expect(ValidateUserDataEncryption(workerConfig, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.userDataEncryption"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateVNetEncryption", func() {
+	var (
+		fldPath            *field.Path
+		infra              *apisazure.InfrastructureConfig
+		cloudProfileConfig *apisazure.CloudProfileConfig
+		worker             core.Worker
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("machine", "type")
+		infra = &apisazure.InfrastructureConfig{Networks: apisazure.NetworkConfig{VNet: apisazure.VNet{
+			Encryption: &apisazure.VNetEncryption{Enabled: true},
+		}}}
+		cloudProfileConfig = &apisazure.CloudProfileConfig{MachineTypes: []apisazure.MachineType{
+			{Name: "Standard_D4s_v5", AcceleratedNetworking: ptr.To(true)},
+			{Name: "Standard_B2s"},
+		}}
+		worker = core.Worker{Machine: core.Machine{Type: "Standard_D4s_v5"}}
+	})
+
+	It("should allow machine types with accelerated networking", func() {
+		Expect(ValidateVNetEncryption(worker, infra, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid machine types without accelerated networking if unencrypted traffic is dropped", func() {
+		worker.Machine.Type = "Standard_B2s"
+
+		Expect(ValidateVNetEncryption(worker, infra, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("machine.type"),
+				"Detail": ContainSubstring(`machine type "Standard_B2s" does not support accelerated networking`),
+			})),
+		))
+	})
+
+	It("should allow machine types without accelerated networking if unencrypted traffic is allowed", func() {
+		worker.Machine.Type = "Standard_B2s"
+		infra.Networks.VNet.Encryption.Enforcement = ptr.To(apisazure.VNetEncryptionEnforcementAllowUnencrypted)
+
+		Expect(ValidateVNetEncryption(worker, infra, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow any machine type if the encryption is disabled", func() {
+		worker.Machine.Type = "Standard_B2s"
+		infra.Networks.VNet.Encryption.Enabled = false
+
+		Expect(ValidateVNetEncryption(worker, infra, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ValidatePrePullImages", func() {
+	var (
+		fldPath      *field.Path
+		worker       core.Worker
+		workerConfig *apisazure.WorkerConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Name: "gpu", Machine: core.Machine{Image: &core.ShootMachineImage{Name: "gardenlinux"}}}
+		workerConfig = &apisazure.WorkerConfig{PrePullImages: []string{"nvcr.io/nvidia/pytorch:24.01-py3"}}
+	})
+
+	It("should allow pre-pulling images if the user data is a shell script", func() {
+		Expect(ValidatePrePullImages(workerConfig, worker, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a machine image with another user data if no images are pre-pulled", func() {
+		worker.Machine.Image.Name = "flatcar"
+		workerConfig.PrePullImages = nil
+
+		Expect(ValidatePrePullImages(workerConfig, worker, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid pre-pulling images if the user data is not a shell script", func() {
+		worker.Machine.Image.Name = "flatcar"
+
+		Expect(ValidatePrePullImages(workerConfig, worker, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.prePullImages"),
+				"Detail": Equal(`images cannot be pre-pulled for machine image "flatcar", because its user data is not a shell script`),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateOSDiskCaching", func() {
+	var (
+		fldPath *field.Path
+		worker  core.Worker
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Name: "database", Volume: &core.Volume{Type: ptr.To("Premium_LRS")}}
+	})
+
+	DescribeTable("should allow caching modes supported by the disk type",
+		func(diskType, caching string) {
+			worker.Volume.Type = ptr.To(diskType)
+			workerConfig := &apisazure.WorkerConfig{Volume: &apisazure.Volume{Caching: ptr.To(caching)}}
+
+			Expect(ValidateOSDiskCaching(workerConfig, worker, fldPath)).To(BeEmpty())
+		},
+		Entry("Premium_LRS with ReadWrite", "Premium_LRS", "ReadWrite"),
+		Entry("Premium_LRS with ReadOnly", "Premium_LRS", "ReadOnly"),
+		Entry("StandardSSD_LRS with None", "StandardSSD_LRS", "None"),
+		Entry("UltraSSD_LRS with None", "UltraSSD_LRS", "None"),
+		Entry("PremiumV2_LRS with None", "PremiumV2_LRS", "None"),
+	)
+
+	DescribeTable("should forbid host caching for disk types which do not support it",
+		func(diskType, caching string) {
+			worker.Volume.Type = ptr.To(diskType)
+			workerConfig := &apisazure.WorkerConfig{Volume: &apisazure.Volume{Caching: ptr.To(caching)}}
+
+			Expect(ValidateOSDiskCaching(workerConfig, worker, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("providerConfig.volume.caching"),
+					"Detail": Equal(fmt.Sprintf(`disk type %q of the OS disk does not support host caching, the caching must be "None"`, diskType)),
+				})),
+			))
+		},
+		Entry("UltraSSD_LRS with ReadOnly", "UltraSSD_LRS", "ReadOnly"),
+		Entry("UltraSSD_LRS with ReadWrite", "UltraSSD_LRS", "ReadWrite"),
+		Entry("PremiumV2_LRS with ReadWrite", "PremiumV2_LRS", "ReadWrite"),
+	)
+
+	It("should allow a caching mode if the disk type is not set", func() {
+		worker.Volume = nil
+		workerConfig := &apisazure.WorkerConfig{Volume: &apisazure.Volume{Caching: ptr.To("ReadWrite")}}
+
+		Expect(ValidateOSDiskCaching(workerConfig, worker, fldPath)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ValidateLicenseType", func() {
+	var (
+		fldPath            *field.Path
+		worker             core.Worker
+		cloudProfileConfig *apisazure.CloudProfileConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{
+			Machine: core.Machine{Image: &core.ShootMachineImage{Name: "rhel", Version: "9.4.0"}},
+		}
+		cloudProfileConfig = &apisazure.CloudProfileConfig{
+			MachineImages: []apisazure.MachineImages{{
+				Name: "rhel",
+				Versions: []apisazure.MachineImageVersion{
+					{Version: "9.4.0", Architecture: ptr.To("amd64"), OperatingSystem: ptr.To(apisazure.OperatingSystemRHEL)},
+					{Version: "9.4.0", Architecture: ptr.To("arm64")},
+					{Version: "9.2.0", Architecture: ptr.To("amd64")},
+				},
+			}},
+		}
+	})
+
+	It("should allow a license type matching the operating system of the image", func() {
+		workerConfig := &apisazure.WorkerConfig{LicenseType: ptr.To(apisazure.LicenseTypeRHELBYOS)}
+
+		Expect(ValidateLicenseType(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a license type of another operating system", func() {
+		workerConfig := &apisazure.WorkerConfig{LicenseType: ptr.To(apisazure.LicenseTypeSLESBYOS)}
+
+		Expect(ValidateLicenseType(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.licenseType"),
+				"Detail": Equal(`license type "SLES_BYOS" can only be applied to images with operating system "SLES", but version "9.4.0" of machine image "rhel" is not marked with it in the CloudProfile`),
+			})),
+		))
+	})
+
+	It("should forbid a license type for an image version without operating system", func() {
+		worker.Machine.Architecture = ptr.To("arm64")
+		workerConfig := &apisazure.WorkerConfig{LicenseType: ptr.To(apisazure.LicenseTypeRHELBYOS)}
+
+		Expect(ValidateLicenseType(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.licenseType"),
+			})),
+		))
+	})
+
+	It("should validate the license type against the pinned version of the image", func() {
+		workerConfig := &apisazure.WorkerConfig{LicenseType: ptr.To(apisazure.LicenseTypeRHELBYOS), MachineImageVersion: ptr.To("9.2.0")}
+
+		Expect(ValidateLicenseType(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.licenseType"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateRollingUpdate", func() {
+	var (
+		fldPath *field.Path
+		worker  core.Worker
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Name: "worker", MaxSurge: ptr.To(intstr.FromInt32(1)), MaxUnavailable: ptr.To(intstr.FromInt32(0))}
+	})
+
+	DescribeTable("should allow valid rolling updates",
+		func(maxSurge, maxUnavailable *intstr.IntOrString) {
+			workerConfig := &apisazure.WorkerConfig{RollingUpdate: &apisazure.RollingUpdate{MaxSurge: maxSurge, MaxUnavailable: maxUnavailable}}
+
+			Expect(ValidateRollingUpdate(workerConfig, worker, fldPath)).To(BeEmpty())
+		},
+		Entry("numbers", ptr.To(intstr.FromInt32(5)), ptr.To(intstr.FromInt32(2))),
+		Entry("percentages", ptr.To(intstr.FromString("150%")), ptr.To(intstr.FromString("100%"))),
+		Entry("only the maximum unavailability", nil, ptr.To(intstr.FromString("10%"))),
+		Entry("zero maximum unavailability with the maximum surge of the worker pool", nil, ptr.To(intstr.FromInt32(0))),
+	)
+
+	It("should forbid that the resulting maximum surge and unavailability are both zero", func() {
+		workerConfig := &apisazure.WorkerConfig{RollingUpdate: &apisazure.RollingUpdate{MaxSurge: ptr.To(intstr.FromString("0%"))}}
+
+		Expect(ValidateRollingUpdate(workerConfig, worker, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.rollingUpdate"),
+				"Detail": Equal("maxSurge and maxUnavailable of the worker pool must not both be 0"),
+			})),
+		))
+	})
+
+	It("should forbid invalid values", func() {
+		workerConfig := &apisazure.WorkerConfig{RollingUpdate: &apisazure.RollingUpdate{
+			MaxSurge:       ptr.To(intstr.FromInt32(-1)),
+			MaxUnavailable: ptr.To(intstr.FromString("120%")),
+		}}
+
+		Expect(ValidateRollingUpdate(workerConfig, worker, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.rollingUpdate.maxSurge"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.rollingUpdate.maxUnavailable"),
+				"Detail": Equal("must not be greater than 100%"),
+			})),
+		))
+	})
+
+	It("should forbid values which are no numbers or percentages", func() {
+		workerConfig := &apisazure.WorkerConfig{RollingUpdate: &apisazure.RollingUpdate{MaxSurge: ptr.To(intstr.FromString("two"))}}
+
+		Expect(ValidateRollingUpdate(workerConfig, worker, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.rollingUpdate.maxSurge"),
+			})),
+		))
+	})
+
+	It("should forbid a rolling update for worker pools which are updated in-place", func() {
+		worker.UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+		workerConfig := &apisazure.WorkerConfig{RollingUpdate: &apisazure.RollingUpdate{MaxSurge: ptr.To(intstr.FromInt32(2))}}
+
+		Expect(ValidateRollingUpdate(workerConfig, worker, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.rollingUpdate"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateMachineImageVersion", func() {
+	var (
+		fldPath            *field.Path
+		worker             core.Worker
+		workerConfig       *apisazure.WorkerConfig
+		cloudProfileSpec   *gardencorev1beta1.CloudProfileSpec
+		cloudProfileConfig *apisazure.CloudProfileConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{
+			Name:    "worker",
+			Machine: core.Machine{Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.2.0"}},
+		}
+		workerConfig = &apisazure.WorkerConfig{MachineImageVersion: ptr.To("1.1.0")}
+
+		expired := metav1.NewTime(time.Now().Add(-time.Hour))
+		cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+			MachineImages: []gardencorev1beta1.MachineImage{{
+				Name: "gardenlinux",
+				Versions: []gardencorev1beta1.MachineImageVersion{
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.2.0"}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0", Classification: ptr.To(gardencorev1beta1.ClassificationDeprecated)}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &expired}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.3.0", Classification: ptr.To(gardencorev1beta1.ClassificationUnavailable)}},
+				},
+			}},
+		}
+		cloudProfileConfig = &apisazure.CloudProfileConfig{
+			MachineImages: []apisazure.MachineImages{{
+				Name: "gardenlinux",
+				Versions: []apisazure.MachineImageVersion{
+					{Version: "1.2.0", URN: ptr.To("sap:gardenlinux:greatest:1.2.0"), Architecture: ptr.To("amd64")},
+					{Version: "1.1.0", URN: ptr.To("sap:gardenlinux:greatest:1.1.0"), Architecture: ptr.To("amd64")},
+					{Version: "1.0.0", URN: ptr.To("sap:gardenlinux:greatest:1.0.0"), Architecture: ptr.To("amd64")},
+					{Version: "1.3.0", URN: ptr.To("sap:gardenlinux:greatest:1.3.0"), Architecture: ptr.To("amd64")},
+				},
+			}},
+		}
+	})
+
+	It("should allow to pin a valid version", func() {
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow worker pools without a pinned version", func() {
+		Expect(ValidateMachineImageVersion(&apisazure.WorkerConfig{}, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid to pin an expired version", func() {
+		workerConfig.MachineImageVersion = ptr.To("1.0.0")
+
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.machineImageVersion"),
+				"Detail": Equal(`version "1.0.0" of machine image "gardenlinux" is expired`),
+			})),
+		))
+	})
+
+	It("should allow to keep an expired version which was already pinned", func() {
+		workerConfig.MachineImageVersion = ptr.To("1.0.0")
+
+		Expect(ValidateMachineImageVersion(workerConfig, &apisazure.WorkerConfig{MachineImageVersion: ptr.To("1.0.0")}, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid to pin an unavailable version", func() {
+		workerConfig.MachineImageVersion = ptr.To("1.3.0")
+
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("providerConfig.machineImageVersion"),
+				"Detail": Equal(`version "1.3.0" of machine image "gardenlinux" is classified as unavailable`),
+			})),
+		))
+	})
+
+	It("should reject a nonexistent version and list the active versions", func() {
+		workerConfig.MachineImageVersion = ptr.To("0.9.0")
+
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeNotSupported),
+				"Field":    Equal("providerConfig.machineImageVersion"),
+				"BadValue": Equal("0.9.0"),
+				"Detail":   And(ContainSubstring(`"1.1.0"`), ContainSubstring(`"1.2.0"`), Not(ContainSubstring(`"1.0.0"`)), Not(ContainSubstring(`"1.3.0"`))),
+			})),
+		))
+	})
+
+	It("should reject a version without an image in the providerConfig of the CloudProfile", func() {
+		cloudProfileConfig.MachineImages[0].Versions = cloudProfileConfig.MachineImages[0].Versions[:1]
+
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.machineImageVersion"),
+			})),
+		))
+	})
+
+	It("should reject an empty version", func() {
+		workerConfig.MachineImageVersion = ptr.To("")
+
+		Expect(ValidateMachineImageVersion(workerConfig, nil, worker, cloudProfileSpec, cloudProfileConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.machineImageVersion"),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateMachineImageExpiration", func() {
+	var (
+		fldPath          *field.Path
+		worker           core.Worker
+		cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		expirationDate   metav1.Time
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("workers").Index(0)
+		worker = core.Worker{
+			Name:    "worker",
+			Machine: core.Machine{Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.2.0"}},
+		}
+
+		expired := metav1.NewTime(time.Now().Add(-time.Hour))
+		expirationDate = metav1.NewTime(time.Now().Add(10 * 24 * time.Hour))
+		cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+			MachineImages: []gardencorev1beta1.MachineImage{{
+				Name: "gardenlinux",
+				Versions: []gardencorev1beta1.MachineImageVersion{
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.2.0"}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0", ExpirationDate: &expirationDate}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", Classification: ptr.To(gardencorev1beta1.ClassificationDeprecated), ExpirationDate: &expirationDate}},
+					{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "0.9.0", ExpirationDate: &expired}},
+				},
+			}},
+		}
+	})
+
+	It("should allow a supported version without an expiration date", func() {
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, nil, cloudProfileSpec, 30*24*time.Hour, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a version which expires after the window", func() {
+		worker.Machine.Image.Version = "1.1.0"
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, nil, cloudProfileSpec, 7*24*time.Hour, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid a version which expires within the window", func() {
+		worker.Machine.Image.Version = "1.1.0"
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, nil, cloudProfileSpec, 30*24*time.Hour, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("workers[0].machine.image.version"),
+				"Detail": Equal(fmt.Sprintf(`version "1.1.0" of machine image "gardenlinux" of worker pool "worker" expires on %s, which is within 720h0m0s`, expirationDate.UTC().Format(time.RFC3339))),
+			})),
+		))
+	})
+
+	It("should forbid a deprecated version", func() {
+		worker.Machine.Image.Version = "1.0.0"
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, nil, cloudProfileSpec, 0, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("workers[0].machine.image.version"),
+				"Detail": Equal(fmt.Sprintf(`version "1.0.0" of machine image "gardenlinux" of worker pool "worker" is classified as deprecated and expires on %s`, expirationDate.UTC().Format(time.RFC3339))),
+			})),
+		))
+	})
+
+	It("should forbid an expired version which is pinned by the WorkerConfig", func() {
+		workerConfig := &apisazure.WorkerConfig{MachineImageVersion: ptr.To("0.9.0")}
+
+		Expect(ValidateMachineImageExpiration(workerConfig, nil, worker, nil, cloudProfileSpec, 0, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("workers[0].providerConfig.machineImageVersion"),
+				"Detail": ContainSubstring(`version "0.9.0" of machine image "gardenlinux" of worker pool "worker" expired on`),
+			})),
+		))
+	})
+
+	It("should allow to keep a version which the old worker pool already used", func() {
+		worker.Machine.Image.Version = "1.0.0"
+		oldWorker := worker.DeepCopy()
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, oldWorker, cloudProfileSpec, 30*24*time.Hour, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid to switch the old worker pool to a deprecated version", func() {
+		oldWorker := worker.DeepCopy()
+		worker.Machine.Image.Version = "1.0.0"
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, oldWorker, cloudProfileSpec, 0, fldPath)).To(HaveLen(1))
+	})
+
+	It("should ignore versions which are not offered by the CloudProfile", func() {
+		worker.Machine.Image.Version = "2.0.0"
+
+		Expect(ValidateMachineImageExpiration(nil, nil, worker, nil, cloudProfileSpec, 30*24*time.Hour, fldPath)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ValidateSubnetName", func() {
+	var (
+		fldPath *field.Path
+		worker  core.Worker
+		infra   *apisazure.InfrastructureConfig
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Name: "backend", Zones: []string{"1", "2"}}
+		infra = &apisazure.InfrastructureConfig{
+			Zoned: true,
+			Networks: apisazure.NetworkConfig{
+				Zones: []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}, {Name: 2, CIDR: "10.250.1.0/24"}},
+				AdditionalSubnets: []apisazure.AdditionalSubnet{
+					{Name: "frontend", CIDR: "10.250.2.0/24"},
+					{Name: "backend-z1", CIDR: "10.250.3.0/24", Zone: ptr.To[int32](1)},
+				},
+			},
+		}
+	})
+
+	It("should allow placing the worker pool in the subnets of its zones", func() {
+		Expect(ValidateSubnetName(&apisazure.WorkerConfig{}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow an additional subnet spanning all zones", func() {
+		Expect(ValidateSubnetName(&apisazure.WorkerConfig{SubnetName: ptr.To("frontend")}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow an additional subnet of the zone of the worker pool", func() {
+		worker.Zones = []string{"1"}
+
+		Expect(ValidateSubnetName(&apisazure.WorkerConfig{SubnetName: ptr.To("backend-z1")}, worker, infra, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an unknown subnet", func() {
+		Expect(ValidateSubnetName(&apisazure.WorkerConfig{SubnetName: ptr.To("database")}, worker, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("providerConfig.subnetName"),
+			})),
+		))
+	})
+
+	It("should forbid an additional subnet of another zone than the worker pool", func() {
+		Expect(ValidateSubnetName(&apisazure.WorkerConfig{SubnetName: ptr.To("backend-z1")}, worker, infra, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.subnetName"),
+				"Detail": Equal(`subnet belongs to zone "1", but worker pool "backend" also uses zone "2"`),
+			})),
+		))
+	})
+})
+
+var _ = Describe("ValidateFallbackZones", func() {
+	var (
+		fldPath          *field.Path
+		worker           core.Worker
+		infra            *apisazure.InfrastructureConfig
+		cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+	)
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("providerConfig")
+		worker = core.Worker{Name: "backend", Zones: []string{"1"}}
+		infra = &apisazure.InfrastructureConfig{
+			Zoned: true,
+			Networks: apisazure.NetworkConfig{
+				Zones: []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}, {Name: 2, CIDR: "10.250.1.0/24"}},
+				AdditionalSubnets: []apisazure.AdditionalSubnet{
+					{Name: "frontend", CIDR: "10.250.2.0/24"},
+					{Name: "backend-z1", CIDR: "10.250.3.0/24", Zone: ptr.To[int32](1)},
+				},
+			},
+		}
+		cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+			Regions: []gardencorev1beta1.Region{{Name: "westeurope", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "1"}, {Name: "2"}, {Name: "3"}}}},
+		}
+	})
+
+	It("should allow fallback zones with a subnet in the infrastructure", func() {
+		Expect(ValidateFallbackZones(&apisazure.WorkerConfig{FallbackZones: []string{"2"}}, worker, infra, "westeurope", cloudProfileSpec, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow any zone of the region with an additional subnet spanning all zones", func() {
+		workerConfig := &apisazure.WorkerConfig{SubnetName: ptr.To("frontend"), FallbackZones: []string{"3", "2"}}
+
+		Expect(ValidateFallbackZones(workerConfig, worker, infra, "westeurope", cloudProfileSpec, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid fallback zones for worker pools without zones", func() {
+		worker.Zones = nil
+
+		Expect(ValidateFallbackZones(&apisazure.WorkerConfig{FallbackZones: []string{"2"}}, worker, infra, "westeurope", cloudProfileSpec, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.fallbackZones"),
+			})),
+		))
+	})
+
+	It("should forbid fallback zones with an additional subnet of a single zone", func() {
+		workerConfig := &apisazure.WorkerConfig{SubnetName: ptr.To("backend-z1"), FallbackZones: []string{"2"}}
+
+		Expect(ValidateFallbackZones(workerConfig, worker, infra, "westeurope", cloudProfileSpec, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.fallbackZones"),
+			})),
+		))
+	})
+
+	It("should forbid invalid fallback zones", func() {
+		workerConfig := &apisazure.WorkerConfig{FallbackZones: []string{"1", "2", "2", "3", "4"}}
+
+		Expect(ValidateFallbackZones(workerConfig, worker, infra, "westeurope", cloudProfileSpec, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.fallbackZones[0]"),
+				"Detail": Equal("zone is already used by the worker pool"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.fallbackZones[2]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.fallbackZones[3]"),
+				"Detail": Equal("the infrastructure has no subnet for the zone"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.fallbackZones[4]"),
+			})),
+		))
+	})
 })
@@ -32,6 +32,150 @@ type ControllerConfiguration struct {
 	// Default: nil
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Infrastructure is the configuration for the infrastructure controller.
+	// +optional
+	Infrastructure *InfrastructureController `json:"infrastructure,omitempty"`
+	// Worker is the configuration for the worker controller.
+	// +optional
+	Worker *WorkerController `json:"worker,omitempty"`
+	// BackupEntry is the configuration for the backupentry controller.
+	// +optional
+	BackupEntry *BackupEntryController `json:"backupEntry,omitempty"`
+	// MaxConcurrentReconciles overrides the maximum number of concurrent reconciliations per controller. The keys are
+	// the controller names, i.e. "backupentry", "controlplane", "dnsrecord", "infrastructure" and "worker".
+	// +optional
+	MaxConcurrentReconciles map[string]int `json:"maxConcurrentReconciles,omitempty"`
+	// AzureClient is the configuration for the clients used for requests to the Azure API.
+	// +optional
+	AzureClient *AzureClient `json:"azureClient,omitempty"`
+	// QuotaMetrics enables the metrics of the Azure quota usages of the subscriptions and regions of the Shoots.
+	// +optional
+	QuotaMetrics *QuotaMetrics `json:"quotaMetrics,omitempty"`
+	// ResourceSKUs is the configuration for the virtual machine SKUs which are read from the resource SKUs API.
+	// +optional
+	ResourceSKUs *ResourceSKUs `json:"resourceSKUs,omitempty"`
+}
+
+// ResourceSKUs is the configuration for the virtual machine SKUs which are read from the resource SKUs API.
+type ResourceSKUs struct {
+	// CacheTTL is the duration for which the virtual machine SKUs of a subscription and region are cached. It must be at
+	// least 1m and defaults to 1h.
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+	// TolerateUnavailability uses the last known virtual machine SKUs, or none, if the resource SKUs API is unavailable,
+	// instead of failing the checks which depend on them. Defaults to true.
+	// +optional
+	TolerateUnavailability *bool `json:"tolerateUnavailability,omitempty"`
+}
+
+// QuotaMetrics is the configuration for the metrics of the Azure quota usages.
+type QuotaMetrics struct {
+	// RefreshInterval is the interval in which the quota usages are refreshed.
+	RefreshInterval metav1.Duration `json:"refreshInterval"`
+}
+
+// AzureClient is the configuration for the clients used for requests to the Azure API.
+type AzureClient struct {
+	// MaxConcurrentRequestsPerSubscription is the maximum number of requests which are sent concurrently to the Azure
+	// API for a single subscription, independent of the number of concurrent reconciliations.
+	// +optional
+	MaxConcurrentRequestsPerSubscription *int `json:"maxConcurrentRequestsPerSubscription,omitempty"`
+	// PollInterval is the interval in which the state of long-running operations, e.g. the creation of NAT gateways or
+	// virtual networks, is polled. It must be at least 1s and defaults to 30s.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// PollTimeout is the duration after which the polling of a long-running operation is aborted. Polling is only
+	// bounded by the timeout of the task of the operation if it is not set.
+	// +optional
+	PollTimeout *metav1.Duration `json:"pollTimeout,omitempty"`
+	// Proxy is the HTTP proxy through which the requests to the Azure API are sent. The proxy of the HTTPS_PROXY,
+	// HTTP_PROXY and NO_PROXY environment variables is used if it is not set.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+	// CACertificatesFile is the path to a file with PEM encoded CA certificates which are trusted for the requests to the
+	// Azure API in addition to the CAs of the system, e.g. those of the private CA of an Azure Stack Hub.
+	// +optional
+	CACertificatesFile *string `json:"caCertificatesFile,omitempty"`
+	// AzureStackHub is the Azure Stack Hub to which the clients connect for the cloud configuration name "AzureStackHub".
+	// +optional
+	AzureStackHub *AzureStackHub `json:"azureStackHub,omitempty"`
+}
+
+// AzureStackHub is the configuration of an Azure Stack Hub.
+type AzureStackHub struct {
+	// ResourceManagerEndpoint is the Azure Resource Manager endpoint of the Azure Stack Hub, e.g.
+	// https://management.local.azurestack.external. The other endpoints are read from its metadata endpoint on startup.
+	ResourceManagerEndpoint string `json:"resourceManagerEndpoint"`
+}
+
+// Proxy is the configuration for the HTTP proxy of the requests to the Azure API.
+type Proxy struct {
+	// URL is the URL of the proxy, e.g. http://proxy.example.com:3128. Credentials for the proxy can be given as user
+	// info of the URL.
+	URL string `json:"url"`
+	// NoProxy is a list of hosts, domains with a leading dot, and CIDRs to which the requests are sent directly.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
+// InfrastructureController is the configuration for the infrastructure controller.
+type InfrastructureController struct {
+	// TaskTimeouts overrides the default timeouts of single tasks of the infrastructure flows. The keys are the task
+	// names, e.g. "ensure nats".
+	// +optional
+	TaskTimeouts map[string]metav1.Duration `json:"taskTimeouts,omitempty"`
+	// CheckpointTTL enables checkpoints of the infrastructure reconciliation. If a reconciliation fails, the next one
+	// skips the tasks which completed within this duration, as long as the infrastructure was not changed meanwhile.
+	// +optional
+	CheckpointTTL *metav1.Duration `json:"checkpointTTL,omitempty"`
+	// EgressReport enables ConfigMaps which report the egress CIDRs and the IDs of the main Azure resources of the
+	// infrastructures.
+	// +optional
+	EgressReport *EgressReport `json:"egressReport,omitempty"`
+}
+
+// EgressReport is the configuration for the ConfigMaps which report the egress CIDRs and the IDs of the main Azure
+// resources of the infrastructures.
+type EgressReport struct {
+	// Namespace is the namespace of the ConfigMaps. If it is empty, the ConfigMap of an infrastructure is written into
+	// the namespace of the infrastructure. Otherwise, the names of the ConfigMaps are prefixed with the namespaces of
+	// their infrastructures.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the ConfigMaps. It defaults to "egress-report".
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// BackupEntryController is the configuration for the backupentry controller.
+type BackupEntryController struct {
+	// NotificationWebhook enables notifications about the creation, the deletion and the failures of BackupEntries, which
+	// are sent to a webhook in addition to the events of the BackupEntries.
+	// +optional
+	NotificationWebhook *NotificationWebhook `json:"notificationWebhook,omitempty"`
+}
+
+// NotificationWebhook is the configuration for the webhook which receives notifications about BackupEntries.
+type NotificationWebhook struct {
+	// URL is the HTTPS URL to which the notifications are posted as JSON.
+	URL string `json:"url"`
+}
+
+// WorkerController is the configuration for the worker controller.
+type WorkerController struct {
+	// MarketplaceAgreements enables the verification of the agreements of the marketplace plans of the machine images
+	// before machines are created.
+	// +optional
+	MarketplaceAgreements *MarketplaceAgreements `json:"marketplaceAgreements,omitempty"`
+}
+
+// MarketplaceAgreements is the configuration for the verification of the agreements of the marketplace plans of the
+// machine images.
+type MarketplaceAgreements struct {
+	// Accept enables the acceptance of the agreements which have not been accepted yet in the subscriptions of the
+	// Shoots. Otherwise, the reconciliation of a Worker fails until the agreement of its machine images is accepted.
+	// +optional
+	Accept bool `json:"accept,omitempty"`
 }
 
 // ETCD is an etcd configuration.
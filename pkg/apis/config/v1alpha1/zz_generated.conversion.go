@@ -15,6 +15,7 @@ import (
 	config "github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -27,6 +28,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AzureClient)(nil), (*config.AzureClient)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AzureClient_To_config_AzureClient(a.(*AzureClient), b.(*config.AzureClient), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AzureClient)(nil), (*AzureClient)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AzureClient_To_v1alpha1_AzureClient(a.(*config.AzureClient), b.(*AzureClient), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzureStackHub)(nil), (*config.AzureStackHub)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AzureStackHub_To_config_AzureStackHub(a.(*AzureStackHub), b.(*config.AzureStackHub), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AzureStackHub)(nil), (*AzureStackHub)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AzureStackHub_To_v1alpha1_AzureStackHub(a.(*config.AzureStackHub), b.(*AzureStackHub), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupEntryController)(nil), (*config.BackupEntryController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupEntryController_To_config_BackupEntryController(a.(*BackupEntryController), b.(*config.BackupEntryController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BackupEntryController)(nil), (*BackupEntryController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BackupEntryController_To_v1alpha1_BackupEntryController(a.(*config.BackupEntryController), b.(*BackupEntryController), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -67,9 +98,159 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressReport)(nil), (*config.EgressReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressReport_To_config_EgressReport(a.(*EgressReport), b.(*config.EgressReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EgressReport)(nil), (*EgressReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EgressReport_To_v1alpha1_EgressReport(a.(*config.EgressReport), b.(*EgressReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InfrastructureController)(nil), (*config.InfrastructureController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureController_To_config_InfrastructureController(a.(*InfrastructureController), b.(*config.InfrastructureController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InfrastructureController)(nil), (*InfrastructureController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InfrastructureController_To_v1alpha1_InfrastructureController(a.(*config.InfrastructureController), b.(*InfrastructureController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MarketplaceAgreements)(nil), (*config.MarketplaceAgreements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MarketplaceAgreements_To_config_MarketplaceAgreements(a.(*MarketplaceAgreements), b.(*config.MarketplaceAgreements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MarketplaceAgreements)(nil), (*MarketplaceAgreements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MarketplaceAgreements_To_v1alpha1_MarketplaceAgreements(a.(*config.MarketplaceAgreements), b.(*MarketplaceAgreements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationWebhook)(nil), (*config.NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationWebhook_To_config_NotificationWebhook(a.(*NotificationWebhook), b.(*config.NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NotificationWebhook)(nil), (*NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NotificationWebhook_To_v1alpha1_NotificationWebhook(a.(*config.NotificationWebhook), b.(*NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Proxy)(nil), (*config.Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Proxy_To_config_Proxy(a.(*Proxy), b.(*config.Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Proxy)(nil), (*Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Proxy_To_v1alpha1_Proxy(a.(*config.Proxy), b.(*Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaMetrics)(nil), (*config.QuotaMetrics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_QuotaMetrics_To_config_QuotaMetrics(a.(*QuotaMetrics), b.(*config.QuotaMetrics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.QuotaMetrics)(nil), (*QuotaMetrics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_QuotaMetrics_To_v1alpha1_QuotaMetrics(a.(*config.QuotaMetrics), b.(*QuotaMetrics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceSKUs)(nil), (*config.ResourceSKUs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceSKUs_To_config_ResourceSKUs(a.(*ResourceSKUs), b.(*config.ResourceSKUs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ResourceSKUs)(nil), (*ResourceSKUs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ResourceSKUs_To_v1alpha1_ResourceSKUs(a.(*config.ResourceSKUs), b.(*ResourceSKUs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerController)(nil), (*config.WorkerController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerController_To_config_WorkerController(a.(*WorkerController), b.(*config.WorkerController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.WorkerController)(nil), (*WorkerController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_WorkerController_To_v1alpha1_WorkerController(a.(*config.WorkerController), b.(*WorkerController), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AzureClient_To_config_AzureClient(in *AzureClient, out *config.AzureClient, s conversion.Scope) error {
+	out.MaxConcurrentRequestsPerSubscription = (*int)(unsafe.Pointer(in.MaxConcurrentRequestsPerSubscription))
+	out.PollInterval = (*v1.Duration)(unsafe.Pointer(in.PollInterval))
+	out.PollTimeout = (*v1.Duration)(unsafe.Pointer(in.PollTimeout))
+	out.Proxy = (*config.Proxy)(unsafe.Pointer(in.Proxy))
+	out.CACertificatesFile = (*string)(unsafe.Pointer(in.CACertificatesFile))
+	out.AzureStackHub = (*config.AzureStackHub)(unsafe.Pointer(in.AzureStackHub))
+	return nil
+}
+
+// Convert_v1alpha1_AzureClient_To_config_AzureClient is an autogenerated conversion function.
+func Convert_v1alpha1_AzureClient_To_config_AzureClient(in *AzureClient, out *config.AzureClient, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureClient_To_config_AzureClient(in, out, s)
+}
+
+func autoConvert_config_AzureClient_To_v1alpha1_AzureClient(in *config.AzureClient, out *AzureClient, s conversion.Scope) error {
+	out.MaxConcurrentRequestsPerSubscription = (*int)(unsafe.Pointer(in.MaxConcurrentRequestsPerSubscription))
+	out.PollInterval = (*v1.Duration)(unsafe.Pointer(in.PollInterval))
+	out.PollTimeout = (*v1.Duration)(unsafe.Pointer(in.PollTimeout))
+	out.Proxy = (*Proxy)(unsafe.Pointer(in.Proxy))
+	out.CACertificatesFile = (*string)(unsafe.Pointer(in.CACertificatesFile))
+	out.AzureStackHub = (*AzureStackHub)(unsafe.Pointer(in.AzureStackHub))
+	return nil
+}
+
+// Convert_config_AzureClient_To_v1alpha1_AzureClient is an autogenerated conversion function.
+func Convert_config_AzureClient_To_v1alpha1_AzureClient(in *config.AzureClient, out *AzureClient, s conversion.Scope) error {
+	return autoConvert_config_AzureClient_To_v1alpha1_AzureClient(in, out, s)
+}
+
+func autoConvert_v1alpha1_AzureStackHub_To_config_AzureStackHub(in *AzureStackHub, out *config.AzureStackHub, s conversion.Scope) error {
+	out.ResourceManagerEndpoint = in.ResourceManagerEndpoint
+	return nil
+}
+
+// Convert_v1alpha1_AzureStackHub_To_config_AzureStackHub is an autogenerated conversion function.
+func Convert_v1alpha1_AzureStackHub_To_config_AzureStackHub(in *AzureStackHub, out *config.AzureStackHub, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AzureStackHub_To_config_AzureStackHub(in, out, s)
+}
+
+func autoConvert_config_AzureStackHub_To_v1alpha1_AzureStackHub(in *config.AzureStackHub, out *AzureStackHub, s conversion.Scope) error {
+	out.ResourceManagerEndpoint = in.ResourceManagerEndpoint
+	return nil
+}
+
+// Convert_config_AzureStackHub_To_v1alpha1_AzureStackHub is an autogenerated conversion function.
+func Convert_config_AzureStackHub_To_v1alpha1_AzureStackHub(in *config.AzureStackHub, out *AzureStackHub, s conversion.Scope) error {
+	return autoConvert_config_AzureStackHub_To_v1alpha1_AzureStackHub(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupEntryController_To_config_BackupEntryController(in *BackupEntryController, out *config.BackupEntryController, s conversion.Scope) error {
+	out.NotificationWebhook = (*config.NotificationWebhook)(unsafe.Pointer(in.NotificationWebhook))
+	return nil
+}
+
+// Convert_v1alpha1_BackupEntryController_To_config_BackupEntryController is an autogenerated conversion function.
+func Convert_v1alpha1_BackupEntryController_To_config_BackupEntryController(in *BackupEntryController, out *config.BackupEntryController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupEntryController_To_config_BackupEntryController(in, out, s)
+}
+
+func autoConvert_config_BackupEntryController_To_v1alpha1_BackupEntryController(in *config.BackupEntryController, out *BackupEntryController, s conversion.Scope) error {
+	out.NotificationWebhook = (*NotificationWebhook)(unsafe.Pointer(in.NotificationWebhook))
 	return nil
 }
 
+// Convert_config_BackupEntryController_To_v1alpha1_BackupEntryController is an autogenerated conversion function.
+func Convert_config_BackupEntryController_To_v1alpha1_BackupEntryController(in *config.BackupEntryController, out *BackupEntryController, s conversion.Scope) error {
+	return autoConvert_config_BackupEntryController_To_v1alpha1_BackupEntryController(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -77,6 +258,13 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Infrastructure = (*config.InfrastructureController)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*config.WorkerController)(unsafe.Pointer(in.Worker))
+	out.BackupEntry = (*config.BackupEntryController)(unsafe.Pointer(in.BackupEntry))
+	out.MaxConcurrentReconciles = *(*map[string]int)(unsafe.Pointer(&in.MaxConcurrentReconciles))
+	out.AzureClient = (*config.AzureClient)(unsafe.Pointer(in.AzureClient))
+	out.QuotaMetrics = (*config.QuotaMetrics)(unsafe.Pointer(in.QuotaMetrics))
+	out.ResourceSKUs = (*config.ResourceSKUs)(unsafe.Pointer(in.ResourceSKUs))
 	return nil
 }
 
@@ -92,6 +280,13 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Infrastructure = (*InfrastructureController)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*WorkerController)(unsafe.Pointer(in.Worker))
+	out.BackupEntry = (*BackupEntryController)(unsafe.Pointer(in.BackupEntry))
+	out.MaxConcurrentReconciles = *(*map[string]int)(unsafe.Pointer(&in.MaxConcurrentReconciles))
+	out.AzureClient = (*AzureClient)(unsafe.Pointer(in.AzureClient))
+	out.QuotaMetrics = (*QuotaMetrics)(unsafe.Pointer(in.QuotaMetrics))
+	out.ResourceSKUs = (*ResourceSKUs)(unsafe.Pointer(in.ResourceSKUs))
 	return nil
 }
 
@@ -171,3 +366,173 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_EgressReport_To_config_EgressReport(in *EgressReport, out *config.EgressReport, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_EgressReport_To_config_EgressReport is an autogenerated conversion function.
+func Convert_v1alpha1_EgressReport_To_config_EgressReport(in *EgressReport, out *config.EgressReport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressReport_To_config_EgressReport(in, out, s)
+}
+
+func autoConvert_config_EgressReport_To_v1alpha1_EgressReport(in *config.EgressReport, out *EgressReport, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_config_EgressReport_To_v1alpha1_EgressReport is an autogenerated conversion function.
+func Convert_config_EgressReport_To_v1alpha1_EgressReport(in *config.EgressReport, out *EgressReport, s conversion.Scope) error {
+	return autoConvert_config_EgressReport_To_v1alpha1_EgressReport(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureController_To_config_InfrastructureController(in *InfrastructureController, out *config.InfrastructureController, s conversion.Scope) error {
+	out.TaskTimeouts = *(*map[string]v1.Duration)(unsafe.Pointer(&in.TaskTimeouts))
+	out.CheckpointTTL = (*v1.Duration)(unsafe.Pointer(in.CheckpointTTL))
+	out.EgressReport = (*config.EgressReport)(unsafe.Pointer(in.EgressReport))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureController_To_config_InfrastructureController is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureController_To_config_InfrastructureController(in *InfrastructureController, out *config.InfrastructureController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureController_To_config_InfrastructureController(in, out, s)
+}
+
+func autoConvert_config_InfrastructureController_To_v1alpha1_InfrastructureController(in *config.InfrastructureController, out *InfrastructureController, s conversion.Scope) error {
+	out.TaskTimeouts = *(*map[string]v1.Duration)(unsafe.Pointer(&in.TaskTimeouts))
+	out.CheckpointTTL = (*v1.Duration)(unsafe.Pointer(in.CheckpointTTL))
+	out.EgressReport = (*EgressReport)(unsafe.Pointer(in.EgressReport))
+	return nil
+}
+
+// Convert_config_InfrastructureController_To_v1alpha1_InfrastructureController is an autogenerated conversion function.
+func Convert_config_InfrastructureController_To_v1alpha1_InfrastructureController(in *config.InfrastructureController, out *InfrastructureController, s conversion.Scope) error {
+	return autoConvert_config_InfrastructureController_To_v1alpha1_InfrastructureController(in, out, s)
+}
+
+func autoConvert_v1alpha1_MarketplaceAgreements_To_config_MarketplaceAgreements(in *MarketplaceAgreements, out *config.MarketplaceAgreements, s conversion.Scope) error {
+	out.Accept = in.Accept
+	return nil
+}
+
+// Convert_v1alpha1_MarketplaceAgreements_To_config_MarketplaceAgreements is an autogenerated conversion function.
+func Convert_v1alpha1_MarketplaceAgreements_To_config_MarketplaceAgreements(in *MarketplaceAgreements, out *config.MarketplaceAgreements, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MarketplaceAgreements_To_config_MarketplaceAgreements(in, out, s)
+}
+
+func autoConvert_config_MarketplaceAgreements_To_v1alpha1_MarketplaceAgreements(in *config.MarketplaceAgreements, out *MarketplaceAgreements, s conversion.Scope) error {
+	out.Accept = in.Accept
+	return nil
+}
+
+// Convert_config_MarketplaceAgreements_To_v1alpha1_MarketplaceAgreements is an autogenerated conversion function.
+func Convert_config_MarketplaceAgreements_To_v1alpha1_MarketplaceAgreements(in *config.MarketplaceAgreements, out *MarketplaceAgreements, s conversion.Scope) error {
+	return autoConvert_config_MarketplaceAgreements_To_v1alpha1_MarketplaceAgreements(in, out, s)
+}
+
+func autoConvert_v1alpha1_NotificationWebhook_To_config_NotificationWebhook(in *NotificationWebhook, out *config.NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1alpha1_NotificationWebhook_To_config_NotificationWebhook is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationWebhook_To_config_NotificationWebhook(in *NotificationWebhook, out *config.NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationWebhook_To_config_NotificationWebhook(in, out, s)
+}
+
+func autoConvert_config_NotificationWebhook_To_v1alpha1_NotificationWebhook(in *config.NotificationWebhook, out *NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_config_NotificationWebhook_To_v1alpha1_NotificationWebhook is an autogenerated conversion function.
+func Convert_config_NotificationWebhook_To_v1alpha1_NotificationWebhook(in *config.NotificationWebhook, out *NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_config_NotificationWebhook_To_v1alpha1_NotificationWebhook(in, out, s)
+}
+
+func autoConvert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.NoProxy = *(*[]string)(unsafe.Pointer(&in.NoProxy))
+	return nil
+}
+
+// Convert_v1alpha1_Proxy_To_config_Proxy is an autogenerated conversion function.
+func Convert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Proxy_To_config_Proxy(in, out, s)
+}
+
+func autoConvert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.NoProxy = *(*[]string)(unsafe.Pointer(&in.NoProxy))
+	return nil
+}
+
+// Convert_config_Proxy_To_v1alpha1_Proxy is an autogenerated conversion function.
+func Convert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	return autoConvert_config_Proxy_To_v1alpha1_Proxy(in, out, s)
+}
+
+func autoConvert_v1alpha1_QuotaMetrics_To_config_QuotaMetrics(in *QuotaMetrics, out *config.QuotaMetrics, s conversion.Scope) error {
+	out.RefreshInterval = in.RefreshInterval
+	return nil
+}
+
+// Convert_v1alpha1_QuotaMetrics_To_config_QuotaMetrics is an autogenerated conversion function.
+func Convert_v1alpha1_QuotaMetrics_To_config_QuotaMetrics(in *QuotaMetrics, out *config.QuotaMetrics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_QuotaMetrics_To_config_QuotaMetrics(in, out, s)
+}
+
+func autoConvert_config_QuotaMetrics_To_v1alpha1_QuotaMetrics(in *config.QuotaMetrics, out *QuotaMetrics, s conversion.Scope) error {
+	out.RefreshInterval = in.RefreshInterval
+	return nil
+}
+
+// Convert_config_QuotaMetrics_To_v1alpha1_QuotaMetrics is an autogenerated conversion function.
+func Convert_config_QuotaMetrics_To_v1alpha1_QuotaMetrics(in *config.QuotaMetrics, out *QuotaMetrics, s conversion.Scope) error {
+	return autoConvert_config_QuotaMetrics_To_v1alpha1_QuotaMetrics(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceSKUs_To_config_ResourceSKUs(in *ResourceSKUs, out *config.ResourceSKUs, s conversion.Scope) error {
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	out.TolerateUnavailability = (*bool)(unsafe.Pointer(in.TolerateUnavailability))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceSKUs_To_config_ResourceSKUs is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceSKUs_To_config_ResourceSKUs(in *ResourceSKUs, out *config.ResourceSKUs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceSKUs_To_config_ResourceSKUs(in, out, s)
+}
+
+func autoConvert_config_ResourceSKUs_To_v1alpha1_ResourceSKUs(in *config.ResourceSKUs, out *ResourceSKUs, s conversion.Scope) error {
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	out.TolerateUnavailability = (*bool)(unsafe.Pointer(in.TolerateUnavailability))
+	return nil
+}
+
+// Convert_config_ResourceSKUs_To_v1alpha1_ResourceSKUs is an autogenerated conversion function.
+func Convert_config_ResourceSKUs_To_v1alpha1_ResourceSKUs(in *config.ResourceSKUs, out *ResourceSKUs, s conversion.Scope) error {
+	return autoConvert_config_ResourceSKUs_To_v1alpha1_ResourceSKUs(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerController_To_config_WorkerController(in *WorkerController, out *config.WorkerController, s conversion.Scope) error {
+	out.MarketplaceAgreements = (*config.MarketplaceAgreements)(unsafe.Pointer(in.MarketplaceAgreements))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerController_To_config_WorkerController is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerController_To_config_WorkerController(in *WorkerController, out *config.WorkerController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerController_To_config_WorkerController(in, out, s)
+}
+
+func autoConvert_config_WorkerController_To_v1alpha1_WorkerController(in *config.WorkerController, out *WorkerController, s conversion.Scope) error {
+	out.MarketplaceAgreements = (*MarketplaceAgreements)(unsafe.Pointer(in.MarketplaceAgreements))
+	return nil
+}
+
+// Convert_config_WorkerController_To_v1alpha1_WorkerController is an autogenerated conversion function.
+func Convert_config_WorkerController_To_v1alpha1_WorkerController(in *config.WorkerController, out *WorkerController, s conversion.Scope) error {
+	return autoConvert_config_WorkerController_To_v1alpha1_WorkerController(in, out, s)
+}
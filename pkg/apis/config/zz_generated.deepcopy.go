@@ -11,10 +11,94 @@ package config
 
 import (
 	configv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	v1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureClient) DeepCopyInto(out *AzureClient) {
+	*out = *in
+	if in.MaxConcurrentRequestsPerSubscription != nil {
+		in, out := &in.MaxConcurrentRequestsPerSubscription, &out.MaxConcurrentRequestsPerSubscription
+		*out = new(int)
+		**out = **in
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PollTimeout != nil {
+		in, out := &in.PollTimeout, &out.PollTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificatesFile != nil {
+		in, out := &in.CACertificatesFile, &out.CACertificatesFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureStackHub != nil {
+		in, out := &in.AzureStackHub, &out.AzureStackHub
+		*out = new(AzureStackHub)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClient.
+func (in *AzureClient) DeepCopy() *AzureClient {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureStackHub) DeepCopyInto(out *AzureStackHub) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureStackHub.
+func (in *AzureStackHub) DeepCopy() *AzureStackHub {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureStackHub)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEntryController) DeepCopyInto(out *BackupEntryController) {
+	*out = *in
+	if in.NotificationWebhook != nil {
+		in, out := &in.NotificationWebhook, &out.NotificationWebhook
+		*out = new(NotificationWebhook)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEntryController.
+func (in *BackupEntryController) DeepCopy() *BackupEntryController {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEntryController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -37,6 +121,43 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 			(*out)[key] = val
 		}
 	}
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(InfrastructureController)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(WorkerController)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupEntry != nil {
+		in, out := &in.BackupEntry, &out.BackupEntry
+		*out = new(BackupEntryController)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrentReconciles != nil {
+		in, out := &in.MaxConcurrentReconciles, &out.MaxConcurrentReconciles
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AzureClient != nil {
+		in, out := &in.AzureClient, &out.AzureClient
+		*out = new(AzureClient)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaMetrics != nil {
+		in, out := &in.QuotaMetrics, &out.QuotaMetrics
+		*out = new(QuotaMetrics)
+		**out = **in
+	}
+	if in.ResourceSKUs != nil {
+		in, out := &in.ResourceSKUs, &out.ResourceSKUs
+		*out = new(ResourceSKUs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -122,3 +243,169 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressReport) DeepCopyInto(out *EgressReport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressReport.
+func (in *EgressReport) DeepCopy() *EgressReport {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureController) DeepCopyInto(out *InfrastructureController) {
+	*out = *in
+	if in.TaskTimeouts != nil {
+		in, out := &in.TaskTimeouts, &out.TaskTimeouts
+		*out = make(map[string]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CheckpointTTL != nil {
+		in, out := &in.CheckpointTTL, &out.CheckpointTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EgressReport != nil {
+		in, out := &in.EgressReport, &out.EgressReport
+		*out = new(EgressReport)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureController.
+func (in *InfrastructureController) DeepCopy() *InfrastructureController {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MarketplaceAgreements) DeepCopyInto(out *MarketplaceAgreements) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarketplaceAgreements.
+func (in *MarketplaceAgreements) DeepCopy() *MarketplaceAgreements {
+	if in == nil {
+		return nil
+	}
+	out := new(MarketplaceAgreements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationWebhook) DeepCopyInto(out *NotificationWebhook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationWebhook.
+func (in *NotificationWebhook) DeepCopy() *NotificationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaMetrics) DeepCopyInto(out *QuotaMetrics) {
+	*out = *in
+	out.RefreshInterval = in.RefreshInterval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaMetrics.
+func (in *QuotaMetrics) DeepCopy() *QuotaMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSKUs) DeepCopyInto(out *ResourceSKUs) {
+	*out = *in
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TolerateUnavailability != nil {
+		in, out := &in.TolerateUnavailability, &out.TolerateUnavailability
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSKUs.
+func (in *ResourceSKUs) DeepCopy() *ResourceSKUs {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSKUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerController) DeepCopyInto(out *WorkerController) {
+	*out = *in
+	if in.MarketplaceAgreements != nil {
+		in, out := &in.MarketplaceAgreements, &out.MarketplaceAgreements
+		*out = new(MarketplaceAgreements)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerController.
+func (in *WorkerController) DeepCopy() *WorkerController {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerController)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -29,24 +29,35 @@ type ClientAuth struct {
 	// ClientID is the Azure client ID.
 	ClientID string `yaml:"clientID"`
 	// ClientSecret is the Azure client secret.
-	// This field is mutually exclusive with TokenRetriever.
+	// This field is mutually exclusive with TokenRetriever and KeyVaultSecretRef.
 	ClientSecret string `yaml:"clientSecret"`
 	// TokenRetriever a function that retrieves a token used for exchanging Azure credentials.
-	// This field is mutually exclusive with ClientSecret.
+	// This field is mutually exclusive with ClientSecret and KeyVaultSecretRef.
 	TokenRetriever func(ctx context.Context) (string, error)
+	// KeyVaultSecretRef references a Key Vault secret which holds the Azure client secret.
+	// This field is mutually exclusive with ClientSecret and TokenRetriever.
+	KeyVaultSecretRef *KeyVaultSecretReference
 }
 
 // GetAzClientCredentials returns the credential struct consumed by the Azure client
 func (clientAuth ClientAuth) GetAzClientCredentials() (azcore.TokenCredential, error) {
 	if clientAuth.TokenRetriever != nil {
-		cred, err := azidentity.NewClientAssertionCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.TokenRetriever, nil)
+		cred, err := azidentity.NewClientAssertionCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.TokenRetriever, &azidentity.ClientAssertionCredentialOptions{
+			ClientOptions: DataPlaneClientOptions(),
+		})
 		if err != nil {
 			return nil, err
 		}
 		return cred, nil
 	}
 
-	return azidentity.NewClientSecretCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.ClientSecret, nil)
+	if clientAuth.KeyVaultSecretRef != nil {
+		return newKeyVaultSecretCredential(clientAuth.TenantID, clientAuth.ClientID, *clientAuth.KeyVaultSecretRef), nil
+	}
+
+	return azidentity.NewClientSecretCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+		ClientOptions: DataPlaneClientOptions(),
+	})
 }
 
 // GetClientAuthData retrieves the client auth data specified by the secret reference.
@@ -120,6 +131,27 @@ func NewClientAuthDataFromSecret(secret *corev1.Secret, allowDNSKeys bool) (*Cli
 		return nil, fmt.Errorf("secret %s/%s doesn't have a client ID", secret.Namespace, secret.Name)
 	}
 
+	if vaultURI, ok := secret.Data[azure.KeyVaultURIKey]; ok {
+		ref := &KeyVaultSecretReference{
+			VaultURI:         string(vaultURI),
+			SecretName:       string(secret.Data[azure.KeyVaultSecretNameKey]),
+			IdentityClientID: string(secret.Data[azure.KeyVaultIdentityClientIDKey]),
+		}
+		if ref.SecretName == "" {
+			return nil, fmt.Errorf("secret %s/%s doesn't have a Key Vault secret name", secret.Namespace, secret.Name)
+		}
+		if ref.IdentityClientID == "" {
+			return nil, fmt.Errorf("secret %s/%s doesn't have a client ID of the managed identity to access the Key Vault", secret.Namespace, secret.Name)
+		}
+
+		return &ClientAuth{
+			SubscriptionID:    string(subscriptionID),
+			TenantID:          string(tenantID),
+			ClientID:          string(clientID),
+			KeyVaultSecretRef: ref,
+		}, nil
+	}
+
 	clientSecret, ok := getSecretDataValue(secret, azure.ClientSecretKey, altClientSecretKey)
 	if !ok {
 		return nil, fmt.Errorf("secret %s/%s doesn't have a client secret", secret.Namespace, secret.Name)
@@ -81,6 +81,45 @@ var _ = Describe("Azure Auth", func() {
 			})
 		})
 
+		Describe("Key Vault reference", func() {
+			BeforeEach(func() {
+				secret.SetNamespace("foo")
+				secret.SetName("bar")
+				delete(secret.Data, azure.ClientSecretKey)
+				secret.Data[azure.KeyVaultURIKey] = []byte("https://my-vault.vault.azure.net")
+				secret.Data[azure.KeyVaultSecretNameKey] = []byte("client-secret")
+				secret.Data[azure.KeyVaultIdentityClientIDKey] = []byte("identity_client_id")
+			})
+
+			It("should read the Key Vault secret reference from the secret", func() {
+				actual, err := NewClientAuthDataFromSecret(secret, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.ClientID).To(Equal(clientAuth.ClientID))
+				Expect(actual.ClientSecret).To(BeEmpty())
+				Expect(actual.KeyVaultSecretRef).To(Equal(&KeyVaultSecretReference{
+					VaultURI:         "https://my-vault.vault.azure.net",
+					SecretName:       "client-secret",
+					IdentityClientID: "identity_client_id",
+				}))
+			})
+
+			It("should fail if the bootstrap identity is not configured", func() {
+				delete(secret.Data, azure.KeyVaultIdentityClientIDKey)
+
+				actual, err := NewClientAuthDataFromSecret(secret, false)
+				Expect(err).To(MatchError(ContainSubstring("secret foo/bar doesn't have a client ID of the managed identity to access the Key Vault")))
+				Expect(actual).To(BeNil())
+			})
+
+			It("should fail if the Key Vault secret name is not configured", func() {
+				delete(secret.Data, azure.KeyVaultSecretNameKey)
+
+				actual, err := NewClientAuthDataFromSecret(secret, false)
+				Expect(err).To(MatchError(ContainSubstring("secret foo/bar doesn't have a Key Vault secret name")))
+				Expect(actual).To(BeNil())
+			})
+		})
+
 		Describe("WorkloadIdentity", func() {
 			It("should read the client auth when secret is ensured", func() {
 				secret.Labels = map[string]string{
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const azureStackHubMetadataAPIVersion = "2015-01-01"
+
+var (
+	trustedCAsMutex sync.RWMutex
+	trustedCAs      *x509.CertPool
+
+	azureStackHubMutex sync.RWMutex
+	azureStackHubCloud *cloud.Configuration
+)
+
+// SetTrustedCACertificates trusts the given PEM encoded CA certificates in addition to the ones of the system for the
+// requests of the Azure clients which are created afterwards. Empty certificates reset the trusted CAs to the ones of
+// the system.
+func SetTrustedCACertificates(pemCerts []byte) error {
+	trustedCAsMutex.Lock()
+	defer trustedCAsMutex.Unlock()
+
+	if len(pemCerts) == 0 {
+		trustedCAs = nil
+		return nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return fmt.Errorf("no valid PEM encoded CA certificates found")
+	}
+	trustedCAs = pool
+	return nil
+}
+
+// trustedCACertificates returns the CAs which are trusted by the Azure clients, or nil if only the CAs of the system
+// are trusted.
+func trustedCACertificates() *x509.CertPool {
+	trustedCAsMutex.RLock()
+	defer trustedCAsMutex.RUnlock()
+
+	return trustedCAs
+}
+
+type azureStackHubMetadata struct {
+	Authentication struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
+
+// LoadAzureStackHubCloud reads the endpoints of the Azure Stack Hub with the given Azure Resource Manager endpoint,
+// e.g. https://management.local.azurestack.external, from its metadata endpoint.
+func LoadAzureStackHubCloud(ctx context.Context, resourceManagerEndpoint string) (cloud.Configuration, error) {
+	endpoint := strings.TrimSuffix(resourceManagerEndpoint, "/")
+	opts := DataPlaneClientOptions()
+	pipeline := runtime.NewPipeline("azurestackhub", "v1", runtime.PipelineOptions{}, &opts)
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(endpoint, "metadata", "endpoints"))
+	if err != nil {
+		return cloud.Configuration{}, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", azureStackHubMetadataAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return cloud.Configuration{}, fmt.Errorf("failed to read the metadata of the Azure Stack Hub %s: %w", endpoint, err)
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return cloud.Configuration{}, fmt.Errorf("failed to read the metadata of the Azure Stack Hub %s: %w", endpoint, runtime.NewResponseError(resp))
+	}
+
+	var metadata azureStackHubMetadata
+	if err := runtime.UnmarshalAsJSON(resp, &metadata); err != nil {
+		return cloud.Configuration{}, fmt.Errorf("failed to decode the metadata of the Azure Stack Hub %s: %w", endpoint, err)
+	}
+	if len(metadata.Authentication.LoginEndpoint) == 0 || len(metadata.Authentication.Audiences) == 0 {
+		return cloud.Configuration{}, fmt.Errorf("the metadata of the Azure Stack Hub %s contains no login endpoint or audience", endpoint)
+	}
+
+	return cloud.Configuration{
+		ActiveDirectoryAuthorityHost: metadata.Authentication.LoginEndpoint,
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {
+				Endpoint: endpoint,
+				Audience: metadata.Authentication.Audiences[0],
+			},
+		},
+	}, nil
+}
+
+// SetAzureStackHubCloud sets the cloud configuration which is used for the cloud configuration name "AzureStackHub".
+// A nil configuration removes it.
+func SetAzureStackHubCloud(cloudConfiguration *cloud.Configuration) {
+	azureStackHubMutex.Lock()
+	defer azureStackHubMutex.Unlock()
+
+	azureStackHubCloud = cloudConfiguration
+}
+
+func azureStackHubCloudConfiguration() (cloud.Configuration, error) {
+	azureStackHubMutex.RLock()
+	defer azureStackHubMutex.RUnlock()
+
+	if azureStackHubCloud == nil {
+		return cloud.Configuration{}, fmt.Errorf("the Azure Stack Hub is not configured in the controller configuration")
+	}
+	return *azureStackHubCloud, nil
+}
+
+// azureStackHubBlobStorageDomain returns the domain of the blob storage of the configured Azure Stack Hub, which
+// replaces the "management" label of the host of its Azure Resource Manager endpoint.
+func azureStackHubBlobStorageDomain() (string, error) {
+	cloudConfiguration, err := azureStackHubCloudConfiguration()
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := url.Parse(cloudConfiguration.Services[cloud.ResourceManager].Endpoint)
+	if err != nil {
+		return "", err
+	}
+	domain, ok := strings.CutPrefix(endpoint.Hostname(), "management.")
+	if !ok {
+		return "", fmt.Errorf("cannot derive the blob storage domain of the Azure Stack Hub from the endpoint %s", endpoint)
+	}
+	return "blob." + domain, nil
+}
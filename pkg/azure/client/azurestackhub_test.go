@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("AzureStackHub", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/metadata/endpoints" || r.URL.Query().Get("api-version") != "2015-01-01" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"authentication":{"loginEndpoint":"https://login.microsoftonline.com/","audiences":["https://management.example.onmicrosoft.com/1234"]}}`))
+		}))
+		DeferCleanup(server.Close)
+
+		Expect(SetTrustedCACertificates(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))).To(Succeed())
+		DeferCleanup(func() {
+			Expect(SetTrustedCACertificates(nil)).To(Succeed())
+			SetAzureStackHubCloud(nil)
+		})
+	})
+
+	Describe("#SetTrustedCACertificates", func() {
+		It("should configure the transport of the clients with the trusted CAs", func() {
+			for _, doer := range []interface{}{DefaultAzureClientOpts().Transport, DataPlaneClientOptions().Transport} {
+				transport := doer.(*http.Client).Transport.(*http.Transport)
+				Expect(transport.TLSClientConfig.RootCAs).NotTo(BeNil())
+			}
+		})
+
+		It("should reset the trusted CAs to the ones of the system", func() {
+			Expect(SetTrustedCACertificates(nil)).To(Succeed())
+
+			transport := DefaultAzureClientOpts().Transport.(*http.Client).Transport.(*http.Transport)
+			Expect(transport.TLSClientConfig.RootCAs).To(BeNil())
+		})
+
+		It("should fail if the certificates cannot be parsed", func() {
+			Expect(SetTrustedCACertificates([]byte("invalid"))).To(MatchError(ContainSubstring("no valid PEM encoded CA certificates found")))
+		})
+	})
+
+	Describe("#LoadAzureStackHubCloud", func() {
+		It("should read the endpoints from the metadata endpoint", func() {
+			cloudConfiguration, err := LoadAzureStackHubCloud(context.Background(), server.URL+"/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cloudConfiguration).To(Equal(cloud.Configuration{
+				ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com/",
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					cloud.ResourceManager: {
+						Endpoint: server.URL,
+						Audience: "https://management.example.onmicrosoft.com/1234",
+					},
+				},
+			}))
+		})
+
+		It("should fail if the metadata endpoint is not available", func() {
+			_, err := LoadAzureStackHubCloud(context.Background(), server.URL+"/unknown")
+			Expect(err).To(MatchError(ContainSubstring("failed to read the metadata of the Azure Stack Hub")))
+		})
+	})
+
+	Describe("#AzureCloudConfigurationFromCloudConfiguration", func() {
+		It("should return the configured Azure Stack Hub", func() {
+			stackHub := cloud.Configuration{
+				ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com/",
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					cloud.ResourceManager: {Endpoint: "https://management.local.azurestack.external"},
+				},
+			}
+			SetAzureStackHubCloud(&stackHub)
+
+			cloudConfiguration, err := AzureCloudConfigurationFromCloudConfiguration(&azure.CloudConfiguration{Name: azure.AzureStackHubCloudName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cloudConfiguration).To(Equal(stackHub))
+
+			domain, err := BlobStorageDomainFromCloudConfiguration(&azure.CloudConfiguration{Name: azure.AzureStackHubCloudName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domain).To(Equal("blob.local.azurestack.external"))
+		})
+
+		It("should fail if no Azure Stack Hub is configured", func() {
+			_, err := AzureCloudConfigurationFromCloudConfiguration(&azure.CloudConfiguration{Name: azure.AzureStackHubCloudName})
+			Expect(err).To(MatchError(ContainSubstring("the Azure Stack Hub is not configured")))
+		})
+	})
+})
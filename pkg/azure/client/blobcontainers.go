@@ -33,9 +33,25 @@ func (c *BlobContainersClient) GetContainer(ctx context.Context, resourceGroupNa
 }
 
 // CreateContainer creates a container with the name <containerName>, in the storage account <accountName>, and resource group <resourceGroupName>.
+// Public access to the container and its blobs is disallowed.
 // Returns the response and the error.
 func (c *BlobContainersClient) CreateContainer(ctx context.Context, resourceGroupName, accountName, containerName string) (armstorage.BlobContainersClientCreateResponse, error) {
-	return c.client.Create(ctx, resourceGroupName, accountName, containerName, armstorage.BlobContainer{}, nil)
+	return c.client.Create(ctx, resourceGroupName, accountName, containerName, armstorage.BlobContainer{
+		ContainerProperties: &armstorage.ContainerProperties{
+			PublicAccess: ptr.To(armstorage.PublicAccessNone),
+		},
+	}, nil)
+}
+
+// DisallowContainerPublicAccess disallows public access to the container with the name <containerName>, in the storage account <accountName>, and resource group <resourceGroupName>.
+// Returns the error.
+func (c *BlobContainersClient) DisallowContainerPublicAccess(ctx context.Context, resourceGroupName, accountName, containerName string) error {
+	_, err := c.client.Update(ctx, resourceGroupName, accountName, containerName, armstorage.BlobContainer{
+		ContainerProperties: &armstorage.ContainerProperties{
+			PublicAccess: ptr.To(armstorage.PublicAccessNone),
+		},
+	}, nil)
+	return err
 }
 
 // GetImmutabilityPolicy gets the immutability policy of the container with the name <containerName>, in the storage account <storageAccountName>, and resource group <resourceGroupName>.
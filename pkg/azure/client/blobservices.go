@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+var _ BlobServices = &BlobServicesClient{}
+
+// BlobServicesClient is a client for the blob service properties of storage accounts.
+type BlobServicesClient struct {
+	client *armstorage.BlobServicesClient
+}
+
+// NewBlobServicesClient creates a new BlobServicesClient.
+func NewBlobServicesClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*BlobServicesClient, error) {
+	client, err := armstorage.NewBlobServicesClient(auth.SubscriptionID, tc, opts)
+	return &BlobServicesClient{client: client}, err
+}
+
+// GetServiceProperties returns the blob service properties of the storage account with the name <accountName> in the
+// resource group <resourceGroupName>.
+func (c *BlobServicesClient) GetServiceProperties(ctx context.Context, resourceGroupName, accountName string) (*armstorage.BlobServicePropertiesProperties, error) {
+	response, err := c.client.GetServiceProperties(ctx, resourceGroupName, accountName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if response.BlobServiceProperties.BlobServiceProperties == nil {
+		return &armstorage.BlobServicePropertiesProperties{}, nil
+	}
+	return response.BlobServiceProperties.BlobServiceProperties, nil
+}
+
+// SetServiceProperties sets the blob service properties of the storage account with the name <accountName> in the
+// resource group <resourceGroupName>.
+func (c *BlobServicesClient) SetServiceProperties(ctx context.Context, resourceGroupName, accountName string, properties *armstorage.BlobServicePropertiesProperties) error {
+	_, err := c.client.SetServiceProperties(ctx, resourceGroupName, accountName, armstorage.BlobServiceProperties{BlobServiceProperties: properties}, nil)
+	return err
+}
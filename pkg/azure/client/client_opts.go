@@ -40,7 +40,7 @@ func init() {
 
 func getTransport() *http.Transport {
 	return &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: proxy,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
 			KeepAlive: 30 * time.Second,
@@ -52,9 +52,22 @@ func getTransport() *http.Transport {
 		TLSHandshakeTimeout: 10 * time.Second,
 		TLSClientConfig: &tls.Config{
 			MinVersion: tls.VersionTLS12,
+			RootCAs:    trustedCACertificates(),
 		},
 	}
 }
+
+// DataPlaneClientOptions returns the client options for the clients of the Azure data plane, e.g. the blob storage
+// and Key Vault, and for the credentials which authenticate against Microsoft Entra ID. They share the transport of
+// the ARM clients, so that their requests are also routed through the configured proxy.
+func DataPlaneClientOptions() policy.ClientOptions {
+	return policy.ClientOptions{
+		Transport: &http.Client{
+			Transport: getTransport(),
+		},
+	}
+}
+
 func getAzureClientOpts() *arm.ClientOptions {
 	return &arm.ClientOptions{
 		ClientOptions: policy.ClientOptions{
@@ -64,6 +77,7 @@ func getAzureClientOpts() *arm.ClientOptions {
 				MaxRetries:    DefaultMaxRetries,
 				StatusCodes:   getRetriableStatusCode(),
 			},
+			PerRetryPolicies: []policy.Policy{subscriptionConcurrencyPolicy{}},
 			Transport: &http.Client{
 				Transport: getTransport(),
 			},
@@ -5,13 +5,45 @@
 package client_test
 
 import (
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 func TestWorker(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Client Suite")
 }
+
+// recordingTransport is a transporter which records the requests and answers them with the given status code and
+// body.
+type recordingTransport struct {
+	requests   []*http.Request
+	statusCode int
+	body       string
+}
+
+func (t *recordingTransport) Do(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: t.statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+// clientOptions returns the default client options which send the requests via the given transport.
+func clientOptions(transport policy.Transporter) *arm.ClientOptions {
+	opts := DefaultAzureClientOpts()
+	opts.Transport = transport
+	return opts
+}
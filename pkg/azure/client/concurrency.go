@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// DefaultMaxConcurrentRequestsPerSubscription is the default maximum number of requests which are sent concurrently to
+// the Azure API for a single subscription.
+const DefaultMaxConcurrentRequestsPerSubscription = 50
+
+var (
+	subscriptionLimitersMutex            sync.Mutex
+	subscriptionLimiters                 = map[string]chan struct{}{}
+	maxConcurrentRequestsPerSubscription = DefaultMaxConcurrentRequestsPerSubscription
+)
+
+// SetMaxConcurrentRequestsPerSubscription sets the maximum number of requests which are sent concurrently to the Azure API
+// for a single subscription. Non-positive values reset the limit to DefaultMaxConcurrentRequestsPerSubscription.
+// Requests which are already in flight are not affected.
+func SetMaxConcurrentRequestsPerSubscription(limit int) {
+	subscriptionLimitersMutex.Lock()
+	defer subscriptionLimitersMutex.Unlock()
+
+	if limit < 1 {
+		limit = DefaultMaxConcurrentRequestsPerSubscription
+	}
+
+	maxConcurrentRequestsPerSubscription = limit
+	subscriptionLimiters = map[string]chan struct{}{}
+}
+
+func subscriptionLimiter(subscriptionID string) chan struct{} {
+	subscriptionLimitersMutex.Lock()
+	defer subscriptionLimitersMutex.Unlock()
+
+	limiter, ok := subscriptionLimiters[subscriptionID]
+	if !ok {
+		limiter = make(chan struct{}, maxConcurrentRequestsPerSubscription)
+		subscriptionLimiters[subscriptionID] = limiter
+	}
+	return limiter
+}
+
+// subscriptionConcurrencyPolicy limits the number of concurrent requests per subscription, so that the subscription is not
+// throttled when many reconciliations run in parallel. It is a per-retry policy, hence no slot is held while a retry is delayed.
+type subscriptionConcurrencyPolicy struct{}
+
+// Do implements policy.Policy.
+func (subscriptionConcurrencyPolicy) Do(req *policy.Request) (*http.Response, error) {
+	subscriptionID := subscriptionIDFromPath(req.Raw().URL.Path)
+	if subscriptionID == "" {
+		return req.Next()
+	}
+
+	limiter := subscriptionLimiter(subscriptionID)
+	select {
+	case limiter <- struct{}{}:
+	case <-req.Raw().Context().Done():
+		return nil, req.Raw().Context().Err()
+	}
+	defer func() { <-limiter }()
+
+	return req.Next()
+}
+
+// subscriptionIDFromPath returns the subscription ID of an Azure Resource Manager request path, e.g. /subscriptions/<id>/resourceGroups/...
+func subscriptionIDFromPath(path string) string {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) < 2 || !strings.EqualFold(parts[0], "subscriptions") {
+		return ""
+	}
+	return strings.ToLower(parts[1])
+}
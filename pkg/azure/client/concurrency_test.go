@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// blockingTransport is a transporter which blocks all requests until it is released and tracks the number of
+// concurrent requests.
+type blockingTransport struct {
+	release  chan struct{}
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+}
+
+func (t *blockingTransport) Do(req *http.Request) (*http.Response, error) {
+	current := t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+	for {
+		seen := t.maxSeen.Load()
+		if current <= seen || t.maxSeen.CompareAndSwap(seen, current) {
+			break
+		}
+	}
+	<-t.release
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+var _ = Describe("Concurrency", func() {
+	var transport *blockingTransport
+
+	BeforeEach(func() {
+		transport = &blockingTransport{release: make(chan struct{})}
+		SetMaxConcurrentRequestsPerSubscription(2)
+		DeferCleanup(func() {
+			SetMaxConcurrentRequestsPerSubscription(DefaultMaxConcurrentRequestsPerSubscription)
+		})
+	})
+
+	sendRequests := func(ctx context.Context, urls ...string) *sync.WaitGroup {
+		opts := DefaultAzureClientOpts()
+		opts.Transport = transport
+		pipeline := runtime.NewPipeline("test", "v1", runtime.PipelineOptions{}, &opts.ClientOptions)
+
+		wg := &sync.WaitGroup{}
+		for _, url := range urls {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				req, err := runtime.NewRequest(ctx, http.MethodGet, url)
+				Expect(err).NotTo(HaveOccurred())
+				_, _ = pipeline.Do(req)
+			}()
+		}
+		return wg
+	}
+
+	It("should limit the concurrent requests per subscription", func() {
+		url := "https://management.azure.com/subscriptions/sub-a/resourceGroups/rg"
+		wg := sendRequests(context.Background(), url, url, url, url, url)
+
+		Eventually(transport.inFlight.Load).Should(BeEquivalentTo(2))
+		Consistently(transport.inFlight.Load, 100*time.Millisecond).Should(BeEquivalentTo(2))
+
+		close(transport.release)
+		wg.Wait()
+		Expect(transport.maxSeen.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("should limit each subscription separately", func() {
+		urlA := "https://management.azure.com/subscriptions/sub-a/resourceGroups/rg"
+		urlB := "https://management.azure.com/subscriptions/sub-b/resourceGroups/rg"
+		wg := sendRequests(context.Background(), urlA, urlA, urlA, urlB, urlB, urlB)
+
+		Eventually(transport.inFlight.Load).Should(BeEquivalentTo(4))
+		Consistently(transport.inFlight.Load, 100*time.Millisecond).Should(BeEquivalentTo(4))
+
+		close(transport.release)
+		wg.Wait()
+	})
+
+	It("should not limit requests without a subscription", func() {
+		url := "https://management.azure.com/providers/Microsoft.Network"
+		wg := sendRequests(context.Background(), url, url, url)
+
+		Eventually(transport.inFlight.Load).Should(BeEquivalentTo(3))
+
+		close(transport.release)
+		wg.Wait()
+	})
+
+	It("should stop waiting when the context is cancelled", func() {
+		url := "https://management.azure.com/subscriptions/sub-a/resourceGroups/rg"
+		wg := sendRequests(context.Background(), url, url)
+		Eventually(transport.inFlight.Load).Should(BeEquivalentTo(2))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		waiting := sendRequests(ctx, url)
+		cancel()
+		waiting.Wait()
+		Expect(transport.inFlight.Load()).To(BeEquivalentTo(2))
+
+		close(transport.release)
+		wg.Wait()
+	})
+})
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const dataCollectionRulesAPIVersion = "2022-06-01"
+
+var _ DataCollectionRules = &DataCollectionRulesClient{}
+
+// DataCollectionRule is an Azure Monitor data collection rule.
+type DataCollectionRule struct {
+	// ID is the resource ID of the rule.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the rule.
+	Name *string `json:"name,omitempty"`
+	// Location is the location of the rule.
+	Location *string `json:"location,omitempty"`
+}
+
+// DataCollectionRuleAssociation associates a data collection rule with a resource.
+type DataCollectionRuleAssociation struct {
+	// ID is the resource ID of the association.
+	ID *string `json:"id,omitempty"`
+	// Properties are the properties of the association.
+	Properties DataCollectionRuleAssociationProperties `json:"properties"`
+}
+
+// DataCollectionRuleAssociationProperties are the properties of a data collection rule association.
+type DataCollectionRuleAssociationProperties struct {
+	// DataCollectionRuleID is the resource ID of the associated data collection rule.
+	DataCollectionRuleID *string `json:"dataCollectionRuleId,omitempty"`
+	// Description is the description of the association.
+	Description *string `json:"description,omitempty"`
+}
+
+// DataCollectionRulesClient is a client for Azure Monitor data collection rules and their associations with resources.
+type DataCollectionRulesClient struct {
+	client *arm.Client
+}
+
+// NewDataCollectionRulesClient creates a new DataCollectionRules client.
+func NewDataCollectionRulesClient(tc azcore.TokenCredential, opts *arm.ClientOptions) (*DataCollectionRulesClient, error) {
+	client, err := arm.NewClient("armmonitor.DataCollectionRulesClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &DataCollectionRulesClient{client: client}, nil
+}
+
+// Get returns the data collection rule with the given resource ID. If the rule does not exist nil is returned.
+func (c *DataCollectionRulesClient) Get(ctx context.Context, id string) (*DataCollectionRule, error) {
+	resp, err := c.do(ctx, http.MethodGet, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	rule := &DataCollectionRule{}
+	if err := runtime.UnmarshalAsJSON(resp, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// GetAssociation returns the data collection rule association with the given name of the resource with the given
+// resource ID. If the association does not exist nil is returned.
+func (c *DataCollectionRulesClient) GetAssociation(ctx context.Context, resourceID, name string) (*DataCollectionRuleAssociation, error) {
+	resp, err := c.doAssociation(ctx, http.MethodGet, resourceID, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	association := &DataCollectionRuleAssociation{}
+	if err := runtime.UnmarshalAsJSON(resp, association); err != nil {
+		return nil, err
+	}
+	return association, nil
+}
+
+// CreateOrUpdateAssociation creates or updates the data collection rule association with the given name of the resource
+// with the given resource ID.
+func (c *DataCollectionRulesClient) CreateOrUpdateAssociation(ctx context.Context, resourceID, name string, association DataCollectionRuleAssociation) error {
+	resp, err := c.doAssociation(ctx, http.MethodPut, resourceID, name, &association)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated) {
+		return runtime.NewResponseError(resp)
+	}
+	return nil
+}
+
+// DeleteAssociation deletes the data collection rule association with the given name of the resource with the given
+// resource ID.
+func (c *DataCollectionRulesClient) DeleteAssociation(ctx context.Context, resourceID, name string) error {
+	resp, err := c.doAssociation(ctx, http.MethodDelete, resourceID, name, nil)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusNoContent) {
+		return FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+	return nil
+}
+
+func (c *DataCollectionRulesClient) doAssociation(ctx context.Context, method, resourceID, name string, body any) (*http.Response, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name of the data collection rule association must not be empty")
+	}
+	return c.do(ctx, method, strings.TrimSuffix(resourceID, "/")+"/providers/Microsoft.Insights/dataCollectionRuleAssociations/"+url.PathEscape(name), body)
+}
+
+// do sends a request for the resource with the given ID. The ID is used as path as is, hence it must be a valid resource
+// ID as returned by Azure.
+func (c *DataCollectionRulesClient) do(ctx context.Context, method, id string, body any) (*http.Response, error) {
+	if _, err := arm.ParseResourceID(id); err != nil {
+		return nil, fmt.Errorf("invalid resource ID %q: %w", id, err)
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.client.Endpoint(), id))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", dataCollectionRulesAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Pipeline().Do(req)
+}
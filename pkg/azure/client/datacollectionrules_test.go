@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("DataCollectionRules", func() {
+	const ruleID = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.Insights/dataCollectionRules/linux"
+
+	It("should get a data collection rule", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusOK,
+			body:       `{"id":"` + ruleID + `","name":"linux","location":"westeurope"}`,
+		}
+		c, err := NewDataCollectionRulesClient(fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		rule, err := c.Get(context.Background(), ruleID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule).To(Equal(&DataCollectionRule{ID: ptr.To(ruleID), Name: ptr.To("linux"), Location: ptr.To("westeurope")}))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].Method).To(Equal(http.MethodGet))
+		Expect(transport.requests[0].URL.Path).To(Equal(ruleID))
+		Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2022-06-01"))
+		Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+	})
+
+	It("should reject an invalid resource ID without sending a request", func() {
+		transport := &recordingTransport{statusCode: http.StatusOK}
+		c, err := NewDataCollectionRulesClient(fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.Get(context.Background(), "linux")
+		Expect(err).To(MatchError(ContainSubstring(`invalid resource ID "linux"`)))
+		Expect(transport.requests).To(BeEmpty())
+	})
+})
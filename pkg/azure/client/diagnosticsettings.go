@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const diagnosticSettingsAPIVersion = "2021-05-01-preview"
+
+var _ DiagnosticSettings = &DiagnosticSettingsClient{}
+
+// DiagnosticSetting is an Azure Monitor diagnostic setting of a resource.
+type DiagnosticSetting struct {
+	// Properties are the properties of the diagnostic setting.
+	Properties DiagnosticSettingProperties `json:"properties"`
+}
+
+// DiagnosticSettingProperties are the destinations and the categories of a diagnostic setting.
+type DiagnosticSettingProperties struct {
+	// WorkspaceID is the resource ID of the Log Analytics workspace the logs and metrics are sent to.
+	WorkspaceID *string `json:"workspaceId,omitempty"`
+	// StorageAccountID is the resource ID of the storage account the logs and metrics are archived in.
+	StorageAccountID *string `json:"storageAccountId,omitempty"`
+	// Logs are the log categories of the diagnostic setting.
+	Logs []DiagnosticSettingCategory `json:"logs,omitempty"`
+	// Metrics are the metric categories of the diagnostic setting.
+	Metrics []DiagnosticSettingCategory `json:"metrics,omitempty"`
+}
+
+// DiagnosticSettingCategory enables or disables a log or metric category.
+type DiagnosticSettingCategory struct {
+	// Category is the name of the category.
+	Category string `json:"category"`
+	// Enabled indicates whether the category is collected.
+	Enabled bool `json:"enabled"`
+}
+
+// DiagnosticSettingsClient is a client for the Azure Monitor diagnostic settings of resources.
+type DiagnosticSettingsClient struct {
+	client         *arm.Client
+	subscriptionID string
+}
+
+// NewDiagnosticSettingsClient creates a new DiagnosticSettings client.
+func NewDiagnosticSettingsClient(auth *ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*DiagnosticSettingsClient, error) {
+	client, err := arm.NewClient("armmonitor.DiagnosticSettingsClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &DiagnosticSettingsClient{client: client, subscriptionID: auth.SubscriptionID}, nil
+}
+
+// Get returns the diagnostic setting of the resource with the given provider path, e.g.
+// Microsoft.Storage/storageAccounts/<name>, in the given resource group. If the setting does not exist nil is returned.
+func (c *DiagnosticSettingsClient) Get(ctx context.Context, resourceGroupName, resourcePath, name string) (*DiagnosticSetting, error) {
+	resp, err := c.do(ctx, http.MethodGet, resourceGroupName, resourcePath, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	setting := &DiagnosticSetting{}
+	if err := runtime.UnmarshalAsJSON(resp, setting); err != nil {
+		return nil, err
+	}
+	return setting, nil
+}
+
+// CreateOrUpdate creates or updates the diagnostic setting of the resource with the given provider path.
+func (c *DiagnosticSettingsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, resourcePath, name string, setting DiagnosticSetting) error {
+	resp, err := c.do(ctx, http.MethodPut, resourceGroupName, resourcePath, name, &setting)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated) {
+		return runtime.NewResponseError(resp)
+	}
+	return nil
+}
+
+// Delete deletes the diagnostic setting of the resource with the given provider path.
+func (c *DiagnosticSettingsClient) Delete(ctx context.Context, resourceGroupName, resourcePath, name string) error {
+	resp, err := c.do(ctx, http.MethodDelete, resourceGroupName, resourcePath, name, nil)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusNoContent) {
+		return FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+	return nil
+}
+
+func (c *DiagnosticSettingsClient) do(ctx context.Context, method, resourceGroupName, resourcePath, name string, body any) (*http.Response, error) {
+	if resourceGroupName == "" || resourcePath == "" || name == "" {
+		return nil, fmt.Errorf("resource group, resource path and name of the diagnostic setting must not be empty")
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.client.Endpoint(),
+		"subscriptions", url.PathEscape(c.subscriptionID),
+		"resourceGroups", url.PathEscape(resourceGroupName),
+		"providers", resourcePath,
+		"providers/Microsoft.Insights/diagnosticSettings", url.PathEscape(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", diagnosticSettingsAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Pipeline().Do(req)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("DiagnosticSettings", func() {
+	It("should get the diagnostic setting of a resource", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusOK,
+			body:       `{"properties":{"workspaceId":"workspace","logs":[{"category":"kube-audit","enabled":true}]}}`,
+		}
+		c, err := NewDiagnosticSettingsClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		setting, err := c.Get(context.Background(), "rg", "Microsoft.Network/networkSecurityGroups/nsg", "gardener")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(setting).To(Equal(&DiagnosticSetting{Properties: DiagnosticSettingProperties{
+			WorkspaceID: ptr.To("workspace"),
+			Logs:        []DiagnosticSettingCategory{{Category: "kube-audit", Enabled: true}},
+		}}))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].Method).To(Equal(http.MethodGet))
+		Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg/providers/Microsoft.Insights/diagnosticSettings/gardener"))
+		Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2021-05-01-preview"))
+		Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+	})
+
+	It("should return nil if the diagnostic setting does not exist", func() {
+		transport := &recordingTransport{statusCode: http.StatusNotFound, body: `{"error":{"code":"ResourceNotFound"}}`}
+		c, err := NewDiagnosticSettingsClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(context.Background(), "rg", "Microsoft.Network/networkSecurityGroups/nsg", "gardener")).To(BeNil())
+	})
+})
@@ -38,7 +38,21 @@ func (c *DisksClient) CreateOrUpdate(ctx context.Context, resourceGroupName stri
 	if err != nil {
 		return nil, err
 	}
-	res, err := future.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, future)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Disk, nil
+}
+
+// Update will patch the given properties of a disk, e.g. the provisioned performance of an Ultra disk which is attached
+// to a running virtual machine.
+func (c *DisksClient) Update(ctx context.Context, resourceGroupName string, diskName string, disk armcompute.DiskUpdate) (*armcompute.Disk, error) {
+	future, err := c.client.BeginUpdate(ctx, resourceGroupName, diskName, disk, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +65,7 @@ func (c *DisksClient) Delete(ctx context.Context, resourceGroupName, name string
 	if err != nil {
 		return err
 	}
-	_, err = future.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, future)
 	if err != nil {
 		if IsAzureAPINotFoundError(err) {
 			return nil
@@ -15,10 +15,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 var _ DNSRecordSet = &DNSRecordSetClient{}
 
+// wildcardLabel is the DNS label matching all names which are not explicitly defined at its level.
+const wildcardLabel = "*"
+
 // DNSRecordSetClient is an implementation of DNSRecordSet for a DNS recordset k8sClient.
 type DNSRecordSetClient struct {
 	client *armdns.RecordSetsClient
@@ -30,15 +35,26 @@ func NewDnsRecordSetClient(auth *ClientAuth, tc azcore.TokenCredential, opts *po
 	return &DNSRecordSetClient{client}, err
 }
 
-// CreateOrUpdate creates or updates the recordset with the given name, record type, values, and TTL in the zone with the given zone ID.
-func (c *DNSRecordSetClient) CreateOrUpdate(ctx context.Context, zoneID string, name string, recordType string, values []string, ttl int64) error {
+// CreateOrUpdate creates or updates the recordset with the given name, record type, values, TTL, and tags in the zone with the given zone ID.
+// The tags replace the metadata of the recordset, except for the metadata managed by Gardener.
+func (c *DNSRecordSetClient) CreateOrUpdate(ctx context.Context, zoneID string, name string, recordType string, values []string, ttl int64, tags map[string]string) error {
 	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
-	relativeRecordSetName, err := getRelativeRecordSetName(name, zoneName)
+	relativeRecordSetName, err := RelativeRecordSetName(name, zoneName)
 	if err != nil {
 		return err
 	}
+	current, err := c.client.Get(ctx, resourceGroupName, zoneName, relativeRecordSetName, armdns.RecordType(recordType), nil)
+	if err := ignoreAzureNotFoundError(err); err != nil {
+		return err
+	}
+	properties := newRecordSetProperties(armdns.RecordType(recordType), values, ttl)
+	var currentMetadata map[string]*string
+	if current.Properties != nil {
+		currentMetadata = current.Properties.Metadata
+	}
+	properties.Metadata = recordSetMetadata(currentMetadata, tags)
 	params := armdns.RecordSet{
-		Properties: newRecordSetProperties(armdns.RecordType(recordType), values, ttl),
+		Properties: properties,
 	}
 	_, err = c.client.CreateOrUpdate(ctx, resourceGroupName, zoneName, relativeRecordSetName, armdns.RecordType(recordType), params, nil)
 	return err
@@ -47,7 +63,7 @@ func (c *DNSRecordSetClient) CreateOrUpdate(ctx context.Context, zoneID string,
 // Delete deletes the recordset with the given name and record type in the zone with the given zone ID.
 func (c *DNSRecordSetClient) Delete(ctx context.Context, zoneID string, name string, recordType string) error {
 	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
-	relativeRecordSetName, err := getRelativeRecordSetName(name, zoneName)
+	relativeRecordSetName, err := RelativeRecordSetName(name, zoneName)
 	if err != nil {
 		return err
 	}
@@ -55,7 +71,13 @@ func (c *DNSRecordSetClient) Delete(ctx context.Context, zoneID string, name str
 	return ignoreAzureNotFoundError(err)
 }
 
-func getRelativeRecordSetName(name, zoneName string) (string, error) {
+// RelativeRecordSetName returns the name of the recordset with the given fully qualified name relative to the zone with the given name.
+// The apex of the zone is returned as "@". Wildcard names like "*.apps.example.com" are returned with the wildcard label as is,
+// since Azure expects it unescaped in the recordset name.
+func RelativeRecordSetName(name, zoneName string) (string, error) {
+	if err := ValidateRecordSetName(name); err != nil {
+		return "", err
+	}
 	if name == zoneName {
 		return "@", nil
 	}
@@ -66,6 +88,37 @@ func getRelativeRecordSetName(name, zoneName string) (string, error) {
 	return strings.TrimSuffix(name, suffix), nil
 }
 
+// ValidateRecordSetName checks that the given fully qualified recordset name only contains a wildcard as its leftmost label.
+func ValidateRecordSetName(name string) error {
+	if !strings.Contains(name, wildcardLabel) {
+		return nil
+	}
+	labels := strings.Split(name, ".")
+	if labels[0] != wildcardLabel || len(labels) < 2 || strings.Contains(strings.Join(labels[1:], "."), wildcardLabel) {
+		return fmt.Errorf("name %s is invalid: a wildcard is only allowed as the leftmost label, e.g. *.example.com", name)
+	}
+	return nil
+}
+
+// recordSetMetadata returns the metadata of a recordset with the given tags and the metadata managed by Gardener of the
+// current metadata. Azure treats the metadata keys case-insensitively. All other metadata is removed, so that tags which
+// were removed from the DNSRecord are removed from the recordset as well.
+func recordSetMetadata(current map[string]*string, tags map[string]string) map[string]*string {
+	metadata := map[string]*string{}
+	for k, v := range current {
+		if strings.HasPrefix(strings.ToLower(k), azure.DNSMetadataGardenerPrefix) {
+			metadata[k] = v
+		}
+	}
+	for k, v := range tags {
+		metadata[k] = ptr.To(v)
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
 func newRecordSetProperties(recordType armdns.RecordType, values []string, ttl int64) *armdns.RecordSetProperties {
 	rrp := &armdns.RecordSetProperties{
 		TTL: ptr.To[int64](ttl),
@@ -91,6 +144,14 @@ func newRecordSetProperties(recordType armdns.RecordType, values []string, ttl i
 			})
 		}
 		rrp.TxtRecords = txtRecords
+	case armdns.RecordTypeNS:
+		var nsRecords []*armdns.NsRecord
+		for _, value := range values {
+			nsRecords = append(nsRecords, &armdns.NsRecord{
+				Nsdname: ptr.To(value),
+			})
+		}
+		rrp.NsRecords = nsRecords
 	}
 	return rrp
 }
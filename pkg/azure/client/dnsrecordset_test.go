@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// recordSetTransport records the paths and the bodies of the recordsets which are put or patched. Get requests are
+// answered with the current resource.
+type recordSetTransport struct {
+	current string
+	paths   []string
+	bodies  []map[string]any
+}
+
+func (t *recordSetTransport) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPut || req.Method == http.MethodPatch {
+		body := map[string]any{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		t.paths = append(t.paths, req.URL.Path)
+		t.bodies = append(t.bodies, body)
+	}
+	body := `{}`
+	if req.Method == http.MethodGet && t.current != "" {
+		body = t.current
+	}
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+}
+
+var _ = Describe("DNSRecordSet", func() {
+	Describe("#CreateOrUpdate", func() {
+		It("should set the name servers of the delegation of a subdomain", func() {
+			transport := &recordSetTransport{}
+			opts := DefaultAzureClientOpts()
+			opts.Transport = transport
+			client, err := NewDnsRecordSetClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "sub.example.com", "NS", []string{"ns1-01.azure-dns.com."}, 300, nil)).To(Succeed())
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "sub.example.com", "NS", []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."}, 300, nil)).To(Succeed())
+
+			Expect(transport.paths).To(HaveEach(HaveSuffix("/resourceGroups/rg/providers/Microsoft.Network/dnsZones/example.com/NS/sub")))
+			Expect(transport.bodies).To(Equal([]map[string]any{
+				{"properties": map[string]any{"TTL": float64(300), "NSRecords": []any{
+					map[string]any{"nsdname": "ns1-01.azure-dns.com."},
+				}}},
+				{"properties": map[string]any{"TTL": float64(300), "NSRecords": []any{
+					map[string]any{"nsdname": "ns1-01.azure-dns.com."},
+					map[string]any{"nsdname": "ns2-01.azure-dns.net."},
+				}}},
+			}))
+		})
+
+		It("should set the tags as metadata and keep the metadata managed by Gardener", func() {
+			transport := &recordSetTransport{current: `{"properties": {"metadata": {"gardener_owner": "shoot", "removed": "tag"}}}`}
+			opts := DefaultAzureClientOpts()
+			opts.Transport = transport
+			client, err := NewDnsRecordSetClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "api.example.com", "A", []string{"1.2.3.4"}, 120, map[string]string{"cost-center": "1234"})).To(Succeed())
+
+			Expect(transport.bodies).To(Equal([]map[string]any{
+				{"properties": map[string]any{
+					"TTL":      float64(120),
+					"ARecords": []any{map[string]any{"ipv4Address": "1.2.3.4"}},
+					"metadata": map[string]any{"gardener_owner": "shoot", "cost-center": "1234"},
+				}},
+			}))
+		})
+	})
+
+	Describe("#AddTags", func() {
+		var (
+			transport *recordSetTransport
+			client    *DNSZoneClient
+		)
+
+		BeforeEach(func() {
+			transport = &recordSetTransport{current: `{"location": "global", "tags": {"owner": "dns-team", "cost-center": "0000"}}`}
+			opts := DefaultAzureClientOpts()
+			opts.Transport = transport
+			var err error
+			client, err = NewDnsZoneClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should add the tags to the zone and keep its other tags", func() {
+			Expect(client.AddTags(context.Background(), "rg/example.com", map[string]string{"cost-center": "1234"})).To(Succeed())
+
+			Expect(transport.paths).To(ConsistOf(HaveSuffix("/resourceGroups/rg/providers/Microsoft.Network/dnsZones/example.com")))
+			Expect(transport.bodies).To(Equal([]map[string]any{
+				{"tags": map[string]any{"owner": "dns-team", "cost-center": "1234"}},
+			}))
+		})
+
+		It("should not update the zone if it already has the tags", func() {
+			Expect(client.AddTags(context.Background(), "rg/example.com", map[string]string{"owner": "dns-team"})).To(Succeed())
+			Expect(transport.paths).To(BeEmpty())
+		})
+
+		It("should fail if the resource group of the zone is unknown", func() {
+			Expect(client.AddTags(context.Background(), "example.com", map[string]string{"owner": "dns-team"})).To(MatchError(ContainSubstring("the resource group of DNS zone example.com is unknown")))
+		})
+	})
+
+	Describe("#CreateOrUpdate in private zones", func() {
+		var (
+			transport *recordSetTransport
+			client    *PrivateDNSRecordSetClient
+		)
+
+		BeforeEach(func() {
+			transport = &recordSetTransport{}
+			opts := DefaultAzureClientOpts()
+			opts.Transport = transport
+			var err error
+			client, err = NewPrivateDNSRecordSetClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should set the records in the private zone", func() {
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "api.example.com", "A", []string{"10.0.0.1", "10.0.0.2"}, 120, nil)).To(Succeed())
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "example.com", "TXT", []string{"foo"}, 300, nil)).To(Succeed())
+
+			Expect(transport.paths).To(ConsistOf(
+				HaveSuffix("/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/example.com/A/api"),
+				HaveSuffix("/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/example.com/TXT/@"),
+			))
+			Expect(transport.bodies).To(Equal([]map[string]any{
+				{"properties": map[string]any{"ttl": float64(120), "aRecords": []any{
+					map[string]any{"ipv4Address": "10.0.0.1"},
+					map[string]any{"ipv4Address": "10.0.0.2"},
+				}}},
+				{"properties": map[string]any{"ttl": float64(300), "txtRecords": []any{
+					map[string]any{"value": []any{"foo"}},
+				}}},
+			}))
+		})
+
+		It("should set the tags as metadata and remove the tags which are no longer configured", func() {
+			transport.current = `{"properties": {"metadata": {"Gardener_owner": "shoot", "cost-center": "0000", "removed": "tag"}}}`
+
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "api.example.com", "A", []string{"10.0.0.1"}, 120, map[string]string{"cost-center": "1234"})).To(Succeed())
+
+			Expect(transport.bodies).To(Equal([]map[string]any{
+				{"properties": map[string]any{
+					"ttl":      float64(120),
+					"aRecords": []any{map[string]any{"ipv4Address": "10.0.0.1"}},
+					"metadata": map[string]any{"Gardener_owner": "shoot", "cost-center": "1234"},
+				}},
+			}))
+		})
+
+		It("should reject NS records", func() {
+			Expect(client.CreateOrUpdate(context.Background(), "rg/example.com", "sub.example.com", "NS", []string{"ns1-01.azure-dns.com."}, 300, nil)).To(MatchError(ContainSubstring("record type NS is not supported in private DNS zones")))
+			Expect(transport.paths).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#RelativeRecordSetName",
+		func(name, zoneName, expectedName string, expectedErr bool) {
+			relativeName, err := RelativeRecordSetName(name, zoneName)
+			if expectedErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(relativeName).To(Equal(expectedName))
+		},
+		Entry("should return the apex of the zone", "example.com", "example.com", "@", false),
+		Entry("should return the relative name", "api.shoot.example.com", "example.com", "api.shoot", false),
+		Entry("should return the wildcard label of the zone", "*.example.com", "example.com", "*", false),
+		Entry("should return the relative wildcard name", "*.apps.example.com", "example.com", "*.apps", false),
+		Entry("should fail if the name does not belong to the zone", "api.example.org", "example.com", "", true),
+		Entry("should fail if the wildcard is not the leftmost label", "apps.*.example.com", "example.com", "", true),
+		Entry("should fail if the wildcard is part of a label", "*apps.example.com", "example.com", "", true),
+		Entry("should fail if there are multiple wildcards", "*.*.example.com", "example.com", "", true),
+	)
+
+	DescribeTable("#ValidateRecordSetName",
+		func(name string, expectedErr bool) {
+			if expectedErr {
+				Expect(ValidateRecordSetName(name)).To(MatchError(ContainSubstring("a wildcard is only allowed as the leftmost label")))
+			} else {
+				Expect(ValidateRecordSetName(name)).To(Succeed())
+			}
+		},
+		Entry("should allow names without wildcard", "api.example.com", false),
+		Entry("should allow a leftmost wildcard", "*.apps.example.com", false),
+		Entry("should forbid a single wildcard label", "*", true),
+		Entry("should forbid a wildcard in the middle", "apps.*.example.com", true),
+		Entry("should forbid a partial wildcard label", "a*.example.com", true),
+	)
+})
@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
+	"k8s.io/utils/ptr"
 )
 
 var _ DNSZone = &DNSZoneClient{}
@@ -52,6 +53,59 @@ func (c *DNSZoneClient) List(ctx context.Context) (map[string]string, error) {
 	return zones, nil
 }
 
+// ListByResourceGroup returns a map of all zone names in the given resource group mapped to their IDs.
+func (c *DNSZoneClient) ListByResourceGroup(ctx context.Context, resourceGroupName string) (map[string]string, error) {
+	zones := make(map[string]string)
+
+	results := c.client.NewListByResourceGroupPager(resourceGroupName, nil)
+	for results.More() {
+		nextResult, err := results.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range nextResult.Value {
+			zoneName := *zone.Name
+			zones[zoneName] = zoneID(resourceGroupName, zoneName)
+		}
+	}
+
+	return zones, nil
+}
+
+// AddTags adds the given tags to the zone with the given zone ID, or updates their values. Other tags of the zone are kept.
+func (c *DNSZoneClient) AddTags(ctx context.Context, zoneID string, tags map[string]string) error {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	if resourceGroupName == "" {
+		return fmt.Errorf("the resource group of DNS zone %s is unknown", zoneID)
+	}
+	zone, err := c.client.Get(ctx, resourceGroupName, zoneName, nil)
+	if err != nil {
+		return err
+	}
+	merged, changed := mergeTags(zone.Tags, tags)
+	if !changed {
+		return nil
+	}
+	_, err = c.client.Update(ctx, resourceGroupName, zoneName, armdns.ZoneUpdate{Tags: merged}, nil)
+	return err
+}
+
+// mergeTags adds the given tags to the current tags of a DNS zone. It returns whether the tags have been changed.
+func mergeTags(current map[string]*string, tags map[string]string) (map[string]*string, bool) {
+	merged := make(map[string]*string, len(current)+len(tags))
+	for k, v := range current {
+		merged[k] = v
+	}
+	changed := false
+	for k, v := range tags {
+		if currentValue, ok := merged[k]; !ok || ptr.Deref(currentValue, "") != v {
+			merged[k] = ptr.To(v)
+			changed = true
+		}
+	}
+	return merged, changed
+}
+
 func getResourceGroupName(zoneID string) (string, error) {
 	submatches := resourceGroupRegex.FindStringSubmatch(zoneID)
 	if len(submatches) != 2 {
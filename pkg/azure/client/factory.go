@@ -90,6 +90,11 @@ func (f azureFactory) DNSRecordSet() (DNSRecordSet, error) {
 	return NewDnsRecordSetClient(f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// PrivateDNSRecordSet returns a record set client for Azure private DNS zones.
+func (f azureFactory) PrivateDNSRecordSet() (DNSRecordSet, error) {
+	return NewPrivateDNSRecordSetClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
 // Group returns an Azure resource group client.
 func (f azureFactory) Group() (ResourceGroup, error) {
 	return NewResourceGroupsClient(f.auth, f.tokenCredential, f.clientOpts)
@@ -110,6 +115,11 @@ func (f azureFactory) VirtualMachine() (VirtualMachine, error) {
 	return NewVMClient(*f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// VirtualMachineExtensions returns an Azure virtual machine extensions client.
+func (f azureFactory) VirtualMachineExtensions() (VirtualMachineExtensions, error) {
+	return NewVirtualMachineExtensionsClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
 // NetworkSecurityGroup returns an Azure network security group client.
 func (f azureFactory) NetworkSecurityGroup() (NetworkSecurityGroup, error) {
 	return NewSecurityGroupClient(*f.auth, f.tokenCredential, f.clientOpts)
@@ -120,6 +130,11 @@ func (f azureFactory) PublicIP() (PublicIP, error) {
 	return NewPublicIPClient(*f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// PublicIPPrefix returns an Azure network PublicIPPrefixClient.
+func (f azureFactory) PublicIPPrefix() (PublicIPPrefix, error) {
+	return NewPublicIPPrefixClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
 // NetworkInterface returns an Azure network interface client.
 func (f azureFactory) NetworkInterface() (NetworkInterface, error) {
 	return NewNetworkInterfaceClient(*f.auth, f.tokenCredential, f.clientOpts)
@@ -155,16 +170,86 @@ func (f azureFactory) NatGateway() (NatGateway, error) {
 	return NewNatGatewaysClient(*f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// ServiceEndpointPolicy returns a ServiceEndpointPolicy client.
+func (f azureFactory) ServiceEndpointPolicy() (ServiceEndpointPolicy, error) {
+	return NewServiceEndpointPolicyClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// NetworkWatcher returns a NetworkWatcher client.
+func (f azureFactory) NetworkWatcher() (NetworkWatcher, error) {
+	return NewNetworkWatcherClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// FlowLog returns a FlowLog client.
+func (f azureFactory) FlowLog() (FlowLog, error) {
+	return NewFlowLogClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// PrivateLinkService returns a PrivateLinkService client.
+func (f azureFactory) PrivateLinkService() (PrivateLinkService, error) {
+	return NewPrivateLinkServiceClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// PrivateEndpoint returns a PrivateEndpoint client.
+func (f azureFactory) PrivateEndpoint() (PrivateEndpoint, error) {
+	return NewPrivateEndpointClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// PrivateDNSZones returns a PrivateDNSZones client.
+func (f azureFactory) PrivateDNSZones() (PrivateDNSZones, error) {
+	return NewPrivateDNSZonesClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// VirtualNetworkLinks returns a VirtualNetworkLinks client.
+func (f azureFactory) VirtualNetworkLinks() (VirtualNetworkLinks, error) {
+	return NewVirtualNetworkLinksClient(*f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// DataCollectionRules returns a DataCollectionRules client.
+func (f azureFactory) DataCollectionRules() (DataCollectionRules, error) {
+	return NewDataCollectionRulesClient(f.tokenCredential, f.clientOpts)
+}
+
+// RoleAssignments returns a RoleAssignments client.
+func (f azureFactory) RoleAssignments() (RoleAssignments, error) {
+	return NewRoleAssignmentsClient(f.tokenCredential, f.clientOpts)
+}
+
+// KeyVaultKeys returns a KeyVaultKeys client.
+func (f azureFactory) KeyVaultKeys() (KeyVaultKeys, error) {
+	return NewKeyVaultKeysClient(f.tokenCredential, &f.clientOpts.ClientOptions)
+}
+
 // ManagedUserIdentity returns a ManagedUserIdentity client.
 func (f azureFactory) ManagedUserIdentity() (ManagedUserIdentity, error) {
 	return NewManagedUserIdentityClient(f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// FederatedIdentityCredentials returns a FederatedIdentityCredentials client.
+func (f azureFactory) FederatedIdentityCredentials() (FederatedIdentityCredentials, error) {
+	return NewFederatedIdentityCredentialsClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// MarketplaceAgreements returns a MarketplaceAgreements client.
+func (f azureFactory) MarketplaceAgreements() (MarketplaceAgreements, error) {
+	return NewMarketplaceAgreementsClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// ManagementLocks returns a ManagementLocks client.
+func (f azureFactory) ManagementLocks() (ManagementLocks, error) {
+	return NewManagementLocksClient(f.tokenCredential, f.clientOpts)
+}
+
 // VirtualMachineImages returns a VirtualMachineImages client.
 func (f azureFactory) VirtualMachineImages() (VirtualMachineImages, error) {
 	return NewVirtualMachineImagesClient(f.auth, f.tokenCredential, f.clientOpts)
 }
 
+// GalleryImageVersions returns a GalleryImageVersions client.
+func (f azureFactory) GalleryImageVersions() (GalleryImageVersions, error) {
+	return NewGalleryImageVersionsClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
 func (f azureFactory) BlobContainers() (BlobContainers, error) {
 	return NewBlobContainersClient(f.auth, f.tokenCredential, f.clientOpts)
 }
@@ -172,3 +257,33 @@ func (f azureFactory) BlobContainers() (BlobContainers, error) {
 func (f azureFactory) ManagementPolicies() (ManagementPolicies, error) {
 	return NewManagementPoliciesClient(f.auth, f.tokenCredential, f.clientOpts)
 }
+
+// LocalUsers returns a LocalUsers client.
+func (f azureFactory) LocalUsers() (LocalUsers, error) {
+	return NewLocalUsersClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// ObjectReplication returns an ObjectReplication client.
+func (f azureFactory) ObjectReplication() (ObjectReplication, error) {
+	return NewObjectReplicationClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// BlobServices returns a client for the blob service properties of storage accounts.
+func (f azureFactory) BlobServices() (BlobServices, error) {
+	return NewBlobServicesClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// Providers returns an Azure resource providers client.
+func (f azureFactory) Providers() (Providers, error) {
+	return NewProvidersClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// Usage returns an Azure usage client.
+func (f azureFactory) Usage() (Usage, error) {
+	return NewUsageClient(f.auth, f.tokenCredential, f.clientOpts)
+}
+
+// DiagnosticSettings returns an Azure diagnostic settings client.
+func (f azureFactory) DiagnosticSettings() (DiagnosticSettings, error) {
+	return NewDiagnosticSettingsClient(f.auth, f.tokenCredential, f.clientOpts)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
+)
+
+var _ FederatedIdentityCredentials = &FederatedIdentityCredentialsClient{}
+
+// FederatedIdentityCredentialsClient is an implementation of FederatedIdentityCredentials for the federated identity
+// credentials of managed user identities.
+type FederatedIdentityCredentialsClient struct {
+	client *armmsi.FederatedIdentityCredentialsClient
+}
+
+// NewFederatedIdentityCredentialsClient creates a new FederatedIdentityCredentialsClient.
+func NewFederatedIdentityCredentialsClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*FederatedIdentityCredentialsClient, error) {
+	client, err := armmsi.NewFederatedIdentityCredentialsClient(auth.SubscriptionID, tc, opts)
+	return &FederatedIdentityCredentialsClient{client}, err
+}
+
+// Get returns the federated identity credential of the given identity by name.
+func (c *FederatedIdentityCredentialsClient) Get(ctx context.Context, resourceGroupName, identityName, name string) (*armmsi.FederatedIdentityCredential, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, identityName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.FederatedIdentityCredential, nil
+}
+
+// CreateOrUpdate creates or updates the federated identity credential of the given identity.
+func (c *FederatedIdentityCredentialsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, identityName, name string, parameters armmsi.FederatedIdentityCredential) (*armmsi.FederatedIdentityCredential, error) {
+	res, err := c.client.CreateOrUpdate(ctx, resourceGroupName, identityName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.FederatedIdentityCredential, nil
+}
+
+// Delete deletes the federated identity credential of the given identity.
+func (c *FederatedIdentityCredentialsClient) Delete(ctx context.Context, resourceGroupName, identityName, name string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, identityName, name, nil)
+	return FilterNotFoundError(err)
+}
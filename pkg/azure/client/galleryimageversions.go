@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+var _ GalleryImageVersions = &GalleryImageVersionsClient{}
+
+// GalleryImageVersionsClient is a client for the versions of images in Azure Compute Galleries, either directly in a
+// gallery or in a gallery which is shared with the subscription or the community.
+type GalleryImageVersionsClient struct {
+	tokenCredential azcore.TokenCredential
+	clientOpts      *policy.ClientOptions
+	shared          *armcompute.SharedGalleryImageVersionsClient
+	community       *armcompute.CommunityGalleryImageVersionsClient
+}
+
+// NewGalleryImageVersionsClient creates a new GalleryImageVersionsClient.
+func NewGalleryImageVersionsClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*GalleryImageVersionsClient, error) {
+	shared, err := armcompute.NewSharedGalleryImageVersionsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	community, err := armcompute.NewCommunityGalleryImageVersionsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &GalleryImageVersionsClient{tokenCredential: tc, clientOpts: opts, shared: shared, community: community}, nil
+}
+
+// Get returns the image version <versionName> of the image <imageName> in the gallery <galleryName> of the resource
+// group <resourceGroupName>. The gallery may be in another subscription than the one of the client. If the image
+// version does not exist nil is returned.
+func (c *GalleryImageVersionsClient) Get(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName, versionName string) (*armcompute.GalleryImageVersion, error) {
+	client, err := armcompute.NewGalleryImageVersionsClient(subscriptionID, c.tokenCredential, c.clientOpts)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Get(ctx, resourceGroupName, galleryName, imageName, versionName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &response.GalleryImageVersion, nil
+}
+
+// GetShared returns the image version <versionName> of the image <imageName> in the gallery with the unique name
+// <galleryUniqueName> which is shared with the subscription in the location <location>. If the image version does not
+// exist nil is returned.
+func (c *GalleryImageVersionsClient) GetShared(ctx context.Context, location, galleryUniqueName, imageName, versionName string) (*armcompute.SharedGalleryImageVersion, error) {
+	response, err := c.shared.Get(ctx, location, galleryUniqueName, imageName, versionName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &response.SharedGalleryImageVersion, nil
+}
+
+// GetCommunity returns the image version <versionName> of the image <imageName> in the community gallery with the
+// public name <publicGalleryName> in the location <location>. If the image version does not exist nil is returned.
+func (c *GalleryImageVersionsClient) GetCommunity(ctx context.Context, location, publicGalleryName, imageName, versionName string) (*armcompute.CommunityGalleryImageVersion, error) {
+	response, err := c.community.Get(ctx, location, publicGalleryName, imageName, versionName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &response.CommunityGalleryImageVersion, nil
+}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
@@ -60,6 +62,45 @@ func IsAzureAPIUnauthorized(err error) bool {
 	return errors.As(err, &inErr)
 }
 
+// IsAzureAPIForbidden tries to determine if the API error is due to missing permissions of the authenticated principal.
+func IsAzureAPIForbidden(err error) bool {
+	return isAzureAPIStatusError(err, http.StatusForbidden)
+}
+
+// IsAzureAPIThrottled tries to determine if the API error is due to throttling of the requests.
+func IsAzureAPIThrottled(err error) bool {
+	return isAzureAPIStatusError(err, http.StatusTooManyRequests)
+}
+
+// IsAzureAPIPreconditionFailed tries to determine if the API error is due to a resource which was changed since it was
+// read, i.e. its etag does not match the one of the If-Match header of the request.
+func IsAzureAPIPreconditionFailed(err error) bool {
+	return isAzureAPIStatusError(err, http.StatusPreconditionFailed)
+}
+
+// IsAzureAPIInUseError tries to determine if the API error is due to a resource which cannot be deleted because other
+// resources still reference it, e.g. a subnet with network interfaces (InUseSubnetCannotBeDeleted).
+func IsAzureAPIInUseError(err error) bool {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	return strings.HasPrefix(respErr.ErrorCode, "InUse") || strings.HasSuffix(respErr.ErrorCode, "InUse")
+}
+
+// RetryAfter returns the duration from the Retry-After header of the given throttling error, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) || respErr.RawResponse == nil {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(respErr.RawResponse.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
 func hasAnyPrefix(s string, prefixes ...string) bool {
 	lString := strings.ToLower(s)
 	for _, p := range prefixes {
@@ -127,6 +168,8 @@ func AzureCloudConfigurationFromCloudConfiguration(cloudConfiguration *azure.Clo
 		return cloud.AzureGovernment, nil
 	case strings.EqualFold(cloudConfigurationName, azure.AzureChinaCloudName):
 		return cloud.AzureChina, nil
+	case strings.EqualFold(cloudConfigurationName, azure.AzureStackHubCloudName):
+		return azureStackHubCloudConfiguration()
 
 	default:
 		return cloud.Configuration{}, fmt.Errorf("unknown cloud configuration name '%s'", cloudConfigurationName)
@@ -7,6 +7,7 @@ package client_test
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	azerrors "github.com/AzureAD/microsoft-authentication-library-for-go/apps/errors"
@@ -51,4 +52,50 @@ var _ = Describe("Helper", func() {
 		Entry("should return false as error if it is an NotFound call error", 1, http.StatusNotFound, false),
 		Entry("should return false as error if it is an unknown error", -1, http.StatusUnauthorized, false),
 	)
+	DescribeTable("#IsAzureAPIForbidden",
+		func(err error, expectIsForbiddenError bool) {
+			Expect(IsAzureAPIForbidden(err)).To(Equal(expectIsForbiddenError))
+		},
+		Entry("should return false as error is not a detailed azure error", errors.New("error"), false),
+		Entry("should return true as error is a Forbidden response error",
+			&azcore.ResponseError{StatusCode: http.StatusForbidden}, true),
+		Entry("should return true as error is a Forbidden call error",
+			azerrors.CallErr{Resp: &http.Response{StatusCode: http.StatusForbidden}}, true),
+		Entry("should return false as error is an Unauthorized response error",
+			&azcore.ResponseError{StatusCode: http.StatusUnauthorized}, false),
+	)
+	DescribeTable("#IsAzureAPIThrottled",
+		func(err error, expectIsThrottledError bool) {
+			Expect(IsAzureAPIThrottled(err)).To(Equal(expectIsThrottledError))
+		},
+		Entry("should return false as error is not a detailed azure error", errors.New("error"), false),
+		Entry("should return true as error is a TooManyRequests response error",
+			&azcore.ResponseError{StatusCode: http.StatusTooManyRequests}, true),
+		Entry("should return false as error is a Forbidden response error",
+			&azcore.ResponseError{StatusCode: http.StatusForbidden}, false),
+	)
+	DescribeTable("#IsAzureAPIInUseError",
+		func(err error, expectIsInUseError bool) {
+			Expect(IsAzureAPIInUseError(err)).To(Equal(expectIsInUseError))
+		},
+		Entry("should return false as error is not a detailed azure error", errors.New("InUseSubnetCannotBeDeleted"), false),
+		Entry("should return true as error is a subnet in use error",
+			&azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InUseSubnetCannotBeDeleted"}, true),
+		Entry("should return true as error is a vnet in use error",
+			&azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "VnetInUse"}, true),
+		Entry("should return false as error is another bad request error",
+			&azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InvalidParameter"}, false),
+	)
+	DescribeTable("#RetryAfter",
+		func(err error, expectedDuration time.Duration, expectedFound bool) {
+			duration, found := RetryAfter(err)
+			Expect(duration).To(Equal(expectedDuration))
+			Expect(found).To(Equal(expectedFound))
+		},
+		Entry("should return false as error is not a detailed azure error", errors.New("error"), time.Duration(0), false),
+		Entry("should return false as the response has no Retry-After header",
+			&azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: &http.Response{}}, time.Duration(0), false),
+		Entry("should return the duration of the Retry-After header",
+			&azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: &http.Response{Header: http.Header{"Retry-After": []string{"17"}}}}, 17*time.Second, true),
+	)
 })
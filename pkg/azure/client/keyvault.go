@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+const (
+	// KeyVaultSecretTTL is the duration for which a client secret read from a Key Vault is cached.
+	KeyVaultSecretTTL = 5 * time.Minute
+
+	keyVaultAPIVersion = "7.4"
+)
+
+// KeyVaultSecretReference references a Key Vault secret which holds the client secret of a service principal.
+type KeyVaultSecretReference struct {
+	// VaultURI is the URI of the Key Vault, e.g. https://my-vault.vault.azure.net.
+	VaultURI string
+	// SecretName is the name of the Key Vault secret.
+	SecretName string
+	// IdentityClientID is the client ID of the managed identity which is used to read the secret from the Key Vault.
+	IdentityClientID string
+}
+
+// KeyVault is an interface for reading secrets from an Azure Key Vault.
+type KeyVault interface {
+	GetSecret(ctx context.Context, vaultURI, secretName string) (string, error)
+}
+
+// DefaultKeyVaultFunc is the default function for creating a Key Vault client authenticating with the managed identity
+// of the given client ID. It is called once per identity, and can be overridden for tests.
+var DefaultKeyVaultFunc = NewKeyVaultClient
+
+type keyVaultClient struct {
+	credential azcore.TokenCredential
+}
+
+// NewKeyVaultClient creates a new Key Vault client which authenticates with the user-assigned managed identity of the given client ID.
+func NewKeyVaultClient(identityClientID string) (KeyVault, error) {
+	credential, err := azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{
+		ClientOptions: DataPlaneClientOptions(),
+		ID:            azidentity.ClientID(identityClientID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &keyVaultClient{credential: credential}, nil
+}
+
+// GetSecret returns the current value of the given Key Vault secret.
+func (c *keyVaultClient) GetSecret(ctx context.Context, vaultURI, secretName string) (string, error) {
+	scope, err := keyVaultScope(vaultURI)
+	if err != nil {
+		return "", err
+	}
+
+	opts := DataPlaneClientOptions()
+	opts.PerRetryPolicies = []policy.Policy{runtime.NewBearerTokenPolicy(c.credential, []string{scope}, nil)}
+	pipeline := runtime.NewPipeline("keyvault", "v1", runtime.PipelineOptions{}, &opts)
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(vaultURI, "secrets", url.PathEscape(secretName)))
+	if err != nil {
+		return "", err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", keyVaultAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return "", err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return "", runtime.NewResponseError(resp)
+	}
+
+	var bundle struct {
+		Value *string `json:"value"`
+	}
+	if err := runtime.UnmarshalAsJSON(resp, &bundle); err != nil {
+		return "", err
+	}
+	if bundle.Value == nil || *bundle.Value == "" {
+		return "", fmt.Errorf("key vault secret %q in %s has no value", secretName, vaultURI)
+	}
+	return *bundle.Value, nil
+}
+
+// keyVaultScope returns the scope of the access tokens for the Key Vault with the given URI. It is derived from the DNS
+// suffix of the vault, e.g. vault.azure.net for my-vault.vault.azure.net.
+func keyVaultScope(vaultURI string) (string, error) {
+	u, err := url.Parse(vaultURI)
+	if err != nil {
+		return "", fmt.Errorf("invalid Key Vault URI %q: %w", vaultURI, err)
+	}
+	_, suffix, found := strings.Cut(u.Hostname(), ".")
+	if !found {
+		return "", fmt.Errorf("invalid Key Vault URI %q: host must contain the vault name and DNS suffix", vaultURI)
+	}
+	return "https://" + suffix + "/.default", nil
+}
+
+// keyVaultSecret is the cached value of a Key Vault secret. Its mutex serializes the reads of the same secret, so that
+// concurrent callers wait for a single request instead of all reading the secret at once.
+type keyVaultSecret struct {
+	mutex     sync.Mutex
+	value     string
+	expiresAt time.Time
+}
+
+var (
+	// keyVaultMutex only guards the maps below and is never held while talking to Azure.
+	keyVaultMutex   sync.Mutex
+	keyVaultSecrets = map[KeyVaultSecretReference]*keyVaultSecret{}
+	keyVaultClients = map[string]KeyVault{}
+)
+
+// ResolveKeyVaultSecret returns the value of the referenced Key Vault secret. Values are cached for KeyVaultSecretTTL.
+// Different secrets are read independently of each other.
+func ResolveKeyVaultSecret(ctx context.Context, ref KeyVaultSecretReference) (string, error) {
+	secret := getKeyVaultSecret(ref)
+
+	secret.mutex.Lock()
+	defer secret.mutex.Unlock()
+
+	if time.Now().Before(secret.expiresAt) {
+		return secret.value, nil
+	}
+
+	keyVault, err := getKeyVaultClient(ref.IdentityClientID)
+	if err != nil {
+		return "", fmt.Errorf("could not create Key Vault client: %w", err)
+	}
+	value, err := keyVault.GetSecret(ctx, ref.VaultURI, ref.SecretName)
+	if err != nil {
+		return "", fmt.Errorf("could not read secret %q from Key Vault %s: %w", ref.SecretName, ref.VaultURI, err)
+	}
+
+	secret.value, secret.expiresAt = value, time.Now().Add(KeyVaultSecretTTL)
+	return value, nil
+}
+
+// InvalidateKeyVaultSecret drops the cached value of the referenced Key Vault secret so that it is read again on next use.
+func InvalidateKeyVaultSecret(ref KeyVaultSecretReference) {
+	keyVaultMutex.Lock()
+	defer keyVaultMutex.Unlock()
+
+	delete(keyVaultSecrets, ref)
+}
+
+func getKeyVaultSecret(ref KeyVaultSecretReference) *keyVaultSecret {
+	keyVaultMutex.Lock()
+	defer keyVaultMutex.Unlock()
+
+	secret, ok := keyVaultSecrets[ref]
+	if !ok {
+		secret = &keyVaultSecret{}
+		keyVaultSecrets[ref] = secret
+	}
+	return secret
+}
+
+// getKeyVaultClient returns the Key Vault client of the managed identity with the given client ID. The clients are
+// cached per identity, so that their access tokens are reused.
+func getKeyVaultClient(identityClientID string) (KeyVault, error) {
+	keyVaultMutex.Lock()
+	defer keyVaultMutex.Unlock()
+
+	if keyVault, ok := keyVaultClients[identityClientID]; ok {
+		return keyVault, nil
+	}
+	keyVault, err := DefaultKeyVaultFunc(identityClientID)
+	if err != nil {
+		return nil, err
+	}
+	keyVaultClients[identityClientID] = keyVault
+	return keyVault, nil
+}
+
+// keyVaultSecretCredential is a client secret credential whose secret is read from a Key Vault.
+type keyVaultSecretCredential struct {
+	tenantID string
+	clientID string
+	ref      KeyVaultSecretReference
+
+	mutex      sync.Mutex
+	secret     string
+	credential azcore.TokenCredential
+}
+
+func newKeyVaultSecretCredential(tenantID, clientID string, ref KeyVaultSecretReference) *keyVaultSecretCredential {
+	return &keyVaultSecretCredential{tenantID: tenantID, clientID: clientID, ref: ref}
+}
+
+// GetToken implements azcore.TokenCredential.
+func (c *keyVaultSecretCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	token, err := c.getToken(ctx, opts)
+
+	var authErr *azidentity.AuthenticationFailedError
+	if errors.As(err, &authErr) {
+		// The client secret might have been rotated in the Key Vault, hence read it again and retry once.
+		InvalidateKeyVaultSecret(c.ref)
+		return c.getToken(ctx, opts)
+	}
+	return token, err
+}
+
+func (c *keyVaultSecretCredential) getToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	secret, err := ResolveKeyVaultSecret(ctx, c.ref)
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+
+	c.mutex.Lock()
+	if c.credential == nil || c.secret != secret {
+		credential, err := azidentity.NewClientSecretCredential(c.tenantID, c.clientID, secret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: DataPlaneClientOptions(),
+		})
+		if err != nil {
+			c.mutex.Unlock()
+			return azcore.AccessToken{}, err
+		}
+		c.secret, c.credential = secret, credential
+	}
+	credential := c.credential
+	c.mutex.Unlock()
+
+	return credential.GetToken(ctx, opts)
+}
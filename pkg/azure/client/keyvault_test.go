@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+type fakeKeyVault struct {
+	mutex   sync.Mutex
+	secrets map[string]string
+	blocked map[string]chan struct{}
+	calls   int
+}
+
+func (f *fakeKeyVault) GetSecret(_ context.Context, vaultURI, secretName string) (string, error) {
+	f.mutex.Lock()
+	blocked := f.blocked[secretName]
+	f.mutex.Unlock()
+	if blocked != nil {
+		<-blocked
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.calls++
+	value, ok := f.secrets[vaultURI+"/"+secretName]
+	if !ok {
+		return "", errors.New("SecretNotFound")
+	}
+	return value, nil
+}
+
+var _ = Describe("Key Vault", func() {
+	var (
+		ctx context.Context
+
+		keyVault          *fakeKeyVault
+		identityClientIDs []string
+		ref               KeyVaultSecretReference
+
+		oldDefaultKeyVaultFunc func(string) (KeyVault, error)
+		identities             int
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		keyVault = &fakeKeyVault{secrets: map[string]string{"https://my-vault.vault.azure.net/client-secret": "secret-1"}}
+		identityClientIDs = nil
+		// the Key Vault clients are cached per identity, hence every test uses another one
+		identities++
+		ref = KeyVaultSecretReference{
+			VaultURI:         "https://my-vault.vault.azure.net",
+			SecretName:       "client-secret",
+			IdentityClientID: fmt.Sprintf("identity-client-id-%d", identities),
+		}
+
+		oldDefaultKeyVaultFunc = DefaultKeyVaultFunc
+		DefaultKeyVaultFunc = func(identityClientID string) (KeyVault, error) {
+			identityClientIDs = append(identityClientIDs, identityClientID)
+			return keyVault, nil
+		}
+	})
+
+	AfterEach(func() {
+		DefaultKeyVaultFunc = oldDefaultKeyVaultFunc
+		InvalidateKeyVaultSecret(ref)
+	})
+
+	Describe("#ResolveKeyVaultSecret", func() {
+		It("should read the secret with the bootstrap identity and cache it", func() {
+			value, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-1"))
+			Expect(identityClientIDs).To(ConsistOf(ref.IdentityClientID))
+
+			keyVault.secrets["https://my-vault.vault.azure.net/client-secret"] = "secret-2"
+			value, err = ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-1"))
+			Expect(keyVault.calls).To(Equal(1))
+		})
+
+		It("should read the secret again after it was invalidated", func() {
+			_, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+
+			keyVault.secrets["https://my-vault.vault.azure.net/client-secret"] = "secret-2"
+			InvalidateKeyVaultSecret(ref)
+
+			value, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-2"))
+			Expect(keyVault.calls).To(Equal(2))
+		})
+
+		It("should create the Key Vault client only once per identity", func() {
+			otherRef := ref
+			otherRef.SecretName = "other-secret"
+			keyVault.secrets["https://my-vault.vault.azure.net/other-secret"] = "secret-3"
+			DeferCleanup(InvalidateKeyVaultSecret, otherRef)
+
+			_, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			value, err := ResolveKeyVaultSecret(ctx, otherRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-3"))
+			Expect(identityClientIDs).To(ConsistOf(ref.IdentityClientID))
+			Expect(keyVault.calls).To(Equal(2))
+		})
+
+		It("should not wait for the reads of other secrets", func() {
+			otherRef := ref
+			otherRef.SecretName = "other-secret"
+			keyVault.secrets["https://my-vault.vault.azure.net/other-secret"] = "secret-3"
+			keyVault.blocked = map[string]chan struct{}{"other-secret": make(chan struct{})}
+			DeferCleanup(InvalidateKeyVaultSecret, otherRef)
+
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				value, err := ResolveKeyVaultSecret(ctx, otherRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal("secret-3"))
+			}()
+
+			value, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-1"))
+
+			close(keyVault.blocked["other-secret"])
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should not cache failures", func() {
+			ref.SecretName = "unknown"
+
+			_, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).To(MatchError(ContainSubstring(`could not read secret "unknown" from Key Vault https://my-vault.vault.azure.net`)))
+
+			keyVault.secrets["https://my-vault.vault.azure.net/unknown"] = "secret-3"
+			value, err := ResolveKeyVaultSecret(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secret-3"))
+		})
+	})
+
+	Describe("#GetAzClientCredentials", func() {
+		It("should not read the Key Vault secret before a token is requested", func() {
+			clientAuth := ClientAuth{
+				TenantID:          "tenant-id",
+				ClientID:          "client-id",
+				KeyVaultSecretRef: &ref,
+			}
+
+			cred, err := clientAuth.GetAzClientCredentials()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cred).NotTo(BeNil())
+			Expect(keyVault.calls).To(BeZero())
+		})
+	})
+})
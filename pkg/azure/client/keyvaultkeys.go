@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+var _ KeyVaultKeys = &KeyVaultKeysClient{}
+
+// KeyVaultKey is the public part of a Key Vault key.
+type KeyVaultKey struct {
+	// Key is the public key as JSON web key.
+	Key JSONWebKey `json:"key"`
+}
+
+// JSONWebKey is a JSON web key as returned by the Key Vault. Only the fields of RSA keys are included.
+type JSONWebKey struct {
+	// KID is the versioned identifier of the key.
+	KID string `json:"kid"`
+	// KTY is the type of the key, e.g. RSA or RSA-HSM.
+	KTY string `json:"kty"`
+	// KeyOps are the operations which are permitted with the key.
+	KeyOps []string `json:"key_ops,omitempty"`
+	// N is the base64url encoded modulus of an RSA key.
+	N string `json:"n,omitempty"`
+	// E is the base64url encoded public exponent of an RSA key.
+	E string `json:"e,omitempty"`
+}
+
+// KeyVaultKeysClient is a client for the keys of Azure Key Vaults.
+type KeyVaultKeysClient struct {
+	credential azcore.TokenCredential
+	opts       policy.ClientOptions
+}
+
+// NewKeyVaultKeysClient creates a new KeyVaultKeys client.
+func NewKeyVaultKeysClient(tc azcore.TokenCredential, opts *policy.ClientOptions) (*KeyVaultKeysClient, error) {
+	c := &KeyVaultKeysClient{credential: tc}
+	if opts != nil {
+		c.opts = *opts
+	}
+	return c, nil
+}
+
+// GetKey returns the key with the given identifier, e.g. https://my-vault.vault.azure.net/keys/my-key. If the identifier
+// contains no version, the current version of the key is returned. If the key does not exist nil is returned.
+func (c *KeyVaultKeysClient) GetKey(ctx context.Context, keyID string) (*KeyVaultKey, error) {
+	u, err := url.Parse(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Key Vault key identifier %q: %w", keyID, err)
+	}
+	scope, err := keyVaultScope(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := c.opts
+	opts.PerRetryPolicies = append(slices.Clone(opts.PerRetryPolicies), runtime.NewBearerTokenPolicy(c.credential, []string{scope}, nil))
+	pipeline := runtime.NewPipeline("keyvault", "v1", runtime.PipelineOptions{}, &opts)
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, u.Scheme+"://"+u.Host+u.EscapedPath())
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", keyVaultAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	key := &KeyVaultKey{}
+	if err := runtime.UnmarshalAsJSON(resp, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -6,9 +6,11 @@ package client
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 )
 
@@ -46,6 +48,29 @@ func (c *LoadBalancersClient) List(ctx context.Context, resourceGroupName string
 	return loadBalancers, nil
 }
 
+// CreateOrUpdate creates or updates a load balancer.
+func (c *LoadBalancersClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, poller)
+	if err != nil {
+		return nil, err
+	}
+	return &res.LoadBalancer, nil
+}
+
+// UpdateIfUnchanged updates the given load balancer unless it was changed since it was read, i.e. the request fails
+// with a precondition error if the etag of the load balancer differs from the one of the given load balancer. It
+// prevents overwriting the concurrent changes of other controllers which manage the same load balancer.
+func (c *LoadBalancersClient) UpdateIfUnchanged(ctx context.Context, resourceGroupName, name string, parameters armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	if parameters.Etag != nil {
+		ctx = policy.WithHTTPHeader(ctx, http.Header{"If-Match": []string{*parameters.Etag}})
+	}
+	return c.CreateOrUpdate(ctx, resourceGroupName, name, parameters)
+}
+
 // Delete deletes a subnet in a given virtual network.
 func (c *LoadBalancersClient) Delete(ctx context.Context, resourceGroupName, loadBalancerName string) error {
 	poller, err := c.client.BeginDelete(ctx, resourceGroupName, loadBalancerName, nil)
@@ -53,6 +78,6 @@ func (c *LoadBalancersClient) Delete(ctx context.Context, resourceGroupName, loa
 		return FilterNotFoundError(err)
 	}
 
-	_, err = poller.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, poller)
 	return err
 }
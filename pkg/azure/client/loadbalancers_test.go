@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("LoadBalancers", func() {
+	Describe("#UpdateIfUnchanged", func() {
+		It("should only update the load balancer if its etag is unchanged", func() {
+			transport := &recordingTransport{
+				statusCode: http.StatusOK,
+				body:       `{"name":"lb","etag":"W/\"2\""}`,
+			}
+			c, err := NewLoadBalancersClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			lb, err := c.UpdateIfUnchanged(context.Background(), "rg", "lb", armnetwork.LoadBalancer{Name: ptr.To("lb"), Etag: ptr.To(`W/"1"`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lb.Etag).To(Equal(ptr.To(`W/"2"`)))
+
+			Expect(transport.requests).To(HaveLen(1))
+			Expect(transport.requests[0].Method).To(Equal(http.MethodPut))
+			Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb"))
+			Expect(transport.requests[0].Header.Get("If-Match")).To(Equal(`W/"1"`))
+		})
+
+		It("should report a changed load balancer as failed precondition", func() {
+			transport := &recordingTransport{
+				statusCode: http.StatusPreconditionFailed,
+				body:       `{"error":{"code":"PreconditionFailed","message":"Precondition failed."}}`,
+			}
+			c, err := NewLoadBalancersClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = c.UpdateIfUnchanged(context.Background(), "rg", "lb", armnetwork.LoadBalancer{Name: ptr.To("lb"), Etag: ptr.To(`W/"1"`)})
+			Expect(IsAzureAPIPreconditionFailed(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+var _ LocalUsers = &LocalUsersClient{}
+
+// LocalUsersClient is a client for the local users of storage accounts.
+type LocalUsersClient struct {
+	client *armstorage.LocalUsersClient
+}
+
+// NewLocalUsersClient creates a new LocalUsersClient.
+func NewLocalUsersClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*LocalUsersClient, error) {
+	client, err := armstorage.NewLocalUsersClient(auth.SubscriptionID, tc, opts)
+	return &LocalUsersClient{client}, err
+}
+
+// CreateOrUpdate creates or replaces the local user <username> of the storage account <storageAccount> in the resource
+// group <resourceGroup>.
+func (c *LocalUsersClient) CreateOrUpdate(ctx context.Context, resourceGroup, storageAccount, username string, user armstorage.LocalUser) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroup, storageAccount, username, user, nil)
+	return err
+}
+
+// Delete deletes the local user <username> of the storage account <storageAccount> in the resource group
+// <resourceGroup>.
+func (c *LocalUsersClient) Delete(ctx context.Context, resourceGroup, storageAccount, username string) error {
+	_, err := c.client.Delete(ctx, resourceGroup, storageAccount, username, nil)
+	return FilterNotFoundError(err)
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	managementLocksAPIVersion = "2016-09-01"
+	// managementLocksPathSegment separates the scope of a management lock from its name in its resource ID.
+	managementLocksPathSegment = "/providers/Microsoft.Authorization/locks/"
+	// scopeLockedErrorCode is the error code with which Azure rejects operations which are blocked by a lock.
+	scopeLockedErrorCode = "ScopeLocked"
+)
+
+var (
+	// scopeLockedMessageRegexp matches the message of a ScopeLocked error, e.g. "The scope '<resource-id>' cannot
+	// perform delete operation because following scope(s) are locked: '<scope>'. Please remove the lock and try again."
+	scopeLockedMessageRegexp = regexp.MustCompile(`The scope '([^']+)' cannot perform (\w+) operation because following scope\(s\) are locked: ((?:'[^']+'[,\s]*)+)`)
+	quotedScopeRegexp        = regexp.MustCompile(`'([^']+)'`)
+)
+
+var _ ManagementLocks = &ManagementLocksClient{}
+
+// ManagementLock is a lock which prevents the deletion or the modification of the resources in its scope.
+type ManagementLock struct {
+	// ID is the resource ID of the lock.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the lock.
+	Name *string `json:"name,omitempty"`
+	// Properties are the properties of the lock.
+	Properties ManagementLockProperties `json:"properties"`
+}
+
+// ManagementLockProperties are the properties of a management lock.
+type ManagementLockProperties struct {
+	// Level is the level of the lock, i.e. CanNotDelete or ReadOnly.
+	Level *string `json:"level,omitempty"`
+	// Notes are the notes of the lock, which usually explain why it was applied.
+	Notes *string `json:"notes,omitempty"`
+}
+
+type managementLockList struct {
+	Value    []*ManagementLock `json:"value"`
+	NextLink *string           `json:"nextLink,omitempty"`
+}
+
+// ManagementLocksClient is a client for the management locks of Azure resources.
+type ManagementLocksClient struct {
+	client *arm.Client
+}
+
+// NewManagementLocksClient creates a new ManagementLocks client.
+func NewManagementLocksClient(tc azcore.TokenCredential, opts *arm.ClientOptions) (*ManagementLocksClient, error) {
+	client, err := arm.NewClient("armlocks.ManagementLocksClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementLocksClient{client: client}, nil
+}
+
+// ListAtScope returns the locks which apply to the given scope, i.e. the locks of the scope itself and the locks
+// which are inherited from the resource group and the subscription of the scope.
+func (c *ManagementLocksClient) ListAtScope(ctx context.Context, scope string) ([]*ManagementLock, error) {
+	if !strings.HasPrefix(scope, "/") {
+		return nil, fmt.Errorf("the scope of the management locks must be a resource ID, but got %q", scope)
+	}
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(c.client.Endpoint(), scope, "/providers/Microsoft.Authorization/locks"))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", managementLocksAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	var locks []*ManagementLock
+	for req != nil {
+		resp, err := c.client.Pipeline().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+
+		page := managementLockList{}
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		locks = append(locks, page.Value...)
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			if req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink); err != nil {
+				return nil, err
+			}
+			req.Raw().Header.Set("Accept", "application/json")
+		}
+	}
+	return locks, nil
+}
+
+// IsAzureAPIScopeLockedError tries to determine if the API error is due to a management lock which blocks the
+// operation.
+func IsAzureAPIScopeLockedError(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && respErr.ErrorCode == scopeLockedErrorCode
+}
+
+// ScopeLockedError is an error to indicate that Azure rejected an operation on a resource, because a management lock
+// applies to it, e.g. a CanNotDelete lock of its resource group.
+type ScopeLockedError struct {
+	// Scope is the resource ID of the resource which could not be modified.
+	Scope string
+	// Operation is the rejected operation, e.g. write or delete.
+	Operation string
+	// LockedScopes are the scopes whose locks blocked the operation.
+	LockedScopes []string
+	// Locks are the locks of the locked scopes. It is empty if the locks could not be listed.
+	Locks []ManagementLock
+	error
+}
+
+func (e *ScopeLockedError) Error() string {
+	if len(e.Locks) == 0 {
+		return fmt.Sprintf("the %s operation on resource %q is blocked by a management lock of [%s], please remove the lock or exclude the resource from it",
+			e.Operation, e.Scope, strings.Join(e.LockedScopes, ", "))
+	}
+
+	locks := make([]string, 0, len(e.Locks))
+	for _, lock := range e.Locks {
+		description := fmt.Sprintf("%s lock %q of %q", ptr.Deref(lock.Properties.Level, "unknown"), ptr.Deref(lock.Name, ""), managementLockScope(lock))
+		if notes := ptr.Deref(lock.Properties.Notes, ""); notes != "" {
+			description = fmt.Sprintf("%s (notes: %s)", description, notes)
+		}
+		locks = append(locks, description)
+	}
+	return fmt.Sprintf("the %s operation on resource %q is blocked by the %s, please remove the lock or exclude the resource from it",
+		e.Operation, e.Scope, strings.Join(locks, ", "))
+}
+
+func (e *ScopeLockedError) Unwrap() error {
+	return e.error
+}
+
+// Codes implements the Coder interface. The error is reported as a configuration problem which can resolve without a
+// change of the shoot, since the lock is removed outside of Gardener.
+func (e *ScopeLockedError) Codes() []gardencorev1beta1.ErrorCode {
+	return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorRetryableConfigurationProblem}
+}
+
+// DescribeScopeLockedError returns a ScopeLockedError which names the locked resource and the blocking locks if the
+// given error contains a ScopeLocked error of Azure, and the given error unchanged otherwise. The locks are looked up on
+// a best-effort basis, as listing them requires the permission Microsoft.Authorization/locks/read.
+func DescribeScopeLockedError(ctx context.Context, factory Factory, err error) error {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) || respErr.ErrorCode != scopeLockedErrorCode {
+		return err
+	}
+
+	matches := scopeLockedMessageRegexp.FindStringSubmatch(respErr.Error())
+	if matches == nil {
+		return err
+	}
+	lockedErr := &ScopeLockedError{Scope: matches[1], Operation: matches[2], error: err}
+	for _, quoted := range quotedScopeRegexp.FindAllStringSubmatch(matches[3], -1) {
+		lockedErr.LockedScopes = append(lockedErr.LockedScopes, quoted[1])
+	}
+
+	client, clientErr := factory.ManagementLocks()
+	if clientErr != nil {
+		return lockedErr
+	}
+	for _, scope := range lockedErr.LockedScopes {
+		locks, listErr := client.ListAtScope(ctx, scope)
+		if listErr != nil {
+			continue
+		}
+		for _, lock := range locks {
+			// the locks which are inherited from the parent scopes are listed as well.
+			if lock != nil && strings.EqualFold(managementLockScope(*lock), scope) {
+				lockedErr.Locks = append(lockedErr.Locks, *lock)
+			}
+		}
+	}
+	return lockedErr
+}
+
+// managementLockScope returns the scope of the given lock, which is the prefix of its resource ID.
+func managementLockScope(lock ManagementLock) string {
+	id := ptr.Deref(lock.ID, "")
+	if index := strings.Index(strings.ToLower(id), strings.ToLower(managementLocksPathSegment)); index >= 0 {
+		return id[:index]
+	}
+	return id
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+func scopeLockedResponseError(scope, operation, lockedScope string) error {
+	return runtime.NewResponseError(&http.Response{
+		StatusCode: http.StatusConflict,
+		Body: io.NopCloser(strings.NewReader(fmt.Sprintf(`{"error":{"code":"ScopeLocked","message":"The scope '%s' cannot perform %s operation because following scope(s) are locked: '%s'. Please remove the lock and try again."}}`,
+			scope, operation, lockedScope))),
+		Request: &http.Request{Method: http.MethodDelete, URL: &url.URL{Scheme: "https", Host: "management.azure.com", Path: scope}},
+	})
+}
+
+var _ = Describe("ManagementLocks", func() {
+	const (
+		resourceGroup = "/subscriptions/sub/resourceGroups/shoot--foo--bar"
+		subnet        = resourceGroup + "/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar/subnets/shoot--foo--bar-nodes"
+	)
+
+	var (
+		ctx         context.Context
+		ctrl        *gomock.Controller
+		factory     *mockclient.MockFactory
+		locksClient *mockclient.MockManagementLocks
+		lockedErr   error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		locksClient = mockclient.NewMockManagementLocks(ctrl)
+		lockedErr = scopeLockedResponseError(subnet, "delete", resourceGroup)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ListAtScope", func() {
+		It("should list the locks which apply to the scope", func() {
+			transport := &recordingTransport{
+				statusCode: http.StatusOK,
+				body:       `{"value":[{"id":"` + resourceGroup + `/providers/Microsoft.Authorization/locks/no-delete","name":"no-delete","properties":{"level":"CanNotDelete"}}]}`,
+			}
+			c, err := NewManagementLocksClient(fakeTokenCredential{}, clientOptions(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.ListAtScope(ctx, subnet)).To(ConsistOf(&ManagementLock{
+				ID:         ptr.To(resourceGroup + "/providers/Microsoft.Authorization/locks/no-delete"),
+				Name:       ptr.To("no-delete"),
+				Properties: ManagementLockProperties{Level: ptr.To("CanNotDelete")},
+			}))
+
+			Expect(transport.requests).To(HaveLen(1))
+			Expect(transport.requests[0].URL.Path).To(Equal(subnet + "/providers/Microsoft.Authorization/locks"))
+			Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2016-09-01"))
+			Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+		})
+
+		It("should reject a scope which is not a resource ID", func() {
+			c, err := NewManagementLocksClient(fakeTokenCredential{}, clientOptions(&recordingTransport{}))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = c.ListAtScope(ctx, "shoot--foo--bar")
+			Expect(err).To(MatchError(`the scope of the management locks must be a resource ID, but got "shoot--foo--bar"`))
+		})
+	})
+
+	Describe("#IsAzureAPIScopeLockedError", func() {
+		It("should detect ScopeLocked errors", func() {
+			Expect(IsAzureAPIScopeLockedError(fmt.Errorf("failed to delete subnet: %w", lockedErr))).To(BeTrue())
+			Expect(IsAzureAPIScopeLockedError(errors.New("ScopeLocked"))).To(BeFalse())
+		})
+	})
+
+	Describe("#DescribeScopeLockedError", func() {
+		It("should return other errors unchanged", func() {
+			err := errors.New("fake")
+			Expect(DescribeScopeLockedError(ctx, factory, err)).To(BeIdenticalTo(err))
+		})
+
+		It("should name the lock which blocks the operation", func() {
+			factory.EXPECT().ManagementLocks().Return(locksClient, nil)
+			locksClient.EXPECT().ListAtScope(ctx, resourceGroup).Return([]*ManagementLock{
+				{
+					ID:         ptr.To(resourceGroup + "/providers/Microsoft.Authorization/locks/no-delete"),
+					Name:       ptr.To("no-delete"),
+					Properties: ManagementLockProperties{Level: ptr.To("CanNotDelete"), Notes: ptr.To("required by policy")},
+				},
+				{
+					ID:         ptr.To("/subscriptions/sub/providers/Microsoft.Authorization/locks/inherited"),
+					Name:       ptr.To("inherited"),
+					Properties: ManagementLockProperties{Level: ptr.To("ReadOnly")},
+				},
+			}, nil)
+
+			err := DescribeScopeLockedError(ctx, factory, fmt.Errorf("failed to delete subnet: %w", lockedErr))
+			Expect(err).To(MatchError(fmt.Sprintf(`the delete operation on resource %q is blocked by the CanNotDelete lock "no-delete" of %q (notes: required by policy), please remove the lock or exclude the resource from it`,
+				subnet, resourceGroup)))
+
+			var scopeLockedErr *ScopeLockedError
+			Expect(errors.As(errors.Join(errors.New("other"), err), &scopeLockedErr)).To(BeTrue())
+			Expect(scopeLockedErr.Scope).To(Equal(subnet))
+			Expect(scopeLockedErr.LockedScopes).To(ConsistOf(resourceGroup))
+			Expect(scopeLockedErr.Codes()).To(ConsistOf(gardencorev1beta1.ErrorRetryableConfigurationProblem))
+			Expect(errors.Is(err, lockedErr)).To(BeTrue())
+		})
+
+		It("should name the locked scope if the locks cannot be listed", func() {
+			factory.EXPECT().ManagementLocks().Return(locksClient, nil)
+			locksClient.EXPECT().ListAtScope(ctx, resourceGroup).Return(nil, errors.New("AuthorizationFailed"))
+
+			Expect(DescribeScopeLockedError(ctx, factory, lockedErr)).To(MatchError(fmt.Sprintf(`the delete operation on resource %q is blocked by a management lock of [%s], please remove the lock or exclude the resource from it`,
+				subnet, resourceGroup)))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"slices"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
@@ -27,42 +28,77 @@ func NewManagementPoliciesClient(auth *ClientAuth, tc azcore.TokenCredential, op
 
 // CreateOrUpdate adds a lifecycle policy on the storage account <storageAccount> in the resource group <resourceGroup> to delete blobs
 // with the the tag `azure.BlobMarkedForDeletionTagKey: true` <daysAfterCreation> days after creation of the blob.
+// The other rules of the lifecycle policy of the storage account are kept.
 func (c *ManagementPoliciesClient) CreateOrUpdate(ctx context.Context, resourceGroup, storageAccount string, daysAfterCreation int) error {
-	_, err := c.client.CreateOrUpdate(ctx, resourceGroup, storageAccount, armstorage.ManagementPolicyNameDefault, armstorage.ManagementPolicy{
-		Properties: &armstorage.ManagementPolicyProperties{
-			Policy: &armstorage.ManagementPolicySchema{
-				Rules: []*armstorage.ManagementPolicyRule{
+	return c.SetRule(ctx, resourceGroup, storageAccount, &armstorage.ManagementPolicyRule{
+		Name: ptr.To(string(azure.BlobDeletionLifecyclePolicyName)),
+		Type: ptr.To(armstorage.RuleTypeLifecycle),
+		Definition: &armstorage.ManagementPolicyDefinition{
+			Actions: &armstorage.ManagementPolicyAction{
+				BaseBlob: &armstorage.ManagementPolicyBaseBlob{
+					Delete: &armstorage.DateAfterModification{
+						DaysAfterCreationGreaterThan: ptr.To(float32(daysAfterCreation)),
+					},
+				},
+			},
+			Filters: &armstorage.ManagementPolicyFilter{
+				// "blockBlob" mentioned in the SDK, they do not expose a constant unfortunately
+				BlobTypes:   []*string{ptr.To("blockBlob")},
+				PrefixMatch: []*string{ptr.To("")},
+				BlobIndexMatch: []*armstorage.TagFilter{
 					{
-						Name: ptr.To(string(azure.BlobDeletionLifecyclePolicyName)),
-						Type: ptr.To(armstorage.RuleTypeLifecycle),
-						Definition: &armstorage.ManagementPolicyDefinition{
-							Actions: &armstorage.ManagementPolicyAction{
-								BaseBlob: &armstorage.ManagementPolicyBaseBlob{
-									Delete: &armstorage.DateAfterModification{
-										DaysAfterCreationGreaterThan: ptr.To(float32(daysAfterCreation)),
-									},
-								},
-							},
-							Filters: &armstorage.ManagementPolicyFilter{
-								// "blockBlob" mentioned in the SDK, they do not expose a constant unfortunately
-								BlobTypes:   []*string{ptr.To("blockBlob")},
-								PrefixMatch: []*string{ptr.To("")},
-								BlobIndexMatch: []*armstorage.TagFilter{
-									{
-										Name: ptr.To(string(azure.BlobMarkedForDeletionTagKey)),
-										// "==" mentioned in the SDK, they do not expose a constant unfortunately
-										Op:    ptr.To("=="),
-										Value: ptr.To("true"),
-									},
-								},
-							},
-						},
-						Enabled: ptr.To(true),
+						Name: ptr.To(string(azure.BlobMarkedForDeletionTagKey)),
+						// "==" mentioned in the SDK, they do not expose a constant unfortunately
+						Op:    ptr.To("=="),
+						Value: ptr.To("true"),
 					},
 				},
 			},
 		},
-	}, nil)
+		Enabled: ptr.To(true),
+	})
+}
+
+// SetRule adds the given rule to the lifecycle policy of the storage account <storageAccount> in the resource group
+// <resourceGroup> or replaces the rule with the same name. The other rules of the policy are kept.
+func (c *ManagementPoliciesClient) SetRule(ctx context.Context, resourceGroup, storageAccount string, rule *armstorage.ManagementPolicyRule) error {
+	policy, err := c.Get(ctx, resourceGroup, storageAccount)
+	if err != nil {
+		return err
+	}
 
+	var rules []*armstorage.ManagementPolicyRule
+	if policy != nil && policy.Properties != nil && policy.Properties.Policy != nil {
+		rules = slices.DeleteFunc(slices.Clone(policy.Properties.Policy.Rules), func(r *armstorage.ManagementPolicyRule) bool {
+			return r != nil && ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "")
+		})
+	}
+
+	return c.Update(ctx, resourceGroup, storageAccount, armstorage.ManagementPolicy{
+		Properties: &armstorage.ManagementPolicyProperties{
+			Policy: &armstorage.ManagementPolicySchema{Rules: append(rules, rule)},
+		},
+	})
+}
+
+// Get returns the lifecycle policy of the storage account <storageAccount> in the resource group <resourceGroup> or
+// nil if the storage account has no lifecycle policy.
+func (c *ManagementPoliciesClient) Get(ctx context.Context, resourceGroup, storageAccount string) (*armstorage.ManagementPolicy, error) {
+	res, err := c.client.Get(ctx, resourceGroup, storageAccount, armstorage.ManagementPolicyNameDefault, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.ManagementPolicy, nil
+}
+
+// Update replaces the lifecycle policy of the storage account <storageAccount> in the resource group <resourceGroup>.
+func (c *ManagementPoliciesClient) Update(ctx context.Context, resourceGroup, storageAccount string, policy armstorage.ManagementPolicy) error {
+	_, err := c.client.CreateOrUpdate(ctx, resourceGroup, storageAccount, armstorage.ManagementPolicyNameDefault, policy, nil)
 	return err
 }
+
+// Delete deletes the lifecycle policy of the storage account <storageAccount> in the resource group <resourceGroup>.
+func (c *ManagementPoliciesClient) Delete(ctx context.Context, resourceGroup, storageAccount string) error {
+	_, err := c.client.Delete(ctx, resourceGroup, storageAccount, armstorage.ManagementPolicyNameDefault, nil)
+	return FilterNotFoundError(err)
+}
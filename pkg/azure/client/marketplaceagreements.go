@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const marketplaceAgreementsAPIVersion = "2021-01-01"
+
+var _ MarketplaceAgreements = &MarketplaceAgreementsClient{}
+
+// MarketplaceAgreement is the agreement of the terms of an Azure Marketplace plan for virtual machines.
+type MarketplaceAgreement struct {
+	// ID is the resource ID of the agreement.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the agreement.
+	Name *string `json:"name,omitempty"`
+	// Type is the resource type of the agreement.
+	Type *string `json:"type,omitempty"`
+	// Properties are the properties of the agreement.
+	Properties MarketplaceAgreementProperties `json:"properties"`
+}
+
+// MarketplaceAgreementProperties are the properties of a marketplace agreement. The properties returned by Azure must
+// be sent back unchanged, except for Accepted, to accept the agreement.
+type MarketplaceAgreementProperties struct {
+	// Publisher is the publisher of the image.
+	Publisher *string `json:"publisher,omitempty"`
+	// Product is the offer of the image.
+	Product *string `json:"product,omitempty"`
+	// Plan is the plan of the image.
+	Plan *string `json:"plan,omitempty"`
+	// LicenseTextLink is the link to the license text.
+	LicenseTextLink *string `json:"licenseTextLink,omitempty"`
+	// PrivacyPolicyLink is the link to the privacy policy.
+	PrivacyPolicyLink *string `json:"privacyPolicyLink,omitempty"`
+	// MarketplaceTermsLink is the link to the terms of the marketplace.
+	MarketplaceTermsLink *string `json:"marketplaceTermsLink,omitempty"`
+	// RetrieveDatetime is the time at which the terms were retrieved.
+	RetrieveDatetime *string `json:"retrieveDatetime,omitempty"`
+	// Signature is the signature of the retrieved terms.
+	Signature *string `json:"signature,omitempty"`
+	// Accepted is whether the terms are accepted for the subscription.
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// MarketplaceAgreementsClient is a client for the agreements of the terms of Azure Marketplace plans for virtual
+// machines in a subscription.
+type MarketplaceAgreementsClient struct {
+	client         *arm.Client
+	subscriptionID string
+}
+
+// NewMarketplaceAgreementsClient creates a new MarketplaceAgreements client.
+func NewMarketplaceAgreementsClient(auth *ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*MarketplaceAgreementsClient, error) {
+	client, err := arm.NewClient("armmarketplaceordering.MarketplaceAgreementsClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &MarketplaceAgreementsClient{client: client, subscriptionID: auth.SubscriptionID}, nil
+}
+
+// Get returns the current agreement of the given plan of the given publisher and offer.
+func (c *MarketplaceAgreementsClient) Get(ctx context.Context, publisher, offer, plan string) (*MarketplaceAgreement, error) {
+	resp, err := c.do(ctx, http.MethodGet, publisher, offer, plan, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	agreement := &MarketplaceAgreement{}
+	if err := runtime.UnmarshalAsJSON(resp, agreement); err != nil {
+		return nil, err
+	}
+	return agreement, nil
+}
+
+// Accept accepts the given agreement, which must have been returned by Get, and returns the accepted agreement.
+func (c *MarketplaceAgreementsClient) Accept(ctx context.Context, agreement MarketplaceAgreement) (*MarketplaceAgreement, error) {
+	props := agreement.Properties
+	if props.Publisher == nil || props.Product == nil || props.Plan == nil {
+		return nil, fmt.Errorf("publisher, product and plan of the marketplace agreement must be set")
+	}
+
+	agreement.Properties.Accepted = ptr.To(true)
+	resp, err := c.do(ctx, http.MethodPut, *props.Publisher, *props.Product, *props.Plan, &agreement)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	accepted := &MarketplaceAgreement{}
+	if err := runtime.UnmarshalAsJSON(resp, accepted); err != nil {
+		return nil, err
+	}
+	return accepted, nil
+}
+
+func (c *MarketplaceAgreementsClient) do(ctx context.Context, method, publisher, offer, plan string, body any) (*http.Response, error) {
+	if publisher == "" || offer == "" || plan == "" {
+		return nil, fmt.Errorf("publisher, offer and plan of the marketplace agreement must not be empty")
+	}
+
+	path := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.MarketplaceOrdering/offerTypes/virtualmachine/publishers/%s/offers/%s/plans/%s/agreements/current",
+		url.PathEscape(c.subscriptionID), url.PathEscape(publisher), url.PathEscape(offer), url.PathEscape(plan))
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.client.Endpoint(), path))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", marketplaceAgreementsAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Pipeline().Do(req)
+}
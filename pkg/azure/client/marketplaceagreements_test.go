@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("MarketplaceAgreements", func() {
+	const agreementPath = "/subscriptions/sub/providers/Microsoft.MarketplaceOrdering/offerTypes/virtualmachine/publishers/sap/offers/gardenlinux/plans/greatest/agreements/current"
+
+	It("should accept the agreement of a plan", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusOK,
+			body:       `{"name":"greatest","properties":{"publisher":"sap","product":"gardenlinux","plan":"greatest","accepted":true}}`,
+		}
+		c, err := NewMarketplaceAgreementsClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		agreement, err := c.Accept(context.Background(), MarketplaceAgreement{Properties: MarketplaceAgreementProperties{
+			Publisher: ptr.To("sap"),
+			Product:   ptr.To("gardenlinux"),
+			Plan:      ptr.To("greatest"),
+			Signature: ptr.To("signature"),
+			Accepted:  ptr.To(false),
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(agreement.Properties.Accepted).To(Equal(ptr.To(true)))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].Method).To(Equal(http.MethodPut))
+		Expect(transport.requests[0].URL.Path).To(Equal(agreementPath))
+		Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2021-01-01"))
+		Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+		body, err := io.ReadAll(transport.requests[0].Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"properties":{"publisher":"sap","product":"gardenlinux","plan":"greatest","signature":"signature","accepted":true}}`))
+	})
+
+	It("should fail if the agreement cannot be retrieved", func() {
+		transport := &recordingTransport{statusCode: http.StatusForbidden, body: `{"error":{"code":"AuthorizationFailed"}}`}
+		c, err := NewMarketplaceAgreementsClient(&ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.Get(context.Background(), "sap", "gardenlinux", "greatest")
+		Expect(err).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		Expect(transport.requests[0].URL.Path).To(Equal(agreementPath))
+	})
+})
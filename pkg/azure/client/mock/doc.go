@@ -2,6 +2,6 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-//go:generate mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,NetworkSecurityGroup,ManagedUserIdentity,StorageAccount,BlobContainers,ManagementPolicies
+//go:generate mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,PublicIPPrefix,NetworkSecurityGroup,ManagedUserIdentity,FederatedIdentityCredentials,StorageAccount,BlobContainers,ManagementPolicies,LocalUsers,ObjectReplication,BlobServices,Providers,LoadBalancer,ServiceEndpointPolicy,Usage,NetworkWatcher,FlowLog,DiagnosticSettings,PrivateLinkService,PrivateEndpoint,NetworkInterface,PrivateDNSZones,VirtualNetworkLinks,DataCollectionRules,RoleAssignments,KeyVaultKeys,VirtualMachineImages,GalleryImageVersions,MarketplaceAgreements,ManagementLocks,Disk,VirtualMachine,VirtualMachineExtensions,Resource
 
 package client
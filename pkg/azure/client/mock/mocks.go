@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,NetworkSecurityGroup,ManagedUserIdentity,StorageAccount,BlobContainers,ManagementPolicies)
+// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,PublicIPPrefix,NetworkSecurityGroup,ManagedUserIdentity,FederatedIdentityCredentials,StorageAccount,BlobContainers,ManagementPolicies,LocalUsers,ObjectReplication,BlobServices,Providers,LoadBalancer,ServiceEndpointPolicy,Usage,NetworkWatcher,FlowLog,DiagnosticSettings,PrivateLinkService,PrivateEndpoint,NetworkInterface,PrivateDNSZones,VirtualNetworkLinks,DataCollectionRules,RoleAssignments,KeyVaultKeys,VirtualMachineImages,GalleryImageVersions,MarketplaceAgreements,ManagementLocks,Disk,VirtualMachine,VirtualMachineExtensions,Resource)
 //
 // Generated by this command:
 //
-//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,NetworkSecurityGroup,ManagedUserIdentity,StorageAccount,BlobContainers,ManagementPolicies
+//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,PublicIPPrefix,NetworkSecurityGroup,ManagedUserIdentity,FederatedIdentityCredentials,StorageAccount,BlobContainers,ManagementPolicies,LocalUsers,ObjectReplication,BlobServices,Providers,LoadBalancer,ServiceEndpointPolicy,Usage,NetworkWatcher,FlowLog,DiagnosticSettings,PrivateLinkService,PrivateEndpoint,NetworkInterface,PrivateDNSZones,VirtualNetworkLinks,DataCollectionRules,RoleAssignments,KeyVaultKeys,VirtualMachineImages,GalleryImageVersions,MarketplaceAgreements,ManagementLocks,Disk,VirtualMachine,VirtualMachineExtensions,Resource
 //
 
 // Package client is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	armmsi "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	armresources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
@@ -45,6 +46,20 @@ func (m *MockDNSZone) EXPECT() *MockDNSZoneMockRecorder {
 	return m.recorder
 }
 
+// AddTags mocks base method.
+func (m *MockDNSZone) AddTags(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockDNSZoneMockRecorder) AddTags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockDNSZone)(nil).AddTags), arg0, arg1, arg2)
+}
+
 // List mocks base method.
 func (m *MockDNSZone) List(arg0 context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -60,6 +75,21 @@ func (mr *MockDNSZoneMockRecorder) List(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDNSZone)(nil).List), arg0)
 }
 
+// ListByResourceGroup mocks base method.
+func (m *MockDNSZone) ListByResourceGroup(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockDNSZoneMockRecorder) ListByResourceGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*MockDNSZone)(nil).ListByResourceGroup), arg0, arg1)
+}
+
 // MockDNSRecordSet is a mock of DNSRecordSet interface.
 type MockDNSRecordSet struct {
 	ctrl     *gomock.Controller
@@ -85,17 +115,17 @@ func (m *MockDNSRecordSet) EXPECT() *MockDNSRecordSetMockRecorder {
 }
 
 // CreateOrUpdate mocks base method.
-func (m *MockDNSRecordSet) CreateOrUpdate(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64) error {
+func (m *MockDNSRecordSet) CreateOrUpdate(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64, arg6 map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrUpdate indicates an expected call of CreateOrUpdate.
-func (mr *MockDNSRecordSetMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+func (mr *MockDNSRecordSetMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDNSRecordSet)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDNSRecordSet)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
 // Delete mocks base method.
@@ -234,6 +264,21 @@ func (mr *MockFactoryMockRecorder) BlobContainers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobContainers", reflect.TypeOf((*MockFactory)(nil).BlobContainers))
 }
 
+// BlobServices mocks base method.
+func (m *MockFactory) BlobServices() (client.BlobServices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobServices")
+	ret0, _ := ret[0].(client.BlobServices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobServices indicates an expected call of BlobServices.
+func (mr *MockFactoryMockRecorder) BlobServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobServices", reflect.TypeOf((*MockFactory)(nil).BlobServices))
+}
+
 // DNSRecordSet mocks base method.
 func (m *MockFactory) DNSRecordSet() (client.DNSRecordSet, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +309,36 @@ func (mr *MockFactoryMockRecorder) DNSZone() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNSZone", reflect.TypeOf((*MockFactory)(nil).DNSZone))
 }
 
+// DataCollectionRules mocks base method.
+func (m *MockFactory) DataCollectionRules() (client.DataCollectionRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataCollectionRules")
+	ret0, _ := ret[0].(client.DataCollectionRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DataCollectionRules indicates an expected call of DataCollectionRules.
+func (mr *MockFactoryMockRecorder) DataCollectionRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataCollectionRules", reflect.TypeOf((*MockFactory)(nil).DataCollectionRules))
+}
+
+// DiagnosticSettings mocks base method.
+func (m *MockFactory) DiagnosticSettings() (client.DiagnosticSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiagnosticSettings")
+	ret0, _ := ret[0].(client.DiagnosticSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiagnosticSettings indicates an expected call of DiagnosticSettings.
+func (mr *MockFactoryMockRecorder) DiagnosticSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiagnosticSettings", reflect.TypeOf((*MockFactory)(nil).DiagnosticSettings))
+}
+
 // Disk mocks base method.
 func (m *MockFactory) Disk() (client.Disk, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +354,51 @@ func (mr *MockFactoryMockRecorder) Disk() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disk", reflect.TypeOf((*MockFactory)(nil).Disk))
 }
 
+// FederatedIdentityCredentials mocks base method.
+func (m *MockFactory) FederatedIdentityCredentials() (client.FederatedIdentityCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FederatedIdentityCredentials")
+	ret0, _ := ret[0].(client.FederatedIdentityCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FederatedIdentityCredentials indicates an expected call of FederatedIdentityCredentials.
+func (mr *MockFactoryMockRecorder) FederatedIdentityCredentials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FederatedIdentityCredentials", reflect.TypeOf((*MockFactory)(nil).FederatedIdentityCredentials))
+}
+
+// FlowLog mocks base method.
+func (m *MockFactory) FlowLog() (client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlowLog")
+	ret0, _ := ret[0].(client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlowLog indicates an expected call of FlowLog.
+func (mr *MockFactoryMockRecorder) FlowLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlowLog", reflect.TypeOf((*MockFactory)(nil).FlowLog))
+}
+
+// GalleryImageVersions mocks base method.
+func (m *MockFactory) GalleryImageVersions() (client.GalleryImageVersions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GalleryImageVersions")
+	ret0, _ := ret[0].(client.GalleryImageVersions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GalleryImageVersions indicates an expected call of GalleryImageVersions.
+func (mr *MockFactoryMockRecorder) GalleryImageVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GalleryImageVersions", reflect.TypeOf((*MockFactory)(nil).GalleryImageVersions))
+}
+
 // Group mocks base method.
 func (m *MockFactory) Group() (client.ResourceGroup, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +414,21 @@ func (mr *MockFactoryMockRecorder) Group() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockFactory)(nil).Group))
 }
 
+// KeyVaultKeys mocks base method.
+func (m *MockFactory) KeyVaultKeys() (client.KeyVaultKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyVaultKeys")
+	ret0, _ := ret[0].(client.KeyVaultKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KeyVaultKeys indicates an expected call of KeyVaultKeys.
+func (mr *MockFactoryMockRecorder) KeyVaultKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyVaultKeys", reflect.TypeOf((*MockFactory)(nil).KeyVaultKeys))
+}
+
 // LoadBalancer mocks base method.
 func (m *MockFactory) LoadBalancer() (client.LoadBalancer, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +444,21 @@ func (mr *MockFactoryMockRecorder) LoadBalancer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MockFactory)(nil).LoadBalancer))
 }
 
+// LocalUsers mocks base method.
+func (m *MockFactory) LocalUsers() (client.LocalUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocalUsers")
+	ret0, _ := ret[0].(client.LocalUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocalUsers indicates an expected call of LocalUsers.
+func (mr *MockFactoryMockRecorder) LocalUsers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalUsers", reflect.TypeOf((*MockFactory)(nil).LocalUsers))
+}
+
 // ManagedUserIdentity mocks base method.
 func (m *MockFactory) ManagedUserIdentity() (client.ManagedUserIdentity, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +474,21 @@ func (mr *MockFactoryMockRecorder) ManagedUserIdentity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedUserIdentity", reflect.TypeOf((*MockFactory)(nil).ManagedUserIdentity))
 }
 
+// ManagementLocks mocks base method.
+func (m *MockFactory) ManagementLocks() (client.ManagementLocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagementLocks")
+	ret0, _ := ret[0].(client.ManagementLocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagementLocks indicates an expected call of ManagementLocks.
+func (mr *MockFactoryMockRecorder) ManagementLocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagementLocks", reflect.TypeOf((*MockFactory)(nil).ManagementLocks))
+}
+
 // ManagementPolicies mocks base method.
 func (m *MockFactory) ManagementPolicies() (client.ManagementPolicies, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +504,21 @@ func (mr *MockFactoryMockRecorder) ManagementPolicies() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagementPolicies", reflect.TypeOf((*MockFactory)(nil).ManagementPolicies))
 }
 
+// MarketplaceAgreements mocks base method.
+func (m *MockFactory) MarketplaceAgreements() (client.MarketplaceAgreements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarketplaceAgreements")
+	ret0, _ := ret[0].(client.MarketplaceAgreements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarketplaceAgreements indicates an expected call of MarketplaceAgreements.
+func (mr *MockFactoryMockRecorder) MarketplaceAgreements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarketplaceAgreements", reflect.TypeOf((*MockFactory)(nil).MarketplaceAgreements))
+}
+
 // NatGateway mocks base method.
 func (m *MockFactory) NatGateway() (client.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -384,6 +564,111 @@ func (mr *MockFactoryMockRecorder) NetworkSecurityGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkSecurityGroup", reflect.TypeOf((*MockFactory)(nil).NetworkSecurityGroup))
 }
 
+// NetworkWatcher mocks base method.
+func (m *MockFactory) NetworkWatcher() (client.NetworkWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkWatcher")
+	ret0, _ := ret[0].(client.NetworkWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkWatcher indicates an expected call of NetworkWatcher.
+func (mr *MockFactoryMockRecorder) NetworkWatcher() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkWatcher", reflect.TypeOf((*MockFactory)(nil).NetworkWatcher))
+}
+
+// ObjectReplication mocks base method.
+func (m *MockFactory) ObjectReplication() (client.ObjectReplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectReplication")
+	ret0, _ := ret[0].(client.ObjectReplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectReplication indicates an expected call of ObjectReplication.
+func (mr *MockFactoryMockRecorder) ObjectReplication() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectReplication", reflect.TypeOf((*MockFactory)(nil).ObjectReplication))
+}
+
+// PrivateDNSRecordSet mocks base method.
+func (m *MockFactory) PrivateDNSRecordSet() (client.DNSRecordSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateDNSRecordSet")
+	ret0, _ := ret[0].(client.DNSRecordSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateDNSRecordSet indicates an expected call of PrivateDNSRecordSet.
+func (mr *MockFactoryMockRecorder) PrivateDNSRecordSet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateDNSRecordSet", reflect.TypeOf((*MockFactory)(nil).PrivateDNSRecordSet))
+}
+
+// PrivateDNSZones mocks base method.
+func (m *MockFactory) PrivateDNSZones() (client.PrivateDNSZones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateDNSZones")
+	ret0, _ := ret[0].(client.PrivateDNSZones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateDNSZones indicates an expected call of PrivateDNSZones.
+func (mr *MockFactoryMockRecorder) PrivateDNSZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateDNSZones", reflect.TypeOf((*MockFactory)(nil).PrivateDNSZones))
+}
+
+// PrivateEndpoint mocks base method.
+func (m *MockFactory) PrivateEndpoint() (client.PrivateEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpoint")
+	ret0, _ := ret[0].(client.PrivateEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpoint indicates an expected call of PrivateEndpoint.
+func (mr *MockFactoryMockRecorder) PrivateEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpoint", reflect.TypeOf((*MockFactory)(nil).PrivateEndpoint))
+}
+
+// PrivateLinkService mocks base method.
+func (m *MockFactory) PrivateLinkService() (client.PrivateLinkService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateLinkService")
+	ret0, _ := ret[0].(client.PrivateLinkService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateLinkService indicates an expected call of PrivateLinkService.
+func (mr *MockFactoryMockRecorder) PrivateLinkService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateLinkService", reflect.TypeOf((*MockFactory)(nil).PrivateLinkService))
+}
+
+// Providers mocks base method.
+func (m *MockFactory) Providers() (client.Providers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Providers")
+	ret0, _ := ret[0].(client.Providers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Providers indicates an expected call of Providers.
+func (mr *MockFactoryMockRecorder) Providers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Providers", reflect.TypeOf((*MockFactory)(nil).Providers))
+}
+
 // PublicIP mocks base method.
 func (m *MockFactory) PublicIP() (client.PublicIP, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +684,21 @@ func (mr *MockFactoryMockRecorder) PublicIP() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockFactory)(nil).PublicIP))
 }
 
+// PublicIPPrefix mocks base method.
+func (m *MockFactory) PublicIPPrefix() (client.PublicIPPrefix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicIPPrefix")
+	ret0, _ := ret[0].(client.PublicIPPrefix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicIPPrefix indicates an expected call of PublicIPPrefix.
+func (mr *MockFactoryMockRecorder) PublicIPPrefix() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIPPrefix", reflect.TypeOf((*MockFactory)(nil).PublicIPPrefix))
+}
+
 // Resource mocks base method.
 func (m *MockFactory) Resource() (client.Resource, error) {
 	m.ctrl.T.Helper()
@@ -414,6 +714,21 @@ func (mr *MockFactoryMockRecorder) Resource() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resource", reflect.TypeOf((*MockFactory)(nil).Resource))
 }
 
+// RoleAssignments mocks base method.
+func (m *MockFactory) RoleAssignments() (client.RoleAssignments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleAssignments")
+	ret0, _ := ret[0].(client.RoleAssignments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleAssignments indicates an expected call of RoleAssignments.
+func (mr *MockFactoryMockRecorder) RoleAssignments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleAssignments", reflect.TypeOf((*MockFactory)(nil).RoleAssignments))
+}
+
 // RouteTables mocks base method.
 func (m *MockFactory) RouteTables() (client.RouteTables, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +744,21 @@ func (mr *MockFactoryMockRecorder) RouteTables() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteTables", reflect.TypeOf((*MockFactory)(nil).RouteTables))
 }
 
+// ServiceEndpointPolicy mocks base method.
+func (m *MockFactory) ServiceEndpointPolicy() (client.ServiceEndpointPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceEndpointPolicy")
+	ret0, _ := ret[0].(client.ServiceEndpointPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceEndpointPolicy indicates an expected call of ServiceEndpointPolicy.
+func (mr *MockFactoryMockRecorder) ServiceEndpointPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEndpointPolicy", reflect.TypeOf((*MockFactory)(nil).ServiceEndpointPolicy))
+}
+
 // StorageAccount mocks base method.
 func (m *MockFactory) StorageAccount() (client.StorageAccount, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +789,21 @@ func (mr *MockFactoryMockRecorder) Subnet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnet", reflect.TypeOf((*MockFactory)(nil).Subnet))
 }
 
+// Usage mocks base method.
+func (m *MockFactory) Usage() (client.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage")
+	ret0, _ := ret[0].(client.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockFactoryMockRecorder) Usage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockFactory)(nil).Usage))
+}
+
 // VirtualMachine mocks base method.
 func (m *MockFactory) VirtualMachine() (client.VirtualMachine, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +819,21 @@ func (mr *MockFactoryMockRecorder) VirtualMachine() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockFactory)(nil).VirtualMachine))
 }
 
+// VirtualMachineExtensions mocks base method.
+func (m *MockFactory) VirtualMachineExtensions() (client.VirtualMachineExtensions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineExtensions")
+	ret0, _ := ret[0].(client.VirtualMachineExtensions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VirtualMachineExtensions indicates an expected call of VirtualMachineExtensions.
+func (mr *MockFactoryMockRecorder) VirtualMachineExtensions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineExtensions", reflect.TypeOf((*MockFactory)(nil).VirtualMachineExtensions))
+}
+
 // VirtualMachineImages mocks base method.
 func (m *MockFactory) VirtualMachineImages() (client.VirtualMachineImages, error) {
 	m.ctrl.T.Helper()
@@ -489,6 +849,21 @@ func (mr *MockFactoryMockRecorder) VirtualMachineImages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineImages", reflect.TypeOf((*MockFactory)(nil).VirtualMachineImages))
 }
 
+// VirtualNetworkLinks mocks base method.
+func (m *MockFactory) VirtualNetworkLinks() (client.VirtualNetworkLinks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualNetworkLinks")
+	ret0, _ := ret[0].(client.VirtualNetworkLinks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VirtualNetworkLinks indicates an expected call of VirtualNetworkLinks.
+func (mr *MockFactoryMockRecorder) VirtualNetworkLinks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualNetworkLinks", reflect.TypeOf((*MockFactory)(nil).VirtualNetworkLinks))
+}
+
 // Vmss mocks base method.
 func (m *MockFactory) Vmss() (client.Vmss, error) {
 	m.ctrl.T.Helper()
@@ -904,6 +1279,45 @@ func (mr *MockPublicIPMockRecorder) List(ctx, resourceGroupName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPublicIP)(nil).List), ctx, resourceGroupName)
 }
 
+// MockPublicIPPrefix is a mock of PublicIPPrefix interface.
+type MockPublicIPPrefix struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicIPPrefixMockRecorder
+	isgomock struct{}
+}
+
+// MockPublicIPPrefixMockRecorder is the mock recorder for MockPublicIPPrefix.
+type MockPublicIPPrefixMockRecorder struct {
+	mock *MockPublicIPPrefix
+}
+
+// NewMockPublicIPPrefix creates a new mock instance.
+func NewMockPublicIPPrefix(ctrl *gomock.Controller) *MockPublicIPPrefix {
+	mock := &MockPublicIPPrefix{ctrl: ctrl}
+	mock.recorder = &MockPublicIPPrefixMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicIPPrefix) EXPECT() *MockPublicIPPrefixMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPublicIPPrefix) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.PublicIPPrefix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.PublicIPPrefix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPublicIPPrefixMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPublicIPPrefix)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
 // MockNetworkSecurityGroup is a mock of NetworkSecurityGroup interface.
 type MockNetworkSecurityGroup struct {
 	ctrl     *gomock.Controller
@@ -1011,42 +1425,157 @@ func (mr *MockManagedUserIdentityMockRecorder) Get(ctx, resourceGroupName, resou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagedUserIdentity)(nil).Get), ctx, resourceGroupName, resourceName)
 }
 
-// MockStorageAccount is a mock of StorageAccount interface.
-type MockStorageAccount struct {
+// MockFederatedIdentityCredentials is a mock of FederatedIdentityCredentials interface.
+type MockFederatedIdentityCredentials struct {
 	ctrl     *gomock.Controller
-	recorder *MockStorageAccountMockRecorder
+	recorder *MockFederatedIdentityCredentialsMockRecorder
 	isgomock struct{}
 }
 
-// MockStorageAccountMockRecorder is the mock recorder for MockStorageAccount.
-type MockStorageAccountMockRecorder struct {
-	mock *MockStorageAccount
+// MockFederatedIdentityCredentialsMockRecorder is the mock recorder for MockFederatedIdentityCredentials.
+type MockFederatedIdentityCredentialsMockRecorder struct {
+	mock *MockFederatedIdentityCredentials
 }
 
-// NewMockStorageAccount creates a new mock instance.
-func NewMockStorageAccount(ctrl *gomock.Controller) *MockStorageAccount {
-	mock := &MockStorageAccount{ctrl: ctrl}
-	mock.recorder = &MockStorageAccountMockRecorder{mock}
+// NewMockFederatedIdentityCredentials creates a new mock instance.
+func NewMockFederatedIdentityCredentials(ctrl *gomock.Controller) *MockFederatedIdentityCredentials {
+	mock := &MockFederatedIdentityCredentials{ctrl: ctrl}
+	mock.recorder = &MockFederatedIdentityCredentialsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStorageAccount) EXPECT() *MockStorageAccountMockRecorder {
+func (m *MockFederatedIdentityCredentials) EXPECT() *MockFederatedIdentityCredentialsMockRecorder {
 	return m.recorder
 }
 
-// CreateOrUpdateStorageAccount mocks base method.
-func (m *MockStorageAccount) CreateOrUpdateStorageAccount(arg0 context.Context, arg1, arg2, arg3 string, arg4 *int32) error {
+// CreateOrUpdate mocks base method.
+func (m *MockFederatedIdentityCredentials) CreateOrUpdate(ctx context.Context, resourceGroupName, parentResourceName, resourceName string, resourceParam armmsi.FederatedIdentityCredential) (*armmsi.FederatedIdentityCredential, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateStorageAccount", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armmsi.FederatedIdentityCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockFederatedIdentityCredentialsMockRecorder) CreateOrUpdate(ctx, resourceGroupName, parentResourceName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockFederatedIdentityCredentials)(nil).CreateOrUpdate), ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockFederatedIdentityCredentials) Delete(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, parentResourceName, resourceName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateOrUpdateStorageAccount indicates an expected call of CreateOrUpdateStorageAccount.
-func (mr *MockStorageAccountMockRecorder) CreateOrUpdateStorageAccount(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockFederatedIdentityCredentialsMockRecorder) Delete(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateStorageAccount", reflect.TypeOf((*MockStorageAccount)(nil).CreateOrUpdateStorageAccount), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFederatedIdentityCredentials)(nil).Delete), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockFederatedIdentityCredentials) Get(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) (*armmsi.FederatedIdentityCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(*armmsi.FederatedIdentityCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFederatedIdentityCredentialsMockRecorder) Get(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFederatedIdentityCredentials)(nil).Get), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// MockStorageAccount is a mock of StorageAccount interface.
+type MockStorageAccount struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageAccountMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageAccountMockRecorder is the mock recorder for MockStorageAccount.
+type MockStorageAccountMockRecorder struct {
+	mock *MockStorageAccount
+}
+
+// NewMockStorageAccount creates a new mock instance.
+func NewMockStorageAccount(ctrl *gomock.Controller) *MockStorageAccount {
+	mock := &MockStorageAccount{ctrl: ctrl}
+	mock.recorder = &MockStorageAccountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageAccount) EXPECT() *MockStorageAccountMockRecorder {
+	return m.recorder
+}
+
+// CheckNameAvailability mocks base method.
+func (m *MockStorageAccount) CheckNameAvailability(arg0 context.Context, arg1 string) (bool, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckNameAvailability", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CheckNameAvailability indicates an expected call of CheckNameAvailability.
+func (mr *MockStorageAccountMockRecorder) CheckNameAvailability(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckNameAvailability", reflect.TypeOf((*MockStorageAccount)(nil).CheckNameAvailability), arg0, arg1)
+}
+
+// CreateOrUpdateStorageAccount mocks base method.
+func (m *MockStorageAccount) CreateOrUpdateStorageAccount(arg0 context.Context, arg1, arg2, arg3 string, arg4 client.StorageAccountOptions) (*armstorage.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateStorageAccount", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*armstorage.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateStorageAccount indicates an expected call of CreateOrUpdateStorageAccount.
+func (mr *MockStorageAccountMockRecorder) CreateOrUpdateStorageAccount(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateStorageAccount", reflect.TypeOf((*MockStorageAccount)(nil).CreateOrUpdateStorageAccount), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetGeoReplicationStats mocks base method.
+func (m *MockStorageAccount) GetGeoReplicationStats(arg0 context.Context, arg1, arg2 string) (*armstorage.GeoReplicationStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGeoReplicationStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armstorage.GeoReplicationStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGeoReplicationStats indicates an expected call of GetGeoReplicationStats.
+func (mr *MockStorageAccountMockRecorder) GetGeoReplicationStats(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGeoReplicationStats", reflect.TypeOf((*MockStorageAccount)(nil).GetGeoReplicationStats), arg0, arg1, arg2)
+}
+
+// GetStorageAccount mocks base method.
+func (m *MockStorageAccount) GetStorageAccount(arg0 context.Context, arg1, arg2 string) (*armstorage.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armstorage.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageAccount indicates an expected call of GetStorageAccount.
+func (mr *MockStorageAccountMockRecorder) GetStorageAccount(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageAccount", reflect.TypeOf((*MockStorageAccount)(nil).GetStorageAccount), arg0, arg1, arg2)
 }
 
 // ListStorageAccountKeys mocks base method.
@@ -1161,6 +1690,20 @@ func (mr *MockBlobContainersMockRecorder) DeleteImmutabilityPolicy(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImmutabilityPolicy", reflect.TypeOf((*MockBlobContainers)(nil).DeleteImmutabilityPolicy), arg0, arg1, arg2, arg3, arg4)
 }
 
+// DisallowContainerPublicAccess mocks base method.
+func (m *MockBlobContainers) DisallowContainerPublicAccess(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisallowContainerPublicAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisallowContainerPublicAccess indicates an expected call of DisallowContainerPublicAccess.
+func (mr *MockBlobContainersMockRecorder) DisallowContainerPublicAccess(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisallowContainerPublicAccess", reflect.TypeOf((*MockBlobContainers)(nil).DisallowContainerPublicAccess), arg0, arg1, arg2, arg3)
+}
+
 // ExtendImmutabilityPolicy mocks base method.
 func (m *MockBlobContainers) ExtendImmutabilityPolicy(arg0 context.Context, arg1, arg2, arg3 string, arg4 *int32, arg5 *string) error {
 	m.ctrl.T.Helper()
@@ -1258,3 +1801,1833 @@ func (mr *MockManagementPoliciesMockRecorder) CreateOrUpdate(arg0, arg1, arg2, a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockManagementPolicies)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3)
 }
+
+// Delete mocks base method.
+func (m *MockManagementPolicies) Delete(ctx context.Context, resourceGroup, storageAccount string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroup, storageAccount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManagementPoliciesMockRecorder) Delete(ctx, resourceGroup, storageAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManagementPolicies)(nil).Delete), ctx, resourceGroup, storageAccount)
+}
+
+// Get mocks base method.
+func (m *MockManagementPolicies) Get(ctx context.Context, resourceGroup, storageAccount string) (*armstorage.ManagementPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroup, storageAccount)
+	ret0, _ := ret[0].(*armstorage.ManagementPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockManagementPoliciesMockRecorder) Get(ctx, resourceGroup, storageAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagementPolicies)(nil).Get), ctx, resourceGroup, storageAccount)
+}
+
+// SetRule mocks base method.
+func (m *MockManagementPolicies) SetRule(ctx context.Context, resourceGroup, storageAccount string, rule *armstorage.ManagementPolicyRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRule", ctx, resourceGroup, storageAccount, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRule indicates an expected call of SetRule.
+func (mr *MockManagementPoliciesMockRecorder) SetRule(ctx, resourceGroup, storageAccount, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRule", reflect.TypeOf((*MockManagementPolicies)(nil).SetRule), ctx, resourceGroup, storageAccount, rule)
+}
+
+// Update mocks base method.
+func (m *MockManagementPolicies) Update(ctx context.Context, resourceGroup, storageAccount string, policy armstorage.ManagementPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, resourceGroup, storageAccount, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockManagementPoliciesMockRecorder) Update(ctx, resourceGroup, storageAccount, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockManagementPolicies)(nil).Update), ctx, resourceGroup, storageAccount, policy)
+}
+
+// MockLocalUsers is a mock of LocalUsers interface.
+type MockLocalUsers struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocalUsersMockRecorder
+	isgomock struct{}
+}
+
+// MockLocalUsersMockRecorder is the mock recorder for MockLocalUsers.
+type MockLocalUsersMockRecorder struct {
+	mock *MockLocalUsers
+}
+
+// NewMockLocalUsers creates a new mock instance.
+func NewMockLocalUsers(ctrl *gomock.Controller) *MockLocalUsers {
+	mock := &MockLocalUsers{ctrl: ctrl}
+	mock.recorder = &MockLocalUsersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocalUsers) EXPECT() *MockLocalUsersMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockLocalUsers) CreateOrUpdate(ctx context.Context, resourceGroup, storageAccount, username string, user armstorage.LocalUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroup, storageAccount, username, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockLocalUsersMockRecorder) CreateOrUpdate(ctx, resourceGroup, storageAccount, username, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockLocalUsers)(nil).CreateOrUpdate), ctx, resourceGroup, storageAccount, username, user)
+}
+
+// Delete mocks base method.
+func (m *MockLocalUsers) Delete(ctx context.Context, resourceGroup, storageAccount, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroup, storageAccount, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLocalUsersMockRecorder) Delete(ctx, resourceGroup, storageAccount, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLocalUsers)(nil).Delete), ctx, resourceGroup, storageAccount, username)
+}
+
+// MockObjectReplication is a mock of ObjectReplication interface.
+type MockObjectReplication struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectReplicationMockRecorder
+	isgomock struct{}
+}
+
+// MockObjectReplicationMockRecorder is the mock recorder for MockObjectReplication.
+type MockObjectReplicationMockRecorder struct {
+	mock *MockObjectReplication
+}
+
+// NewMockObjectReplication creates a new mock instance.
+func NewMockObjectReplication(ctrl *gomock.Controller) *MockObjectReplication {
+	mock := &MockObjectReplication{ctrl: ctrl}
+	mock.recorder = &MockObjectReplicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectReplication) EXPECT() *MockObjectReplicationMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdatePolicy mocks base method.
+func (m *MockObjectReplication) CreateOrUpdatePolicy(arg0 context.Context, arg1, arg2, arg3 string, arg4 armstorage.ObjectReplicationPolicy) (*armstorage.ObjectReplicationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdatePolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*armstorage.ObjectReplicationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdatePolicy indicates an expected call of CreateOrUpdatePolicy.
+func (mr *MockObjectReplicationMockRecorder) CreateOrUpdatePolicy(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdatePolicy", reflect.TypeOf((*MockObjectReplication)(nil).CreateOrUpdatePolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// DeletePolicy mocks base method.
+func (m *MockObjectReplication) DeletePolicy(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockObjectReplicationMockRecorder) DeletePolicy(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockObjectReplication)(nil).DeletePolicy), arg0, arg1, arg2, arg3)
+}
+
+// EnableBlobVersioning mocks base method.
+func (m *MockObjectReplication) EnableBlobVersioning(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableBlobVersioning", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableBlobVersioning indicates an expected call of EnableBlobVersioning.
+func (mr *MockObjectReplicationMockRecorder) EnableBlobVersioning(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBlobVersioning", reflect.TypeOf((*MockObjectReplication)(nil).EnableBlobVersioning), arg0, arg1, arg2, arg3)
+}
+
+// ListPolicies mocks base method.
+func (m *MockObjectReplication) ListPolicies(arg0 context.Context, arg1, arg2 string) ([]*armstorage.ObjectReplicationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*armstorage.ObjectReplicationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockObjectReplicationMockRecorder) ListPolicies(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockObjectReplication)(nil).ListPolicies), arg0, arg1, arg2)
+}
+
+// MockBlobServices is a mock of BlobServices interface.
+type MockBlobServices struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobServicesMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobServicesMockRecorder is the mock recorder for MockBlobServices.
+type MockBlobServicesMockRecorder struct {
+	mock *MockBlobServices
+}
+
+// NewMockBlobServices creates a new mock instance.
+func NewMockBlobServices(ctrl *gomock.Controller) *MockBlobServices {
+	mock := &MockBlobServices{ctrl: ctrl}
+	mock.recorder = &MockBlobServicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobServices) EXPECT() *MockBlobServicesMockRecorder {
+	return m.recorder
+}
+
+// GetServiceProperties mocks base method.
+func (m *MockBlobServices) GetServiceProperties(arg0 context.Context, arg1, arg2 string) (*armstorage.BlobServicePropertiesProperties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceProperties", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armstorage.BlobServicePropertiesProperties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceProperties indicates an expected call of GetServiceProperties.
+func (mr *MockBlobServicesMockRecorder) GetServiceProperties(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceProperties", reflect.TypeOf((*MockBlobServices)(nil).GetServiceProperties), arg0, arg1, arg2)
+}
+
+// SetServiceProperties mocks base method.
+func (m *MockBlobServices) SetServiceProperties(arg0 context.Context, arg1, arg2 string, arg3 *armstorage.BlobServicePropertiesProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetServiceProperties", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetServiceProperties indicates an expected call of SetServiceProperties.
+func (mr *MockBlobServicesMockRecorder) SetServiceProperties(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceProperties", reflect.TypeOf((*MockBlobServices)(nil).SetServiceProperties), arg0, arg1, arg2, arg3)
+}
+
+// MockProviders is a mock of Providers interface.
+type MockProviders struct {
+	ctrl     *gomock.Controller
+	recorder *MockProvidersMockRecorder
+	isgomock struct{}
+}
+
+// MockProvidersMockRecorder is the mock recorder for MockProviders.
+type MockProvidersMockRecorder struct {
+	mock *MockProviders
+}
+
+// NewMockProviders creates a new mock instance.
+func NewMockProviders(ctrl *gomock.Controller) *MockProviders {
+	mock := &MockProviders{ctrl: ctrl}
+	mock.recorder = &MockProvidersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviders) EXPECT() *MockProvidersMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockProviders) Get(arg0 context.Context, arg1 string) (*armresources.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*armresources.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProvidersMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProviders)(nil).Get), arg0, arg1)
+}
+
+// Register mocks base method.
+func (m *MockProviders) Register(arg0 context.Context, arg1 string) (*armresources.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret0, _ := ret[0].(*armresources.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockProvidersMockRecorder) Register(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockProviders)(nil).Register), arg0, arg1)
+}
+
+// MockLoadBalancer is a mock of LoadBalancer interface.
+type MockLoadBalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancerMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadBalancerMockRecorder is the mock recorder for MockLoadBalancer.
+type MockLoadBalancerMockRecorder struct {
+	mock *MockLoadBalancer
+}
+
+// NewMockLoadBalancer creates a new mock instance.
+func NewMockLoadBalancer(ctrl *gomock.Controller) *MockLoadBalancer {
+	mock := &MockLoadBalancer{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancer) EXPECT() *MockLoadBalancerMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockLoadBalancer) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockLoadBalancerMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockLoadBalancer)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancer) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancerMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancer)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockLoadBalancer) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoadBalancerMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancer)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancer) List(ctx context.Context, resourceGroupName string) ([]*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceGroupName)
+	ret0, _ := ret[0].([]*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancerMockRecorder) List(ctx, resourceGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancer)(nil).List), ctx, resourceGroupName)
+}
+
+// UpdateIfUnchanged mocks base method.
+func (m *MockLoadBalancer) UpdateIfUnchanged(ctx context.Context, resourceGroupName, name string, parameters armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIfUnchanged", ctx, resourceGroupName, name, parameters)
+	ret0, _ := ret[0].(*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIfUnchanged indicates an expected call of UpdateIfUnchanged.
+func (mr *MockLoadBalancerMockRecorder) UpdateIfUnchanged(ctx, resourceGroupName, name, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIfUnchanged", reflect.TypeOf((*MockLoadBalancer)(nil).UpdateIfUnchanged), ctx, resourceGroupName, name, parameters)
+}
+
+// MockServiceEndpointPolicy is a mock of ServiceEndpointPolicy interface.
+type MockServiceEndpointPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceEndpointPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceEndpointPolicyMockRecorder is the mock recorder for MockServiceEndpointPolicy.
+type MockServiceEndpointPolicyMockRecorder struct {
+	mock *MockServiceEndpointPolicy
+}
+
+// NewMockServiceEndpointPolicy creates a new mock instance.
+func NewMockServiceEndpointPolicy(ctrl *gomock.Controller) *MockServiceEndpointPolicy {
+	mock := &MockServiceEndpointPolicy{ctrl: ctrl}
+	mock.recorder = &MockServiceEndpointPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceEndpointPolicy) EXPECT() *MockServiceEndpointPolicyMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockServiceEndpointPolicy) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.ServiceEndpointPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.ServiceEndpointPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceEndpointPolicyMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceEndpointPolicy)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockUsage is a mock of Usage interface.
+type MockUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageMockRecorder is the mock recorder for MockUsage.
+type MockUsageMockRecorder struct {
+	mock *MockUsage
+}
+
+// NewMockUsage creates a new mock instance.
+func NewMockUsage(ctrl *gomock.Controller) *MockUsage {
+	mock := &MockUsage{ctrl: ctrl}
+	mock.recorder = &MockUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsage) EXPECT() *MockUsageMockRecorder {
+	return m.recorder
+}
+
+// ListComputeUsages mocks base method.
+func (m *MockUsage) ListComputeUsages(ctx context.Context, location string) ([]*armcompute.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComputeUsages", ctx, location)
+	ret0, _ := ret[0].([]*armcompute.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComputeUsages indicates an expected call of ListComputeUsages.
+func (mr *MockUsageMockRecorder) ListComputeUsages(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComputeUsages", reflect.TypeOf((*MockUsage)(nil).ListComputeUsages), ctx, location)
+}
+
+// ListNetworkUsages mocks base method.
+func (m *MockUsage) ListNetworkUsages(ctx context.Context, location string) ([]*armnetwork.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkUsages", ctx, location)
+	ret0, _ := ret[0].([]*armnetwork.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkUsages indicates an expected call of ListNetworkUsages.
+func (mr *MockUsageMockRecorder) ListNetworkUsages(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkUsages", reflect.TypeOf((*MockUsage)(nil).ListNetworkUsages), ctx, location)
+}
+
+// ListVirtualMachineSKUs mocks base method.
+func (m *MockUsage) ListVirtualMachineSKUs(ctx context.Context, location string) ([]*armcompute.ResourceSKU, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachineSKUs", ctx, location)
+	ret0, _ := ret[0].([]*armcompute.ResourceSKU)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachineSKUs indicates an expected call of ListVirtualMachineSKUs.
+func (mr *MockUsageMockRecorder) ListVirtualMachineSKUs(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachineSKUs", reflect.TypeOf((*MockUsage)(nil).ListVirtualMachineSKUs), ctx, location)
+}
+
+// MockNetworkWatcher is a mock of NetworkWatcher interface.
+type MockNetworkWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkWatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkWatcherMockRecorder is the mock recorder for MockNetworkWatcher.
+type MockNetworkWatcherMockRecorder struct {
+	mock *MockNetworkWatcher
+}
+
+// NewMockNetworkWatcher creates a new mock instance.
+func NewMockNetworkWatcher(ctrl *gomock.Controller) *MockNetworkWatcher {
+	mock := &MockNetworkWatcher{ctrl: ctrl}
+	mock.recorder = &MockNetworkWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkWatcher) EXPECT() *MockNetworkWatcherMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockNetworkWatcher) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.Watcher) (*armnetwork.Watcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.Watcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockNetworkWatcherMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockNetworkWatcher)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockNetworkWatcher) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNetworkWatcherMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNetworkWatcher)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// ListAll mocks base method.
+func (m *MockNetworkWatcher) ListAll(ctx context.Context) ([]*armnetwork.Watcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx)
+	ret0, _ := ret[0].([]*armnetwork.Watcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockNetworkWatcherMockRecorder) ListAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockNetworkWatcher)(nil).ListAll), ctx)
+}
+
+// MockFlowLog is a mock of FlowLog interface.
+type MockFlowLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlowLogMockRecorder
+	isgomock struct{}
+}
+
+// MockFlowLogMockRecorder is the mock recorder for MockFlowLog.
+type MockFlowLogMockRecorder struct {
+	mock *MockFlowLog
+}
+
+// NewMockFlowLog creates a new mock instance.
+func NewMockFlowLog(ctrl *gomock.Controller) *MockFlowLog {
+	mock := &MockFlowLog{ctrl: ctrl}
+	mock.recorder = &MockFlowLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlowLog) EXPECT() *MockFlowLogMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockFlowLog) CreateOrUpdate(ctx context.Context, resourceGroupName, parentResourceName, resourceName string, resourceParam armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockFlowLogMockRecorder) CreateOrUpdate(ctx, resourceGroupName, parentResourceName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockFlowLog)(nil).CreateOrUpdate), ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockFlowLog) Delete(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFlowLogMockRecorder) Delete(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFlowLog)(nil).Delete), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockFlowLog) Get(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) (*armnetwork.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFlowLogMockRecorder) Get(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFlowLog)(nil).Get), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// List mocks base method.
+func (m *MockFlowLog) List(ctx context.Context, resourceGroupName, parentResourceName string) ([]*armnetwork.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceGroupName, parentResourceName)
+	ret0, _ := ret[0].([]*armnetwork.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFlowLogMockRecorder) List(ctx, resourceGroupName, parentResourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFlowLog)(nil).List), ctx, resourceGroupName, parentResourceName)
+}
+
+// MockDiagnosticSettings is a mock of DiagnosticSettings interface.
+type MockDiagnosticSettings struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiagnosticSettingsMockRecorder
+	isgomock struct{}
+}
+
+// MockDiagnosticSettingsMockRecorder is the mock recorder for MockDiagnosticSettings.
+type MockDiagnosticSettingsMockRecorder struct {
+	mock *MockDiagnosticSettings
+}
+
+// NewMockDiagnosticSettings creates a new mock instance.
+func NewMockDiagnosticSettings(ctrl *gomock.Controller) *MockDiagnosticSettings {
+	mock := &MockDiagnosticSettings{ctrl: ctrl}
+	mock.recorder = &MockDiagnosticSettingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiagnosticSettings) EXPECT() *MockDiagnosticSettingsMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockDiagnosticSettings) CreateOrUpdate(ctx context.Context, resourceGroupName, resourcePath, name string, setting client.DiagnosticSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourcePath, name, setting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockDiagnosticSettingsMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourcePath, name, setting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDiagnosticSettings)(nil).CreateOrUpdate), ctx, resourceGroupName, resourcePath, name, setting)
+}
+
+// Delete mocks base method.
+func (m *MockDiagnosticSettings) Delete(ctx context.Context, resourceGroupName, resourcePath, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourcePath, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiagnosticSettingsMockRecorder) Delete(ctx, resourceGroupName, resourcePath, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDiagnosticSettings)(nil).Delete), ctx, resourceGroupName, resourcePath, name)
+}
+
+// Get mocks base method.
+func (m *MockDiagnosticSettings) Get(ctx context.Context, resourceGroupName, resourcePath, name string) (*client.DiagnosticSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourcePath, name)
+	ret0, _ := ret[0].(*client.DiagnosticSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDiagnosticSettingsMockRecorder) Get(ctx, resourceGroupName, resourcePath, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDiagnosticSettings)(nil).Get), ctx, resourceGroupName, resourcePath, name)
+}
+
+// MockPrivateLinkService is a mock of PrivateLinkService interface.
+type MockPrivateLinkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateLinkServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateLinkServiceMockRecorder is the mock recorder for MockPrivateLinkService.
+type MockPrivateLinkServiceMockRecorder struct {
+	mock *MockPrivateLinkService
+}
+
+// NewMockPrivateLinkService creates a new mock instance.
+func NewMockPrivateLinkService(ctrl *gomock.Controller) *MockPrivateLinkService {
+	mock := &MockPrivateLinkService{ctrl: ctrl}
+	mock.recorder = &MockPrivateLinkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateLinkService) EXPECT() *MockPrivateLinkServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPrivateLinkService) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.PrivateLinkService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.PrivateLinkService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPrivateLinkServiceMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPrivateLinkService)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockPrivateEndpoint is a mock of PrivateEndpoint interface.
+type MockPrivateEndpoint struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateEndpointMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateEndpointMockRecorder is the mock recorder for MockPrivateEndpoint.
+type MockPrivateEndpointMockRecorder struct {
+	mock *MockPrivateEndpoint
+}
+
+// NewMockPrivateEndpoint creates a new mock instance.
+func NewMockPrivateEndpoint(ctrl *gomock.Controller) *MockPrivateEndpoint {
+	mock := &MockPrivateEndpoint{ctrl: ctrl}
+	mock.recorder = &MockPrivateEndpointMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateEndpoint) EXPECT() *MockPrivateEndpointMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockPrivateEndpoint) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.PrivateEndpoint) (*armnetwork.PrivateEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.PrivateEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockPrivateEndpointMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockPrivateEndpoint)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockPrivateEndpoint) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPrivateEndpointMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPrivateEndpoint)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockPrivateEndpoint) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.PrivateEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.PrivateEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPrivateEndpointMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPrivateEndpoint)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockNetworkInterface is a mock of NetworkInterface interface.
+type MockNetworkInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkInterfaceMockRecorder is the mock recorder for MockNetworkInterface.
+type MockNetworkInterfaceMockRecorder struct {
+	mock *MockNetworkInterface
+}
+
+// NewMockNetworkInterface creates a new mock instance.
+func NewMockNetworkInterface(ctrl *gomock.Controller) *MockNetworkInterface {
+	mock := &MockNetworkInterface{ctrl: ctrl}
+	mock.recorder = &MockNetworkInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkInterface) EXPECT() *MockNetworkInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockNetworkInterface) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.Interface) (*armnetwork.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockNetworkInterfaceMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockNetworkInterface)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockNetworkInterface) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNetworkInterfaceMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNetworkInterface)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockNetworkInterface) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNetworkInterfaceMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNetworkInterface)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockPrivateDNSZones is a mock of PrivateDNSZones interface.
+type MockPrivateDNSZones struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateDNSZonesMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateDNSZonesMockRecorder is the mock recorder for MockPrivateDNSZones.
+type MockPrivateDNSZonesMockRecorder struct {
+	mock *MockPrivateDNSZones
+}
+
+// NewMockPrivateDNSZones creates a new mock instance.
+func NewMockPrivateDNSZones(ctrl *gomock.Controller) *MockPrivateDNSZones {
+	mock := &MockPrivateDNSZones{ctrl: ctrl}
+	mock.recorder = &MockPrivateDNSZonesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateDNSZones) EXPECT() *MockPrivateDNSZonesMockRecorder {
+	return m.recorder
+}
+
+// AddTags mocks base method.
+func (m *MockPrivateDNSZones) AddTags(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockPrivateDNSZonesMockRecorder) AddTags(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockPrivateDNSZones)(nil).AddTags), arg0, arg1, arg2)
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockPrivateDNSZones) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam client.PrivateDNSZone) (*client.PrivateDNSZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*client.PrivateDNSZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockPrivateDNSZonesMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockPrivateDNSZones)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockPrivateDNSZones) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPrivateDNSZonesMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPrivateDNSZones)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockPrivateDNSZones) Get(ctx context.Context, resourceGroupName, resourceName string) (*client.PrivateDNSZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*client.PrivateDNSZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPrivateDNSZonesMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPrivateDNSZones)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// ListByResourceGroup mocks base method.
+func (m *MockPrivateDNSZones) ListByResourceGroup(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockPrivateDNSZonesMockRecorder) ListByResourceGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*MockPrivateDNSZones)(nil).ListByResourceGroup), arg0, arg1)
+}
+
+// MockVirtualNetworkLinks is a mock of VirtualNetworkLinks interface.
+type MockVirtualNetworkLinks struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualNetworkLinksMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualNetworkLinksMockRecorder is the mock recorder for MockVirtualNetworkLinks.
+type MockVirtualNetworkLinksMockRecorder struct {
+	mock *MockVirtualNetworkLinks
+}
+
+// NewMockVirtualNetworkLinks creates a new mock instance.
+func NewMockVirtualNetworkLinks(ctrl *gomock.Controller) *MockVirtualNetworkLinks {
+	mock := &MockVirtualNetworkLinks{ctrl: ctrl}
+	mock.recorder = &MockVirtualNetworkLinksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualNetworkLinks) EXPECT() *MockVirtualNetworkLinksMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockVirtualNetworkLinks) CreateOrUpdate(ctx context.Context, resourceGroupName, parentResourceName, resourceName string, resourceParam client.VirtualNetworkLink) (*client.VirtualNetworkLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*client.VirtualNetworkLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockVirtualNetworkLinksMockRecorder) CreateOrUpdate(ctx, resourceGroupName, parentResourceName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockVirtualNetworkLinks)(nil).CreateOrUpdate), ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockVirtualNetworkLinks) Delete(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVirtualNetworkLinksMockRecorder) Delete(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualNetworkLinks)(nil).Delete), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockVirtualNetworkLinks) Get(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) (*client.VirtualNetworkLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(*client.VirtualNetworkLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVirtualNetworkLinksMockRecorder) Get(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualNetworkLinks)(nil).Get), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// MockDataCollectionRules is a mock of DataCollectionRules interface.
+type MockDataCollectionRules struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataCollectionRulesMockRecorder
+	isgomock struct{}
+}
+
+// MockDataCollectionRulesMockRecorder is the mock recorder for MockDataCollectionRules.
+type MockDataCollectionRulesMockRecorder struct {
+	mock *MockDataCollectionRules
+}
+
+// NewMockDataCollectionRules creates a new mock instance.
+func NewMockDataCollectionRules(ctrl *gomock.Controller) *MockDataCollectionRules {
+	mock := &MockDataCollectionRules{ctrl: ctrl}
+	mock.recorder = &MockDataCollectionRulesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataCollectionRules) EXPECT() *MockDataCollectionRulesMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateAssociation mocks base method.
+func (m *MockDataCollectionRules) CreateOrUpdateAssociation(ctx context.Context, resourceID, name string, association client.DataCollectionRuleAssociation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateAssociation", ctx, resourceID, name, association)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateAssociation indicates an expected call of CreateOrUpdateAssociation.
+func (mr *MockDataCollectionRulesMockRecorder) CreateOrUpdateAssociation(ctx, resourceID, name, association any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateAssociation", reflect.TypeOf((*MockDataCollectionRules)(nil).CreateOrUpdateAssociation), ctx, resourceID, name, association)
+}
+
+// DeleteAssociation mocks base method.
+func (m *MockDataCollectionRules) DeleteAssociation(ctx context.Context, resourceID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssociation", ctx, resourceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssociation indicates an expected call of DeleteAssociation.
+func (mr *MockDataCollectionRulesMockRecorder) DeleteAssociation(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssociation", reflect.TypeOf((*MockDataCollectionRules)(nil).DeleteAssociation), ctx, resourceID, name)
+}
+
+// Get mocks base method.
+func (m *MockDataCollectionRules) Get(ctx context.Context, id string) (*client.DataCollectionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*client.DataCollectionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDataCollectionRulesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDataCollectionRules)(nil).Get), ctx, id)
+}
+
+// GetAssociation mocks base method.
+func (m *MockDataCollectionRules) GetAssociation(ctx context.Context, resourceID, name string) (*client.DataCollectionRuleAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssociation", ctx, resourceID, name)
+	ret0, _ := ret[0].(*client.DataCollectionRuleAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssociation indicates an expected call of GetAssociation.
+func (mr *MockDataCollectionRulesMockRecorder) GetAssociation(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssociation", reflect.TypeOf((*MockDataCollectionRules)(nil).GetAssociation), ctx, resourceID, name)
+}
+
+// MockRoleAssignments is a mock of RoleAssignments interface.
+type MockRoleAssignments struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleAssignmentsMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleAssignmentsMockRecorder is the mock recorder for MockRoleAssignments.
+type MockRoleAssignmentsMockRecorder struct {
+	mock *MockRoleAssignments
+}
+
+// NewMockRoleAssignments creates a new mock instance.
+func NewMockRoleAssignments(ctrl *gomock.Controller) *MockRoleAssignments {
+	mock := &MockRoleAssignments{ctrl: ctrl}
+	mock.recorder = &MockRoleAssignmentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleAssignments) EXPECT() *MockRoleAssignmentsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleAssignments) Create(ctx context.Context, scope, name string, assignment client.RoleAssignment) (*client.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, scope, name, assignment)
+	ret0, _ := ret[0].(*client.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleAssignmentsMockRecorder) Create(ctx, scope, name, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleAssignments)(nil).Create), ctx, scope, name, assignment)
+}
+
+// Delete mocks base method.
+func (m *MockRoleAssignments) Delete(ctx context.Context, scope, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, scope, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleAssignmentsMockRecorder) Delete(ctx, scope, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleAssignments)(nil).Delete), ctx, scope, name)
+}
+
+// Get mocks base method.
+func (m *MockRoleAssignments) Get(ctx context.Context, scope, name string) (*client.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, scope, name)
+	ret0, _ := ret[0].(*client.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleAssignmentsMockRecorder) Get(ctx, scope, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleAssignments)(nil).Get), ctx, scope, name)
+}
+
+// GetRoleDefinition mocks base method.
+func (m *MockRoleAssignments) GetRoleDefinition(ctx context.Context, id string) (*client.RoleDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleDefinition", ctx, id)
+	ret0, _ := ret[0].(*client.RoleDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleDefinition indicates an expected call of GetRoleDefinition.
+func (mr *MockRoleAssignmentsMockRecorder) GetRoleDefinition(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleDefinition", reflect.TypeOf((*MockRoleAssignments)(nil).GetRoleDefinition), ctx, id)
+}
+
+// ListForPrincipal mocks base method.
+func (m *MockRoleAssignments) ListForPrincipal(ctx context.Context, scope, principalID string) ([]client.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPrincipal", ctx, scope, principalID)
+	ret0, _ := ret[0].([]client.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPrincipal indicates an expected call of ListForPrincipal.
+func (mr *MockRoleAssignmentsMockRecorder) ListForPrincipal(ctx, scope, principalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPrincipal", reflect.TypeOf((*MockRoleAssignments)(nil).ListForPrincipal), ctx, scope, principalID)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleAssignments) ListPermissions(ctx context.Context, scope string) ([]client.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx, scope)
+	ret0, _ := ret[0].([]client.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleAssignmentsMockRecorder) ListPermissions(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleAssignments)(nil).ListPermissions), ctx, scope)
+}
+
+// MockKeyVaultKeys is a mock of KeyVaultKeys interface.
+type MockKeyVaultKeys struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyVaultKeysMockRecorder
+	isgomock struct{}
+}
+
+// MockKeyVaultKeysMockRecorder is the mock recorder for MockKeyVaultKeys.
+type MockKeyVaultKeysMockRecorder struct {
+	mock *MockKeyVaultKeys
+}
+
+// NewMockKeyVaultKeys creates a new mock instance.
+func NewMockKeyVaultKeys(ctrl *gomock.Controller) *MockKeyVaultKeys {
+	mock := &MockKeyVaultKeys{ctrl: ctrl}
+	mock.recorder = &MockKeyVaultKeysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyVaultKeys) EXPECT() *MockKeyVaultKeysMockRecorder {
+	return m.recorder
+}
+
+// GetKey mocks base method.
+func (m *MockKeyVaultKeys) GetKey(ctx context.Context, keyID string) (*client.KeyVaultKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", ctx, keyID)
+	ret0, _ := ret[0].(*client.KeyVaultKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockKeyVaultKeysMockRecorder) GetKey(ctx, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockKeyVaultKeys)(nil).GetKey), ctx, keyID)
+}
+
+// MockVirtualMachineImages is a mock of VirtualMachineImages interface.
+type MockVirtualMachineImages struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineImagesMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualMachineImagesMockRecorder is the mock recorder for MockVirtualMachineImages.
+type MockVirtualMachineImagesMockRecorder struct {
+	mock *MockVirtualMachineImages
+}
+
+// NewMockVirtualMachineImages creates a new mock instance.
+func NewMockVirtualMachineImages(ctrl *gomock.Controller) *MockVirtualMachineImages {
+	mock := &MockVirtualMachineImages{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineImagesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachineImages) EXPECT() *MockVirtualMachineImagesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockVirtualMachineImages) Get(ctx context.Context, location, publisherName, offer, sku, version string) (*armcompute.VirtualMachineImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, location, publisherName, offer, sku, version)
+	ret0, _ := ret[0].(*armcompute.VirtualMachineImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVirtualMachineImagesMockRecorder) Get(ctx, location, publisherName, offer, sku, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineImages)(nil).Get), ctx, location, publisherName, offer, sku, version)
+}
+
+// ListSkus mocks base method.
+func (m *MockVirtualMachineImages) ListSkus(ctx context.Context, location, publisherName, offer string) (*armcompute.VirtualMachineImagesClientListSKUsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkus", ctx, location, publisherName, offer)
+	ret0, _ := ret[0].(*armcompute.VirtualMachineImagesClientListSKUsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkus indicates an expected call of ListSkus.
+func (mr *MockVirtualMachineImagesMockRecorder) ListSkus(ctx, location, publisherName, offer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkus", reflect.TypeOf((*MockVirtualMachineImages)(nil).ListSkus), ctx, location, publisherName, offer)
+}
+
+// MockGalleryImageVersions is a mock of GalleryImageVersions interface.
+type MockGalleryImageVersions struct {
+	ctrl     *gomock.Controller
+	recorder *MockGalleryImageVersionsMockRecorder
+	isgomock struct{}
+}
+
+// MockGalleryImageVersionsMockRecorder is the mock recorder for MockGalleryImageVersions.
+type MockGalleryImageVersionsMockRecorder struct {
+	mock *MockGalleryImageVersions
+}
+
+// NewMockGalleryImageVersions creates a new mock instance.
+func NewMockGalleryImageVersions(ctrl *gomock.Controller) *MockGalleryImageVersions {
+	mock := &MockGalleryImageVersions{ctrl: ctrl}
+	mock.recorder = &MockGalleryImageVersionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGalleryImageVersions) EXPECT() *MockGalleryImageVersionsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGalleryImageVersions) Get(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName, versionName string) (*armcompute.GalleryImageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, subscriptionID, resourceGroupName, galleryName, imageName, versionName)
+	ret0, _ := ret[0].(*armcompute.GalleryImageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGalleryImageVersionsMockRecorder) Get(ctx, subscriptionID, resourceGroupName, galleryName, imageName, versionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGalleryImageVersions)(nil).Get), ctx, subscriptionID, resourceGroupName, galleryName, imageName, versionName)
+}
+
+// GetCommunity mocks base method.
+func (m *MockGalleryImageVersions) GetCommunity(ctx context.Context, location, publicGalleryName, imageName, versionName string) (*armcompute.CommunityGalleryImageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommunity", ctx, location, publicGalleryName, imageName, versionName)
+	ret0, _ := ret[0].(*armcompute.CommunityGalleryImageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommunity indicates an expected call of GetCommunity.
+func (mr *MockGalleryImageVersionsMockRecorder) GetCommunity(ctx, location, publicGalleryName, imageName, versionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommunity", reflect.TypeOf((*MockGalleryImageVersions)(nil).GetCommunity), ctx, location, publicGalleryName, imageName, versionName)
+}
+
+// GetShared mocks base method.
+func (m *MockGalleryImageVersions) GetShared(ctx context.Context, location, galleryUniqueName, imageName, versionName string) (*armcompute.SharedGalleryImageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShared", ctx, location, galleryUniqueName, imageName, versionName)
+	ret0, _ := ret[0].(*armcompute.SharedGalleryImageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShared indicates an expected call of GetShared.
+func (mr *MockGalleryImageVersionsMockRecorder) GetShared(ctx, location, galleryUniqueName, imageName, versionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShared", reflect.TypeOf((*MockGalleryImageVersions)(nil).GetShared), ctx, location, galleryUniqueName, imageName, versionName)
+}
+
+// MockMarketplaceAgreements is a mock of MarketplaceAgreements interface.
+type MockMarketplaceAgreements struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarketplaceAgreementsMockRecorder
+	isgomock struct{}
+}
+
+// MockMarketplaceAgreementsMockRecorder is the mock recorder for MockMarketplaceAgreements.
+type MockMarketplaceAgreementsMockRecorder struct {
+	mock *MockMarketplaceAgreements
+}
+
+// NewMockMarketplaceAgreements creates a new mock instance.
+func NewMockMarketplaceAgreements(ctrl *gomock.Controller) *MockMarketplaceAgreements {
+	mock := &MockMarketplaceAgreements{ctrl: ctrl}
+	mock.recorder = &MockMarketplaceAgreementsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarketplaceAgreements) EXPECT() *MockMarketplaceAgreementsMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockMarketplaceAgreements) Accept(ctx context.Context, agreement client.MarketplaceAgreement) (*client.MarketplaceAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, agreement)
+	ret0, _ := ret[0].(*client.MarketplaceAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockMarketplaceAgreementsMockRecorder) Accept(ctx, agreement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockMarketplaceAgreements)(nil).Accept), ctx, agreement)
+}
+
+// Get mocks base method.
+func (m *MockMarketplaceAgreements) Get(ctx context.Context, publisher, offer, plan string) (*client.MarketplaceAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, publisher, offer, plan)
+	ret0, _ := ret[0].(*client.MarketplaceAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMarketplaceAgreementsMockRecorder) Get(ctx, publisher, offer, plan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMarketplaceAgreements)(nil).Get), ctx, publisher, offer, plan)
+}
+
+// MockManagementLocks is a mock of ManagementLocks interface.
+type MockManagementLocks struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementLocksMockRecorder
+	isgomock struct{}
+}
+
+// MockManagementLocksMockRecorder is the mock recorder for MockManagementLocks.
+type MockManagementLocksMockRecorder struct {
+	mock *MockManagementLocks
+}
+
+// NewMockManagementLocks creates a new mock instance.
+func NewMockManagementLocks(ctrl *gomock.Controller) *MockManagementLocks {
+	mock := &MockManagementLocks{ctrl: ctrl}
+	mock.recorder = &MockManagementLocksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementLocks) EXPECT() *MockManagementLocksMockRecorder {
+	return m.recorder
+}
+
+// ListAtScope mocks base method.
+func (m *MockManagementLocks) ListAtScope(ctx context.Context, scope string) ([]*client.ManagementLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAtScope", ctx, scope)
+	ret0, _ := ret[0].([]*client.ManagementLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAtScope indicates an expected call of ListAtScope.
+func (mr *MockManagementLocksMockRecorder) ListAtScope(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAtScope", reflect.TypeOf((*MockManagementLocks)(nil).ListAtScope), ctx, scope)
+}
+
+// MockDisk is a mock of Disk interface.
+type MockDisk struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiskMockRecorder
+	isgomock struct{}
+}
+
+// MockDiskMockRecorder is the mock recorder for MockDisk.
+type MockDiskMockRecorder struct {
+	mock *MockDisk
+}
+
+// NewMockDisk creates a new mock instance.
+func NewMockDisk(ctrl *gomock.Controller) *MockDisk {
+	mock := &MockDisk{ctrl: ctrl}
+	mock.recorder = &MockDiskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisk) EXPECT() *MockDiskMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockDisk) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armcompute.Disk) (*armcompute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armcompute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockDiskMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDisk)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockDisk) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiskMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDisk)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockDisk) Get(ctx context.Context, resourceGroupName, resourceName string) (*armcompute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armcompute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDiskMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDisk)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// Update mocks base method.
+func (m *MockDisk) Update(arg0 context.Context, arg1, arg2 string, arg3 armcompute.DiskUpdate) (*armcompute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDiskMockRecorder) Update(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDisk)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// MockVirtualMachine is a mock of VirtualMachine interface.
+type MockVirtualMachine struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualMachineMockRecorder is the mock recorder for MockVirtualMachine.
+type MockVirtualMachineMockRecorder struct {
+	mock *MockVirtualMachine
+}
+
+// NewMockVirtualMachine creates a new mock instance.
+func NewMockVirtualMachine(ctrl *gomock.Controller) *MockVirtualMachine {
+	mock := &MockVirtualMachine{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachine) EXPECT() *MockVirtualMachineMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockVirtualMachine) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armcompute.VirtualMachine) (*armcompute.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armcompute.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockVirtualMachineMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockVirtualMachine)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockVirtualMachine) Delete(ctx context.Context, resourceGroupName, resourceName string, opts *bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVirtualMachineMockRecorder) Delete(ctx, resourceGroupName, resourceName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualMachine)(nil).Delete), ctx, resourceGroupName, resourceName, opts)
+}
+
+// Get mocks base method.
+func (m *MockVirtualMachine) Get(ctx context.Context, resourceGroupName, resourceName string, expand *armcompute.InstanceViewTypes) (*armcompute.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName, expand)
+	ret0, _ := ret[0].(*armcompute.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVirtualMachineMockRecorder) Get(ctx, resourceGroupName, resourceName, expand any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachine)(nil).Get), ctx, resourceGroupName, resourceName, expand)
+}
+
+// ListByVmss mocks base method.
+func (m *MockVirtualMachine) ListByVmss(arg0 context.Context, arg1, arg2 string) ([]*armcompute.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVmss", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*armcompute.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVmss indicates an expected call of ListByVmss.
+func (mr *MockVirtualMachineMockRecorder) ListByVmss(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVmss", reflect.TypeOf((*MockVirtualMachine)(nil).ListByVmss), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockVirtualMachine) Update(arg0 context.Context, arg1, arg2 string, arg3 armcompute.VirtualMachineUpdate) (*armcompute.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVirtualMachineMockRecorder) Update(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVirtualMachine)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// UpdateTags mocks base method.
+func (m *MockVirtualMachine) UpdateTags(arg0 context.Context, arg1, arg2 string, arg3 map[string]*string) (*armcompute.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockVirtualMachineMockRecorder) UpdateTags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockVirtualMachine)(nil).UpdateTags), arg0, arg1, arg2, arg3)
+}
+
+// MockVirtualMachineExtensions is a mock of VirtualMachineExtensions interface.
+type MockVirtualMachineExtensions struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineExtensionsMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualMachineExtensionsMockRecorder is the mock recorder for MockVirtualMachineExtensions.
+type MockVirtualMachineExtensionsMockRecorder struct {
+	mock *MockVirtualMachineExtensions
+}
+
+// NewMockVirtualMachineExtensions creates a new mock instance.
+func NewMockVirtualMachineExtensions(ctrl *gomock.Controller) *MockVirtualMachineExtensions {
+	mock := &MockVirtualMachineExtensions{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineExtensionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachineExtensions) EXPECT() *MockVirtualMachineExtensionsMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockVirtualMachineExtensions) CreateOrUpdate(ctx context.Context, resourceGroupName, parentResourceName, resourceName string, resourceParam armcompute.VirtualMachineExtension) (*armcompute.VirtualMachineExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armcompute.VirtualMachineExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockVirtualMachineExtensionsMockRecorder) CreateOrUpdate(ctx, resourceGroupName, parentResourceName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockVirtualMachineExtensions)(nil).CreateOrUpdate), ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockVirtualMachineExtensions) Delete(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVirtualMachineExtensionsMockRecorder) Delete(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVirtualMachineExtensions)(nil).Delete), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// MockResource is a mock of Resource interface.
+type MockResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceMockRecorder is the mock recorder for MockResource.
+type MockResourceMockRecorder struct {
+	mock *MockResource
+}
+
+// NewMockResource creates a new mock instance.
+func NewMockResource(ctrl *gomock.Controller) *MockResource {
+	mock := &MockResource{ctrl: ctrl}
+	mock.recorder = &MockResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResource) EXPECT() *MockResourceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockResource) GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, resourceID, apiVersion)
+	ret0, _ := ret[0].(*armresources.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockResourceMockRecorder) GetByID(ctx, resourceID, apiVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResource)(nil).GetByID), ctx, resourceID, apiVersion)
+}
+
+// List mocks base method.
+func (m *MockResource) List(ctx context.Context, options *armresources.ClientListOptions) ([]*armresources.GenericResourceExpanded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, options)
+	ret0, _ := ret[0].([]*armresources.GenericResourceExpanded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockResourceMockRecorder) List(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockResource)(nil).List), ctx, options)
+}
+
+// ListByResourceGroup mocks base method.
+func (m *MockResource) ListByResourceGroup(ctx context.Context, resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) ([]*armresources.GenericResourceExpanded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", ctx, resourceGroupName, options)
+	ret0, _ := ret[0].([]*armresources.GenericResourceExpanded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockResourceMockRecorder) ListByResourceGroup(ctx, resourceGroupName, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*MockResource)(nil).ListByResourceGroup), ctx, resourceGroupName, options)
+}
@@ -31,7 +31,7 @@ func (c *NatGatewayClient) CreateOrUpdate(ctx context.Context, resourceGroupName
 	if err != nil {
 		return nil, err
 	}
-	resp, err := poller.PollUntilDone(ctx, nil)
+	resp, err := pollUntilDone(ctx, poller)
 	return &resp.NatGateway, err
 }
 
@@ -70,6 +70,6 @@ func (c *NatGatewayClient) Delete(ctx context.Context, resourceGroupName, natGat
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = poller.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, poller)
 	return err
 }
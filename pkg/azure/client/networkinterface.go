@@ -31,7 +31,7 @@ func (c *NetworkInterfaceClient) CreateOrUpdate(ctx context.Context, resourceGro
 	if err != nil {
 		return nil, err
 	}
-	res, err := future.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +53,6 @@ func (c *NetworkInterfaceClient) Delete(ctx context.Context, resourceGroupName,
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = future.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, future)
 	return err
 }
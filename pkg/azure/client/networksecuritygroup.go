@@ -31,7 +31,7 @@ func (c *NetworkSecurityGroupClient) CreateOrUpdate(ctx context.Context, resourc
 	if err != nil {
 		return nil, err
 	}
-	nsg, err := future.PollUntilDone(ctx, nil)
+	nsg, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (c *NetworkSecurityGroupClient) Delete(ctx context.Context, resourceGroupNa
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	if _, err := future.PollUntilDone(ctx, nil); err != nil {
+	if _, err := pollUntilDone(ctx, future); err != nil {
 		return err
 	}
 	return err
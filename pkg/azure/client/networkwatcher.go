@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+)
+
+var (
+	_ NetworkWatcher = &NetworkWatcherClient{}
+	_ FlowLog        = &FlowLogClient{}
+)
+
+// NetworkWatcherClient is an implementation of NetworkWatcher for a network watcher k8sClient.
+type NetworkWatcherClient struct {
+	client *armnetwork.WatchersClient
+}
+
+// NewNetworkWatcherClient creates a new NetworkWatcher client.
+func NewNetworkWatcherClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*NetworkWatcherClient, error) {
+	client, err := armnetwork.NewWatchersClient(auth.SubscriptionID, tc, opts)
+	return &NetworkWatcherClient{client}, err
+}
+
+// ListAll lists all network watchers of the subscription.
+func (c *NetworkWatcherClient) ListAll(ctx context.Context) ([]*armnetwork.Watcher, error) {
+	pager := c.client.NewListAllPager(nil)
+	var watchers []*armnetwork.Watcher
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, page.Value...)
+	}
+	return watchers, nil
+}
+
+// CreateOrUpdate creates or updates a network watcher.
+func (c *NetworkWatcherClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.Watcher) (*armnetwork.Watcher, error) {
+	res, err := c.client.CreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Watcher, nil
+}
+
+// Delete deletes a network watcher.
+func (c *NetworkWatcherClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = pollUntilDone(ctx, poller)
+	return err
+}
+
+// FlowLogClient is an implementation of FlowLog for a flow log k8sClient.
+type FlowLogClient struct {
+	client *armnetwork.FlowLogsClient
+}
+
+// NewFlowLogClient creates a new FlowLog client.
+func NewFlowLogClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*FlowLogClient, error) {
+	client, err := armnetwork.NewFlowLogsClient(auth.SubscriptionID, tc, opts)
+	return &FlowLogClient{client}, err
+}
+
+// CreateOrUpdate creates or updates the flow log of a network watcher.
+func (c *FlowLogClient) CreateOrUpdate(ctx context.Context, resourceGroupName, watcherName, name string, parameters armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, watcherName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, poller)
+	return &res.FlowLog, err
+}
+
+// Get returns the flow log of a network watcher. If the flow log does not exist nil is returned.
+func (c *FlowLogClient) Get(ctx context.Context, resourceGroupName, watcherName, name string) (*armnetwork.FlowLog, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, watcherName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.FlowLog, nil
+}
+
+// List lists the flow logs of a network watcher.
+func (c *FlowLogClient) List(ctx context.Context, resourceGroupName, watcherName string) ([]*armnetwork.FlowLog, error) {
+	pager := c.client.NewListPager(resourceGroupName, watcherName, nil)
+	var flowLogs []*armnetwork.FlowLog
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		flowLogs = append(flowLogs, page.Value...)
+	}
+	return flowLogs, nil
+}
+
+// Delete deletes the flow log of a network watcher.
+func (c *FlowLogClient) Delete(ctx context.Context, resourceGroupName, watcherName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, watcherName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = pollUntilDone(ctx, poller)
+	return err
+}
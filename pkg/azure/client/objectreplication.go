@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"k8s.io/utils/ptr"
+)
+
+var _ ObjectReplication = &ObjectReplicationClient{}
+
+// ObjectReplicationClient is a client for the object replication policies of storage accounts and the blob service
+// properties they require.
+type ObjectReplicationClient struct {
+	policies     *armstorage.ObjectReplicationPoliciesClient
+	blobServices *armstorage.BlobServicesClient
+}
+
+// NewObjectReplicationClient creates a new ObjectReplicationClient.
+func NewObjectReplicationClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*ObjectReplicationClient, error) {
+	policies, err := armstorage.NewObjectReplicationPoliciesClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	blobServices, err := armstorage.NewBlobServicesClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectReplicationClient{policies: policies, blobServices: blobServices}, nil
+}
+
+// EnableBlobVersioning enables the versioning of blobs of the storage account with the name <accountName> in the
+// resource group <resourceGroupName> and, if <changeFeed> is set, its change feed. Object replication requires the
+// versioning of blobs in both storage accounts and the change feed in the source account. The other properties of the
+// blob service are kept.
+func (c *ObjectReplicationClient) EnableBlobVersioning(ctx context.Context, resourceGroupName, accountName string, changeFeed bool) error {
+	current, err := c.blobServices.GetServiceProperties(ctx, resourceGroupName, accountName, nil)
+	if err != nil {
+		return err
+	}
+
+	properties := current.BlobServiceProperties.BlobServiceProperties
+	if properties == nil {
+		properties = &armstorage.BlobServicePropertiesProperties{}
+	}
+	versioningEnabled := ptr.Deref(properties.IsVersioningEnabled, false)
+	changeFeedEnabled := properties.ChangeFeed != nil && ptr.Deref(properties.ChangeFeed.Enabled, false)
+	if versioningEnabled && (changeFeedEnabled || !changeFeed) {
+		return nil
+	}
+
+	properties.IsVersioningEnabled = ptr.To(true)
+	if changeFeed {
+		if properties.ChangeFeed == nil {
+			properties.ChangeFeed = &armstorage.ChangeFeed{}
+		}
+		properties.ChangeFeed.Enabled = ptr.To(true)
+	}
+	_, err = c.blobServices.SetServiceProperties(ctx, resourceGroupName, accountName, armstorage.BlobServiceProperties{BlobServiceProperties: properties}, nil)
+	return err
+}
+
+// ListPolicies lists the object replication policies of the storage account with the name <accountName> in the
+// resource group <resourceGroupName>.
+func (c *ObjectReplicationClient) ListPolicies(ctx context.Context, resourceGroupName, accountName string) ([]*armstorage.ObjectReplicationPolicy, error) {
+	var policies []*armstorage.ObjectReplicationPolicy
+	pager := c.policies.NewListPager(resourceGroupName, accountName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, page.Value...)
+	}
+	return policies, nil
+}
+
+// CreateOrUpdatePolicy creates or updates the object replication policy with the ID <policyID> of the storage account
+// with the name <accountName> in the resource group <resourceGroupName>. A policy is created in the destination account
+// first with the ID "default", then the returned policy and its rule IDs are set in the source account.
+func (c *ObjectReplicationClient) CreateOrUpdatePolicy(ctx context.Context, resourceGroupName, accountName, policyID string, policy armstorage.ObjectReplicationPolicy) (*armstorage.ObjectReplicationPolicy, error) {
+	response, err := c.policies.CreateOrUpdate(ctx, resourceGroupName, accountName, policyID, policy, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &response.ObjectReplicationPolicy, nil
+}
+
+// DeletePolicy deletes the object replication policy with the ID <policyID> of the storage account with the name
+// <accountName> in the resource group <resourceGroupName>.
+func (c *ObjectReplicationClient) DeletePolicy(ctx context.Context, resourceGroupName, accountName, policyID string) error {
+	_, err := c.policies.Delete(ctx, resourceGroupName, accountName, policyID, nil)
+	return FilterNotFoundError(err)
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	// DefaultPollInterval is the default interval in which the state of long-running operations is polled. It is the
+	// default of the Azure SDK.
+	DefaultPollInterval = 30 * time.Second
+	// MinPollInterval is the minimum interval in which the state of long-running operations can be polled.
+	MinPollInterval = time.Second
+)
+
+var (
+	pollerOptionsMutex sync.RWMutex
+	pollInterval       = DefaultPollInterval
+	pollTimeout        time.Duration
+)
+
+// SetPollerOptions sets the interval in which the state of long-running operations, e.g. the creation of NAT gateways
+// or virtual networks, is polled and the timeout after which polling is aborted. Intervals below MinPollInterval reset
+// the interval to DefaultPollInterval, non-positive timeouts disable the timeout, so that polling is only bounded by
+// the context of the operation. Operations which are already polled are not affected.
+func SetPollerOptions(interval, timeout time.Duration) {
+	pollerOptionsMutex.Lock()
+	defer pollerOptionsMutex.Unlock()
+
+	if interval < MinPollInterval {
+		interval = DefaultPollInterval
+	}
+	pollInterval, pollTimeout = interval, max(timeout, 0)
+}
+
+// PollerOptions returns the interval and the timeout with which the state of long-running operations is polled.
+func PollerOptions() (*runtime.PollUntilDoneOptions, time.Duration) {
+	pollerOptionsMutex.RLock()
+	defer pollerOptionsMutex.RUnlock()
+
+	return &runtime.PollUntilDoneOptions{Frequency: pollInterval}, pollTimeout
+}
+
+// pollUntilDone polls the given long-running operation with the configured poller options until it is done, the
+// timeout expired or the context is canceled. A Retry-After header of a response takes precedence over the interval.
+func pollUntilDone[T any](ctx context.Context, poller *runtime.Poller[T]) (T, error) {
+	options, timeout := PollerOptions()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return poller.PollUntilDone(ctx, options)
+}
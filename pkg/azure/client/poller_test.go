@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+type fakeTokenCredential struct{}
+
+func (fakeTokenCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// inProgressTransport accepts a long-running operation and reports it as in progress on every poll.
+type inProgressTransport struct {
+	mutex sync.Mutex
+	polls []time.Time
+}
+
+func (t *inProgressTransport) Do(req *http.Request) (*http.Response, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	if req.Method == http.MethodPut {
+		header.Set("Azure-AsyncOperation", "https://management.azure.com/subscriptions/sub/providers/Microsoft.Network/locations/westeurope/operations/op?api-version=2023-09-01")
+		return &http.Response{StatusCode: http.StatusCreated, Header: header, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	}
+
+	t.mutex.Lock()
+	t.polls = append(t.polls, time.Now())
+	t.mutex.Unlock()
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(`{"status":"InProgress"}`)), Request: req}, nil
+}
+
+func (t *inProgressTransport) Polls() []time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]time.Time(nil), t.polls...)
+}
+
+var _ = Describe("Poller", func() {
+	AfterEach(func() {
+		SetPollerOptions(DefaultPollInterval, 0)
+	})
+
+	Describe("#SetPollerOptions", func() {
+		It("should use the default interval and no timeout if nothing is configured", func() {
+			options, timeout := PollerOptions()
+			Expect(options.Frequency).To(Equal(DefaultPollInterval))
+			Expect(timeout).To(BeZero())
+		})
+
+		It("should pass the configured interval to the poller options", func() {
+			SetPollerOptions(5*time.Second, 10*time.Minute)
+
+			options, timeout := PollerOptions()
+			Expect(options.Frequency).To(Equal(5 * time.Second))
+			Expect(timeout).To(Equal(10 * time.Minute))
+		})
+
+		It("should reset intervals which are rejected by the Azure SDK and negative timeouts", func() {
+			SetPollerOptions(500*time.Millisecond, -time.Minute)
+
+			options, timeout := PollerOptions()
+			Expect(options.Frequency).To(Equal(DefaultPollInterval))
+			Expect(timeout).To(BeZero())
+		})
+	})
+
+	Describe("long-running operations", func() {
+		var (
+			transport *inProgressTransport
+			client    *NatGatewayClient
+		)
+
+		BeforeEach(func() {
+			transport = &inProgressTransport{}
+			opts := DefaultAzureClientOpts()
+			opts.Transport = transport
+
+			var err error
+			client, err = NewNatGatewaysClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should poll in the configured interval until the timeout expires", func() {
+			SetPollerOptions(time.Second, 2500*time.Millisecond)
+
+			_, err := client.CreateOrUpdate(context.Background(), "rg", "nat", armnetwork.NatGateway{Location: to.Ptr("westeurope")})
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+
+			polls := transport.Polls()
+			Expect(len(polls)).To(BeNumerically(">=", 2))
+			for i := 1; i < len(polls); i++ {
+				Expect(polls[i].Sub(polls[i-1])).To(BeNumerically("~", time.Second, 300*time.Millisecond))
+			}
+		})
+
+		It("should stop polling when the context is cancelled", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := client.CreateOrUpdate(ctx, "rg", "nat", armnetwork.NatGateway{Location: to.Ptr("westeurope")})
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", DefaultPollInterval))
+		})
+	})
+})
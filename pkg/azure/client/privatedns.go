@@ -0,0 +1,380 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const privateDNSAPIVersion = "2020-06-01"
+
+var (
+	_ PrivateDNSZones     = &PrivateDNSZonesClient{}
+	_ VirtualNetworkLinks = &VirtualNetworkLinksClient{}
+	_ DNSRecordSet        = &PrivateDNSRecordSetClient{}
+)
+
+// PrivateDNSZone is an Azure private DNS zone.
+type PrivateDNSZone struct {
+	// ID is the resource ID of the zone.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the zone.
+	Name *string `json:"name,omitempty"`
+	// Location is the location of the zone, which is always global.
+	Location *string `json:"location,omitempty"`
+	// Tags are the tags of the zone.
+	Tags map[string]*string `json:"tags,omitempty"`
+}
+
+// VirtualNetworkLink is a link between a private DNS zone and a virtual network.
+type VirtualNetworkLink struct {
+	// ID is the resource ID of the link.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the link.
+	Name *string `json:"name,omitempty"`
+	// Location is the location of the link, which is always global.
+	Location *string `json:"location,omitempty"`
+	// Tags are the tags of the link.
+	Tags map[string]*string `json:"tags,omitempty"`
+	// Properties are the properties of the link.
+	Properties *VirtualNetworkLinkProperties `json:"properties,omitempty"`
+}
+
+// VirtualNetworkLinkProperties are the properties of a virtual network link.
+type VirtualNetworkLinkProperties struct {
+	// VirtualNetwork references the linked virtual network.
+	VirtualNetwork *VirtualNetworkReference `json:"virtualNetwork,omitempty"`
+	// RegistrationEnabled indicates whether the records of the virtual machines in the virtual network are registered
+	// automatically in the zone.
+	RegistrationEnabled *bool `json:"registrationEnabled,omitempty"`
+	// VirtualNetworkLinkState is the state of the link, either InProgress or Completed.
+	VirtualNetworkLinkState *string `json:"virtualNetworkLinkState,omitempty"`
+}
+
+// privateDNSZoneList is a page of the private DNS zones of a resource group.
+type privateDNSZoneList struct {
+	Value    []PrivateDNSZone `json:"value"`
+	NextLink *string          `json:"nextLink,omitempty"`
+}
+
+// privateDNSRecordSet is a recordset of an Azure private DNS zone.
+type privateDNSRecordSet struct {
+	Properties privateDNSRecordSetProperties `json:"properties"`
+}
+
+// privateDNSRecordSetMetadata is the part of a recordset of an Azure private DNS zone which is read to keep the
+// metadata managed by Gardener.
+type privateDNSRecordSetMetadata struct {
+	Properties struct {
+		Metadata map[string]*string `json:"metadata,omitempty"`
+	} `json:"properties"`
+}
+
+// privateDNSRecordSetProperties are the properties of a recordset of an Azure private DNS zone. Only the records of the
+// type of the recordset are set.
+type privateDNSRecordSetProperties struct {
+	Metadata    map[string]*string     `json:"metadata,omitempty"`
+	TTL         int64                  `json:"ttl"`
+	ARecords    []privateDNSARecord    `json:"aRecords,omitempty"`
+	CnameRecord *privateDNSCnameRecord `json:"cnameRecord,omitempty"`
+	TxtRecords  []privateDNSTxtRecord  `json:"txtRecords,omitempty"`
+}
+
+type privateDNSARecord struct {
+	IPv4Address string `json:"ipv4Address"`
+}
+
+type privateDNSCnameRecord struct {
+	Cname string `json:"cname"`
+}
+
+type privateDNSTxtRecord struct {
+	Value []string `json:"value"`
+}
+
+// VirtualNetworkReference references a virtual network by its resource ID.
+type VirtualNetworkReference struct {
+	// ID is the resource ID of the virtual network.
+	ID *string `json:"id,omitempty"`
+}
+
+// privateDNSClient sends requests to the Microsoft.Network/privateDnsZones resource provider.
+type privateDNSClient struct {
+	client         *arm.Client
+	subscriptionID string
+}
+
+func newPrivateDNSClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (privateDNSClient, error) {
+	client, err := arm.NewClient("armprivatedns.PrivateZonesClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return privateDNSClient{}, err
+	}
+	return privateDNSClient{client: client, subscriptionID: auth.SubscriptionID}, nil
+}
+
+func (c privateDNSClient) get(ctx context.Context, result any, resourceGroupName string, path ...string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, nil, resourceGroupName, path...)
+	if err != nil {
+		return false, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return false, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+	return true, runtime.UnmarshalAsJSON(resp, result)
+}
+
+func createOrUpdatePrivateDNSResource[T any](ctx context.Context, c privateDNSClient, resource T, resourceGroupName string, path ...string) (*T, error) {
+	resp, err := c.do(ctx, http.MethodPut, &resource, resourceGroupName, path...)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	poller, err := runtime.NewPoller[T](resp, c.client.Pipeline(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, poller)
+	return &res, err
+}
+
+func (c privateDNSClient) delete(ctx context.Context, resourceGroupName string, path ...string) error {
+	resp, err := c.do(ctx, http.MethodDelete, nil, resourceGroupName, path...)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted, http.StatusNoContent) {
+		return FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+	poller, err := runtime.NewPoller[struct{}](resp, c.client.Pipeline(), nil)
+	if err != nil {
+		return err
+	}
+	_, err = pollUntilDone(ctx, poller)
+	return err
+}
+
+func (c privateDNSClient) do(ctx context.Context, method string, body any, resourceGroupName string, path ...string) (*http.Response, error) {
+	if resourceGroupName == "" {
+		return nil, fmt.Errorf("resource group of the private DNS resource must not be empty")
+	}
+
+	segments := []string{
+		c.client.Endpoint(),
+		"subscriptions", url.PathEscape(c.subscriptionID),
+		"resourceGroups", url.PathEscape(resourceGroupName),
+		"providers/Microsoft.Network/privateDnsZones",
+	}
+	for _, segment := range path {
+		if segment == "" {
+			return nil, fmt.Errorf("names of the private DNS resource must not be empty")
+		}
+		segments = append(segments, url.PathEscape(segment))
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(segments[0], segments[1:]...))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", privateDNSAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Pipeline().Do(req)
+}
+
+// next requests the next page of a list with the given link.
+func (c privateDNSClient) next(ctx context.Context, nextLink string) (*http.Response, error) {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, nextLink)
+	if err != nil {
+		return nil, err
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+	return c.client.Pipeline().Do(req)
+}
+
+// PrivateDNSZonesClient is a client for Azure private DNS zones.
+type PrivateDNSZonesClient struct {
+	privateDNSClient
+}
+
+// NewPrivateDNSZonesClient creates a new PrivateDNSZones client.
+func NewPrivateDNSZonesClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PrivateDNSZonesClient, error) {
+	client, err := newPrivateDNSClient(auth, tc, opts)
+	return &PrivateDNSZonesClient{client}, err
+}
+
+// Get returns a private DNS zone. If the zone does not exist nil is returned.
+func (c *PrivateDNSZonesClient) Get(ctx context.Context, resourceGroupName, name string) (*PrivateDNSZone, error) {
+	zone := &PrivateDNSZone{}
+	if found, err := c.get(ctx, zone, resourceGroupName, name); err != nil || !found {
+		return nil, err
+	}
+	return zone, nil
+}
+
+// ListByResourceGroup returns a map of all private DNS zone names in the given resource group mapped to their IDs.
+func (c *PrivateDNSZonesClient) ListByResourceGroup(ctx context.Context, resourceGroupName string) (map[string]string, error) {
+	zones := make(map[string]string)
+
+	resp, err := c.do(ctx, http.MethodGet, nil, resourceGroupName)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+		page := &privateDNSZoneList{}
+		if err := runtime.UnmarshalAsJSON(resp, page); err != nil {
+			return nil, err
+		}
+		for _, zone := range page.Value {
+			if zone.Name != nil {
+				zones[*zone.Name] = zoneID(resourceGroupName, *zone.Name)
+			}
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return zones, nil
+		}
+		resp, err = c.next(ctx, *page.NextLink)
+	}
+}
+
+// CreateOrUpdate creates or updates a private DNS zone.
+func (c *PrivateDNSZonesClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, zone PrivateDNSZone) (*PrivateDNSZone, error) {
+	return createOrUpdatePrivateDNSResource(ctx, c.privateDNSClient, zone, resourceGroupName, name)
+}
+
+// AddTags adds the given tags to the private zone with the given zone ID, or updates their values. Other tags of the
+// zone are kept.
+func (c *PrivateDNSZonesClient) AddTags(ctx context.Context, zoneID string, tags map[string]string) error {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	zone, err := c.Get(ctx, resourceGroupName, zoneName)
+	if err != nil {
+		return err
+	}
+	if zone == nil {
+		return fmt.Errorf("private DNS zone %s does not exist", zoneID)
+	}
+	merged, changed := mergeTags(zone.Tags, tags)
+	if !changed {
+		return nil
+	}
+	_, err = c.CreateOrUpdate(ctx, resourceGroupName, zoneName, PrivateDNSZone{Location: zone.Location, Tags: merged})
+	return err
+}
+
+// Delete deletes a private DNS zone. The zone must not have any virtual network links.
+func (c *PrivateDNSZonesClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	return c.delete(ctx, resourceGroupName, name)
+}
+
+// VirtualNetworkLinksClient is a client for the virtual network links of Azure private DNS zones.
+type VirtualNetworkLinksClient struct {
+	privateDNSClient
+}
+
+// NewVirtualNetworkLinksClient creates a new VirtualNetworkLinks client.
+func NewVirtualNetworkLinksClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*VirtualNetworkLinksClient, error) {
+	client, err := newPrivateDNSClient(auth, tc, opts)
+	return &VirtualNetworkLinksClient{client}, err
+}
+
+// Get returns a virtual network link of a private DNS zone. If the link does not exist nil is returned.
+func (c *VirtualNetworkLinksClient) Get(ctx context.Context, resourceGroupName, zoneName, name string) (*VirtualNetworkLink, error) {
+	link := &VirtualNetworkLink{}
+	if found, err := c.get(ctx, link, resourceGroupName, zoneName, "virtualNetworkLinks", name); err != nil || !found {
+		return nil, err
+	}
+	return link, nil
+}
+
+// CreateOrUpdate creates or updates a virtual network link of a private DNS zone.
+func (c *VirtualNetworkLinksClient) CreateOrUpdate(ctx context.Context, resourceGroupName, zoneName, name string, link VirtualNetworkLink) (*VirtualNetworkLink, error) {
+	return createOrUpdatePrivateDNSResource(ctx, c.privateDNSClient, link, resourceGroupName, zoneName, "virtualNetworkLinks", name)
+}
+
+// Delete deletes a virtual network link of a private DNS zone.
+func (c *VirtualNetworkLinksClient) Delete(ctx context.Context, resourceGroupName, zoneName, name string) error {
+	return c.delete(ctx, resourceGroupName, zoneName, "virtualNetworkLinks", name)
+}
+
+// PrivateDNSRecordSetClient is an implementation of DNSRecordSet for the recordsets of Azure private DNS zones.
+type PrivateDNSRecordSetClient struct {
+	privateDNSClient
+}
+
+// NewPrivateDNSRecordSetClient creates a new PrivateDNSRecordSetClient.
+func NewPrivateDNSRecordSetClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PrivateDNSRecordSetClient, error) {
+	client, err := newPrivateDNSClient(auth, tc, opts)
+	return &PrivateDNSRecordSetClient{client}, err
+}
+
+// CreateOrUpdate creates or updates the recordset with the given name, record type, values, TTL, and tags in the private
+// zone with the given zone ID. The tags replace the metadata of the recordset, except for the metadata managed by Gardener.
+func (c *PrivateDNSRecordSetClient) CreateOrUpdate(ctx context.Context, zoneID string, name string, recordType string, values []string, ttl int64, tags map[string]string) error {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	relativeRecordSetName, err := RelativeRecordSetName(name, zoneName)
+	if err != nil {
+		return err
+	}
+	properties, err := newPrivateDNSRecordSetProperties(recordType, values, ttl)
+	if err != nil {
+		return err
+	}
+	current := &privateDNSRecordSetMetadata{}
+	if _, err := c.get(ctx, current, resourceGroupName, zoneName, recordType, relativeRecordSetName); err != nil {
+		return err
+	}
+	properties.Metadata = recordSetMetadata(current.Properties.Metadata, tags)
+	_, err = createOrUpdatePrivateDNSResource(ctx, c.privateDNSClient, privateDNSRecordSet{Properties: *properties}, resourceGroupName, zoneName, recordType, relativeRecordSetName)
+	return err
+}
+
+// Delete deletes the recordset with the given name and record type in the private zone with the given zone ID.
+func (c *PrivateDNSRecordSetClient) Delete(ctx context.Context, zoneID string, name string, recordType string) error {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	relativeRecordSetName, err := RelativeRecordSetName(name, zoneName)
+	if err != nil {
+		return err
+	}
+	return c.delete(ctx, resourceGroupName, zoneName, recordType, relativeRecordSetName)
+}
+
+// newPrivateDNSRecordSetProperties returns the properties of a recordset of a private DNS zone. Private DNS zones do not
+// support the delegation of subdomains, hence there are no NS records.
+func newPrivateDNSRecordSetProperties(recordType string, values []string, ttl int64) (*privateDNSRecordSetProperties, error) {
+	properties := &privateDNSRecordSetProperties{TTL: ttl}
+	switch recordType {
+	case "A":
+		for _, value := range values {
+			properties.ARecords = append(properties.ARecords, privateDNSARecord{IPv4Address: value})
+		}
+	case "CNAME":
+		properties.CnameRecord = &privateDNSCnameRecord{Cname: values[0]}
+	case "TXT":
+		for _, value := range values {
+			properties.TxtRecords = append(properties.TxtRecords, privateDNSTxtRecord{Value: []string{value}})
+		}
+	default:
+		return nil, fmt.Errorf("record type %s is not supported in private DNS zones", recordType)
+	}
+	return properties, nil
+}
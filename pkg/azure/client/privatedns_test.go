@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("PrivateDNSZones", func() {
+	It("should get a private DNS zone", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusOK,
+			body:       `{"id":"zone-id","name":"privatelink.blob.core.windows.net","location":"global"}`,
+		}
+		c, err := NewPrivateDNSZonesClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		zone, err := c.Get(context.Background(), "rg", "privatelink.blob.core.windows.net")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone).To(Equal(&PrivateDNSZone{
+			ID:       ptr.To("zone-id"),
+			Name:     ptr.To("privatelink.blob.core.windows.net"),
+			Location: ptr.To("global"),
+		}))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].Method).To(Equal(http.MethodGet))
+		Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net"))
+		Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2020-06-01"))
+		Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+	})
+
+	It("should return nil if the private DNS zone does not exist", func() {
+		transport := &recordingTransport{statusCode: http.StatusNotFound, body: `{"error":{"code":"ResourceNotFound"}}`}
+		c, err := NewPrivateDNSZonesClient(ClientAuth{SubscriptionID: "sub"}, fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(context.Background(), "rg", "privatelink.blob.core.windows.net")).To(BeNil())
+	})
+})
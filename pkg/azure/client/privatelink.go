@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+)
+
+var (
+	_ PrivateLinkService = &PrivateLinkServiceClient{}
+	_ PrivateEndpoint    = &PrivateEndpointClient{}
+)
+
+// PrivateLinkServiceClient is an implementation of PrivateLinkService for a private link service k8sClient.
+type PrivateLinkServiceClient struct {
+	client *armnetwork.PrivateLinkServicesClient
+}
+
+// NewPrivateLinkServiceClient creates a new PrivateLinkService client.
+func NewPrivateLinkServiceClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PrivateLinkServiceClient, error) {
+	client, err := armnetwork.NewPrivateLinkServicesClient(auth.SubscriptionID, tc, opts)
+	return &PrivateLinkServiceClient{client}, err
+}
+
+// Get returns a private link service. If the private link service does not exist nil is returned.
+func (c *PrivateLinkServiceClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.PrivateLinkService, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.PrivateLinkService, nil
+}
+
+// PrivateEndpointClient is an implementation of PrivateEndpoint for a private endpoint k8sClient.
+type PrivateEndpointClient struct {
+	client *armnetwork.PrivateEndpointsClient
+}
+
+// NewPrivateEndpointClient creates a new PrivateEndpoint client.
+func NewPrivateEndpointClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PrivateEndpointClient, error) {
+	client, err := armnetwork.NewPrivateEndpointsClient(auth.SubscriptionID, tc, opts)
+	return &PrivateEndpointClient{client}, err
+}
+
+// Get returns a private endpoint. If the private endpoint does not exist nil is returned.
+func (c *PrivateEndpointClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.PrivateEndpoint, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.PrivateEndpoint, nil
+}
+
+// CreateOrUpdate creates or updates a private endpoint.
+func (c *PrivateEndpointClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.PrivateEndpoint) (*armnetwork.PrivateEndpoint, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, poller)
+	return &res.PrivateEndpoint, err
+}
+
+// Delete deletes a private endpoint.
+func (c *PrivateEndpointClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = pollUntilDone(ctx, poller)
+	return err
+}
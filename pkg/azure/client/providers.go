@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+)
+
+var _ Providers = &ProvidersClient{}
+
+// ProvidersClient is a client for resource providers.
+type ProvidersClient struct {
+	client *armresources.ProvidersClient
+}
+
+// NewProvidersClient creates a new ProvidersClient
+func NewProvidersClient(auth *ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ProvidersClient, error) {
+	client, err := armresources.NewProvidersClient(auth.SubscriptionID, tc, opts)
+	return &ProvidersClient{client}, err
+}
+
+// Get gets the resource provider with the given namespace.
+func (c *ProvidersClient) Get(ctx context.Context, resourceProviderNamespace string) (*armresources.Provider, error) {
+	res, err := c.client.Get(ctx, resourceProviderNamespace, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.Provider, nil
+}
+
+// Register registers the subscription with the resource provider with the given namespace.
+func (c *ProvidersClient) Register(ctx context.Context, resourceProviderNamespace string) (*armresources.Provider, error) {
+	res, err := c.client.Register(ctx, resourceProviderNamespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Provider, nil
+}
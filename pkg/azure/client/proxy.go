@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+var (
+	proxyMutex sync.RWMutex
+	proxyFunc  = http.ProxyFromEnvironment
+)
+
+// SetProxy routes the requests of the Azure clients through the proxy with the given URL, except for the requests to
+// the hosts of the given no-proxy list. The list has the format of the NO_PROXY environment variable, e.g. hosts,
+// domains with a leading dot and CIDRs. Credentials for the proxy are taken from the user info of the URL. An empty
+// URL resets the proxy to the one of the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables. The proxy also
+// applies to the clients which were created before.
+func SetProxy(proxyURL string, noProxy []string) {
+	proxyMutex.Lock()
+	defer proxyMutex.Unlock()
+
+	if len(proxyURL) == 0 {
+		proxyFunc = http.ProxyFromEnvironment
+		return
+	}
+
+	fn := (&httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    strings.Join(noProxy, ","),
+	}).ProxyFunc()
+	proxyFunc = func(req *http.Request) (*url.URL, error) {
+		return fn(req.URL)
+	}
+}
+
+// proxy returns the URL of the proxy for the given request, or nil if the request is sent directly.
+func proxy(req *http.Request) (*url.URL, error) {
+	proxyMutex.RLock()
+	defer proxyMutex.RUnlock()
+
+	return proxyFunc(req)
+}
@@ -31,7 +31,7 @@ func (c *PublicIPClient) CreateOrUpdate(ctx context.Context, resourceGroupName,
 	if err != nil {
 		return nil, err
 	}
-	res, err := future.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +74,6 @@ func (c *PublicIPClient) Delete(ctx context.Context, resourceGroupName, name str
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = future.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, future)
 	return err
 }
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+)
+
+var _ PublicIPPrefix = &PublicIPPrefixClient{}
+
+// PublicIPPrefixClient is an implementation of PublicIPPrefix for a public IP prefixes k8sClient.
+type PublicIPPrefixClient struct {
+	client *armnetwork.PublicIPPrefixesClient
+}
+
+// NewPublicIPPrefixClient creates a new PublicIPPrefixClient.
+func NewPublicIPPrefixClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PublicIPPrefixClient, error) {
+	client, err := armnetwork.NewPublicIPPrefixesClient(auth.SubscriptionID, tc, opts)
+	return &PublicIPPrefixClient{client}, err
+}
+
+// Get returns a public IP prefix by name.
+func (c *PublicIPPrefixClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.PublicIPPrefix, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.PublicIPPrefix, nil
+}
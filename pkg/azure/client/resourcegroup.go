@@ -53,7 +53,7 @@ func (c *ResourceGroupClient) Delete(ctx context.Context, resourceGroupName stri
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = resourceGroupResp.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, resourceGroupResp)
 	return err
 }
 
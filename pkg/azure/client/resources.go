@@ -38,3 +38,27 @@ func (c *ResourceClient) ListByResourceGroup(ctx context.Context, resourceGroupN
 	}
 	return res, nil
 }
+
+// List fetches all resources of the subscription which match the filter of the given options.
+func (c *ResourceClient) List(ctx context.Context, options *armresources.ClientListOptions) ([]*armresources.GenericResourceExpanded, error) {
+	var res []*armresources.GenericResourceExpanded
+	pager := c.client.NewListPager(options)
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, nextResult.Value...)
+	}
+	return res, nil
+}
+
+// GetByID fetches the resource with the given ID with the given API version of its resource provider. If the resource
+// does not exist nil is returned.
+func (c *ResourceClient) GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error) {
+	res, err := c.client.GetByID(ctx, resourceID, apiVersion, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.GenericResource, nil
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	roleAssignmentsAPIVersion = "2022-04-01"
+	// roleAssignmentExistsErrorCode is the error code with which Azure rejects a role assignment if the principal is
+	// already assigned the role at the scope under another name.
+	roleAssignmentExistsErrorCode = "RoleAssignmentExists"
+)
+
+var _ RoleAssignments = &RoleAssignmentsClient{}
+
+// RoleDefinition is an Azure role definition.
+type RoleDefinition struct {
+	// ID is the resource ID of the role definition.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the role definition.
+	Name *string `json:"name,omitempty"`
+	// Properties are the properties of the role definition.
+	Properties RoleDefinitionProperties `json:"properties"`
+}
+
+// RoleDefinitionProperties are the properties of a role definition.
+type RoleDefinitionProperties struct {
+	// RoleName is the display name of the role.
+	RoleName *string `json:"roleName,omitempty"`
+	// Permissions are the actions and data actions which the role permits.
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+// RoleAssignment assigns a role to a principal at a scope.
+type RoleAssignment struct {
+	// ID is the resource ID of the role assignment.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the role assignment.
+	Name *string `json:"name,omitempty"`
+	// Properties are the properties of the role assignment.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// RoleAssignmentProperties are the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// RoleDefinitionID is the resource ID of the assigned role definition.
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	// PrincipalID is the object ID of the principal the role is assigned to.
+	PrincipalID *string `json:"principalId,omitempty"`
+	// PrincipalType is the type of the principal, e.g. ServicePrincipal.
+	PrincipalType *string `json:"principalType,omitempty"`
+	// Scope is the scope of the role assignment.
+	Scope *string `json:"scope,omitempty"`
+}
+
+// Permission are the actions which a principal is permitted to perform.
+type Permission struct {
+	// Actions are the permitted actions.
+	Actions []string `json:"actions,omitempty"`
+	// NotActions are the actions which are excluded from Actions.
+	NotActions []string `json:"notActions,omitempty"`
+	// DataActions are the permitted actions on the data of resources, e.g. on the keys of a Key Vault.
+	DataActions []string `json:"dataActions,omitempty"`
+	// NotDataActions are the data actions which are excluded from DataActions.
+	NotDataActions []string `json:"notDataActions,omitempty"`
+}
+
+// Permits returns true if the permission permits the given action. Actions and NotActions may contain wildcards.
+func (p Permission) Permits(action string) bool {
+	return matchesAnyAction(p.Actions, action) && !matchesAnyAction(p.NotActions, action)
+}
+
+// PermitsDataAction returns true if the permission permits the given data action. DataActions and NotDataActions may
+// contain wildcards.
+func (p Permission) PermitsDataAction(dataAction string) bool {
+	return matchesAnyAction(p.DataActions, dataAction) && !matchesAnyAction(p.NotDataActions, dataAction)
+}
+
+func matchesAnyAction(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		expr := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		if matched, err := regexp.MatchString(expr, action); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+type permissionList struct {
+	Value    []Permission `json:"value"`
+	NextLink *string      `json:"nextLink,omitempty"`
+}
+
+type roleAssignmentList struct {
+	Value    []RoleAssignment `json:"value"`
+	NextLink *string          `json:"nextLink,omitempty"`
+}
+
+// RoleAssignmentsClient is a client for Azure role assignments and the role definitions and permissions they relate to.
+type RoleAssignmentsClient struct {
+	client *arm.Client
+}
+
+// NewRoleAssignmentsClient creates a new RoleAssignments client.
+func NewRoleAssignmentsClient(tc azcore.TokenCredential, opts *arm.ClientOptions) (*RoleAssignmentsClient, error) {
+	client, err := arm.NewClient("armauthorization.RoleAssignmentsClient", "v1.0.0", tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &RoleAssignmentsClient{client: client}, nil
+}
+
+// GetRoleDefinition returns the role definition with the given resource ID. If the role definition does not exist nil
+// is returned.
+func (c *RoleAssignmentsClient) GetRoleDefinition(ctx context.Context, id string) (*RoleDefinition, error) {
+	resp, err := c.do(ctx, http.MethodGet, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	definition := &RoleDefinition{}
+	if err := runtime.UnmarshalAsJSON(resp, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
+
+// ListPermissions returns the permissions of the authenticated principal at the given scope.
+func (c *RoleAssignmentsClient) ListPermissions(ctx context.Context, scope string) ([]Permission, error) {
+	if _, err := arm.ParseResourceID(scope); err != nil {
+		return nil, fmt.Errorf("invalid resource ID %q: %w", scope, err)
+	}
+
+	var (
+		permissions []Permission
+		link        = runtime.JoinPaths(c.client.Endpoint(), strings.TrimSuffix(scope, "/"), "/providers/Microsoft.Authorization/permissions") + "?api-version=" + roleAssignmentsAPIVersion
+	)
+	for link != "" {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, link)
+		if err != nil {
+			return nil, err
+		}
+		req.Raw().Header.Set("Accept", "application/json")
+		resp, err := c.client.Pipeline().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+
+		page := &permissionList{}
+		if err := runtime.UnmarshalAsJSON(resp, page); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, page.Value...)
+		link = ptr.Deref(page.NextLink, "")
+	}
+	return permissions, nil
+}
+
+// ListForPrincipal returns the role assignments of the principal with the given object ID at, above and below the given
+// scope. Roles which are assigned to groups of the principal are not included.
+func (c *RoleAssignmentsClient) ListForPrincipal(ctx context.Context, scope, principalID string) ([]RoleAssignment, error) {
+	if _, err := arm.ParseResourceID(scope); err != nil {
+		return nil, fmt.Errorf("invalid resource ID %q: %w", scope, err)
+	}
+
+	var (
+		assignments []RoleAssignment
+		link        = runtime.JoinPaths(c.client.Endpoint(), strings.TrimSuffix(scope, "/"), "/providers/Microsoft.Authorization/roleAssignments") + "?" + url.Values{
+			"api-version": {roleAssignmentsAPIVersion},
+			"$filter":     {fmt.Sprintf("principalId eq '%s'", principalID)},
+		}.Encode()
+	)
+	for link != "" {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, link)
+		if err != nil {
+			return nil, err
+		}
+		req.Raw().Header.Set("Accept", "application/json")
+		resp, err := c.client.Pipeline().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+
+		page := &roleAssignmentList{}
+		if err := runtime.UnmarshalAsJSON(resp, page); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, page.Value...)
+		link = ptr.Deref(page.NextLink, "")
+	}
+	return assignments, nil
+}
+
+// Get returns the role assignment with the given name at the given scope. If the role assignment does not exist nil is
+// returned.
+func (c *RoleAssignmentsClient) Get(ctx context.Context, scope, name string) (*RoleAssignment, error) {
+	resp, err := c.doRoleAssignment(ctx, http.MethodGet, scope, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+
+	assignment := &RoleAssignment{}
+	if err := runtime.UnmarshalAsJSON(resp, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// Create creates the role assignment with the given name at the given scope. Role assignments cannot be updated.
+func (c *RoleAssignmentsClient) Create(ctx context.Context, scope, name string, assignment RoleAssignment) (*RoleAssignment, error) {
+	resp, err := c.doRoleAssignment(ctx, http.MethodPut, scope, name, &assignment)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	created := &RoleAssignment{}
+	if err := runtime.UnmarshalAsJSON(resp, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Delete deletes the role assignment with the given name at the given scope.
+func (c *RoleAssignmentsClient) Delete(ctx context.Context, scope, name string) error {
+	resp, err := c.doRoleAssignment(ctx, http.MethodDelete, scope, name, nil)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusNoContent) {
+		return FilterNotFoundError(runtime.NewResponseError(resp))
+	}
+	return nil
+}
+
+// IsRoleAssignmentExistsError returns true if the error indicates that the role is already assigned to the principal at
+// the scope.
+func IsRoleAssignmentExistsError(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusConflict && respErr.ErrorCode == roleAssignmentExistsErrorCode
+}
+
+func (c *RoleAssignmentsClient) doRoleAssignment(ctx context.Context, method, scope, name string, body any) (*http.Response, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name of the role assignment must not be empty")
+	}
+	return c.do(ctx, method, strings.TrimSuffix(scope, "/")+"/providers/Microsoft.Authorization/roleAssignments/"+url.PathEscape(name), body)
+}
+
+// do sends a request for the resource with the given ID. The ID is used as path as is, hence it must be a valid resource
+// ID as returned by Azure.
+func (c *RoleAssignmentsClient) do(ctx context.Context, method, id string, body any) (*http.Response, error) {
+	if _, err := arm.ParseResourceID(id); err != nil {
+		return nil, fmt.Errorf("invalid resource ID %q: %w", id, err)
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.client.Endpoint(), id))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", roleAssignmentsAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header.Set("Accept", "application/json")
+
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	return c.client.Pipeline().Do(req)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("RoleAssignments", func() {
+	const (
+		scope            = "/subscriptions/sub/resourceGroups/shoot--foo--bar"
+		roleDefinitionID = "/subscriptions/sub/providers/Microsoft.Authorization/roleDefinitions/acrpull"
+	)
+
+	It("should create a role assignment at the given scope", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusCreated,
+			body:       `{"name":"assignment","properties":{"roleDefinitionId":"` + roleDefinitionID + `","principalId":"principal"}}`,
+		}
+		c, err := NewRoleAssignmentsClient(fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		assignment, err := c.Create(context.Background(), scope, "assignment", RoleAssignment{Properties: RoleAssignmentProperties{
+			RoleDefinitionID: ptr.To(roleDefinitionID),
+			PrincipalID:      ptr.To("principal"),
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(assignment).To(Equal(&RoleAssignment{Name: ptr.To("assignment"), Properties: RoleAssignmentProperties{
+			RoleDefinitionID: ptr.To(roleDefinitionID),
+			PrincipalID:      ptr.To("principal"),
+		}}))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].Method).To(Equal(http.MethodPut))
+		Expect(transport.requests[0].URL.Path).To(Equal(scope + "/providers/Microsoft.Authorization/roleAssignments/assignment"))
+		Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2022-04-01"))
+		Expect(transport.requests[0].Header.Get("Authorization")).To(Equal("Bearer token"))
+	})
+
+	It("should detect that the role is already assigned", func() {
+		transport := &recordingTransport{statusCode: http.StatusConflict, body: `{"error":{"code":"RoleAssignmentExists"}}`}
+		c, err := NewRoleAssignmentsClient(fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.Create(context.Background(), scope, "assignment", RoleAssignment{})
+		Expect(IsRoleAssignmentExistsError(err)).To(BeTrue())
+	})
+
+	It("should list the role assignments of a principal at the given scope", func() {
+		transport := &recordingTransport{
+			statusCode: http.StatusOK,
+			body:       `{"value":[{"name":"assignment","properties":{"roleDefinitionId":"` + roleDefinitionID + `","principalId":"principal","scope":"/subscriptions/sub"}}]}`,
+		}
+		c, err := NewRoleAssignmentsClient(fakeTokenCredential{}, clientOptions(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		assignments, err := c.ListForPrincipal(context.Background(), scope, "principal")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(assignments).To(ConsistOf(RoleAssignment{Name: ptr.To("assignment"), Properties: RoleAssignmentProperties{
+			RoleDefinitionID: ptr.To(roleDefinitionID),
+			PrincipalID:      ptr.To("principal"),
+			Scope:            ptr.To("/subscriptions/sub"),
+		}}))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].URL.Path).To(Equal(scope + "/providers/Microsoft.Authorization/roleAssignments"))
+		Expect(transport.requests[0].URL.Query().Get("$filter")).To(Equal("principalId eq 'principal'"))
+	})
+
+	It("should match the data actions of a permission with wildcards", func() {
+		permission := Permission{DataActions: []string{"Microsoft.KeyVault/vaults/keys/*"}, NotDataActions: []string{"Microsoft.KeyVault/vaults/keys/sign/action"}}
+		Expect(permission.PermitsDataAction("Microsoft.KeyVault/vaults/keys/unwrap/action")).To(BeTrue())
+		Expect(permission.PermitsDataAction("microsoft.keyvault/vaults/keys/sign/action")).To(BeFalse())
+		Expect(permission.Permits("Microsoft.KeyVault/vaults/keys/unwrap/action")).To(BeFalse())
+	})
+})
@@ -32,7 +32,7 @@ func (c *RouteTablesClient) CreateOrUpdate(ctx context.Context, resourceGroupNam
 	if err != nil {
 		return nil, fmt.Errorf("cannot create route table: %v", err)
 	}
-	res, err := poller.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, poller)
 	return &res.RouteTable, err
 }
 
@@ -42,7 +42,7 @@ func (c *RouteTablesClient) Delete(ctx context.Context, resourceGroupName, name
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = poller.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, poller)
 	return err
 }
 
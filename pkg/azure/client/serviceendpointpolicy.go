@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+)
+
+var _ ServiceEndpointPolicy = &ServiceEndpointPolicyClient{}
+
+// ServiceEndpointPolicyClient is an implementation of ServiceEndpointPolicy for a service endpoint policies k8sClient.
+type ServiceEndpointPolicyClient struct {
+	client *armnetwork.ServiceEndpointPoliciesClient
+}
+
+// NewServiceEndpointPolicyClient creates a new ServiceEndpointPolicyClient.
+func NewServiceEndpointPolicyClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ServiceEndpointPolicyClient, error) {
+	client, err := armnetwork.NewServiceEndpointPoliciesClient(auth.SubscriptionID, tc, opts)
+	return &ServiceEndpointPolicyClient{client}, err
+}
+
+// Get returns a service endpoint policy by name.
+func (c *ServiceEndpointPolicyClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.ServiceEndpointPolicy, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.ServiceEndpointPolicy, nil
+}
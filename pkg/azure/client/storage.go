@@ -46,6 +46,8 @@ func BlobStorageDomainFromCloudConfiguration(cloudConfiguration *azureapi.CloudC
 	case strings.EqualFold(cloudConfiguration.Name, "AzureChina"):
 		// source: https://learn.microsoft.com/en-us/azure/china/resources-developer-guide#check-endpoints-in-azure
 		return azure.AzureChinaBlobStorageDomain, nil
+	case strings.EqualFold(cloudConfiguration.Name, azureapi.AzureStackHubCloudName):
+		return azureStackHubBlobStorageDomain()
 	}
 	return "", fmt.Errorf("unknown cloud configuration name '%s'", cloudConfiguration.Name)
 }
@@ -62,7 +64,9 @@ func NewBlobStorageClient(_ context.Context, storageAccountName, storageAccountK
 		return nil, fmt.Errorf("failed to parse service url: %v", err)
 	}
 
-	containerClient, err := container.NewClientWithSharedKeyCredential(containerEndpointURL.String(), credentials, nil)
+	containerClient, err := container.NewClientWithSharedKeyCredential(containerEndpointURL.String(), credentials, &container.ClientOptions{
+		ClientOptions: DataPlaneClientOptions(),
+	})
 	return &BlobStorageClient{containerClient}, err
 }
 
@@ -96,6 +100,32 @@ func NewBlobStorageClientFromSecret(ctx context.Context, secret *corev1.Secret,
 	return NewBlobStorageClient(ctx, string(storageAccountName), string(storageAccountKey), storageDomain, containerName)
 }
 
+// NewSecondaryBlobStorageClientFromSecretRef creates a client for the container <containerName> in the secondary storage
+// account of a backup bucket by reading auth information from a secret reference. If the secret does not contain a
+// secondary storage account, nil is returned.
+func NewSecondaryBlobStorageClientFromSecretRef(ctx context.Context, client client.Client, secretRef *corev1.SecretReference, containerName string) (*BlobStorageClient, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, client, secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	storageAccountName, ok := secret.Data[azure.SecondaryStorageAccount]
+	if !ok {
+		return nil, nil
+	}
+	storageAccountKey, ok := secret.Data[azure.SecondaryStorageKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s doesn't have a storage key of the secondary storage account", secret.Namespace, secret.Name)
+	}
+
+	storageDomain := azure.AzureBlobStorageDomain
+	if v, ok := secret.Data[azure.StorageDomain]; ok {
+		storageDomain = string(v)
+	}
+
+	return NewBlobStorageClient(ctx, string(storageAccountName), string(storageAccountKey), storageDomain, containerName)
+}
+
 // CleanupObjectsWithPrefix cleans up the blob objects with the specific <prefix> from <container>.
 //
 // If the <container> has no immutability, the objects are deleted.
@@ -104,23 +134,52 @@ func NewBlobStorageClientFromSecret(ctx context.Context, secret *corev1.Secret,
 //  1. If the object's immutability has expired, it is deleted.
 //  2. If the object's immutability has not expired, the BlobMarkedForDeletionTagKey tag is added to perform a delayed delete of the object through the lifecycle policy set on the storage account.
 //
+// If the versioning of blobs is enabled in the storage account, e.g. for object replication, deleting a blob keeps its
+// versions, hence all versions of the objects are deleted as well.
+//
 // If blobs with <prefix> do not exist, no error is returned.
 func (c *BlobStorageClient) CleanupObjectsWithPrefix(ctx context.Context, prefix string) error {
-	pager := c.client.NewListBlobsFlatPager(&azblob.ListBlobsFlatOptions{Prefix: ptr.To(prefix)})
+	pager := c.client.NewListBlobsFlatPager(&azblob.ListBlobsFlatOptions{
+		Prefix:  ptr.To(prefix),
+		Include: container.ListBlobsInclude{Versions: true},
+	})
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
 			return err
 		}
 		for _, blob := range page.Segment.BlobItems {
-			if err := c.cleanupBlobIfExists(ctx, *blob.Name); err != nil {
-				return err
+			if blob.VersionID == nil || ptr.Deref(blob.IsCurrentVersion, false) {
+				if err := c.cleanupBlobIfExists(ctx, *blob.Name); err != nil {
+					return err
+				}
+			}
+			if blob.VersionID != nil {
+				if err := c.deleteBlobVersionIfExists(ctx, *blob.Name, *blob.VersionID); err != nil {
+					return err
+				}
 			}
 		}
 	}
 	return nil
 }
 
+// deleteBlobVersionIfExists deletes the version <versionID> of the azure blob with name <blobName>. Deleting the current
+// version of a blob makes it a previous version, hence the previous versions are deleted after the blob itself.
+//
+// If the version does not exist, no error is returned.
+func (c *BlobStorageClient) deleteBlobVersionIfExists(ctx context.Context, blobName, versionID string) error {
+	blobClient, err := c.client.NewBlobClient(blobName).WithVersionID(versionID)
+	if err != nil {
+		return err
+	}
+	_, err = blobClient.Delete(ctx, nil)
+	if err == nil || bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return nil
+	}
+	return err
+}
+
 // cleanupBlobIfExists cleans up the azure blob with name <blobName>.
 //
 // If the <container> has no immutability, the objects are deleted.
@@ -26,8 +26,24 @@ func NewStorageAccountClient(auth *ClientAuth, tc azcore.TokenCredential, opts *
 	return &StorageAccountClient{client}, err
 }
 
-// CreateOrUpdateStorageAccount creates a storage account.
-func (c *StorageAccountClient) CreateOrUpdateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string, keyExpiration *int32) error {
+// StorageAccountOptions are the settings of a storage account which is created or updated by
+// CreateOrUpdateStorageAccount.
+type StorageAccountOptions struct {
+	// KeyExpirationDays is the expiration period of the access keys in days. If not set, the keys do not expire.
+	KeyExpirationDays *int32
+	// GeoReplication additionally replicates the storage account to the secondary region with read access.
+	GeoReplication bool
+	// InfrastructureEncryption encrypts the data at rest a second time with platform-managed keys, which only takes
+	// effect when the storage account is created.
+	InfrastructureEncryption bool
+	// SFTP enables the SFTP endpoint, which requires the hierarchical namespace that is also only enabled when the
+	// storage account is created. Otherwise, the SFTP endpoint is disabled and the namespace is kept.
+	SFTP bool
+}
+
+// CreateOrUpdateStorageAccount creates a storage account with the given options and returns it. The storage account is
+// zone-redundant.
+func (c *StorageAccountClient) CreateOrUpdateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string, opts StorageAccountOptions) (*armstorage.Account, error) {
 	properties := armstorage.AccountPropertiesCreateParameters{
 		AccessTier:             ptr.To(armstorage.AccessTierCool),
 		EnableHTTPSTrafficOnly: ptr.To(true),
@@ -36,26 +52,81 @@ func (c *StorageAccountClient) CreateOrUpdateStorageAccount(ctx context.Context,
 		KeyPolicy: &armstorage.KeyPolicy{
 			KeyExpirationPeriodInDays: ptr.To(int32(0)),
 		},
+		IsSftpEnabled: ptr.To(opts.SFTP),
 	}
-	if keyExpiration != nil {
+	if opts.KeyExpirationDays != nil {
 		properties.KeyPolicy = &armstorage.KeyPolicy{
-			KeyExpirationPeriodInDays: keyExpiration,
+			KeyExpirationPeriodInDays: opts.KeyExpirationDays,
+		}
+	}
+	if opts.InfrastructureEncryption {
+		properties.Encryption = &armstorage.Encryption{
+			KeySource:                       ptr.To(armstorage.KeySourceMicrosoftStorage),
+			RequireInfrastructureEncryption: ptr.To(true),
 		}
 	}
+	if opts.SFTP {
+		properties.IsHnsEnabled = ptr.To(true)
+	}
+	skuName := armstorage.SKUNameStandardZRS
+	if opts.GeoReplication {
+		skuName = armstorage.SKUNameStandardRAGZRS
+	}
 	poller, err := c.client.BeginCreate(ctx, resourceGroupName, storageAccountName, armstorage.AccountCreateParameters{
 		Kind:       ptr.To(armstorage.KindStorageV2),
 		Location:   &region,
-		SKU:        &armstorage.SKU{Name: ptr.To(armstorage.SKUNameStandardZRS)},
+		SKU:        &armstorage.SKU{Name: ptr.To(skuName)},
 		Properties: &properties,
 	}, nil)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	res, err := pollUntilDone(ctx, poller)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Account, nil
+}
+
+// GetStorageAccount returns the storage account with the given name in the given resource group. It returns nil if the
+// storage account does not exist.
+func (c *StorageAccountClient) GetStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.Account, error) {
+	response, err := c.client.GetProperties(ctx, resourceGroupName, storageAccountName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
 	}
+	return &response.Account, nil
+}
 
-	_, err = poller.PollUntilDone(ctx, nil)
+// CheckNameAvailability checks whether a storage account with the given name can be created. Storage account names are
+// globally unique, hence the name is unavailable if any storage account in Azure has it. If the name is unavailable,
+// the reason is returned as well.
+func (c *StorageAccountClient) CheckNameAvailability(ctx context.Context, storageAccountName string) (bool, string, error) {
+	response, err := c.client.CheckNameAvailability(ctx, armstorage.AccountCheckNameAvailabilityParameters{
+		Name: ptr.To(storageAccountName),
+		Type: ptr.To("Microsoft.Storage/storageAccounts"),
+	}, nil)
+	if err != nil {
+		return false, "", err
+	}
+	return ptr.Deref(response.NameAvailable, false), ptr.Deref(response.Message, ""), nil
+}
 
-	return err
+// GetGeoReplicationStats returns the geo-replication statistics of the specified storage account. It returns nil if the
+// storage account is not geo-replicated.
+func (c *StorageAccountClient) GetGeoReplicationStats(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.GeoReplicationStats, error) {
+	response, err := c.client.GetProperties(ctx, resourceGroupName, storageAccountName, &armstorage.AccountsClientGetPropertiesOptions{
+		Expand: ptr.To(armstorage.StorageAccountExpandGeoReplicationStats),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Properties == nil {
+		return nil, nil
+	}
+	return response.Properties.GeoReplicationStats, nil
 }
 
 // ListStorageAccountKeys lists all keys for the specified storage account.
@@ -31,7 +31,7 @@ func (c *SubnetsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, v
 	if err != nil {
 		return nil, err
 	}
-	res, err := poller.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, poller)
 	return &res.Subnet, err
 }
 
@@ -71,6 +71,6 @@ func (c *SubnetsClient) Delete(ctx context.Context, resourceGroupName, vnetName,
 		return FilterNotFoundError(err)
 	}
 
-	_, err = poller.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, poller)
 	return err
 }
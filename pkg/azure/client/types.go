@@ -21,6 +21,7 @@ type Factory interface {
 	DNSZone() (DNSZone, error)
 	DNSRecordSet() (DNSRecordSet, error)
 	VirtualMachine() (VirtualMachine, error)
+	VirtualMachineExtensions() (VirtualMachineExtensions, error)
 	NetworkInterface() (NetworkInterface, error)
 	Disk() (Disk, error)
 	Group() (ResourceGroup, error)
@@ -29,13 +30,35 @@ type Factory interface {
 	Subnet() (Subnet, error)
 	LoadBalancer() (LoadBalancer, error)
 	PublicIP() (PublicIP, error)
+	PublicIPPrefix() (PublicIPPrefix, error)
 	Vnet() (VirtualNetwork, error)
 	RouteTables() (RouteTables, error)
 	NatGateway() (NatGateway, error)
 	ManagedUserIdentity() (ManagedUserIdentity, error)
+	FederatedIdentityCredentials() (FederatedIdentityCredentials, error)
 	VirtualMachineImages() (VirtualMachineImages, error)
+	GalleryImageVersions() (GalleryImageVersions, error)
+	MarketplaceAgreements() (MarketplaceAgreements, error)
+	ManagementLocks() (ManagementLocks, error)
 	BlobContainers() (BlobContainers, error)
 	ManagementPolicies() (ManagementPolicies, error)
+	LocalUsers() (LocalUsers, error)
+	ObjectReplication() (ObjectReplication, error)
+	BlobServices() (BlobServices, error)
+	Providers() (Providers, error)
+	ServiceEndpointPolicy() (ServiceEndpointPolicy, error)
+	Usage() (Usage, error)
+	NetworkWatcher() (NetworkWatcher, error)
+	FlowLog() (FlowLog, error)
+	DiagnosticSettings() (DiagnosticSettings, error)
+	PrivateLinkService() (PrivateLinkService, error)
+	PrivateEndpoint() (PrivateEndpoint, error)
+	PrivateDNSZones() (PrivateDNSZones, error)
+	PrivateDNSRecordSet() (DNSRecordSet, error)
+	VirtualNetworkLinks() (VirtualNetworkLinks, error)
+	DataCollectionRules() (DataCollectionRules, error)
+	KeyVaultKeys() (KeyVaultKeys, error)
+	RoleAssignments() (RoleAssignments, error)
 }
 
 // ResourceGroup represents an Azure ResourceGroup k8sClient.
@@ -46,6 +69,12 @@ type ResourceGroup interface {
 	ContainerCheckExistenceFunc[armresources.ResourceGroup]
 }
 
+// Providers represents an Azure resource providers k8sClient.
+type Providers interface {
+	Get(context.Context, string) (*armresources.Provider, error)
+	Register(context.Context, string) (*armresources.Provider, error)
+}
+
 // NatGateway is an interface for the Azure NatGateway service.
 type NatGateway interface {
 	CreateOrUpdateFunc[armnetwork.NatGateway]
@@ -61,17 +90,73 @@ type RouteTables interface {
 	GetFunc[armnetwork.RouteTable]
 }
 
+// ServiceEndpointPolicy is a k8sClient for the Azure service endpoint policy service.
+type ServiceEndpointPolicy interface {
+	GetFunc[armnetwork.ServiceEndpointPolicy]
+}
+
+// NetworkWatcher is a k8sClient for the Azure network watcher service.
+type NetworkWatcher interface {
+	ListAll(ctx context.Context) ([]*armnetwork.Watcher, error)
+	CreateOrUpdateFunc[armnetwork.Watcher]
+	DeleteFunc[armnetwork.Watcher]
+}
+
+// FlowLog is a k8sClient for the flow logs of an Azure network watcher.
+type FlowLog interface {
+	SubResourceCreateOrUpdateFunc[armnetwork.FlowLog]
+	SubResourceGetFunc[armnetwork.FlowLog]
+	SubResourceListFunc[armnetwork.FlowLog]
+	SubResourceDeleteFunc[armnetwork.FlowLog]
+}
+
+// PrivateLinkService is a k8sClient for Azure private link services.
+type PrivateLinkService interface {
+	GetFunc[armnetwork.PrivateLinkService]
+}
+
+// PrivateEndpoint is a k8sClient for the Azure private endpoint service.
+type PrivateEndpoint interface {
+	GetFunc[armnetwork.PrivateEndpoint]
+	CreateOrUpdateFunc[armnetwork.PrivateEndpoint]
+	DeleteFunc[armnetwork.PrivateEndpoint]
+}
+
+// PrivateDNSZones is a k8sClient for Azure private DNS zones.
+type PrivateDNSZones interface {
+	GetFunc[PrivateDNSZone]
+	ListByResourceGroup(context.Context, string) (map[string]string, error)
+	CreateOrUpdateFunc[PrivateDNSZone]
+	DeleteFunc[PrivateDNSZone]
+	AddTags(context.Context, string, map[string]string) error
+}
+
+// VirtualNetworkLinks is a k8sClient for the virtual network links of Azure private DNS zones.
+type VirtualNetworkLinks interface {
+	SubResourceGetFunc[VirtualNetworkLink]
+	SubResourceCreateOrUpdateFunc[VirtualNetworkLink]
+	SubResourceDeleteFunc[VirtualNetworkLink]
+}
+
 // ManagedUserIdentity is a k8sClient for the Azure Managed User Identity service.
 type ManagedUserIdentity interface {
 	GetFunc[armmsi.UserAssignedIdentitiesClientGetResponse]
 }
 
+// FederatedIdentityCredentials is a k8sClient for the federated identity credentials of Azure Managed User Identities.
+type FederatedIdentityCredentials interface {
+	SubResourceGetFunc[armmsi.FederatedIdentityCredential]
+	SubResourceCreateOrUpdateFunc[armmsi.FederatedIdentityCredential]
+	SubResourceDeleteFunc[armmsi.FederatedIdentityCredential]
+}
+
 // Vmss represents an Azure virtual machine scale set k8sClient.
 type Vmss interface {
 	ListFunc[armcompute.VirtualMachineScaleSet]
 	GetWithExpandFunc[armcompute.VirtualMachineScaleSet, *armcompute.ExpandTypesForGetVMScaleSets]
 	CreateOrUpdateFunc[armcompute.VirtualMachineScaleSet]
 	DeleteWithOptsFunc[armcompute.VirtualMachineScaleSet, *bool]
+	UpdateTags(context.Context, string, string, map[string]*string) (*armcompute.VirtualMachineScaleSet, error)
 }
 
 // VirtualMachine represents an Azure virtual machine k8sClient.
@@ -79,6 +164,15 @@ type VirtualMachine interface {
 	GetWithExpandFunc[armcompute.VirtualMachine, *armcompute.InstanceViewTypes]
 	CreateOrUpdateFunc[armcompute.VirtualMachine]
 	DeleteWithOptsFunc[armcompute.VirtualMachine, *bool]
+	ListByVmss(context.Context, string, string) ([]*armcompute.VirtualMachine, error)
+	UpdateTags(context.Context, string, string, map[string]*string) (*armcompute.VirtualMachine, error)
+	Update(context.Context, string, string, armcompute.VirtualMachineUpdate) (*armcompute.VirtualMachine, error)
+}
+
+// VirtualMachineExtensions represents an Azure virtual machine extensions k8sClient.
+type VirtualMachineExtensions interface {
+	SubResourceCreateOrUpdateFunc[armcompute.VirtualMachineExtension]
+	SubResourceDeleteFunc[armcompute.VirtualMachineExtension]
 }
 
 // NetworkSecurityGroup represents an Azure Network security group k8sClient.
@@ -96,6 +190,11 @@ type PublicIP interface {
 	ListFunc[armnetwork.PublicIPAddress]
 }
 
+// PublicIPPrefix represents an Azure Network Public IP Prefix k8sClient.
+type PublicIPPrefix interface {
+	GetFunc[armnetwork.PublicIPPrefix]
+}
+
 // NetworkInterface represents an Azure Network Interface k8sClient.
 type NetworkInterface interface {
 	GetFunc[armnetwork.Interface]
@@ -108,6 +207,7 @@ type Disk interface {
 	GetFunc[armcompute.Disk]
 	CreateOrUpdateFunc[armcompute.Disk]
 	DeleteFunc[armcompute.Disk]
+	Update(context.Context, string, string, armcompute.DiskUpdate) (*armcompute.Disk, error)
 }
 
 // Subnet represents an Azure Subnet k8sClient.
@@ -122,7 +222,9 @@ type Subnet interface {
 type LoadBalancer interface {
 	GetFunc[armnetwork.LoadBalancer]
 	ListFunc[armnetwork.LoadBalancer]
+	CreateOrUpdateFunc[armnetwork.LoadBalancer]
 	DeleteFunc[armnetwork.LoadBalancer]
+	UpdateIfUnchanged(ctx context.Context, resourceGroupName, name string, parameters armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error)
 }
 
 // VirtualNetwork represents an Azure Virtual Network k8sClient.
@@ -134,25 +236,88 @@ type VirtualNetwork interface {
 
 // StorageAccount represents an Azure storage account k8sClient.
 type StorageAccount interface {
-	CreateOrUpdateStorageAccount(context.Context, string, string, string, *int32) error
+	CreateOrUpdateStorageAccount(context.Context, string, string, string, StorageAccountOptions) (*armstorage.Account, error)
+	GetStorageAccount(context.Context, string, string) (*armstorage.Account, error)
+	CheckNameAvailability(context.Context, string) (bool, string, error)
+	GetGeoReplicationStats(context.Context, string, string) (*armstorage.GeoReplicationStats, error)
 	ListStorageAccountKeys(context.Context, string, string) ([]*armstorage.AccountKey, error)
 	RotateKey(context.Context, string, string, string) ([]*armstorage.AccountKey, error)
 }
 
+// DiagnosticSettings represents an Azure k8sClient for the diagnostic settings of resources.
+type DiagnosticSettings interface {
+	Get(ctx context.Context, resourceGroupName, resourcePath, name string) (*DiagnosticSetting, error)
+	CreateOrUpdate(ctx context.Context, resourceGroupName, resourcePath, name string, setting DiagnosticSetting) error
+	Delete(ctx context.Context, resourceGroupName, resourcePath, name string) error
+}
+
+// DataCollectionRules represents an Azure k8sClient for the data collection rules of Azure Monitor and their
+// associations with resources.
+type DataCollectionRules interface {
+	Get(ctx context.Context, id string) (*DataCollectionRule, error)
+	GetAssociation(ctx context.Context, resourceID, name string) (*DataCollectionRuleAssociation, error)
+	CreateOrUpdateAssociation(ctx context.Context, resourceID, name string, association DataCollectionRuleAssociation) error
+	DeleteAssociation(ctx context.Context, resourceID, name string) error
+}
+
+// RoleAssignments represents an Azure k8sClient for role assignments, the role definitions they assign and the
+// permissions of the authenticated principal.
+type RoleAssignments interface {
+	GetRoleDefinition(ctx context.Context, id string) (*RoleDefinition, error)
+	ListPermissions(ctx context.Context, scope string) ([]Permission, error)
+	ListForPrincipal(ctx context.Context, scope, principalID string) ([]RoleAssignment, error)
+	Get(ctx context.Context, scope, name string) (*RoleAssignment, error)
+	Create(ctx context.Context, scope, name string, assignment RoleAssignment) (*RoleAssignment, error)
+	Delete(ctx context.Context, scope, name string) error
+}
+
+// KeyVaultKeys represents an Azure k8sClient for the keys of Key Vaults.
+type KeyVaultKeys interface {
+	GetKey(ctx context.Context, keyID string) (*KeyVaultKey, error)
+}
+
 // DNSZone represents an Azure DNS zone k8sClient.
 type DNSZone interface {
 	List(context.Context) (map[string]string, error)
+	ListByResourceGroup(context.Context, string) (map[string]string, error)
+	AddTags(context.Context, string, map[string]string) error
 }
 
 // DNSRecordSet represents an Azure DNS recordset k8sClient.
 type DNSRecordSet interface {
-	CreateOrUpdate(context.Context, string, string, string, []string, int64) error
+	CreateOrUpdate(context.Context, string, string, string, []string, int64, map[string]string) error
 	Delete(context.Context, string, string, string) error
 }
 
 // VirtualMachineImages represents an Azure Virtual Machine Image k8sClient.
 type VirtualMachineImages interface {
 	ListSkus(ctx context.Context, location string, publisherName string, offer string) (*armcompute.VirtualMachineImagesClientListSKUsResponse, error)
+	Get(ctx context.Context, location, publisherName, offer, sku, version string) (*armcompute.VirtualMachineImage, error)
+}
+
+// GalleryImageVersions represents an Azure k8sClient for the versions of images in Azure Compute Galleries.
+type GalleryImageVersions interface {
+	Get(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName, versionName string) (*armcompute.GalleryImageVersion, error)
+	GetShared(ctx context.Context, location, galleryUniqueName, imageName, versionName string) (*armcompute.SharedGalleryImageVersion, error)
+	GetCommunity(ctx context.Context, location, publicGalleryName, imageName, versionName string) (*armcompute.CommunityGalleryImageVersion, error)
+}
+
+// MarketplaceAgreements represents an Azure k8sClient for the agreements of the terms of Azure Marketplace plans.
+type MarketplaceAgreements interface {
+	Get(ctx context.Context, publisher, offer, plan string) (*MarketplaceAgreement, error)
+	Accept(ctx context.Context, agreement MarketplaceAgreement) (*MarketplaceAgreement, error)
+}
+
+// ManagementLocks represents an Azure k8sClient for the management locks of resources.
+type ManagementLocks interface {
+	ListAtScope(ctx context.Context, scope string) ([]*ManagementLock, error)
+}
+
+// Usage represents an Azure k8sClient for the quota usages and the virtual machine SKUs of a subscription.
+type Usage interface {
+	ListComputeUsages(ctx context.Context, location string) ([]*armcompute.Usage, error)
+	ListNetworkUsages(ctx context.Context, location string) ([]*armnetwork.Usage, error)
+	ListVirtualMachineSKUs(ctx context.Context, location string) ([]*armcompute.ResourceSKU, error)
 }
 
 // BlobStorage represents an Azure blob storage k8sClient.
@@ -163,12 +328,15 @@ type BlobStorage interface {
 // Resource is an Azure resources client.
 type Resource interface {
 	ListByResourceGroup(ctx context.Context, resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) ([]*armresources.GenericResourceExpanded, error)
+	List(ctx context.Context, options *armresources.ClientListOptions) ([]*armresources.GenericResourceExpanded, error)
+	GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error)
 }
 
 // BlobContainers is an Azure Blob Container client.
 type BlobContainers interface {
 	GetContainer(context.Context, string, string, string) (armstorage.BlobContainersClientGetResponse, error)
 	CreateContainer(context.Context, string, string, string) (armstorage.BlobContainersClientCreateResponse, error)
+	DisallowContainerPublicAccess(context.Context, string, string, string) error
 	GetImmutabilityPolicy(context.Context, string, string, string) (*int32, bool, *string, error)
 	CreateOrUpdateImmutabilityPolicy(context.Context, string, string, string, *int32) (*string, error)
 	DeleteImmutabilityPolicy(context.Context, string, string, string, *string) error
@@ -180,4 +348,28 @@ type BlobContainers interface {
 // ManagementPolicies is an Azure Blob Storage storage account lifecycle policy client
 type ManagementPolicies interface {
 	CreateOrUpdate(context.Context, string, string, int) error
+	SetRule(ctx context.Context, resourceGroup, storageAccount string, rule *armstorage.ManagementPolicyRule) error
+	Get(ctx context.Context, resourceGroup, storageAccount string) (*armstorage.ManagementPolicy, error)
+	Update(ctx context.Context, resourceGroup, storageAccount string, policy armstorage.ManagementPolicy) error
+	Delete(ctx context.Context, resourceGroup, storageAccount string) error
+}
+
+// LocalUsers is a client for the local users of Azure storage accounts, which authenticate at the SFTP endpoint.
+type LocalUsers interface {
+	CreateOrUpdate(ctx context.Context, resourceGroup, storageAccount, username string, user armstorage.LocalUser) error
+	Delete(ctx context.Context, resourceGroup, storageAccount, username string) error
+}
+
+// BlobServices is a client for the blob service properties of Azure storage accounts.
+type BlobServices interface {
+	GetServiceProperties(context.Context, string, string) (*armstorage.BlobServicePropertiesProperties, error)
+	SetServiceProperties(context.Context, string, string, *armstorage.BlobServicePropertiesProperties) error
+}
+
+// ObjectReplication is an Azure Blob Storage object replication policy client
+type ObjectReplication interface {
+	EnableBlobVersioning(context.Context, string, string, bool) error
+	ListPolicies(context.Context, string, string) ([]*armstorage.ObjectReplicationPolicy, error)
+	CreateOrUpdatePolicy(context.Context, string, string, string, armstorage.ObjectReplicationPolicy) (*armstorage.ObjectReplicationPolicy, error)
+	DeletePolicy(context.Context, string, string, string) error
 }
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+)
+
+var _ Usage = &UsageClient{}
+
+// UsageClient is a client for the compute and network usages of a subscription.
+type UsageClient struct {
+	computeClient *armcompute.UsageClient
+	networkClient *armnetwork.UsagesClient
+	skuClient     *armcompute.ResourceSKUsClient
+}
+
+// NewUsageClient creates a new UsageClient.
+func NewUsageClient(auth *ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*UsageClient, error) {
+	computeClient, err := armcompute.NewUsageClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	networkClient, err := armnetwork.NewUsagesClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	skuClient, err := armcompute.NewResourceSKUsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &UsageClient{computeClient, networkClient, skuClient}, nil
+}
+
+// ListComputeUsages lists the compute usages, e.g. the vCPUs per VM family, of the subscription in the given location.
+func (c *UsageClient) ListComputeUsages(ctx context.Context, location string) ([]*armcompute.Usage, error) {
+	pager := c.computeClient.NewListPager(location, nil)
+	var ls []*armcompute.Usage
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ls = append(ls, res.Value...)
+	}
+	return ls, nil
+}
+
+// ListNetworkUsages lists the network usages, e.g. the public IP addresses, of the subscription in the given location.
+func (c *UsageClient) ListNetworkUsages(ctx context.Context, location string) ([]*armnetwork.Usage, error) {
+	pager := c.networkClient.NewListPager(location, nil)
+	var ls []*armnetwork.Usage
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ls = append(ls, res.Value...)
+	}
+	return ls, nil
+}
+
+// ListVirtualMachineSKUs lists the virtual machine SKUs which are available to the subscription in the given location.
+func (c *UsageClient) ListVirtualMachineSKUs(ctx context.Context, location string) ([]*armcompute.ResourceSKU, error) {
+	pager := c.skuClient.NewListPager(&armcompute.ResourceSKUsClientListOptions{
+		Filter: ptr.To(fmt.Sprintf("location eq '%s'", location)),
+	})
+	var ls []*armcompute.ResourceSKU
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, sku := range res.Value {
+			if sku != nil && sku.ResourceType != nil && *sku.ResourceType == "virtualMachines" {
+				ls = append(ls, sku)
+			}
+		}
+	}
+	return ls, nil
+}
@@ -6,9 +6,11 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 )
 
@@ -38,13 +40,56 @@ func (c *VirtualMachinesClient) Get(ctx context.Context, resourceGroupName strin
 	return &vm.VirtualMachine, nil
 }
 
+// ListByVmss will list the virtual machines of the vmss with the given resource ID in a resource group.
+func (c *VirtualMachinesClient) ListByVmss(ctx context.Context, resourceGroupName, vmssID string) ([]*armcompute.VirtualMachine, error) {
+	pager := c.client.NewListPager(resourceGroupName, &armcompute.VirtualMachinesClientListOptions{
+		Filter: to.Ptr(fmt.Sprintf("'virtualMachineScaleSet/id' eq '%s'", vmssID)),
+	})
+	var ls []*armcompute.VirtualMachine
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ls = append(ls, res.Value...)
+	}
+	return ls, nil
+}
+
+// UpdateTags will replace the tags of a virtual machine. Only the tags are patched, hence the virtual machine is not
+// changed otherwise.
+func (c *VirtualMachinesClient) UpdateTags(ctx context.Context, resourceGroupName, name string, tags map[string]*string) (*armcompute.VirtualMachine, error) {
+	future, err := c.client.BeginUpdate(ctx, resourceGroupName, name, armcompute.VirtualMachineUpdate{Tags: tags}, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, future)
+	if err != nil {
+		return nil, err
+	}
+	return &res.VirtualMachine, nil
+}
+
+// Update will patch the given properties of a virtual machine, e.g. its license type, without changing it otherwise.
+func (c *VirtualMachinesClient) Update(ctx context.Context, resourceGroupName, name string, parameters armcompute.VirtualMachineUpdate) (*armcompute.VirtualMachine, error) {
+	future, err := c.client.BeginUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, future)
+	if err != nil {
+		return nil, err
+	}
+	return &res.VirtualMachine, nil
+}
+
 // CreateOrUpdate will Create a virtual machine or update an existing one.
 func (c *VirtualMachinesClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, name string, parameters armcompute.VirtualMachine) (*armcompute.VirtualMachine, error) {
 	future, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
 	if err != nil {
 		return nil, err
 	}
-	res, err := future.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +104,6 @@ func (c *VirtualMachinesClient) Delete(ctx context.Context, resourceGroupName, n
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = future.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, future)
 	return err
 }
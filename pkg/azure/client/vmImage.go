@@ -6,10 +6,12 @@ package client
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"k8s.io/utils/ptr"
 )
 
 var _ VirtualMachineImages = &VirtualMachineImageClient{}
@@ -33,3 +35,27 @@ func (c *VirtualMachineImageClient) ListSkus(ctx context.Context, location strin
 	}
 	return &skus, nil
 }
+
+// Get returns the virtual machine image with the given location, publisher, offer, SKU and version. The version
+// "latest" is resolved to the latest version of the SKU. If the image does not exist nil is returned.
+func (c *VirtualMachineImageClient) Get(ctx context.Context, location, publisherName, offer, sku, version string) (*armcompute.VirtualMachineImage, error) {
+	if strings.EqualFold(version, "latest") {
+		versions, err := c.client.List(ctx, location, publisherName, offer, sku, &armcompute.VirtualMachineImagesClientListOptions{
+			Orderby: ptr.To("name desc"),
+			Top:     ptr.To[int32](1),
+		})
+		if err != nil {
+			return nil, FilterNotFoundError(err)
+		}
+		if len(versions.VirtualMachineImageResourceArray) == 0 || versions.VirtualMachineImageResourceArray[0].Name == nil {
+			return nil, nil
+		}
+		version = *versions.VirtualMachineImageResourceArray[0].Name
+	}
+
+	image, err := c.client.Get(ctx, location, publisherName, offer, sku, version, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &image.VirtualMachineImage, nil
+}
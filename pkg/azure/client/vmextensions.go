@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+// VirtualMachineExtensionsClient is an implementation of VirtualMachineExtensions for a virtual machine extensions k8sClient.
+type VirtualMachineExtensionsClient struct {
+	client *armcompute.VirtualMachineExtensionsClient
+}
+
+// NewVirtualMachineExtensionsClient creates a new virtual machine extensions client.
+func NewVirtualMachineExtensionsClient(auth ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (VirtualMachineExtensions, error) {
+	client, err := armcompute.NewVirtualMachineExtensionsClient(auth.SubscriptionID, tc, opts)
+	return &VirtualMachineExtensionsClient{client}, err
+}
+
+// CreateOrUpdate will install the extension with the given name on a virtual machine or update an installed one.
+func (c *VirtualMachineExtensionsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, vmName, name string, extension armcompute.VirtualMachineExtension) (*armcompute.VirtualMachineExtension, error) {
+	future, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, vmName, name, extension, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, future)
+	if err != nil {
+		return nil, err
+	}
+	return &res.VirtualMachineExtension, nil
+}
+
+// Delete will remove the extension with the given name from a virtual machine.
+func (c *VirtualMachineExtensionsClient) Delete(ctx context.Context, resourceGroupName, vmName, name string) error {
+	future, err := c.client.BeginDelete(ctx, resourceGroupName, vmName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = pollUntilDone(ctx, future)
+	return err
+}
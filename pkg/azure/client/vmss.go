@@ -56,7 +56,21 @@ func (c VmssClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name
 	if err != nil {
 		return nil, err
 	}
-	res, err := future.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, future)
+	if err != nil {
+		return nil, err
+	}
+	return &res.VirtualMachineScaleSet, nil
+}
+
+// UpdateTags will replace the tags of a vmss. Only the tags are patched, hence the virtual machines of the vmss are not
+// changed.
+func (c VmssClient) UpdateTags(ctx context.Context, resourceGroupName, name string, tags map[string]*string) (*armcompute.VirtualMachineScaleSet, error) {
+	future, err := c.client.BeginUpdate(ctx, resourceGroupName, name, armcompute.VirtualMachineScaleSetUpdate{Tags: tags}, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pollUntilDone(ctx, future)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +85,6 @@ func (c VmssClient) Delete(ctx context.Context, resourceGroupName, name string,
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = future.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, future)
 	return err
 }
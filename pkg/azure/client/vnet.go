@@ -32,7 +32,7 @@ func (v *VnetClient) CreateOrUpdate(ctx context.Context, resourceGroupName strin
 	if err != nil {
 		return nil, fmt.Errorf("cannot create virtual network: %v", err)
 	}
-	res, err := poller.PollUntilDone(ctx, nil)
+	res, err := pollUntilDone(ctx, poller)
 	return &res.VirtualNetwork, err
 }
 
@@ -42,7 +42,7 @@ func (v *VnetClient) Delete(ctx context.Context, resourceGroup, vnetName string)
 	if err != nil {
 		return FilterNotFoundError(err)
 	}
-	_, err = poller.PollUntilDone(ctx, nil)
+	_, err = pollUntilDone(ctx, poller)
 	return err
 }
 
@@ -22,6 +22,10 @@ const (
 	// Deprecated: This annotation is deprecated and will only used for testing until the deprecation by Azure.
 	DisableDefaultOutboundAccessAnnotation = "azure.provider.extensions.gardener.cloud/disable-default-outbound-access"
 
+	// ConfirmNetworkDeletionAnnotation confirms deletions and recreations of the VNet and the subnets of a shoot whose
+	// network is protected against deletions.
+	ConfirmNetworkDeletionAnnotation = "azure.provider.extensions.gardener.cloud/confirm-network-deletion"
+
 	// CloudControllerManagerImageName is the name of the cloud-controller-manager image.
 	CloudControllerManagerImageName = "cloud-controller-manager"
 	// CloudNodeManagerImageName is the name of the cloud-node-manager image.
@@ -47,6 +51,13 @@ const (
 	// MachineControllerManagerProviderAzureImageName is the name of the MachineController Azure image.
 	MachineControllerManagerProviderAzureImageName = "machine-controller-manager-provider-azure"
 
+	// DNSRecordTypeNS is the type of DNSRecords which delegate a subdomain of a DNS zone to the given name servers. Azure
+	// manages the NS records of the apex of a zone, hence only NS records of subdomains can be reconciled.
+	DNSRecordTypeNS extensionsv1alpha1.DNSRecordType = "NS"
+	// DNSMetadataGardenerPrefix is the prefix of the metadata keys of DNS recordsets which are managed by Gardener. The
+	// DNSRecord controller keeps this metadata when it sets the tags of a recordset.
+	DNSMetadataGardenerPrefix = "gardener"
+
 	// RemedyControllerImageName is the name of the remedy-controller image.
 	RemedyControllerImageName = "remedy-controller-azure"
 
@@ -58,6 +69,12 @@ const (
 	ClientIDKey = "clientID"
 	// ClientSecretKey is the key for the client secret.
 	ClientSecretKey = "clientSecret"
+	// KeyVaultURIKey is the key for the URI of the Key Vault which holds the client secret.
+	KeyVaultURIKey = "keyVaultURI"
+	// KeyVaultSecretNameKey is the key for the name of the Key Vault secret which holds the client secret.
+	KeyVaultSecretNameKey = "keyVaultSecretName" // #nosec G101 -- No credential.
+	// KeyVaultIdentityClientIDKey is the key for the client ID of the managed identity used to read the client secret from the Key Vault.
+	KeyVaultIdentityClientIDKey = "keyVaultIdentityClientID"
 	// AzureCloud is the key for the cloud configuration in the DNS Secret.
 	AzureCloud = "azureCloud" // #nosec G101 -- No credential.
 
@@ -78,6 +95,12 @@ const (
 	StorageKey = "storageKey"
 	// StorageDomain is a constant for the key in a backup secret that holds the domain for the Azure blob storage service.
 	StorageDomain = "domain"
+	// SecondaryStorageAccount is a constant for the key in a backup secret that holds the name of the secondary storage
+	// account to which the backups are replicated.
+	SecondaryStorageAccount = "secondaryStorageAccount"
+	// SecondaryStorageKey is a constant for the key in a backup secret that holds the access key of the secondary storage
+	// account.
+	SecondaryStorageKey = "secondaryStorageKey" // #nosec G101 -- No credential.
 
 	// AzureBlobStorageDomain is the host name for azure blob storage service.
 	AzureBlobStorageDomain = "blob.core.windows.net"
@@ -88,6 +111,10 @@ const (
 
 	// AzureCSIDiskDriverTopologyKey is the topology key for the Azure CSI Disk Driver.
 	AzureCSIDiskDriverTopologyKey = "topology.disk.csi.azure.com/zone"
+	// CapacityTypeLabel is the label of the nodes which reports the capacity type of their VMs.
+	CapacityTypeLabel = "azure.provider.extensions.gardener.cloud/capacity-type"
+	// CapacityTypeOnDemand is the capacity type of VMs with regular priority.
+	CapacityTypeOnDemand = "on-demand"
 
 	// MachineSetTagKey is the name of the infrastructure resource tag for machine sets.
 	MachineSetTagKey = "machineset.azure.extensions.gardener.cloud"
@@ -165,11 +192,18 @@ const (
 
 	// BlobDeletionLifecyclePolicyName is the name of the lifecycle policy that is added to storage accounts which deletes objects after their immutability expires.
 	BlobDeletionLifecyclePolicyName = "delete-backupentry"
+	// BlobVersionDeletionLifecyclePolicyName is the name of the lifecycle policy that is added to storage accounts with
+	// versioning of blobs which deletes the previous versions of objects.
+	BlobVersionDeletionLifecyclePolicyName = "delete-blob-versions"
 	// BlobMarkedForDeletionTagKey is the tag to be added to objects to delete them after their immutability expires.
 	BlobMarkedForDeletionTagKey = "blob-marked-for-deletion"
 
 	// StorageAccountKeyMustRotate is an annotation to indicate that the storageAccountKey has to be rotated.
 	StorageAccountKeyMustRotate = "azure.provider.extensions.gardener.cloud/rotate"
+
+	// PrivateLinkNameSuffix is appended to the namespace of the shoot to get the name of the Private Link Service and of
+	// the Private Endpoint of the private link between the control plane and the nodes.
+	PrivateLinkNameSuffix = "-connectivity"
 )
 
 // UsernamePrefix is a constant for the username prefix of components deployed by Azure.
@@ -5,15 +5,30 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-azure/pkg/apis/config/loader"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
+// ConcurrentReconcilesControllers are the names of the controllers whose maximum number of concurrent reconciliations
+// can be overridden in the controller configuration.
+var ConcurrentReconcilesControllers = sets.New("backupentry", "controlplane", "dnsrecord", "infrastructure", "worker")
+
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
 type ConfigOptions struct {
 	// Kubeconfig is the path to a kubeconfig.
@@ -41,11 +56,81 @@ func (c *ConfigOptions) Complete() error {
 	if err != nil {
 		return err
 	}
+	if err := validateConfig(config); err != nil {
+		return err
+	}
 
 	c.config = &Config{config}
 	return nil
 }
 
+func validateConfig(cfg *config.ControllerConfiguration) error {
+	for _, name := range slices.Sorted(maps.Keys(cfg.MaxConcurrentReconciles)) {
+		if !ConcurrentReconcilesControllers.Has(name) {
+			return fmt.Errorf("maxConcurrentReconciles: unsupported controller %q, supported controllers are %v", name, sets.List(ConcurrentReconcilesControllers))
+		}
+		if cfg.MaxConcurrentReconciles[name] < 1 {
+			return fmt.Errorf("maxConcurrentReconciles: value for controller %q must be at least 1", name)
+		}
+	}
+	if cfg.AzureClient != nil && cfg.AzureClient.MaxConcurrentRequestsPerSubscription != nil && *cfg.AzureClient.MaxConcurrentRequestsPerSubscription < 1 {
+		return fmt.Errorf("azureClient.maxConcurrentRequestsPerSubscription must be at least 1")
+	}
+	if cfg.AzureClient != nil && cfg.AzureClient.PollInterval != nil && cfg.AzureClient.PollInterval.Duration < azureclient.MinPollInterval {
+		return fmt.Errorf("azureClient.pollInterval must be at least %s", azureclient.MinPollInterval)
+	}
+	if cfg.AzureClient != nil && cfg.AzureClient.PollTimeout != nil && cfg.AzureClient.PollTimeout.Duration <= 0 {
+		return fmt.Errorf("azureClient.pollTimeout must be positive")
+	}
+	if cfg.AzureClient != nil && cfg.AzureClient.Proxy != nil {
+		proxyURL, err := url.Parse(cfg.AzureClient.Proxy.URL)
+		if err != nil {
+			return fmt.Errorf("azureClient.proxy.url is invalid: %w", err)
+		}
+		if (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || proxyURL.Host == "" {
+			return fmt.Errorf("azureClient.proxy.url must be an absolute http or https URL")
+		}
+	}
+	if cfg.AzureClient != nil && cfg.AzureClient.AzureStackHub != nil {
+		endpoint, err := url.Parse(cfg.AzureClient.AzureStackHub.ResourceManagerEndpoint)
+		if err != nil {
+			return fmt.Errorf("azureClient.azureStackHub.resourceManagerEndpoint is invalid: %w", err)
+		}
+		if endpoint.Scheme != "https" || endpoint.Host == "" {
+			return fmt.Errorf("azureClient.azureStackHub.resourceManagerEndpoint must be an absolute https URL")
+		}
+	}
+	if cfg.QuotaMetrics != nil && cfg.QuotaMetrics.RefreshInterval.Duration < time.Minute {
+		return fmt.Errorf("quotaMetrics.refreshInterval must be at least 1m")
+	}
+	if cfg.ResourceSKUs != nil && cfg.ResourceSKUs.CacheTTL != nil && cfg.ResourceSKUs.CacheTTL.Duration < time.Minute {
+		return fmt.Errorf("resourceSKUs.cacheTTL must be at least 1m")
+	}
+	if cfg.BackupEntry != nil && cfg.BackupEntry.NotificationWebhook != nil {
+		webhookURL, err := url.Parse(cfg.BackupEntry.NotificationWebhook.URL)
+		if err != nil {
+			return fmt.Errorf("backupEntry.notificationWebhook.url is invalid: %w", err)
+		}
+		if webhookURL.Scheme != "https" || webhookURL.Host == "" {
+			return fmt.Errorf("backupEntry.notificationWebhook.url must be an absolute https URL")
+		}
+	}
+	if cfg.Infrastructure != nil && cfg.Infrastructure.EgressReport != nil {
+		report := cfg.Infrastructure.EgressReport
+		if len(report.Namespace) > 0 {
+			if errs := validation.IsDNS1123Label(report.Namespace); len(errs) > 0 {
+				return fmt.Errorf("infrastructure.egressReport.namespace is invalid: %s", strings.Join(errs, ", "))
+			}
+		}
+		if len(report.Name) > 0 {
+			if errs := validation.IsDNS1123Subdomain(report.Name); len(errs) > 0 {
+				return fmt.Errorf("infrastructure.egressReport.name is invalid: %s", strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
 // Completed returns the completed Config. Only call this if `Complete` was successful.
 func (c *ConfigOptions) Completed() *Config {
 	return c.config
@@ -78,6 +163,128 @@ func (c *Config) Options() config.ControllerConfiguration {
 	return cfg
 }
 
+// ApplyInfrastructureTaskTimeouts sets the given infrastructure task timeouts to those of this Config.
+func (c *Config) ApplyInfrastructureTaskTimeouts(taskTimeouts *map[string]time.Duration) {
+	if c.Config.Infrastructure == nil {
+		return
+	}
+	timeouts := make(map[string]time.Duration, len(c.Config.Infrastructure.TaskTimeouts))
+	for name, timeout := range c.Config.Infrastructure.TaskTimeouts {
+		timeouts[name] = timeout.Duration
+	}
+	*taskTimeouts = timeouts
+}
+
+// ApplyInfrastructureCheckpointTTL sets the given infrastructure checkpoint TTL to that of this Config.
+func (c *Config) ApplyInfrastructureCheckpointTTL(ttl *time.Duration) {
+	if c.Config.Infrastructure == nil || c.Config.Infrastructure.CheckpointTTL == nil {
+		return
+	}
+	*ttl = c.Config.Infrastructure.CheckpointTTL.Duration
+}
+
+// ApplyInfrastructureEgressReport sets the given egress report configuration to that of this Config. It remains nil,
+// i.e. the egress report remains disabled, if it is not configured.
+func (c *Config) ApplyInfrastructureEgressReport(egressReport **config.EgressReport) {
+	if c.Config.Infrastructure == nil || c.Config.Infrastructure.EgressReport == nil {
+		return
+	}
+	*egressReport = c.Config.Infrastructure.EgressReport.DeepCopy()
+}
+
+// ApplyWorkerMarketplaceAgreements sets the given marketplace agreements configuration to that of this Config. It
+// remains nil, i.e. the agreements are not verified, if it is not configured.
+func (c *Config) ApplyWorkerMarketplaceAgreements(marketplaceAgreements **config.MarketplaceAgreements) {
+	if c.Config.Worker == nil || c.Config.Worker.MarketplaceAgreements == nil {
+		return
+	}
+	*marketplaceAgreements = c.Config.Worker.MarketplaceAgreements.DeepCopy()
+}
+
+// ApplyBackupEntryNotificationWebhook sets the given notification webhook configuration to that of this Config. It
+// remains nil, i.e. only events are recorded, if it is not configured.
+func (c *Config) ApplyBackupEntryNotificationWebhook(notificationWebhook **config.NotificationWebhook) {
+	if c.Config.BackupEntry == nil || c.Config.BackupEntry.NotificationWebhook == nil {
+		return
+	}
+	*notificationWebhook = c.Config.BackupEntry.NotificationWebhook.DeepCopy()
+}
+
+// ApplyMaxConcurrentReconciles sets the maximum number of concurrent reconciliations of the given controller options
+// if it is overridden for the named controller in this Config.
+func (c *Config) ApplyMaxConcurrentReconciles(controllerName string, opts *controller.Options) {
+	if maxConcurrentReconciles, ok := c.Config.MaxConcurrentReconciles[controllerName]; ok && maxConcurrentReconciles > 0 {
+		opts.MaxConcurrentReconciles = maxConcurrentReconciles
+	}
+}
+
+// ApplyAzureClient configures the limit of concurrent requests per subscription, the polling of long-running
+// operations, the proxy and the trusted CAs of the Azure clients. It fails if the CA certificates cannot be parsed or
+// the metadata of the configured Azure Stack Hub cannot be read.
+func (c *Config) ApplyAzureClient(ctx context.Context) error {
+	cfg := c.Config.AzureClient
+	if cfg == nil {
+		return nil
+	}
+	if cfg.MaxConcurrentRequestsPerSubscription != nil {
+		azureclient.SetMaxConcurrentRequestsPerSubscription(*cfg.MaxConcurrentRequestsPerSubscription)
+	}
+	if cfg.PollInterval != nil || cfg.PollTimeout != nil {
+		var interval, timeout time.Duration
+		if cfg.PollInterval != nil {
+			interval = cfg.PollInterval.Duration
+		}
+		if cfg.PollTimeout != nil {
+			timeout = cfg.PollTimeout.Duration
+		}
+		azureclient.SetPollerOptions(interval, timeout)
+	}
+	if cfg.Proxy != nil {
+		azureclient.SetProxy(cfg.Proxy.URL, cfg.Proxy.NoProxy)
+	}
+	if cfg.CACertificatesFile != nil {
+		certs, err := os.ReadFile(*cfg.CACertificatesFile)
+		if err != nil {
+			return fmt.Errorf("failed to read azureClient.caCertificatesFile: %w", err)
+		}
+		if err := azureclient.SetTrustedCACertificates(certs); err != nil {
+			return fmt.Errorf("azureClient.caCertificatesFile is invalid: %w", err)
+		}
+	}
+	if cfg.AzureStackHub != nil {
+		cloudConfiguration, err := azureclient.LoadAzureStackHubCloud(ctx, cfg.AzureStackHub.ResourceManagerEndpoint)
+		if err != nil {
+			return err
+		}
+		azureclient.SetAzureStackHubCloud(&cloudConfiguration)
+	}
+	return nil
+}
+
+// ApplyQuotaMetrics sets the given refresh interval of the quota usage metrics to that of this Config. The interval
+// remains zero, i.e. the metrics remain disabled, if the metrics are not configured.
+func (c *Config) ApplyQuotaMetrics(refreshInterval *time.Duration) {
+	if c.Config.QuotaMetrics == nil {
+		return
+	}
+	*refreshInterval = c.Config.QuotaMetrics.RefreshInterval.Duration
+}
+
+// ApplyResourceSKUs sets the given cache duration of the virtual machine SKUs and whether their unavailability is
+// tolerated to those of this Config. The values are kept for the fields which are not configured.
+func (c *Config) ApplyResourceSKUs(cacheTTL *time.Duration, tolerateUnavailability *bool) {
+	cfg := c.Config.ResourceSKUs
+	if cfg == nil {
+		return
+	}
+	if cfg.CacheTTL != nil {
+		*cacheTTL = cfg.CacheTTL.Duration
+	}
+	if cfg.TolerateUnavailability != nil {
+		*tolerateUnavailability = *cfg.TolerateUnavailability
+	}
+}
+
 // ApplyHealthCheckConfig applies the HealthCheckConfig to the config
 func (c *Config) ApplyHealthCheckConfig(config *apisconfigv1alpha1.HealthCheckConfig) {
 	if c.Config.HealthCheckConfig != nil {
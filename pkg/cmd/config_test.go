@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/cmd"
+)
+
+var _ = Describe("Config", func() {
+	var configOpts *ConfigOptions
+
+	configFile := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(path, []byte(`apiVersion: azure.provider.extensions.config.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+etcd:
+  storage:
+    className: gardener.cloud-fast
+`+content), 0600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		configOpts = &ConfigOptions{}
+	})
+
+	Describe("#ApplyMaxConcurrentReconciles", func() {
+		It("should override the maximum number of concurrent reconciliations of the configured controller", func() {
+			configOpts.ConfigFilePath = configFile(`maxConcurrentReconciles:
+  infrastructure: 20
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			infrastructureOpts := controller.Options{MaxConcurrentReconciles: 5}
+			configOpts.Completed().ApplyMaxConcurrentReconciles("infrastructure", &infrastructureOpts)
+			Expect(infrastructureOpts.MaxConcurrentReconciles).To(Equal(20))
+
+			workerOpts := controller.Options{MaxConcurrentReconciles: 5}
+			configOpts.Completed().ApplyMaxConcurrentReconciles("worker", &workerOpts)
+			Expect(workerOpts.MaxConcurrentReconciles).To(Equal(5))
+		})
+
+		It("should keep the options if no overrides are configured", func() {
+			configOpts.ConfigFilePath = configFile("")
+			Expect(configOpts.Complete()).To(Succeed())
+
+			opts := controller.Options{MaxConcurrentReconciles: 5}
+			configOpts.Completed().ApplyMaxConcurrentReconciles("dnsrecord", &opts)
+			Expect(opts.MaxConcurrentReconciles).To(Equal(5))
+		})
+
+		It("should reject unsupported controllers", func() {
+			configOpts.ConfigFilePath = configFile(`maxConcurrentReconciles:
+  bastion: 2
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring(`unsupported controller "bastion"`)))
+		})
+
+		It("should reject non-positive values", func() {
+			configOpts.ConfigFilePath = configFile(`maxConcurrentReconciles:
+  worker: 0
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring(`value for controller "worker" must be at least 1`)))
+		})
+	})
+
+	Describe("#ApplyAzureClient", func() {
+		It("should reject a non-positive request limit", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  maxConcurrentRequestsPerSubscription: 0
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("azureClient.maxConcurrentRequestsPerSubscription must be at least 1")))
+		})
+
+		It("should configure the polling of long-running operations", func() {
+			DeferCleanup(func() {
+				azureclient.SetPollerOptions(azureclient.DefaultPollInterval, 0)
+			})
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  pollInterval: 5s
+  pollTimeout: 10m
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			Expect(configOpts.Completed().ApplyAzureClient(context.Background())).To(Succeed())
+			options, timeout := azureclient.PollerOptions()
+			Expect(options.Frequency).To(Equal(5 * time.Second))
+			Expect(timeout).To(Equal(10 * time.Minute))
+		})
+
+		It("should keep the default polling of long-running operations if it is not configured", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  maxConcurrentRequestsPerSubscription: 10
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+			DeferCleanup(func() {
+				azureclient.SetMaxConcurrentRequestsPerSubscription(azureclient.DefaultMaxConcurrentRequestsPerSubscription)
+			})
+
+			Expect(configOpts.Completed().ApplyAzureClient(context.Background())).To(Succeed())
+			options, timeout := azureclient.PollerOptions()
+			Expect(options.Frequency).To(Equal(azureclient.DefaultPollInterval))
+			Expect(timeout).To(BeZero())
+		})
+
+		It("should reject a poll interval below one second", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  pollInterval: 500ms
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("azureClient.pollInterval must be at least 1s")))
+		})
+
+		It("should configure the proxy", func() {
+			DeferCleanup(func() {
+				azureclient.SetProxy("", nil)
+			})
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  proxy:
+    url: http://proxy.example.com:3128
+    noProxy:
+    - .internal.example.com
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			Expect(configOpts.Completed().ApplyAzureClient(context.Background())).To(Succeed())
+			transport := azureclient.DefaultAzureClientOpts().Transport.(*http.Client).Transport.(*http.Transport)
+			proxyURL, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "management.azure.com"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL).To(HaveValue(HaveField("Host", "proxy.example.com:3128")))
+		})
+
+		It("should reject a proxy URL which is not absolute", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  proxy:
+    url: proxy.example.com:3128
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("azureClient.proxy.url")))
+		})
+
+		It("should fail if the CA certificates cannot be parsed", func() {
+			caFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+			Expect(os.WriteFile(caFile, []byte("invalid"), 0600)).To(Succeed())
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  caCertificatesFile: ` + caFile + `
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			Expect(configOpts.Completed().ApplyAzureClient(context.Background())).To(MatchError(ContainSubstring("azureClient.caCertificatesFile is invalid")))
+		})
+
+		It("should reject an Azure Stack Hub endpoint which is not an absolute https URL", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  azureStackHub:
+    resourceManagerEndpoint: http://management.local.azurestack.external
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("azureClient.azureStackHub.resourceManagerEndpoint must be an absolute https URL")))
+		})
+
+		It("should reject a non-positive poll timeout", func() {
+			configOpts.ConfigFilePath = configFile(`azureClient:
+  pollTimeout: 0s
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("azureClient.pollTimeout must be positive")))
+		})
+	})
+
+	Describe("#ApplyQuotaMetrics", func() {
+		It("should set the refresh interval of the quota metrics", func() {
+			configOpts.ConfigFilePath = configFile(`quotaMetrics:
+  refreshInterval: 15m
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var refreshInterval time.Duration
+			configOpts.Completed().ApplyQuotaMetrics(&refreshInterval)
+			Expect(refreshInterval).To(Equal(15 * time.Minute))
+		})
+
+		It("should keep the quota metrics disabled if they are not configured", func() {
+			configOpts.ConfigFilePath = configFile("")
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var refreshInterval time.Duration
+			configOpts.Completed().ApplyQuotaMetrics(&refreshInterval)
+			Expect(refreshInterval).To(BeZero())
+		})
+
+		It("should reject a refresh interval below one minute", func() {
+			configOpts.ConfigFilePath = configFile(`quotaMetrics:
+  refreshInterval: 30s
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("quotaMetrics.refreshInterval must be at least 1m")))
+		})
+	})
+
+	Describe("#ApplyResourceSKUs", func() {
+		It("should set the options of the virtual machine SKUs", func() {
+			configOpts.ConfigFilePath = configFile(`resourceSKUs:
+  cacheTTL: 6h
+  tolerateUnavailability: false
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			cacheTTL, tolerateUnavailability := time.Hour, true
+			configOpts.Completed().ApplyResourceSKUs(&cacheTTL, &tolerateUnavailability)
+			Expect(cacheTTL).To(Equal(6 * time.Hour))
+			Expect(tolerateUnavailability).To(BeFalse())
+		})
+
+		It("should keep the defaults if the virtual machine SKUs are not configured", func() {
+			configOpts.ConfigFilePath = configFile("")
+			Expect(configOpts.Complete()).To(Succeed())
+
+			cacheTTL, tolerateUnavailability := time.Hour, true
+			configOpts.Completed().ApplyResourceSKUs(&cacheTTL, &tolerateUnavailability)
+			Expect(cacheTTL).To(Equal(time.Hour))
+			Expect(tolerateUnavailability).To(BeTrue())
+		})
+
+		It("should reject a cache duration below one minute", func() {
+			configOpts.ConfigFilePath = configFile(`resourceSKUs:
+  cacheTTL: 30s
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("resourceSKUs.cacheTTL must be at least 1m")))
+		})
+	})
+
+	Describe("#ApplyInfrastructureEgressReport", func() {
+		It("should set the egress report configuration", func() {
+			configOpts.ConfigFilePath = configFile(`infrastructure:
+  egressReport:
+    namespace: egress
+    name: azure-egress
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var egressReport *config.EgressReport
+			configOpts.Completed().ApplyInfrastructureEgressReport(&egressReport)
+			Expect(egressReport).To(Equal(&config.EgressReport{Namespace: "egress", Name: "azure-egress"}))
+		})
+
+		It("should keep the egress report disabled if it is not configured", func() {
+			configOpts.ConfigFilePath = configFile(`infrastructure:
+  checkpointTTL: 30m
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var egressReport *config.EgressReport
+			configOpts.Completed().ApplyInfrastructureEgressReport(&egressReport)
+			Expect(egressReport).To(BeNil())
+		})
+
+		It("should reject an invalid namespace", func() {
+			configOpts.ConfigFilePath = configFile(`infrastructure:
+  egressReport:
+    namespace: Egress_Reports
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("infrastructure.egressReport.namespace is invalid")))
+		})
+
+		It("should reject an invalid name", func() {
+			configOpts.ConfigFilePath = configFile(`infrastructure:
+  egressReport:
+    name: Egress_Report
+`)
+			Expect(configOpts.Complete()).To(MatchError(ContainSubstring("infrastructure.egressReport.name is invalid")))
+		})
+	})
+
+	Describe("#ApplyWorkerMarketplaceAgreements", func() {
+		It("should set the marketplace agreements configuration", func() {
+			configOpts.ConfigFilePath = configFile(`worker:
+  marketplaceAgreements:
+    accept: true
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var marketplaceAgreements *config.MarketplaceAgreements
+			configOpts.Completed().ApplyWorkerMarketplaceAgreements(&marketplaceAgreements)
+			Expect(marketplaceAgreements).To(Equal(&config.MarketplaceAgreements{Accept: true}))
+		})
+
+		It("should keep the verification disabled if it is not configured", func() {
+			configOpts.ConfigFilePath = configFile(`infrastructure:
+  checkpointTTL: 30m
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var marketplaceAgreements *config.MarketplaceAgreements
+			configOpts.Completed().ApplyWorkerMarketplaceAgreements(&marketplaceAgreements)
+			Expect(marketplaceAgreements).To(BeNil())
+		})
+	})
+
+	Describe("#ApplyBackupEntryNotificationWebhook", func() {
+		It("should set the notification webhook configuration", func() {
+			configOpts.ConfigFilePath = configFile(`backupEntry:
+  notificationWebhook:
+    url: https://backup-tracker.example.com/notifications
+`)
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var notificationWebhook *config.NotificationWebhook
+			configOpts.Completed().ApplyBackupEntryNotificationWebhook(&notificationWebhook)
+			Expect(notificationWebhook).To(Equal(&config.NotificationWebhook{URL: "https://backup-tracker.example.com/notifications"}))
+		})
+
+		It("should keep the notification webhook disabled if it is not configured", func() {
+			configOpts.ConfigFilePath = configFile("")
+			Expect(configOpts.Complete()).To(Succeed())
+
+			var notificationWebhook *config.NotificationWebhook
+			configOpts.Completed().ApplyBackupEntryNotificationWebhook(&notificationWebhook)
+			Expect(notificationWebhook).To(BeNil())
+		})
+
+		DescribeTable("should reject invalid webhook URLs",
+			func(webhookURL string) {
+				configOpts.ConfigFilePath = configFile(`backupEntry:
+  notificationWebhook:
+    url: "` + webhookURL + `"
+`)
+				Expect(configOpts.Complete()).To(MatchError(ContainSubstring("backupEntry.notificationWebhook.url")))
+			},
+			Entry("empty", ""),
+			Entry("plain http", "http://backup-tracker.example.com/notifications"),
+			Entry("relative", "/notifications"),
+			Entry("malformed", "https://backup tracker.example.com/%zz"),
+		)
+	})
+})
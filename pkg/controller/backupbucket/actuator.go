@@ -13,12 +13,14 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
 )
@@ -28,6 +30,7 @@ var DefaultAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
 
 type actuator struct {
 	client client.Client
+	clock  clock.Clock
 }
 
 var _ backupbucket.Actuator = (*actuator)(nil)
@@ -36,6 +39,7 @@ var _ backupbucket.Actuator = (*actuator)(nil)
 func NewActuator(mgr manager.Manager) backupbucket.Actuator {
 	return &actuator{
 		client: mgr.GetClient(),
+		clock:  clock.RealClock{},
 	}
 }
 func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
@@ -47,6 +51,9 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, backupBuck
 	if err != nil {
 		return logWithError(logger, err, "Failed to decode the provider specific configuration from the backupbucket resource")
 	}
+	if err := forbidSFTPForImmutableBuckets(&backupBucketConfig); err != nil {
+		return logWithError(logger, err, "Failed to validate the provider specific configuration of the backupbucket resource")
+	}
 
 	bucketCloudConfiguration, err := azureclient.CloudConfiguration(backupBucketConfig.CloudConfiguration, &backupBucket.Spec.Region)
 	if err != nil {
@@ -76,7 +83,11 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, backupBuck
 	if err != nil {
 		return logWithError(logger, err, "Failed to ensure the resource group and storage account")
 	}
-	if err := a.ensureStorageAccountKey(ctx, logger, factory, resourceGroupName, storageAccountName, storageDomain, backupBucket, &backupBucketConfig); err != nil {
+	secondary, err := a.ensureSecondaryStorageAccount(ctx, logger, factory, backupBucket, &backupBucketConfig, resourceGroupName)
+	if err != nil {
+		return logWithError(logger, err, "Failed to ensure the secondary storage account")
+	}
+	if err := a.ensureStorageAccountKey(ctx, logger, factory, resourceGroupName, storageAccountName, storageDomain, backupBucket, &backupBucketConfig, secondary); err != nil {
 		return logWithError(logger, err, "Failed to ensure the storage account key")
 	}
 
@@ -92,6 +103,16 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, backupBuck
 		}
 	}
 
+	if backupBucketConfig.ChangeFeed != nil {
+		blobServicesClient, err := factory.BlobServices()
+		if err != nil {
+			return err
+		}
+		if err := EnsureChangeFeed(ctx, logger, blobServicesClient, resourceGroupName, storageAccountName, backupBucketConfig.ChangeFeed); err != nil {
+			return logWithError(logger, err, "Failed to reconcile the change feed of the storage account")
+		}
+	}
+
 	blobContainersClient, err := factory.BlobContainers()
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
@@ -111,6 +132,16 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, backupBuck
 		}
 	}
 
+	if backupBucketConfig.SFTP != nil {
+		localUsersClient, err := factory.LocalUsers()
+		if err != nil {
+			return err
+		}
+		if err := EnsureSFTPLocalUser(ctx, localUsersClient, resourceGroupName, storageAccountName, backupBucket.Name, backupBucketConfig.SFTP); err != nil {
+			return logWithError(logger, err, "Failed to reconcile the SFTP local user of the storage account")
+		}
+	}
+
 	if immutableBucketsFeatureEnabled {
 		// set the immutability policy on the container as configured in the backupBucket
 		if err = ensureBackupBucketImmutabilityPolicy(
@@ -122,6 +153,26 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, backupBuck
 		}
 	}
 
+	if err := a.reconcileSecondaryStorageAccount(ctx, logger, factory, backupBucket, resourceGroupName, storageAccountName, secondary); err != nil {
+		return logWithError(logger, err, "Failed to reconcile the secondary storage account")
+	}
+
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+	if err := a.updateGeoReplicationCondition(ctx, storageAccountClient, backupBucket, &backupBucketConfig, resourceGroupName, storageAccountName); err != nil {
+		return logWithError(logger, err, "Failed to update the geo-replication condition")
+	}
+
+	if err := a.reconcilePublicAccess(ctx, logger, storageAccountClient, blobContainersClient, backupBucket, &backupBucketConfig, resourceGroupName, storageAccountName); err != nil {
+		return logWithError(logger, err, "Failed to verify the public access settings")
+	}
+
+	if err := a.reconcileDiagnosticSettings(ctx, logger, factory, backupBucket, &backupBucketConfig, resourceGroupName, storageAccountName); err != nil {
+		return logWithError(logger, err, "Failed to reconcile the diagnostic settings of the storage account")
+	}
+
 	return nil
 }
 
@@ -132,6 +183,7 @@ func (a *actuator) ensureStorageAccountKey(
 	resourceGroupName, storageAccountName, storageDomain string,
 	backupBucket *extensionsv1alpha1.BackupBucket,
 	backupBucketConfig *azure.BackupBucketConfig,
+	secondary *storageAccountCredentials,
 ) error {
 	storageAccountClient, err := factory.StorageAccount()
 	if err != nil {
@@ -149,7 +201,7 @@ func (a *actuator) ensureStorageAccountKey(
 		return logWithError(log, err, "Failed to ensure account key rotation")
 	}
 
-	if err := a.createOrUpdateBackupBucketGeneratedSecret(ctx, backupBucket, storageAccountName, *SortKeysByAge(keys)[0].Value, storageDomain); err != nil {
+	if err := a.createOrUpdateBackupBucketGeneratedSecret(ctx, backupBucket, storageAccountName, *SortKeysByAge(keys)[0].Value, storageDomain, secondary); err != nil {
 		return logWithError(log, err, "Failed to update the backupbucket secret with the storage account")
 	}
 	return nil
@@ -216,7 +268,7 @@ func (a *actuator) Delete(ctx context.Context, logger logr.Logger, backupBucket
 	return util.DetermineError(a.delete(ctx, logger, backupBucket), helper.KnownCodes)
 }
 
-func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) delete(ctx context.Context, logger logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
 	// If the backupBucket has no generated secret in the status that means
 	// no backupbucket exists and therefore there is no need for deletion.
 	if backupBucket.Status.GeneratedSecretRef == nil {
@@ -264,19 +316,41 @@ func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *exte
 		return err
 	}
 
+	storageAccountName := storageAccountNameFromConfig(backupBucket, &backupBucketConfig)
 	if secret != nil {
+		if name, ok := secret.Data[azuretypes.StorageAccount]; ok {
+			storageAccountName = string(name)
+		}
+
+		if secondaryStorageAccountName, ok := secondaryStorageAccountNameFromSecret(secret); ok {
+			logger.Info("Deleting the backup bucket in the secondary storage account")
+			if err := deleteSecondaryStorageAccountContainer(ctx, factory, backupBucket.Name, storageAccountName, secondaryStorageAccountName, backupBucket.Name); err != nil {
+				return err
+			}
+		}
+
 		// Get a storage account client to delete the backup bucket in the storage account.
 		blobContainersClient, err := factory.BlobContainers()
 		if err != nil {
 			return err
 		}
-		storageAccountName := GenerateStorageAccountName(backupBucket.Name)
 		// resourceGroupName and backupBucketName are identical
 		if err := blobContainersClient.DeleteContainer(ctx, backupBucket.Name, storageAccountName, backupBucket.Name); err != nil {
 			return err
 		}
 	}
 
+	if hasDiagnosticSettings(backupBucket, &backupBucketConfig) {
+		diagnosticSettingsClient, err := factory.DiagnosticSettings()
+		if err != nil {
+			return err
+		}
+		logger.Info("Deleting the diagnostic settings of the storage account")
+		if err := DeleteDiagnosticSettings(ctx, diagnosticSettingsClient, backupBucket.Name, storageAccountName); err != nil {
+			return err
+		}
+	}
+
 	// Get resource group client and delete the resource group which contains the backup storage account.
 	groupClient, err := factory.Group()
 	if err != nil {
@@ -6,6 +6,7 @@ package backupbucket_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -15,12 +16,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -253,13 +257,152 @@ var _ = Describe("Actuator", func() {
 						},
 					},
 				}
-				mockEnsureResourceGroupAndStorageAccountWithParams(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket, ptr.To(int32(5)))
+				mockEnsureResourceGroupAndStorageAccountWithParams(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket, ptr.To(int32(5)), false)
 				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
 				storageAccountKeys[0].CreationTime = ptr.To(time.Now())
 				err := a.Reconcile(ctx, logger, backupBucket)
 				Expect(err).ShouldNot(HaveOccurred())
 			})
 		})
+		Context("when geo-replication is enabled", func() {
+			BeforeEach(func() {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Object: &v1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						GeoReplication: &v1alpha1.GeoReplicationConfig{
+							MaxSyncLag: &metav1.Duration{Duration: 15 * time.Minute},
+						},
+					},
+				}
+				mockEnsureResourceGroupAndStorageAccountWithParams(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket, nil, true)
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+			})
+
+			It("should report the bucket as degraded if the secondary region lags behind", func() {
+				lastSyncTime := time.Now().Add(-time.Hour).UTC()
+				azureStorageAccountClient.EXPECT().GetGeoReplicationStats(ctx, resourceGroupName, storageAccountName).Return(&armstorage.GeoReplicationStats{
+					Status:       to.Ptr(armstorage.GeoReplicationStatusLive),
+					LastSyncTime: &lastSyncTime,
+				}, nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{}), gomock.Any())
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+
+				Expect(backupBucket.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(ConditionTypeGeoReplication),
+					"Status":  Equal(gardencorev1beta1.ConditionFalse),
+					"Reason":  Equal(ReasonReplicationLagging),
+					"Message": ContainSubstring(lastSyncTime.Format(time.RFC3339)),
+				})))
+			})
+
+			It("should report the bucket as healthy if the secondary region is in sync", func() {
+				lastSyncTime := time.Now().Add(-time.Minute).UTC()
+				azureStorageAccountClient.EXPECT().GetGeoReplicationStats(ctx, resourceGroupName, storageAccountName).Return(&armstorage.GeoReplicationStats{
+					Status:       to.Ptr(armstorage.GeoReplicationStatusLive),
+					LastSyncTime: &lastSyncTime,
+				}, nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{}), gomock.Any())
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+
+				Expect(backupBucket.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(ConditionTypeGeoReplication),
+					"Status": Equal(gardencorev1beta1.ConditionTrue),
+					"Reason": Equal(ReasonReplicationInSync),
+				})))
+			})
+
+			It("should fail if the geo-replication statistics cannot be read", func() {
+				azureStorageAccountClient.EXPECT().GetGeoReplicationStats(ctx, resourceGroupName, storageAccountName).Return(nil, fmt.Errorf("geo-replication stats error test"))
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("geo-replication stats error test")))
+			})
+		})
+
+		Context("when geo-replication is disabled", func() {
+			BeforeEach(func() {
+				mockEnsureResourceGroupAndStorageAccount(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket)
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+			})
+
+			It("should remove an existing geo-replication condition", func() {
+				backupBucket.Status.Conditions = []gardencorev1beta1.Condition{{Type: ConditionTypeGeoReplication, Status: gardencorev1beta1.ConditionTrue}}
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{}), gomock.Any())
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+				Expect(backupBucket.Status.Conditions).To(BeEmpty())
+			})
+		})
+
+		Context("when the verification of the public access is configured", func() {
+			var publicAccessMode v1alpha1.PublicAccessMode
+
+			JustBeforeEach(func() {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Object: &v1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						PublicAccess: &v1alpha1.PublicAccessConfig{Mode: publicAccessMode},
+					},
+				}
+				mockEnsureResourceGroupAndStorageAccount(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket)
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, resourceGroupName, storageAccountName).Return(&armstorage.Account{
+					Properties: &armstorage.AccountProperties{AllowBlobPublicAccess: to.Ptr(false)},
+				}, nil)
+				azureBlobContainersClient.EXPECT().GetContainer(ctx, resourceGroupName, storageAccountName, backupBucket.Name).Return(armstorage.BlobContainersClientGetResponse{
+					BlobContainer: armstorage.BlobContainer{
+						ContainerProperties: &armstorage.ContainerProperties{PublicAccess: to.Ptr(armstorage.PublicAccessContainer)},
+					},
+				}, nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucket{}), gomock.Any())
+			})
+
+			Context("in the Verify mode", func() {
+				BeforeEach(func() {
+					publicAccessMode = v1alpha1.PublicAccessModeVerify
+				})
+
+				It("should flag the drift of the container without changing it", func() {
+					Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+
+					Expect(backupBucket.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(ConditionTypePublicAccess),
+						"Status":  Equal(gardencorev1beta1.ConditionFalse),
+						"Reason":  Equal(ReasonPublicAccessDrift),
+						"Message": ContainSubstring("the container allows public access"),
+					})))
+				})
+			})
+
+			Context("in the Enforce mode", func() {
+				BeforeEach(func() {
+					publicAccessMode = v1alpha1.PublicAccessModeEnforce
+				})
+
+				It("should disallow public access to the container again", func() {
+					azureBlobContainersClient.EXPECT().DisallowContainerPublicAccess(ctx, resourceGroupName, storageAccountName, backupBucket.Name)
+
+					Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+
+					Expect(backupBucket.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(ConditionTypePublicAccess),
+						"Status": Equal(gardencorev1beta1.ConditionTrue),
+						"Reason": Equal(ReasonPublicAccessEnforced),
+					})))
+				})
+			})
+		})
+
 		Context("client creation fails during reconciliation", func() {
 			It("should error", func() {
 				// resource group client is the first client created in the reconciliation
@@ -290,16 +433,235 @@ var _ = Describe("Actuator", func() {
 
 				// try creating storage account
 				azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil)
-				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, nil).Return(fmt.Errorf("storage account creation error test"))
+				mockStorageAccountNameAvailable(ctx, azureStorageAccountClient, storageAccountName)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{}).Return(nil, fmt.Errorf("storage account creation error test"))
 
 				err := a.Reconcile(ctx, logger, backupBucket)
 				Expect(err).Should(HaveOccurred())
 			})
 		})
 
+		Context("when a storage account name prefix is configured", func() {
+			var prefixedStorageAccountName string
+
+			BeforeEach(func() {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Object: &v1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						StorageAccountNamePrefix: ptr.To("teamabkp"),
+					},
+				}
+				prefixedStorageAccountName = GenerateStorageAccountNameWithPrefix("teamabkp", backupBucket.Name)
+
+				azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+				azureGroupClient.EXPECT().CreateOrUpdate(ctx, name, armresources.ResourceGroup{
+					Location: to.Ptr(backupBucket.Spec.Region),
+				})
+				azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil).AnyTimes()
+			})
+
+			It("should create the storage account with the prefixed name", func() {
+				Expect(prefixedStorageAccountName).To(HavePrefix("teamabkp"))
+				Expect(prefixedStorageAccountName).To(HaveLen(23))
+
+				mockStorageAccountNameAvailable(ctx, azureStorageAccountClient, prefixedStorageAccountName)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, prefixedStorageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{}).Return(nil, fmt.Errorf("storage account creation error test"))
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("storage account creation error test")))
+			})
+
+			It("should fail with a configuration problem if the name is taken by another storage account", func() {
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, prefixedStorageAccountName).Return(nil, nil)
+				azureStorageAccountClient.EXPECT().CheckNameAvailability(ctx, prefixedStorageAccountName).Return(false, "The storage account named "+prefixedStorageAccountName+" is already taken.", nil)
+
+				err := a.Reconcile(ctx, logger, backupBucket)
+				Expect(err).To(MatchError(ContainSubstring("is already taken")))
+				var coder gardencorev1beta1helper.Coder
+				Expect(errors.As(err, &coder)).To(BeTrue())
+				Expect(coder.Codes()).To(ContainElement(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+
+			It("should not treat the storage account in the resource group of the backup bucket as a collision", func() {
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, prefixedStorageAccountName).Return(&armstorage.Account{Name: ptr.To(prefixedStorageAccountName)}, nil)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, prefixedStorageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{}).Return(nil, fmt.Errorf("storage account creation error test"))
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("storage account creation error test")))
+			})
+
+			It("should keep using the existing storage account if the prefix changed", func() {
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{})
+				azureStorageAccountClient.EXPECT().ListStorageAccountKeys(ctx, name, storageAccountName).Return(storageAccountKeys, nil)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when infrastructure encryption is required", func() {
+			BeforeEach(func() {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Object: &v1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						RequireInfrastructureEncryption: ptr.To(true),
+					},
+				}
+
+				azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+				azureGroupClient.EXPECT().CreateOrUpdate(ctx, name, armresources.ResourceGroup{
+					Location: to.Ptr(backupBucket.Spec.Region),
+				})
+				azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil).AnyTimes()
+			})
+
+			It("should create the storage account with infrastructure encryption", func() {
+				mockStorageAccountNameAvailable(ctx, azureStorageAccountClient, storageAccountName)
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(nil, nil)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{InfrastructureEncryption: true}).Return(nil, fmt.Errorf("storage account creation error test"))
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("storage account creation error test")))
+			})
+
+			It("should keep reconciling a storage account with infrastructure encryption", func() {
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(&armstorage.Account{
+					Properties: &armstorage.AccountProperties{
+						Encryption: &armstorage.Encryption{RequireInfrastructureEncryption: ptr.To(true)},
+					},
+				}, nil)
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{InfrastructureEncryption: true})
+				azureStorageAccountClient.EXPECT().ListStorageAccountKeys(ctx, name, storageAccountName).Return(storageAccountKeys, nil)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should fail with a configuration problem if the existing storage account is not encrypted twice", func() {
+				backupBucket.Status.GeneratedSecretRef = &corev1.SecretReference{Name: "generated-bucket-" + backupBucket.Name, Namespace: "garden"}
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "generated-bucket-" + backupBucket.Name, Namespace: "garden"}, &corev1.Secret{}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+					obj.Data = map[string][]byte{azure.StorageAccount: []byte(storageAccountName)}
+					return nil
+				})
+				azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(&armstorage.Account{
+					Properties: &armstorage.AccountProperties{},
+				}, nil)
+
+				err := a.Reconcile(ctx, logger, backupBucket)
+				Expect(err).To(MatchError(ContainSubstring("infrastructure encryption cannot be enabled for the existing storage account")))
+				var coder gardencorev1beta1helper.Coder
+				Expect(errors.As(err, &coder)).To(BeTrue())
+				Expect(coder.Codes()).To(ContainElement(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+		})
+
+		Context("when SFTP is enabled", func() {
+			BeforeEach(func() {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Object: &v1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						SFTP: &v1alpha1.SFTPConfig{SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA"}},
+					},
+				}
+			})
+
+			It("should fail with a configuration problem if immutable buckets are enabled", func() {
+				err := a.Reconcile(ctx, logger, backupBucket)
+				Expect(err).To(MatchError(ContainSubstring("SFTP cannot be enabled while the EnableImmutableBuckets feature gate is enabled")))
+				var coder gardencorev1beta1helper.Coder
+				Expect(errors.As(err, &coder)).To(BeTrue())
+				Expect(coder.Codes()).To(ContainElement(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+
+			Context("and immutable buckets are disabled", func() {
+				BeforeEach(func() {
+					Expect(features.ExtensionFeatureGate.Set(fmt.Sprintf("%s=%s", features.EnableImmutableBuckets, "false"))).To(Succeed())
+
+					azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+					azureGroupClient.EXPECT().CreateOrUpdate(ctx, name, armresources.ResourceGroup{
+						Location: to.Ptr(backupBucket.Spec.Region),
+					})
+					azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil).AnyTimes()
+				})
+
+				It("should create the storage account with a hierarchical namespace and SFTP", func() {
+					mockStorageAccountNameAvailable(ctx, azureStorageAccountClient, storageAccountName)
+					azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(nil, nil)
+					azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{SFTP: true}).Return(nil, fmt.Errorf("storage account creation error test"))
+
+					Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("storage account creation error test")))
+				})
+
+				It("should create the local user which may read the backups", func() {
+					localUsersClient := mockazureclient.NewMockLocalUsers(ctrl)
+
+					mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+					azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(&armstorage.Account{
+						Properties: &armstorage.AccountProperties{IsHnsEnabled: ptr.To(true), IsSftpEnabled: ptr.To(true)},
+					}, nil)
+					azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{SFTP: true})
+					azureStorageAccountClient.EXPECT().ListStorageAccountKeys(ctx, name, storageAccountName).Return(storageAccountKeys, nil)
+					azureClientFactory.EXPECT().BlobContainers().Return(azureBlobContainersClient, nil)
+					azureBlobContainersClient.EXPECT().GetContainer(ctx, resourceGroupName, storageAccountName, backupBucket.Name).Return(armstorage.BlobContainersClientGetResponse{}, nil)
+					azureClientFactory.EXPECT().LocalUsers().Return(localUsersClient, nil)
+					localUsersClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName, gomock.Any())
+
+					Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+				})
+
+				It("should fail with a configuration problem if the existing storage account has a flat namespace", func() {
+					backupBucket.Status.GeneratedSecretRef = &corev1.SecretReference{Name: "generated-bucket-" + backupBucket.Name, Namespace: "garden"}
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "generated-bucket-" + backupBucket.Name, Namespace: "garden"}, &corev1.Secret{}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						obj.Data = map[string][]byte{azure.StorageAccount: []byte(storageAccountName)}
+						return nil
+					})
+					azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(&armstorage.Account{
+						Properties: &armstorage.AccountProperties{},
+					}, nil)
+
+					err := a.Reconcile(ctx, logger, backupBucket)
+					Expect(err).To(MatchError(ContainSubstring("SFTP cannot be enabled for the existing storage account")))
+					var coder gardencorev1beta1helper.Coder
+					Expect(errors.As(err, &coder)).To(BeTrue())
+					Expect(coder.Codes()).To(ContainElement(gardencorev1beta1.ErrorConfigurationProblem))
+				})
+			})
+		})
+
+		Context("when SFTP is disabled", func() {
+			It("should delete the local user of a storage account with a hierarchical namespace", func() {
+				localUsersClient := mockazureclient.NewMockLocalUsers(ctrl)
+
+				mockGeneratedSecretNoop(ctx, c, storageAccountName, backupBucket)
+				azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+				azureGroupClient.EXPECT().CreateOrUpdate(ctx, name, armresources.ResourceGroup{
+					Location: to.Ptr(backupBucket.Spec.Region),
+				})
+				azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil).AnyTimes()
+				azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{}).Return(&armstorage.Account{
+					Properties: &armstorage.AccountProperties{IsHnsEnabled: ptr.To(true)},
+				}, nil)
+				azureClientFactory.EXPECT().LocalUsers().Return(localUsersClient, nil)
+				localUsersClient.EXPECT().Delete(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName)
+				azureStorageAccountClient.EXPECT().ListStorageAccountKeys(ctx, name, storageAccountName).Return(storageAccountKeys, nil)
+				mockEnsureBlobContainer(ctx, azureClientFactory, azureManagementPoliciesClient, azureBlobContainersClient, resourceGroupName, storageAccountName, backupBucket)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
 		Context("set lifecycle policy on the storage account during each reconciliation", func() {
 			It("should error if adding the lifecycle policy to the storage account fails", func() {
 				mockEnsureResourceGroupAndStorageAccount(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket)
+				mockStorageAccountNameAvailable(ctx, azureStorageAccountClient, storageAccountName)
 
 				// create generated secret
 				mockGeneratedSecretCreation(ctx, c, sw, storageAccountName, backupBucket)
@@ -714,7 +1076,11 @@ var _ = Describe("Actuator", func() {
 			}
 			// passing &corev1.Secret{} here instead of generatedSecret
 			// since kutil.GetSecretByReference() uses a &corev1.Secret{} to Get()
-			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(generatedSecret), &corev1.Secret{})
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(generatedSecret), &corev1.Secret{}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+				*obj = *generatedSecret.DeepCopy()
+				obj.Data = map[string][]byte{azure.StorageAccount: []byte(storageAccountName)}
+				return nil
+			})
 			azureClientFactory.EXPECT().BlobContainers().Return(azureBlobContainersClient, nil)
 		})
 
@@ -736,6 +1102,46 @@ var _ = Describe("Actuator", func() {
 			err := a.Delete(ctx, logger, backupBucket)
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("should delete the bucket in the storage account of the generated secret", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+				Object: &v1alpha1.BackupBucketConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						Kind:       "BackupBucketConfig",
+					},
+					StorageAccountNamePrefix: ptr.To("teamabkp"),
+				},
+			}
+			azureBlobContainersClient.EXPECT().DeleteContainer(ctx, resourceGroupName, storageAccountName, backupBucket.Name)
+
+			azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+			azureGroupClient.EXPECT().Delete(ctx, resourceGroupName)
+
+			c.EXPECT().Delete(ctx, generatedSecret)
+
+			err := a.Delete(ctx, logger, backupBucket)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should delete the diagnostic settings of the storage account", func() {
+			backupBucket.Status.Conditions = []gardencorev1beta1.Condition{{Type: ConditionTypeDiagnosticSettings, Status: gardencorev1beta1.ConditionTrue}}
+			diagnosticSettingsClient := mockazureclient.NewMockDiagnosticSettings(ctrl)
+			azureBlobContainersClient.EXPECT().DeleteContainer(ctx, resourceGroupName, storageAccountName, backupBucket.Name)
+
+			azureClientFactory.EXPECT().DiagnosticSettings().Return(diagnosticSettingsClient, nil)
+			for _, resourcePath := range DiagnosticSettingResources(storageAccountName) {
+				diagnosticSettingsClient.EXPECT().Delete(ctx, resourceGroupName, resourcePath, DiagnosticSettingName)
+			}
+
+			azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
+			azureGroupClient.EXPECT().Delete(ctx, resourceGroupName)
+
+			c.EXPECT().Delete(ctx, generatedSecret)
+
+			err := a.Delete(ctx, logger, backupBucket)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 	})
 })
 
@@ -746,7 +1152,7 @@ func mockEnsureResourceGroupAndStorageAccount(
 	azureStorageAccountClient *mockazureclient.MockStorageAccount,
 	storageAccountName string, backupBucket *extensionsv1alpha1.BackupBucket,
 ) {
-	mockEnsureResourceGroupAndStorageAccountWithParams(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket, nil)
+	mockEnsureResourceGroupAndStorageAccountWithParams(ctx, azureClientFactory, azureGroupClient, azureStorageAccountClient, storageAccountName, backupBucket, nil, false)
 }
 
 // mocks ensureResourceGroupAndStorageAccount() which creates the resource group and storage account
@@ -757,6 +1163,7 @@ func mockEnsureResourceGroupAndStorageAccountWithParams(
 	azureStorageAccountClient *mockazureclient.MockStorageAccount,
 	storageAccountName string, backupBucket *extensionsv1alpha1.BackupBucket,
 	withExpirationPolicy *int32,
+	withGeoReplication bool,
 ) {
 	// create resource group
 	azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
@@ -766,10 +1173,16 @@ func mockEnsureResourceGroupAndStorageAccountWithParams(
 
 	// create storage account
 	azureClientFactory.EXPECT().StorageAccount().Return(azureStorageAccountClient, nil).AnyTimes()
-	azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, withExpirationPolicy)
+	azureStorageAccountClient.EXPECT().CreateOrUpdateStorageAccount(ctx, name, storageAccountName, backupBucket.Spec.Region, azclient.StorageAccountOptions{KeyExpirationDays: withExpirationPolicy, GeoReplication: withGeoReplication})
 	azureStorageAccountClient.EXPECT().ListStorageAccountKeys(ctx, name, storageAccountName).Return(storageAccountKeys, nil)
 }
 
+// mockStorageAccountNameAvailable mocks the check that the name of a new storage account is not taken.
+func mockStorageAccountNameAvailable(ctx context.Context, azureStorageAccountClient *mockazureclient.MockStorageAccount, storageAccountName string) {
+	azureStorageAccountClient.EXPECT().GetStorageAccount(ctx, name, storageAccountName).Return(nil, nil)
+	azureStorageAccountClient.EXPECT().CheckNameAvailability(ctx, storageAccountName).Return(true, "", nil)
+}
+
 func mockGeneratedSecretCreation(
 	ctx context.Context,
 	c *mockclient.MockClient,
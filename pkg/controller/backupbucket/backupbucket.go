@@ -14,6 +14,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
@@ -25,10 +27,66 @@ import (
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
+const (
+	// defaultStorageAccountNamePrefix is the prefix of the storage account name if none is configured.
+	defaultStorageAccountNamePrefix = "bkp"
+	// maxStorageAccountNameLength is the maximum length of storage account names allowed by Azure.
+	maxStorageAccountNameLength = 24
+	// maxStorageAccountNameHashLength is the maximum number of characters of the hash of the bucket name in the
+	// storage account name.
+	maxStorageAccountNameHashLength = 15
+)
+
 // GenerateStorageAccountName generates the name of the storage account from the bucket name <backupBucketName>.
 func GenerateStorageAccountName(backupBucketName string) string {
+	return GenerateStorageAccountNameWithPrefix(defaultStorageAccountNamePrefix, backupBucketName)
+}
+
+// GenerateStorageAccountNameWithPrefix generates the name of the storage account from the given prefix and the bucket
+// name <backupBucketName>. The prefix is followed by as many characters of the hash of the bucket name as fit into
+// the maximum length of storage account names, but at most 15.
+func GenerateStorageAccountNameWithPrefix(prefix, backupBucketName string) string {
 	backupBucketNameSHA := utils.ComputeSHA256Hex([]byte(backupBucketName))
-	return fmt.Sprintf("bkp%s", backupBucketNameSHA[:15])
+	hashLength := min(maxStorageAccountNameHashLength, max(maxStorageAccountNameLength-len(prefix), 0))
+	return fmt.Sprintf("%s%s", prefix, backupBucketNameSHA[:hashLength])
+}
+
+// storageAccountNameFromConfig returns the name of the storage account which is created for the given backup bucket.
+func storageAccountNameFromConfig(backupBucket *extensionsv1alpha1.BackupBucket, backupBucketConfig *azure.BackupBucketConfig) string {
+	return GenerateStorageAccountNameWithPrefix(storageAccountNamePrefix(backupBucketConfig), backupBucket.Name)
+}
+
+// storageAccountNamePrefix returns the configured prefix of the names of the storage accounts of the backup bucket.
+func storageAccountNamePrefix(backupBucketConfig *azure.BackupBucketConfig) string {
+	if backupBucketConfig != nil && backupBucketConfig.StorageAccountNamePrefix != nil {
+		return *backupBucketConfig.StorageAccountNamePrefix
+	}
+	return defaultStorageAccountNamePrefix
+}
+
+// ensureStorageAccountNameAvailable returns an error if the storage account with the given name cannot be created,
+// because the globally unique name is already taken by another storage account. A storage account with the name in
+// the resource group of the backup bucket is not a collision, as it was created by an earlier reconciliation.
+func ensureStorageAccountNameAvailable(ctx context.Context, storageAccountClient azureclient.StorageAccount, resourceGroupName, storageAccountName string) error {
+	account, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if account != nil {
+		return nil
+	}
+
+	available, reason, err := storageAccountClient.CheckNameAvailability(ctx, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("failed to check the availability of the storage account name %q: %w", storageAccountName, err)
+	}
+	if !available {
+		return gardencorev1beta1helper.NewErrorWithCodes(
+			fmt.Errorf("the storage account name %q is already taken, configure a different storageAccountNamePrefix: %s", storageAccountName, reason),
+			gardencorev1beta1.ErrorConfigurationProblem,
+		)
+	}
+	return nil
 }
 
 // ensureResourceGroupAndStorageAccount ensures the existence of the necessary resourcegroup and storageacccount for the backupbucket
@@ -40,7 +98,7 @@ func (a *actuator) ensureResourceGroupAndStorageAccount(
 ) (string, string, error) {
 	var (
 		resourceGroupName  = backupBucket.Name
-		storageAccountName = GenerateStorageAccountName(backupBucket.Name)
+		storageAccountName = storageAccountNameFromConfig(backupBucket, backupBucketConfig)
 	)
 	// Get resource group client to ensure resource group to host backup storage account exists.
 	groupClient, err := factory.Group()
@@ -59,9 +117,15 @@ func (a *actuator) ensureResourceGroupAndStorageAccount(
 		return "", "", err
 	}
 
-	var keyExpirationDays *int32
-	if backupBucketConfig != nil && backupBucketConfig.RotationConfig != nil {
-		keyExpirationDays = backupBucketConfig.RotationConfig.ExpirationPeriodDays
+	opts := azureclient.StorageAccountOptions{
+		InfrastructureEncryption: requiresInfrastructureEncryption(backupBucketConfig),
+	}
+	if backupBucketConfig != nil {
+		if backupBucketConfig.RotationConfig != nil {
+			opts.KeyExpirationDays = backupBucketConfig.RotationConfig.ExpirationPeriodDays
+		}
+		opts.GeoReplication = backupBucketConfig.GeoReplication != nil
+		opts.SFTP = backupBucketConfig.SFTP != nil
 	}
 
 	secret, err := a.getBackupBucketGeneratedSecret(ctx, backupBucket)
@@ -72,15 +136,61 @@ func (a *actuator) ensureResourceGroupAndStorageAccount(
 		if _, ok := secret.Data[azuretypes.StorageAccount]; !ok {
 			return "", "", fmt.Errorf("secret %s/%s does not contain expected key %s", secret.Namespace, secret.Name, azuretypes.StorageAccount)
 		}
+		// The name of an existing storage account is kept even if the configured prefix changed, so that the storage
+		// account and the backups in it are not orphaned.
 		storageAccountName = string(secret.Data[azuretypes.StorageAccount])
+	} else if err := ensureStorageAccountNameAvailable(ctx, storageAccountClient, resourceGroupName, storageAccountName); err != nil {
+		return "", "", err
 	}
 
-	if err := storageAccountClient.CreateOrUpdateStorageAccount(ctx, resourceGroupName, storageAccountName, backupBucket.Spec.Region, keyExpirationDays); err != nil {
+	if err := ensureInfrastructureEncryptionUnchanged(ctx, storageAccountClient, resourceGroupName, storageAccountName, opts.InfrastructureEncryption); err != nil {
+		return "", "", err
+	}
+	if err := ensureHierarchicalNamespaceForSFTP(ctx, storageAccountClient, resourceGroupName, storageAccountName, opts.SFTP); err != nil {
+		return "", "", err
+	}
+	account, err := storageAccountClient.CreateOrUpdateStorageAccount(ctx, resourceGroupName, storageAccountName, backupBucket.Spec.Region, opts)
+	if err != nil {
 		return "", "", err
 	}
+	if !opts.SFTP {
+		if err := deleteSFTPLocalUser(ctx, factory, account, resourceGroupName, storageAccountName); err != nil {
+			return "", "", err
+		}
+	}
 	return resourceGroupName, storageAccountName, nil
 }
 
+// requiresInfrastructureEncryption returns whether the storage accounts of the backup bucket require infrastructure
+// encryption.
+func requiresInfrastructureEncryption(backupBucketConfig *azure.BackupBucketConfig) bool {
+	return backupBucketConfig != nil && ptr.Deref(backupBucketConfig.RequireInfrastructureEncryption, false)
+}
+
+// ensureInfrastructureEncryptionUnchanged returns an error if infrastructure encryption is required, but the storage
+// account with the given name already exists without it. Azure only applies infrastructure encryption when a storage
+// account is created, so it would otherwise be silently missing.
+func ensureInfrastructureEncryptionUnchanged(ctx context.Context, storageAccountClient azureclient.StorageAccount, resourceGroupName, storageAccountName string, required bool) error {
+	if !required {
+		return nil
+	}
+
+	account, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if account == nil || account.Properties == nil {
+		return nil
+	}
+	if account.Properties.Encryption != nil && ptr.Deref(account.Properties.Encryption.RequireInfrastructureEncryption, false) {
+		return nil
+	}
+	return gardencorev1beta1helper.NewErrorWithCodes(
+		fmt.Errorf("infrastructure encryption cannot be enabled for the existing storage account %q, since it can only be configured when a storage account is created", storageAccountName),
+		gardencorev1beta1.ErrorConfigurationProblem,
+	)
+}
+
 // SortKeysByAge sorts the storage AccountKey by their age in ascending order. The younger key is
 // placed in the beginning of the sorted list. A nil timestamp is treated as "infinitely old"
 func SortKeysByAge(
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// EnsureChangeFeed enables or disables the change feed of the storage account and sets its retention as configured.
+// The blob service properties are only updated if the change feed differs, the other properties are kept.
+func EnsureChangeFeed(
+	ctx context.Context, log logr.Logger,
+	c azureclient.BlobServices,
+	resourceGroupName, storageAccountName string,
+	cfg *azure.ChangeFeedConfig,
+) error {
+	properties, err := c.GetServiceProperties(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("failed to get the blob service properties: %w", err)
+	}
+
+	current := ptr.Deref(properties.ChangeFeed, armstorage.ChangeFeed{})
+	if ptr.Deref(current.Enabled, false) == cfg.Enabled && (!cfg.Enabled || ptr.Equal(current.RetentionInDays, cfg.RetentionDays)) {
+		return nil
+	}
+
+	log.Info("Updating the change feed of the storage account", "enabled", cfg.Enabled, "retentionDays", cfg.RetentionDays)
+	properties.ChangeFeed = &armstorage.ChangeFeed{Enabled: ptr.To(cfg.Enabled)}
+	if cfg.Enabled {
+		properties.ChangeFeed.RetentionInDays = cfg.RetentionDays
+	}
+	if err := c.SetServiceProperties(ctx, resourceGroupName, storageAccountName, properties); err != nil {
+		return fmt.Errorf("failed to update the change feed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+var _ = Describe("ChangeFeed", func() {
+	const (
+		resourceGroupName  = "backup-rg"
+		storageAccountName = "bkp0123456789abcde"
+	)
+
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		blobServices *mockazureclient.MockBlobServices
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		blobServices = mockazureclient.NewMockBlobServices(ctrl)
+	})
+
+	Describe("#EnsureChangeFeed", func() {
+		It("should enable the change feed with the configured retention and keep the other properties", func() {
+			blobServices.EXPECT().GetServiceProperties(ctx, resourceGroupName, storageAccountName).Return(&armstorage.BlobServicePropertiesProperties{
+				IsVersioningEnabled: ptr.To(true),
+			}, nil)
+			blobServices.EXPECT().SetServiceProperties(ctx, resourceGroupName, storageAccountName, &armstorage.BlobServicePropertiesProperties{
+				IsVersioningEnabled: ptr.To(true),
+				ChangeFeed:          &armstorage.ChangeFeed{Enabled: ptr.To(true), RetentionInDays: ptr.To[int32](30)},
+			}).Return(nil)
+
+			Expect(EnsureChangeFeed(ctx, logr.Discard(), blobServices, resourceGroupName, storageAccountName,
+				&azure.ChangeFeedConfig{Enabled: true, RetentionDays: ptr.To[int32](30)})).To(Succeed())
+		})
+
+		It("should update the retention of an enabled change feed", func() {
+			blobServices.EXPECT().GetServiceProperties(ctx, resourceGroupName, storageAccountName).Return(&armstorage.BlobServicePropertiesProperties{
+				ChangeFeed: &armstorage.ChangeFeed{Enabled: ptr.To(true), RetentionInDays: ptr.To[int32](30)},
+			}, nil)
+			blobServices.EXPECT().SetServiceProperties(ctx, resourceGroupName, storageAccountName, &armstorage.BlobServicePropertiesProperties{
+				ChangeFeed: &armstorage.ChangeFeed{Enabled: ptr.To(true)},
+			}).Return(nil)
+
+			Expect(EnsureChangeFeed(ctx, logr.Discard(), blobServices, resourceGroupName, storageAccountName,
+				&azure.ChangeFeedConfig{Enabled: true})).To(Succeed())
+		})
+
+		It("should disable the change feed", func() {
+			blobServices.EXPECT().GetServiceProperties(ctx, resourceGroupName, storageAccountName).Return(&armstorage.BlobServicePropertiesProperties{
+				ChangeFeed: &armstorage.ChangeFeed{Enabled: ptr.To(true), RetentionInDays: ptr.To[int32](30)},
+			}, nil)
+			blobServices.EXPECT().SetServiceProperties(ctx, resourceGroupName, storageAccountName, &armstorage.BlobServicePropertiesProperties{
+				ChangeFeed: &armstorage.ChangeFeed{Enabled: ptr.To(false)},
+			}).Return(nil)
+
+			Expect(EnsureChangeFeed(ctx, logr.Discard(), blobServices, resourceGroupName, storageAccountName,
+				&azure.ChangeFeedConfig{Enabled: false})).To(Succeed())
+		})
+
+		It("should not update the blob service properties if the change feed is up-to-date", func() {
+			blobServices.EXPECT().GetServiceProperties(ctx, resourceGroupName, storageAccountName).Return(&armstorage.BlobServicePropertiesProperties{
+				ChangeFeed: &armstorage.ChangeFeed{Enabled: ptr.To(true), RetentionInDays: ptr.To[int32](30)},
+			}, nil)
+
+			Expect(EnsureChangeFeed(ctx, logr.Discard(), blobServices, resourceGroupName, storageAccountName,
+				&azure.ChangeFeedConfig{Enabled: true, RetentionDays: ptr.To[int32](30)})).To(Succeed())
+		})
+
+		It("should not update the blob service properties if the change feed was never enabled", func() {
+			blobServices.EXPECT().GetServiceProperties(ctx, resourceGroupName, storageAccountName).Return(&armstorage.BlobServicePropertiesProperties{}, nil)
+
+			Expect(EnsureChangeFeed(ctx, logr.Discard(), blobServices, resourceGroupName, storageAccountName,
+				&azure.ChangeFeedConfig{Enabled: false})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypeDiagnosticSettings is the type of the BackupBucket condition which reports that the logs and metrics
+	// of the storage account are routed to the configured destinations.
+	ConditionTypeDiagnosticSettings gardencorev1beta1.ConditionType = "DiagnosticSettings"
+	// ReasonDiagnosticSettingsConfigured is the condition reason used when the diagnostic settings are configured.
+	ReasonDiagnosticSettingsConfigured = "DiagnosticSettingsConfigured"
+
+	// DiagnosticSettingName is the name of the diagnostic settings the extension manages on the storage account.
+	DiagnosticSettingName = "gardener-backup"
+)
+
+// DiagnosticSettingResources returns the provider paths of the resources whose diagnostic settings are managed for the
+// given storage account. Transaction metrics are available on the storage account, the logs only on its blob service.
+func DiagnosticSettingResources(storageAccountName string) []string {
+	storageAccount := "Microsoft.Storage/storageAccounts/" + storageAccountName
+	return []string{storageAccount, storageAccount + "/blobServices/default"}
+}
+
+// DiagnosticSettingSpec returns the desired diagnostic setting of the resource with the given provider path.
+func DiagnosticSettingSpec(resourcePath string, cfg *azure.DiagnosticSettingsConfig) azureclient.DiagnosticSetting {
+	setting := azureclient.DiagnosticSetting{
+		Properties: azureclient.DiagnosticSettingProperties{
+			WorkspaceID:      cfg.WorkspaceID,
+			StorageAccountID: cfg.StorageAccountID,
+			Metrics:          []azureclient.DiagnosticSettingCategory{{Category: "Transaction", Enabled: true}},
+		},
+	}
+	if strings.HasSuffix(resourcePath, "/blobServices/default") {
+		setting.Properties.Logs = []azureclient.DiagnosticSettingCategory{
+			{Category: "StorageRead", Enabled: true},
+			{Category: "StorageWrite", Enabled: true},
+			{Category: "StorageDelete", Enabled: true},
+		}
+	}
+	return setting
+}
+
+// EnsureDiagnosticSettings creates or updates the diagnostic settings of the storage account and its blob service.
+// Settings which are already up-to-date are not touched.
+func EnsureDiagnosticSettings(
+	ctx context.Context, log logr.Logger,
+	c azureclient.DiagnosticSettings,
+	resourceGroupName, storageAccountName string,
+	cfg *azure.DiagnosticSettingsConfig,
+) error {
+	for _, resourcePath := range DiagnosticSettingResources(storageAccountName) {
+		desired := DiagnosticSettingSpec(resourcePath, cfg)
+
+		current, err := c.Get(ctx, resourceGroupName, resourcePath, DiagnosticSettingName)
+		if err != nil {
+			return fmt.Errorf("failed to get the diagnostic setting of %s: %w", resourcePath, err)
+		}
+		if current != nil && reflect.DeepEqual(current.Properties, desired.Properties) {
+			continue
+		}
+
+		log.Info("Updating the diagnostic setting", "resource", resourcePath)
+		if err := c.CreateOrUpdate(ctx, resourceGroupName, resourcePath, DiagnosticSettingName, desired); err != nil {
+			return fmt.Errorf("failed to update the diagnostic setting of %s: %w", resourcePath, err)
+		}
+	}
+	return nil
+}
+
+// DeleteDiagnosticSettings deletes the diagnostic settings the extension created on the storage account and its blob
+// service. Diagnostic settings outlive the deletion of their resource, hence they must be removed explicitly.
+func DeleteDiagnosticSettings(ctx context.Context, c azureclient.DiagnosticSettings, resourceGroupName, storageAccountName string) error {
+	for _, resourcePath := range DiagnosticSettingResources(storageAccountName) {
+		if err := c.Delete(ctx, resourceGroupName, resourcePath, DiagnosticSettingName); err != nil {
+			return fmt.Errorf("failed to delete the diagnostic setting of %s: %w", resourcePath, err)
+		}
+	}
+	return nil
+}
+
+// hasDiagnosticSettings returns true if diagnostic settings are or were configured for the given BackupBucket.
+func hasDiagnosticSettings(backupBucket *extensionsv1alpha1.BackupBucket, backupBucketConfig *azure.BackupBucketConfig) bool {
+	return backupBucketConfig.DiagnosticSettings != nil ||
+		v1beta1helper.GetCondition(backupBucket.Status.Conditions, ConditionTypeDiagnosticSettings) != nil
+}
+
+// reconcileDiagnosticSettings ensures the configured diagnostic settings of the storage account and reports them in the
+// conditions of the BackupBucket. The diagnostic settings and the condition are removed if they are no longer configured.
+func (a *actuator) reconcileDiagnosticSettings(
+	ctx context.Context, log logr.Logger,
+	factory azureclient.Factory,
+	backupBucket *extensionsv1alpha1.BackupBucket,
+	backupBucketConfig *azure.BackupBucketConfig,
+	resourceGroupName, storageAccountName string,
+) error {
+	if !hasDiagnosticSettings(backupBucket, backupBucketConfig) {
+		return nil
+	}
+
+	diagnosticSettingsClient, err := factory.DiagnosticSettings()
+	if err != nil {
+		return err
+	}
+
+	var conditions []gardencorev1beta1.Condition
+	if cfg := backupBucketConfig.DiagnosticSettings; cfg == nil {
+		log.Info("Deleting the diagnostic settings of the storage account")
+		if err := DeleteDiagnosticSettings(ctx, diagnosticSettingsClient, resourceGroupName, storageAccountName); err != nil {
+			return err
+		}
+		conditions = v1beta1helper.RemoveConditions(backupBucket.Status.Conditions, ConditionTypeDiagnosticSettings)
+	} else {
+		if err := EnsureDiagnosticSettings(ctx, log, diagnosticSettingsClient, resourceGroupName, storageAccountName, cfg); err != nil {
+			return err
+		}
+		condition := v1beta1helper.GetOrInitConditionWithClock(a.clock, backupBucket.Status.Conditions, ConditionTypeDiagnosticSettings)
+		condition = v1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionTrue, ReasonDiagnosticSettingsConfigured,
+			"The logs and metrics of the storage account are routed to the configured destinations.")
+		conditions = v1beta1helper.MergeConditions(backupBucket.Status.Conditions, condition)
+	}
+
+	if !v1beta1helper.ConditionsNeedUpdate(backupBucket.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.Conditions = conditions
+	return a.client.Status().Patch(ctx, backupBucket, patch)
+}
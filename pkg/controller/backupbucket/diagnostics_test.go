@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+type fakeDiagnosticSettings struct {
+	settings map[string]azclient.DiagnosticSetting
+	updates  int
+}
+
+func (f *fakeDiagnosticSettings) Get(_ context.Context, resourceGroupName, resourcePath, name string) (*azclient.DiagnosticSetting, error) {
+	setting, ok := f.settings[resourceGroupName+"/"+resourcePath+"/"+name]
+	if !ok {
+		return nil, nil
+	}
+	return &setting, nil
+}
+
+func (f *fakeDiagnosticSettings) CreateOrUpdate(_ context.Context, resourceGroupName, resourcePath, name string, setting azclient.DiagnosticSetting) error {
+	f.updates++
+	f.settings[resourceGroupName+"/"+resourcePath+"/"+name] = setting
+	return nil
+}
+
+func (f *fakeDiagnosticSettings) Delete(_ context.Context, resourceGroupName, resourcePath, name string) error {
+	delete(f.settings, resourceGroupName+"/"+resourcePath+"/"+name)
+	return nil
+}
+
+var _ = Describe("DiagnosticSettings", func() {
+	const (
+		resourceGroupName  = "backup-rg"
+		storageAccountName = "bkp0123456789abcde"
+		storageAccountPath = "Microsoft.Storage/storageAccounts/" + storageAccountName
+		blobServicePath    = storageAccountPath + "/blobServices/default"
+		workspaceID        = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.OperationalInsights/workspaces/audit"
+	)
+
+	var (
+		ctx                context.Context
+		diagnosticSettings *fakeDiagnosticSettings
+		cfg                *azure.DiagnosticSettingsConfig
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		diagnosticSettings = &fakeDiagnosticSettings{settings: map[string]azclient.DiagnosticSetting{}}
+		cfg = &azure.DiagnosticSettingsConfig{WorkspaceID: ptr.To(workspaceID)}
+	})
+
+	Describe("#DiagnosticSettingSpec", func() {
+		It("should only collect the transaction metrics of the storage account", func() {
+			Expect(DiagnosticSettingSpec(storageAccountPath, cfg)).To(Equal(azclient.DiagnosticSetting{
+				Properties: azclient.DiagnosticSettingProperties{
+					WorkspaceID: ptr.To(workspaceID),
+					Metrics:     []azclient.DiagnosticSettingCategory{{Category: "Transaction", Enabled: true}},
+				},
+			}))
+		})
+
+		It("should collect the logs of the blob service", func() {
+			setting := DiagnosticSettingSpec(blobServicePath, cfg)
+			Expect(setting.Properties.Logs).To(ConsistOf(
+				azclient.DiagnosticSettingCategory{Category: "StorageRead", Enabled: true},
+				azclient.DiagnosticSettingCategory{Category: "StorageWrite", Enabled: true},
+				azclient.DiagnosticSettingCategory{Category: "StorageDelete", Enabled: true},
+			))
+		})
+	})
+
+	Describe("#EnsureDiagnosticSettings", func() {
+		It("should create the diagnostic settings of the storage account and its blob service", func() {
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+
+			Expect(diagnosticSettings.settings).To(HaveKeyWithValue(resourceGroupName+"/"+storageAccountPath+"/"+DiagnosticSettingName, DiagnosticSettingSpec(storageAccountPath, cfg)))
+			Expect(diagnosticSettings.settings).To(HaveKeyWithValue(resourceGroupName+"/"+blobServicePath+"/"+DiagnosticSettingName, DiagnosticSettingSpec(blobServicePath, cfg)))
+			Expect(diagnosticSettings.updates).To(Equal(2))
+		})
+
+		It("should not update diagnostic settings which are up-to-date", func() {
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+
+			Expect(diagnosticSettings.updates).To(Equal(2))
+		})
+
+		It("should update the diagnostic settings if the destinations change", func() {
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+
+			cfg.StorageAccountID = ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/audit")
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+
+			Expect(diagnosticSettings.updates).To(Equal(4))
+			Expect(diagnosticSettings.settings[resourceGroupName+"/"+blobServicePath+"/"+DiagnosticSettingName].Properties.StorageAccountID).To(Equal(cfg.StorageAccountID))
+		})
+	})
+
+	Describe("#DeleteDiagnosticSettings", func() {
+		It("should delete the diagnostic settings created by the extension", func() {
+			foreign := resourceGroupName + "/" + storageAccountPath + "/other"
+			diagnosticSettings.settings[foreign] = azclient.DiagnosticSetting{}
+			Expect(EnsureDiagnosticSettings(ctx, logr.Discard(), diagnosticSettings, resourceGroupName, storageAccountName, cfg)).To(Succeed())
+
+			Expect(DeleteDiagnosticSettings(ctx, diagnosticSettings, resourceGroupName, storageAccountName)).To(Succeed())
+			Expect(diagnosticSettings.settings).To(HaveLen(1))
+			Expect(diagnosticSettings.settings).To(HaveKey(foreign))
+		})
+	})
+})
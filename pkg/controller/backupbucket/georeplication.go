@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypeGeoReplication is the type of the BackupBucket condition which reports whether the storage account is
+	// replicated to the secondary region within the configured maximum sync lag.
+	ConditionTypeGeoReplication gardencorev1beta1.ConditionType = "GeoReplication"
+
+	// ReasonReplicationInSync is the condition reason used when the secondary region is in sync with the primary region.
+	ReasonReplicationInSync = "ReplicationInSync"
+	// ReasonReplicationLagging is the condition reason used when the last sync time of the secondary region exceeds the
+	// maximum sync lag.
+	ReasonReplicationLagging = "ReplicationLagging"
+	// ReasonReplicationBootstrapping is the condition reason used while the initial replication to the secondary region
+	// is in progress.
+	ReasonReplicationBootstrapping = "ReplicationBootstrapping"
+	// ReasonReplicationUnavailable is the condition reason used when the secondary region is unavailable.
+	ReasonReplicationUnavailable = "ReplicationUnavailable"
+
+	// DefaultMaxGeoReplicationSyncLag is the default maximum duration the secondary region may lag behind.
+	DefaultMaxGeoReplicationSyncLag = time.Hour
+)
+
+// GeoReplicationCondition computes the GeoReplication condition of the given BackupBucket based on the geo-replication
+// statistics of its storage account.
+func GeoReplicationCondition(clock clock.Clock, backupBucket *extensionsv1alpha1.BackupBucket, stats *armstorage.GeoReplicationStats, maxSyncLag time.Duration) gardencorev1beta1.Condition {
+	var (
+		status  gardencorev1beta1.ConditionStatus
+		reason  string
+		message string
+	)
+
+	switch {
+	case stats == nil || ptr.Deref(stats.Status, armstorage.GeoReplicationStatusUnavailable) == armstorage.GeoReplicationStatusUnavailable:
+		status, reason = gardencorev1beta1.ConditionFalse, ReasonReplicationUnavailable
+		message = "The secondary region of the storage account is unavailable, backups are not replicated."
+	case *stats.Status == armstorage.GeoReplicationStatusBootstrap || stats.LastSyncTime == nil:
+		status, reason = gardencorev1beta1.ConditionProgressing, ReasonReplicationBootstrapping
+		message = "The initial replication of the storage account to the secondary region is in progress."
+	default:
+		lastSyncTime := stats.LastSyncTime.UTC()
+		lag := clock.Since(lastSyncTime).Truncate(time.Second)
+		if lag > maxSyncLag {
+			status, reason = gardencorev1beta1.ConditionFalse, ReasonReplicationLagging
+			message = fmt.Sprintf("The secondary region was last synced at %s and lags behind by %s, which exceeds the maximum sync lag of %s.",
+				lastSyncTime.Format(time.RFC3339), lag, maxSyncLag)
+		} else {
+			status, reason = gardencorev1beta1.ConditionTrue, ReasonReplicationInSync
+			message = fmt.Sprintf("The secondary region was last synced at %s.", lastSyncTime.Format(time.RFC3339))
+		}
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, backupBucket.Status.Conditions, ConditionTypeGeoReplication)
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+}
+
+// updateGeoReplicationCondition reports the geo-replication status of the storage account in the conditions of the
+// BackupBucket. The condition is removed if geo-replication is not enabled.
+func (a *actuator) updateGeoReplicationCondition(
+	ctx context.Context,
+	storageAccountClient azureclient.StorageAccount,
+	backupBucket *extensionsv1alpha1.BackupBucket,
+	backupBucketConfig *azure.BackupBucketConfig,
+	resourceGroupName, storageAccountName string,
+) error {
+	var conditions []gardencorev1beta1.Condition
+	if backupBucketConfig.GeoReplication == nil {
+		if v1beta1helper.GetCondition(backupBucket.Status.Conditions, ConditionTypeGeoReplication) == nil {
+			return nil
+		}
+		conditions = v1beta1helper.RemoveConditions(backupBucket.Status.Conditions, ConditionTypeGeoReplication)
+	} else {
+		stats, err := storageAccountClient.GetGeoReplicationStats(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("failed to get the geo-replication statistics of the storage account: %w", err)
+		}
+
+		maxSyncLag := DefaultMaxGeoReplicationSyncLag
+		if backupBucketConfig.GeoReplication.MaxSyncLag != nil {
+			maxSyncLag = backupBucketConfig.GeoReplication.MaxSyncLag.Duration
+		}
+		conditions = v1beta1helper.MergeConditions(backupBucket.Status.Conditions, GeoReplicationCondition(a.clock, backupBucket, stats, maxSyncLag))
+	}
+
+	if !v1beta1helper.ConditionsNeedUpdate(backupBucket.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.Conditions = conditions
+	return a.client.Status().Patch(ctx, backupBucket, patch)
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+var _ = Describe("GeoReplication", func() {
+	var (
+		fakeClock    *testclock.FakeClock
+		backupBucket *extensionsv1alpha1.BackupBucket
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		backupBucket = &extensionsv1alpha1.BackupBucket{}
+	})
+
+	Describe("#GeoReplicationCondition", func() {
+		It("should report an in-sync secondary region", func() {
+			condition := GeoReplicationCondition(fakeClock, backupBucket, &armstorage.GeoReplicationStats{
+				Status:       to.Ptr(armstorage.GeoReplicationStatusLive),
+				LastSyncTime: to.Ptr(fakeClock.Now().Add(-10 * time.Minute)),
+			}, 15*time.Minute)
+
+			Expect(condition.Type).To(Equal(ConditionTypeGeoReplication))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonReplicationInSync))
+			Expect(condition.Message).To(Equal("The secondary region was last synced at 2024-01-01T11:50:00Z."))
+		})
+
+		It("should report a lagging secondary region", func() {
+			condition := GeoReplicationCondition(fakeClock, backupBucket, &armstorage.GeoReplicationStats{
+				Status:       to.Ptr(armstorage.GeoReplicationStatusLive),
+				LastSyncTime: to.Ptr(fakeClock.Now().Add(-20 * time.Minute)),
+			}, 15*time.Minute)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonReplicationLagging))
+			Expect(condition.Message).To(Equal("The secondary region was last synced at 2024-01-01T11:40:00Z and lags behind by 20m0s, which exceeds the maximum sync lag of 15m0s."))
+		})
+
+		It("should report a bootstrapping secondary region", func() {
+			condition := GeoReplicationCondition(fakeClock, backupBucket, &armstorage.GeoReplicationStats{
+				Status: to.Ptr(armstorage.GeoReplicationStatusBootstrap),
+			}, 15*time.Minute)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(condition.Reason).To(Equal(ReasonReplicationBootstrapping))
+		})
+
+		It("should report an unavailable secondary region", func() {
+			condition := GeoReplicationCondition(fakeClock, backupBucket, &armstorage.GeoReplicationStats{
+				Status:       to.Ptr(armstorage.GeoReplicationStatusUnavailable),
+				LastSyncTime: to.Ptr(fakeClock.Now().Add(-time.Minute)),
+			}, 15*time.Minute)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonReplicationUnavailable))
+		})
+
+		It("should report missing statistics as unavailable", func() {
+			condition := GeoReplicationCondition(fakeClock, backupBucket, nil, 15*time.Minute)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonReplicationUnavailable))
+		})
+
+		It("should keep the last transition time if the status does not change", func() {
+			lastTransitionTime := fakeClock.Now().Add(-time.Hour)
+			backupBucket.Status.Conditions = []gardencorev1beta1.Condition{{
+				Type:               ConditionTypeGeoReplication,
+				Status:             gardencorev1beta1.ConditionTrue,
+				Reason:             ReasonReplicationInSync,
+				LastTransitionTime: metav1.NewTime(lastTransitionTime),
+			}}
+
+			condition := GeoReplicationCondition(fakeClock, backupBucket, &armstorage.GeoReplicationStats{
+				Status:       to.Ptr(armstorage.GeoReplicationStatusLive),
+				LastSyncTime: to.Ptr(fakeClock.Now()),
+			}, 15*time.Minute)
+
+			Expect(condition.LastTransitionTime.Time).To(Equal(lastTransitionTime))
+		})
+	})
+})
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypePublicAccess is the type of the BackupBucket condition which reports whether public access to the
+	// blobs of the backup bucket is disallowed.
+	ConditionTypePublicAccess gardencorev1beta1.ConditionType = "PublicAccess"
+
+	// ReasonPublicAccessDisallowed is the condition reason used when neither the storage account nor the container allow
+	// public access.
+	ReasonPublicAccessDisallowed = "PublicAccessDisallowed"
+	// ReasonPublicAccessEnforced is the condition reason used when public access to the container was allowed and has
+	// been disallowed again.
+	ReasonPublicAccessEnforced = "PublicAccessEnforced"
+	// ReasonPublicAccessDrift is the condition reason used when the storage account or the container allow public access.
+	ReasonPublicAccessDrift = "PublicAccessDrift"
+)
+
+// PublicAccessDrift returns whether the given storage account and the given container allow public access to blobs.
+// An unset container public access level means that public access is disallowed.
+func PublicAccessDrift(account *armstorage.Account, container *armstorage.ContainerProperties) (accountDrift, containerDrift bool) {
+	if account != nil && account.Properties != nil {
+		accountDrift = ptr.Deref(account.Properties.AllowBlobPublicAccess, false)
+	}
+	if container != nil {
+		containerDrift = ptr.Deref(container.PublicAccess, armstorage.PublicAccessNone) != armstorage.PublicAccessNone
+	}
+	return
+}
+
+// PublicAccessCondition computes the PublicAccess condition of the given BackupBucket. If enforced is set, drift of
+// the container was corrected and is not reported.
+func PublicAccessCondition(clock clock.Clock, backupBucket *extensionsv1alpha1.BackupBucket, accountDrift, containerDrift, enforced bool) gardencorev1beta1.Condition {
+	var (
+		status  = gardencorev1beta1.ConditionTrue
+		reason  = ReasonPublicAccessDisallowed
+		message = "Public access to the blobs of the storage account and of the container is disallowed."
+		drift   []string
+	)
+
+	if accountDrift {
+		drift = append(drift, "the storage account allows public access to blobs")
+	}
+	if containerDrift && !enforced {
+		drift = append(drift, "the container allows public access")
+	}
+
+	switch {
+	case len(drift) > 0:
+		status, reason = gardencorev1beta1.ConditionFalse, ReasonPublicAccessDrift
+		message = fmt.Sprintf("Public access is not disallowed: %s.", strings.Join(drift, ", "))
+	case containerDrift:
+		reason = ReasonPublicAccessEnforced
+		message = "The container allowed public access, which has been disallowed again."
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, backupBucket.Status.Conditions, ConditionTypePublicAccess)
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+}
+
+// reconcilePublicAccess verifies that public access to the storage account and the container of the BackupBucket is
+// disallowed and reports the result in the conditions of the BackupBucket. In the Enforce mode, public access to the
+// container is disallowed again. The storage account is not updated here, because it is created with public access
+// disallowed on each reconciliation. The condition is removed if the verification is not configured.
+func (a *actuator) reconcilePublicAccess(
+	ctx context.Context, log logr.Logger,
+	storageAccountClient azureclient.StorageAccount,
+	blobContainersClient azureclient.BlobContainers,
+	backupBucket *extensionsv1alpha1.BackupBucket,
+	backupBucketConfig *azure.BackupBucketConfig,
+	resourceGroupName, storageAccountName string,
+) error {
+	var conditions []gardencorev1beta1.Condition
+	if cfg := backupBucketConfig.PublicAccess; cfg == nil {
+		if v1beta1helper.GetCondition(backupBucket.Status.Conditions, ConditionTypePublicAccess) == nil {
+			return nil
+		}
+		conditions = v1beta1helper.RemoveConditions(backupBucket.Status.Conditions, ConditionTypePublicAccess)
+	} else {
+		account, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("failed to get the storage account: %w", err)
+		}
+		container, err := blobContainersClient.GetContainer(ctx, resourceGroupName, storageAccountName, backupBucket.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get the container: %w", err)
+		}
+
+		accountDrift, containerDrift := PublicAccessDrift(account, container.ContainerProperties)
+		enforced := false
+		if containerDrift && cfg.Mode == azure.PublicAccessModeEnforce {
+			log.Info("Disallowing public access to the container", "bucket", backupBucket.Name)
+			if err := blobContainersClient.DisallowContainerPublicAccess(ctx, resourceGroupName, storageAccountName, backupBucket.Name); err != nil {
+				return fmt.Errorf("failed to disallow public access to the container: %w", err)
+			}
+			enforced = true
+		}
+		conditions = v1beta1helper.MergeConditions(backupBucket.Status.Conditions, PublicAccessCondition(a.clock, backupBucket, accountDrift, containerDrift, enforced))
+	}
+
+	if !v1beta1helper.ConditionsNeedUpdate(backupBucket.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.Conditions = conditions
+	return a.client.Status().Patch(ctx, backupBucket, patch)
+}
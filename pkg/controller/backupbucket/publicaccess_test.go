@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+var _ = Describe("PublicAccess", func() {
+	var (
+		fakeClock    *testclock.FakeClock
+		backupBucket *extensionsv1alpha1.BackupBucket
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		backupBucket = &extensionsv1alpha1.BackupBucket{}
+	})
+
+	Describe("#PublicAccessDrift", func() {
+		It("should not report drift if public access is disallowed", func() {
+			accountDrift, containerDrift := PublicAccessDrift(
+				&armstorage.Account{Properties: &armstorage.AccountProperties{AllowBlobPublicAccess: to.Ptr(false)}},
+				&armstorage.ContainerProperties{PublicAccess: to.Ptr(armstorage.PublicAccessNone)},
+			)
+			Expect(accountDrift).To(BeFalse())
+			Expect(containerDrift).To(BeFalse())
+		})
+
+		It("should treat unset settings as disallowed public access", func() {
+			accountDrift, containerDrift := PublicAccessDrift(&armstorage.Account{Properties: &armstorage.AccountProperties{}}, &armstorage.ContainerProperties{})
+			Expect(accountDrift).To(BeFalse())
+			Expect(containerDrift).To(BeFalse())
+		})
+
+		It("should report drift of the storage account and the container", func() {
+			accountDrift, containerDrift := PublicAccessDrift(
+				&armstorage.Account{Properties: &armstorage.AccountProperties{AllowBlobPublicAccess: to.Ptr(true)}},
+				&armstorage.ContainerProperties{PublicAccess: to.Ptr(armstorage.PublicAccessBlob)},
+			)
+			Expect(accountDrift).To(BeTrue())
+			Expect(containerDrift).To(BeTrue())
+		})
+	})
+
+	Describe("#PublicAccessCondition", func() {
+		It("should report disallowed public access", func() {
+			condition := PublicAccessCondition(fakeClock, backupBucket, false, false, false)
+
+			Expect(condition.Type).To(Equal(ConditionTypePublicAccess))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonPublicAccessDisallowed))
+		})
+
+		It("should report drift of the container", func() {
+			condition := PublicAccessCondition(fakeClock, backupBucket, false, true, false)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonPublicAccessDrift))
+			Expect(condition.Message).To(Equal("Public access is not disallowed: the container allows public access."))
+		})
+
+		It("should report the enforcement of the container settings", func() {
+			condition := PublicAccessCondition(fakeClock, backupBucket, false, true, true)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonPublicAccessEnforced))
+		})
+
+		It("should report drift of the storage account even if the container settings were enforced", func() {
+			condition := PublicAccessCondition(fakeClock, backupBucket, true, true, true)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonPublicAccessDrift))
+			Expect(condition.Message).To(Equal("Public access is not disallowed: the storage account allows public access to blobs."))
+		})
+	})
+})
@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
+)
+
+const (
+	// ConditionTypeSecondaryStorageAccount is the type of the BackupBucket condition which reports whether the primary
+	// and the secondary storage account are available and the backups are replicated to the secondary storage account.
+	ConditionTypeSecondaryStorageAccount gardencorev1beta1.ConditionType = "SecondaryStorageAccount"
+
+	// ReasonStorageAccountsReplicating is the condition reason used when both storage accounts are available and the
+	// object replication policy is in place.
+	ReasonStorageAccountsReplicating = "StorageAccountsReplicating"
+	// ReasonStorageAccountUnavailable is the condition reason used when the primary or the secondary storage account is
+	// unavailable.
+	ReasonStorageAccountUnavailable = "StorageAccountUnavailable"
+
+	// secondaryStorageAccountNameSuffix is appended to the backup bucket name before hashing it into the name of the
+	// secondary storage account, so that it differs from the name of the primary storage account.
+	secondaryStorageAccountNameSuffix = "-secondary"
+	// objectReplicationPolicyIDDefault is the policy ID with which an object replication policy is created in the
+	// destination account. Azure generates the actual ID, which is then used for the policy of the source account.
+	objectReplicationPolicyIDDefault = "default"
+	// objectReplicationMinCreationTime is the minimum creation time of the blobs which are replicated. It makes the
+	// policy replicate the blobs which existed before the policy was created as well.
+	objectReplicationMinCreationTime = "1601-01-01T00:00:00Z"
+	// blobVersionRetentionDays is the number of days after which the previous versions of the blobs are deleted. They
+	// are only kept because object replication requires the versioning of blobs.
+	blobVersionRetentionDays = 1
+)
+
+// storageAccountCredentials are the name and the access key of a storage account.
+type storageAccountCredentials struct {
+	name string
+	key  string
+}
+
+// secondaryStorageAccountName returns the name of the secondary storage account which is created for the given backup
+// bucket.
+func secondaryStorageAccountName(backupBucket *extensionsv1alpha1.BackupBucket, backupBucketConfig *azure.BackupBucketConfig) string {
+	return GenerateStorageAccountNameWithPrefix(storageAccountNamePrefix(backupBucketConfig), backupBucket.Name+secondaryStorageAccountNameSuffix)
+}
+
+// secondaryStorageAccountNameFromSecret returns the name of the secondary storage account stored in the given
+// generated secret of a backup bucket.
+func secondaryStorageAccountNameFromSecret(secret *corev1.Secret) (string, bool) {
+	if secret == nil {
+		return "", false
+	}
+	name, ok := secret.Data[azuretypes.SecondaryStorageAccount]
+	return string(name), ok
+}
+
+// ensureSecondaryStorageAccount creates or updates the secondary storage account of the backup bucket in the resource
+// group of the backup bucket and returns its credentials. The returned credentials are nil if no secondary storage
+// account is configured. The access keys of the secondary storage account are rotated by age like the ones of the
+// primary storage account.
+func (a *actuator) ensureSecondaryStorageAccount(
+	ctx context.Context,
+	log logr.Logger,
+	factory azureclient.Factory,
+	backupBucket *extensionsv1alpha1.BackupBucket,
+	backupBucketConfig *azure.BackupBucketConfig,
+	resourceGroupName string,
+) (*storageAccountCredentials, error) {
+	if backupBucketConfig.SecondaryStorageAccount == nil {
+		return nil, nil
+	}
+
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	storageAccountName := secondaryStorageAccountName(backupBucket, backupBucketConfig)
+	secret, err := a.getBackupBucketGeneratedSecret(ctx, backupBucket)
+	if err != nil {
+		return nil, err
+	}
+	if name, ok := secondaryStorageAccountNameFromSecret(secret); ok {
+		// like for the primary storage account, the name is kept if the configured prefix changed.
+		storageAccountName = name
+	} else if err := ensureStorageAccountNameAvailable(ctx, storageAccountClient, resourceGroupName, storageAccountName); err != nil {
+		return nil, err
+	}
+
+	// The secondary storage account is neither geo-replicated nor reachable via SFTP.
+	opts := azureclient.StorageAccountOptions{
+		InfrastructureEncryption: requiresInfrastructureEncryption(backupBucketConfig),
+	}
+	if backupBucketConfig.RotationConfig != nil {
+		opts.KeyExpirationDays = backupBucketConfig.RotationConfig.ExpirationPeriodDays
+	}
+
+	if err := ensureInfrastructureEncryptionUnchanged(ctx, storageAccountClient, resourceGroupName, storageAccountName, opts.InfrastructureEncryption); err != nil {
+		return nil, err
+	}
+	if _, err := storageAccountClient.CreateOrUpdateStorageAccount(ctx, resourceGroupName, storageAccountName, backupBucketConfig.SecondaryStorageAccount.Region, opts); err != nil {
+		return nil, err
+	}
+
+	keys, err := storageAccountClient.ListStorageAccountKeys(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) < 2 {
+		return nil, fmt.Errorf("secondary storage account %s did not return its keys", storageAccountName)
+	}
+
+	if rotation := backupBucketConfig.RotationConfig; rotation != nil && rotation.RotationPeriodDays > 0 {
+		mostRecentKey := SortKeysByAge(keys)[0]
+		shouldRotateByAge := mostRecentKey.CreationTime == nil ||
+			time.Now().After(mostRecentKey.CreationTime.Add(24*time.Hour*time.Duration(rotation.RotationPeriodDays)))
+		// the annotation is removed by the rotation of the key of the primary storage account, which happens afterwards.
+		if shouldRotateByAge || backupBucket.GetAnnotations()[azuretypes.StorageAccountKeyMustRotate] == "true" {
+			log.Info("Rotating key of the secondary storage account", "name", *keys[1].KeyName)
+			if keys, err = storageAccountClient.RotateKey(ctx, resourceGroupName, storageAccountName, *keys[1].KeyName); err != nil {
+				return nil, fmt.Errorf("failed to rotate the key of the secondary storage account: %w", err)
+			}
+		}
+	}
+
+	return &storageAccountCredentials{name: storageAccountName, key: *SortKeysByAge(keys)[0].Value}, nil
+}
+
+// reconcileSecondaryStorageAccount creates the container of the backup bucket in the secondary storage account and
+// replicates the blobs of the container in the primary storage account to it with an object replication policy. The
+// replication happens asynchronously, hence the SecondaryStorageAccount condition of the backup bucket reports whether
+// both storage accounts are available, and the reconciliation fails if one of them is not. The condition is removed if
+// no secondary storage account is configured.
+func (a *actuator) reconcileSecondaryStorageAccount(
+	ctx context.Context,
+	log logr.Logger,
+	factory azureclient.Factory,
+	backupBucket *extensionsv1alpha1.BackupBucket,
+	resourceGroupName, primaryStorageAccountName string,
+	secondary *storageAccountCredentials,
+) error {
+	if secondary == nil {
+		if v1beta1helper.GetCondition(backupBucket.Status.Conditions, ConditionTypeSecondaryStorageAccount) == nil {
+			return nil
+		}
+		return a.patchConditions(ctx, backupBucket, v1beta1helper.RemoveConditions(backupBucket.Status.Conditions, ConditionTypeSecondaryStorageAccount))
+	}
+
+	blobContainersClient, err := factory.BlobContainers()
+	if err != nil {
+		return err
+	}
+	if err := ensureContainer(ctx, log, blobContainersClient, resourceGroupName, secondary.name, backupBucket.Name); err != nil {
+		return err
+	}
+
+	if features.ExtensionFeatureGate.Enabled(features.EnableImmutableBuckets) {
+		// the lifecycle policy deletes the blobs of deleted backup entries, as object replication does not replicate
+		// deletions. Immutability policies are forbidden in combination with a secondary storage account.
+		managementPoliciesClient, err := factory.ManagementPolicies()
+		if err != nil {
+			return err
+		}
+		if err := managementPoliciesClient.CreateOrUpdate(ctx, resourceGroupName, secondary.name, 0); err != nil {
+			return fmt.Errorf("failed to add the lifecycle policy on the secondary storage account: %w", err)
+		}
+	}
+
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+	primary, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, primaryStorageAccountName)
+	if err != nil {
+		return err
+	}
+	secondaryAccount, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, secondary.name)
+	if err != nil {
+		return err
+	}
+
+	condition := SecondaryStorageAccountCondition(a.clock, backupBucket, primary, secondaryAccount)
+	if condition.Status == gardencorev1beta1.ConditionTrue {
+		objectReplicationClient, err := factory.ObjectReplication()
+		if err != nil {
+			return err
+		}
+		if err := EnsureObjectReplicationPolicy(ctx, objectReplicationClient, resourceGroupName, primary, secondaryAccount, backupBucket.Name); err != nil {
+			return fmt.Errorf("failed to replicate the backups to the secondary storage account: %w", err)
+		}
+
+		managementPoliciesClient, err := factory.ManagementPolicies()
+		if err != nil {
+			return err
+		}
+		for _, storageAccountName := range []string{primaryStorageAccountName, secondary.name} {
+			if err := EnsureBlobVersionDeletionRule(ctx, managementPoliciesClient, resourceGroupName, storageAccountName); err != nil {
+				return fmt.Errorf("failed to add the lifecycle policy for the blob versions on storage account %s: %w", storageAccountName, err)
+			}
+		}
+	}
+
+	if err := a.patchConditions(ctx, backupBucket, v1beta1helper.MergeConditions(backupBucket.Status.Conditions, condition)); err != nil {
+		return err
+	}
+	if condition.Status != gardencorev1beta1.ConditionTrue {
+		return errors.New(condition.Message)
+	}
+	return nil
+}
+
+// SecondaryStorageAccountCondition computes the SecondaryStorageAccount condition of the given BackupBucket based on
+// the given primary and secondary storage accounts.
+func SecondaryStorageAccountCondition(clock clock.Clock, backupBucket *extensionsv1alpha1.BackupBucket, primary, secondary *armstorage.Account) gardencorev1beta1.Condition {
+	var (
+		status      = gardencorev1beta1.ConditionTrue
+		reason      = ReasonStorageAccountsReplicating
+		message     = "The primary and the secondary storage account are available, the backups are replicated to the secondary storage account."
+		unavailable []string
+	)
+
+	if !storageAccountAvailable(primary) {
+		unavailable = append(unavailable, "primary")
+	}
+	if !storageAccountAvailable(secondary) {
+		unavailable = append(unavailable, "secondary")
+	}
+	if len(unavailable) > 0 {
+		status, reason = gardencorev1beta1.ConditionFalse, ReasonStorageAccountUnavailable
+		message = fmt.Sprintf("The %s storage account is unavailable, the backups are not replicated.", unavailable[0])
+		if len(unavailable) > 1 {
+			message = "The primary and the secondary storage accounts are unavailable, the backups are not replicated."
+		}
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, backupBucket.Status.Conditions, ConditionTypeSecondaryStorageAccount)
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+}
+
+// storageAccountAvailable returns whether the given storage account is provisioned and its primary location is
+// available.
+func storageAccountAvailable(account *armstorage.Account) bool {
+	return account != nil && account.Properties != nil &&
+		ptr.Deref(account.Properties.ProvisioningState, "") == armstorage.ProvisioningStateSucceeded &&
+		ptr.Deref(account.Properties.StatusOfPrimary, armstorage.AccountStatusUnavailable) == armstorage.AccountStatusAvailable
+}
+
+// EnsureObjectReplicationPolicy ensures that the blobs of the container with the given name in the source storage
+// account are replicated to the container with the same name in the destination storage account. Object replication
+// requires the versioning of blobs in both storage accounts and the change feed in the source storage account, which
+// are enabled. An object replication policy is created in the destination storage account first, then the same policy
+// with the rule IDs generated by Azure is created in the source storage account.
+func EnsureObjectReplicationPolicy(ctx context.Context, c azureclient.ObjectReplication, resourceGroupName string, source, destination *armstorage.Account, containerName string) error {
+	if err := c.EnableBlobVersioning(ctx, resourceGroupName, *source.Name, true); err != nil {
+		return fmt.Errorf("failed to enable the versioning and the change feed of the source storage account: %w", err)
+	}
+	if err := c.EnableBlobVersioning(ctx, resourceGroupName, *destination.Name, false); err != nil {
+		return fmt.Errorf("failed to enable the versioning of the destination storage account: %w", err)
+	}
+
+	sourcePolicies, err := c.ListPolicies(ctx, resourceGroupName, *source.Name)
+	if err != nil {
+		return err
+	}
+	if findObjectReplicationPolicy(sourcePolicies, source, destination, containerName) != nil {
+		return nil
+	}
+
+	// the policy of the destination might already exist if the creation of the one of the source failed before.
+	destinationPolicies, err := c.ListPolicies(ctx, resourceGroupName, *destination.Name)
+	if err != nil {
+		return err
+	}
+	policy := findObjectReplicationPolicy(destinationPolicies, source, destination, containerName)
+	if policy == nil {
+		if policy, err = c.CreateOrUpdatePolicy(ctx, resourceGroupName, *destination.Name, objectReplicationPolicyIDDefault, armstorage.ObjectReplicationPolicy{
+			Properties: &armstorage.ObjectReplicationPolicyProperties{
+				SourceAccount:      source.ID,
+				DestinationAccount: destination.ID,
+				Rules: []*armstorage.ObjectReplicationPolicyRule{{
+					SourceContainer:      ptr.To(containerName),
+					DestinationContainer: ptr.To(containerName),
+					Filters:              &armstorage.ObjectReplicationPolicyFilter{MinCreationTime: ptr.To(objectReplicationMinCreationTime)},
+				}},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	if policy.Properties == nil || policy.Properties.PolicyID == nil {
+		return fmt.Errorf("object replication policy of the destination storage account %s has no ID", *destination.Name)
+	}
+
+	_, err = c.CreateOrUpdatePolicy(ctx, resourceGroupName, *source.Name, *policy.Properties.PolicyID, armstorage.ObjectReplicationPolicy{Properties: policy.Properties})
+	return err
+}
+
+// EnsureBlobVersionDeletionRule adds a rule to the lifecycle policy of the given storage account which deletes the
+// previous versions of the blobs, which are kept for every overwritten or deleted blob once the versioning of blobs is
+// enabled for object replication.
+func EnsureBlobVersionDeletionRule(ctx context.Context, c azureclient.ManagementPolicies, resourceGroupName, storageAccountName string) error {
+	return c.SetRule(ctx, resourceGroupName, storageAccountName, &armstorage.ManagementPolicyRule{
+		Name:    ptr.To(azuretypes.BlobVersionDeletionLifecyclePolicyName),
+		Type:    ptr.To(armstorage.RuleTypeLifecycle),
+		Enabled: ptr.To(true),
+		Definition: &armstorage.ManagementPolicyDefinition{
+			Actions: &armstorage.ManagementPolicyAction{
+				Version: &armstorage.ManagementPolicyVersion{
+					Delete: &armstorage.DateAfterCreation{DaysAfterCreationGreaterThan: ptr.To(float32(blobVersionRetentionDays))},
+				},
+			},
+			Filters: &armstorage.ManagementPolicyFilter{
+				// the SDK does not expose constants for the blob types
+				BlobTypes: []*string{ptr.To("blockBlob")},
+			},
+		},
+	})
+}
+
+// findObjectReplicationPolicy returns the policy of the given ones which replicates the container with the given name
+// from the source to the destination storage account.
+func findObjectReplicationPolicy(policies []*armstorage.ObjectReplicationPolicy, source, destination *armstorage.Account, containerName string) *armstorage.ObjectReplicationPolicy {
+	for _, policy := range policies {
+		if policy == nil || policy.Properties == nil ||
+			!strings.EqualFold(ptr.Deref(policy.Properties.SourceAccount, ""), *source.ID) ||
+			!strings.EqualFold(ptr.Deref(policy.Properties.DestinationAccount, ""), *destination.ID) {
+			continue
+		}
+		for _, rule := range policy.Properties.Rules {
+			if rule != nil && ptr.Deref(rule.SourceContainer, "") == containerName && ptr.Deref(rule.DestinationContainer, "") == containerName {
+				return policy
+			}
+		}
+	}
+	return nil
+}
+
+// deleteSecondaryStorageAccountContainer deletes the object replication policies of the primary and the secondary
+// storage account and the container of the backup bucket in the secondary storage account. The object replication
+// does not replicate deletions, hence the container is deleted explicitly.
+func deleteSecondaryStorageAccountContainer(ctx context.Context, factory azureclient.Factory, resourceGroupName, primaryStorageAccountName, secondaryStorageAccountName, containerName string) error {
+	objectReplicationClient, err := factory.ObjectReplication()
+	if err != nil {
+		return err
+	}
+	for _, storageAccountName := range []string{primaryStorageAccountName, secondaryStorageAccountName} {
+		policies, err := objectReplicationClient.ListPolicies(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if azureclient.IsAzureAPINotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		for _, policy := range policies {
+			if policy == nil || policy.Name == nil {
+				continue
+			}
+			if err := objectReplicationClient.DeletePolicy(ctx, resourceGroupName, storageAccountName, *policy.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	blobContainersClient, err := factory.BlobContainers()
+	if err != nil {
+		return err
+	}
+	return blobContainersClient.DeleteContainer(ctx, resourceGroupName, secondaryStorageAccountName, containerName)
+}
+
+// ensureContainer creates the container with the given name in the given storage account if it does not exist.
+func ensureContainer(ctx context.Context, log logr.Logger, blobContainersClient azureclient.BlobContainers, resourceGroupName, storageAccountName, containerName string) error {
+	_, err := blobContainersClient.GetContainer(ctx, resourceGroupName, storageAccountName, containerName)
+	if err == nil || !azureclient.IsAzureAPINotFoundError(err) {
+		return err
+	}
+
+	log.Info("Bucket does not exist; creating", "name", containerName, "storageAccount", storageAccountName)
+	_, err = blobContainersClient.CreateContainer(ctx, resourceGroupName, storageAccountName, containerName)
+	return err
+}
+
+// patchConditions patches the conditions of the status of the given BackupBucket if they changed.
+func (a *actuator) patchConditions(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, conditions []gardencorev1beta1.Condition) error {
+	if !v1beta1helper.ConditionsNeedUpdate(backupBucket.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.Conditions = conditions
+	return a.client.Status().Patch(ctx, backupBucket, patch)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	testclock "k8s.io/utils/clock/testing"
+
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+var _ = Describe("SecondaryStorageAccount", func() {
+	const (
+		resourceGroupName = "bucket"
+		containerName     = "bucket"
+		sourceID          = "/subscriptions/sub/resourceGroups/bucket/providers/Microsoft.Storage/storageAccounts/primary"
+		destinationID     = "/subscriptions/sub/resourceGroups/bucket/providers/Microsoft.Storage/storageAccounts/secondary"
+	)
+
+	var (
+		fakeClock    *testclock.FakeClock
+		backupBucket *extensionsv1alpha1.BackupBucket
+
+		primary, secondary *armstorage.Account
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		backupBucket = &extensionsv1alpha1.BackupBucket{}
+
+		primary = &armstorage.Account{
+			ID:   to.Ptr(sourceID),
+			Name: to.Ptr("primary"),
+			Properties: &armstorage.AccountProperties{
+				ProvisioningState: to.Ptr(armstorage.ProvisioningStateSucceeded),
+				StatusOfPrimary:   to.Ptr(armstorage.AccountStatusAvailable),
+			},
+		}
+		secondary = &armstorage.Account{
+			ID:   to.Ptr(destinationID),
+			Name: to.Ptr("secondary"),
+			Properties: &armstorage.AccountProperties{
+				ProvisioningState: to.Ptr(armstorage.ProvisioningStateSucceeded),
+				StatusOfPrimary:   to.Ptr(armstorage.AccountStatusAvailable),
+			},
+		}
+	})
+
+	Describe("#SecondaryStorageAccountCondition", func() {
+		It("should report available storage accounts", func() {
+			condition := SecondaryStorageAccountCondition(fakeClock, backupBucket, primary, secondary)
+
+			Expect(condition.Type).To(Equal(ConditionTypeSecondaryStorageAccount))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonStorageAccountsReplicating))
+		})
+
+		It("should report an unavailable secondary storage account", func() {
+			secondary.Properties.StatusOfPrimary = to.Ptr(armstorage.AccountStatusUnavailable)
+
+			condition := SecondaryStorageAccountCondition(fakeClock, backupBucket, primary, secondary)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonStorageAccountUnavailable))
+			Expect(condition.Message).To(Equal("The secondary storage account is unavailable, the backups are not replicated."))
+		})
+
+		It("should report unavailable primary and secondary storage accounts", func() {
+			primary.Properties.ProvisioningState = to.Ptr(armstorage.ProvisioningStateCreating)
+
+			condition := SecondaryStorageAccountCondition(fakeClock, backupBucket, primary, nil)
+
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal("The primary and the secondary storage accounts are unavailable, the backups are not replicated."))
+		})
+	})
+
+	Describe("#EnsureObjectReplicationPolicy", func() {
+		var (
+			ctx    context.Context
+			ctrl   *gomock.Controller
+			client *mockazureclient.MockObjectReplication
+
+			policy *armstorage.ObjectReplicationPolicy
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			ctrl = gomock.NewController(GinkgoT())
+			client = mockazureclient.NewMockObjectReplication(ctrl)
+
+			policy = &armstorage.ObjectReplicationPolicy{
+				Name: to.Ptr("policy-id"),
+				Properties: &armstorage.ObjectReplicationPolicyProperties{
+					PolicyID:           to.Ptr("policy-id"),
+					SourceAccount:      to.Ptr(sourceID),
+					DestinationAccount: to.Ptr(destinationID),
+					Rules: []*armstorage.ObjectReplicationPolicyRule{{
+						RuleID:               to.Ptr("rule-id"),
+						SourceContainer:      to.Ptr(containerName),
+						DestinationContainer: to.Ptr(containerName),
+					}},
+				},
+			}
+
+			client.EXPECT().EnableBlobVersioning(ctx, resourceGroupName, "primary", true)
+			client.EXPECT().EnableBlobVersioning(ctx, resourceGroupName, "secondary", false)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should do nothing if the source storage account has the policy", func() {
+			client.EXPECT().ListPolicies(ctx, resourceGroupName, "primary").Return([]*armstorage.ObjectReplicationPolicy{policy}, nil)
+
+			Expect(EnsureObjectReplicationPolicy(ctx, client, resourceGroupName, primary, secondary, containerName)).To(Succeed())
+		})
+
+		It("should create the policy in the destination and then in the source storage account", func() {
+			client.EXPECT().ListPolicies(ctx, resourceGroupName, "primary")
+			client.EXPECT().ListPolicies(ctx, resourceGroupName, "secondary")
+			client.EXPECT().CreateOrUpdatePolicy(ctx, resourceGroupName, "secondary", "default", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _, _ string, p armstorage.ObjectReplicationPolicy) (*armstorage.ObjectReplicationPolicy, error) {
+					Expect(*p.Properties.SourceAccount).To(Equal(sourceID))
+					Expect(*p.Properties.DestinationAccount).To(Equal(destinationID))
+					Expect(p.Properties.Rules).To(HaveLen(1))
+					Expect(*p.Properties.Rules[0].SourceContainer).To(Equal(containerName))
+					Expect(*p.Properties.Rules[0].Filters.MinCreationTime).To(Equal("1601-01-01T00:00:00Z"))
+					return policy, nil
+				})
+			client.EXPECT().CreateOrUpdatePolicy(ctx, resourceGroupName, "primary", "policy-id", armstorage.ObjectReplicationPolicy{Properties: policy.Properties})
+
+			Expect(EnsureObjectReplicationPolicy(ctx, client, resourceGroupName, primary, secondary, containerName)).To(Succeed())
+		})
+
+		It("should reuse the policy of the destination storage account", func() {
+			client.EXPECT().ListPolicies(ctx, resourceGroupName, "primary")
+			client.EXPECT().ListPolicies(ctx, resourceGroupName, "secondary").Return([]*armstorage.ObjectReplicationPolicy{policy}, nil)
+			client.EXPECT().CreateOrUpdatePolicy(ctx, resourceGroupName, "primary", "policy-id", armstorage.ObjectReplicationPolicy{Properties: policy.Properties})
+
+			Expect(EnsureObjectReplicationPolicy(ctx, client, resourceGroupName, primary, secondary, containerName)).To(Succeed())
+		})
+	})
+
+	Describe("#EnsureBlobVersionDeletionRule", func() {
+		It("should delete the previous versions of the blobs after a day", func() {
+			ctx := context.Background()
+			ctrl := gomock.NewController(GinkgoT())
+			client := mockazureclient.NewMockManagementPolicies(ctrl)
+
+			client.EXPECT().SetRule(ctx, resourceGroupName, "primary", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, rule *armstorage.ManagementPolicyRule) error {
+					Expect(*rule.Name).To(Equal("delete-blob-versions"))
+					Expect(rule.Definition.Actions.BaseBlob).To(BeNil())
+					Expect(*rule.Definition.Actions.Version.Delete.DaysAfterCreationGreaterThan).To(BeNumerically("==", 1))
+					return nil
+				})
+
+			Expect(EnsureBlobVersionDeletionRule(ctx, client, resourceGroupName, "primary")).To(Succeed())
+		})
+	})
+})
@@ -18,7 +18,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
-func (a *actuator) createOrUpdateBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, storageAccountName, storageKey, storageDomain string) error {
+func (a *actuator) createOrUpdateBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, storageAccountName, storageKey, storageDomain string, secondary *storageAccountCredentials) error {
 	var generatedSecret = &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("generated-bucket-%s", backupBucket.Name),
@@ -32,6 +32,10 @@ func (a *actuator) createOrUpdateBackupBucketGeneratedSecret(ctx context.Context
 			azure.StorageKey:     []byte(storageKey),
 			azure.StorageDomain:  []byte(storageDomain),
 		}
+		if secondary != nil {
+			generatedSecret.Data[azure.SecondaryStorageAccount] = []byte(secondary.name)
+			generatedSecret.Data[azure.SecondaryStorageKey] = []byte(secondary.key)
+		}
 		return nil
 	}); err != nil {
 		return err
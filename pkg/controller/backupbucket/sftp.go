@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
+)
+
+const (
+	// SFTPLocalUserName is the name of the local user of the storage account which may read the backups via SFTP.
+	SFTPLocalUserName = "backupreader"
+	// sftpPermissions are the permissions of the SFTP local user on the backup container, i.e. read and list.
+	sftpPermissions = "rl"
+)
+
+// ensureHierarchicalNamespaceForSFTP returns an error if SFTP is configured, but the storage account with the given name
+// already exists with a flat namespace. SFTP requires a hierarchical namespace, which Azure only enables when a storage
+// account is created.
+func ensureHierarchicalNamespaceForSFTP(ctx context.Context, storageAccountClient azureclient.StorageAccount, resourceGroupName, storageAccountName string, sftp bool) error {
+	if !sftp {
+		return nil
+	}
+
+	account, err := storageAccountClient.GetStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if account == nil || account.Properties == nil || ptr.Deref(account.Properties.IsHnsEnabled, false) {
+		return nil
+	}
+	return gardencorev1beta1helper.NewErrorWithCodes(
+		fmt.Errorf("SFTP cannot be enabled for the existing storage account %q, since it requires a hierarchical namespace which can only be enabled when a storage account is created", storageAccountName),
+		gardencorev1beta1.ErrorConfigurationProblem,
+	)
+}
+
+// forbidSFTPForImmutableBuckets returns an error if SFTP is configured while immutable buckets are enabled. The
+// lifecycle policy which deletes the immutable backups delayed matches the blob index tags of the backups, but a
+// hierarchical namespace, which SFTP requires, does not support blob index tags.
+func forbidSFTPForImmutableBuckets(backupBucketConfig *azure.BackupBucketConfig) error {
+	if backupBucketConfig.SFTP == nil || !features.ExtensionFeatureGate.Enabled(features.EnableImmutableBuckets) {
+		return nil
+	}
+	return gardencorev1beta1helper.NewErrorWithCodes(
+		fmt.Errorf("SFTP cannot be enabled while the %s feature gate is enabled, since the delayed deletion of the backups requires blob index tags which a hierarchical namespace does not support", features.EnableImmutableBuckets),
+		gardencorev1beta1.ErrorConfigurationProblem,
+	)
+}
+
+// deleteSFTPLocalUser deletes the local user which may read the backups via SFTP from the given storage account once
+// SFTP is disabled, so that its SSH keys are not authorized again if SFTP is enabled later on. Local users only exist in
+// storage accounts with a hierarchical namespace, hence other storage accounts are not checked for it.
+func deleteSFTPLocalUser(ctx context.Context, factory azureclient.Factory, account *armstorage.Account, resourceGroupName, storageAccountName string) error {
+	if account == nil || account.Properties == nil || !ptr.Deref(account.Properties.IsHnsEnabled, false) {
+		return nil
+	}
+
+	localUsersClient, err := factory.LocalUsers()
+	if err != nil {
+		return err
+	}
+	if err := localUsersClient.Delete(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName); err != nil {
+		return fmt.Errorf("failed to delete the local user %s: %w", SFTPLocalUserName, err)
+	}
+	return nil
+}
+
+// EnsureSFTPLocalUser creates or updates the local user which may read the backups in the given container via SFTP. The
+// local user authenticates with the configured SSH keys only and is confined to the container. Azure does not return
+// the SSH keys of local users, hence the local user is updated on each reconciliation.
+func EnsureSFTPLocalUser(
+	ctx context.Context,
+	c azureclient.LocalUsers,
+	resourceGroupName, storageAccountName, containerName string,
+	cfg *azure.SFTPConfig,
+) error {
+	properties := &armstorage.LocalUserProperties{
+		HomeDirectory: ptr.To(containerName),
+		PermissionScopes: []*armstorage.PermissionScope{{
+			Permissions:  ptr.To(sftpPermissions),
+			ResourceName: ptr.To(containerName),
+			Service:      ptr.To("blob"),
+		}},
+		HasSSHKey:      ptr.To(true),
+		HasSSHPassword: ptr.To(false),
+		HasSharedKey:   ptr.To(false),
+	}
+	for _, key := range cfg.SSHAuthorizedKeys {
+		properties.SSHAuthorizedKeys = append(properties.SSHAuthorizedKeys, &armstorage.SSHPublicKey{Key: ptr.To(key)})
+	}
+
+	if err := c.CreateOrUpdate(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName, armstorage.LocalUser{Properties: properties}); err != nil {
+		return fmt.Errorf("failed to update the local user %s: %w", SFTPLocalUserName, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+)
+
+var _ = Describe("SFTP", func() {
+	const (
+		resourceGroupName  = "backup-rg"
+		storageAccountName = "bkp0123456789abcde"
+		containerName      = "backup-bucket"
+	)
+
+	var (
+		ctx        context.Context
+		ctrl       *gomock.Controller
+		localUsers *mockazureclient.MockLocalUsers
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		localUsers = mockazureclient.NewMockLocalUsers(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureSFTPLocalUser", func() {
+		It("should create the local user with read and list permissions on the container and the configured keys", func() {
+			localUsers.EXPECT().CreateOrUpdate(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName, armstorage.LocalUser{
+				Properties: &armstorage.LocalUserProperties{
+					HomeDirectory: ptr.To(containerName),
+					PermissionScopes: []*armstorage.PermissionScope{{
+						Permissions:  ptr.To("rl"),
+						ResourceName: ptr.To(containerName),
+						Service:      ptr.To("blob"),
+					}},
+					HasSSHKey:      ptr.To(true),
+					HasSSHPassword: ptr.To(false),
+					HasSharedKey:   ptr.To(false),
+					SSHAuthorizedKeys: []*armstorage.SSHPublicKey{
+						{Key: ptr.To("ssh-ed25519 AAAA first")},
+						{Key: ptr.To("ssh-ed25519 AAAA second")},
+					},
+				},
+			}).Return(nil)
+
+			Expect(EnsureSFTPLocalUser(ctx, localUsers, resourceGroupName, storageAccountName, containerName,
+				&azure.SFTPConfig{SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA first", "ssh-ed25519 AAAA second"}})).To(Succeed())
+		})
+
+		It("should return the error if the local user cannot be updated", func() {
+			localUsers.EXPECT().CreateOrUpdate(ctx, resourceGroupName, storageAccountName, SFTPLocalUserName, gomock.Any()).Return(errors.New("forbidden"))
+
+			Expect(EnsureSFTPLocalUser(ctx, localUsers, resourceGroupName, storageAccountName, containerName,
+				&azure.SFTPConfig{SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA"}})).To(MatchError(ContainSubstring("failed to update the local user backupreader: forbidden")))
+		})
+	})
+})
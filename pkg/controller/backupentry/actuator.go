@@ -7,6 +7,7 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
@@ -18,12 +19,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 var (
 	// DefaultBlobStorageClient is the default function to get a backupbucket client. Can be overridden for tests.
 	DefaultBlobStorageClient = azureclient.NewBlobStorageClientFromSecretRef
+	// DefaultSecondaryBlobStorageClient is the default function to get a client for the secondary storage account of a
+	// backupbucket. Can be overridden for tests.
+	DefaultSecondaryBlobStorageClient = azureclient.NewSecondaryBlobStorageClientFromSecretRef
 )
 
 type actuator struct {
@@ -36,8 +41,18 @@ func newActuator(mgr manager.Manager) genericactuator.BackupEntryDelegate {
 	}
 }
 
+// GetETCDSecretData returns the data of the etcd backup secret. The backups are only written to the primary storage
+// account and replicated to the secondary storage account by Azure, hence the credentials of the secondary storage
+// account are not passed to etcd.
 func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
-	return backupSecretData, nil
+	if _, ok := backupSecretData[azure.SecondaryStorageAccount]; !ok {
+		return backupSecretData, nil
+	}
+
+	data := maps.Clone(backupSecretData)
+	delete(data, azure.SecondaryStorageAccount)
+	delete(data, azure.SecondaryStorageKey)
+	return data, nil
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, backupEntry *extensionsv1alpha1.BackupEntry) error {
@@ -46,5 +61,14 @@ func (a *actuator) Delete(ctx context.Context, _ logr.Logger, backupEntry *exten
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 	entryName := strings.TrimPrefix(backupEntry.Name, v1beta1constants.BackupSourcePrefix+"-")
-	return util.DetermineError(storageClient.CleanupObjectsWithPrefix(ctx, fmt.Sprintf("%s/", entryName)), helper.KnownCodes)
+	if err := storageClient.CleanupObjectsWithPrefix(ctx, fmt.Sprintf("%s/", entryName)); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	// object replication does not replicate deletions, hence the replicated backups are deleted explicitly.
+	secondaryStorageClient, err := DefaultSecondaryBlobStorageClient(ctx, a.client, &backupEntry.Spec.SecretRef, backupEntry.Spec.BucketName)
+	if err != nil || secondaryStorageClient == nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	return util.DetermineError(secondaryStorageClient.CleanupObjectsWithPrefix(ctx, fmt.Sprintf("%s/", entryName)), helper.KnownCodes)
 }
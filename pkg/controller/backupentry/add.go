@@ -6,13 +6,16 @@ package backupentry
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -29,13 +32,20 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// NotificationWebhook enables the notifications about BackupEntries to a webhook.
+	NotificationWebhook *config.NotificationWebhook
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	var notifier Notifier
+	if opts.NotificationWebhook != nil {
+		notifier = NewWebhookNotifier(opts.NotificationWebhook.URL, http.DefaultClient, DefaultNotificationBackoff)
+	}
+
 	return backupentry.Add(mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr)),
+		Actuator:          NewNotifyingActuator(genericactuator.NewActuator(mgr, newActuator(mgr)), mgr.GetEventRecorderFor(azure.Name+"-backupentry-controller"), notifier, clock.RealClock{}),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              azure.Type,
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// EventReasonBackupEntryCreated is the reason of the event of the BackupEntry which is recorded when it was created.
+	EventReasonBackupEntryCreated = "BackupEntryCreated"
+	// EventReasonBackupEntryDeleted is the reason of the event of the BackupEntry which is recorded when its backups were
+	// deleted.
+	EventReasonBackupEntryDeleted = "BackupEntryDeleted"
+	// EventReasonBackupEntryReconcileFailed is the reason of the event of the BackupEntry which is recorded when its
+	// reconciliation or restoration failed.
+	EventReasonBackupEntryReconcileFailed = "BackupEntryReconcileFailed"
+	// EventReasonBackupEntryDeleteFailed is the reason of the event of the BackupEntry which is recorded when the deletion
+	// of its backups failed.
+	EventReasonBackupEntryDeleteFailed = "BackupEntryDeleteFailed"
+
+	// notificationTimeout bounds the time of a single delivery attempt of a notification.
+	notificationTimeout = 10 * time.Second
+)
+
+// DefaultNotificationBackoff is the backoff of the delivery attempts of a notification to the webhook. The delivery is
+// given up after the last step, so that an unavailable webhook does not block the reconciliation of the BackupEntries.
+var DefaultNotificationBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Steps:    4,
+}
+
+// Notification is the notification about a BackupEntry which is posted as JSON to the webhook.
+type Notification struct {
+	// Type is the type of the event, i.e. Normal or Warning.
+	Type string `json:"type"`
+	// Reason is the reason of the event, e.g. BackupEntryCreated.
+	Reason string `json:"reason"`
+	// Message describes the event.
+	Message string `json:"message"`
+	// BackupEntry is the name of the BackupEntry.
+	BackupEntry string `json:"backupEntry"`
+	// BucketName is the name of the bucket of the BackupEntry.
+	BucketName string `json:"bucketName"`
+	// Timestamp is the time of the event.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Notifier delivers notifications about BackupEntries.
+type Notifier interface {
+	// Notify delivers the given notification.
+	Notify(context.Context, Notification) error
+}
+
+// WebhookNotifier posts notifications to a webhook. Failed deliveries are retried with a backoff if they may succeed
+// later, i.e. for network errors, throttling and server errors.
+type WebhookNotifier struct {
+	url     string
+	client  *http.Client
+	backoff wait.Backoff
+}
+
+// NewWebhookNotifier creates a notifier which posts the notifications to the given URL.
+func NewWebhookNotifier(url string, client *http.Client, backoff wait.Backoff) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: client, backoff: backoff}
+}
+
+// Notify implements Notifier.
+func (w *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	if err := wait.ExponentialBackoffWithContext(ctx, w.backoff, func(ctx context.Context) (bool, error) {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return true, nil
+		}
+		if !retry {
+			return false, err
+		}
+		lastErr = err
+		return false, nil
+	}); err != nil {
+		if wait.Interrupted(err) && lastErr != nil {
+			return fmt.Errorf("failed to deliver notification to webhook: %w", lastErr)
+		}
+		return fmt.Errorf("failed to deliver notification to webhook: %w", err)
+	}
+	return nil
+}
+
+// post posts the given body to the webhook and returns whether a failed delivery should be retried.
+func (w *WebhookNotifier) post(ctx context.Context, body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return false, nil
+	case response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	default:
+		return false, fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+}
+
+// notifyingActuator records events for the creation, the deletion and the failures of BackupEntries and sends them to
+// the notifier, if any.
+type notifyingActuator struct {
+	backupentry.Actuator
+	recorder record.EventRecorder
+	notifier Notifier
+	clock    clock.Clock
+}
+
+// NewNotifyingActuator wraps the given actuator with one which records events for the creation, the deletion and the
+// failures of BackupEntries. The events are additionally delivered to the given notifier if it is not nil. A failed
+// delivery is only logged and does not fail the operation.
+func NewNotifyingActuator(actuator backupentry.Actuator, recorder record.EventRecorder, notifier Notifier, clock clock.Clock) backupentry.Actuator {
+	return &notifyingActuator{
+		Actuator: actuator,
+		recorder: recorder,
+		notifier: notifier,
+		clock:    clock,
+	}
+}
+
+func (a *notifyingActuator) Reconcile(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	if err := a.Actuator.Reconcile(ctx, log, be); err != nil {
+		a.notify(ctx, log, be, corev1.EventTypeWarning, EventReasonBackupEntryReconcileFailed, fmt.Sprintf("Failed to reconcile the backup entry: %v", err))
+		return err
+	}
+
+	// the generic reconciler records the operation type in the status before calling the actuator.
+	if be.Status.LastOperation != nil && be.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate {
+		a.notify(ctx, log, be, corev1.EventTypeNormal, EventReasonBackupEntryCreated, fmt.Sprintf("Created the backup entry in bucket %q", be.Spec.BucketName))
+	}
+	return nil
+}
+
+func (a *notifyingActuator) Restore(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	if err := a.Actuator.Restore(ctx, log, be); err != nil {
+		a.notify(ctx, log, be, corev1.EventTypeWarning, EventReasonBackupEntryReconcileFailed, fmt.Sprintf("Failed to restore the backup entry: %v", err))
+		return err
+	}
+	return nil
+}
+
+func (a *notifyingActuator) Delete(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+	if err := a.Actuator.Delete(ctx, log, be); err != nil {
+		a.notify(ctx, log, be, corev1.EventTypeWarning, EventReasonBackupEntryDeleteFailed, fmt.Sprintf("Failed to delete the backups of the backup entry: %v", err))
+		return err
+	}
+
+	a.notify(ctx, log, be, corev1.EventTypeNormal, EventReasonBackupEntryDeleted, fmt.Sprintf("Deleted the backups of the backup entry in bucket %q", be.Spec.BucketName))
+	return nil
+}
+
+func (a *notifyingActuator) notify(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry, eventType, reason, message string) {
+	a.recorder.Event(be, eventType, reason, message)
+
+	if a.notifier == nil {
+		return
+	}
+	if err := a.notifier.Notify(ctx, Notification{
+		Type:        eventType,
+		Reason:      reason,
+		Message:     message,
+		BackupEntry: be.Name,
+		BucketName:  be.Spec.BucketName,
+		Timestamp:   a.clock.Now().UTC(),
+	}); err != nil {
+		log.Error(err, "Failed to notify the webhook about the backup entry", "reason", reason)
+	}
+}
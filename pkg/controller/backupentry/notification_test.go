@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupentry"
+)
+
+type fakeActuator struct {
+	err error
+}
+
+func (f *fakeActuator) Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return f.err
+}
+
+func (f *fakeActuator) Delete(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return f.err
+}
+
+func (f *fakeActuator) Restore(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return f.err
+}
+
+func (f *fakeActuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.BackupEntry) error {
+	return f.err
+}
+
+type fakeNotifier struct {
+	notifications []Notification
+	err           error
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, notification Notification) error {
+	f.notifications = append(f.notifications, notification)
+	return f.err
+}
+
+var _ = Describe("Notification", func() {
+	var (
+		ctx         context.Context
+		log         logr.Logger
+		fakeClock   *testclock.FakeClock
+		backupEntry *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log = logr.Discard()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		backupEntry = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "bucket"},
+			Status: extensionsv1alpha1.BackupEntryStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate},
+				},
+			},
+		}
+	})
+
+	Describe("#NewNotifyingActuator", func() {
+		var (
+			inner    *fakeActuator
+			recorder *record.FakeRecorder
+			notifier *fakeNotifier
+		)
+
+		BeforeEach(func() {
+			inner = &fakeActuator{}
+			recorder = record.NewFakeRecorder(10)
+			notifier = &fakeNotifier{}
+		})
+
+		It("should emit an event and notify when a backup entry was created", func() {
+			Expect(NewNotifyingActuator(inner, recorder, notifier, fakeClock).Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+			Expect(recorder.Events).To(Receive(Equal(`Normal BackupEntryCreated Created the backup entry in bucket "bucket"`)))
+			Expect(notifier.notifications).To(ConsistOf(Notification{
+				Type:        "Normal",
+				Reason:      EventReasonBackupEntryCreated,
+				Message:     `Created the backup entry in bucket "bucket"`,
+				BackupEntry: "shoot--foo--bar--uid",
+				BucketName:  "bucket",
+				Timestamp:   fakeClock.Now(),
+			}))
+		})
+
+		It("should not emit an event when an existing backup entry was reconciled", func() {
+			backupEntry.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+
+			Expect(NewNotifyingActuator(inner, recorder, notifier, fakeClock).Reconcile(ctx, log, backupEntry)).To(Succeed())
+
+			Expect(recorder.Events).To(BeEmpty())
+			Expect(notifier.notifications).To(BeEmpty())
+		})
+
+		It("should emit a warning event when the reconciliation failed", func() {
+			inner.err = errors.New("fake")
+
+			Expect(NewNotifyingActuator(inner, recorder, notifier, fakeClock).Reconcile(ctx, log, backupEntry)).To(MatchError("fake"))
+
+			Expect(recorder.Events).To(Receive(Equal("Warning BackupEntryReconcileFailed Failed to reconcile the backup entry: fake")))
+			Expect(notifier.notifications).To(ConsistOf(HaveField("Reason", EventReasonBackupEntryReconcileFailed)))
+		})
+
+		It("should emit an event when a backup entry was deleted", func() {
+			Expect(NewNotifyingActuator(inner, recorder, nil, fakeClock).Delete(ctx, log, backupEntry)).To(Succeed())
+
+			Expect(recorder.Events).To(Receive(Equal(`Normal BackupEntryDeleted Deleted the backups of the backup entry in bucket "bucket"`)))
+		})
+
+		It("should emit a warning event when the deletion failed", func() {
+			inner.err = errors.New("fake")
+
+			Expect(NewNotifyingActuator(inner, recorder, notifier, fakeClock).Delete(ctx, log, backupEntry)).To(MatchError("fake"))
+
+			Expect(recorder.Events).To(Receive(Equal("Warning BackupEntryDeleteFailed Failed to delete the backups of the backup entry: fake")))
+			Expect(notifier.notifications).To(ConsistOf(HaveField("Reason", EventReasonBackupEntryDeleteFailed)))
+		})
+
+		It("should not fail the operation if the notification failed", func() {
+			notifier.err = errors.New("unavailable")
+
+			Expect(NewNotifyingActuator(inner, recorder, notifier, fakeClock).Delete(ctx, log, backupEntry)).To(Succeed())
+			Expect(notifier.notifications).To(HaveLen(1))
+		})
+	})
+
+	Describe("#WebhookNotifier", func() {
+		var (
+			backoff      wait.Backoff
+			notification Notification
+		)
+
+		BeforeEach(func() {
+			backoff = wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+			notification = Notification{Type: "Normal", Reason: EventReasonBackupEntryCreated, BackupEntry: "entry", BucketName: "bucket", Timestamp: fakeClock.Now()}
+		})
+
+		It("should post the notification as JSON", func() {
+			var received Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			DeferCleanup(server.Close)
+
+			Expect(NewWebhookNotifier(server.URL, server.Client(), backoff).Notify(ctx, notification)).To(Succeed())
+			Expect(received).To(Equal(notification))
+		})
+
+		It("should retry server errors", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if requests.Add(1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			DeferCleanup(server.Close)
+
+			Expect(NewWebhookNotifier(server.URL, server.Client(), backoff).Notify(ctx, notification)).To(Succeed())
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("should give up after the last step of the backoff", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			DeferCleanup(server.Close)
+
+			Expect(NewWebhookNotifier(server.URL, server.Client(), backoff).Notify(ctx, notification)).To(MatchError(ContainSubstring("webhook responded with status 429")))
+			Expect(requests.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("should not retry client errors", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusBadRequest)
+			}))
+			DeferCleanup(server.Close)
+
+			Expect(NewWebhookNotifier(server.URL, server.Client(), backoff).Notify(ctx, notification)).To(MatchError(ContainSubstring("webhook responded with status 400")))
+			Expect(requests.Load()).To(BeEquivalentTo(1))
+		})
+	})
+})
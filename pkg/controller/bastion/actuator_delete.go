@@ -32,6 +32,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 	if err != nil {
 		return err
 	}
+	opts.applySecurityGroup(infrastructureStatus)
 
 	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -50,6 +50,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	if err != nil {
 		return err
 	}
+	opts.applySecurityGroup(infrastructureStatus)
 
 	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -171,9 +171,22 @@ var _ = Describe("Bastion test", func() {
 				"Type": ptr.To("gardenctl"),
 			}))
 			Expect(options.SecurityGroupName).To(Equal("cluster1-workers"))
+			Expect(options.SecurityGroupResourceGroupName).To(Equal("cluster1"))
 			Expect(options.MachineType).To(Equal("machineName"))
 			Expect(*options.ImageRef.CommunityGalleryImageID).To(Equal("/CommunityGalleries/gardenlinux-1.2.3"))
 		})
+
+		It("should use the existing security group of the infrastructure status", func() {
+			options, err := NewBaseOpts(bastion, cluster, "cluster1", log)
+			Expect(err).To(Not(HaveOccurred()))
+
+			options.applySecurityGroup(&api.InfrastructureStatus{
+				Networks:       api.NetworkStatus{ResourceGroup: ptr.To("network-rg")},
+				SecurityGroups: []api.SecurityGroup{{Purpose: api.PurposeNodes, Name: "existing-nsg", ResourceGroup: ptr.To("security-rg")}},
+			})
+			Expect(options.SecurityGroupName).To(Equal("existing-nsg"))
+			Expect(options.SecurityGroupResourceGroupName).To(Equal("security-rg"))
+		})
 	})
 
 	Describe("check Names generations", func() {
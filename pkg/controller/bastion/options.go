@@ -36,8 +36,11 @@ type BaseOptions struct {
 	PublicIPName        string
 	NicName             string
 	SecurityGroupName   string
-	SecretReference     corev1.SecretReference
-	Logr                logr.Logger
+	// SecurityGroupResourceGroupName is the resource group of the security group, which differs from the resource
+	// group of the shoot if the network resources are located in a dedicated resource group.
+	SecurityGroupResourceGroupName string
+	SecretReference                corev1.SecretReference
+	Logr                           logr.Logger
 }
 
 // Options contains provider-related information required for setting up
@@ -80,9 +83,22 @@ func NewBaseOpts(bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluste
 		PublicIPName:        publicIPResourceName(baseResourceName),
 		NicName:             NicResourceName(baseResourceName),
 		SecurityGroupName:   NSGName(clusterName),
+
+		SecurityGroupResourceGroupName: resourceGroup,
 	}, nil
 }
 
+// applySecurityGroup points the options to the security group of the nodes, which is located in the network resource
+// group or is an existing security group referenced by the InfrastructureConfig.
+func (o *BaseOptions) applySecurityGroup(infrastructureStatus *azure.InfrastructureStatus) {
+	if infrastructureStatus.Networks.ResourceGroup != nil {
+		o.SecurityGroupResourceGroupName = *infrastructureStatus.Networks.ResourceGroup
+	}
+	if securityGroup, err := helper.FindSecurityGroupByPurpose(infrastructureStatus.SecurityGroups, azure.PurposeNodes); err == nil && securityGroup.ResourceGroup != nil {
+		o.SecurityGroupName, o.SecurityGroupResourceGroupName = securityGroup.Name, *securityGroup.ResourceGroup
+	}
+}
+
 // NewOpts determines the information that is required to reconcile a Bastion.
 func NewOpts(bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, resourceGroup string, log logr.Logger) (Options, error) {
 	baseOpts, err := NewBaseOpts(bastion, cluster, resourceGroup, log)
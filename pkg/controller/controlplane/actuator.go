@@ -17,9 +17,12 @@ import (
 	azurev1alpha1 "github.com/gardener/remedy-controller/pkg/apis/azure/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 const (
@@ -30,6 +33,9 @@ const (
 	GracefulDeletionTimeout = 10 * time.Minute
 )
 
+// DefaultAzureClientFactoryFunc is the default function for creating an Azure client factory. It can be overridden for tests.
+var DefaultAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
+
 // NewActuator creates a new Actuator that acts upon and updates the status of ControlPlane resources.
 func NewActuator(
 	mgr manager.Manager,
@@ -40,6 +46,7 @@ func NewActuator(
 	return &actuator{
 		Actuator:                     a,
 		client:                       mgr.GetClient(),
+		scheme:                       mgr.GetScheme(),
 		gracefulDeletionTimeout:      gracefulDeletionTimeout,
 		gracefulDeletionWaitInterval: gracefulDeletionWaitInterval,
 	}
@@ -49,13 +56,39 @@ func NewActuator(
 type actuator struct {
 	controlplane.Actuator
 	client                       client.Client
+	scheme                       *runtime.Scheme
 	gracefulDeletionTimeout      time.Duration
 	gracefulDeletionWaitInterval time.Duration
 }
 
+// Reconcile reconciles the given controlplane and cluster, creating or updating the additional
+// control plane components as needed.
+// After delegating to the composed Actuator, it ensures the outbound rules configured in the ControlPlaneConfig and the
+// Private Endpoint of a configured private link.
+func (a *actuator) Reconcile(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (bool, error) {
+	// Call Reconcile on the composed Actuator
+	requeue, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+
+	requeueOutboundRules, err := a.reconcileOutboundRules(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+	return requeue || requeueOutboundRules, a.reconcilePrivateEndpoint(ctx, log, cp, cluster)
+}
+
 // Delete reconciles the given controlplane and cluster, deleting the additional
 // control plane components as needed.
-// Before delegating to the composed Actuator, it ensures that all remedy controller resources have been deleted gracefully.
+// Before delegating to the composed Actuator, it ensures that all remedy controller resources have been deleted gracefully
+// and that a chained Gateway Load Balancer is removed from the load balancer of the cloud-controller-manager. A Private
+// Endpoint of the shoot is deleted as well.
 func (a *actuator) Delete(
 	ctx context.Context,
 	log logr.Logger,
@@ -77,6 +110,13 @@ func (a *actuator) Delete(
 		}
 	}
 
+	if err := a.unchainGatewayLoadBalancer(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	if err := a.deletePrivateEndpoint(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+
 	// Call Delete on the composed Actuator
 	if err := a.Actuator.Delete(ctx, log, cp, cluster); err != nil {
 		return err
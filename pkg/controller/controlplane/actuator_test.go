@@ -6,8 +6,12 @@ package controlplane
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
 	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
@@ -21,10 +25,18 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	apisazurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 )
 
 var _ = Describe("Actuator", func() {
@@ -33,6 +45,10 @@ var _ = Describe("Actuator", func() {
 		ctx    = context.TODO()
 		logger = log.Log.WithName("test")
 
+		scheme = runtime.NewScheme()
+		_      = apisazure.AddToScheme(scheme)
+		_      = apisazurev1alpha1.AddToScheme(scheme)
+
 		c        *mockclient.MockClient
 		mgr      *mockmanager.MockManager
 		a        *mockcontrolplane.MockActuator
@@ -81,6 +97,7 @@ var _ = Describe("Actuator", func() {
 		c = mockclient.NewMockClient(ctrl)
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
+		mgr.EXPECT().GetScheme().Return(scheme)
 
 		a = mockcontrolplane.NewMockActuator(ctrl)
 		gracefulDeletionTimeout = 10 * time.Second
@@ -92,6 +109,498 @@ var _ = Describe("Actuator", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#Reconcile", func() {
+		const (
+			resourceGroup = "rg"
+			lbID          = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/" + namespace
+			poolID        = lbID + "/backendAddressPools/" + namespace
+		)
+
+		var (
+			defaultFactory = DefaultAzureClientFactoryFunc
+
+			factory  *mockazureclient.MockFactory
+			lbClient *mockazureclient.MockLoadBalancer
+			ipClient *mockazureclient.MockPublicIP
+
+			newOutboundControlPlane = func() *extensionsv1alpha1.ControlPlane {
+				cp := newControlPlane()
+				cp.Spec.Region = "westeurope"
+				cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancer":{"outboundRules":{"frontendIPCount":1,"tcp":{"allocatedOutboundPorts":1024}}}}`)}
+				cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","resourceGroup":{"name":"` + resourceGroup + `"}}`)}
+				return cp
+			}
+			newLoadBalancer = func() *armnetwork.LoadBalancer {
+				return &armnetwork.LoadBalancer{
+					ID: ptr.To(lbID),
+					Properties: &armnetwork.LoadBalancerPropertiesFormat{
+						FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{{Name: ptr.To("service-frontend")}},
+						BackendAddressPools:      []*armnetwork.BackendAddressPool{{Name: ptr.To(namespace), ID: ptr.To(poolID)}},
+					},
+				}
+			}
+			outboundIP = &armnetwork.PublicIPAddress{Name: ptr.To(namespace + "-outbound-0"), ID: ptr.To("ip-0")}
+
+			sw                  *mockclient.MockStatusWriter
+			outboundRulesStatus = &apisazurev1alpha1.OutboundRulesStatus{
+				Names:                []string{namespace + "-outbound-tcp"},
+				PublicIPAddressNames: []string{namespace + "-outbound-0"},
+			}
+			setProviderStatus = func(cp *extensionsv1alpha1.ControlPlane, status *apisazurev1alpha1.ControlPlaneStatus) {
+				status.TypeMeta = metav1.TypeMeta{APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"}
+				raw, err := json.Marshal(status)
+				Expect(err).NotTo(HaveOccurred())
+				cp.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+			}
+			expectProviderStatus = func(expected *apisazurev1alpha1.ControlPlaneStatus) {
+				c.EXPECT().Status().Return(sw)
+				sw.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					cp := obj.(*extensionsv1alpha1.ControlPlane)
+					status, ok := cp.Status.ProviderStatus.Object.(*apisazurev1alpha1.ControlPlaneStatus)
+					Expect(ok).To(BeTrue())
+					expected.TypeMeta = status.TypeMeta
+					Expect(status).To(Equal(expected))
+					// the API server responds with the patched status
+					setProviderStatus(cp, status)
+					return nil
+				})
+			}
+		)
+
+		BeforeEach(func() {
+			factory = mockazureclient.NewMockFactory(ctrl)
+			lbClient = mockazureclient.NewMockLoadBalancer(ctrl)
+			ipClient = mockazureclient.NewMockPublicIP(ctrl)
+			sw = mockclient.NewMockStatusWriter(ctrl)
+			DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, _ ...azclient.AzureFactoryOption) (azclient.Factory, error) {
+				return factory, nil
+			}
+		})
+
+		AfterEach(func() {
+			DefaultAzureClientFactoryFunc = defaultFactory
+		})
+
+		It("should not touch the load balancer if no outbound rules are configured", func() {
+			cp := newControlPlane()
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+
+			requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
+		})
+
+		Context("outbound rules which are no longer configured", func() {
+			var (
+				cp *extensionsv1alpha1.ControlPlane
+				lb *armnetwork.LoadBalancer
+			)
+
+			BeforeEach(func() {
+				cp = newOutboundControlPlane()
+				cp.Spec.ProviderConfig = nil
+				setProviderStatus(cp, &apisazurev1alpha1.ControlPlaneStatus{
+					GatewayLoadBalancer: &apisazurev1alpha1.GatewayLoadBalancerStatus{FrontendIPConfigurationID: "gwlb-frontend"},
+					OutboundRules:       outboundRulesStatus,
+				})
+
+				lb = newLoadBalancer()
+				lb.Properties.FrontendIPConfigurations = append(lb.Properties.FrontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+					Name: ptr.To(namespace + "-outbound-0"),
+					Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+						PublicIPAddress:     &armnetwork.PublicIPAddress{ID: ptr.To("ip-0")},
+						GatewayLoadBalancer: &armnetwork.SubResource{ID: ptr.To("gwlb-frontend")},
+					},
+				})
+				lb.Properties.OutboundRules = []*armnetwork.OutboundRule{{Name: ptr.To(namespace + "-outbound-tcp")}}
+				lb.Properties.LoadBalancingRules = []*armnetwork.LoadBalancingRule{{
+					Name:       ptr.To("service-rule"),
+					Properties: &armnetwork.LoadBalancingRulePropertiesFormat{DisableOutboundSnat: ptr.To(false)},
+				}}
+
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+				factory.EXPECT().PublicIP().Return(ipClient, nil)
+				lbClient.EXPECT().Get(ctx, resourceGroup, namespace).DoAndReturn(func(_ context.Context, _, _ string) (*armnetwork.LoadBalancer, error) {
+					return lb, nil
+				})
+			})
+
+			It("should remove the outbound rules, their frontends and public IPs", func() {
+				lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+						Expect(lb.Properties.FrontendIPConfigurations).To(ConsistOf(HaveField("Name", ptr.To("service-frontend"))))
+						Expect(lb.Properties.OutboundRules).To(BeEmpty())
+						Expect(lb.Properties.LoadBalancingRules).To(HaveLen(1))
+						return &lb, nil
+					})
+				ipClient.EXPECT().List(ctx, resourceGroup).Return([]*armnetwork.PublicIPAddress{outboundIP, {Name: ptr.To("service-ip")}}, nil)
+				ipClient.EXPECT().Delete(ctx, resourceGroup, namespace+"-outbound-0").Return(nil)
+				expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{})
+
+				requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeue).To(BeFalse())
+			})
+
+			It("should keep the outbound rules until the cloud-controller-manager enabled outbound SNAT again", func() {
+				lb.Properties.LoadBalancingRules[0].Properties.DisableOutboundSnat = ptr.To(true)
+
+				requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeue).To(BeTrue())
+			})
+
+			It("should not overwrite the changes of the cloud-controller-manager", func() {
+				lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).Return(nil, &azcore.ResponseError{StatusCode: http.StatusPreconditionFailed})
+
+				requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requeue).To(BeTrue())
+			})
+		})
+
+		It("should skip the outbound rules if the load balancer does not exist yet", func() {
+			cp := newOutboundControlPlane()
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, resourceGroup, namespace).Return(nil, nil)
+
+			_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should enable outbound SNAT again if the cloud-controller-manager deleted the load balancer", func() {
+			cp := newOutboundControlPlane()
+			setProviderStatus(cp, &apisazurev1alpha1.ControlPlaneStatus{OutboundRules: outboundRulesStatus})
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, resourceGroup, namespace).Return(nil, nil)
+			expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{})
+
+			requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeTrue())
+		})
+
+		It("should add the outbound frontend and rule to the load balancer and delete unused public IPs", func() {
+			cp := newOutboundControlPlane()
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, resourceGroup, namespace).Return(newLoadBalancer(), nil)
+			ipClient.EXPECT().Get(ctx, resourceGroup, namespace+"-outbound-0", nil).Return(nil, nil)
+			ipClient.EXPECT().CreateOrUpdate(ctx, resourceGroup, namespace+"-outbound-0", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, ip armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+					Expect(ip.SKU.Name).To(Equal(ptr.To(armnetwork.PublicIPAddressSKUNameStandard)))
+					Expect(ip.Properties.PublicIPAllocationMethod).To(Equal(ptr.To(armnetwork.IPAllocationMethodStatic)))
+					return outboundIP, nil
+				})
+			lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+					Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+					Expect(lb.Properties.FrontendIPConfigurations[1].Properties.PublicIPAddress.ID).To(Equal(ptr.To("ip-0")))
+					Expect(lb.Properties.OutboundRules).To(HaveLen(1))
+					rule := lb.Properties.OutboundRules[0]
+					Expect(rule.Name).To(Equal(ptr.To(namespace + "-outbound-tcp")))
+					Expect(rule.Properties.Protocol).To(Equal(ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolTCP)))
+					Expect(rule.Properties.AllocatedOutboundPorts).To(Equal(ptr.To(int32(1024))))
+					Expect(rule.Properties.IdleTimeoutInMinutes).To(Equal(ptr.To(int32(4))))
+					Expect(rule.Properties.BackendAddressPool.ID).To(Equal(ptr.To(poolID)))
+					Expect(rule.Properties.FrontendIPConfigurations).To(ConsistOf(&armnetwork.SubResource{ID: ptr.To(lbID + "/frontendIPConfigurations/" + namespace + "-outbound-0")}))
+					return &lb, nil
+				})
+			ipClient.EXPECT().List(ctx, resourceGroup).Return([]*armnetwork.PublicIPAddress{
+				outboundIP,
+				{Name: ptr.To(namespace + "-outbound-1")},
+				{Name: ptr.To("service-ip")},
+			}, nil)
+			ipClient.EXPECT().Delete(ctx, resourceGroup, namespace+"-outbound-1").Return(nil)
+			expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{OutboundRules: outboundRulesStatus})
+
+			requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeTrue(), "the configuration of the cloud-controller-manager must be deployed again to disable outbound SNAT")
+		})
+
+		It("should requeue instead of overwriting the changes of the cloud-controller-manager", func() {
+			cp := newOutboundControlPlane()
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, resourceGroup, namespace).Return(newLoadBalancer(), nil)
+			ipClient.EXPECT().Get(ctx, resourceGroup, namespace+"-outbound-0", nil).Return(outboundIP, nil)
+			lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).Return(nil, &azcore.ResponseError{StatusCode: http.StatusPreconditionFailed})
+
+			requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeTrue())
+		})
+
+		It("should not update the load balancer if the outbound rules are up to date", func() {
+			cp := newOutboundControlPlane()
+			setProviderStatus(cp, &apisazurev1alpha1.ControlPlaneStatus{OutboundRules: outboundRulesStatus})
+			lb := newLoadBalancer()
+			lb.Properties.FrontendIPConfigurations = append(lb.Properties.FrontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+				Name:       ptr.To(namespace + "-outbound-0"),
+				Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{PublicIPAddress: &armnetwork.PublicIPAddress{ID: ptr.To("ip-0")}},
+			})
+			lb.Properties.OutboundRules = []*armnetwork.OutboundRule{{
+				Name: ptr.To(namespace + "-outbound-tcp"),
+				Properties: &armnetwork.OutboundRulePropertiesFormat{
+					Protocol:                 ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolTCP),
+					AllocatedOutboundPorts:   ptr.To[int32](1024),
+					IdleTimeoutInMinutes:     ptr.To[int32](4),
+					FrontendIPConfigurations: []*armnetwork.SubResource{{ID: ptr.To(lbID + "/frontendIPConfigurations/" + namespace + "-outbound-0")}},
+					BackendAddressPool:       &armnetwork.SubResource{ID: ptr.To(poolID)},
+				},
+			}}
+
+			a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, resourceGroup, namespace).Return(lb, nil)
+			ipClient.EXPECT().Get(ctx, resourceGroup, namespace+"-outbound-0", nil).Return(outboundIP, nil)
+			ipClient.EXPECT().List(ctx, resourceGroup).Return([]*armnetwork.PublicIPAddress{outboundIP}, nil)
+
+			requeue, err := actuator.Reconcile(ctx, logger, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
+		})
+
+		Context("gateway load balancer", func() {
+			const (
+				gatewayLBID = "/subscriptions/sub/resourceGroups/nva/providers/Microsoft.Network/loadBalancers/gwlb"
+				frontendID  = gatewayLBID + "/frontendIPConfigurations/frontend"
+			)
+
+			var (
+				newGatewayControlPlane = func() *extensionsv1alpha1.ControlPlane {
+					cp := newOutboundControlPlane()
+					cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancer":{"outboundRules":{"frontendIPCount":1,"tcp":{"allocatedOutboundPorts":1024}},"gatewayLoadBalancer":{"frontendIPConfigurationID":"` + frontendID + `"}}}`)}
+					return cp
+				}
+				gatewayLB = func() *armnetwork.LoadBalancer {
+					return &armnetwork.LoadBalancer{
+						ID:  ptr.To(gatewayLBID),
+						SKU: &armnetwork.LoadBalancerSKU{Name: ptr.To(armnetwork.LoadBalancerSKUNameGateway)},
+						Properties: &armnetwork.LoadBalancerPropertiesFormat{
+							FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{{Name: ptr.To("frontend"), ID: ptr.To(frontendID)}},
+						},
+					}
+				}
+			)
+
+			var shootLB *armnetwork.LoadBalancer
+
+			BeforeEach(func() {
+				shootLB = newLoadBalancer()
+				factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+				factory.EXPECT().PublicIP().Return(ipClient, nil)
+				lbClient.EXPECT().Get(ctx, resourceGroup, namespace).DoAndReturn(func(_ context.Context, _, _ string) (*armnetwork.LoadBalancer, error) {
+					return shootLB, nil
+				})
+			})
+
+			It("should chain the gateway load balancer to the outbound frontend", func() {
+				cp := newGatewayControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				lbClient.EXPECT().Get(ctx, "nva", "gwlb").Return(gatewayLB(), nil)
+				expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{GatewayLoadBalancer: &apisazurev1alpha1.GatewayLoadBalancerStatus{FrontendIPConfigurationID: frontendID}})
+				ipClient.EXPECT().Get(ctx, resourceGroup, namespace+"-outbound-0", nil).Return(outboundIP, nil)
+				lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+						Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+						Expect(lb.Properties.FrontendIPConfigurations[0].Properties).To(BeNil())
+						Expect(lb.Properties.FrontendIPConfigurations[1].Properties.GatewayLoadBalancer).To(Equal(&armnetwork.SubResource{ID: ptr.To(frontendID)}))
+						return &lb, nil
+					})
+				ipClient.EXPECT().List(ctx, resourceGroup).Return([]*armnetwork.PublicIPAddress{outboundIP}, nil)
+				expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{
+					GatewayLoadBalancer: &apisazurev1alpha1.GatewayLoadBalancerStatus{FrontendIPConfigurationID: frontendID},
+					OutboundRules:       outboundRulesStatus,
+				})
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail if the gateway load balancer does not exist", func() {
+				cp := newGatewayControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				lbClient.EXPECT().Get(ctx, "nva", "gwlb").Return(nil, nil)
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).To(MatchError(ContainSubstring("does not exist")))
+			})
+
+			It("should fail if the load balancer is not a gateway load balancer", func() {
+				cp := newGatewayControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				lb := gatewayLB()
+				lb.SKU.Name = ptr.To(armnetwork.LoadBalancerSKUNameStandard)
+				lbClient.EXPECT().Get(ctx, "nva", "gwlb").Return(lb, nil)
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).To(MatchError(ContainSubstring("is not a gateway load balancer")))
+			})
+
+			It("should fail if the frontend IP configuration does not exist", func() {
+				cp := newGatewayControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				lb := gatewayLB()
+				lb.Properties.FrontendIPConfigurations = nil
+				lbClient.EXPECT().Get(ctx, "nva", "gwlb").Return(lb, nil)
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).To(MatchError(ContainSubstring("has no frontend IP configuration frontend")))
+			})
+
+			It("should remove the chaining if the gateway load balancer is no longer configured", func() {
+				cp := newOutboundControlPlane()
+				setProviderStatus(cp, &apisazurev1alpha1.ControlPlaneStatus{
+					GatewayLoadBalancer: &apisazurev1alpha1.GatewayLoadBalancerStatus{FrontendIPConfigurationID: frontendID},
+					OutboundRules:       outboundRulesStatus,
+				})
+				shootLB.Properties.FrontendIPConfigurations = append(shootLB.Properties.FrontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+					Name: ptr.To(namespace + "-outbound-0"),
+					Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+						PublicIPAddress:     &armnetwork.PublicIPAddress{ID: ptr.To("ip-0")},
+						GatewayLoadBalancer: &armnetwork.SubResource{ID: ptr.To(frontendID)},
+					},
+				})
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				ipClient.EXPECT().Get(ctx, resourceGroup, namespace+"-outbound-0", nil).Return(outboundIP, nil)
+				lbClient.EXPECT().UpdateIfUnchanged(ctx, resourceGroup, namespace, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+						Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+						Expect(lb.Properties.FrontendIPConfigurations[1].Properties.GatewayLoadBalancer).To(BeNil())
+						return &lb, nil
+					})
+				expectProviderStatus(&apisazurev1alpha1.ControlPlaneStatus{OutboundRules: outboundRulesStatus})
+				ipClient.EXPECT().List(ctx, resourceGroup).Return([]*armnetwork.PublicIPAddress{outboundIP}, nil)
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("private link", func() {
+			const (
+				endpointSubnetID = "/subscriptions/sub/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed/subnets/private-endpoints"
+				plsID            = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateLinkServices/" + namespace + "-connectivity"
+				peID             = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/" + namespace + "-connectivity"
+			)
+
+			var (
+				plsClient *mockazureclient.MockPrivateLinkService
+				peClient  *mockazureclient.MockPrivateEndpoint
+				nicClient *mockazureclient.MockNetworkInterface
+				sw        *mockclient.MockStatusWriter
+
+				privateLinkCluster         *extensionscontroller.Cluster
+				newPrivateLinkControlPlane = func() *extensionsv1alpha1.ControlPlane {
+					cp := newControlPlane()
+					cp.Spec.Region = "westeurope"
+					cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","resourceGroup":{"name":"` + resourceGroup + `"},"networks":{"subnets":[{"name":"` + namespace + `-private-link","purpose":"privateLink"}]}}`)}
+					return cp
+				}
+				expectStatus = func(expected *apisazurev1alpha1.PrivateLinkStatus) {
+					c.EXPECT().Status().Return(sw)
+					sw.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						status, ok := obj.(*extensionsv1alpha1.ControlPlane).Status.ProviderStatus.Object.(*apisazurev1alpha1.ControlPlaneStatus)
+						Expect(ok).To(BeTrue())
+						Expect(status.PrivateLink).To(Equal(expected))
+						return nil
+					})
+				}
+			)
+
+			BeforeEach(func() {
+				plsClient = mockazureclient.NewMockPrivateLinkService(ctrl)
+				peClient = mockazureclient.NewMockPrivateEndpoint(ctrl)
+				nicClient = mockazureclient.NewMockNetworkInterface(ctrl)
+				sw = mockclient.NewMockStatusWriter(ctrl)
+
+				privateLinkCluster = &extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								InfrastructureConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/19","privateLink":{"cidr":"10.250.32.0/24","endpointSubnetID":"` + endpointSubnetID + `"}}}`)},
+							},
+						},
+					},
+				}
+			})
+
+			It("should skip the private endpoint if the private link service does not exist yet", func() {
+				cp := newPrivateLinkControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, privateLinkCluster).Return(false, nil)
+				factory.EXPECT().PrivateLinkService().Return(plsClient, nil)
+				factory.EXPECT().PrivateEndpoint().Return(peClient, nil)
+				factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+				plsClient.EXPECT().Get(ctx, resourceGroup, namespace+"-connectivity").Return(nil, nil)
+				expectStatus(&apisazurev1alpha1.PrivateLinkStatus{
+					ServiceName: namespace + "-connectivity",
+					SubnetName:  namespace + "-private-link",
+				})
+
+				_, err := actuator.Reconcile(ctx, logger, cp, privateLinkCluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should create the private endpoint and report it in the status", func() {
+				cp := newPrivateLinkControlPlane()
+				a.EXPECT().Reconcile(ctx, logger, cp, privateLinkCluster).Return(false, nil)
+				factory.EXPECT().PrivateLinkService().Return(plsClient, nil)
+				factory.EXPECT().PrivateEndpoint().Return(peClient, nil)
+				factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+				plsClient.EXPECT().Get(ctx, resourceGroup, namespace+"-connectivity").Return(&armnetwork.PrivateLinkService{ID: ptr.To(plsID)}, nil)
+				peClient.EXPECT().Get(ctx, resourceGroup, namespace+"-connectivity").Return(nil, nil)
+				peClient.EXPECT().CreateOrUpdate(ctx, resourceGroup, namespace+"-connectivity", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, pe armnetwork.PrivateEndpoint) (*armnetwork.PrivateEndpoint, error) {
+						Expect(pe.Location).To(Equal(ptr.To("westeurope")))
+						Expect(pe.Properties.Subnet.ID).To(Equal(ptr.To(endpointSubnetID)))
+						Expect(pe.Properties.PrivateLinkServiceConnections).To(HaveLen(1))
+						Expect(pe.Properties.PrivateLinkServiceConnections[0].Properties.PrivateLinkServiceID).To(Equal(ptr.To(plsID)))
+
+						pe.ID = ptr.To(peID)
+						pe.Properties.PrivateLinkServiceConnections[0].Properties.PrivateLinkServiceConnectionState = &armnetwork.PrivateLinkServiceConnectionState{Status: ptr.To("Approved")}
+						return &pe, nil
+					})
+				nicClient.EXPECT().Get(ctx, resourceGroup, namespace+"-connectivity-nic").Return(&armnetwork.Interface{
+					Properties: &armnetwork.InterfacePropertiesFormat{
+						IPConfigurations: []*armnetwork.InterfaceIPConfiguration{{Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{PrivateIPAddress: ptr.To("10.0.1.4")}}},
+					},
+				}, nil)
+				expectStatus(&apisazurev1alpha1.PrivateLinkStatus{
+					ServiceName:         namespace + "-connectivity",
+					SubnetName:          namespace + "-private-link",
+					EndpointID:          ptr.To(peID),
+					EndpointIPAddresses: []string{"10.0.1.4"},
+					ConnectionState:     ptr.To("Approved"),
+				})
+
+				_, err := actuator.Reconcile(ctx, logger, cp, privateLinkCluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should delete the private endpoint if the private link is no longer configured", func() {
+				cp := newPrivateLinkControlPlane()
+				cp.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneStatus","privateLink":{"serviceName":"` + namespace + `-connectivity","subnetName":"` + namespace + `-private-link","endpointID":"` + peID + `"}}`)}
+				a.EXPECT().Reconcile(ctx, logger, cp, cluster).Return(false, nil)
+				factory.EXPECT().PrivateEndpoint().Return(peClient, nil)
+				peClient.EXPECT().Delete(ctx, resourceGroup, namespace+"-connectivity").Return(nil)
+				expectStatus(nil)
+
+				_, err := actuator.Reconcile(ctx, logger, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("#Delete", func() {
 		It("should successfully delete controlplane if there are no remedy controller resources", func() {
 			cp := newControlPlane()
@@ -117,6 +626,47 @@ var _ = Describe("Actuator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should remove a chained gateway load balancer from the load balancer", func() {
+			cp := newControlPlane()
+			time := metav1.Now()
+			cp.DeletionTimestamp = &time
+			cp.Spec.Region = "westeurope"
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancer":{"gatewayLoadBalancer":{"frontendIPConfigurationID":"gwlb-frontend"}}}`)}
+			cp.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","resourceGroup":{"name":"rg"}}`)}
+
+			factory := mockazureclient.NewMockFactory(ctrl)
+			lbClient := mockazureclient.NewMockLoadBalancer(ctrl)
+			defaultFactory := DefaultAzureClientFactoryFunc
+			DeferCleanup(func() { DefaultAzureClientFactoryFunc = defaultFactory })
+			DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, _ ...azclient.AzureFactoryOption) (azclient.Factory, error) {
+				return factory, nil
+			}
+
+			c.EXPECT().List(gomock.Any(), &azurev1alpha1.PublicIPAddressList{}, client.InNamespace(namespace)).Return(nil).Times(2)
+			c.EXPECT().List(ctx, &azurev1alpha1.VirtualMachineList{}, client.InNamespace(namespace)).Return(nil)
+			c.EXPECT().DeleteAllOf(ctx, &azurev1alpha1.PublicIPAddress{}, client.InNamespace(namespace)).Return(nil)
+			c.EXPECT().DeleteAllOf(ctx, &azurev1alpha1.VirtualMachine{}, client.InNamespace(namespace)).Return(nil)
+
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			lbClient.EXPECT().Get(ctx, "rg", namespace).Return(&armnetwork.LoadBalancer{
+				Properties: &armnetwork.LoadBalancerPropertiesFormat{
+					FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{
+						{Name: ptr.To("service-frontend"), Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{}},
+						{Name: ptr.To(namespace + "-outbound-0"), Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{GatewayLoadBalancer: &armnetwork.SubResource{ID: ptr.To("gwlb-frontend")}}},
+					},
+				},
+			}, nil)
+			lbClient.EXPECT().UpdateIfUnchanged(ctx, "rg", namespace, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+					Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+					Expect(lb.Properties.FrontendIPConfigurations[1].Properties.GatewayLoadBalancer).To(BeNil())
+					return &lb, nil
+				})
+
+			a.EXPECT().Delete(ctx, logger, cp, cluster).Return(nil)
+			Expect(actuator.Delete(ctx, logger, cp, cluster)).To(Succeed())
+		})
+
 		It("should return RequeueAfterError if there are publicipaddresses remaining and timeout is not yet reached", func() {
 			cp := newControlPlane()
 			time := metav1.Now()
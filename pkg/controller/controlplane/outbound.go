@@ -0,0 +1,501 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// outboundPrefix returns the name prefix of the frontend IP configurations, public IP addresses and outbound rules
+// managed by the extension on the load balancer of the cloud-controller-manager.
+func outboundPrefix(cp *extensionsv1alpha1.ControlPlane) string {
+	return cp.Namespace + "-outbound-"
+}
+
+// reconcileOutboundRules ensures the outbound rules configured in the ControlPlaneConfig on the load balancer that is
+// managed by the cloud-controller-manager. The load balancer is named after the cluster and is only created by the
+// cloud-controller-manager once the first service of type LoadBalancer exists. The load balancer is only updated if the
+// cloud-controller-manager did not change it concurrently, otherwise the reconciliation is requeued. The created outbound
+// rules are recorded in the provider status, which disables the outbound SNAT of the load balancing rules of the
+// cloud-controller-manager with the next deployment of its configuration, hence the reconciliation is requeued once
+// they are recorded. Outbound rules which are no longer configured are removed.
+func (a *actuator) reconcileOutboundRules(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	cpConfig, err := azureapihelper.ControlPlaneConfigFromControlPlane(cp)
+	if err != nil {
+		return false, fmt.Errorf("could not decode providerConfig of controlplane: %w", err)
+	}
+	if cpConfig.LoadBalancer == nil || cpConfig.LoadBalancer.OutboundRules == nil {
+		return a.removeOutboundRules(ctx, log, cp, cluster)
+	}
+
+	infraStatus, err := azureapihelper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return false, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane: %w", err)
+	}
+
+	factory, err := a.newAzureClientFactory(ctx, cp, cluster)
+	if err != nil {
+		return false, err
+	}
+	lbClient, err := factory.LoadBalancer()
+	if err != nil {
+		return false, err
+	}
+	ipClient, err := factory.PublicIP()
+	if err != nil {
+		return false, err
+	}
+
+	resourceGroup := infraStatus.ResourceGroup.Name
+	lb, err := lbClient.Get(ctx, resourceGroup, cp.Namespace)
+	if err != nil {
+		return false, err
+	}
+	var backendPool *armnetwork.BackendAddressPool
+	if lb != nil && lb.Properties != nil {
+		for _, pool := range lb.Properties.BackendAddressPools {
+			if ptr.Deref(pool.Name, "") == cp.Namespace {
+				backendPool = pool
+				break
+			}
+		}
+	}
+	if backendPool == nil {
+		log.Info("Load balancer of the cloud-controller-manager does not exist yet, skipping outbound rules", "name", cp.Namespace)
+		// the cloud-controller-manager deletes the load balancer together with the outbound rules once the last service of
+		// type LoadBalancer is deleted, hence outbound SNAT must not stay disabled for the recreated load balancer.
+		return a.updateOutboundRulesStatus(ctx, cp, nil)
+	}
+
+	var gatewayLoadBalancer *armnetwork.SubResource
+	if cpConfig.LoadBalancer.GatewayLoadBalancer != nil {
+		if gatewayLoadBalancer, err = verifyGatewayLoadBalancer(ctx, lbClient, lb, cpConfig.LoadBalancer.GatewayLoadBalancer.FrontendIPConfigurationID); err != nil {
+			return false, err
+		}
+		// the chaining is recorded before the load balancer is updated, so that it is removed even if the update fails
+		// after Azure applied it.
+		if err := a.updateGatewayLoadBalancerStatus(ctx, cp, &apisazure.GatewayLoadBalancerStatus{FrontendIPConfigurationID: *gatewayLoadBalancer.ID}); err != nil {
+			return false, err
+		}
+	}
+
+	var (
+		prefix          = outboundPrefix(cp)
+		frontendIPCount = int(ptr.Deref(cpConfig.LoadBalancer.OutboundRules.FrontendIPCount, 1))
+		frontends       []*armnetwork.FrontendIPConfiguration
+		frontendRefs    []*armnetwork.SubResource
+		publicIPNames   = make([]string, 0, frontendIPCount)
+	)
+	for i := range frontendIPCount {
+		name := fmt.Sprintf("%s%d", prefix, i)
+		ip, err := ensureOutboundPublicIP(ctx, ipClient, resourceGroup, cp.Spec.Region, name)
+		if err != nil {
+			return false, err
+		}
+		publicIPNames = append(publicIPNames, name)
+		frontends = append(frontends, &armnetwork.FrontendIPConfiguration{
+			Name: to.Ptr(name),
+			Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress:     &armnetwork.PublicIPAddress{ID: ip.ID},
+				GatewayLoadBalancer: gatewayLoadBalancer,
+			},
+		})
+		frontendRefs = append(frontendRefs, &armnetwork.SubResource{ID: to.Ptr(fmt.Sprintf("%s/frontendIPConfigurations/%s", *lb.ID, name))})
+	}
+
+	var rules []*armnetwork.OutboundRule
+	for protocol, allocation := range map[armnetwork.LoadBalancerOutboundRuleProtocol]*apisazure.OutboundRuleAllocation{
+		armnetwork.LoadBalancerOutboundRuleProtocolTCP: cpConfig.LoadBalancer.OutboundRules.TCP,
+		armnetwork.LoadBalancerOutboundRuleProtocolUDP: cpConfig.LoadBalancer.OutboundRules.UDP,
+	} {
+		if allocation == nil {
+			continue
+		}
+		rule := &armnetwork.OutboundRule{
+			Name: to.Ptr(prefix + strings.ToLower(string(protocol))),
+			Properties: &armnetwork.OutboundRulePropertiesFormat{
+				Protocol:                 to.Ptr(protocol),
+				AllocatedOutboundPorts:   to.Ptr(allocation.AllocatedOutboundPorts),
+				IdleTimeoutInMinutes:     allocation.IdleTimeoutMinutes,
+				FrontendIPConfigurations: frontendRefs,
+				BackendAddressPool:       &armnetwork.SubResource{ID: backendPool.ID},
+			},
+		}
+		if protocol == armnetwork.LoadBalancerOutboundRuleProtocolTCP {
+			rule.Properties.EnableTCPReset = to.Ptr(true)
+		}
+		rules = append(rules, rule)
+	}
+	slices.SortFunc(rules, func(a, b *armnetwork.OutboundRule) int { return strings.Compare(*a.Name, *b.Name) })
+
+	if !outboundRulesUpToDate(lb, prefix, frontends, rules) {
+		lb.Properties.FrontendIPConfigurations = append(slices.DeleteFunc(lb.Properties.FrontendIPConfigurations, func(f *armnetwork.FrontendIPConfiguration) bool {
+			return strings.HasPrefix(ptr.Deref(f.Name, ""), prefix)
+		}), frontends...)
+		lb.Properties.OutboundRules = append(slices.DeleteFunc(lb.Properties.OutboundRules, func(r *armnetwork.OutboundRule) bool {
+			return strings.HasPrefix(ptr.Deref(r.Name, ""), prefix)
+		}), rules...)
+
+		log.Info("Updating outbound rules of load balancer", "name", cp.Namespace)
+		if updated, err := updateLoadBalancerIfUnchanged(ctx, log, lbClient, resourceGroup, cp.Namespace, lb); err != nil || !updated {
+			return !updated, err
+		}
+	}
+	if gatewayLoadBalancer == nil {
+		if err := a.updateGatewayLoadBalancerStatus(ctx, cp, nil); err != nil {
+			return false, err
+		}
+	}
+
+	// remove public IP addresses which are no longer used after the number of frontend IPs was decreased.
+	if err := deleteOutboundPublicIPs(ctx, log, ipClient, resourceGroup, prefix, publicIPNames); err != nil {
+		return false, err
+	}
+
+	ruleNames := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleNames = append(ruleNames, *rule.Name)
+	}
+	return a.updateOutboundRulesStatus(ctx, cp, &apisazure.OutboundRulesStatus{Names: ruleNames, PublicIPAddressNames: publicIPNames})
+}
+
+// removeOutboundRules removes the outbound rules and their frontend IP configurations and public IP addresses, which are
+// recorded in the provider status, once they are no longer configured in the ControlPlaneConfig. The configuration of
+// the cloud-controller-manager already enables outbound SNAT again for its load balancing rules, but the outbound rules
+// are only removed once the cloud-controller-manager applied it to the load balancer, so that the nodes keep their
+// egress connectivity. Until then, the reconciliation is requeued.
+func (a *actuator) removeOutboundRules(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return false, fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	if (status.OutboundRules == nil && status.GatewayLoadBalancer == nil) || cp.Spec.InfrastructureProviderStatus == nil {
+		return false, nil
+	}
+
+	infraStatus, err := azureapihelper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return false, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane: %w", err)
+	}
+
+	factory, err := a.newAzureClientFactory(ctx, cp, cluster)
+	if err != nil {
+		return false, err
+	}
+	lbClient, err := factory.LoadBalancer()
+	if err != nil {
+		return false, err
+	}
+	ipClient, err := factory.PublicIP()
+	if err != nil {
+		return false, err
+	}
+
+	resourceGroup, prefix := infraStatus.ResourceGroup.Name, outboundPrefix(cp)
+	lb, err := lbClient.Get(ctx, resourceGroup, cp.Namespace)
+	if err != nil {
+		return false, err
+	}
+	if lb != nil && lb.Properties != nil {
+		for _, rule := range lb.Properties.LoadBalancingRules {
+			if rule.Properties != nil && ptr.Deref(rule.Properties.DisableOutboundSnat, false) {
+				log.Info("Cloud-controller-manager did not enable outbound SNAT for its load balancing rules yet, keeping outbound rules", "name", cp.Namespace)
+				return true, nil
+			}
+		}
+
+		frontends := slices.DeleteFunc(slices.Clone(lb.Properties.FrontendIPConfigurations), func(f *armnetwork.FrontendIPConfiguration) bool {
+			return strings.HasPrefix(ptr.Deref(f.Name, ""), prefix)
+		})
+		rules := slices.DeleteFunc(slices.Clone(lb.Properties.OutboundRules), func(r *armnetwork.OutboundRule) bool {
+			return strings.HasPrefix(ptr.Deref(r.Name, ""), prefix)
+		})
+		if len(frontends) != len(lb.Properties.FrontendIPConfigurations) || len(rules) != len(lb.Properties.OutboundRules) {
+			lb.Properties.FrontendIPConfigurations, lb.Properties.OutboundRules = frontends, rules
+
+			log.Info("Removing outbound rules from load balancer", "name", cp.Namespace)
+			if updated, err := updateLoadBalancerIfUnchanged(ctx, log, lbClient, resourceGroup, cp.Namespace, lb); err != nil || !updated {
+				return !updated, err
+			}
+		}
+	}
+
+	if err := deleteOutboundPublicIPs(ctx, log, ipClient, resourceGroup, prefix, nil); err != nil {
+		return false, err
+	}
+
+	status.OutboundRules, status.GatewayLoadBalancer = nil, nil
+	return false, a.patchControlPlaneStatus(ctx, cp, status)
+}
+
+// updateLoadBalancerIfUnchanged updates the given load balancer of the cloud-controller-manager unless it was changed
+// since it was read. It returns false if the load balancer was changed concurrently, so that the update is retried with
+// the next reconciliation instead of overwriting the changes of the cloud-controller-manager.
+func updateLoadBalancerIfUnchanged(ctx context.Context, log logr.Logger, lbClient azureclient.LoadBalancer, resourceGroup, name string, lb *armnetwork.LoadBalancer) (bool, error) {
+	if _, err := lbClient.UpdateIfUnchanged(ctx, resourceGroup, name, *lb); err != nil {
+		if azureclient.IsAzureAPIPreconditionFailed(err) {
+			log.Info("Load balancer was changed concurrently, retrying the update of its outbound rules", "name", name)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update outbound rules of load balancer %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// deleteOutboundPublicIPs deletes the outbound public IP addresses with the given prefix except for the given ones.
+func deleteOutboundPublicIPs(ctx context.Context, log logr.Logger, ipClient azureclient.PublicIP, resourceGroup, prefix string, keep []string) error {
+	ips, err := ipClient.List(ctx, resourceGroup)
+	if err != nil {
+		return err
+	}
+	for _, ip := range ips {
+		name := ptr.Deref(ip.Name, "")
+		if !strings.HasPrefix(name, prefix) || slices.Contains(keep, name) {
+			continue
+		}
+		log.Info("Deleting unused outbound public IP address", "name", name)
+		if err := ipClient.Delete(ctx, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateOutboundRulesStatus reports the given outbound rules status in the provider status of the ControlPlane, unless
+// it is already up-to-date. It returns true if the outbound rules were created or removed, since the configuration of the
+// cloud-controller-manager must be deployed again then.
+func (a *actuator) updateOutboundRulesStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, outboundRules *apisazure.OutboundRulesStatus) (bool, error) {
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return false, fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	if apiequality.Semantic.DeepEqual(status.OutboundRules, outboundRules) {
+		return false, nil
+	}
+	toggled := (status.OutboundRules == nil) != (outboundRules == nil)
+	status.OutboundRules = outboundRules
+	return toggled, a.patchControlPlaneStatus(ctx, cp, status)
+}
+
+func ensureOutboundPublicIP(ctx context.Context, c azureclient.PublicIP, resourceGroup, region, name string) (*armnetwork.PublicIPAddress, error) {
+	ip, err := c.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if ip != nil {
+		return ip, nil
+	}
+
+	return c.CreateOrUpdate(ctx, resourceGroup, name, armnetwork.PublicIPAddress{
+		Location: to.Ptr(region),
+		SKU: &armnetwork.PublicIPAddressSKU{
+			Name: to.Ptr(armnetwork.PublicIPAddressSKUNameStandard),
+		},
+		Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+			PublicIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodStatic),
+			PublicIPAddressVersion:   to.Ptr(armnetwork.IPVersionIPv4),
+		},
+	})
+}
+
+// outboundRulesUpToDate checks whether the frontend IP configurations and outbound rules with the given prefix on the
+// load balancer match the desired ones.
+func outboundRulesUpToDate(lb *armnetwork.LoadBalancer, prefix string, frontends []*armnetwork.FrontendIPConfiguration, rules []*armnetwork.OutboundRule) bool {
+	var currentFrontends []string
+	for _, f := range lb.Properties.FrontendIPConfigurations {
+		if name := ptr.Deref(f.Name, ""); strings.HasPrefix(name, prefix) {
+			currentFrontends = append(currentFrontends, frontendKey(f))
+		}
+	}
+	var desiredFrontends []string
+	for _, f := range frontends {
+		desiredFrontends = append(desiredFrontends, frontendKey(f))
+	}
+	slices.Sort(currentFrontends)
+	if !slices.Equal(currentFrontends, desiredFrontends) {
+		return false
+	}
+
+	var currentRules []string
+	for _, r := range lb.Properties.OutboundRules {
+		if strings.HasPrefix(ptr.Deref(r.Name, ""), prefix) {
+			currentRules = append(currentRules, outboundRuleKey(r))
+		}
+	}
+	var desiredRules []string
+	for _, r := range rules {
+		desiredRules = append(desiredRules, outboundRuleKey(r))
+	}
+	slices.Sort(currentRules)
+	return slices.Equal(currentRules, desiredRules)
+}
+
+func frontendKey(f *armnetwork.FrontendIPConfiguration) string {
+	var ipID, gatewayLoadBalancerID string
+	if f.Properties != nil && f.Properties.PublicIPAddress != nil {
+		ipID = ptr.Deref(f.Properties.PublicIPAddress.ID, "")
+	}
+	if f.Properties != nil && f.Properties.GatewayLoadBalancer != nil {
+		gatewayLoadBalancerID = strings.ToLower(ptr.Deref(f.Properties.GatewayLoadBalancer.ID, ""))
+	}
+	return fmt.Sprintf("%s=%s|%s", ptr.Deref(f.Name, ""), ipID, gatewayLoadBalancerID)
+}
+
+func outboundRuleKey(r *armnetwork.OutboundRule) string {
+	if r.Properties == nil {
+		return ptr.Deref(r.Name, "")
+	}
+	var frontends []string
+	for _, f := range r.Properties.FrontendIPConfigurations {
+		frontends = append(frontends, strings.ToLower(ptr.Deref(f.ID, "")))
+	}
+	slices.Sort(frontends)
+	var backend string
+	if r.Properties.BackendAddressPool != nil {
+		backend = strings.ToLower(ptr.Deref(r.Properties.BackendAddressPool.ID, ""))
+	}
+	return fmt.Sprintf("%s|%s|%d|%d|%s|%s", ptr.Deref(r.Name, ""), ptr.Deref(r.Properties.Protocol, ""),
+		ptr.Deref(r.Properties.AllocatedOutboundPorts, 0), ptr.Deref(r.Properties.IdleTimeoutInMinutes, 4),
+		strings.Join(frontends, ","), backend)
+}
+
+// verifyGatewayLoadBalancer checks that the referenced frontend IP configuration belongs to an existing Gateway Load
+// Balancer in the subscription of the given load balancer and returns the reference to chain it.
+func verifyGatewayLoadBalancer(ctx context.Context, lbClient azureclient.LoadBalancer, lb *armnetwork.LoadBalancer, frontendID string) (*armnetwork.SubResource, error) {
+	frontendResourceID, err := arm.ParseResourceID(frontendID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid frontend IP configuration ID %q of the gateway load balancer: %w", frontendID, err)
+	}
+	lbResourceID, err := arm.ParseResourceID(ptr.Deref(lb.ID, ""))
+	if err != nil {
+		return nil, err
+	}
+	if frontendResourceID.Parent == nil || !strings.EqualFold(frontendResourceID.SubscriptionID, lbResourceID.SubscriptionID) {
+		return nil, fmt.Errorf("gateway load balancer %q must be in the subscription %s of the shoot", frontendID, lbResourceID.SubscriptionID)
+	}
+
+	gateway, err := lbClient.Get(ctx, frontendResourceID.ResourceGroupName, frontendResourceID.Parent.Name)
+	if err != nil {
+		return nil, err
+	}
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway load balancer %s in resource group %s does not exist", frontendResourceID.Parent.Name, frontendResourceID.ResourceGroupName)
+	}
+	if gateway.SKU == nil || ptr.Deref(gateway.SKU.Name, "") != armnetwork.LoadBalancerSKUNameGateway {
+		return nil, fmt.Errorf("load balancer %s in resource group %s is not a gateway load balancer", frontendResourceID.Parent.Name, frontendResourceID.ResourceGroupName)
+	}
+	if gateway.Properties != nil {
+		for _, frontend := range gateway.Properties.FrontendIPConfigurations {
+			if strings.EqualFold(ptr.Deref(frontend.Name, ""), frontendResourceID.Name) {
+				return &armnetwork.SubResource{ID: frontend.ID}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("gateway load balancer %s in resource group %s has no frontend IP configuration %s", frontendResourceID.Parent.Name, frontendResourceID.ResourceGroupName, frontendResourceID.Name)
+}
+
+// unchainGatewayLoadBalancer removes the Gateway Load Balancer from the outbound frontend IP configurations of the
+// load balancer of the cloud-controller-manager, so that the Gateway Load Balancer is no longer referenced by the shoot.
+// Frontend IP configurations are only chained while the ControlPlaneConfig references a Gateway Load Balancer, and the
+// chaining is recorded in the provider status, so that it is also removed once the reference was dropped.
+func (a *actuator) unchainGatewayLoadBalancer(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	cpConfig, err := azureapihelper.ControlPlaneConfigFromControlPlane(cp)
+	if err != nil {
+		return fmt.Errorf("could not decode providerConfig of controlplane: %w", err)
+	}
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	configured := cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.GatewayLoadBalancer != nil
+	if (!configured && status.GatewayLoadBalancer == nil) || cp.Spec.InfrastructureProviderStatus == nil {
+		return nil
+	}
+
+	infraStatus, err := azureapihelper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return fmt.Errorf("could not decode infrastructureProviderStatus of controlplane: %w", err)
+	}
+
+	factory, err := a.newAzureClientFactory(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+	lbClient, err := factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := infraStatus.ResourceGroup.Name
+	lb, err := lbClient.Get(ctx, resourceGroup, cp.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if lb != nil && lb.Properties != nil {
+		prefix, chained := outboundPrefix(cp), false
+		for _, f := range lb.Properties.FrontendIPConfigurations {
+			if strings.HasPrefix(ptr.Deref(f.Name, ""), prefix) && f.Properties != nil && f.Properties.GatewayLoadBalancer != nil {
+				f.Properties.GatewayLoadBalancer = nil
+				chained = true
+			}
+		}
+		if chained {
+			log.Info("Removing gateway load balancer from outbound frontend IP configurations of load balancer", "name", cp.Namespace)
+			if _, err := lbClient.UpdateIfUnchanged(ctx, resourceGroup, cp.Namespace, *lb); err != nil {
+				return fmt.Errorf("failed to remove gateway load balancer from load balancer %s: %w", cp.Namespace, err)
+			}
+		}
+	}
+
+	return a.updateGatewayLoadBalancerStatus(ctx, cp, nil)
+}
+
+// updateGatewayLoadBalancerStatus reports the given Gateway Load Balancer status in the provider status of the
+// ControlPlane, unless it is already up-to-date.
+func (a *actuator) updateGatewayLoadBalancerStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, gatewayLoadBalancer *apisazure.GatewayLoadBalancerStatus) error {
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	if apiequality.Semantic.DeepEqual(status.GatewayLoadBalancer, gatewayLoadBalancer) {
+		return nil
+	}
+	status.GatewayLoadBalancer = gatewayLoadBalancer
+	return a.patchControlPlaneStatus(ctx, cp, status)
+}
+
+func (a *actuator) newAzureClientFactory(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (azureclient.Factory, error) {
+	cloudProfile, err := azureapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var cloudConfiguration *apisazure.CloudConfiguration
+	if cloudProfile != nil {
+		cloudConfiguration = cloudProfile.CloudConfiguration
+	}
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &cp.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return DefaultAzureClientFactoryFunc(ctx, a.client, cp.Spec.SecretRef, false, azureclient.WithCloudConfiguration(azCloudConfiguration))
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+// privateLinkName returns the name of the Private Link Service and of the Private Endpoint which connects to it. The
+// Private Link Service is created by the cloud-controller-manager for the internal load balancer service which exposes
+// the node side of the connectivity, if the service is annotated with this name.
+func privateLinkName(cp *extensionsv1alpha1.ControlPlane) string {
+	return cp.Namespace + azure.PrivateLinkNameSuffix
+}
+
+func privateEndpointNetworkInterfaceName(cp *extensionsv1alpha1.ControlPlane) string {
+	return privateLinkName(cp) + "-nic"
+}
+
+// reconcilePrivateEndpoint ensures the Private Endpoint which connects the configured subnet, usually a subnet of the
+// seed, to the Private Link Service of the shoot and reports it in the provider status of the ControlPlane. The Private
+// Endpoint is deleted once the private link is no longer configured.
+func (a *actuator) reconcilePrivateEndpoint(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	infraConfig, err := azureapihelper.InfrastructureConfigFromCluster(cluster)
+	if err != nil {
+		return fmt.Errorf("could not decode infrastructureConfig of shoot: %w", err)
+	}
+	privateLink := infraConfig.Networks.PrivateLink
+	if privateLink == nil {
+		if err := a.deletePrivateEndpoint(ctx, log, cp, cluster); err != nil {
+			return err
+		}
+		return a.updatePrivateLinkStatus(ctx, cp, nil)
+	}
+
+	infraStatus, err := azureapihelper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return fmt.Errorf("could not decode infrastructureProviderStatus of controlplane: %w", err)
+	}
+	var subnetName string
+	for _, subnet := range infraStatus.Networks.Subnets {
+		if subnet.Purpose == apisazure.PurposePrivateLink {
+			subnetName = subnet.Name
+		}
+	}
+	if subnetName == "" {
+		return fmt.Errorf("infrastructure status of controlplane contains no subnet for the private link")
+	}
+
+	status := &apisazure.PrivateLinkStatus{
+		ServiceName: privateLinkName(cp),
+		SubnetName:  subnetName,
+	}
+
+	factory, err := a.newAzureClientFactory(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+	plsClient, err := factory.PrivateLinkService()
+	if err != nil {
+		return err
+	}
+	peClient, err := factory.PrivateEndpoint()
+	if err != nil {
+		return err
+	}
+	nicClient, err := factory.NetworkInterface()
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := infraStatus.ResourceGroup.Name
+	pls, err := plsClient.Get(ctx, resourceGroup, privateLinkName(cp))
+	if err != nil {
+		return err
+	}
+	if pls == nil {
+		log.Info("Private link service of the cloud-controller-manager does not exist yet, skipping private endpoint", "name", privateLinkName(cp))
+		return a.updatePrivateLinkStatus(ctx, cp, status)
+	}
+
+	pe, err := peClient.Get(ctx, resourceGroup, privateLinkName(cp))
+	if err != nil {
+		return err
+	}
+	if !privateEndpointUpToDate(pe, pls, privateLink) {
+		log.Info("Updating private endpoint", "name", privateLinkName(cp))
+		if pe, err = peClient.CreateOrUpdate(ctx, resourceGroup, privateLinkName(cp), armnetwork.PrivateEndpoint{
+			Location: to.Ptr(cp.Spec.Region),
+			Properties: &armnetwork.PrivateEndpointProperties{
+				CustomNetworkInterfaceName: to.Ptr(privateEndpointNetworkInterfaceName(cp)),
+				Subnet:                     &armnetwork.Subnet{ID: to.Ptr(privateLink.EndpointSubnetID)},
+				PrivateLinkServiceConnections: []*armnetwork.PrivateLinkServiceConnection{{
+					Name: to.Ptr(privateLinkName(cp)),
+					Properties: &armnetwork.PrivateLinkServiceConnectionProperties{
+						PrivateLinkServiceID: pls.ID,
+					},
+				}},
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to update private endpoint %s: %w", privateLinkName(cp), err)
+		}
+	}
+	status.EndpointID = pe.ID
+	if pe.Properties != nil {
+		for _, connection := range pe.Properties.PrivateLinkServiceConnections {
+			if connection.Properties != nil && connection.Properties.PrivateLinkServiceConnectionState != nil {
+				status.ConnectionState = connection.Properties.PrivateLinkServiceConnectionState.Status
+			}
+		}
+	}
+
+	nic, err := nicClient.Get(ctx, resourceGroup, privateEndpointNetworkInterfaceName(cp))
+	if err != nil {
+		return err
+	}
+	if nic != nil && nic.Properties != nil {
+		for _, ipConfig := range nic.Properties.IPConfigurations {
+			if ipConfig.Properties != nil && ipConfig.Properties.PrivateIPAddress != nil {
+				status.EndpointIPAddresses = append(status.EndpointIPAddresses, *ipConfig.Properties.PrivateIPAddress)
+			}
+		}
+	}
+
+	return a.updatePrivateLinkStatus(ctx, cp, status)
+}
+
+// privateEndpointUpToDate checks whether the Private Endpoint exists in the configured subnet and connects to the
+// given Private Link Service. The subnet of an existing Private Endpoint cannot be changed, but the subnet is immutable
+// in the InfrastructureConfig.
+func privateEndpointUpToDate(pe *armnetwork.PrivateEndpoint, pls *armnetwork.PrivateLinkService, privateLink *apisazure.PrivateLinkConfig) bool {
+	if pe == nil || pe.Properties == nil || pe.Properties.Subnet == nil || len(pe.Properties.PrivateLinkServiceConnections) != 1 {
+		return false
+	}
+	connection := pe.Properties.PrivateLinkServiceConnections[0]
+	return strings.EqualFold(ptr.Deref(pe.Properties.Subnet.ID, ""), privateLink.EndpointSubnetID) &&
+		connection.Properties != nil &&
+		strings.EqualFold(ptr.Deref(connection.Properties.PrivateLinkServiceID, ""), ptr.Deref(pls.ID, ""))
+}
+
+// deletePrivateEndpoint deletes the Private Endpoint of the shoot, so that the Private Link Service can be removed by
+// the cloud-controller-manager. Azure refuses to delete a Private Link Service with connected endpoints.
+func (a *actuator) deletePrivateEndpoint(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if cp.Spec.InfrastructureProviderStatus == nil {
+		return nil
+	}
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	if status.PrivateLink == nil || status.PrivateLink.EndpointID == nil {
+		return nil
+	}
+
+	infraStatus, err := azureapihelper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return fmt.Errorf("could not decode infrastructureProviderStatus of controlplane: %w", err)
+	}
+
+	factory, err := a.newAzureClientFactory(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+	peClient, err := factory.PrivateEndpoint()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting private endpoint", "name", privateLinkName(cp))
+	if err := peClient.Delete(ctx, infraStatus.ResourceGroup.Name, privateLinkName(cp)); err != nil {
+		return fmt.Errorf("failed to delete private endpoint %s: %w", privateLinkName(cp), err)
+	}
+	return nil
+}
+
+// updatePrivateLinkStatus reports the given private link status in the provider status of the ControlPlane, unless it
+// is already up-to-date.
+func (a *actuator) updatePrivateLinkStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, privateLink *apisazure.PrivateLinkStatus) error {
+	status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+	if err != nil {
+		return fmt.Errorf("could not decode providerStatus of controlplane: %w", err)
+	}
+	if apiequality.Semantic.DeepEqual(status.PrivateLink, privateLink) {
+		return nil
+	}
+	status.PrivateLink = privateLink
+	return a.patchControlPlaneStatus(ctx, cp, status)
+}
+
+// patchControlPlaneStatus patches the provider status of the ControlPlane with the given status.
+func (a *actuator) patchControlPlaneStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, status *apisazure.ControlPlaneStatus) error {
+	statusV1alpha1 := &v1alpha1.ControlPlaneStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ControlPlaneStatus",
+		},
+	}
+	if err := a.scheme.Convert(status, statusV1alpha1, nil); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cp.DeepCopy())
+	cp.Status.ProviderStatus = &runtime.RawExtension{Object: statusV1alpha1}
+	return a.client.Status().Patch(ctx, cp, patch)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -307,6 +308,11 @@ func (vp *valuesProvider) GetConfigChartValues(ctx context.Context, cp *extensio
 	if err != nil {
 		return nil, fmt.Errorf("could not get service account from secret '%s/%s': %w", cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name, err)
 	}
+	// The cloud provider config is read by components which require the plain client secret.
+	if auth.KeyVaultSecretRef != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the client secret of secret %s/%s is stored in a Key Vault, which is not supported for the cloud provider config",
+			cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name), v1beta1.ErrorConfigurationProblem)
+	}
 
 	// Check if the configmap for the acr access need to be removed.
 	if infraStatus.Identity == nil || !infraStatus.Identity.ACRAccess {
@@ -316,7 +322,7 @@ func (vp *valuesProvider) GetConfigChartValues(ctx context.Context, cp *extensio
 	}
 
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp, cluster, auth)
+	return getConfigChartValues(cpConfig, infraStatus, cp, cluster, auth)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -377,7 +383,15 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	_ secretsmanager.Reader,
 	_ map[string]string,
 ) (map[string]interface{}, error) {
-	return getControlPlaneShootChartValues(ctx, cp, cluster, vp.client)
+	// Decode providerConfig
+	cpConfig := &apisazure.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	return getControlPlaneShootChartValues(ctx, cpConfig, cp, cluster, vp.client)
 }
 
 // GetControlPlaneShootCRDsChartValues returns the values for the control plane shoot CRDs chart applied by the generic actuator.
@@ -397,7 +411,7 @@ func (vp *valuesProvider) GetControlPlaneShootCRDsChartValues(
 func (vp *valuesProvider) GetStorageClassesChartValues(
 	_ context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
-	_ *extensionscontroller.Cluster,
+	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
 	// Decode providerConfig
 	cpConfig := &apisazure.ControlPlaneConfig{}
@@ -411,11 +425,38 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 	if cpConfig.Storage != nil {
 		values["managedDefaultStorageClass"] = ptr.Deref(cpConfig.Storage.ManagedDefaultStorageClass, true)
 		values["managedDefaultVolumeSnapshotClass"] = ptr.Deref(cpConfig.Storage.ManagedDefaultVolumeSnapshotClass, true)
+		if mode := cpConfig.Storage.DefaultStorageClassVolumeBindingMode; mode != nil {
+			values["defaultStorageClassVolumeBindingMode"] = string(*mode)
+		}
+	}
+	if zones := storageClassTopologyZones(cluster); len(zones) > 0 {
+		values["allowedTopologyZones"] = zones
 	}
 
 	return values, nil
 }
 
+// storageClassTopologyZones returns the zones of the worker pools of the given cluster in the form of the zone topology
+// labels of the Azure Disk CSI driver, i.e. '<region>-<zone>'. The 'default' StorageClass only provisions volumes in these
+// zones, so that volumes which are bound immediately are not placed in a zone without nodes. It returns nil for
+// non-zonal clusters.
+func storageClassTopologyZones(cluster *extensionscontroller.Cluster) []string {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil
+	}
+
+	var zones []string
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		for _, zone := range worker.Zones {
+			if zone := fmt.Sprintf("%s-%s", cluster.Shoot.Spec.Region, zone); !slices.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+	}
+	slices.Sort(zones)
+	return zones
+}
+
 func (vp *valuesProvider) removeAcrConfig(ctx context.Context, namespace string) error {
 	cm := corev1.ConfigMap{}
 	cm.SetName(azure.CloudProviderAcrConfigName)
@@ -424,7 +465,7 @@ func (vp *valuesProvider) removeAcrConfig(ctx context.Context, namespace string)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
-func getConfigChartValues(infraStatus *apisazure.InfrastructureStatus, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, ca *azureclient.ClientAuth) (map[string]interface{}, error) {
+func getConfigChartValues(cpConfig *apisazure.ControlPlaneConfig, infraStatus *apisazure.InfrastructureStatus, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, ca *azureclient.ClientAuth) (map[string]interface{}, error) {
 	subnetName, routeTableName, securityGroupName, err := getInfraNames(infraStatus)
 	if err != nil {
 		return nil, fmt.Errorf("could not determine subnet, route table or security group name from infrastructureStatus of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
@@ -467,10 +508,33 @@ func getConfigChartValues(infraStatus *apisazure.InfrastructureStatus, cp *exten
 		values["vnetResourceGroup"] = *infraStatus.Networks.VNet.ResourceGroup
 	}
 
+	if infraStatus.Networks.ResourceGroup != nil {
+		values["networkResourceGroup"] = *infraStatus.Networks.ResourceGroup
+		values["securityGroupResourceGroup"] = *infraStatus.Networks.ResourceGroup
+	}
+
+	// an existing security group which is referenced by the InfrastructureConfig is located in its own resource group.
+	if securityGroup, err := azureapihelper.FindSecurityGroupByPurpose(infraStatus.SecurityGroups, apisazure.PurposeNodes); err == nil && securityGroup.ResourceGroup != nil {
+		values["securityGroupResourceGroup"] = *securityGroup.ResourceGroup
+	}
+
 	if infraStatus.Identity != nil && infraStatus.Identity.ACRAccess {
 		values["acrIdentityClientId"] = infraStatus.Identity.ClientID
 	}
 
+	// The outbound rules managed by the extension provide the SNAT for the nodes, hence the load balancing rules
+	// created by the cloud-controller-manager must not use their frontend IPs for outbound connections. Outbound SNAT is
+	// only disabled once the outbound rules exist, so that the nodes keep their egress connectivity in the meantime.
+	if cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.OutboundRules != nil {
+		status, err := azureapihelper.ControlPlaneStatusFromControlPlane(cp)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode providerStatus of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+		}
+		if status.OutboundRules != nil {
+			values["disableOutboundSNAT"] = true
+		}
+	}
+
 	return appendMachineSetValues(values, infraStatus), nil
 }
 
@@ -496,11 +560,19 @@ func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazur
 }
 
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
+// The cloud-controller-manager places the frontend IPs of internal load balancers in the subnet, hence the dedicated subnet
+// of the internal load balancers takes precedence over the subnet of the nodes.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, error) {
 	_, nodesSubnet, err := azureapihelper.FindSubnetByPurposeAndZone(infraStatus.Networks.Subnets, apisazure.PurposeNodes, nil)
 	if err != nil {
 		return "", "", "", fmt.Errorf("could not determine subnet for purpose 'nodes': %w", err)
 	}
+	subnetName := nodesSubnet.Name
+	for _, subnet := range infraStatus.Networks.Subnets {
+		if subnet.Purpose == apisazure.PurposeInternalLoadBalancer {
+			subnetName = subnet.Name
+		}
+	}
 	nodesRouteTable, err := azureapihelper.FindRouteTableByPurpose(infraStatus.RouteTables, apisazure.PurposeNodes)
 	if err != nil {
 		return "", "", "", fmt.Errorf("could not determine route table for purpose 'nodes': %w", err)
@@ -510,7 +582,7 @@ func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string,
 		return "", "", "", fmt.Errorf("could not determine security group for purpose 'nodes': %w", err)
 	}
 
-	return nodesSubnet.Name, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
+	return subnetName, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
 }
 
 // getControlPlaneChartValues collects and returns the control plane chart values.
@@ -532,7 +604,7 @@ func getControlPlaneChartValues(
 		return nil, err
 	}
 
-	csi, err := getCSIControllerChartValues(cluster, scaledDown, infraStatus, checksums, useWorkloadIdentity)
+	csi, err := getCSIControllerChartValues(cpConfig, cluster, scaledDown, infraStatus, checksums, useWorkloadIdentity)
 	if err != nil {
 		return nil, err
 	}
@@ -585,13 +657,28 @@ func getCCMChartValues(
 
 	if cpConfig.CloudControllerManager != nil {
 		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+
+		if cpConfig.CloudControllerManager.Resources != nil {
+			resources, err := resourcesChartValues(cpConfig.CloudControllerManager.Resources)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert the resources of the cloud-controller-manager: %w", err)
+			}
+			values["resources"] = resources
+		}
 	}
 
 	return values, nil
 }
 
+// resourcesChartValues converts the given resource requirements into chart values. The values must be maps so that
+// they are merged with the default resources of the charts.
+func resourcesChartValues(resources *corev1.ResourceRequirements) (map[string]interface{}, error) {
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(resources)
+}
+
 // getCSIControllerChartValues collects and returns the CSIController chart values.
 func getCSIControllerChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
 	cluster *extensionscontroller.Cluster,
 	scaledDown bool,
 	infraStatus *apisazure.InfrastructureStatus,
@@ -610,6 +697,14 @@ func getCSIControllerChartValues(
 		"useWorkloadIdentity": useWorkloadIdentity,
 	}
 
+	if cpConfig.CSISnapshotController != nil {
+		csiSnapshotController, err := getCSISnapshotControllerChartValues(cpConfig.CSISnapshotController, cluster, scaledDown)
+		if err != nil {
+			return nil, err
+		}
+		values["csiSnapshotController"] = csiSnapshotController
+	}
+
 	k8sVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
 	if err != nil {
 		return nil, err
@@ -638,6 +733,33 @@ func getCSIControllerChartValues(
 	return values, nil
 }
 
+// getCSISnapshotControllerChartValues collects and returns the chart values of the csi-snapshot-controller for the
+// given configuration. Like the remedy controller, a disabled csi-snapshot-controller is scaled to zero replicas.
+func getCSISnapshotControllerChartValues(config *apisazure.CSISnapshotControllerConfig, cluster *extensionscontroller.Cluster, scaledDown bool) (map[string]interface{}, error) {
+	if !ptr.Deref(config.Enabled, true) {
+		return map[string]interface{}{"replicas": 0}, nil
+	}
+
+	values := map[string]interface{}{
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+	}
+	if config.Replicas != nil {
+		replicas := extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, int(*config.Replicas))
+		values["replicas"] = replicas
+		// the high availability webhook of the gardener-resource-manager would overwrite the replicas otherwise.
+		values["highAvailabilityReplicas"] = replicas
+	}
+	if config.Resources != nil {
+		resources, err := resourcesChartValues(config.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the resources of the csi-snapshot-controller: %w", err)
+		}
+		values["resources"] = resources
+	}
+
+	return values, nil
+}
+
 // getRemedyControllerChartValues collects and returns the remedy controller chart values.
 func getRemedyControllerChartValues(
 	cluster *extensionscontroller.Cluster,
@@ -665,6 +787,7 @@ func getRemedyControllerChartValues(
 // getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
 func getControlPlaneShootChartValues(
 	ctx context.Context,
+	cpConfig *apisazure.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	client k8sclient.Client,
@@ -695,6 +818,18 @@ func getControlPlaneShootChartValues(
 	disableRemedyController := cluster.Shoot.Annotations[azure.DisableRemedyControllerAnnotation] == "true" ||
 		features.ExtensionFeatureGate.Enabled(features.DisableRemedyController)
 
+	ccm := map[string]interface{}{
+		"enabled":    true,
+		"vpaEnabled": gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
+	}
+	if cpConfig.CloudNodeManager != nil && cpConfig.CloudNodeManager.Resources != nil {
+		resources, err := resourcesChartValues(cpConfig.CloudNodeManager.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the resources of the cloud-node-manager: %w", err)
+		}
+		ccm["resources"] = resources
+	}
+
 	return map[string]interface{}{
 		// the allow-egress chart is enabled in all cases **except**:
 		// - when the shoot is using AVSets due to using basic loadbalancers (see https://github.com/gardener/gardener-extension-provider-azure/issues/1).
@@ -702,10 +837,7 @@ func getControlPlaneShootChartValues(
 		azure.AllowEgressName: map[string]interface{}{
 			"enabled": deployAllowEgressChart(cluster.Shoot, infraStatus),
 		},
-		azure.CloudControllerManagerName: map[string]interface{}{
-			"enabled":    true,
-			"vpaEnabled": gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
-		},
+		azure.CloudControllerManagerName: ccm,
 		azure.CSINodeName: map[string]interface{}{
 			"enabled": true,
 			"podAnnotations": map[string]interface{}{
@@ -743,5 +875,11 @@ func deployAllowEgressChart(shoot *v1beta1.Shoot, infraStatus *apisazure.Infrast
 		return false
 	}
 
+	// the outbound load balancer provides the egress of the nodes, the allow-egress service would conflict with it because
+	// a network interface can only be in the backend pool of one public load balancer.
+	if infraStatus.Networks.OutboundLoadBalancer != nil {
+		return false
+	}
+
 	return (infraStatus.Zoned || azureapihelper.IsVmoRequired(infraStatus)) && infraStatus.Networks.OutboundAccessType == apisazure.OutboundAccessTypeLoadBalancer
 }
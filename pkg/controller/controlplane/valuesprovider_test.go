@@ -8,12 +8,14 @@ import (
 	"context"
 	"encoding/json"
 	"maps"
+	"path/filepath"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
@@ -25,15 +27,20 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
+	"github.com/gardener/gardener-extension-provider-azure/charts"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
@@ -193,6 +200,23 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Get(ctx, controlPlaneSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(controlPlaneSecret))
 		})
 
+		It("should return error if the client secret is stored in a Key Vault", func() {
+			data := controlPlaneSecret.Data
+			DeferCleanup(func() { controlPlaneSecret.Data = data })
+			controlPlaneSecret.Data = map[string][]byte{
+				"clientID":                 []byte(`ClientID`),
+				"subscriptionID":           []byte(`SubscriptionID`),
+				"tenantID":                 []byte(`TenantID`),
+				"keyVaultURI":              []byte(`https://my-vault.vault.azure.net`),
+				"keyVaultSecretName":       []byte(`client-secret`),
+				"keyVaultIdentityClientID": []byte(`IdentityClientID`),
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			_, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).To(MatchError(ContainSubstring("is stored in a Key Vault, which is not supported for the cloud provider config")))
+		})
+
 		Context("Error due to missing resources in the infrastructure status", func() {
 			BeforeEach(func() {
 				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
@@ -255,6 +279,92 @@ var _ = Describe("ValuesProvider", func() {
 				Expect(values).To(Equal(ControlPlaneChartValues))
 			})
 
+			It("should not disable outbound SNAT before the configured outbound rules exist", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				controlPlaneConfig.LoadBalancer = &v1alpha1.LoadBalancerConfig{
+					OutboundRules: &v1alpha1.OutboundRules{
+						TCP: &v1alpha1.OutboundRuleAllocation{AllocatedOutboundPorts: 1024},
+					},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes": maxNodes,
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should disable outbound SNAT once the configured outbound rules exist", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				controlPlaneConfig.LoadBalancer = &v1alpha1.LoadBalancerConfig{
+					OutboundRules: &v1alpha1.OutboundRules{
+						TCP: &v1alpha1.OutboundRuleAllocation{AllocatedOutboundPorts: 1024},
+					},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+				cp.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneStatus","outboundRules":{"names":["outbound-tcp"],"publicIPAddressNames":["outbound-0"]}}`)}
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":            maxNodes,
+					"disableOutboundSNAT": true,
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should point the cloud provider to the network resource group", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				infrastructureStatus.Networks.VNet.ResourceGroup = ptr.To("network-rg")
+				infrastructureStatus.Networks.ResourceGroup = ptr.To("network-rg")
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":                   maxNodes,
+					"vnetResourceGroup":          "network-rg",
+					"networkResourceGroup":       "network-rg",
+					"securityGroupResourceGroup": "network-rg",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should point the cloud provider to the existing security group", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				infrastructureStatus.SecurityGroups[0].Name = "existing-nsg"
+				infrastructureStatus.SecurityGroups[0].ResourceGroup = ptr.To("security-rg")
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":                   maxNodes,
+					"securityGroupName":          "existing-nsg",
+					"securityGroupResourceGroup": "security-rg",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should place the internal load balancers in their dedicated subnet", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				infrastructureStatus.Networks.Subnets = append(infrastructureStatus.Networks.Subnets, v1alpha1.Subnet{
+					Name:    "subnet-abcd1234-internal-lb",
+					Purpose: v1alpha1.PurposeInternalLoadBalancer,
+				})
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":   maxNodes,
+					"subnetName": "subnet-abcd1234-internal-lb",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
 			It("should return correct control plane chart values with identity", func() {
 				identityName := "identity-client-id"
 				infrastructureStatus.Identity = &v1alpha1.IdentityStatus{
@@ -369,6 +479,91 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should render the resource overrides of the cloud-controller-manager", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.CloudControllerManager.Resources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CloudControllerManagerName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "50m"},
+				"limits":   map[string]interface{}{"memory": "512Mi"},
+			}))
+
+			deployment := &appsv1.Deployment{}
+			renderChartObject("seed-controlplane/charts/cloud-controller-manager", "cloud-controller-manager.yaml", "deployment/cloud-controller-manager",
+				utils.MergeMaps(values[azure.CloudControllerManagerName].(map[string]interface{}), map[string]interface{}{"global": values["global"]}), deployment)
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(Equal(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("64M")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+			}))
+		})
+
+		It("should render the configured replicas and resources of the csi-snapshot-controller", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.CSISnapshotController = &v1alpha1.CSISnapshotControllerConfig{
+				Replicas: ptr.To[int32](3),
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotController", map[string]interface{}{
+				"replicas":                 3,
+				"highAvailabilityReplicas": 3,
+				"resources": map[string]interface{}{
+					"limits": map[string]interface{}{"memory": "256Mi"},
+				},
+			}))
+
+			deployment := &appsv1.Deployment{}
+			renderChartObject("seed-controlplane/charts/csi-driver-controller", "deployment-csi-snapshot-controller.yaml", "deployment/csi-snapshot-controller",
+				utils.MergeMaps(values[azure.CSIControllerName].(map[string]interface{}), map[string]interface{}{"global": values["global"]}), deployment)
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To[int32](3)))
+			Expect(deployment.Annotations).To(HaveKeyWithValue("high-availability-config.resources.gardener.cloud/replicas", "3"))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(Equal(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("33m"), corev1.ResourceMemory: resource.MustParse("32Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+			}))
+		})
+
+		It("should scale the csi-snapshot-controller to zero if it is disabled", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.CSISnapshotController = &v1alpha1.CSISnapshotControllerConfig{Enabled: ptr.To(false)}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotController", map[string]interface{}{"replicas": 0}))
+
+			deployment := &appsv1.Deployment{}
+			renderChartObject("seed-controlplane/charts/csi-driver-controller", "deployment-csi-snapshot-controller.yaml", "deployment/csi-snapshot-controller",
+				utils.MergeMaps(values[azure.CSIControllerName].(map[string]interface{}), map[string]interface{}{"global": values["global"]}), deployment)
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To[int32](0)))
+			Expect(deployment.Annotations).NotTo(HaveKey("high-availability-config.resources.gardener.cloud/replicas"))
+		})
+
+		It("should not set the replicas of the csi-snapshot-controller if the control plane is scaled down", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, &gardencorev1beta1.Seed{})
+			cluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			controlPlaneConfig.CSISnapshotController = &v1alpha1.CSISnapshotControllerConfig{Replicas: ptr.To[int32](3)}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotController", map[string]interface{}{
+				"replicas":                 0,
+				"highAvailabilityReplicas": 0,
+			}))
+		})
+
 		It("should return correct control plane chart values with zoned infrastructure", func() {
 			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, &gardencorev1beta1.Seed{})
 			infrastructureStatus.Zoned = true
@@ -552,6 +747,42 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should not deploy allowEgress if the nodes use the outbound load balancer", func() {
+			infrastructureStatus.Networks.OutboundLoadBalancer = &v1alpha1.OutboundLoadBalancerStatus{ID: "lb-id", BackendAddressPoolID: "pool-id"}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.AllowEgressName, enabledFalse))
+		})
+
+		It("should render the resource overrides of the cloud-node-manager", func() {
+			controlPlaneConfig.CloudNodeManager = &v1alpha1.CloudNodeManagerConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+				},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.CloudControllerManagerName, utils.MergeMaps(cloudControllerManager, map[string]interface{}{
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"memory": "100Mi"},
+					"limits":   map[string]interface{}{"memory": "200Mi"},
+				},
+			})))
+
+			daemonSet := &appsv1.DaemonSet{}
+			renderChartObject("shoot-system-components/charts/cloud-controller-manager", "cloud-node-manager.yaml", "daemonset/cloud-node-manager",
+				values[azure.CloudControllerManagerName], daemonSet)
+			Expect(daemonSet.Spec.Template.Spec.Containers[0].Resources).To(Equal(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("100Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+			}))
+		})
+
 		Context("remedy controller is disabled", func() {
 			BeforeEach(func() {
 				shootAnnotations := map[string]string{
@@ -616,6 +847,43 @@ var _ = Describe("ValuesProvider", func() {
 				"managedDefaultVolumeSnapshotClass": true,
 			}))
 		})
+
+		It("should restrict the default storage class to the zones of the worker pools and wait for the first consumer", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, nil)
+			cluster.Shoot.Spec.Region = "westeurope"
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "pool-a", Zones: []string{"2", "1"}},
+				{Name: "pool-b", Zones: []string{"1"}},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("allowedTopologyZones", []string{"westeurope-1", "westeurope-2"}))
+
+			storageClass := &storagev1.StorageClass{}
+			renderChartObject("shoot-storageclasses", "storageclasses.yaml", "storageclass/default", values, storageClass)
+			Expect(storageClass.VolumeBindingMode).To(Equal(ptr.To(storagev1.VolumeBindingWaitForFirstConsumer)))
+			Expect(storageClass.AllowedTopologies).To(ConsistOf(corev1.TopologySelectorTerm{
+				MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{{
+					Key:    "topology.disk.csi.azure.com/zone",
+					Values: []string{"westeurope-1", "westeurope-2"},
+				}},
+			}))
+		})
+
+		It("should render the configured volume binding mode of the default storage class", func() {
+			controlPlaneConfig.Storage = &v1alpha1.Storage{DefaultStorageClassVolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate)}
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, nil)
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("defaultStorageClassVolumeBindingMode", "Immediate"))
+
+			storageClass := &storagev1.StorageClass{}
+			renderChartObject("shoot-storageclasses", "storageclasses.yaml", "storageclass/default", values, storageClass)
+			Expect(storageClass.VolumeBindingMode).To(Equal(ptr.To(storagev1.VolumeBindingImmediate)))
+			Expect(storageClass.AllowedTopologies).To(BeEmpty())
+		})
 	})
 })
 
@@ -701,3 +969,17 @@ func generateCluster(cidr, k8sVersion string, vpaEnabled bool, shootAnnotations
 		Shoot: shoot,
 	}
 }
+
+// renderChartObject renders the given internal chart with the given values and decodes the given object of the given
+// template into obj.
+func renderChartObject(chartPath, template, name string, values interface{}, obj client.Object) {
+	GinkgoHelper()
+
+	renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.32.0"})
+	release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join(charts.InternalChartsPath, chartPath), "release", namespace, values)
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest, ok := release.Files()[filepath.Join(release.ChartName, "templates", template)][name]
+	Expect(ok).To(BeTrue(), "object %s is not rendered", name)
+	Expect(yaml.Unmarshal([]byte(manifest), obj)).To(Succeed())
+}
@@ -7,19 +7,29 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azurevalidation "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
@@ -46,41 +56,64 @@ func NewActuator(mgr manager.Manager) dnsrecord.Actuator {
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	// Invalid wildcard names are a configuration issue which is not resolved by retrying.
+	if err := azureclient.ValidateRecordSetName(dns.Spec.Name); err != nil {
+		return v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if dns.Spec.RecordType == azuretypes.DNSRecordTypeNS {
+		if errs := validateNameServers(dns.Spec.Values, field.NewPath("spec", "values")); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
+	}
+
+	config, secretRef, err := dnsRecordConfig(dns)
+	if err != nil {
+		return err
+	}
+
+	if isPrivateZone(config) && dns.Spec.RecordType == azuretypes.DNSRecordTypeNS {
+		return fmt.Errorf("NS records are not supported in private DNS zones, subdomains can only be delegated in public DNS zones")
+	}
+
 	clientFactory, err := DefaultAzureClientFactoryFunc(
 		ctx,
 		a.client,
-		dns.Spec.SecretRef,
+		secretRef,
 		true,
 	)
 	if err != nil {
 		return err
 	}
-	// Create Azure DNS zone and recordset clients
-	dnsZoneClient, err := clientFactory.DNSZone()
-	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %+v", err), helper.KnownCodes)
-	}
-	dnsRecordSetClient, err := clientFactory.DNSRecordSet()
-	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %+v", err), helper.KnownCodes)
-	}
 
 	// Determine DNS zone ID
-	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
+	zone, dnsRecordSetClient, err := a.getZoneAndRecordSetClient(ctx, log, dns, config, secretRef, clientFactory)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return err
+	}
+	if isApexNSRecord(dns, zone) {
+		return fmt.Errorf("the NS records of the apex of DNS zone %s are managed by Azure and cannot be modified, only subdomains can be delegated", zone)
 	}
 
 	// Create or update DNS recordset
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
 	log.Info("Creating or updating DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-	if err := dnsRecordSetClient.CreateOrUpdate(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+	if err := dnsRecordSetClient.CreateOrUpdate(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl, config.Tags); err != nil {
 		return &reconcilerutils.RequeueAfterError{
 			Cause:        fmt.Errorf("could not create or update DNS recordset in zone %s with name %s, type %s, and values %v: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
 			RequeueAfter: requeueAfterOnProviderError,
 		}
 	}
 
+	if ptr.Deref(config.TagZone, false) && len(config.Tags) > 0 {
+		log.Info("Adding tags to DNS zone", "zone", zone, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := addZoneTags(ctx, config, zone, clientFactory); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not add tags to DNS zone %s: %+v", zone, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	}
+
 	// Update resource status
 	patch := k8sclient.MergeFrom(dns.DeepCopy())
 	dns.Status.Zone = &zone
@@ -89,30 +122,36 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	config, secretRef, err := dnsRecordConfig(dns)
+	if err != nil {
+		return err
+	}
+
+	if isPrivateZone(config) && dns.Spec.RecordType == azuretypes.DNSRecordTypeNS {
+		// NS records have never been reconciled in private DNS zones, hence there is nothing to delete.
+		log.Info("Skipping deletion of the NS recordset in the private DNS zone", "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		return nil
+	}
+
 	clientFactory, err := DefaultAzureClientFactoryFunc(
 		ctx,
 		a.client,
-		dns.Spec.SecretRef,
+		secretRef,
 		true,
 	)
 	if err != nil {
 		return err
 	}
 
-	// Create Azure DNS zone and recordset clients
-	dnsZoneClient, err := clientFactory.DNSZone()
-	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %+v", err), helper.KnownCodes)
-	}
-	dnsRecordSetClient, err := clientFactory.DNSRecordSet()
-	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %+v", err), helper.KnownCodes)
-	}
-
 	// Determine DNS zone ID
-	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
+	zone, dnsRecordSetClient, err := a.getZoneAndRecordSetClient(ctx, log, dns, config, secretRef, clientFactory)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return err
+	}
+	if isApexNSRecord(dns, zone) {
+		// The apex NS records have never been reconciled, they must not be deleted along with the DNSRecord.
+		log.Info("Skipping deletion of the NS recordset of the apex of the DNS zone", "zone", zone, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		return nil
 	}
 
 	// Delete DNS recordset
@@ -142,7 +181,119 @@ func (a *actuator) Migrate(_ context.Context, _ logr.Logger, _ *extensionsv1alph
 	return nil
 }
 
-func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsZoneClient azureclient.DNSZone) (string, error) {
+// dnsRecordConfig decodes and validates the provider config of the given DNSRecord. It returns the reference to the
+// secret with the credentials used for the DNS operations.
+func dnsRecordConfig(dns *extensionsv1alpha1.DNSRecord) (*azure.DNSRecordConfig, corev1.SecretReference, error) {
+	config, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return nil, corev1.SecretReference{}, fmt.Errorf("could not decode provider config of DNSRecord: %w", err)
+	}
+	if errs := azurevalidation.ValidateDNSRecordConfig(config, dns.Namespace, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, corev1.SecretReference{}, util.DetermineError(errs.ToAggregate(), helper.KnownCodes)
+	}
+
+	secretRef := dns.Spec.SecretRef
+	if config.SecretRef != nil {
+		secretRef = *config.SecretRef
+		if secretRef.Namespace == "" {
+			secretRef.Namespace = dns.Namespace
+		}
+	}
+	return config, secretRef, nil
+}
+
+// validateNameServers validates that the values of an NS record are fully qualified domain names of name servers.
+func validateNameServers(values []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(values) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "NS records must have at least one name server"))
+	}
+	for i, value := range values {
+		allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath.Index(i), value)...)
+	}
+	return allErrs
+}
+
+// isApexNSRecord returns whether the given DNSRecord is an NS record of the apex of the given zone, which is given by
+// its name or by its ID.
+func isApexNSRecord(dns *extensionsv1alpha1.DNSRecord, zone string) bool {
+	zoneName := zone[strings.LastIndex(zone, "/")+1:]
+	return dns.Spec.RecordType == azuretypes.DNSRecordTypeNS && strings.EqualFold(strings.TrimSuffix(dns.Spec.Name, "."), zoneName)
+}
+
+// isPrivateZone returns whether the given config targets a private DNS zone.
+func isPrivateZone(config *azure.DNSRecordConfig) bool {
+	return config.ZoneType != nil && *config.ZoneType == azure.DNSZoneTypePrivate
+}
+
+// getZoneAndRecordSetClient determines the DNS zone of the given DNSRecord and returns it together with the client for
+// the recordsets of the zone. Private DNS zones are only looked up among the private DNS zones of the resource group,
+// hence the records are never set in a public DNS zone with the same name and vice versa.
+func (a *actuator) getZoneAndRecordSetClient(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, config *azure.DNSRecordConfig, secretRef corev1.SecretReference, clientFactory azureclient.Factory) (string, azureclient.DNSRecordSet, error) {
+	if isPrivateZone(config) {
+		privateDNSZoneClient, err := clientFactory.PrivateDNSZones()
+		if err != nil {
+			return "", nil, util.DetermineError(fmt.Errorf("could not create Azure private DNS zone client: %+v", err), helper.KnownCodes)
+		}
+		privateDNSRecordSetClient, err := clientFactory.PrivateDNSRecordSet()
+		if err != nil {
+			return "", nil, util.DetermineError(fmt.Errorf("could not create Azure private DNS recordset client: %+v", err), helper.KnownCodes)
+		}
+		zone, err := a.getZoneInResourceGroup(ctx, log, dns, *config.ResourceGroup, secretRef, privateDNSZoneClient)
+		if err != nil {
+			return "", nil, util.DetermineError(err, helper.KnownCodes)
+		}
+		return zone, privateDNSRecordSetClient, nil
+	}
+
+	// Create Azure DNS zone and recordset clients
+	dnsZoneClient, err := clientFactory.DNSZone()
+	if err != nil {
+		return "", nil, util.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %+v", err), helper.KnownCodes)
+	}
+	dnsRecordSetClient, err := clientFactory.DNSRecordSet()
+	if err != nil {
+		return "", nil, util.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %+v", err), helper.KnownCodes)
+	}
+	zone, err := a.getZone(ctx, log, dns, config, secretRef, dnsZoneClient)
+	if err != nil {
+		return "", nil, util.DetermineError(err, helper.KnownCodes)
+	}
+	return zone, dnsRecordSetClient, nil
+}
+
+// zoneLister lists the public or the private DNS zones of a resource group.
+type zoneLister interface {
+	ListByResourceGroup(context.Context, string) (map[string]string, error)
+}
+
+// zoneTagger adds tags to a public or a private DNS zone.
+type zoneTagger interface {
+	AddTags(context.Context, string, map[string]string) error
+}
+
+// addZoneTags adds the tags of the given config to the public or private DNS zone with the given ID.
+func addZoneTags(ctx context.Context, config *azure.DNSRecordConfig, zone string, clientFactory azureclient.Factory) error {
+	var (
+		tagger zoneTagger
+		err    error
+	)
+	if isPrivateZone(config) {
+		tagger, err = clientFactory.PrivateDNSZones()
+	} else {
+		tagger, err = clientFactory.DNSZone()
+	}
+	if err != nil {
+		return err
+	}
+	return tagger.AddTags(ctx, zone, config.Tags)
+}
+
+func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, config *azure.DNSRecordConfig, secretRef corev1.SecretReference, dnsZoneClient azureclient.DNSZone) (string, error) {
+	if config.ResourceGroup != nil {
+		return a.getZoneInResourceGroup(ctx, log, dns, *config.ResourceGroup, secretRef, dnsZoneClient)
+	}
+
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
 		return *dns.Spec.Zone, nil
@@ -166,3 +317,61 @@ func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensions
 		return zone, nil
 	}
 }
+
+// getZoneInResourceGroup determines the DNS zone in the given resource group. The zones of the resource group are listed
+// until the zone is resolved, so that missing permissions of the DNS credentials are reported clearly. Afterwards, the
+// zone in the status is used as long as it is in the resource group and not changed in the spec.
+func (a *actuator) getZoneInResourceGroup(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, resourceGroup string, secretRef corev1.SecretReference, dnsZoneClient zoneLister) (string, error) {
+	if zone := resolvedZoneInResourceGroup(dns, resourceGroup); zone != "" {
+		return zone, nil
+	}
+
+	zones, err := dnsZoneClient.ListByResourceGroup(ctx, resourceGroup)
+	if err != nil {
+		if azureclient.IsAzureAPIUnauthorized(err) || azureclient.IsAzureAPIForbidden(err) {
+			return "", fmt.Errorf("credentials of secret %s/%s are not authorized to list the DNS zones in resource group %s: %w", secretRef.Namespace, secretRef.Name, resourceGroup, err)
+		}
+		return "", &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not get DNS zones in resource group %s: %+v", resourceGroup, err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
+	log.Info("Got DNS zones", "resourceGroup", resourceGroup, "zones", zones, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+
+	var zone string
+	switch {
+	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
+		zone = *dns.Spec.Zone
+	case dns.Status.Zone != nil && *dns.Status.Zone != "":
+		zone = *dns.Status.Zone
+	default:
+		if zone = dnsrecord.FindZoneForName(zones, dns.Spec.Name); zone == "" {
+			return "", fmt.Errorf("could not find DNS zone for name %s in resource group %s", dns.Spec.Name, resourceGroup)
+		}
+		return zone, nil
+	}
+
+	// The zone might be given by its name only, or by its ID including the resource group.
+	zoneName := zone[strings.LastIndex(zone, "/")+1:]
+	if id, ok := zones[zoneName]; ok && (zone == zoneName || strings.EqualFold(zone, id)) {
+		return id, nil
+	}
+	return "", fmt.Errorf("DNS zone %s is not visible in resource group %s with the credentials of secret %s/%s", zone, resourceGroup, secretRef.Namespace, secretRef.Name)
+}
+
+// resolvedZoneInResourceGroup returns the ID of the DNS zone in the status of the given DNSRecord if it is in the given
+// resource group and the spec either does not set a zone or sets the same zone by its name or by its ID.
+func resolvedZoneInResourceGroup(dns *extensionsv1alpha1.DNSRecord, resourceGroup string) string {
+	if dns.Status.Zone == nil {
+		return ""
+	}
+	zone := *dns.Status.Zone
+	zoneResourceGroup, zoneName, ok := strings.Cut(zone, "/")
+	if !ok || !strings.EqualFold(zoneResourceGroup, resourceGroup) {
+		return ""
+	}
+	if specZone := ptr.Deref(dns.Spec.Zone, ""); specZone != "" && specZone != zoneName && !strings.EqualFold(specZone, zone) {
+		return ""
+	}
+	return zone
+}
@@ -6,8 +6,13 @@ package dnsrecord_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
@@ -17,6 +22,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -50,6 +56,7 @@ var _ = Describe("Actuator", func() {
 		a                       dnsrecord.Actuator
 		dns                     *extensionsv1alpha1.DNSRecord
 		zones                   map[string]string
+		usedSecretRef           corev1.SecretReference
 		defaultFactory          = DefaultAzureClientFactoryFunc
 	)
 
@@ -64,7 +71,9 @@ var _ = Describe("Actuator", func() {
 
 		c.EXPECT().Status().Return(sw).AnyTimes()
 
-		DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, _ ...azclient.AzureFactoryOption) (azclient.Factory, error) {
+		usedSecretRef = corev1.SecretReference{}
+		DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, secretRef corev1.SecretReference, _ bool, _ ...azclient.AzureFactoryOption) (azclient.Factory, error) {
+			usedSecretRef = secretRef
 			return azureClientFactory, nil
 		}
 
@@ -113,7 +122,7 @@ var _ = Describe("Actuator", func() {
 			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
 			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
 			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
-			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
 			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
 				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
 					Expect(obj.Status).To(Equal(extensionsv1alpha1.DNSRecordStatus{
@@ -125,6 +134,419 @@ var _ = Describe("Actuator", func() {
 
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(usedSecretRef).To(Equal(dns.Spec.SecretRef))
+		})
+
+		Context("with a dedicated DNS resource group", func() {
+			const dnsResourceGroup = "dns-rg"
+
+			BeforeEach(func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+					"kind": "DNSRecordConfig",
+					"resourceGroup": "` + dnsResourceGroup + `",
+					"secretRef": {"name": "dns-credentials"}
+				}`)}
+				zones = map[string]string{
+					shootDomain: dnsResourceGroup + "/" + shootDomain,
+				}
+			})
+
+			It("should reconcile the DNSRecord with the dedicated credentials and resource group", func() {
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, dnsResourceGroup+"/"+shootDomain, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.Status.Zone).To(Equal(ptr.To(dnsResourceGroup + "/" + shootDomain)))
+						return nil
+					},
+				)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+				Expect(usedSecretRef).To(Equal(corev1.SecretReference{Name: "dns-credentials", Namespace: namespace}))
+			})
+
+			It("should resolve a zone given by its name in the resource group", func() {
+				dns.Spec.Zone = ptr.To(shootDomain)
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, dnsResourceGroup+"/"+shootDomain, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should set the tags on the recordset without tagging the zone", func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+					"kind": "DNSRecordConfig",
+					"resourceGroup": "` + dnsResourceGroup + `",
+					"tags": {"cost-center": "1234"}
+				}`)}
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, dnsResourceGroup+"/"+shootDomain, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), map[string]string{"cost-center": "1234"}).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should add the tags to the zone if configured", func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+					"kind": "DNSRecordConfig",
+					"resourceGroup": "` + dnsResourceGroup + `",
+					"tags": {"cost-center": "1234"},
+					"tagZone": true
+				}`)}
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).Times(2)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, dnsResourceGroup+"/"+shootDomain, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), map[string]string{"cost-center": "1234"}).Return(nil)
+				azureDNSZoneClient.EXPECT().AddTags(ctx, dnsResourceGroup+"/"+shootDomain, map[string]string{"cost-center": "1234"}).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should not list the zones again once the zone is resolved", func() {
+				dns.Spec.Zone = ptr.To(shootDomain)
+				dns.Status.Zone = ptr.To(dnsResourceGroup + "/" + shootDomain)
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, dnsResourceGroup+"/"+shootDomain, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should list the zones again if the zone was resolved in another resource group", func() {
+				dns.Status.Zone = ptr.To("other-rg/" + shootDomain)
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+
+				err := a.Reconcile(ctx, logger, dns, nil)
+				Expect(err).To(MatchError(ContainSubstring("is not visible in resource group " + dnsResourceGroup)))
+			})
+
+			It("should reject dedicated credentials in another namespace", func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+					"kind": "DNSRecordConfig",
+					"secretRef": {"name": "dns-credentials", "namespace": "other"}
+				}`)}
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("spec.providerConfig.secretRef.namespace")))
+				Expect(usedSecretRef).To(BeZero())
+			})
+
+			It("should fail if the zone is not in the resource group", func() {
+				dns.Spec.Zone = ptr.To("other-rg/" + shootDomain)
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+
+				err := a.Reconcile(ctx, logger, dns, nil)
+				Expect(err).To(MatchError(ContainSubstring("is not visible in resource group " + dnsResourceGroup)))
+			})
+
+			It("should return a clear error if the credentials cannot list the zones", func() {
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+				azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden, ErrorCode: "AuthorizationFailed"})
+
+				err := a.Reconcile(ctx, logger, dns, nil)
+				Expect(err).To(MatchError(ContainSubstring("credentials of secret " + namespace + "/dns-credentials are not authorized to list the DNS zones in resource group " + dnsResourceGroup)))
+			})
+
+			It("should reject an invalid provider config", func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+					"kind": "DNSRecordConfig",
+					"resourceGroup": "invalid resource group!"
+				}`)}
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("spec.providerConfig.resourceGroup")))
+			})
+		})
+	})
+
+	Describe("wildcard records", func() {
+		const wildcardName = "*.apps." + shootDomain
+
+		BeforeEach(func() {
+			dns.Spec.Name = wildcardName
+		})
+
+		DescribeTable("should create, update and delete the wildcard record",
+			func(recordType extensionsv1alpha1.DNSRecordType, values, updatedValues []string) {
+				dns.Spec.RecordType = recordType
+				dns.Spec.Values = values
+
+				azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).Times(3)
+				azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).Times(3)
+				azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.Status.Zone).To(Equal(ptr.To(zone)))
+						return nil
+					},
+				).Times(2)
+
+				gomock.InOrder(
+					azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, wildcardName, string(recordType), values, int64(120), nil).Return(nil),
+					azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, wildcardName, string(recordType), updatedValues, int64(120), nil).Return(nil),
+					azureDNSRecordSetClient.EXPECT().Delete(ctx, zone, wildcardName, string(recordType)).Return(nil),
+				)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+				// the zone is remembered in the status, hence it is not looked up again.
+				dns.Status.Zone = ptr.To(zone)
+				dns.Spec.Values = updatedValues
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+				Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+			},
+			Entry("A record", extensionsv1alpha1.DNSRecordTypeA, []string{address}, []string{address, "5.6.7.8"}),
+			Entry("CNAME record", extensionsv1alpha1.DNSRecordTypeCNAME, []string{"ingress.example.com"}, []string{"ingress2.example.com"}),
+			Entry("TXT record", extensionsv1alpha1.DNSRecordTypeTXT, []string{"foo"}, []string{"bar"}),
+		)
+
+		It("should reject a wildcard which is not the leftmost label", func() {
+			dns.Spec.Name = "apps.*." + shootDomain
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("a wildcard is only allowed as the leftmost label")))
+			var coder gardencorev1beta1helper.Coder
+			Expect(errors.As(err, &coder)).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+	})
+
+	Describe("delegation records", func() {
+		const (
+			subdomain = "sub." + shootDomain
+			zoneID    = "dns-rg/" + shootDomain
+		)
+
+		var nameServers = []string{"ns1-01.azure-dns.com.", "ns2-01.azure-dns.net."}
+
+		BeforeEach(func() {
+			dns.Spec.Name = subdomain
+			dns.Spec.RecordType = azure.DNSRecordTypeNS
+			dns.Spec.Values = nameServers
+			zones = map[string]string{
+				shootDomain: zoneID,
+			}
+		})
+
+		It("should create and update the delegation of the subdomain", func() {
+			updatedNameServers := append([]string{"ns3-01.azure-dns.org."}, nameServers...)
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).Times(2)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).Times(2)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(obj.Status.Zone).To(Equal(ptr.To(zoneID)))
+					return nil
+				},
+			).Times(2)
+
+			gomock.InOrder(
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, subdomain, "NS", nameServers, int64(120), nil).Return(nil),
+				azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, subdomain, "NS", updatedNameServers, int64(120), nil).Return(nil),
+			)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+			dns.Status.Zone = ptr.To(zoneID)
+			dns.Spec.Values = updatedNameServers
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should reject name servers which are no fully qualified domain names", func() {
+			dns.Spec.Values = []string{"ns1-01.azure-dns.com", "ns1-01.azure-dns.com.", "ns"}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.values[2]")))
+			Expect(err).NotTo(MatchError(ContainSubstring("spec.values[0]")))
+		})
+
+		It("should reject a delegation without name servers", func() {
+			dns.Spec.Values = nil
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("NS records must have at least one name server")))
+		})
+
+		It("should not modify the NS records of the apex of the zone", func() {
+			dns.Spec.Name = shootDomain
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("the NS records of the apex of DNS zone " + zoneID + " are managed by Azure")))
+		})
+
+		It("should delete the delegation of the subdomain but not the NS records of the apex of the zone", func() {
+			dns.Status.Zone = ptr.To(zoneID)
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).Times(2)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).Times(2)
+			azureDNSRecordSetClient.EXPECT().Delete(ctx, zoneID, subdomain, "NS").Return(nil)
+
+			Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+
+			dns.Spec.Name = shootDomain
+			Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+		})
+	})
+
+	Describe("split-horizon zones", func() {
+		const (
+			dnsResourceGroup = "dns-rg"
+			zoneID           = dnsResourceGroup + "/" + shootDomain
+			privateAddress   = "10.250.0.10"
+		)
+
+		var (
+			azurePrivateDNSZoneClient      *mockazureclient.MockPrivateDNSZones
+			azurePrivateDNSRecordSetClient *mockazureclient.MockDNSRecordSet
+		)
+
+		BeforeEach(func() {
+			azurePrivateDNSZoneClient = mockazureclient.NewMockPrivateDNSZones(ctrl)
+			azurePrivateDNSRecordSetClient = mockazureclient.NewMockDNSRecordSet(ctrl)
+			zones = map[string]string{
+				shootDomain: zoneID,
+			}
+		})
+
+		withZoneType := func(zoneType string) {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+				"kind": "DNSRecordConfig",
+				"resourceGroup": "` + dnsResourceGroup + `",
+				"zoneType": "` + zoneType + `"
+			}`)}
+		}
+
+		It("should only set the record in the private zone", func() {
+			withZoneType("Private")
+			dns.Spec.Values = []string{privateAddress}
+
+			azureClientFactory.EXPECT().PrivateDNSZones().Return(azurePrivateDNSZoneClient, nil)
+			azureClientFactory.EXPECT().PrivateDNSRecordSet().Return(azurePrivateDNSRecordSetClient, nil)
+			azurePrivateDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+			azurePrivateDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{privateAddress}, int64(120), nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(obj.Status.Zone).To(Equal(ptr.To(zoneID)))
+					return nil
+				},
+			)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should add the tags to the private zone if configured", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+				"kind": "DNSRecordConfig",
+				"resourceGroup": "` + dnsResourceGroup + `",
+				"zoneType": "Private",
+				"tags": {"cost-center": "1234"},
+				"tagZone": true
+			}`)}
+			dns.Spec.Values = []string{privateAddress}
+
+			azureClientFactory.EXPECT().PrivateDNSZones().Return(azurePrivateDNSZoneClient, nil).Times(2)
+			azureClientFactory.EXPECT().PrivateDNSRecordSet().Return(azurePrivateDNSRecordSetClient, nil)
+			azurePrivateDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+			azurePrivateDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{privateAddress}, int64(120), map[string]string{"cost-center": "1234"}).Return(nil)
+			azurePrivateDNSZoneClient.EXPECT().AddTags(ctx, zoneID, map[string]string{"cost-center": "1234"}).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should only set the record in the public zone", func() {
+			withZoneType("Public")
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should resolve the private zone given by its name", func() {
+			withZoneType("Private")
+			dns.Spec.Zone = ptr.To(shootDomain)
+
+			azureClientFactory.EXPECT().PrivateDNSZones().Return(azurePrivateDNSZoneClient, nil)
+			azureClientFactory.EXPECT().PrivateDNSRecordSet().Return(azurePrivateDNSRecordSetClient, nil)
+			azurePrivateDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(zones, nil)
+			azurePrivateDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zoneID, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should fail if the private zone does not exist, even if a public zone with the same name exists", func() {
+			withZoneType("Private")
+			dns.Spec.Zone = ptr.To(zoneID)
+
+			azureClientFactory.EXPECT().PrivateDNSZones().Return(azurePrivateDNSZoneClient, nil)
+			azureClientFactory.EXPECT().PrivateDNSRecordSet().Return(azurePrivateDNSRecordSetClient, nil)
+			azurePrivateDNSZoneClient.EXPECT().ListByResourceGroup(ctx, dnsResourceGroup).Return(map[string]string{}, nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("DNS zone " + zoneID + " is not visible in resource group " + dnsResourceGroup)))
+		})
+
+		It("should reject NS records in the private zone", func() {
+			withZoneType("Private")
+			dns.Spec.Name = "sub." + shootDomain
+			dns.Spec.RecordType = azure.DNSRecordTypeNS
+			dns.Spec.Values = []string{"ns1-01.azure-dns.com."}
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("NS records are not supported in private DNS zones")))
+		})
+
+		It("should require the resource group of the private zone", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+				"kind": "DNSRecordConfig",
+				"zoneType": "Private"
+			}`)}
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(MatchError(ContainSubstring("spec.providerConfig.resourceGroup")))
+		})
+
+		It("should only delete the record in the private zone", func() {
+			withZoneType("Private")
+			dns.Status.Zone = ptr.To(zoneID)
+
+			azureClientFactory.EXPECT().PrivateDNSZones().Return(azurePrivateDNSZoneClient, nil)
+			azureClientFactory.EXPECT().PrivateDNSRecordSet().Return(azurePrivateDNSRecordSetClient, nil)
+			azurePrivateDNSRecordSetClient.EXPECT().Delete(ctx, zoneID, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
+
+			Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
 		})
 	})
 
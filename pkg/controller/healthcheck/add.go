@@ -92,18 +92,31 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 		mgr,
 		opts,
 		nil,
-		[]healthcheck.ConditionTypeToHealthCheck{{
-			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
-			HealthCheck:   worker.NewNodesChecker(),
-			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
-				return util.DetermineErrorCodes(err, helper.KnownCodes)
+		[]healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
+				HealthCheck:   worker.NewNodesChecker(),
+				ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
+					return util.DetermineErrorCodes(err, helper.KnownCodes)
+				},
 			},
-		}},
+			{
+				ConditionType: ConditionTypeQuotaHeadroom,
+				HealthCheck:   NewQuotaHealthChecker(DefaultQuotaHeadroomThreshold),
+			},
+			{
+				ConditionType: ConditionTypeMachineImageVersions,
+				HealthCheck:   NewMachineImageVersionsHealthChecker(),
+			},
+		},
 		sets.New(gardencorev1beta1.ShootControlPlaneHealthy),
 	)
 }
 
 // AddToManager adds a controller with the default Options.
 func AddToManager(ctx context.Context, mgr manager.Manager) error {
-	return RegisterHealthChecks(ctx, mgr, DefaultAddOptions)
+	if err := RegisterHealthChecks(ctx, mgr, DefaultAddOptions); err != nil {
+		return err
+	}
+	return AddQuotaMetricsToManager(mgr, DefaultQuotaMetricsOptions)
 }
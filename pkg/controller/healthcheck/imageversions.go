@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypeMachineImageVersions is the type of the Worker condition which warns when the worker pools use
+	// gallery image versions which are excluded from the latest version of their image, reached their end of life or
+	// were deleted from their gallery. It does not contribute to the health of the Shoot.
+	ConditionTypeMachineImageVersions = "MachineImageVersions"
+
+	// ImageVersionCacheTTL is the duration for which the lifecycle of a gallery image version is cached.
+	ImageVersionCacheTTL = time.Hour
+
+	galleryImageVersionResourceType = "Microsoft.Compute/galleries/images/versions"
+	sharedGalleriesPrefix           = "SharedGalleries"
+	communityGalleriesPrefix        = "CommunityGalleries"
+)
+
+// DefaultAzureGalleryImageVersionsClientFunc is the default function for creating the Azure gallery image versions
+// client of the machine image versions health check. It can be overridden for tests.
+var DefaultAzureGalleryImageVersionsClientFunc = func(auth *azureclient.ClientAuth, options ...azureclient.AzureFactoryOption) (azureclient.GalleryImageVersions, error) {
+	factory, err := azureclient.NewAzureClientFactory(auth, options...)
+	if err != nil {
+		return nil, err
+	}
+	return factory.GalleryImageVersions()
+}
+
+// galleryImageVersionLifecycle are the lifecycle properties which the different kinds of gallery image versions share.
+type galleryImageVersionLifecycle struct {
+	excludeFromLatest *bool
+	endOfLifeDate     *time.Time
+}
+
+// GalleryImageVersionDeprecation returns why the given image should no longer be used, or an empty string if it can be
+// used or is not a gallery image version. A gallery image version is deprecated if it is excluded from the latest
+// version of its image or reached its end of life, and it cannot be used at all anymore once it was deleted.
+func GalleryImageVersionDeprecation(ctx context.Context, c azureclient.GalleryImageVersions, now time.Time, location string, image api.Image) (string, error) {
+	lifecycle, found, err := getGalleryImageVersionLifecycle(ctx, c, location, image)
+	if err != nil || lifecycle == nil && found {
+		return "", err
+	}
+
+	switch {
+	case !found:
+		return "does not exist in its gallery anymore", nil
+	case ptr.Deref(lifecycle.excludeFromLatest, false):
+		return "is excluded from the latest version of its image", nil
+	case lifecycle.endOfLifeDate != nil && !now.Before(*lifecycle.endOfLifeDate):
+		return fmt.Sprintf("reached its end of life on %s", lifecycle.endOfLifeDate.UTC().Format(time.RFC3339)), nil
+	default:
+		return "", nil
+	}
+}
+
+// getGalleryImageVersionLifecycle returns the lifecycle properties of the given image and whether the image version
+// exists. The returned lifecycle is nil for images which are no gallery image versions.
+func getGalleryImageVersionLifecycle(ctx context.Context, c azureclient.GalleryImageVersions, location string, image api.Image) (*galleryImageVersionLifecycle, bool, error) {
+	switch {
+	case image.ID != nil:
+		id, err := arm.ParseResourceID(*image.ID)
+		if err != nil || !strings.EqualFold(id.ResourceType.String(), galleryImageVersionResourceType) {
+			// managed images or malformed IDs have no lifecycle.
+			return nil, true, nil
+		}
+		version, err := c.Get(ctx, id.SubscriptionID, id.ResourceGroupName, id.Parent.Parent.Name, id.Parent.Name, id.Name)
+		if err != nil || version == nil {
+			return nil, false, err
+		}
+		lifecycle := &galleryImageVersionLifecycle{}
+		if version.Properties != nil && version.Properties.PublishingProfile != nil {
+			lifecycle.excludeFromLatest = version.Properties.PublishingProfile.ExcludeFromLatest
+			lifecycle.endOfLifeDate = version.Properties.PublishingProfile.EndOfLifeDate
+		}
+		return lifecycle, true, nil
+
+	case image.SharedGalleryImageID != nil:
+		gallery, name, versionName, ok := parseGalleryImageVersionID(sharedGalleriesPrefix, *image.SharedGalleryImageID)
+		if !ok {
+			return nil, true, nil
+		}
+		version, err := c.GetShared(ctx, location, gallery, name, versionName)
+		if err != nil || version == nil {
+			return nil, false, err
+		}
+		lifecycle := &galleryImageVersionLifecycle{}
+		if version.Properties != nil {
+			lifecycle.excludeFromLatest = version.Properties.ExcludeFromLatest
+			lifecycle.endOfLifeDate = version.Properties.EndOfLifeDate
+		}
+		return lifecycle, true, nil
+
+	case image.CommunityGalleryImageID != nil:
+		gallery, name, versionName, ok := parseGalleryImageVersionID(communityGalleriesPrefix, *image.CommunityGalleryImageID)
+		if !ok {
+			return nil, true, nil
+		}
+		version, err := c.GetCommunity(ctx, location, gallery, name, versionName)
+		if err != nil || version == nil {
+			return nil, false, err
+		}
+		lifecycle := &galleryImageVersionLifecycle{}
+		if version.Properties != nil {
+			lifecycle.excludeFromLatest = version.Properties.ExcludeFromLatest
+			lifecycle.endOfLifeDate = version.Properties.EndOfLifeDate
+		}
+		return lifecycle, true, nil
+	}
+
+	return nil, true, nil
+}
+
+// parseGalleryImageVersionID parses IDs of the format '/<prefix>/<gallery>/Images/<image>/Versions/<version>' of
+// shared and community gallery image versions.
+func parseGalleryImageVersionID(prefix, id string) (string, string, string, bool) {
+	parts := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(parts) != 6 || !strings.EqualFold(parts[0], prefix) || !strings.EqualFold(parts[2], "Images") || !strings.EqualFold(parts[4], "Versions") {
+		return "", "", "", false
+	}
+	return parts[1], parts[3], parts[5], true
+}
+
+// MachineImageVersionsHealthChecker warns about gallery image versions used by the worker pools of a Worker which are
+// deprecated in their gallery, so that they are updated before the image versions are deleted.
+type MachineImageVersionsHealthChecker struct {
+	logger     logr.Logger
+	seedClient client.Client
+	clock      clock.Clock
+	cache      *imageVersionCache
+}
+
+// NewMachineImageVersionsHealthChecker creates a health check which warns when the worker pools use deprecated gallery
+// image versions.
+func NewMachineImageVersionsHealthChecker() healthcheck.HealthCheck {
+	return &MachineImageVersionsHealthChecker{
+		clock: clock.RealClock{},
+		cache: &imageVersionCache{deprecations: map[string]cachedImageVersionDeprecation{}},
+	}
+}
+
+// InjectSeedClient injects the seed client.
+func (m *MachineImageVersionsHealthChecker) InjectSeedClient(seedClient client.Client) {
+	m.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (m *MachineImageVersionsHealthChecker) SetLoggerSuffix(provider, extension string) {
+	m.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-machine-image-versions", provider, extension))
+}
+
+// DeepCopy clones the health check. Like the generic health checks, it does not perform a *deep* copy, hence the
+// copies share the cache.
+func (m *MachineImageVersionsHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *m
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (m *MachineImageVersionsHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := m.seedClient.Get(ctx, request, worker); err != nil {
+		return nil, fmt.Errorf("failed to get worker %q: %w", request, err)
+	}
+
+	workerStatus, err := helper.WorkerStatusFromWorker(worker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the provider status of worker %q: %w", request, err)
+	}
+
+	var (
+		descriptions []string
+		newClient    = m.galleryImageVersionsClientFunc(ctx, worker)
+	)
+	for _, image := range workerStatus.MachineImages {
+		deprecation, err := m.cache.get(ctx, m.clock, worker.Spec.Region, image.Image, newClient)
+		if err != nil {
+			m.logger.Error(err, "Health check failed")
+			return nil, err
+		}
+		if deprecation != "" {
+			descriptions = append(descriptions, fmt.Sprintf("version %s of image %s %s", image.Version, image.Name, deprecation))
+		}
+	}
+
+	if len(descriptions) == 0 {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	slices.Sort(descriptions)
+	descriptions = slices.Compact(descriptions)
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: fmt.Sprintf("The gallery image versions of the worker pools are deprecated: %s. Update the machine image versions before the image versions are deleted.",
+			strings.Join(descriptions, "; ")),
+	}, nil
+}
+
+// galleryImageVersionsClientFunc returns a function which creates a gallery image versions client with the credentials
+// of the given Worker. The client is only created once it is needed, i.e. if an image is not cached.
+func (m *MachineImageVersionsHealthChecker) galleryImageVersionsClientFunc(ctx context.Context, worker *extensionsv1alpha1.Worker) func() (azureclient.GalleryImageVersions, error) {
+	var c azureclient.GalleryImageVersions
+	return func() (azureclient.GalleryImageVersions, error) {
+		if c != nil {
+			return c, nil
+		}
+		auth, options, err := clientAuthOfWorker(ctx, m.seedClient, worker)
+		if err != nil {
+			return nil, err
+		}
+		if c, err = DefaultAzureGalleryImageVersionsClientFunc(auth, options...); err != nil {
+			return nil, fmt.Errorf("could not create Azure gallery image versions client: %w", err)
+		}
+		return c, nil
+	}
+}
+
+type cachedImageVersionDeprecation struct {
+	deprecation string
+	expiresAt   time.Time
+}
+
+// imageVersionCache caches the deprecations of gallery image versions per location, since gallery image versions are
+// shared by many shoots and their lifecycle changes rarely.
+type imageVersionCache struct {
+	mutex        sync.Mutex
+	deprecations map[string]cachedImageVersionDeprecation
+}
+
+func (i *imageVersionCache) get(ctx context.Context, clock clock.Clock, location string, image api.Image, newClient func() (azureclient.GalleryImageVersions, error)) (string, error) {
+	key := strings.ToLower(location + "|" + ptr.Deref(image.ID, "") + "|" + ptr.Deref(image.SharedGalleryImageID, "") + "|" + ptr.Deref(image.CommunityGalleryImageID, ""))
+	if image.ID == nil && image.SharedGalleryImageID == nil && image.CommunityGalleryImageID == nil {
+		return "", nil
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	now := clock.Now()
+	if cached, ok := i.deprecations[key]; ok && now.Before(cached.expiresAt) {
+		return cached.deprecation, nil
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return "", err
+	}
+	deprecation, err := GalleryImageVersionDeprecation(ctx, c, now, location, image)
+	if err != nil {
+		return "", err
+	}
+	i.deprecations[key] = cachedImageVersionDeprecation{deprecation: deprecation, expiresAt: now.Add(ImageVersionCacheTTL)}
+	return deprecation, nil
+}
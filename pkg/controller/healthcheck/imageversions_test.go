@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
+)
+
+var _ = Describe("MachineImageVersions", func() {
+	const (
+		location  = "westeurope"
+		galleryID = "/subscriptions/gallery-subscription/resourceGroups/images/providers/Microsoft.Compute/galleries/gardener/images/gardenlinux/versions/1.2.3"
+	)
+
+	Describe("#GalleryImageVersionDeprecation", func() {
+		var (
+			ctx    context.Context
+			ctrl   *gomock.Controller
+			client *mockazureclient.MockGalleryImageVersions
+			now    time.Time
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			ctrl = gomock.NewController(GinkgoT())
+			client = mockazureclient.NewMockGalleryImageVersions(ctrl)
+			now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should detect a gallery image version which is excluded from the latest version", func() {
+			client.EXPECT().Get(ctx, "gallery-subscription", "images", "gardener", "gardenlinux", "1.2.3").Return(&armcompute.GalleryImageVersion{
+				Properties: &armcompute.GalleryImageVersionProperties{
+					PublishingProfile: &armcompute.GalleryImageVersionPublishingProfile{ExcludeFromLatest: ptr.To(true)},
+				},
+			}, nil)
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{ID: ptr.To(galleryID)})).To(Equal("is excluded from the latest version of its image"))
+		})
+
+		It("should detect a gallery image version which reached its end of life", func() {
+			client.EXPECT().Get(ctx, "gallery-subscription", "images", "gardener", "gardenlinux", "1.2.3").Return(&armcompute.GalleryImageVersion{
+				Properties: &armcompute.GalleryImageVersionProperties{
+					PublishingProfile: &armcompute.GalleryImageVersionPublishingProfile{EndOfLifeDate: ptr.To(now.Add(-time.Hour))},
+				},
+			}, nil)
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{ID: ptr.To(galleryID)})).To(Equal("reached its end of life on 2024-01-01T11:00:00Z"))
+		})
+
+		It("should not report a gallery image version before its end of life", func() {
+			client.EXPECT().Get(ctx, "gallery-subscription", "images", "gardener", "gardenlinux", "1.2.3").Return(&armcompute.GalleryImageVersion{
+				Properties: &armcompute.GalleryImageVersionProperties{
+					PublishingProfile: &armcompute.GalleryImageVersionPublishingProfile{
+						ExcludeFromLatest: ptr.To(false),
+						EndOfLifeDate:     ptr.To(now.Add(time.Hour)),
+					},
+				},
+			}, nil)
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{ID: ptr.To(galleryID)})).To(BeEmpty())
+		})
+
+		It("should detect a deleted gallery image version", func() {
+			client.EXPECT().Get(ctx, "gallery-subscription", "images", "gardener", "gardenlinux", "1.2.3")
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{ID: ptr.To(galleryID)})).To(Equal("does not exist in its gallery anymore"))
+		})
+
+		It("should detect an excluded shared gallery image version", func() {
+			client.EXPECT().GetShared(ctx, location, "shared-gallery", "gardenlinux", "1.2.3").Return(&armcompute.SharedGalleryImageVersion{
+				Properties: &armcompute.SharedGalleryImageVersionProperties{ExcludeFromLatest: ptr.To(true)},
+			}, nil)
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{
+				SharedGalleryImageID: ptr.To("/SharedGalleries/shared-gallery/Images/gardenlinux/Versions/1.2.3"),
+			})).To(Equal("is excluded from the latest version of its image"))
+		})
+
+		It("should detect an excluded community gallery image version", func() {
+			client.EXPECT().GetCommunity(ctx, location, "community-gallery", "gardenlinux", "1.2.3").Return(&armcompute.CommunityGalleryImageVersion{
+				Properties: &armcompute.CommunityGalleryImageVersionProperties{ExcludeFromLatest: ptr.To(true)},
+			}, nil)
+
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{
+				CommunityGalleryImageID: ptr.To("/CommunityGalleries/community-gallery/Images/gardenlinux/Versions/1.2.3"),
+			})).To(Equal("is excluded from the latest version of its image"))
+		})
+
+		It("should ignore images which are no gallery image versions", func() {
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{URN: ptr.To("sap:gardenlinux:greatest:1.2.3")})).To(BeEmpty())
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{
+				ID: ptr.To("/subscriptions/sub/resourceGroups/images/providers/Microsoft.Compute/images/gardenlinux"),
+			})).To(BeEmpty())
+			Expect(GalleryImageVersionDeprecation(ctx, client, now, location, api.Image{
+				CommunityGalleryImageID: ptr.To("/CommunityGalleries/community-gallery/Images/gardenlinux"),
+			})).To(BeEmpty())
+		})
+	})
+})
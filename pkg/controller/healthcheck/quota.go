@@ -0,0 +1,495 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypeQuotaHeadroom is the type of the Worker condition which warns when the Azure quotas of the
+	// subscription do not leave enough headroom to scale the worker pools to their maximum. It does not contribute to
+	// the health of the Shoot.
+	ConditionTypeQuotaHeadroom = "QuotaHeadroom"
+
+	// DefaultQuotaHeadroomThreshold is the share of a quota limit which must remain available after all worker pools
+	// have been scaled to their maximum.
+	DefaultQuotaHeadroomThreshold = 0.1
+	// QuotaCacheTTL is the duration for which the quotas of a subscription and region are cached.
+	QuotaCacheTTL = 10 * time.Minute
+	// QuotaThrottlingBackoff is the duration for which no quotas are requested after Azure throttled a request without
+	// telling when to retry.
+	QuotaThrottlingBackoff = 5 * time.Minute
+	// DefaultResourceSKUsCacheTTL is the default duration for which the virtual machine SKUs of a subscription and region
+	// are cached.
+	DefaultResourceSKUsCacheTTL = time.Hour
+
+	quotaTotalRegionalVCPUs      = "cores"
+	quotaNetworkInterfaces       = "NetworkInterfaces"
+	quotaPublicIPAddresses       = "PublicIPAddresses"
+	quotaStandardPublicIPAddress = "StandardSkuPublicIpAddresses"
+)
+
+// DefaultAzureUsageClientFunc is the default function for creating the Azure usage client of the quota health check.
+// It can be overridden for tests.
+var DefaultAzureUsageClientFunc = func(auth *azureclient.ClientAuth, options ...azureclient.AzureFactoryOption) (azureclient.Usage, error) {
+	factory, err := azureclient.NewAzureClientFactory(auth, options...)
+	if err != nil {
+		return nil, err
+	}
+	return factory.Usage()
+}
+
+// DefaultResourceSKUsOptions are the default options of the virtual machine SKUs.
+var DefaultResourceSKUsOptions = ResourceSKUsOptions{
+	CacheTTL:               DefaultResourceSKUsCacheTTL,
+	TolerateUnavailability: true,
+}
+
+// ResourceSKUsOptions are the options of the virtual machine SKUs, which determine the vCPU quotas consumed by the
+// machine types.
+type ResourceSKUsOptions struct {
+	// CacheTTL is the duration for which the virtual machine SKUs of a subscription and region are cached.
+	CacheTTL time.Duration
+	// TolerateUnavailability uses the last known virtual machine SKUs, or none, if they cannot be listed, instead of
+	// failing to get the quota snapshot.
+	TolerateUnavailability bool
+}
+
+// Quota is the current usage and the limit of an Azure quota of a subscription in a region.
+type Quota struct {
+	// Name is the name of the quota, e.g. standardDSv5Family.
+	Name string
+	// DisplayName is the localized name of the quota, e.g. Standard DSv5 Family vCPUs.
+	DisplayName string
+	// Current is the current usage of the quota.
+	Current int64
+	// Limit is the limit of the quota.
+	Limit int64
+}
+
+// MachineTypeQuota describes which vCPU quota a machine type consumes.
+type MachineTypeQuota struct {
+	// Family is the name of the vCPU quota of the machine type family, e.g. standardDSv5Family.
+	Family string
+	// VCPUs is the number of vCPUs of the machine type.
+	VCPUs int64
+}
+
+// QuotaSnapshot contains the quotas and machine types of a subscription in a region.
+type QuotaSnapshot struct {
+	// Quotas are the compute and network quotas.
+	Quotas []Quota
+	// MachineTypes maps the machine type names to the vCPU quota they consume.
+	MachineTypes map[string]MachineTypeQuota
+	// MachineTypesError is the error of listing the virtual machine SKUs if their unavailability is tolerated.
+	// MachineTypes then contains the last known machine types, which are empty if the SKUs were never listed.
+	MachineTypesError error
+}
+
+// QuotaDemand returns the quota units which are required to scale all pools of the given Worker to their maximum. The
+// machines of the given fallback zones of the pools count towards their current size. The demand of the quotas which
+// are not consumed by machines, e.g. public IP addresses, is zero.
+func QuotaDemand(worker *extensionsv1alpha1.Worker, fallbackZones []api.WorkerPoolFallbackZones, machineDeployments []machinev1alpha1.MachineDeployment, machineTypes map[string]MachineTypeQuota) map[string]int64 {
+	replicas := make(map[string]int32, len(machineDeployments))
+	for _, deployment := range machineDeployments {
+		replicas[deployment.Name] = deployment.Status.Replicas
+	}
+	fallbackZonesByPool := make(map[string][]string, len(fallbackZones))
+	for _, fallback := range fallbackZones {
+		fallbackZonesByPool[fallback.Name] = fallback.Zones
+	}
+
+	demand := map[string]int64{
+		quotaTotalRegionalVCPUs:      0,
+		quotaNetworkInterfaces:       0,
+		quotaPublicIPAddresses:       0,
+		quotaStandardPublicIPAddress: 0,
+	}
+
+	for _, pool := range worker.Spec.Pools {
+		deploymentName := fmt.Sprintf("%s-%s", worker.Namespace, pool.Name)
+
+		var current int32
+		if len(pool.Zones) == 0 {
+			current = replicas[deploymentName]
+		}
+		for _, zone := range append(slices.Clone(pool.Zones), fallbackZonesByPool[pool.Name]...) {
+			current += replicas[fmt.Sprintf("%s-z%s", deploymentName, zone)]
+		}
+
+		additional := int64(max(pool.Maximum-current, 0))
+		demand[quotaNetworkInterfaces] += additional
+
+		machineType, ok := machineTypes[pool.MachineType]
+		if !ok {
+			continue
+		}
+		demand[quotaTotalRegionalVCPUs] += additional * machineType.VCPUs
+		if machineType.Family != "" {
+			demand[machineType.Family] += additional * machineType.VCPUs
+		}
+	}
+
+	return demand
+}
+
+// QuotasApproachingLimit returns a description of each quota with a demand whose remaining headroom after fulfilling the
+// demand falls below the given share of its limit.
+func QuotasApproachingLimit(quotas []Quota, demand map[string]int64, threshold float64) []string {
+	var descriptions []string
+
+	for _, quota := range quotas {
+		required, ok := demand[quota.Name]
+		if !ok || quota.Limit <= 0 {
+			continue
+		}
+
+		headroom := quota.Limit - quota.Current - required
+		if float64(headroom) >= threshold*float64(quota.Limit) {
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%q uses %d of %d and requires %d more to scale the worker pools to their maximum",
+			quota.DisplayName, quota.Current, quota.Limit, required))
+	}
+
+	slices.Sort(descriptions)
+	return descriptions
+}
+
+// QuotaHealthChecker warns about Azure quotas which do not leave enough headroom to scale the worker pools of a Worker
+// to their maximum.
+type QuotaHealthChecker struct {
+	logger     logr.Logger
+	seedClient client.Client
+	clock      clock.Clock
+	threshold  float64
+}
+
+// NewQuotaHealthChecker creates a health check which warns when less than the given share of a quota limit remains
+// available after all worker pools have been scaled to their maximum.
+func NewQuotaHealthChecker(threshold float64) healthcheck.HealthCheck {
+	return &QuotaHealthChecker{
+		clock:     clock.RealClock{},
+		threshold: threshold,
+	}
+}
+
+// InjectSeedClient injects the seed client.
+func (q *QuotaHealthChecker) InjectSeedClient(seedClient client.Client) {
+	q.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (q *QuotaHealthChecker) SetLoggerSuffix(provider, extension string) {
+	q.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-quota", provider, extension))
+}
+
+// DeepCopy clones the health check. Like the generic health checks, it does not perform a *deep* copy.
+func (q *QuotaHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *q
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (q *QuotaHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := q.seedClient.Get(ctx, request, worker); err != nil {
+		return nil, fmt.Errorf("failed to get worker %q: %w", request, err)
+	}
+
+	snapshot, err := q.quotaSnapshot(ctx, worker)
+	if err != nil {
+		if azureclient.IsAzureAPIForbidden(err) {
+			// missing permissions of the credentials must not be reported as failing health check, since the condition is
+			// only a warning.
+			q.logger.Info("Credentials are not permitted to read the quotas", "worker", request, "error", err.Error())
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: fmt.Sprintf("The Azure quotas of the subscription in region %s were not checked, because the credentials are not permitted to read them.", worker.Spec.Region),
+			}, nil
+		}
+		q.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	workerStatus, err := helper.WorkerStatusFromWorker(worker)
+	if err != nil {
+		return nil, err
+	}
+
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := q.seedClient.List(ctx, machineDeployments, client.InNamespace(worker.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list machine deployments: %w", err)
+	}
+
+	demand := QuotaDemand(worker, workerStatus.FallbackZones, machineDeployments.Items, snapshot.MachineTypes)
+	vCPUsUnchecked := false
+	if snapshot.MachineTypesError != nil {
+		q.logger.Info("Virtual machine SKUs are unavailable, using the last known machine types", "region", worker.Spec.Region, "machineTypes", len(snapshot.MachineTypes), "error", snapshot.MachineTypesError.Error())
+		if len(snapshot.MachineTypes) == 0 {
+			// without machine types the vCPU demand is unknown, hence the quota would only appear to have headroom.
+			delete(demand, quotaTotalRegionalVCPUs)
+			vCPUsUnchecked = true
+		}
+	}
+
+	descriptions := QuotasApproachingLimit(snapshot.Quotas, demand, q.threshold)
+	switch {
+	case len(descriptions) > 0:
+		detail := fmt.Sprintf("Azure quotas of the subscription in region %s are approaching their limits: %s. Request a quota increase before scaling up.",
+			worker.Spec.Region, strings.Join(descriptions, "; "))
+		if vCPUsUnchecked {
+			detail += " The vCPU quotas were not checked, because the virtual machine SKUs are unavailable."
+		}
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionFalse, Detail: detail}, nil
+	case vCPUsUnchecked:
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: fmt.Sprintf("The vCPU quotas of the subscription in region %s were not checked, because the virtual machine SKUs are unavailable.", worker.Spec.Region),
+		}, nil
+	default:
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+}
+
+func (q *QuotaHealthChecker) quotaSnapshot(ctx context.Context, worker *extensionsv1alpha1.Worker) (*QuotaSnapshot, error) {
+	subscriptionID, newUsageClient, err := usageClientOfWorker(ctx, q.seedClient, worker)
+	if err != nil {
+		return nil, err
+	}
+	return GetQuotaSnapshot(ctx, q.clock, subscriptionID, worker.Spec.Region, newUsageClient)
+}
+
+// usageClientOfWorker returns the subscription of the given Worker and a function which creates a usage client with
+// the credentials of the Worker.
+func usageClientOfWorker(ctx context.Context, c client.Client, worker *extensionsv1alpha1.Worker) (string, func() (azureclient.Usage, error), error) {
+	auth, options, err := clientAuthOfWorker(ctx, c, worker)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return auth.SubscriptionID, func() (azureclient.Usage, error) {
+		return DefaultAzureUsageClientFunc(auth, options...)
+	}, nil
+}
+
+// clientAuthOfWorker returns the credentials of the given Worker and the options to create Azure clients for the cloud
+// of its region.
+func clientAuthOfWorker(ctx context.Context, c client.Client, worker *extensionsv1alpha1.Worker) (*azureclient.ClientAuth, []azureclient.AzureFactoryOption, error) {
+	cluster, err := extensionscontroller.GetCluster(ctx, c, worker.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	var cloudConfiguration *api.CloudConfiguration
+	if cloudProfileConfig != nil {
+		cloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &worker.Spec.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auth, _, err := azureclient.GetClientAuthData(ctx, c, worker.Spec.SecretRef, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return auth, []azureclient.AzureFactoryOption{azureclient.WithCloudConfiguration(azCloudConfiguration)}, nil
+}
+
+// quotaCacheEntry caches the quota snapshot and machine types of a subscription and region. Its mutex serializes the
+// requests for them, so that concurrent health checks of the Shoots in the same subscription and region share one
+// request, while other subscriptions and regions are not blocked.
+type quotaCacheEntry struct {
+	mutex sync.Mutex
+
+	snapshot   *QuotaSnapshot
+	expiresAt  time.Time
+	retryAfter time.Time
+	// forbidden is the error of the last request if the credentials were not permitted to read the quotas. It is
+	// returned until retryAfter.
+	forbidden error
+
+	machineTypes          map[string]MachineTypeQuota
+	machineTypesExpiresAt time.Time
+}
+
+var (
+	quotaCacheMutex sync.Mutex
+	quotaCache      = map[string]*quotaCacheEntry{}
+)
+
+func quotaCacheEntryOf(key string) *quotaCacheEntry {
+	quotaCacheMutex.Lock()
+	defer quotaCacheMutex.Unlock()
+
+	entry, ok := quotaCache[key]
+	if !ok {
+		entry = &quotaCacheEntry{}
+		quotaCache[key] = entry
+	}
+	return entry
+}
+
+// GetQuotaSnapshot returns the quotas and machine types of the given subscription and region. Snapshots are cached for
+// QuotaCacheTTL. If Azure throttles the requests, the cached snapshot is used until Azure allows to retry, even if it
+// already expired. If the credentials are not permitted to read the quotas, the error is cached for QuotaCacheTTL as
+// well. The machine types change rarely and are cached for the CacheTTL of DefaultResourceSKUsOptions. If their
+// unavailability is tolerated, a failure to list them does not fail the snapshot, see QuotaSnapshot.MachineTypesError.
+func GetQuotaSnapshot(ctx context.Context, clock clock.Clock, subscriptionID, region string, newUsageClient func() (azureclient.Usage, error)) (*QuotaSnapshot, error) {
+	entry := quotaCacheEntryOf(subscriptionID + "/" + region)
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	now := clock.Now()
+	if entry.snapshot != nil && now.Before(entry.expiresAt) {
+		return entry.snapshot, nil
+	}
+	if now.Before(entry.retryAfter) {
+		switch {
+		case entry.snapshot != nil:
+			return entry.snapshot, nil
+		case entry.forbidden != nil:
+			return nil, entry.forbidden
+		default:
+			return nil, fmt.Errorf("requests for the quotas of subscription %s in region %s are throttled until %s", subscriptionID, region, entry.retryAfter.Format(time.RFC3339))
+		}
+	}
+
+	usageClient, err := newUsageClient()
+	if err != nil {
+		return nil, fmt.Errorf("could not create Azure usage client: %w", err)
+	}
+
+	snapshot, err := entry.fetchQuotaSnapshot(ctx, usageClient, now, region)
+	if err != nil {
+		switch {
+		case azureclient.IsAzureAPIForbidden(err):
+			entry.snapshot, entry.forbidden, entry.retryAfter = nil, err, now.Add(QuotaCacheTTL)
+			return nil, err
+		case azureclient.IsAzureAPIThrottled(err):
+			backoff, found := azureclient.RetryAfter(err)
+			if !found {
+				backoff = QuotaThrottlingBackoff
+			}
+			entry.forbidden, entry.retryAfter = nil, now.Add(backoff)
+			if entry.snapshot != nil {
+				return entry.snapshot, nil
+			}
+			return nil, err
+		default:
+			return nil, err
+		}
+	}
+
+	entry.snapshot, entry.expiresAt, entry.retryAfter, entry.forbidden = snapshot, now.Add(QuotaCacheTTL), time.Time{}, nil
+	return snapshot, nil
+}
+
+func (e *quotaCacheEntry) fetchQuotaSnapshot(ctx context.Context, usageClient azureclient.Usage, now time.Time, region string) (*QuotaSnapshot, error) {
+	computeUsages, err := usageClient.ListComputeUsages(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("could not list compute usages: %w", err)
+	}
+	networkUsages, err := usageClient.ListNetworkUsages(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("could not list network usages: %w", err)
+	}
+	snapshot := &QuotaSnapshot{}
+	snapshot.MachineTypes, err = e.getMachineTypes(ctx, usageClient, now, region)
+	if err != nil {
+		if !DefaultResourceSKUsOptions.TolerateUnavailability {
+			return nil, err
+		}
+		snapshot.MachineTypesError = err
+	}
+
+	for _, usage := range computeUsages {
+		if usage == nil || usage.Name == nil {
+			continue
+		}
+		snapshot.Quotas = append(snapshot.Quotas, newQuota(usage.Name.Value, usage.Name.LocalizedValue, int64(ptr.Deref(usage.CurrentValue, 0)), ptr.Deref(usage.Limit, 0)))
+	}
+	for _, usage := range networkUsages {
+		if usage == nil || usage.Name == nil {
+			continue
+		}
+		snapshot.Quotas = append(snapshot.Quotas, newQuota(usage.Name.Value, usage.Name.LocalizedValue, ptr.Deref(usage.CurrentValue, 0), ptr.Deref(usage.Limit, 0)))
+	}
+	return snapshot, nil
+}
+
+// getMachineTypes returns the cached machine types or lists them if they expired. If they cannot be listed, the last
+// known machine types are returned together with the error. The caller must hold the mutex of the entry.
+func (e *quotaCacheEntry) getMachineTypes(ctx context.Context, usageClient azureclient.Usage, now time.Time, region string) (map[string]MachineTypeQuota, error) {
+	if e.machineTypes != nil && now.Before(e.machineTypesExpiresAt) {
+		return e.machineTypes, nil
+	}
+
+	skus, err := usageClient.ListVirtualMachineSKUs(ctx, region)
+	if err != nil {
+		return e.machineTypes, fmt.Errorf("could not list virtual machine SKUs: %w", err)
+	}
+
+	types := make(map[string]MachineTypeQuota, len(skus))
+	for _, sku := range skus {
+		if sku == nil || sku.Name == nil {
+			continue
+		}
+		types[*sku.Name] = MachineTypeQuota{Family: ptr.Deref(sku.Family, ""), VCPUs: vCPUs(sku)}
+	}
+	e.machineTypes, e.machineTypesExpiresAt = types, now.Add(DefaultResourceSKUsOptions.CacheTTL)
+	return types, nil
+}
+
+func newQuota(name, displayName *string, current, limit int64) Quota {
+	quota := Quota{Name: ptr.Deref(name, ""), DisplayName: ptr.Deref(displayName, ""), Current: current, Limit: limit}
+	if quota.DisplayName == "" {
+		quota.DisplayName = quota.Name
+	}
+	return quota
+}
+
+func vCPUs(sku *armcompute.ResourceSKU) int64 {
+	for _, capability := range sku.Capabilities {
+		if capability == nil || ptr.Deref(capability.Name, "") != "vCPUs" {
+			continue
+		}
+		if value, err := strconv.ParseInt(ptr.Deref(capability.Value, ""), 10, 64); err == nil {
+			return value
+		}
+	}
+	return 0
+}
@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
+)
+
+var _ = Describe("Quota", func() {
+	const namespace = "shoot--foobar--azure"
+
+	Describe("#QuotaDemand", func() {
+		It("should compute the demand to scale all pools to their maximum", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{Name: "non-zonal", MachineType: "Standard_D4s_v5", Maximum: 5},
+						{Name: "zonal", MachineType: "Standard_D2s_v5", Maximum: 6, Zones: []string{"1", "2"}},
+						{Name: "unknown", MachineType: "Standard_Unknown", Maximum: 1},
+					},
+				},
+			}
+			machineDeployments := []machinev1alpha1.MachineDeployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-non-zonal"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 2}},
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-zonal-z1"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 1}},
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-zonal-z2"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 1}},
+			}
+			machineTypes := map[string]MachineTypeQuota{
+				"Standard_D4s_v5": {Family: "standardDSv5Family", VCPUs: 4},
+				"Standard_D2s_v5": {Family: "standardDSv5Family", VCPUs: 2},
+			}
+
+			Expect(QuotaDemand(worker, nil, machineDeployments, machineTypes)).To(Equal(map[string]int64{
+				"cores":                        3*4 + 4*2,
+				"standardDSv5Family":           3*4 + 4*2,
+				"NetworkInterfaces":            3 + 4 + 1,
+				"PublicIPAddresses":            0,
+				"StandardSkuPublicIpAddresses": 0,
+			}))
+		})
+
+		It("should not demand anything for pools which exceed their maximum", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{{Name: "pool", MachineType: "Standard_D4s_v5", Maximum: 1}},
+				},
+			}
+			machineDeployments := []machinev1alpha1.MachineDeployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-pool"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 2}},
+			}
+
+			demand := QuotaDemand(worker, nil, machineDeployments, map[string]MachineTypeQuota{"Standard_D4s_v5": {Family: "standardDSv5Family", VCPUs: 4}})
+			Expect(demand).To(HaveKeyWithValue("cores", int64(0)))
+			Expect(demand).To(HaveKeyWithValue("NetworkInterfaces", int64(0)))
+		})
+
+		It("should count the machines of the fallback zones towards the current size of the pools", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{{Name: "pool", MachineType: "Standard_D4s_v5", Maximum: 4, Zones: []string{"1"}}},
+				},
+			}
+			machineDeployments := []machinev1alpha1.MachineDeployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-pool-z1"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 1}},
+				{ObjectMeta: metav1.ObjectMeta{Name: namespace + "-pool-z2"}, Status: machinev1alpha1.MachineDeploymentStatus{Replicas: 2}},
+			}
+
+			demand := QuotaDemand(worker, []api.WorkerPoolFallbackZones{{Name: "pool", Zones: []string{"2"}}}, machineDeployments, map[string]MachineTypeQuota{"Standard_D4s_v5": {Family: "standardDSv5Family", VCPUs: 4}})
+			Expect(demand).To(HaveKeyWithValue("cores", int64(4)))
+			Expect(demand).To(HaveKeyWithValue("NetworkInterfaces", int64(1)))
+		})
+	})
+
+	Describe("#QuotasApproachingLimit", func() {
+		It("should name the quotas whose headroom falls below the threshold", func() {
+			quotas := []Quota{
+				{Name: "cores", DisplayName: "Total Regional vCPUs", Current: 10, Limit: 100},
+				{Name: "standardDSv5Family", DisplayName: "Standard DSv5 Family vCPUs", Current: 40, Limit: 50},
+				{Name: "standardFSv2Family", DisplayName: "Standard FSv2 Family vCPUs", Current: 50, Limit: 50},
+				{Name: "PublicIPAddresses", DisplayName: "Public IP Addresses", Current: 95, Limit: 100},
+				{Name: "NetworkInterfaces", DisplayName: "Network Interfaces", Current: 0, Limit: 0},
+			}
+			demand := map[string]int64{
+				"cores":              8,
+				"standardDSv5Family": 8,
+				"PublicIPAddresses":  0,
+				"NetworkInterfaces":  2,
+			}
+
+			Expect(QuotasApproachingLimit(quotas, demand, 0.1)).To(ConsistOf(
+				`"Public IP Addresses" uses 95 of 100 and requires 0 more to scale the worker pools to their maximum`,
+				`"Standard DSv5 Family vCPUs" uses 40 of 50 and requires 8 more to scale the worker pools to their maximum`,
+			))
+		})
+
+		It("should return nothing if there is enough headroom", func() {
+			Expect(QuotasApproachingLimit([]Quota{{Name: "cores", Current: 10, Limit: 100}}, map[string]int64{"cores": 80}, 0.1)).To(BeEmpty())
+		})
+	})
+
+	Describe("#GetQuotaSnapshot", func() {
+		var (
+			ctx         context.Context
+			ctrl        *gomock.Controller
+			fakeClock   *testclock.FakeClock
+			usageClient *mockazureclient.MockUsage
+			newClient   func() (azureclient.Usage, error)
+
+			subscriptionID string
+			region         = "westeurope"
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			ctrl = gomock.NewController(GinkgoT())
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			usageClient = mockazureclient.NewMockUsage(ctrl)
+			newClient = func() (azureclient.Usage, error) { return usageClient, nil }
+			// the snapshots are cached per subscription, hence use a dedicated one for each test
+			subscriptionID = strconv.Itoa(CurrentSpecReport().LeafNodeLocation.LineNumber)
+		})
+
+		expectUsages := func() {
+			usageClient.EXPECT().ListComputeUsages(ctx, region).Return([]*armcompute.Usage{{
+				Name:         &armcompute.UsageName{Value: ptr.To("standardDSv5Family"), LocalizedValue: ptr.To("Standard DSv5 Family vCPUs")},
+				CurrentValue: ptr.To[int32](4),
+				Limit:        ptr.To[int64](10),
+			}}, nil)
+			usageClient.EXPECT().ListNetworkUsages(ctx, region).Return([]*armnetwork.Usage{{
+				Name:         &armnetwork.UsageName{Value: ptr.To("PublicIPAddresses")},
+				CurrentValue: ptr.To[int64](1),
+				Limit:        ptr.To[int64](100),
+			}}, nil)
+		}
+		expectSKUs := func() {
+			usageClient.EXPECT().ListVirtualMachineSKUs(ctx, region).Return([]*armcompute.ResourceSKU{{
+				Name:         ptr.To("Standard_D4s_v5"),
+				Family:       ptr.To("standardDSv5Family"),
+				Capabilities: []*armcompute.ResourceSKUCapabilities{{Name: ptr.To("vCPUs"), Value: ptr.To("4")}},
+			}}, nil)
+		}
+		expectList := func() {
+			expectUsages()
+			expectSKUs()
+		}
+
+		It("should fetch and cache the snapshot", func() {
+			expectList()
+
+			snapshot, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Quotas).To(ConsistOf(
+				Quota{Name: "standardDSv5Family", DisplayName: "Standard DSv5 Family vCPUs", Current: 4, Limit: 10},
+				Quota{Name: "PublicIPAddresses", DisplayName: "PublicIPAddresses", Current: 1, Limit: 100},
+			))
+			Expect(snapshot.MachineTypes).To(Equal(map[string]MachineTypeQuota{"Standard_D4s_v5": {Family: "standardDSv5Family", VCPUs: 4}}))
+
+			fakeClock.Step(QuotaCacheTTL - time.Second)
+			cached, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(snapshot))
+
+			fakeClock.Step(time.Second)
+			expectUsages()
+			refreshed, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(refreshed.MachineTypes).To(Equal(snapshot.MachineTypes))
+
+			fakeClock.Step(DefaultResourceSKUsCacheTTL)
+			expectList()
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should keep using the expired snapshot while being throttled", func() {
+			expectList()
+			snapshot, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(QuotaCacheTTL)
+			usageClient.EXPECT().ListComputeUsages(ctx, region).Return(nil, &azcore.ResponseError{
+				StatusCode:  http.StatusTooManyRequests,
+				RawResponse: &http.Response{Header: http.Header{"Retry-After": []string{"60"}}},
+			})
+			cached, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(snapshot))
+
+			fakeClock.Step(59 * time.Second)
+			cached, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(snapshot))
+
+			fakeClock.Step(time.Second)
+			expectUsages()
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not request the quotas while being throttled without a snapshot", func() {
+			usageClient.EXPECT().ListComputeUsages(ctx, region).Return(nil, &azcore.ResponseError{StatusCode: http.StatusTooManyRequests})
+			_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).To(HaveOccurred())
+
+			fakeClock.Step(QuotaThrottlingBackoff - time.Second)
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).To(MatchError(ContainSubstring("throttled")))
+		})
+
+		It("should cache that the credentials are not permitted to read the quotas", func() {
+			expectList()
+			_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(QuotaCacheTTL)
+			usageClient.EXPECT().ListComputeUsages(ctx, region).Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden})
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(azureclient.IsAzureAPIForbidden(err)).To(BeTrue())
+
+			fakeClock.Step(QuotaCacheTTL - time.Second)
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(azureclient.IsAzureAPIForbidden(err)).To(BeTrue())
+
+			fakeClock.Step(time.Second)
+			expectUsages()
+			_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not block the snapshots of other subscriptions", func() {
+			blocked, release := make(chan struct{}), make(chan struct{})
+			usageClient.EXPECT().ListComputeUsages(ctx, region).DoAndReturn(func(_ context.Context, _ string) ([]*armcompute.Usage, error) {
+				close(blocked)
+				<-release
+				return nil, nil
+			})
+			usageClient.EXPECT().ListNetworkUsages(ctx, region).Return(nil, nil)
+			expectSKUs()
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+			Eventually(blocked).Should(BeClosed())
+
+			expectList()
+			_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID+"-other", region, newClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			close(release)
+			Eventually(done).Should(BeClosed())
+		})
+
+		Context("unavailable SKUs", func() {
+			expectUnavailableSKUs := func() {
+				usageClient.EXPECT().ListVirtualMachineSKUs(ctx, region).Return(nil, &azcore.ResponseError{StatusCode: http.StatusServiceUnavailable})
+			}
+
+			It("should use the last known machine types", func() {
+				expectList()
+				snapshot, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeClock.Step(DefaultResourceSKUsCacheTTL)
+				expectUsages()
+				expectUnavailableSKUs()
+				degraded, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(degraded.MachineTypes).To(Equal(snapshot.MachineTypes))
+				Expect(degraded.MachineTypesError).To(MatchError(ContainSubstring("could not list virtual machine SKUs")))
+
+				fakeClock.Step(QuotaCacheTTL)
+				expectList()
+				refreshed, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(refreshed.MachineTypesError).NotTo(HaveOccurred())
+			})
+
+			It("should return a snapshot without machine types if the SKUs were never listed", func() {
+				expectUsages()
+				expectUnavailableSKUs()
+
+				snapshot, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Quotas).To(HaveLen(2))
+				Expect(snapshot.MachineTypes).To(BeEmpty())
+				Expect(snapshot.MachineTypesError).To(HaveOccurred())
+			})
+
+			It("should fail if the unavailability of the SKUs is not tolerated", func() {
+				DeferCleanup(test.WithVar(&DefaultResourceSKUsOptions.TolerateUnavailability, false))
+				expectUsages()
+				expectUnavailableSKUs()
+
+				_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).To(MatchError(ContainSubstring("could not list virtual machine SKUs")))
+			})
+
+			It("should cache the machine types for the configured duration", func() {
+				DeferCleanup(test.WithVar(&DefaultResourceSKUsOptions.CacheTTL, QuotaCacheTTL))
+				expectList()
+				_, err := GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeClock.Step(QuotaCacheTTL)
+				expectList()
+				_, err = GetQuotaSnapshot(ctx, fakeClock, subscriptionID, region, newClient)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
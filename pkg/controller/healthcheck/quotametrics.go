@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// QuotaResourceVCPUs is the resource label of the total regional vCPUs.
+	QuotaResourceVCPUs = "vcpus"
+	// QuotaResourcePublicIPAddresses is the resource label of the public IP addresses.
+	QuotaResourcePublicIPAddresses = "public_ip_addresses"
+	// QuotaResourceDisks is the resource label of the managed disks of all disk types.
+	QuotaResourceDisks = "disks"
+
+	// quotaDiskCountSuffix is the suffix of the compute quotas which count the managed disks of a disk type, e.g.
+	// PremiumDiskCount.
+	quotaDiskCountSuffix = "DiskCount"
+)
+
+// DefaultQuotaMetricsOptions are the default options of the quota usage metrics. The metrics are disabled by default.
+var DefaultQuotaMetricsOptions = QuotaMetricsOptions{}
+
+// QuotaMetricsOptions are the options of the quota usage metrics.
+type QuotaMetricsOptions struct {
+	// RefreshInterval is the interval in which the quota usages are refreshed. The metrics are disabled if it is zero.
+	RefreshInterval time.Duration
+}
+
+var (
+	quotaUsageDesc = prometheus.NewDesc(
+		"azure_quota_usage",
+		"Current usage of an Azure quota of a subscription in a region.",
+		[]string{"subscription", "region", "resource"}, nil,
+	)
+	quotaLimitDesc = prometheus.NewDesc(
+		"azure_quota_limit",
+		"Limit of an Azure quota of a subscription in a region.",
+		[]string{"subscription", "region", "resource"}, nil,
+	)
+	quotaShootsDesc = prometheus.NewDesc(
+		"azure_quota_shoots",
+		"Number of Shoots of the seed in a subscription and region.",
+		[]string{"subscription", "region"}, nil,
+	)
+)
+
+// SubscriptionRegion identifies the quotas of a subscription in a region.
+type SubscriptionRegion struct {
+	// SubscriptionID is the ID of the subscription.
+	SubscriptionID string
+	// Region is the region.
+	Region string
+}
+
+// QuotaUsage is the aggregated usage of the quotas of a resource in a subscription and region.
+type QuotaUsage struct {
+	SubscriptionRegion
+	// Resource is the kind of the resource, e.g. vcpus.
+	Resource string
+	// Current is the current usage of the resource.
+	Current int64
+	// Limit is the limit of the resource.
+	Limit int64
+}
+
+// SubscriptionQuotaUsages are the aggregated quota usages of a subscription in a region.
+type SubscriptionQuotaUsages struct {
+	SubscriptionRegion
+	// Shoots is the number of Shoots of the seed in the subscription and region.
+	Shoots int
+	// Usages are the aggregated quota usages.
+	Usages []QuotaUsage
+}
+
+// AggregateQuotaUsages aggregates the quotas of the given snapshots to the usages of vCPUs, public IP addresses and
+// managed disks per subscription and region. The quotas of Azure are shared by all Shoots in a subscription and region,
+// hence they are counted once, independent of the number of Shoots. Subscriptions and regions without a snapshot are
+// omitted.
+func AggregateQuotaUsages(shoots map[SubscriptionRegion]int, snapshots map[SubscriptionRegion]*QuotaSnapshot) []SubscriptionQuotaUsages {
+	var result []SubscriptionQuotaUsages
+
+	for key, count := range shoots {
+		snapshot, ok := snapshots[key]
+		if !ok || snapshot == nil {
+			continue
+		}
+
+		usages := map[string]*QuotaUsage{}
+		add := func(resource string, quota Quota) {
+			usage, ok := usages[resource]
+			if !ok {
+				usage = &QuotaUsage{SubscriptionRegion: key, Resource: resource}
+				usages[resource] = usage
+			}
+			usage.Current += quota.Current
+			usage.Limit += quota.Limit
+		}
+
+		for _, quota := range snapshot.Quotas {
+			switch {
+			case quota.Name == quotaTotalRegionalVCPUs:
+				add(QuotaResourceVCPUs, quota)
+			case quota.Name == quotaPublicIPAddresses:
+				add(QuotaResourcePublicIPAddresses, quota)
+			case strings.HasSuffix(quota.Name, quotaDiskCountSuffix):
+				add(QuotaResourceDisks, quota)
+			}
+		}
+
+		aggregated := SubscriptionQuotaUsages{SubscriptionRegion: key, Shoots: count}
+		for _, usage := range usages {
+			aggregated.Usages = append(aggregated.Usages, *usage)
+		}
+		slices.SortFunc(aggregated.Usages, func(a, b QuotaUsage) int { return cmp.Compare(a.Resource, b.Resource) })
+		result = append(result, aggregated)
+	}
+
+	slices.SortFunc(result, func(a, b SubscriptionQuotaUsages) int {
+		return cmp.Or(cmp.Compare(a.SubscriptionID, b.SubscriptionID), cmp.Compare(a.Region, b.Region))
+	})
+	return result
+}
+
+// QuotaUsageCollector periodically aggregates the quota usages of the subscriptions and regions of the Azure Workers in
+// the seed and exposes the last aggregation as Prometheus metrics. The quotas are read with the credentials of the
+// Workers and share the cache of the quota health check.
+type QuotaUsageCollector struct {
+	client          client.Client
+	clock           clock.Clock
+	logger          logr.Logger
+	refreshInterval time.Duration
+
+	mutex  sync.RWMutex
+	usages []SubscriptionQuotaUsages
+}
+
+var (
+	_ prometheus.Collector           = &QuotaUsageCollector{}
+	_ manager.LeaderElectionRunnable = &QuotaUsageCollector{}
+)
+
+// NewQuotaUsageCollector creates a new QuotaUsageCollector which refreshes the quota usages in the given interval.
+func NewQuotaUsageCollector(c client.Client, clock clock.Clock, refreshInterval time.Duration) *QuotaUsageCollector {
+	return &QuotaUsageCollector{
+		client:          c,
+		clock:           clock,
+		logger:          log.Log.WithName("azure-quota-usage-collector"),
+		refreshInterval: refreshInterval,
+	}
+}
+
+// AddQuotaMetricsToManager registers the metrics of the quota usages and adds their periodic refresh to the given
+// manager. Nothing is added if the refresh interval of the given options is zero.
+func AddQuotaMetricsToManager(mgr manager.Manager, opts QuotaMetricsOptions) error {
+	if opts.RefreshInterval <= 0 {
+		return nil
+	}
+
+	collector := NewQuotaUsageCollector(mgr.GetClient(), clock.RealClock{}, opts.RefreshInterval)
+	if err := metrics.Registry.Register(collector); err != nil {
+		return err
+	}
+	return mgr.Add(collector)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader reads the quotas to not multiply the
+// requests to Azure by the number of replicas.
+func (c *QuotaUsageCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start refreshes the quota usages in the configured interval until the given context is cancelled.
+func (c *QuotaUsageCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Refresh(ctx); err != nil {
+			c.logger.Error(err, "Failed to refresh quota usages")
+		}
+	}, c.refreshInterval)
+	return nil
+}
+
+// Refresh aggregates the current quota usages of the subscriptions and regions of the Azure Workers in the seed.
+// Subscriptions and regions whose quotas cannot be read are logged and omitted until the next refresh.
+func (c *QuotaUsageCollector) Refresh(ctx context.Context) error {
+	workers := &extensionsv1alpha1.WorkerList{}
+	if err := c.client.List(ctx, workers); err != nil {
+		return err
+	}
+
+	var (
+		shoots          = map[SubscriptionRegion]int{}
+		newUsageClients = map[SubscriptionRegion]func() (azureclient.Usage, error){}
+	)
+	for _, worker := range workers.Items {
+		if worker.Spec.Type != azure.Type || worker.DeletionTimestamp != nil {
+			continue
+		}
+
+		subscriptionID, newUsageClient, err := usageClientOfWorker(ctx, c.client, &worker)
+		if err != nil {
+			c.logger.Error(err, "Failed to determine the subscription of worker", "worker", client.ObjectKeyFromObject(&worker))
+			continue
+		}
+
+		key := SubscriptionRegion{SubscriptionID: subscriptionID, Region: worker.Spec.Region}
+		shoots[key]++
+		if _, ok := newUsageClients[key]; !ok {
+			newUsageClients[key] = newUsageClient
+		}
+	}
+
+	snapshots := make(map[SubscriptionRegion]*QuotaSnapshot, len(newUsageClients))
+	for key, newUsageClient := range newUsageClients {
+		snapshot, err := GetQuotaSnapshot(ctx, c.clock, key.SubscriptionID, key.Region, newUsageClient)
+		if err != nil {
+			c.logger.Error(err, "Failed to get quotas", "subscription", key.SubscriptionID, "region", key.Region)
+			continue
+		}
+		snapshots[key] = snapshot
+	}
+
+	usages := AggregateQuotaUsages(shoots, snapshots)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.usages = usages
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *QuotaUsageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- quotaUsageDesc
+	ch <- quotaLimitDesc
+	ch <- quotaShootsDesc
+}
+
+// Collect implements prometheus.Collector. It exposes the quota usages of the last refresh.
+func (c *QuotaUsageCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, aggregated := range c.usages {
+		ch <- prometheus.MustNewConstMetric(quotaShootsDesc, prometheus.GaugeValue, float64(aggregated.Shoots), aggregated.SubscriptionID, aggregated.Region)
+		for _, usage := range aggregated.Usages {
+			ch <- prometheus.MustNewConstMetric(quotaUsageDesc, prometheus.GaugeValue, float64(usage.Current), usage.SubscriptionID, usage.Region, usage.Resource)
+			ch <- prometheus.MustNewConstMetric(quotaLimitDesc, prometheus.GaugeValue, float64(usage.Limit), usage.SubscriptionID, usage.Region, usage.Resource)
+		}
+	}
+}
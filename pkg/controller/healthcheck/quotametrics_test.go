@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
+)
+
+var _ = Describe("QuotaMetrics", func() {
+	Describe("#AggregateQuotaUsages", func() {
+		var (
+			subA = SubscriptionRegion{SubscriptionID: "sub-a", Region: "westeurope"}
+			subB = SubscriptionRegion{SubscriptionID: "sub-b", Region: "northeurope"}
+		)
+
+		It("should aggregate the vCPU, public IP and disk quotas per subscription and region", func() {
+			snapshots := map[SubscriptionRegion]*QuotaSnapshot{
+				subA: {Quotas: []Quota{
+					{Name: "cores", Current: 40, Limit: 100},
+					{Name: "standardDSv5Family", Current: 40, Limit: 50},
+					{Name: "PublicIPAddresses", Current: 3, Limit: 1000},
+					{Name: "StandardSkuPublicIpAddresses", Current: 3, Limit: 1000},
+					{Name: "PremiumDiskCount", Current: 10, Limit: 50000},
+					{Name: "StandardSSDDiskCount", Current: 5, Limit: 50000},
+				}},
+				subB: {Quotas: []Quota{
+					{Name: "cores", Current: 8, Limit: 20},
+				}},
+			}
+
+			Expect(AggregateQuotaUsages(map[SubscriptionRegion]int{subB: 1, subA: 3}, snapshots)).To(Equal([]SubscriptionQuotaUsages{
+				{
+					SubscriptionRegion: subA,
+					Shoots:             3,
+					Usages: []QuotaUsage{
+						{SubscriptionRegion: subA, Resource: QuotaResourceDisks, Current: 15, Limit: 100000},
+						{SubscriptionRegion: subA, Resource: QuotaResourcePublicIPAddresses, Current: 3, Limit: 1000},
+						{SubscriptionRegion: subA, Resource: QuotaResourceVCPUs, Current: 40, Limit: 100},
+					},
+				},
+				{
+					SubscriptionRegion: subB,
+					Shoots:             1,
+					Usages: []QuotaUsage{
+						{SubscriptionRegion: subB, Resource: QuotaResourceVCPUs, Current: 8, Limit: 20},
+					},
+				},
+			}))
+		})
+
+		It("should omit subscriptions and regions without a snapshot", func() {
+			snapshots := map[SubscriptionRegion]*QuotaSnapshot{
+				subA: {Quotas: []Quota{{Name: "cores", Current: 1, Limit: 10}}},
+			}
+
+			Expect(AggregateQuotaUsages(map[SubscriptionRegion]int{subA: 1, subB: 2}, snapshots)).To(ConsistOf(
+				HaveField("SubscriptionRegion", subA),
+			))
+		})
+
+		It("should return nothing without shoots", func() {
+			Expect(AggregateQuotaUsages(nil, map[SubscriptionRegion]*QuotaSnapshot{subA: {}})).To(BeEmpty())
+		})
+	})
+
+	Describe("QuotaUsageCollector", func() {
+		var (
+			ctx         context.Context
+			ctrl        *gomock.Controller
+			c           client.Client
+			usageClient *mockazureclient.MockUsage
+			collector   *QuotaUsageCollector
+
+			oldDefaultAzureUsageClientFunc func(*azureclient.ClientAuth, ...azureclient.AzureFactoryOption) (azureclient.Usage, error)
+			subscriptionID                 string
+		)
+
+		newWorker := func(namespace, region string) []client.Object {
+			return []client.Object{
+				&extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+					Data: map[string][]byte{
+						azure.SubscriptionIDKey: []byte(subscriptionID),
+						azure.TenantIDKey:       []byte("tenant"),
+						azure.ClientIDKey:       []byte("client"),
+						azure.ClientSecretKey:   []byte("secret"),
+					},
+				},
+				&extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+					Spec: extensionsv1alpha1.WorkerSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+						Region:      region,
+						SecretRef:   corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			ctrl = gomock.NewController(GinkgoT())
+			usageClient = mockazureclient.NewMockUsage(ctrl)
+			// the snapshots are cached per subscription, hence use a dedicated one for each test
+			subscriptionID = "metrics-" + strconv.Itoa(CurrentSpecReport().LeafNodeLocation.LineNumber)
+
+			oldDefaultAzureUsageClientFunc = DefaultAzureUsageClientFunc
+			DefaultAzureUsageClientFunc = func(*azureclient.ClientAuth, ...azureclient.AzureFactoryOption) (azureclient.Usage, error) {
+				return usageClient, nil
+			}
+
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			var objects []client.Object
+			objects = append(objects, newWorker("shoot--foo--a", "westeurope")...)
+			objects = append(objects, newWorker("shoot--foo--b", "westeurope")...)
+			c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			collector = NewQuotaUsageCollector(c, testclock.NewFakeClock(time.Now()), time.Minute)
+		})
+
+		AfterEach(func() {
+			DefaultAzureUsageClientFunc = oldDefaultAzureUsageClientFunc
+		})
+
+		gather := func() map[string]float64 {
+			registry := prometheus.NewPedanticRegistry()
+			Expect(registry.Register(collector)).To(Succeed())
+			families, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+
+			values := map[string]float64{}
+			for _, family := range families {
+				for _, metric := range family.GetMetric() {
+					var labels []string
+					for _, label := range metric.GetLabel() {
+						labels = append(labels, label.GetName()+"="+label.GetValue())
+					}
+					values[family.GetName()+"{"+strings.Join(labels, ",")+"}"] = metric.GetGauge().GetValue()
+				}
+			}
+			return values
+		}
+
+		It("should read the quotas once per subscription and region and expose them as metrics", func() {
+			usageClient.EXPECT().ListComputeUsages(ctx, "westeurope").Return([]*armcompute.Usage{
+				{Name: &armcompute.UsageName{Value: ptr.To("cores")}, CurrentValue: ptr.To[int32](12), Limit: ptr.To[int64](100)},
+				{Name: &armcompute.UsageName{Value: ptr.To("PremiumDiskCount")}, CurrentValue: ptr.To[int32](6), Limit: ptr.To[int64](50000)},
+			}, nil)
+			usageClient.EXPECT().ListNetworkUsages(ctx, "westeurope").Return([]*armnetwork.Usage{
+				{Name: &armnetwork.UsageName{Value: ptr.To("PublicIPAddresses")}, CurrentValue: ptr.To[int64](2), Limit: ptr.To[int64](1000)},
+			}, nil)
+			usageClient.EXPECT().ListVirtualMachineSKUs(ctx, "westeurope").Return(nil, nil)
+
+			Expect(collector.Refresh(ctx)).To(Succeed())
+
+			Expect(gather()).To(Equal(map[string]float64{
+				"azure_quota_shoots{region=westeurope,subscription=" + subscriptionID + "}":                             2,
+				"azure_quota_usage{region=westeurope,resource=disks,subscription=" + subscriptionID + "}":               6,
+				"azure_quota_usage{region=westeurope,resource=public_ip_addresses,subscription=" + subscriptionID + "}": 2,
+				"azure_quota_usage{region=westeurope,resource=vcpus,subscription=" + subscriptionID + "}":               12,
+				"azure_quota_limit{region=westeurope,resource=disks,subscription=" + subscriptionID + "}":               50000,
+				"azure_quota_limit{region=westeurope,resource=public_ip_addresses,subscription=" + subscriptionID + "}": 1000,
+				"azure_quota_limit{region=westeurope,resource=vcpus,subscription=" + subscriptionID + "}":               100,
+			}))
+		})
+
+		It("should not expose the quotas of a subscription which cannot be read", func() {
+			usageClient.EXPECT().ListComputeUsages(ctx, "westeurope").Return(nil, errors.New("forbidden"))
+
+			Expect(collector.Refresh(ctx)).To(Succeed())
+			Expect(gather()).To(BeEmpty())
+		})
+	})
+})
@@ -5,23 +5,33 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 )
 
 type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
 	disableProjectedTokenMount bool
+	taskTimeouts               map[string]time.Duration
+	checkpointTTL              time.Duration
+	egressReport               *config.EgressReport
 }
 
 // NewActuator creates a new infrastructure.Actuator.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool, taskTimeouts map[string]time.Duration, checkpointTTL time.Duration, egressReport *config.EgressReport) infrastructure.Actuator {
 	return &actuator{
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		taskTimeouts:               taskTimeouts,
+		checkpointTTL:              checkpointTTL,
+		egressReport:               egressReport,
 	}
 }
@@ -56,13 +56,14 @@ func (a *actuator) delete(ctx context.Context, log logr.Logger, infra *extension
 	}
 
 	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
-		Client:  a.client,
-		Factory: factory,
-		Auth:    nil,
-		Logger:  log,
-		Infra:   infra,
-		Cluster: cluster,
-		State:   infraState,
+		Client:       a.client,
+		Factory:      factory,
+		Auth:         nil,
+		Logger:       log,
+		Infra:        infra,
+		Cluster:      cluster,
+		State:        infraState,
+		TaskTimeouts: a.taskTimeouts,
 	})
 	if err != nil {
 		return err
@@ -70,6 +71,10 @@ func (a *actuator) delete(ctx context.Context, log logr.Logger, infra *extension
 
 	err = fctx.Delete(ctx)
 	if err != nil {
+		return azureclient.DescribeScopeLockedError(ctx, factory, err)
+	}
+
+	if err := DeleteEgressReport(ctx, a.client, a.egressReport, infra); err != nil {
 		return err
 	}
 
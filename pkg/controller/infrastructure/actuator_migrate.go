@@ -17,6 +17,11 @@ import (
 
 // Migrate implements infrastructure.Actuator.
 func (a *actuator) Migrate(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, _ *controller.Cluster) error {
+	// the egress report is written again by the restoration in the destination seed
+	if err := DeleteEgressReport(ctx, a.client, a.egressReport, infra); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
 	tf, err := newTerraformer(log, a.restConfig, terraformerPurpose, infra, a.disableProjectedTokenMount)
 	if err != nil {
 		return err
@@ -84,19 +84,25 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 	}
 
 	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
-		Client:  a.client,
-		Factory: factory,
-		Auth:    auth,
-		Logger:  log,
-		Infra:   infra,
-		Cluster: cluster,
-		State:   infraState,
+		Client:        a.client,
+		Factory:       factory,
+		Auth:          auth,
+		Logger:        log,
+		Infra:         infra,
+		Cluster:       cluster,
+		State:         infraState,
+		TaskTimeouts:  a.taskTimeouts,
+		CheckpointTTL: a.checkpointTTL,
 	})
 	if err != nil {
 		return err
 	}
 
-	return fctx.Reconcile(ctx)
+	if err := fctx.Reconcile(ctx); err != nil {
+		// operations which are blocked by a management lock are reported with the names of the locks.
+		return azureclient.DescribeScopeLockedError(ctx, factory, err)
+	}
+	return ReconcileEgressReport(ctx, a.client, a.egressReport, infra)
 }
 
 func (a *actuator) migrateFromTerraform(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure) (*azure.InfrastructureState, error) {
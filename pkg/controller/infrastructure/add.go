@@ -6,6 +6,7 @@ package infrastructure
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -34,13 +36,21 @@ type AddOptions struct {
 	DisableProjectedTokenMount bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// TaskTimeouts overrides the default timeouts of the infrastructure flow tasks by task name.
+	TaskTimeouts map[string]time.Duration
+	// CheckpointTTL is the duration for which completed tasks of a failed infrastructure reconciliation are skipped by
+	// the following reconciliations. Checkpoints are disabled if it is zero.
+	CheckpointTTL time.Duration
+	// EgressReport enables the ConfigMaps which report the egress CIDRs and the resource IDs of the infrastructures.
+	// They are disabled if it is nil.
+	EgressReport *config.EgressReport
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount),
+		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount, opts.TaskTimeouts, opts.CheckpointTTL, opts.EgressReport),
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              azure.Type,
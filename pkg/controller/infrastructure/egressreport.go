@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+const (
+	// DefaultEgressReportName is the name of the egress report ConfigMaps if no name is configured.
+	DefaultEgressReportName = "egress-report"
+	// ExtensionPurposeEgressReport is the value of the purpose label of the egress report ConfigMaps.
+	ExtensionPurposeEgressReport = "egress-report"
+
+	// EgressReportKeyEgressCIDRs is the key of the comma-separated egress CIDRs in the egress report.
+	EgressReportKeyEgressCIDRs = "egressCIDRs"
+	// EgressReportKeyRegion is the key of the region in the egress report.
+	EgressReportKeyRegion = "region"
+	// EgressReportKeyOutboundAccessType is the key of the outbound access type in the egress report.
+	EgressReportKeyOutboundAccessType = "outboundAccessType"
+	// EgressReportKeyResourceGroup is the key of the name of the resource group in the egress report.
+	EgressReportKeyResourceGroup = "resourceGroup"
+	// EgressReportKeyVNetName is the key of the name of the virtual network in the egress report.
+	EgressReportKeyVNetName = "vnetName"
+	// EgressReportKeyVNetResourceGroup is the key of the resource group of an existing virtual network in the egress
+	// report.
+	EgressReportKeyVNetResourceGroup = "vnetResourceGroup"
+	// EgressReportKeyNatGatewayIDs is the key of the comma-separated IDs of the NAT gateways in the egress report.
+	EgressReportKeyNatGatewayIDs = "natGatewayIDs"
+	// EgressReportKeyOutboundLoadBalancerID is the key of the ID of the outbound load balancer in the egress report.
+	EgressReportKeyOutboundLoadBalancerID = "outboundLoadBalancerID"
+	// EgressReportKeyIdentityID is the key of the ID of the managed identity in the egress report.
+	EgressReportKeyIdentityID = "identityID"
+)
+
+// EgressReportKey returns the key of the egress report ConfigMap of the given Infrastructure. The ConfigMap is placed
+// into the namespace of the Infrastructure unless a namespace is configured, in which case its name is prefixed with
+// the namespace of the Infrastructure to keep the ConfigMaps of different shoots apart.
+func EgressReportKey(report *config.EgressReport, infra *extensionsv1alpha1.Infrastructure) client.ObjectKey {
+	name := DefaultEgressReportName
+	if len(report.Name) > 0 {
+		name = report.Name
+	}
+	if len(report.Namespace) == 0 {
+		return client.ObjectKey{Namespace: infra.Namespace, Name: name}
+	}
+	return client.ObjectKey{Namespace: report.Namespace, Name: fmt.Sprintf("%s-%s", infra.Namespace, name)}
+}
+
+// EgressReportData returns the data of the egress report of the given Infrastructure with the given status. Keys
+// without a value are omitted.
+func EgressReportData(infra *extensionsv1alpha1.Infrastructure, status *api.InfrastructureStatus) map[string]string {
+	var natGatewayIDs []string
+	for _, subnet := range status.Networks.Subnets {
+		if id := ptr.Deref(subnet.NatGatewayID, ""); len(id) > 0 && !slices.Contains(natGatewayIDs, id) {
+			natGatewayIDs = append(natGatewayIDs, id)
+		}
+	}
+	slices.Sort(natGatewayIDs)
+
+	var outboundLoadBalancerID, identityID string
+	if status.Networks.OutboundLoadBalancer != nil {
+		outboundLoadBalancerID = status.Networks.OutboundLoadBalancer.ID
+	}
+	if status.Identity != nil {
+		identityID = status.Identity.ID
+	}
+
+	data := map[string]string{}
+	for key, value := range map[string]string{
+		EgressReportKeyEgressCIDRs:            strings.Join(infra.Status.EgressCIDRs, ","),
+		EgressReportKeyRegion:                 infra.Spec.Region,
+		EgressReportKeyOutboundAccessType:     string(status.Networks.OutboundAccessType),
+		EgressReportKeyResourceGroup:          status.ResourceGroup.Name,
+		EgressReportKeyVNetName:               status.Networks.VNet.Name,
+		EgressReportKeyVNetResourceGroup:      ptr.Deref(status.Networks.VNet.ResourceGroup, ""),
+		EgressReportKeyNatGatewayIDs:          strings.Join(natGatewayIDs, ","),
+		EgressReportKeyOutboundLoadBalancerID: outboundLoadBalancerID,
+		EgressReportKeyIdentityID:             identityID,
+	} {
+		if len(value) > 0 {
+			data[key] = value
+		}
+	}
+	return data
+}
+
+// ReconcileEgressReport writes the egress CIDRs and the resource IDs of the provider status of the given
+// Infrastructure into its egress report ConfigMap. Nothing is written if the egress report is not configured.
+func ReconcileEgressReport(ctx context.Context, c client.Client, report *config.EgressReport, infra *extensionsv1alpha1.Infrastructure) error {
+	if report == nil {
+		return nil
+	}
+
+	status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return fmt.Errorf("failed to decode the infrastructure status: %w", err)
+	}
+
+	key := EgressReportKey(report, infra)
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, azure.ExtensionPurposeLabel, ExtensionPurposeEgressReport)
+		configMap.Data = EgressReportData(infra, status)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to write the egress report %s: %w", key, err)
+	}
+	return nil
+}
+
+// DeleteEgressReport deletes the egress report ConfigMap of the given Infrastructure if the egress report is
+// configured.
+func DeleteEgressReport(ctx context.Context, c client.Client, report *config.EgressReport, infra *extensionsv1alpha1.Infrastructure) error {
+	if report == nil {
+		return nil
+	}
+
+	key := EgressReportKey(report, infra)
+	if err := kubernetesutils.DeleteObject(ctx, c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}); err != nil {
+		return fmt.Errorf("failed to delete the egress report %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure_test
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure"
+)
+
+var _ = Describe("EgressReport", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		natID     = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/shoot--foo--bar-nat-gateway"
+		lbID      = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/loadBalancers/shoot--foo--bar-outbound"
+	)
+
+	var (
+		ctx   context.Context
+		c     client.Client
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	setProviderStatus := func(status *apiv1alpha1.InfrastructureStatus) {
+		status.TypeMeta = metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"}
+		raw, err := json.Marshal(status)
+		Expect(err).NotTo(HaveOccurred())
+		infra.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+			Status:     extensionsv1alpha1.InfrastructureStatus{EgressCIDRs: []string{"20.1.2.3/32", "20.1.2.4/32"}},
+		}
+		setProviderStatus(&apiv1alpha1.InfrastructureStatus{
+			ResourceGroup: apiv1alpha1.ResourceGroup{Name: namespace},
+			Networks: apiv1alpha1.NetworkStatus{
+				VNet:               apiv1alpha1.VNetStatus{Name: namespace},
+				OutboundAccessType: apiv1alpha1.OutboundAccessTypeNatGateway,
+				Subnets: []apiv1alpha1.Subnet{
+					{Name: namespace + "-nodes-z1", NatGatewayID: ptr.To(natID + "-z1")},
+					{Name: namespace + "-nodes-z2", NatGatewayID: ptr.To(natID + "-z2")},
+					{Name: namespace + "-nodes-z3"},
+				},
+			},
+		})
+	})
+
+	Describe("#EgressReportKey", func() {
+		It("should place the ConfigMap into the namespace of the infrastructure by default", func() {
+			Expect(EgressReportKey(&config.EgressReport{}, infra)).To(Equal(client.ObjectKey{Namespace: namespace, Name: "egress-report"}))
+		})
+
+		It("should prefix the name with the namespace of the infrastructure if a namespace is configured", func() {
+			Expect(EgressReportKey(&config.EgressReport{Namespace: "egress", Name: "azure"}, infra)).To(Equal(client.ObjectKey{Namespace: "egress", Name: namespace + "-azure"}))
+		})
+	})
+
+	Describe("#ReconcileEgressReport", func() {
+		report := &config.EgressReport{Namespace: "egress"}
+
+		getConfigMap := func() *corev1.ConfigMap {
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "egress", Name: namespace + "-egress-report"}, configMap)).To(Succeed())
+			return configMap
+		}
+
+		It("should write the egress CIDRs and the resource IDs", func() {
+			Expect(ReconcileEgressReport(ctx, c, report, infra)).To(Succeed())
+
+			configMap := getConfigMap()
+			Expect(configMap.Labels).To(HaveKeyWithValue(azure.ExtensionPurposeLabel, "egress-report"))
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"egressCIDRs":        "20.1.2.3/32,20.1.2.4/32",
+				"region":             "westeurope",
+				"outboundAccessType": "NATGateway",
+				"resourceGroup":      namespace,
+				"vnetName":           namespace,
+				"natGatewayIDs":      natID + "-z1," + natID + "-z2",
+			}))
+		})
+
+		It("should update the ConfigMap if the infrastructure changes", func() {
+			Expect(ReconcileEgressReport(ctx, c, report, infra)).To(Succeed())
+
+			infra.Status.EgressCIDRs = []string{"20.1.2.5/32"}
+			setProviderStatus(&apiv1alpha1.InfrastructureStatus{
+				ResourceGroup: apiv1alpha1.ResourceGroup{Name: namespace},
+				Networks: apiv1alpha1.NetworkStatus{
+					VNet:                 apiv1alpha1.VNetStatus{Name: "existing", ResourceGroup: ptr.To("network")},
+					OutboundAccessType:   apiv1alpha1.OutboundAccessTypeLoadBalancer,
+					OutboundLoadBalancer: &apiv1alpha1.OutboundLoadBalancerStatus{ID: lbID},
+				},
+				Identity: &apiv1alpha1.IdentityStatus{ID: "identity-id"},
+			})
+			Expect(ReconcileEgressReport(ctx, c, report, infra)).To(Succeed())
+
+			Expect(getConfigMap().Data).To(Equal(map[string]string{
+				"egressCIDRs":            "20.1.2.5/32",
+				"region":                 "westeurope",
+				"outboundAccessType":     "LoadBalancer",
+				"resourceGroup":          namespace,
+				"vnetName":               "existing",
+				"vnetResourceGroup":      "network",
+				"outboundLoadBalancerID": lbID,
+				"identityID":             "identity-id",
+			}))
+		})
+
+		It("should not write a ConfigMap if the egress report is not configured", func() {
+			Expect(ReconcileEgressReport(ctx, c, nil, infra)).To(Succeed())
+
+			configMaps := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, configMaps)).To(Succeed())
+			Expect(configMaps.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("#DeleteEgressReport", func() {
+		It("should delete the ConfigMap", func() {
+			report := &config.EgressReport{}
+			Expect(ReconcileEgressReport(ctx, c, report, infra)).To(Succeed())
+
+			Expect(DeleteEgressReport(ctx, c, report, infra)).To(Succeed())
+
+			configMaps := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, configMaps)).To(Succeed())
+			Expect(configMaps.Items).To(BeEmpty())
+		})
+
+		It("should succeed if the ConfigMap does not exist", func() {
+			Expect(DeleteEgressReport(ctx, c, &config.EgressReport{}, infra)).To(Succeed())
+		})
+	})
+})
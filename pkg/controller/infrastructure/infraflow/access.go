@@ -7,11 +7,12 @@ package infraflow
 import (
 	"context"
 	"errors"
-	"reflect"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
@@ -46,11 +47,19 @@ func (p *access) DeletePublicIP(ctx context.Context, rgName, pipName string) err
 		return err
 	}
 
-	if pip.Properties.NatGateway != nil && pip.Properties.NatGateway.Name != nil {
-		err := p.DisassociatePublicIP(ctx, rgName, *pip.Properties.NatGateway.Name, *pip.ID)
+	if pip == nil {
+		return nil
+	}
+
+	// the NAT Gateway may be located in another resource group than the public IP, e.g. in the network resource group.
+	if pip.Properties != nil && pip.Properties.NatGateway != nil && pip.Properties.NatGateway.ID != nil {
+		natID, err := arm.ParseResourceID(*pip.Properties.NatGateway.ID)
 		if err != nil {
 			return err
 		}
+		if err := p.DisassociatePublicIP(ctx, natID.ResourceGroupName, natID.Name, *pip.ID); err != nil {
+			return err
+		}
 	}
 
 	return pipClient.Delete(ctx, rgName, pipName)
@@ -67,10 +76,13 @@ func (p *access) DisassociatePublicIP(ctx context.Context, rgName, natName, pipI
 	if err != nil {
 		return err
 	}
+	if nat == nil || nat.Properties == nil {
+		return nil
+	}
 
 	var natPips []*armnetwork.SubResource
 	for _, natPip := range nat.Properties.PublicIPAddresses {
-		if natPip != nil && !reflect.DeepEqual(*natPip.ID, pipId) {
+		if natPip != nil && !strings.EqualFold(ptr.Deref(natPip.ID, ""), pipId) {
 			natPips = append(natPips, natPip)
 		}
 	}
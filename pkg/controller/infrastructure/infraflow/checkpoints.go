@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/utils/ptr"
+
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// keyCheckpointFingerprint is the key of the fingerprint of the infrastructure for which the checkpoints were recorded.
+	keyCheckpointFingerprint = "fingerprint"
+	// childKeyCheckpointTasks is the prefix key for the completion times of the tasks.
+	childKeyCheckpointTasks = "tasks"
+)
+
+var _ shared.Checkpoints = &checkpoints{}
+
+// checkpoints records the tasks of the reconciliation flow which completed in the whiteboard, so that they are persisted
+// in the infrastructure state together with the IDs of the resources they discovered. A checkpoint is valid for the
+// given TTL and as long as the fingerprint of the infrastructure does not change.
+type checkpoints struct {
+	tasks shared.Whiteboard
+	ttl   time.Duration
+	timer shared.Timestamper
+}
+
+// newCheckpoints returns the checkpoints stored in the given whiteboard. The checkpoints are discarded if they were
+// recorded for a different fingerprint.
+func newCheckpoints(wb shared.Whiteboard, fingerprint string, ttl time.Duration) *checkpoints {
+	cp := &checkpoints{
+		tasks: wb.GetChild(childKeyCheckpointTasks),
+		ttl:   ttl,
+		timer: shared.DefaultTimer,
+	}
+	if ptr.Deref(wb.Get(keyCheckpointFingerprint), "") != fingerprint {
+		cp.reset()
+		wb.Set(keyCheckpointFingerprint, fingerprint)
+	}
+	return cp
+}
+
+// Completed returns true if the task completed within the TTL.
+func (c *checkpoints) Completed(taskName string) bool {
+	completed := c.tasks.Get(taskName)
+	if completed == nil {
+		return false
+	}
+	ts, err := time.Parse(time.RFC3339, *completed)
+	if err != nil {
+		return false
+	}
+	return c.timer.Now().Sub(ts) < c.ttl
+}
+
+// Complete records the completion time of the task.
+func (c *checkpoints) Complete(taskName string) {
+	c.tasks.Set(taskName, c.timer.Now().UTC().Format(time.RFC3339))
+}
+
+func (c *checkpoints) reset() {
+	for _, taskName := range c.tasks.Keys() {
+		c.tasks.Delete(taskName)
+	}
+}
+
+// clearCheckpoints removes all checkpoints stored in the given whiteboard.
+func clearCheckpoints(wb shared.Whiteboard) {
+	(&checkpoints{tasks: wb.GetChild(childKeyCheckpointTasks)}).reset()
+	wb.Delete(keyCheckpointFingerprint)
+}
+
+// checkpointFingerprint returns a hash of the inputs of the reconciliation flow. Changes of the infrastructure spec, which
+// increase its generation, or of the annotations which influence the flow invalidate all checkpoints.
+func (fctx *FlowContext) checkpointFingerprint() (string, error) {
+	data, err := json.Marshal(struct {
+		Generation                   int64
+		ZoneMigration                string
+		DisableDefaultOutboundAccess string
+	}{
+		Generation:                   fctx.infra.Generation,
+		ZoneMigration:                fctx.infra.Annotations[consts.NetworkLayoutZoneMigrationAnnotation],
+		DisableDefaultOutboundAccess: fctx.cluster.Shoot.Annotations[consts.DisableDefaultOutboundAccessAnnotation],
+	})
+	if err != nil {
+		return "", err
+	}
+	return utils.ComputeSHA256Hex(data), nil
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Checkpoints", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+	)
+
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		factory         *mockazureclient.MockFactory
+		providersClient *mockazureclient.MockProviders
+		fakeClient      k8sclient.Client
+		infra           *extensionsv1alpha1.Infrastructure
+	)
+
+	newFlowContext := func(state *azure.InfrastructureState, ttl time.Duration) *infraflow.FlowContext {
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Client:        fakeClient,
+			Factory:       factory,
+			Auth:          &client.ClientAuth{SubscriptionID: subscriptionID},
+			Logger:        logr.Discard(),
+			Infra:         infra,
+			Cluster:       &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:         state,
+			CheckpointTTL: ttl,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	// stateOf returns the state with which the next reconciliation starts.
+	stateOf := func(fctx *infraflow.FlowContext) *azure.InfrastructureState {
+		persisted := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+		state := &azure.InfrastructureState{Data: persisted.Data}
+		for _, item := range persisted.ManagedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: item.ID})
+		}
+		return state
+	}
+
+	expectResourceProvidersToBeChecked := func() {
+		factory.EXPECT().Providers().Return(providersClient, nil)
+		providersClient.EXPECT().Get(gomock.Any(), gomock.Any()).
+			Return(&armresources.Provider{RegistrationState: ptr.To(infraflow.ResourceProviderRegistered)}, nil).
+			Times(len(infraflow.RequiredResourceProviders))
+	}
+
+	expectResourceGroupToFail := func() {
+		factory.EXPECT().Group().Return(nil, fmt.Errorf("transient error"))
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		providersClient = mockazureclient.NewMockProviders(ctrl)
+
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:      "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+			},
+		}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should skip the tasks which completed before a failure", func() {
+		expectResourceProvidersToBeChecked()
+		expectResourceGroupToFail()
+
+		fctx := newFlowContext(&azure.InfrastructureState{}, time.Hour)
+		Expect(fctx.Reconcile(ctx)).To(MatchError(ContainSubstring(`failed to "ensure resource group"`)))
+
+		By("resuming the reconciliation without checking the resource providers again")
+		expectResourceGroupToFail()
+
+		fctx = newFlowContext(stateOf(fctx), time.Hour)
+		Expect(fctx.Reconcile(ctx)).To(MatchError(ContainSubstring(`failed to "ensure resource group"`)))
+		Expect(stateOf(fctx).Data).To(HaveKey("checkpoints|tasks|ensure resource providers"))
+		Expect(stateOf(fctx).Data).NotTo(HaveKey("checkpoints|tasks|ensure resource group"))
+	})
+
+	It("should run all tasks again if the infrastructure changed", func() {
+		expectResourceProvidersToBeChecked()
+		expectResourceGroupToFail()
+
+		fctx := newFlowContext(&azure.InfrastructureState{}, time.Hour)
+		Expect(fctx.Reconcile(ctx)).To(HaveOccurred())
+
+		By("resuming the reconciliation after the infrastructure was changed")
+		infra.Spec.SSHPublicKey = []byte("ssh-rsa AAAA")
+		infra.Generation++
+		expectResourceProvidersToBeChecked()
+		expectResourceGroupToFail()
+
+		fctx = newFlowContext(stateOf(fctx), time.Hour)
+		Expect(fctx.Reconcile(ctx)).To(HaveOccurred())
+	})
+
+	It("should run all tasks again if checkpoints are disabled", func() {
+		expectResourceProvidersToBeChecked()
+		expectResourceGroupToFail()
+
+		fctx := newFlowContext(&azure.InfrastructureState{}, 0)
+		Expect(fctx.Reconcile(ctx)).To(HaveOccurred())
+		Expect(stateOf(fctx).Data).NotTo(HaveKey(HavePrefix("checkpoints")))
+
+		expectResourceProvidersToBeChecked()
+		expectResourceGroupToFail()
+
+		fctx = newFlowContext(stateOf(fctx), 0)
+		Expect(fctx.Reconcile(ctx)).To(HaveOccurred())
+	})
+})
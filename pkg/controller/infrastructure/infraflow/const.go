@@ -17,10 +17,23 @@ const (
 	KeyManagedIdentityClientId = "managed_identity_client_id"
 	// KeyManagedIdentityId is a key for the MI's identity ID.
 	KeyManagedIdentityId = "managed_identity_id"
+	// KeyManagedIdentityPrincipalId is a key for the MI's principal ID.
+	KeyManagedIdentityPrincipalId = "managed_identity_principal_id"
+
+	// ChildKeyServiceEndpointPolicies is the prefix key for the service endpoint policies attached to the subnets by the extension.
+	ChildKeyServiceEndpointPolicies = "service_endpoint_policies"
+
+	// ChildKeyCheckpoints is the prefix key for the checkpoints of the reconciliation flow.
+	ChildKeyCheckpoints = "checkpoints"
 
 	// ChildKeyMigration is the prefix key for data stored during migrations.
 	ChildKeyMigration = "migration"
 
+	// GatewaySubnetName is the name which Azure requires for the subnet of the virtual network gateways.
+	GatewaySubnetName = "GatewaySubnet"
+	// RouteServerSubnetName is the name which Azure requires for the subnet of the Azure Route Server.
+	RouteServerSubnetName = "RouteServerSubnet"
+
 	// TagManagedByGardener is the tag used to mark resources managed by Gardener.
 	TagManagedByGardener = "managed-by-gardener"
 	// TagShootName is the tag used to mark the shoot name on resources managed by Gardener.
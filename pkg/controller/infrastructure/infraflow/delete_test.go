@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Delete", func() {
+	const (
+		namespace     = "shoot--foo--bar"
+		vnetGroup     = "foreign-rg"
+		vnetName      = "foreign-vnet"
+		subnetName    = namespace + "-nodes"
+		nicIPConfigID = "/subscriptions/sub/resourceGroups/" + namespace + "/providers/Microsoft.Network/networkInterfaces/" + namespace + "-machine-nic/ipConfigurations/" + namespace + "-machine-nic"
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		ctx          context.Context
+		factory      *mockazureclient.MockFactory
+		subnetClient *mockazureclient.MockSubnet
+		fctx         *infraflow.FlowContext
+	)
+
+	usedSubnet := func() *armnetwork.Subnet {
+		return &armnetwork.Subnet{
+			Name: ptr.To(subnetName),
+			Properties: &armnetwork.SubnetPropertiesFormat{
+				IPConfigurations: []*armnetwork.IPConfiguration{{ID: ptr.To(nicIPConfigID)}},
+			},
+		}
+	}
+	unusedSubnet := func() *armnetwork.Subnet {
+		return &armnetwork.Subnet{Name: ptr.To(subnetName), Properties: &armnetwork.SubnetPropertiesFormat{}}
+	}
+	inUseError := &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InUseSubnetCannotBeDeleted"}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		subnetClient = mockazureclient.NewMockSubnet(ctrl)
+		factory.EXPECT().Subnet().Return(subnetClient, nil)
+
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{Name: ptr.To(vnetName), ResourceGroup: ptr.To(vnetGroup)},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err = infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster:      &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:        &azure.InfrastructureState{},
+			InUseBackoff: &wait.Backoff{Duration: time.Millisecond, Steps: 3},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#WaitForSubnetsInForeignGroupToBeUnused", func() {
+		It("should wait until the network interfaces are gone", func() {
+			gomock.InOrder(
+				subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{usedSubnet()}, nil),
+				subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{unusedSubnet()}, nil),
+			)
+
+			Expect(fctx.WaitForSubnetsInForeignGroupToBeUnused(ctx)).To(Succeed())
+		})
+
+		It("should ignore subnets which are not managed for the shoot", func() {
+			subnet := usedSubnet()
+			subnet.Name = ptr.To("other-subnet")
+			subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{subnet}, nil)
+
+			Expect(fctx.WaitForSubnetsInForeignGroupToBeUnused(ctx)).To(Succeed())
+		})
+
+		It("should name the network interface which blocks the deletion of the subnet", func() {
+			subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{usedSubnet()}, nil).Times(3)
+
+			err := fctx.WaitForSubnetsInForeignGroupToBeUnused(ctx)
+			Expect(err).To(MatchError(ContainSubstring("Name: " + subnetName)))
+			Expect(err).To(MatchError(ContainSubstring("Used by: [networkInterfaces/" + namespace + "-machine-nic]")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorRetryableInfraDependencies))
+		})
+	})
+
+	Describe("#DeleteSubnetsInForeignGroup", func() {
+		It("should retry the deletion as long as the subnet is in use", func() {
+			subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{unusedSubnet()}, nil)
+			gomock.InOrder(
+				subnetClient.EXPECT().Delete(ctx, vnetGroup, vnetName, subnetName).Return(inUseError),
+				subnetClient.EXPECT().Delete(ctx, vnetGroup, vnetName, subnetName).Return(nil),
+			)
+
+			Expect(fctx.DeleteSubnetsInForeignGroup(ctx)).To(Succeed())
+		})
+
+		It("should report the subnet in use after the retries are exhausted", func() {
+			subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{usedSubnet()}, nil)
+			subnetClient.EXPECT().Delete(ctx, vnetGroup, vnetName, subnetName).Return(inUseError).Times(3)
+
+			err := fctx.DeleteSubnetsInForeignGroup(ctx)
+			var inUseErr *infraflow.ResourceInUseError
+			Expect(errors.As(err, &inUseErr)).To(BeTrue())
+			Expect(inUseErr.Name).To(Equal(subnetName))
+			Expect(inUseErr.Users).To(ConsistOf("networkInterfaces/" + namespace + "-machine-nic"))
+			Expect(err).To(MatchError(ContainSubstring("InUseSubnetCannotBeDeleted")))
+		})
+
+		It("should not retry other errors", func() {
+			subnetClient.EXPECT().List(ctx, vnetGroup, vnetName).Return([]*armnetwork.Subnet{unusedSubnet()}, nil)
+			subnetClient.EXPECT().Delete(ctx, vnetGroup, vnetName, subnetName).Return(errors.New("boom"))
+
+			Expect(fctx.DeleteSubnetsInForeignGroup(ctx)).To(MatchError("boom"))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+// checkDeletionProtection returns a DeletionProtectionError if the network of the shoot is protected against
+// deletions and the given resources would be deleted without the confirmation of an operator.
+func (fctx *FlowContext) checkDeletionProtection(resources []string) error {
+	if len(resources) == 0 || !ptr.Deref(fctx.cfg.Networks.DeletionProtection, false) {
+		return nil
+	}
+	if confirmed, _ := strconv.ParseBool(fctx.cluster.Shoot.Annotations[consts.ConfirmNetworkDeletionAnnotation]); confirmed {
+		return nil
+	}
+	return &DeletionProtectionError{Resources: resources}
+}
+
+// removedAddressPrefixes returns the address prefixes of the current VNet which the desired VNet does not contain.
+func removedAddressPrefixes(current, desired []*string) []string {
+	keep := sets.New[string]()
+	for _, prefix := range desired {
+		keep.Insert(ptr.Deref(prefix, ""))
+	}
+
+	var removed []string
+	for _, prefix := range current {
+		if prefix != nil && !keep.Has(*prefix) {
+			removed = append(removed, *prefix)
+		}
+	}
+	return removed
+}
+
+// vnetAddressPrefixes returns the address prefixes of the given VNet.
+func vnetAddressPrefixes(vnet *armnetwork.VirtualNetwork) []*string {
+	if vnet == nil || vnet.Properties == nil || vnet.Properties.AddressSpace == nil {
+		return nil
+	}
+	return vnet.Properties.AddressSpace.AddressPrefixes
+}
+
+func describeAddressPrefixes(vnetName string, prefixes []string) []string {
+	resources := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		resources = append(resources, fmt.Sprintf("address prefix %s of VNet %s", prefix, vnetName))
+	}
+	return resources
+}
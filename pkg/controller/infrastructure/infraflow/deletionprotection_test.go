@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("DeletionProtection", func() {
+	const (
+		namespace        = "shoot--foo--bar"
+		region           = "westeurope"
+		subscriptionID   = "00000000-0000-0000-0000-000000000000"
+		vnetID           = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+		workerSubnet     = namespace + "-nodes"
+		obsoleteSubnet   = namespace + "-nodes-obsolete"
+		workerSubnetID   = vnetID + "/subnets/" + workerSubnet
+		obsoleteSubnetID = vnetID + "/subnets/" + obsoleteSubnet
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		ctx          context.Context
+		factory      *mockazureclient.MockFactory
+		vnetClient   *mockazureclient.MockVirtualNetwork
+		subnetClient *mockazureclient.MockSubnet
+		shoot        *gardencorev1beta1.Shoot
+		protected    bool
+	)
+
+	newFlowContext := func() *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:               v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers:            ptr.To("10.250.0.0/16"),
+				DeletionProtection: ptr.To(protected),
+			},
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: shoot},
+			State:   &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		vnetClient = mockazureclient.NewMockVirtualNetwork(ctrl)
+		subnetClient = mockazureclient.NewMockSubnet(ctrl)
+		shoot = &gardencorev1beta1.Shoot{}
+		protected = true
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureVirtualNetwork", func() {
+		var current *armnetwork.VirtualNetwork
+
+		BeforeEach(func() {
+			current = &armnetwork.VirtualNetwork{
+				ID:       ptr.To(vnetID),
+				Location: ptr.To(region),
+				Properties: &armnetwork.VirtualNetworkPropertiesFormat{
+					AddressSpace: &armnetwork.AddressSpace{AddressPrefixes: []*string{ptr.To("10.0.0.0/8"), ptr.To("192.168.0.0/16")}},
+				},
+			}
+			factory.EXPECT().Vnet().Return(vnetClient, nil).AnyTimes()
+			vnetClient.EXPECT().Get(ctx, namespace, namespace).Return(current, nil)
+		})
+
+		It("should refuse to remove an address prefix of the protected VNet", func() {
+			err := newFlowContext().EnsureVirtualNetwork(ctx)
+
+			var protectionErr *infraflow.DeletionProtectionError
+			Expect(errors.As(err, &protectionErr)).To(BeTrue())
+			Expect(protectionErr.Resources).To(ConsistOf("address prefix 192.168.0.0/16 of VNet " + namespace))
+			Expect(protectionErr.Codes()).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			Expect(err).To(MatchError(ContainSubstring(consts.ConfirmNetworkDeletionAnnotation + "=true")))
+		})
+
+		DescribeTable("should remove the address prefix",
+			func(prepare func()) {
+				prepare()
+				vnetClient.EXPECT().CreateOrUpdate(ctx, namespace, namespace, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, vnet armnetwork.VirtualNetwork) (*armnetwork.VirtualNetwork, error) {
+						Expect(vnet.Properties.AddressSpace.AddressPrefixes).To(ConsistOf(ptr.To("10.0.0.0/8")))
+						return current, nil
+					})
+				vnetClient.EXPECT().Get(ctx, namespace, namespace).Return(current, nil)
+
+				Expect(newFlowContext().EnsureVirtualNetwork(ctx)).To(Succeed())
+			},
+			Entry("if the VNet is not protected", func() { protected = false }),
+			Entry("if the deletion is confirmed", func() {
+				shoot.Annotations = map[string]string{consts.ConfirmNetworkDeletionAnnotation: "true"}
+			}),
+		)
+	})
+
+	Describe("#EnsureSubnets", func() {
+		BeforeEach(func() {
+			factory.EXPECT().Subnet().Return(subnetClient, nil)
+			subnetClient.EXPECT().List(ctx, namespace, namespace).Return([]*armnetwork.Subnet{
+				{ID: ptr.To(workerSubnetID), Name: ptr.To(workerSubnet), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.250.0.0/16")}},
+				{ID: ptr.To(obsoleteSubnetID), Name: ptr.To(obsoleteSubnet), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.251.0.0/16")}},
+			}, nil)
+		})
+
+		It("should refuse to delete a subnet of the protected network", func() {
+			err := newFlowContext().EnsureSubnets(ctx)
+
+			var protectionErr *infraflow.DeletionProtectionError
+			Expect(errors.As(err, &protectionErr)).To(BeTrue())
+			Expect(protectionErr.Resources).To(ConsistOf("subnet " + obsoleteSubnet))
+		})
+
+		It("should delete the subnet if the deletion is confirmed", func() {
+			shoot.Annotations = map[string]string{consts.ConfirmNetworkDeletionAnnotation: "true"}
+			subnetClient.EXPECT().Delete(ctx, namespace, namespace, obsoleteSubnet).Return(nil)
+			subnetClient.EXPECT().CreateOrUpdate(ctx, namespace, namespace, workerSubnet, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _, _ string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+					subnet.ID = ptr.To(workerSubnetID)
+					return &subnet, nil
+				})
+
+			Expect(newFlowContext().EnsureSubnets(ctx)).To(Succeed())
+		})
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -15,6 +17,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -56,7 +60,7 @@ func (fctx *FlowContext) ensureResourceGroup(ctx context.Context) (*armresources
 		if location := ptr.Deref(rg.Location, ""); location != rgCfg.Location {
 			// special case - return an error but do not proceed without user input.
 			return nil, NewSpecMismatchError(rgCfg.AzureResourceMetadata, "location", rgCfg.Location, location,
-				to.Ptr("This error is caused because the resource group location does not match the shoot's region. To proceed please delete the resource group"),
+				to.Ptr("This error is caused because the resource group location does not match the configured resource group location, which defaults to the shoot's region. To proceed please delete the resource group"),
 			)
 		}
 
@@ -122,7 +126,14 @@ func (fctx *FlowContext) ensureManagedVirtualNetwork(ctx context.Context) (*armn
 		}
 	}
 
+	// the current prefixes are captured first, because the desired VNet reuses the properties of the current one.
+	currentPrefixes := slices.Clone(vnetAddressPrefixes(vnet))
 	vnet = vnetCfg.ToProvider(vnet)
+	removed := removedAddressPrefixes(currentPrefixes, vnetAddressPrefixes(vnet))
+	if err := fctx.checkDeletionProtection(describeAddressPrefixes(vnetCfg.Name, removed)); err != nil {
+		return nil, err
+	}
+
 	log.Info("reconciling virtual network", "name", vnetCfg.Name)
 	log.V(1).Info("creating virtual network with spec", "spec", *vnet)
 	vnet, err = c.CreateOrUpdate(ctx, vnetCfg.ResourceGroup, vnetCfg.Name, *vnet)
@@ -198,6 +209,10 @@ func (fctx *FlowContext) ensureRouteTable(ctx context.Context) (*armnetwork.Rout
 
 // EnsureSecurityGroup creates or updates a KindSecurityGroup
 func (fctx *FlowContext) EnsureSecurityGroup(ctx context.Context) error {
+	if !fctx.adapter.SecurityGroupConfig().Managed {
+		return fctx.EnsureExistingSecurityGroup(ctx)
+	}
+
 	log := shared.LogFromContext(ctx)
 	sg, err := fctx.ensureSecurityGroup(ctx)
 	if err != nil {
@@ -308,6 +323,9 @@ func (fctx *FlowContext) ensurePublicIps(ctx context.Context) error {
 	desiredConfiguration := fctx.adapter.ManagedIpConfigs()
 	for name, ip := range desiredConfiguration {
 		toReconcile[name] = ip.ToProvider(nameToCurrentIps[name])
+		if prefix := ip.PublicIPPrefix; prefix != nil {
+			toReconcile[name].Properties.PublicIPPrefix = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplatePublicIPPrefix, fctx.auth.SubscriptionID, prefix.ResourceGroup, prefix.Name))}
+		}
 	}
 
 	for _, resource := range fctx.inventory.ByKind(KindPublicIP) {
@@ -344,6 +362,11 @@ func (fctx *FlowContext) ensurePublicIps(ctx context.Context) error {
 		}
 	}
 
+	// the prefixes must provide the public IPs which are recreated before the current public IPs are deleted.
+	if err := fctx.ensurePublicIPPrefixes(ctx, nameToCurrentIps, toDelete); err != nil {
+		return err
+	}
+
 	for ipName, ip := range toDelete {
 		err := fctx.providerAccess.DeletePublicIP(ctx, fctx.adapter.ResourceGroupName(), ipName)
 		if err != nil {
@@ -392,7 +415,7 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 		return err
 	}
 
-	currentNats, err := c.List(ctx, fctx.adapter.ResourceGroupName())
+	currentNats, err := c.List(ctx, fctx.adapter.NetworkResourceGroupName())
 	if err != nil {
 		return err
 	}
@@ -429,19 +452,23 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 
 		targetNat, ok := toReconcile[name]
 		if !ok {
-			log.Info("Will delete NAT Gateway because it is not needed", "Resource Group", fctx.adapter.ResourceGroupName(), "Name", *current.Name)
+			log.Info("Will delete NAT Gateway because it is not needed", "Resource Group", fctx.adapter.NetworkResourceGroupName(), "Name", *current.Name)
 			toDelete[name] = *current.ID
 			continue
 		}
 		if ok, offender, v := ForceNewNat(current, targetNat); ok {
-			log.Info("Will delete NAT Gateway because it cannot be reconciled", "Resource Group", fctx.adapter.ResourceGroupName(), "Name", *current.Name, "Field", offender, "Value", v)
+			log.Info("Will delete NAT Gateway because it cannot be reconciled", "Resource Group", fctx.adapter.NetworkResourceGroupName(), "Name", *current.Name, "Field", offender, "Value", v)
 			toDelete[name] = *current.ID
 			continue
 		}
+		if current.Properties != nil && ptr.Deref(current.Properties.IdleTimeoutInMinutes, 4) != ptr.Deref(targetNat.Properties.IdleTimeoutInMinutes, 4) {
+			log.Info("Will update the idle timeout of NAT Gateway in place", "Resource Group", fctx.adapter.NetworkResourceGroupName(), "Name", *current.Name,
+				"Current", ptr.Deref(current.Properties.IdleTimeoutInMinutes, 4), "Desired", ptr.Deref(targetNat.Properties.IdleTimeoutInMinutes, 4))
+		}
 	}
 
 	for natName, nat := range toDelete {
-		err := fctx.providerAccess.DeleteNatGateway(ctx, fctx.adapter.ResourceGroupName(), natName)
+		err := fctx.providerAccess.DeleteNatGateway(ctx, fctx.adapter.NetworkResourceGroupName(), natName)
 		if err != nil {
 			joinError = errors.Join(joinError, err)
 		}
@@ -455,7 +482,7 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 	ipAddresses := []string{}
 
 	for name, nat := range toReconcile {
-		nat, err := c.CreateOrUpdate(ctx, fctx.adapter.ResourceGroupName(), name, *nat)
+		nat, err := c.CreateOrUpdate(ctx, fctx.adapter.NetworkResourceGroupName(), name, *nat)
 		if err != nil {
 			joinError = errors.Join(joinError, err)
 			continue
@@ -503,7 +530,9 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 		vnetName    = fctx.adapter.VirtualNetworkConfig().Name
 		toDelete    = map[string]*armnetwork.Subnet{}
 		toReconcile = map[string]*armnetwork.Subnet{}
-		joinErr     error
+		// desiredPolicies maps the subnet name to the service endpoint policies configured for it.
+		desiredPolicies = map[string][]string{}
+		joinErr         error
 	)
 
 	c, err := fctx.factory.Subnet()
@@ -532,13 +561,54 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 		}
 	}
 
-	zones := fctx.adapter.Zones()
+	if err := fctx.validateServiceEndpointPolicies(ctx); err != nil {
+		return err
+	}
+	if err := fctx.validatePrivateLink(ctx); err != nil {
+		return err
+	}
+
+	zones := slices.Clone(fctx.adapter.Zones())
+	if podSubnet := fctx.adapter.PodSubnetConfig(); podSubnet != nil {
+		// the pod subnet spans all zones, hence it can only share the NAT Gateway of the single subnet layout.
+		z := ZoneConfig{Subnet: *podSubnet}
+		if len(fctx.cfg.Networks.Zones) == 0 {
+			z.NatGateway = zones[0].NatGateway
+		}
+		zones = append(zones, z)
+	}
+	zones = append(zones, fctx.adapter.AdditionalSubnetConfigs()...)
+	if privateLinkSubnet := fctx.adapter.PrivateLinkSubnetConfig(); privateLinkSubnet != nil {
+		zones = append(zones, ZoneConfig{Subnet: *privateLinkSubnet})
+	}
+	if internalLoadBalancerSubnet := fctx.adapter.InternalLoadBalancerSubnetConfig(); internalLoadBalancerSubnet != nil {
+		zones = append(zones, ZoneConfig{Subnet: *internalLoadBalancerSubnet})
+	}
+	if gatewaySubnet := fctx.adapter.GatewaySubnetConfig(); gatewaySubnet != nil {
+		zones = append(zones, ZoneConfig{Subnet: *gatewaySubnet})
+	}
+	if routeServerSubnet := fctx.adapter.RouteServerSubnetConfig(); routeServerSubnet != nil {
+		zones = append(zones, ZoneConfig{Subnet: *routeServerSubnet})
+	}
 	for _, z := range zones {
 		actual := z.Subnet.ToProvider(mappedSubnets[z.Subnet.Name])
+		if z.Subnet.IsGateway() || z.Subnet.IsRouteServer() {
+			// the gateway and the route server subnet keep the route table which their owner may have attached, e.g. for
+			// forced tunneling.
+			toReconcile[z.Subnet.Name] = actual
+			continue
+		}
 
 		rtCfg := fctx.adapter.RouteTableConfig()
 		actual.Properties.RouteTable = &armnetwork.RouteTable{ID: to.Ptr(GetIdFromTemplate(TemplateRouteTable, fctx.auth.SubscriptionID, rtCfg.ResourceGroup, rtCfg.Name))}
 
+		desiredPolicies[z.Subnet.Name] = z.Subnet.ServiceEndpointPolicies()
+		actual.Properties.ServiceEndpointPolicies = MergeServiceEndpointPolicies(
+			actual.Properties.ServiceEndpointPolicies,
+			fctx.attachedServiceEndpointPolicies(z.Subnet.Name),
+			z.Subnet.ServiceEndpointPolicies(),
+		)
+
 		sgCfg := fctx.adapter.SecurityGroupConfig()
 		actual.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(GetIdFromTemplate(TemplateSecurityGroup, fctx.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))}
 
@@ -558,7 +628,7 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 				}
 				// if this is a user-managed NAT gateway, do nothing. This is checked by looking at the resource group of the NGW.
 				// In case that the NGW belongs to our RG, but it should not exist (z.NatGateway == nil), we remove the association.
-				if resourceId.ResourceGroupName == fctx.adapter.ResourceGroupName() {
+				if resourceId.ResourceGroupName == fctx.adapter.NetworkResourceGroupName() {
 					actual.Properties.NatGateway = nil
 				}
 			}
@@ -584,6 +654,19 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 		}
 	}
 
+	if err := fctx.retainPrivateLinkSubnet(ctx, toDelete); err != nil {
+		return err
+	}
+
+	protected := make([]string, 0, len(toDelete))
+	for name := range toDelete {
+		protected = append(protected, "subnet "+name)
+	}
+	slices.Sort(protected)
+	if err := fctx.checkDeletionProtection(protected); err != nil {
+		return err
+	}
+
 	for name, subnet := range toDelete {
 		err := c.Delete(ctx, vnetRgroup, vnetName, name)
 		if err != nil {
@@ -591,6 +674,7 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 		}
 		fctx.inventory.Delete(*subnet.ID)
 		fctx.whiteboard.GetChild(KindNatGateway.String()).Set(name, *subnet.ID)
+		fctx.whiteboard.GetChild(ChildKeyServiceEndpointPolicies).Delete(name)
 	}
 	if joinErr != nil {
 		return joinErr
@@ -607,6 +691,7 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 			continue
 		}
 		fctx.whiteboard.GetChild(KindSubnet.String()).Set(name, *subnet.ID)
+		fctx.setAttachedServiceEndpointPolicies(name, desiredPolicies[name])
 		if subnet.Properties.NatGateway != nil && subnet.Properties.NatGateway.ID != nil {
 			fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindNatGateway.String()).Set("id", *subnet.Properties.NatGateway.ID)
 		}
@@ -615,6 +700,63 @@ func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 	return joinErr
 }
 
+// validateServiceEndpointPolicies checks that the service endpoint policies referenced by the subnets exist and are in the
+// region of the shoot. The policies are managed externally, hence the extension never creates or deletes them.
+func (fctx *FlowContext) validateServiceEndpointPolicies(ctx context.Context) error {
+	var ids []string
+	for _, z := range fctx.adapter.Zones() {
+		ids = append(ids, z.Subnet.ServiceEndpointPolicies()...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.ServiceEndpointPolicy()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		resourceID, err := arm.ParseResourceID(id)
+		if err != nil {
+			return err
+		}
+		metadata := AzureResourceMetadata{ResourceGroup: resourceID.ResourceGroupName, Name: resourceID.Name, Kind: KindServiceEndpointPolicy}
+		if !strings.EqualFold(resourceID.SubscriptionID, fctx.auth.SubscriptionID) {
+			return NewTerminalConditionError(metadata, fmt.Errorf("service endpoint policy %s must be in the subscription of the shoot", id))
+		}
+
+		policy, err := c.Get(ctx, resourceID.ResourceGroupName, resourceID.Name)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return NewTerminalConditionError(metadata, fmt.Errorf("service endpoint policy %s does not exist", id))
+		}
+		if location := ptr.Deref(policy.Location, ""); !strings.EqualFold(location, fctx.adapter.Region()) {
+			return NewTerminalConditionError(metadata, fmt.Errorf("service endpoint policy %s is in location %q but must be in the region of the shoot %q", id, location, fctx.adapter.Region()))
+		}
+	}
+	return nil
+}
+
+// attachedServiceEndpointPolicies returns the service endpoint policies the extension attached to the given subnet.
+func (fctx *FlowContext) attachedServiceEndpointPolicies(subnetName string) []string {
+	policies := ptr.Deref(fctx.whiteboard.GetChild(ChildKeyServiceEndpointPolicies).Get(subnetName), "")
+	if policies == "" {
+		return nil
+	}
+	return strings.Split(policies, ",")
+}
+
+func (fctx *FlowContext) setAttachedServiceEndpointPolicies(subnetName string, policies []string) {
+	if len(policies) == 0 {
+		fctx.whiteboard.GetChild(ChildKeyServiceEndpointPolicies).Delete(subnetName)
+		return
+	}
+	fctx.whiteboard.GetChild(ChildKeyServiceEndpointPolicies).Set(subnetName, strings.Join(policies, ","))
+}
+
 // EnsureManagedIdentity reconciles the managed identity specificed in the config.
 func (fctx *FlowContext) EnsureManagedIdentity(ctx context.Context) (err error) {
 	if fctx.cfg.Identity == nil {
@@ -635,6 +777,9 @@ func (fctx *FlowContext) EnsureManagedIdentity(ctx context.Context) (err error)
 
 	fctx.whiteboard.Set(KeyManagedIdentityClientId, *res.Properties.ClientID)
 	fctx.whiteboard.Set(KeyManagedIdentityId, *res.ID)
+	if res.Properties.PrincipalID != nil {
+		fctx.whiteboard.Set(KeyManagedIdentityPrincipalId, *res.Properties.PrincipalID)
+	}
 	return err
 }
 
@@ -669,9 +814,16 @@ func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.I
 		Zoned: fctx.cfg.Zoned,
 	}
 
-	if fctx.cfg.Networks.VNet.ResourceGroup != nil {
+	if sgCfg := fctx.adapter.SecurityGroupConfig(); !sgCfg.Managed {
+		status.SecurityGroups[0].ResourceGroup = to.Ptr(sgCfg.ResourceGroup)
+	}
+
+	if fctx.cfg.Networks.VNet.ResourceGroup != nil || fctx.adapter.HasNetworkResourceGroup() {
 		status.Networks.VNet.ResourceGroup = to.Ptr(fctx.adapter.VirtualNetworkConfig().ResourceGroup)
 	}
+	if fctx.adapter.HasNetworkResourceGroup() {
+		status.Networks.ResourceGroup = to.Ptr(fctx.adapter.NetworkResourceGroupName())
+	}
 
 	if len(fctx.cfg.Networks.Zones) > 0 {
 		status.Networks.Layout = v1alpha1.NetworkLayoutMultipleSubnet
@@ -691,11 +843,67 @@ func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.I
 			// if at least one of the "zones" does not have NATGateway enabled, mark the outbound access as OutboundAccessTypeLoadBalancer.
 			outboundAccessType = v1alpha1.OutboundAccessTypeLoadBalancer
 		}
+		if z.NatGateway != nil {
+			subnet.NatGatewayPublicIPCount = to.Ptr(int32(len(z.NatGateway.PublicIPList)))
+		}
 
 		status.Networks.Subnets = append(status.Networks.Subnets, subnet)
 	}
 	status.Networks.OutboundAccessType = outboundAccessType
 
+	for _, z := range fctx.adapter.AdditionalSubnetConfigs() {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    z.Subnet.Name,
+			Purpose: v1alpha1.PurposeNodes,
+			Zone:    z.Subnet.zone,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(z.Subnet.Name),
+		})
+	}
+
+	if podSubnet := fctx.adapter.PodSubnetConfig(); podSubnet != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    podSubnet.Name,
+			Purpose: v1alpha1.PurposePods,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(podSubnet.Name),
+		})
+	}
+
+	if privateLinkSubnet := fctx.adapter.PrivateLinkSubnetConfig(); privateLinkSubnet != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    privateLinkSubnet.Name,
+			Purpose: v1alpha1.PurposePrivateLink,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(privateLinkSubnet.Name),
+		})
+	}
+
+	if internalLoadBalancerSubnet := fctx.adapter.InternalLoadBalancerSubnetConfig(); internalLoadBalancerSubnet != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    internalLoadBalancerSubnet.Name,
+			Purpose: v1alpha1.PurposeInternalLoadBalancer,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(internalLoadBalancerSubnet.Name),
+		})
+	}
+
+	if gatewaySubnet := fctx.adapter.GatewaySubnetConfig(); gatewaySubnet != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    gatewaySubnet.Name,
+			Purpose: v1alpha1.PurposeGateway,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(gatewaySubnet.Name),
+		})
+	}
+
+	if routeServerSubnet := fctx.adapter.RouteServerSubnetConfig(); routeServerSubnet != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    routeServerSubnet.Name,
+			Purpose: v1alpha1.PurposeRouteServer,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(routeServerSubnet.Name),
+		})
+	}
+
+	status.Networks.PrivateDNSZone = fctx.privateDNSZoneStatus()
+	status.Networks.OutboundLoadBalancer = fctx.outboundLoadBalancerStatus()
+	status.Networks.NetworkWatcher = fctx.networkWatcherStatus()
+
 	if identity := fctx.cfg.Identity; identity != nil {
 		status.Identity = &v1alpha1.IdentityStatus{
 			ID:        *fctx.whiteboard.Get(KeyManagedIdentityId),
@@ -736,18 +944,23 @@ func (fctx *FlowContext) GetInfrastructureState() *runtime.RawExtension {
 
 // GetEgressIpCidrs retrieves the CIDRs of the IP ranges used for egress from the FlowContext
 func (fctx *FlowContext) GetEgressIpCidrs() []string {
-	if fctx.whiteboard.HasChild(KindNatGateway.String()) && fctx.whiteboard.GetChild(KindNatGateway.String()).HasObject(KeyPublicIPAddresses) {
-		ipAddresses, ok := fctx.whiteboard.GetChild(KindNatGateway.String()).GetObject(KeyPublicIPAddresses).([]string)
+	var cidrs []string
+	for _, kind := range []AzureResourceKind{KindNatGateway, KindLoadBalancer} {
+		if !fctx.whiteboard.HasChild(kind.String()) || !fctx.whiteboard.GetChild(kind.String()).HasObject(KeyPublicIPAddresses) {
+			continue
+		}
+		ipAddresses, ok := fctx.whiteboard.GetChild(kind.String()).GetObject(KeyPublicIPAddresses).([]string)
 		if !ok {
-			return nil
+			continue
+		}
+		if cidrs == nil {
+			cidrs = []string{}
 		}
-		cidrs := []string{}
 		for _, address := range ipAddresses {
 			cidrs = append(cidrs, address+"/32")
 		}
-		return cidrs
 	}
-	return nil
+	return cidrs
 }
 
 // DeleteResourceGroup deletes the shoot's resource group.
@@ -759,7 +972,8 @@ func (fctx *FlowContext) DeleteResourceGroup(ctx context.Context) error {
 	return c.Delete(ctx, fctx.adapter.ResourceGroupName())
 }
 
-// DeleteSubnetsInForeignGroup deletes all managed subnets in a foreign resource group
+// DeleteSubnetsInForeignGroup deletes all managed subnets in a foreign resource group. The deletion of a subnet is retried
+// with backoff as long as Azure reports that it is still in use.
 func (fctx *FlowContext) DeleteSubnetsInForeignGroup(ctx context.Context) error {
 	vnetCfg := fctx.adapter.VirtualNetworkConfig()
 	vnetRgroup := vnetCfg.ResourceGroup
@@ -770,20 +984,19 @@ func (fctx *FlowContext) DeleteSubnetsInForeignGroup(ctx context.Context) error
 		return err
 	}
 
-	currentSubnets, err := c.List(ctx, vnetRgroup, vnetName)
-
-	// In case we cannot list any subnets at all, assume that the deletion succeeded at an earlier point in time.
-	if client.FilterNotFoundError(err) != nil {
+	filteredSubnets, err := fctx.listSubnetsInForeignGroup(ctx, c)
+	if err != nil {
 		return err
 	}
 
-	filteredSubnets := Filter(currentSubnets, func(s *armnetwork.Subnet) bool {
-		return fctx.adapter.HasShootPrefix(s.Name)
-	})
-
 	var joinErr error
 	for _, s := range filteredSubnets {
-		err := c.Delete(ctx, vnetRgroup, vnetName, *s.Name)
+		err := fctx.retryWhileInUse(ctx, func() error {
+			return c.Delete(ctx, vnetRgroup, vnetName, *s.Name)
+		})
+		if client.IsAzureAPIInUseError(err) {
+			err = NewResourceInUseError(AzureResourceMetadata{ResourceGroup: vnetRgroup, Name: *s.Name, Parent: vnetName, Kind: KindSubnet}, subnetUsers(s), err)
+		}
 		if err != nil {
 			joinErr = errors.Join(joinErr, err)
 		}
@@ -791,6 +1004,101 @@ func (fctx *FlowContext) DeleteSubnetsInForeignGroup(ctx context.Context) error
 	return joinErr
 }
 
+// WaitForSubnetsInForeignGroupToBeUnused waits until no network interfaces use the managed subnets in a foreign resource
+// group anymore, e.g. those of machines of a worker pool whose deletion is still in progress. Otherwise, the deletion of
+// the subnets fails.
+func (fctx *FlowContext) WaitForSubnetsInForeignGroupToBeUnused(ctx context.Context) error {
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+
+	var inUseErr error
+	err = fctx.retryWhileInUse(ctx, func() error {
+		subnets, err := fctx.listSubnetsInForeignGroup(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		inUseErr = nil
+		for _, s := range subnets {
+			if users := subnetUsers(s); len(users) > 0 {
+				inUseErr = errors.Join(inUseErr, NewResourceInUseError(AzureResourceMetadata{ResourceGroup: vnetCfg.ResourceGroup, Name: *s.Name, Parent: vnetCfg.Name, Kind: KindSubnet}, users, nil))
+			}
+		}
+		if inUseErr != nil {
+			fctx.log.Info("Waiting for subnets to be no longer in use", "reason", inUseErr.Error())
+			return errSubnetsInUse
+		}
+		return nil
+	})
+	if errors.Is(err, errSubnetsInUse) {
+		return inUseErr
+	}
+	return err
+}
+
+func (fctx *FlowContext) listSubnetsInForeignGroup(ctx context.Context, c client.Subnet) ([]*armnetwork.Subnet, error) {
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+
+	currentSubnets, err := c.List(ctx, vnetCfg.ResourceGroup, vnetCfg.Name)
+	// In case we cannot list any subnets at all, assume that the deletion succeeded at an earlier point in time.
+	if client.FilterNotFoundError(err) != nil {
+		return nil, err
+	}
+
+	return Filter(currentSubnets, func(s *armnetwork.Subnet) bool {
+		return fctx.adapter.HasShootPrefix(s.Name)
+	}), nil
+}
+
+// errSubnetsInUse signals that subnets are still in use, so that the wait for them is retried.
+var errSubnetsInUse = errors.New("subnets are still in use")
+
+// retryWhileInUse runs fn with the in-use backoff of the flow context as long as it fails because resources are still in
+// use. The last error is returned if the backoff is exhausted.
+func (fctx *FlowContext) retryWhileInUse(ctx context.Context, fn func() error) error {
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, fctx.inUseBackoff, func(context.Context) (bool, error) {
+		lastErr = fn()
+		if lastErr == nil {
+			return true, nil
+		}
+		if errors.Is(lastErr, errSubnetsInUse) || client.IsAzureAPIInUseError(lastErr) {
+			return false, nil
+		}
+		return false, lastErr
+	})
+	if wait.Interrupted(err) && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// subnetUsers returns the resources which use the given subnet with an IP configuration, e.g. network interfaces of
+// machines or frontends of load balancers, as "<type>/<name>".
+func subnetUsers(subnet *armnetwork.Subnet) []string {
+	if subnet.Properties == nil {
+		return nil
+	}
+
+	users := sets.New[string]()
+	for _, ipConfig := range subnet.Properties.IPConfigurations {
+		if ipConfig == nil || ipConfig.ID == nil {
+			continue
+		}
+		id, err := arm.ParseResourceID(*ipConfig.ID)
+		if err != nil || id.Parent == nil {
+			users.Insert(*ipConfig.ID)
+			continue
+		}
+		users.Insert(fmt.Sprintf("%s/%s", id.Parent.ResourceType.Types[len(id.Parent.ResourceType.Types)-1], id.Parent.Name))
+	}
+	return sets.List(users)
+}
+
 // DeleteLoadBalancers deletes all load balancers in shoots resource group
 // This is a prerequisite for the deletion of the subnets in foreign resource group because
 // internal load balancers might have a Frontend IP configuration referencing the
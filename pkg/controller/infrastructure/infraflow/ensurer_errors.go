@@ -6,6 +6,11 @@ package infraflow
 
 import (
 	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 // SpecMismatchError is an error to indicate that the reconciliation cannot proceed or the operation requested is not supported.
@@ -54,3 +59,52 @@ func (t *TerminalConditionError) Error() string {
 func (t *TerminalConditionError) Unwrap() error {
 	return t.error
 }
+
+// ResourceInUseError is an error to indicate that a resource cannot be deleted, because other resources still use it.
+// It is expected to resolve once the users are deleted, e.g. the network interfaces of lingering machines.
+type ResourceInUseError struct {
+	AzureResourceMetadata
+	// Users are the resources which still use the resource.
+	Users []string
+	error
+}
+
+// NewResourceInUseError creates a ResourceInUseError.
+func NewResourceInUseError(identifier AzureResourceMetadata, users []string, err error) *ResourceInUseError {
+	return &ResourceInUseError{identifier, users, err}
+}
+
+func (t *ResourceInUseError) Error() string {
+	s := fmt.Sprintf("resource cannot be deleted as it is still in use. Resource: %s, Name: %s, Used by: [%s]", t.Kind, t.Name, strings.Join(t.Users, ", "))
+	if t.error != nil {
+		s = fmt.Sprintf("%s, Error: %s", s, t.error)
+	}
+	return s
+}
+
+func (t *ResourceInUseError) Unwrap() error {
+	return t.error
+}
+
+// Codes implements the Coder interface, so that the error is reported as dependency which resolves over time.
+func (t *ResourceInUseError) Codes() []gardencorev1beta1.ErrorCode {
+	return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorRetryableInfraDependencies}
+}
+
+// DeletionProtectionError is an error to indicate that the reconciliation would delete or recreate parts of the
+// network of the shoot which are protected against deletions.
+type DeletionProtectionError struct {
+	// Resources describe the protected resources and the reasons why they would be deleted.
+	Resources []string
+}
+
+func (t *DeletionProtectionError) Error() string {
+	return fmt.Sprintf("the network of the shoot is protected against deletions, but the reconciliation would delete [%s]. Please confirm the deletion by annotating the shoot with %s=true",
+		strings.Join(t.Resources, ", "), consts.ConfirmNetworkDeletionAnnotation)
+}
+
+// Codes implements the Coder interface, so that the error is reported as configuration problem which requires the
+// confirmation of an operator.
+func (t *DeletionProtectionError) Codes() []gardencorev1beta1.ErrorCode {
+	return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Ensurer", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		natName        = namespace + "-nat-gateway"
+		ipName         = natName + "-ip"
+		natID          = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/natGateways/" + natName
+		ipID           = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/publicIPAddresses/" + ipName
+	)
+
+	var (
+		ctrl       *gomock.Controller
+		ctx        context.Context
+		logs       []string
+		factory    *mockazureclient.MockFactory
+		natClient  *mockazureclient.MockNatGateway
+		ipClient   *mockazureclient.MockPublicIP
+		fctx       *infraflow.FlowContext
+		currentNat *armnetwork.NatGateway
+		currentIP  *armnetwork.PublicIPAddress
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		logs = nil
+		ctx = logr.NewContext(context.TODO(), funcr.New(func(_, args string) { logs = append(logs, args) }, funcr.Options{}))
+		factory = mockazureclient.NewMockFactory(ctrl)
+		natClient = mockazureclient.NewMockNatGateway(ctrl)
+		ipClient = mockazureclient.NewMockPublicIP(ctrl)
+
+		currentNat = &armnetwork.NatGateway{
+			ID:       ptr.To(natID),
+			Name:     ptr.To(natName),
+			Location: ptr.To(region),
+			Properties: &armnetwork.NatGatewayPropertiesFormat{
+				IdleTimeoutInMinutes: ptr.To[int32](4),
+				PublicIPAddresses:    []*armnetwork.SubResource{{ID: ptr.To(ipID)}},
+			},
+			SKU: &armnetwork.NatGatewaySKU{Name: ptr.To(armnetwork.NatGatewaySKUNameStandard)},
+		}
+		currentIP = &armnetwork.PublicIPAddress{
+			ID:       ptr.To(ipID),
+			Name:     ptr.To(ipName),
+			Location: ptr.To(region),
+			Tags: map[string]*string{
+				infraflow.TagManagedByGardener: ptr.To("true"),
+				infraflow.TagShootName:         ptr.To(namespace),
+			},
+			Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+				PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic),
+				IPAddress:                ptr.To("20.0.0.1"),
+			},
+		}
+
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:       v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers:    ptr.To("10.250.0.0/16"),
+				NatGateway: &v1alpha1.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: ptr.To[int32](30)},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err = infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureNatGateways", func() {
+		It("should update the idle timeout in place without recreating the NAT Gateway or detaching its public IP", func() {
+			factory.EXPECT().NatGateway().Return(natClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			natClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.NatGateway{currentNat}, nil)
+			natClient.EXPECT().CreateOrUpdate(ctx, namespace, natName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, nat armnetwork.NatGateway) (*armnetwork.NatGateway, error) {
+				Expect(nat.ID).To(Equal(ptr.To(natID)))
+				Expect(nat.Properties.IdleTimeoutInMinutes).To(Equal(ptr.To[int32](30)))
+				Expect(nat.Properties.PublicIPAddresses).To(ConsistOf(&armnetwork.SubResource{ID: ptr.To(ipID)}))
+				return &nat, nil
+			})
+			ipClient.EXPECT().Get(ctx, namespace, ipName, nil).Return(currentIP, nil)
+
+			Expect(fctx.EnsureNatGateways(ctx)).To(Succeed())
+			Expect(logs).To(ContainElement(ContainSubstring("Will update the idle timeout of NAT Gateway in place")))
+			Expect(logs).NotTo(ContainElement(ContainSubstring("Will delete NAT Gateway")))
+		})
+	})
+
+	Describe("#EnsurePublicIps", func() {
+		It("should keep the public IP of the NAT Gateway if its idle timeout changes", func() {
+			factory.EXPECT().PublicIP().Return(ipClient, nil).Times(2)
+			ipClient.EXPECT().Get(ctx, namespace, ipName, nil).Return(currentIP, nil)
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{currentIP}, nil)
+			ipClient.EXPECT().CreateOrUpdate(ctx, namespace, ipName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, ip armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+				Expect(ip.ID).To(Equal(ptr.To(ipID)))
+				return currentIP, nil
+			})
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+			Expect(logs).NotTo(ContainElement(ContainSubstring("Will delete public IP")))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// DefaultFederatedCredentialAudience is the audience of the federated identity credentials if none is configured. It is
+// the audience which Microsoft Entra ID expects in the tokens which are exchanged for access tokens.
+const DefaultFederatedCredentialAudience = "api://AzureADTokenExchange"
+
+// EnsureFederatedCredentials creates or updates the configured federated identity credentials of the managed identity,
+// which trust the tokens of service accounts of the shoot. They are only reconciled once the service account issuer of
+// the shoot is known, i.e. for a managed issuer after its first advertisement. Federated identity credentials of the
+// inventory which are no longer configured are deleted. An existing federated identity credential which was not
+// created by the extension is only adopted if it matches the configuration.
+func (fctx *FlowContext) EnsureFederatedCredentials(ctx context.Context) error {
+	if fctx.cfg.Identity == nil || len(fctx.cfg.Identity.FederatedCredentials) == 0 {
+		return fctx.DeleteFederatedCredentials(ctx)
+	}
+
+	log := shared.LogFromContext(ctx)
+	if fctx.whiteboard.Get(KeyManagedIdentityId) == nil {
+		return fmt.Errorf("the managed identity %s does not exist", fctx.cfg.Identity.Name)
+	}
+
+	issuer := fctx.serviceAccountIssuer()
+	if issuer == "" {
+		log.Info("skipping federated identity credentials, as the service account issuer of the shoot is not known yet")
+		return nil
+	}
+
+	c, err := fctx.factory.FederatedIdentityCredentials()
+	if err != nil {
+		return err
+	}
+
+	current := sets.New[string]()
+	for _, credential := range fctx.cfg.Identity.FederatedCredentials {
+		id, err := fctx.ensureFederatedCredential(ctx, c, issuer, credential)
+		if err != nil {
+			return err
+		}
+		current.Insert(strings.ToLower(id))
+	}
+
+	return fctx.deleteFederatedCredentials(ctx, c, current)
+}
+
+// ensureFederatedCredential creates or updates the given federated identity credential of the managed identity with the
+// given issuer and returns its ID.
+func (fctx *FlowContext) ensureFederatedCredential(ctx context.Context, c client.FederatedIdentityCredentials, issuer string, credential azure.IdentityFederatedCredential) (string, error) {
+	log := shared.LogFromContext(ctx)
+
+	var (
+		resourceGroup = fctx.cfg.Identity.ResourceGroup
+		identity      = fctx.cfg.Identity.Name
+		desired       = federatedCredentialProperties(issuer, credential)
+	)
+
+	existing, err := c.Get(ctx, resourceGroup, identity, credential.Name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && existing.ID != nil {
+		if federatedCredentialPropertiesEqual(existing.Properties, desired) {
+			return *existing.ID, fctx.inventory.Insert(*existing.ID)
+		}
+		if fctx.inventory.Get(*existing.ID) == nil {
+			return "", fmt.Errorf("the federated identity credential %s of the managed identity %s already exists with another subject, issuer or audiences", credential.Name, identity)
+		}
+	}
+
+	log.Info("reconciling federated identity credential of managed identity", "name", credential.Name, "subject", *desired.Subject)
+	reconciled, err := c.CreateOrUpdate(ctx, resourceGroup, identity, credential.Name, armmsi.FederatedIdentityCredential{Properties: desired})
+	if err != nil {
+		return "", err
+	}
+
+	log.V(1).Info("Adding to inventory", "id", *reconciled.ID)
+	return *reconciled.ID, fctx.inventory.Insert(*reconciled.ID)
+}
+
+// DeleteFederatedCredentials deletes the federated identity credentials of the managed identity which were created by
+// the extension.
+func (fctx *FlowContext) DeleteFederatedCredentials(ctx context.Context) error {
+	if len(fctx.inventory.ByKind(KindFederatedIdentityCredential)) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.FederatedIdentityCredentials()
+	if err != nil {
+		return err
+	}
+	return fctx.deleteFederatedCredentials(ctx, c, nil)
+}
+
+// deleteFederatedCredentials deletes all federated identity credentials of the inventory except the ones with the given
+// lower case IDs.
+func (fctx *FlowContext) deleteFederatedCredentials(ctx context.Context, c client.FederatedIdentityCredentials, keep sets.Set[string]) error {
+	log := shared.LogFromContext(ctx)
+
+	var joinErr error
+	for _, id := range fctx.inventory.ByKind(KindFederatedIdentityCredential) {
+		if keep.Has(strings.ToLower(id.String())) {
+			continue
+		}
+
+		log.Info("deleting federated identity credential", "id", id.String())
+		if err := c.Delete(ctx, id.ResourceGroupName, id.Parent.Name, id.Name); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	return joinErr
+}
+
+// serviceAccountIssuer returns the service account issuer of the shoot. A managed issuer is only known once it was
+// advertised in the status of the shoot, while a custom issuer is configured in its specification.
+func (fctx *FlowContext) serviceAccountIssuer() string {
+	if fctx.cluster == nil || fctx.cluster.Shoot == nil {
+		return ""
+	}
+
+	shoot := fctx.cluster.Shoot
+	for _, address := range shoot.Status.AdvertisedAddresses {
+		if address.Name == v1beta1constants.AdvertisedAddressServiceAccountIssuer {
+			return address.URL
+		}
+	}
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.ServiceAccountConfig != nil {
+		return ptr.Deref(kubeAPIServer.ServiceAccountConfig.Issuer, "")
+	}
+	return ""
+}
+
+func federatedCredentialProperties(issuer string, credential azure.IdentityFederatedCredential) *armmsi.FederatedIdentityCredentialProperties {
+	audiences := credential.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{DefaultFederatedCredentialAudience}
+	}
+
+	namespace, name, _ := strings.Cut(credential.ServiceAccount, "/")
+	return &armmsi.FederatedIdentityCredentialProperties{
+		Issuer:    ptr.To(issuer),
+		Subject:   ptr.To(fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)),
+		Audiences: to.SliceOfPtrs(audiences...),
+	}
+}
+
+func federatedCredentialPropertiesEqual(current, desired *armmsi.FederatedIdentityCredentialProperties) bool {
+	if current == nil {
+		return false
+	}
+
+	currentAudiences := sets.New[string]()
+	for _, audience := range current.Audiences {
+		currentAudiences.Insert(ptr.Deref(audience, ""))
+	}
+	desiredAudiences := sets.New[string]()
+	for _, audience := range desired.Audiences {
+		desiredAudiences.Insert(ptr.Deref(audience, ""))
+	}
+
+	return ptr.Deref(current.Issuer, "") == *desired.Issuer &&
+		ptr.Deref(current.Subject, "") == *desired.Subject &&
+		currentAudiences.Equal(desiredAudiences)
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("FederatedCredentials", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		issuer         = "https://discovery.example.com/projects/foo/shoots/1234/issuer"
+		identityID     = "/subscriptions/" + subscriptionID + "/resourceGroups/identity-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"
+		credentialID   = identityID + "/federatedIdentityCredentials/app"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		factory *mockazureclient.MockFactory
+		fake    *mockazureclient.MockFederatedIdentityCredentials
+		shoot   *gardencorev1beta1.Shoot
+		data    map[string]string
+
+		desired = armmsi.FederatedIdentityCredential{
+			Properties: &armmsi.FederatedIdentityCredentialProperties{
+				Issuer:    ptr.To(issuer),
+				Subject:   ptr.To("system:serviceaccount:default:app"),
+				Audiences: to.SliceOfPtrs(infraflow.DefaultFederatedCredentialAudience),
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		fake = mockazureclient.NewMockFederatedIdentityCredentials(ctrl)
+		data = map[string]string{infraflow.KeyManagedIdentityId: identityID}
+		shoot = &gardencorev1beta1.Shoot{
+			Status: gardencorev1beta1.ShootStatus{
+				AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "external", URL: "https://api.foo.example.com"},
+					{Name: "service-account-issuer", URL: issuer},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newFlowContext := func(credentials []v1alpha1.IdentityFederatedCredential, managedItems ...string) *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+			Identity: &v1alpha1.IdentityConfig{Name: "identity", ResourceGroup: "identity-rg", FederatedCredentials: credentials},
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		state := &azure.InfrastructureState{Data: data}
+		for _, id := range managedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: shoot},
+			State:   state,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		return fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	Describe("#EnsureFederatedCredentials", func() {
+		It("should create the federated identity credential for the advertised issuer and add it to the inventory", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Get(ctx, "identity-rg", "identity", "app").Return(nil, nil)
+			fake.EXPECT().CreateOrUpdate(ctx, "identity-rg", "identity", "app", desired).
+				Return(&armmsi.FederatedIdentityCredential{ID: ptr.To(credentialID)}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}})
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(v1alpha1.AzureResource{
+				Kind: infraflow.KindFederatedIdentityCredential.String(),
+				ID:   credentialID,
+			}))
+		})
+
+		It("should use the custom issuer of the shoot if no issuer is advertised", func() {
+			shoot.Status.AdvertisedAddresses = nil
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				ServiceAccountConfig: &gardencorev1beta1.ServiceAccountConfig{Issuer: ptr.To("https://issuer.example.com")},
+			}
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Get(ctx, "identity-rg", "identity", "app").Return(nil, nil)
+			fake.EXPECT().CreateOrUpdate(ctx, "identity-rg", "identity", "app", armmsi.FederatedIdentityCredential{
+				Properties: &armmsi.FederatedIdentityCredentialProperties{
+					Issuer:    ptr.To("https://issuer.example.com"),
+					Subject:   ptr.To("system:serviceaccount:kube-system:backup"),
+					Audiences: to.SliceOfPtrs("api://custom"),
+				},
+			}).Return(&armmsi.FederatedIdentityCredential{ID: ptr.To(credentialID)}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "kube-system/backup", Audiences: []string{"api://custom"}}})
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+		})
+
+		It("should skip the federated identity credentials as long as the issuer is not known", func() {
+			shoot.Status.AdvertisedAddresses = nil
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}})
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+
+		It("should not update an up-to-date federated identity credential", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Get(ctx, "identity-rg", "identity", "app").Return(&armmsi.FederatedIdentityCredential{ID: ptr.To(credentialID), Properties: desired.Properties}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}}, credentialID)
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(HaveLen(1))
+		})
+
+		It("should update an outdated federated identity credential of the inventory", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Get(ctx, "identity-rg", "identity", "app").Return(&armmsi.FederatedIdentityCredential{
+				ID: ptr.To(credentialID),
+				Properties: &armmsi.FederatedIdentityCredentialProperties{
+					Issuer:    ptr.To("https://old.example.com"),
+					Subject:   desired.Properties.Subject,
+					Audiences: desired.Properties.Audiences,
+				},
+			}, nil)
+			fake.EXPECT().CreateOrUpdate(ctx, "identity-rg", "identity", "app", desired).
+				Return(&armmsi.FederatedIdentityCredential{ID: ptr.To(credentialID)}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}}, credentialID)
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+		})
+
+		It("should fail if another federated identity credential with the same name exists", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Get(ctx, "identity-rg", "identity", "app").Return(&armmsi.FederatedIdentityCredential{
+				ID: ptr.To(credentialID),
+				Properties: &armmsi.FederatedIdentityCredentialProperties{
+					Issuer:    ptr.To(issuer),
+					Subject:   ptr.To("system:serviceaccount:default:other"),
+					Audiences: desired.Properties.Audiences,
+				},
+			}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}})
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(MatchError(ContainSubstring("already exists with another subject, issuer or audiences")))
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+
+		It("should fail if the managed identity does not exist", func() {
+			data = nil
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}})
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(MatchError("the managed identity identity does not exist"))
+		})
+
+		It("should delete federated identity credentials of the inventory which are no longer configured", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Delete(ctx, "identity-rg", "identity", "app").Return(nil)
+
+			fctx := newFlowContext(nil, credentialID)
+			Expect(fctx.EnsureFederatedCredentials(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+	})
+
+	Describe("#DeleteFederatedCredentials", func() {
+		It("should not do anything if no federated identity credential was created by the extension", func() {
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}})
+			Expect(fctx.DeleteFederatedCredentials(ctx)).To(Succeed())
+		})
+
+		It("should delete the federated identity credentials of the inventory", func() {
+			factory.EXPECT().FederatedIdentityCredentials().Return(fake, nil)
+			fake.EXPECT().Delete(ctx, "identity-rg", "identity", "app").Return(nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityFederatedCredential{{Name: "app", ServiceAccount: "default/app"}}, credentialID)
+			Expect(fctx.DeleteFederatedCredentials(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+	})
+})
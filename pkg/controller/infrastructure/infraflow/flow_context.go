@@ -14,6 +14,8 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -28,6 +30,15 @@ const (
 	defaultLongTimeout = 4 * time.Minute
 )
 
+// defaultInUseBackoff is the backoff with which the deletion of resources is retried as long as they are still in use.
+// It retries for about two minutes, so that it stays within the timeout of the deletion tasks.
+var defaultInUseBackoff = wait.Backoff{
+	Duration: 5 * time.Second,
+	Factor:   2,
+	Cap:      time.Minute,
+	Steps:    6,
+}
+
 var setSeparator = sync.OnceFunc(func() {
 	shared.Separator = "|"
 })
@@ -51,6 +62,9 @@ type FlowContext struct {
 	adapter        *InfrastructureAdapter
 	providerAccess Access
 	inventory      *Inventory
+	taskTimeouts   map[string]time.Duration
+	checkpointTTL  time.Duration
+	inUseBackoff   wait.Backoff
 
 	*shared.BasicFlowContext
 }
@@ -64,6 +78,13 @@ type Opts struct {
 	Infra   *extensionsv1alpha1.Infrastructure
 	Cluster *controller.Cluster
 	State   *azure.InfrastructureState
+	// TaskTimeouts overrides the default timeouts of the flow tasks by task name.
+	TaskTimeouts map[string]time.Duration
+	// CheckpointTTL is the duration for which the tasks which completed in a failed reconciliation are skipped. Checkpoints
+	// are disabled if it is zero.
+	CheckpointTTL time.Duration
+	// InUseBackoff overrides the backoff with which the deletion of resources is retried as long as they are still in use.
+	InUseBackoff *wait.Backoff
 }
 
 // NewFlowContext creates a new FlowContext.
@@ -118,8 +139,11 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 		providerAccess: &access{
 			opts.Factory,
 		},
-		adapter:   adapter,
-		inventory: inv,
+		adapter:       adapter,
+		inventory:     inv,
+		taskTimeouts:  opts.TaskTimeouts,
+		checkpointTTL: opts.CheckpointTTL,
+		inUseBackoff:  ptr.Deref(opts.InUseBackoff, defaultInUseBackoff),
 	}
 
 	return fc, nil
@@ -127,7 +151,10 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 
 // Reconcile reconciles target infrastructure.
 func (fctx *FlowContext) Reconcile(ctx context.Context) error {
-	graph := fctx.buildReconcileGraph()
+	graph, err := fctx.buildReconcileGraph()
+	if err != nil {
+		return err
+	}
 	fl := graph.Compile()
 	if err := fl.Run(ctx, flow.Opts{
 		Log: fctx.log,
@@ -137,6 +164,8 @@ func (fctx *FlowContext) Reconcile(ctx context.Context) error {
 		fctx.log.Error(err, "flow reconciliation failed")
 		return errors.Join(err, fctx.persistState(ctx))
 	}
+	// the checkpoints only serve to resume a failed reconciliation, the next one must evaluate all tasks again.
+	clearCheckpoints(fctx.whiteboard.GetChild(ChildKeyCheckpoints))
 
 	status, err := fctx.GetInfrastructureStatus(ctx)
 	state := fctx.GetInfrastructureState()
@@ -147,33 +176,72 @@ func (fctx *FlowContext) Reconcile(ctx context.Context) error {
 	return infrainternal.PatchProviderStatusAndState(ctx, fctx.client, fctx.infra, status, state, egressCidrs)
 }
 
-func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState)
+func (fctx *FlowContext) buildReconcileGraph() (*flow.Graph, error) {
+	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState).WithTaskTimeouts(fctx.taskTimeouts)
+	if fctx.checkpointTTL > 0 {
+		fingerprint, err := fctx.checkpointFingerprint()
+		if err != nil {
+			return nil, err
+		}
+		fctx.WithCheckpoints(newCheckpoints(fctx.whiteboard.GetChild(ChildKeyCheckpoints), fingerprint, fctx.checkpointTTL))
+	}
 	g := flow.NewGraph("Azure infrastructure reconciliation")
 
+	resourceProviders := fctx.AddTask(g, "ensure resource providers",
+		fctx.EnsureResourceProviders, shared.Timeout(defaultTimeout), shared.Checkpoint())
+
 	resourceGroup := fctx.AddTask(g, "ensure resource group",
-		fctx.EnsureResourceGroup, shared.Timeout(defaultTimeout))
+		fctx.EnsureResourceGroup, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(resourceProviders))
+
+	networkResourceGroup := fctx.AddTask(g, "ensure network resource group",
+		fctx.EnsureNetworkResourceGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceProviders),
+		shared.DoIf(fctx.adapter.HasNetworkResourceGroup()))
 
 	vnet := fctx.AddTask(g, "ensure vnet",
-		fctx.EnsureVirtualNetwork, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsureVirtualNetwork, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(resourceGroup, networkResourceGroup))
+
+	managedIdentity := fctx.AddTask(g, "ensure managed identity",
+		fctx.EnsureManagedIdentity, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.DoIf(fctx.cfg.Identity != nil))
 
-	_ = fctx.AddTask(g, "ensure managed identity",
-		fctx.EnsureManagedIdentity, shared.DoIf(fctx.cfg.Identity != nil))
+	_ = fctx.AddTask(g, "ensure role assignments",
+		fctx.EnsureRoleAssignments, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(resourceGroup, managedIdentity))
+
+	_ = fctx.AddTask(g, "ensure federated identity credentials",
+		fctx.EnsureFederatedCredentials, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(managedIdentity))
 
 	routeTable := fctx.AddTask(g, "ensure route table",
-		fctx.EnsureRouteTable, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsureRouteTable, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(resourceGroup, networkResourceGroup))
 
 	securityGroup := fctx.AddTask(g, "ensure security group",
-		fctx.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Checkpoint(), shared.Dependencies(resourceGroup, networkResourceGroup))
+
+	_ = fctx.AddTask(g, "ensure private DNS zone",
+		fctx.EnsurePrivateDNSZone, shared.Timeout(defaultLongTimeout), shared.Checkpoint(), shared.Dependencies(vnet))
+
+	networkWatcher := fctx.AddTask(g, "ensure network watcher",
+		fctx.EnsureNetworkWatcher, shared.Timeout(defaultLongTimeout), shared.Checkpoint(), shared.Dependencies(resourceProviders))
+
+	_ = fctx.AddTask(g, "ensure flow logs",
+		fctx.EnsureFlowLogs, shared.Timeout(defaultLongTimeout), shared.Checkpoint(), shared.Dependencies(vnet, networkWatcher))
+
+	// the outbound load balancer must be gone before its public IPs can be deleted.
+	unusedOutboundLoadBalancer := fctx.AddTask(g, "delete outbound load balancer",
+		fctx.DeleteOutboundLoadBalancer, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup),
+		shared.DoIf(fctx.adapter.OutboundLoadBalancer() == nil))
 
 	ip := fctx.AddTask(g, "ensure public IPs",
-		fctx.EnsurePublicIps, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsurePublicIps, shared.Timeout(defaultLongTimeout), shared.Checkpoint(), shared.Dependencies(resourceGroup, unusedOutboundLoadBalancer))
+
+	_ = fctx.AddTask(g, "ensure outbound load balancer",
+		fctx.EnsureOutboundLoadBalancer, shared.Timeout(defaultLongTimeout), shared.Dependencies(ip),
+		shared.DoIf(fctx.adapter.OutboundLoadBalancer() != nil))
+	// the egress IPs of the NAT gateways are not persisted, hence this task always runs.
 	nat := fctx.AddTask(g, "ensure nats",
-		fctx.EnsureNatGateways, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, ip))
+		fctx.EnsureNatGateways, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, networkResourceGroup, ip))
 
 	_ = fctx.AddTask(g, "ensure subnets", fctx.EnsureSubnets,
 		shared.Timeout(defaultLongTimeout), shared.Dependencies(vnet, routeTable, securityGroup, nat))
-	return g
+	return g, nil
 }
 
 // Delete deletes all resources managed by the reconciler
@@ -186,18 +254,44 @@ func (fctx *FlowContext) Delete(ctx context.Context) error {
 		}
 	}
 
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState)
+	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState).WithTaskTimeouts(fctx.taskTimeouts)
 	managedVnet := fctx.adapter.VirtualNetworkConfig().Managed
+	networkResourceGroup := fctx.adapter.HasNetworkResourceGroup()
 	g := flow.NewGraph("Azure infrastructure deletion")
 
 	loadBalancers := fctx.AddTask(g, "delete load balancers",
-		fctx.DeleteLoadBalancers, shared.Timeout(defaultLongTimeout), shared.DoIf(!managedVnet))
+		fctx.DeleteLoadBalancers, shared.Timeout(defaultLongTimeout), shared.DoIf(!managedVnet || networkResourceGroup))
+	unusedForeignSubnets := fctx.AddTask(g, "wait for subnets in foreign resource group to be unused",
+		fctx.WaitForSubnetsInForeignGroupToBeUnused, shared.Timeout(defaultLongTimeout),
+		shared.Dependencies(loadBalancers), shared.DoIf(!managedVnet || networkResourceGroup))
 	foreignSubnets := fctx.AddTask(g, "delete subnets in foreign resource group",
 		fctx.DeleteSubnetsInForeignGroup, shared.Timeout(defaultLongTimeout),
-		shared.Dependencies(loadBalancers), shared.DoIf(!managedVnet))
+		shared.Dependencies(unusedForeignSubnets), shared.DoIf(!managedVnet))
+	// the network resource group itself is not owned by the extension, only the network resources which it created.
+	networkResources := fctx.AddTask(g, "delete network resources in network resource group",
+		fctx.DeleteNetworkResources, shared.Timeout(defaultLongTimeout),
+		shared.Dependencies(unusedForeignSubnets), shared.DoIf(networkResourceGroup))
+
+	// the network watcher is shared with the other resources of the subscription, hence only the flow logs are deleted.
+	flowLogs := fctx.AddTask(g, "delete flow logs",
+		fctx.DeleteFlowLogs, shared.Timeout(defaultLongTimeout))
+
+	privateDNSZone := fctx.AddTask(g, "delete private DNS zone",
+		fctx.DeletePrivateDNSZone, shared.Timeout(defaultLongTimeout))
+
+	outboundLoadBalancer := fctx.AddTask(g, "delete outbound load balancer",
+		fctx.DeleteOutboundLoadBalancer, shared.Timeout(defaultLongTimeout), shared.Dependencies(loadBalancers))
+
+	// role assignments are not removed together with their scope.
+	roleAssignments := fctx.AddTask(g, "delete role assignments",
+		fctx.DeleteRoleAssignments, shared.Timeout(defaultTimeout))
+
+	// the managed identity is not located in the resource group of the shoot.
+	_ = fctx.AddTask(g, "delete federated identity credentials",
+		fctx.DeleteFederatedCredentials, shared.Timeout(defaultTimeout))
 
 	fctx.AddTask(g, "delete resource group",
-		fctx.DeleteResourceGroup, shared.Dependencies(foreignSubnets), shared.Timeout(defaultLongTimeout))
+		fctx.DeleteResourceGroup, shared.Dependencies(foreignSubnets, networkResources, flowLogs, privateDNSZone, outboundLoadBalancer, roleAssignments), shared.Timeout(defaultLongTimeout))
 
 	fl := g.Compile()
 	if err := fl.Run(ctx, flow.Opts{}); err != nil {
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// DefaultTrafficAnalyticsInterval is the interval in minutes in which traffic analytics processes the flow logs if not
+// configured otherwise.
+const DefaultTrafficAnalyticsInterval int32 = 60
+
+// FlowLogSpec returns the desired flow log of the given target resource.
+func FlowLogSpec(targetID, location string, config *azure.FlowLogsConfig) armnetwork.FlowLog {
+	flowLog := armnetwork.FlowLog{
+		Location: ptr.To(location),
+		Properties: &armnetwork.FlowLogPropertiesFormat{
+			Enabled:          ptr.To(true),
+			StorageID:        ptr.To(config.StorageAccountID),
+			TargetResourceID: ptr.To(targetID),
+			Format: &armnetwork.FlowLogFormatParameters{
+				Type:    ptr.To(armnetwork.FlowLogFormatTypeJSON),
+				Version: ptr.To[int32](2),
+			},
+			RetentionPolicy: &armnetwork.RetentionPolicyParameters{
+				Enabled: ptr.To(config.RetentionDays != nil),
+				Days:    ptr.To(ptr.Deref(config.RetentionDays, 0)),
+			},
+		},
+	}
+
+	if ta := config.TrafficAnalytics; ta != nil {
+		flowLog.Properties.FlowAnalyticsConfiguration = &armnetwork.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsConfigurationProperties{
+				Enabled:                  ptr.To(true),
+				WorkspaceResourceID:      ptr.To(ta.WorkspaceResourceID),
+				WorkspaceID:              ptr.To(ta.WorkspaceID),
+				WorkspaceRegion:          ptr.To(ta.WorkspaceRegion),
+				TrafficAnalyticsInterval: ptr.To(ptr.Deref(ta.IntervalMinutes, DefaultTrafficAnalyticsInterval)),
+			},
+		}
+	}
+
+	return flowLog
+}
+
+// DeleteFlowLog deletes the flow log with the given ID.
+func DeleteFlowLog(ctx context.Context, flowLogs client.FlowLog, id arm.ResourceID) error {
+	if id.Parent == nil {
+		return fmt.Errorf("flow log %s has no network watcher", id.String())
+	}
+	return flowLogs.Delete(ctx, id.ResourceGroupName, id.Parent.Name, id.Name)
+}
+
+// EnsureFlowLogs reconciles the virtual network flow log of the VNet of the shoot. Flow logs which are no longer
+// configured are deleted, which includes the NSG flow logs of the worker security group created by former versions.
+func (fctx *FlowContext) EnsureFlowLogs(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	flowLogs, err := fctx.factory.FlowLog()
+	if err != nil {
+		return err
+	}
+
+	var current string
+	if config := fctx.cfg.Networks.FlowLogs; config != nil {
+		flowLog, err := fctx.ensureFlowLog(ctx, flowLogs, config)
+		if err != nil {
+			return err
+		}
+		current = *flowLog.ID
+
+		log.V(1).Info("Adding to inventory", "id", current)
+		if err := fctx.inventory.Insert(current); err != nil {
+			return err
+		}
+	}
+
+	return fctx.deleteFlowLogs(ctx, flowLogs, current)
+}
+
+func (fctx *FlowContext) ensureFlowLog(ctx context.Context, flowLogs client.FlowLog, config *azure.FlowLogsConfig) (*armnetwork.FlowLog, error) {
+	log := shared.LogFromContext(ctx)
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+
+	watchers, err := fctx.factory.NetworkWatcher()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := fctx.factory.Group()
+	if err != nil {
+		return nil, err
+	}
+
+	watcherID, _, err := FindOrCreateNetworkWatcher(ctx, watchers, groups, vnetCfg.Location, ptr.Deref(config.CreateNetworkWatcher, false))
+	if err != nil {
+		return nil, err
+	}
+
+	// the flow logs of all VNets of the region are located in the same network watcher, hence the flow log is named
+	// after the shoot rather than after a VNet which might not be managed by the extension.
+	name := fctx.adapter.TechnicalName()
+	targetID := GetIdFromTemplate(TemplateVirtualNetwork, fctx.auth.SubscriptionID, vnetCfg.ResourceGroup, vnetCfg.Name)
+	log.Info("reconciling flow log", "networkWatcher", watcherID.Name, "name", name)
+	return flowLogs.CreateOrUpdate(ctx, watcherID.ResourceGroupName, watcherID.Name, name, FlowLogSpec(targetID, vnetCfg.Location, config))
+}
+
+// DeleteFlowLogs deletes the flow logs of the shoot. They are located in the resource group of the network watcher and
+// thus are not removed together with the resource group of the shoot.
+func (fctx *FlowContext) DeleteFlowLogs(ctx context.Context) error {
+	flowLogs, err := fctx.factory.FlowLog()
+	if err != nil {
+		return err
+	}
+
+	return fctx.deleteFlowLogs(ctx, flowLogs, "")
+}
+
+// deleteFlowLogs deletes all flow logs of the inventory except the one with the given ID.
+func (fctx *FlowContext) deleteFlowLogs(ctx context.Context, flowLogs client.FlowLog, keep string) error {
+	log := shared.LogFromContext(ctx)
+
+	var joinErr error
+	for _, id := range fctx.inventory.ByKind(KindFlowLog) {
+		if strings.EqualFold(id.String(), keep) {
+			continue
+		}
+		log.Info("deleting flow log", "id", id.String())
+		if err := DeleteFlowLog(ctx, flowLogs, id); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	return joinErr
+}
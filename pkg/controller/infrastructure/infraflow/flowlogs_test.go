@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("FlowLogs", func() {
+	const (
+		namespace        = "shoot--foo--bar"
+		region           = "westeurope"
+		subscriptionID   = "00000000-0000-0000-0000-000000000000"
+		storageAccountID = "/subscriptions/" + subscriptionID + "/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/flowlogs"
+		watcherID        = "/subscriptions/" + subscriptionID + "/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_westeurope"
+		flowLogID        = watcherID + "/flowLogs/" + namespace
+		vnetID           = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+	)
+
+	var (
+		ctrl           *gomock.Controller
+		ctx            context.Context
+		factory        *mockazureclient.MockFactory
+		watcherClient  *mockazureclient.MockNetworkWatcher
+		flowLogClient  *mockazureclient.MockFlowLog
+		groupClient    *mockazureclient.MockResourceGroup
+		flowLogsConfig *azure.FlowLogsConfig
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		watcherClient = mockazureclient.NewMockNetworkWatcher(ctrl)
+		flowLogClient = mockazureclient.NewMockFlowLog(ctrl)
+		groupClient = mockazureclient.NewMockResourceGroup(ctrl)
+		flowLogsConfig = &azure.FlowLogsConfig{StorageAccountID: storageAccountID}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#FlowLogSpec", func() {
+		It("should return a version 2 flow log without retention policy", func() {
+			flowLog := infraflow.FlowLogSpec(vnetID, region, flowLogsConfig)
+			Expect(flowLog.Location).To(Equal(ptr.To(region)))
+			Expect(flowLog.Properties.TargetResourceID).To(Equal(ptr.To(vnetID)))
+			Expect(flowLog.Properties.StorageID).To(Equal(ptr.To(storageAccountID)))
+			Expect(flowLog.Properties.Format).To(Equal(&armnetwork.FlowLogFormatParameters{Type: ptr.To(armnetwork.FlowLogFormatTypeJSON), Version: ptr.To[int32](2)}))
+			Expect(flowLog.Properties.RetentionPolicy).To(Equal(&armnetwork.RetentionPolicyParameters{Enabled: ptr.To(false), Days: ptr.To[int32](0)}))
+			Expect(flowLog.Properties.FlowAnalyticsConfiguration).To(BeNil())
+		})
+
+		It("should configure the retention and traffic analytics", func() {
+			flowLogsConfig.RetentionDays = ptr.To[int32](7)
+			flowLogsConfig.TrafficAnalytics = &azure.TrafficAnalyticsConfig{
+				WorkspaceResourceID: "/subscriptions/" + subscriptionID + "/resourceGroups/logs/providers/Microsoft.OperationalInsights/workspaces/ws",
+				WorkspaceID:         "11111111-1111-1111-1111-111111111111",
+				WorkspaceRegion:     region,
+			}
+
+			flowLog := infraflow.FlowLogSpec(vnetID, region, flowLogsConfig)
+			Expect(flowLog.Properties.RetentionPolicy).To(Equal(&armnetwork.RetentionPolicyParameters{Enabled: ptr.To(true), Days: ptr.To[int32](7)}))
+			Expect(flowLog.Properties.FlowAnalyticsConfiguration.NetworkWatcherFlowAnalyticsConfiguration).To(Equal(&armnetwork.TrafficAnalyticsConfigurationProperties{
+				Enabled:                  ptr.To(true),
+				WorkspaceResourceID:      ptr.To(flowLogsConfig.TrafficAnalytics.WorkspaceResourceID),
+				WorkspaceID:              ptr.To("11111111-1111-1111-1111-111111111111"),
+				WorkspaceRegion:          ptr.To(region),
+				TrafficAnalyticsInterval: ptr.To(infraflow.DefaultTrafficAnalyticsInterval),
+			}))
+		})
+	})
+
+	Describe("#EnsureFlowLogs", func() {
+		newFlowContext := func(config *azure.FlowLogsConfig, managedItems ...string) *infraflow.FlowContext {
+			infraConfig := &v1alpha1.InfrastructureConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+				Networks: v1alpha1.NetworkConfig{
+					VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+					Workers: ptr.To("10.250.0.0/16"),
+				},
+			}
+			if config != nil {
+				infraConfig.Networks.FlowLogs = &v1alpha1.FlowLogsConfig{
+					StorageAccountID:     config.StorageAccountID,
+					CreateNetworkWatcher: config.CreateNetworkWatcher,
+				}
+			}
+			raw, err := json.Marshal(infraConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			state := &azure.InfrastructureState{}
+			for _, id := range managedItems {
+				state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+			}
+
+			fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+				Factory: factory,
+				Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+				Infra: &extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+					Spec: extensionsv1alpha1.InfrastructureSpec{
+						Region:      region,
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+					},
+				},
+				Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+				State:   state,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return fctx
+		}
+
+		BeforeEach(func() {
+			factory.EXPECT().FlowLog().Return(flowLogClient, nil)
+		})
+
+		It("should create the flow log of the VNet", func() {
+			factory.EXPECT().NetworkWatcher().Return(watcherClient, nil)
+			factory.EXPECT().Group().Return(groupClient, nil)
+			watcherClient.EXPECT().ListAll(ctx).Return([]*armnetwork.Watcher{{ID: ptr.To(watcherID), Location: ptr.To(region)}}, nil)
+			flowLogClient.EXPECT().CreateOrUpdate(ctx, "NetworkWatcherRG", "NetworkWatcher_westeurope", namespace, infraflow.FlowLogSpec(vnetID, region, flowLogsConfig)).
+				Return(&armnetwork.FlowLog{ID: ptr.To(flowLogID)}, nil)
+
+			fctx := newFlowContext(flowLogsConfig)
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+		})
+
+		It("should replace the NSG flow log of the worker security group by the flow log of the VNet", func() {
+			nsgFlowLogID := watcherID + "/flowLogs/" + namespace + "-workers"
+
+			factory.EXPECT().NetworkWatcher().Return(watcherClient, nil)
+			factory.EXPECT().Group().Return(groupClient, nil)
+			watcherClient.EXPECT().ListAll(ctx).Return([]*armnetwork.Watcher{{ID: ptr.To(watcherID), Location: ptr.To(region)}}, nil)
+			flowLogClient.EXPECT().CreateOrUpdate(ctx, "NetworkWatcherRG", "NetworkWatcher_westeurope", namespace, infraflow.FlowLogSpec(vnetID, region, flowLogsConfig)).
+				Return(&armnetwork.FlowLog{ID: ptr.To(flowLogID)}, nil)
+			flowLogClient.EXPECT().Delete(ctx, "NetworkWatcherRG", "NetworkWatcher_westeurope", namespace+"-workers").Return(nil)
+
+			fctx := newFlowContext(flowLogsConfig, nsgFlowLogID)
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+			Expect(fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: infraflow.KindFlowLog.String(), ID: flowLogID},
+			))
+		})
+
+		It("should delete the flow log if flow logs are disabled", func() {
+			flowLogClient.EXPECT().Delete(ctx, "NetworkWatcherRG", "NetworkWatcher_westeurope", namespace).Return(nil)
+
+			fctx := newFlowContext(nil, flowLogID)
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+		})
+
+		It("should not delete anything if flow logs were never enabled", func() {
+			fctx := newFlowContext(nil)
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+		})
+
+		It("should delete the flow log on deletion", func() {
+			flowLogClient.EXPECT().Delete(ctx, "NetworkWatcherRG", "NetworkWatcher_westeurope", namespace).Return(nil)
+
+			fctx := newFlowContext(flowLogsConfig, flowLogID)
+			Expect(fctx.DeleteFlowLogs(ctx)).To(Succeed())
+		})
+	})
+})
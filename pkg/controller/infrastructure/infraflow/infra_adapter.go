@@ -6,6 +6,8 @@ package infraflow
 
 import (
 	"fmt"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,6 +16,8 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -32,8 +36,9 @@ type InfrastructureAdapter struct {
 	cluster *extensionscontroller.Cluster
 
 	// cached configuration
-	vnetConfig  VirtualNetworkConfig
-	zoneConfigs []ZoneConfig
+	vnetConfig           VirtualNetworkConfig
+	zoneConfigs          []ZoneConfig
+	outboundLoadBalancer *OutboundLoadBalancerConfig
 }
 
 // NewInfrastructureAdapter returns a new instance of the InfrastructureAdapter.
@@ -53,6 +58,7 @@ func NewInfrastructureAdapter(
 	}
 	ia.vnetConfig = ia.virtualNetworkConfig()
 	ia.zoneConfigs = ia.zonesConfig()
+	ia.outboundLoadBalancer = ia.outboundLoadBalancerConfig()
 	return ia, nil
 }
 
@@ -79,7 +85,7 @@ func (ia *InfrastructureAdapter) ResourceGroup() ResourceGroupConfig {
 			Name: ia.ResourceGroupName(),
 			Kind: KindResourceGroup,
 		},
-		Location: ia.Region(),
+		Location: ptr.Deref(ia.config.ResourceGroupLocation, ia.Region()),
 	}
 }
 
@@ -88,6 +94,21 @@ func (ia *InfrastructureAdapter) ResourceGroupName() string {
 	return ia.TechnicalName()
 }
 
+// NetworkResourceGroupName returns the name of the resource group of the network resources of the shoot, i.e. of the
+// managed VNet, the security group, the route table and the NAT gateways. It is the resource group of the shoot unless
+// a dedicated network resource group is configured.
+func (ia *InfrastructureAdapter) NetworkResourceGroupName() string {
+	if ia.config.NetworkResourceGroup != nil {
+		return ia.config.NetworkResourceGroup.Name
+	}
+	return ia.ResourceGroupName()
+}
+
+// HasNetworkResourceGroup returns true if the network resources of the shoot are located in a dedicated resource group.
+func (ia *InfrastructureAdapter) HasNetworkResourceGroup() bool {
+	return ia.config.NetworkResourceGroup != nil
+}
+
 // VirtualNetworkConfig contains configuration for the virtual network
 type VirtualNetworkConfig struct {
 	AzureResourceMetadata
@@ -97,8 +118,12 @@ type VirtualNetworkConfig struct {
 	Location string
 	// Cidr is the vnet's CIDR.
 	CIDR *string
+	// AdditionalCIDRs are further address prefixes of the vnet.
+	AdditionalCIDRs []string
 	// DDoSPlanID is the ID reference of the DDoS protection plan.
 	DDoSPlanID *string
+	// Encryption is the encryption of the vnet, if it is configured.
+	Encryption *armnetwork.VirtualNetworkEncryption
 }
 
 // Region is the region of the shoot.
@@ -113,7 +138,7 @@ func (ia *InfrastructureAdapter) VirtualNetworkConfig() VirtualNetworkConfig {
 
 func (ia *InfrastructureAdapter) virtualNetworkConfig() VirtualNetworkConfig {
 	name := ia.TechnicalName()
-	rg := ia.ResourceGroupName()
+	rg := ia.NetworkResourceGroupName()
 	managed := ia.isGardenerManagedVirtualNetwork()
 	if !managed {
 		name = *ia.config.Networks.VNet.Name
@@ -136,6 +161,14 @@ func (ia *InfrastructureAdapter) virtualNetworkConfig() VirtualNetworkConfig {
 	} else if cidr = ia.config.Networks.Workers; cidr != nil {
 		vnc.CIDR = to.Ptr(*cidr)
 	}
+	vnc.AdditionalCIDRs = slices.Clone(ia.config.Networks.VNet.AdditionalCIDRs)
+
+	if encryption := ia.config.Networks.VNet.Encryption; encryption != nil {
+		vnc.Encryption = &armnetwork.VirtualNetworkEncryption{
+			Enabled:     to.Ptr(encryption.Enabled),
+			Enforcement: to.Ptr(armnetwork.VirtualNetworkEncryptionEnforcement(ptr.Deref(encryption.Enforcement, azure.VNetEncryptionEnforcementDropUnencrypted))),
+		}
+	}
 
 	return vnc
 }
@@ -155,7 +188,7 @@ type RouteTableConfig struct {
 func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
 	return RouteTableConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
-			ResourceGroup: ia.ResourceGroupName(),
+			ResourceGroup: ia.NetworkResourceGroupName(),
 			Name:          "worker_route_table",
 			Kind:          KindRouteTable,
 		},
@@ -167,17 +200,31 @@ func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
 type SecurityGroupConfig struct {
 	AzureResourceMetadata
 	Location string
+	// Managed is false for an existing security group which is referenced by the InfrastructureConfig.
+	Managed bool
 }
 
 // SecurityGroupConfig returns the configuration for our desired security group.
 func (ia *InfrastructureAdapter) SecurityGroupConfig() SecurityGroupConfig {
+	if ref := ia.config.Networks.SecurityGroup; ref != nil {
+		return SecurityGroupConfig{
+			AzureResourceMetadata: AzureResourceMetadata{
+				ResourceGroup: ref.ResourceGroup,
+				Name:          ref.Name,
+				Kind:          KindSecurityGroup,
+			},
+			Location: ia.Region(),
+		}
+	}
+
 	return SecurityGroupConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
-			ResourceGroup: ia.ResourceGroupName(),
+			ResourceGroup: ia.NetworkResourceGroupName(),
 			Name:          fmt.Sprintf("%s-workers", ia.TechnicalName()),
 			Kind:          KindSecurityGroup,
 		},
 		Location: ia.Region(),
+		Managed:  true,
 	}
 }
 
@@ -188,6 +235,10 @@ type PublicIPConfig struct {
 	Zones    []string
 	Location string
 	Managed  bool
+	// DNSLabel is the DNS name label of the public IP.
+	DNSLabel *string
+	// PublicIPPrefix is the existing public IP prefix from which the public IP is allocated.
+	PublicIPPrefix *AzureResourceMetadata
 }
 
 // NatGatewayConfig contains configuration for a NAT Gateway.
@@ -199,13 +250,53 @@ type NatGatewayConfig struct {
 	PublicIPList []PublicIPConfig
 }
 
+// OutboundLoadBalancerConfig contains the configuration of the outbound load balancer shared by the worker pools.
+type OutboundLoadBalancerConfig struct {
+	AzureResourceMetadata
+	ShootInfo
+	Location               string
+	PublicIPList           []PublicIPConfig
+	AllocatedOutboundPorts *int32
+	IdleTimeout            int32
+}
+
 // SubnetConfig is the specification for a subnet
 type SubnetConfig struct {
 	AzureResourceMetadata
-	cidr                  string
-	serviceEndpoint       []string
-	zone                  *string
-	defaultOutboundAccess bool
+	cidr                    string
+	serviceEndpoint         []string
+	serviceEndpointPolicies []string
+	zone                    *string
+	defaultOutboundAccess   bool
+	delegation              *string
+	// disablePrivateLinkServiceNetworkPolicies must be set for a subnet which provides the IP addresses of a Private
+	// Link Service.
+	disablePrivateLinkServiceNetworkPolicies bool
+	// gateway must be set for the gateway subnet, to which Azure does not allow to attach the security group, the route
+	// table and the NAT gateways of the shoot.
+	gateway bool
+	// routeServer must be set for the route server subnet, to which Azure does not allow to attach the security group of
+	// the shoot either.
+	routeServer bool
+	// privateEndpointNetworkPolicies and privateLinkServiceNetworkPolicies overwrite the network policies of the subnet
+	// if they are set, otherwise the policies of an existing subnet are kept.
+	privateEndpointNetworkPolicies    *string
+	privateLinkServiceNetworkPolicies *string
+}
+
+// ServiceEndpointPolicies returns the IDs of the service endpoint policies which should be attached to the subnet.
+func (s *SubnetConfig) ServiceEndpointPolicies() []string {
+	return s.serviceEndpointPolicies
+}
+
+// IsGateway returns whether the subnet is the gateway subnet.
+func (s *SubnetConfig) IsGateway() bool {
+	return s.gateway
+}
+
+// IsRouteServer returns whether the subnet is the route server subnet.
+func (s *SubnetConfig) IsRouteServer() bool {
+	return s.routeServer
 }
 
 // ZoneConfig is the specification for a zone.
@@ -231,6 +322,18 @@ func (ia *InfrastructureAdapter) shootSubnetNamePrefix() string {
 	return fmt.Sprintf("%s-nodes", ia.TechnicalName())
 }
 
+func (ia *InfrastructureAdapter) podSubnetName() string {
+	return fmt.Sprintf("%s-pods", ia.TechnicalName())
+}
+
+func (ia *InfrastructureAdapter) privateLinkSubnetName() string {
+	return fmt.Sprintf("%s-private-link", ia.TechnicalName())
+}
+
+func (ia *InfrastructureAdapter) internalLoadBalancerSubnetName() string {
+	return fmt.Sprintf("%s-internal-lb", ia.TechnicalName())
+}
+
 func (ia *InfrastructureAdapter) subnetName(zone *int32, migrated bool) string {
 	n := ia.shootSubnetNamePrefix()
 	if zone != nil && !migrated {
@@ -248,6 +351,17 @@ func (ia *InfrastructureAdapter) IsOwnSubnetName(name *string) bool {
 	if name == nil {
 		return false
 	}
+	if *name == ia.podSubnetName() || *name == ia.privateLinkSubnetName() || *name == ia.internalLoadBalancerSubnetName() {
+		return true
+	}
+	// the gateway subnet has a fixed name, hence it is only owned by the shoot if it is configured in a vnet which is
+	// managed by the shoot. A removed gateway subnet is kept, since it may still be used by a virtual network gateway.
+	if *name == GatewaySubnetName {
+		return ia.vnetConfig.Managed && ia.config.Networks.GatewaySubnet != nil
+	}
+	if *name == RouteServerSubnetName {
+		return ia.vnetConfig.Managed && ia.config.Networks.RouteServerSubnet != nil
+	}
 	expectedPrefix := ia.shootSubnetNamePrefix()
 	if _, found := strings.CutPrefix(*name, expectedPrefix); found {
 		return true
@@ -257,8 +371,84 @@ func (ia *InfrastructureAdapter) IsOwnSubnetName(name *string) bool {
 	return false
 }
 
-func (ia *InfrastructureAdapter) publicIPName(natName string) string {
-	return fmt.Sprintf("%s-ip", natName)
+// publicIPName returns the name of the managed public IP with the given index of a NAT gateway. The first public IP keeps
+// the name it had before the number of public IPs became configurable.
+func (ia *InfrastructureAdapter) publicIPName(natName string, index int) string {
+	if index == 0 {
+		return fmt.Sprintf("%s-ip", natName)
+	}
+	return fmt.Sprintf("%s-ip-%d", natName, index+1)
+}
+
+// managedPublicIPs returns the configuration of the public IPs which are created for a NAT gateway. Public IPs beyond
+// the configured count are not part of the result, hence they are detached and deleted by the reconciliation.
+func (ia *InfrastructureAdapter) managedPublicIPs(natName string, count *int32, zones []string, dnsLabelTemplate *string, prefix *azure.PublicIPPrefixReference) []PublicIPConfig {
+	var prefixMetadata *AzureResourceMetadata
+	if prefix != nil {
+		prefixMetadata = &AzureResourceMetadata{
+			ResourceGroup: prefix.ResourceGroup,
+			Name:          prefix.Name,
+			Kind:          KindPublicIPPrefix,
+		}
+	}
+
+	var ips []PublicIPConfig
+	for i := range int(ptr.Deref(count, 1)) {
+		var dnsLabel *string
+		if dnsLabelTemplate != nil {
+			var zone string
+			if len(zones) == 1 {
+				zone = zones[0]
+			}
+			dnsLabel = ptr.To(helper.PublicIPDNSLabel(*dnsLabelTemplate, ia.cluster.Shoot.Name, zone, i))
+		}
+
+		ips = append(ips, PublicIPConfig{
+			ShootInfo: ShootInfo{
+				ShootName: ia.TechnicalName(),
+			},
+			AzureResourceMetadata: AzureResourceMetadata{
+				ResourceGroup: ia.ResourceGroupName(),
+				Name:          ia.publicIPName(natName, i),
+				Kind:          KindPublicIP,
+			},
+			Managed:        true,
+			Zones:          zones,
+			Location:       ia.Region(),
+			DNSLabel:       dnsLabel,
+			PublicIPPrefix: prefixMetadata,
+		})
+	}
+	return ips
+}
+
+// OutboundLoadBalancer returns the target specification of the outbound load balancer or nil if it is not configured.
+func (ia *InfrastructureAdapter) OutboundLoadBalancer() *OutboundLoadBalancerConfig {
+	return ia.outboundLoadBalancer
+}
+
+func (ia *InfrastructureAdapter) outboundLoadBalancerConfig() *OutboundLoadBalancerConfig {
+	config := ia.config.Networks.OutboundLoadBalancer
+	if config == nil {
+		return nil
+	}
+
+	name := fmt.Sprintf("%s-outbound-lb", ia.TechnicalName())
+	return &OutboundLoadBalancerConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          name,
+			Kind:          KindLoadBalancer,
+		},
+		ShootInfo: ShootInfo{
+			ShootName: ia.TechnicalName(),
+		},
+		Location: ia.Region(),
+		// the public IPs are zone-redundant, hence they keep serving the machines of all zones if one zone fails.
+		PublicIPList:           ia.managedPublicIPs(name, config.PublicIPCount, nil, nil, nil),
+		AllocatedOutboundPorts: config.AllocatedOutboundPorts,
+		IdleTimeout:            ptr.Deref(config.IdleTimeoutMinutes, 4),
+	}
 }
 
 // Zones returns the target specification for the zones that need to be reconciled.
@@ -266,6 +456,144 @@ func (ia *InfrastructureAdapter) Zones() []ZoneConfig {
 	return ia.zoneConfigs
 }
 
+// PodSubnetConfig returns the specification of the delegated pod subnet or nil if no pod subnet is configured.
+func (ia *InfrastructureAdapter) PodSubnetConfig() *SubnetConfig {
+	pods := ia.config.Networks.Pods
+	if pods == nil {
+		return nil
+	}
+
+	return &SubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          ia.podSubnetName(),
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		cidr:                  pods.CIDR,
+		defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+		delegation:            to.Ptr(ptr.Deref(pods.Delegation, azure.DefaultPodSubnetDelegation)),
+	}
+}
+
+// PrivateLinkSubnetConfig returns the specification of the subnet of the Private Link Service or nil if no private link
+// is configured.
+func (ia *InfrastructureAdapter) PrivateLinkSubnetConfig() *SubnetConfig {
+	privateLink := ia.config.Networks.PrivateLink
+	if privateLink == nil {
+		return nil
+	}
+
+	return &SubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          ia.privateLinkSubnetName(),
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		cidr:                                     privateLink.CIDR,
+		defaultOutboundAccess:                    !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+		disablePrivateLinkServiceNetworkPolicies: true,
+	}
+}
+
+// InternalLoadBalancerSubnetConfig returns the specification of the dedicated subnet of the internal load balancers or
+// nil if the internal load balancers are placed in the worker subnet.
+func (ia *InfrastructureAdapter) InternalLoadBalancerSubnetConfig() *SubnetConfig {
+	internalLoadBalancer := ia.config.Networks.InternalLoadBalancer
+	if internalLoadBalancer == nil {
+		return nil
+	}
+
+	return &SubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          ia.internalLoadBalancerSubnetName(),
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		cidr:                  internalLoadBalancer.CIDR,
+		defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+	}
+}
+
+// GatewaySubnetConfig returns the specification of the gateway subnet or nil if no gateway subnet is configured.
+func (ia *InfrastructureAdapter) GatewaySubnetConfig() *SubnetConfig {
+	gatewaySubnet := ia.config.Networks.GatewaySubnet
+	if gatewaySubnet == nil {
+		return nil
+	}
+
+	return &SubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          GatewaySubnetName,
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		cidr:                  gatewaySubnet.CIDR,
+		defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+		gateway:               true,
+	}
+}
+
+// RouteServerSubnetConfig returns the specification of the route server subnet or nil if no route server subnet is
+// configured.
+func (ia *InfrastructureAdapter) RouteServerSubnetConfig() *SubnetConfig {
+	routeServerSubnet := ia.config.Networks.RouteServerSubnet
+	if routeServerSubnet == nil {
+		return nil
+	}
+
+	return &SubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          RouteServerSubnetName,
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		cidr:                  routeServerSubnet.CIDR,
+		defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+		routeServer:           true,
+	}
+}
+
+// AdditionalSubnetConfigs returns the specification of the additional subnets for the nodes. A subnet which is bound to
+// a zone shares the NAT Gateway of the zone, otherwise it can only share the NAT Gateway of the single subnet layout.
+// Subnets which are excluded from the NAT Gateway have none, hence a managed NAT Gateway is detached from them.
+func (ia *InfrastructureAdapter) AdditionalSubnetConfigs() []ZoneConfig {
+	var zones []ZoneConfig
+	for _, subnet := range ia.config.Networks.AdditionalSubnets {
+		z := ZoneConfig{
+			Subnet: SubnetConfig{
+				AzureResourceMetadata: AzureResourceMetadata{
+					ResourceGroup: ia.vnetConfig.ResourceGroup,
+					Name:          helper.AdditionalSubnetName(ia.TechnicalName(), subnet.Name),
+					Parent:        ia.vnetConfig.Name,
+					Kind:          KindSubnet,
+				},
+				cidr:                  subnet.CIDR,
+				defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+			},
+		}
+
+		switch {
+		case !ptr.Deref(subnet.AttachNatGateway, true):
+		case subnet.Zone != nil:
+			z.Subnet.zone = to.Ptr(helper.InfrastructureZoneToString(*subnet.Zone))
+			for _, zone := range ia.zoneConfigs {
+				if ptr.Equal(zone.Subnet.zone, z.Subnet.zone) {
+					z.NatGateway = zone.NatGateway
+				}
+			}
+		case len(ia.config.Networks.Zones) == 0:
+			z.NatGateway = ia.zoneConfigs[0].NatGateway
+		}
+		zones = append(zones, z)
+	}
+	return zones
+}
+
 func (ia *InfrastructureAdapter) hasDisableDefaultOutBoundAccessAnnotation() bool {
 	ok, _ := strconv.ParseBool(ia.cluster.Shoot.Annotations[consts.DisableDefaultOutboundAccessAnnotation])
 	return ok
@@ -289,10 +617,13 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 					Parent:        ia.vnetConfig.Name,
 					Kind:          KindSubnet,
 				},
-				cidr:                  configZone.CIDR,
-				serviceEndpoint:       configZone.ServiceEndpoints,
-				zone:                  &zoneString,
-				defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+				cidr:                              configZone.CIDR,
+				serviceEndpoint:                   configZone.ServiceEndpoints,
+				serviceEndpointPolicies:           configZone.ServiceEndpointPolicies,
+				zone:                              &zoneString,
+				defaultOutboundAccess:             !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+				privateEndpointNetworkPolicies:    configZone.PrivateEndpointNetworkPolicies,
+				privateLinkServiceNetworkPolicies: configZone.PrivateLinkServiceNetworkPolicies,
 			},
 			Migrated: isMigratedZone,
 		}
@@ -300,7 +631,7 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 		if configZone.NatGateway != nil && configZone.NatGateway.Enabled {
 			ngw := &NatGatewayConfig{
 				AzureResourceMetadata: AzureResourceMetadata{
-					ResourceGroup: ia.ResourceGroupName(),
+					ResourceGroup: ia.NetworkResourceGroupName(),
 					Name:          ia.natGatewayNameForZone(configZone.Name, isMigratedZone),
 					Kind:          KindNatGateway,
 				},
@@ -327,20 +658,7 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 					ngw.PublicIPList = append(ngw.PublicIPList, ip)
 				}
 			} else {
-				ip := PublicIPConfig{
-					ShootInfo: ShootInfo{
-						ShootName: ia.TechnicalName(),
-					},
-					AzureResourceMetadata: AzureResourceMetadata{
-						ResourceGroup: ia.ResourceGroupName(),
-						Name:          ia.publicIPName(ngw.Name),
-						Kind:          KindPublicIP,
-					},
-					Managed:  true,
-					Zones:    []string{zoneString},
-					Location: ia.Region(),
-				}
-				ngw.PublicIPList = append(ngw.PublicIPList, ip)
+				ngw.PublicIPList = ia.managedPublicIPs(ngw.Name, configZone.NatGateway.PublicIPCount, []string{zoneString}, configZone.NatGateway.PublicIPDNSLabelTemplate, configZone.NatGateway.PublicIPPrefix)
 			}
 		}
 		zones = append(zones, z)
@@ -359,9 +677,12 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 				Parent:        ia.vnetConfig.Name,
 				Kind:          KindSubnet,
 			},
-			cidr:                  *config.Networks.Workers,
-			serviceEndpoint:       config.Networks.ServiceEndpoints,
-			defaultOutboundAccess: !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+			cidr:                              *config.Networks.Workers,
+			serviceEndpoint:                   config.Networks.ServiceEndpoints,
+			serviceEndpointPolicies:           config.Networks.ServiceEndpointPolicies,
+			defaultOutboundAccess:             !ia.hasDisableDefaultOutBoundAccessAnnotation(),
+			privateEndpointNetworkPolicies:    config.Networks.PrivateEndpointNetworkPolicies,
+			privateLinkServiceNetworkPolicies: config.Networks.PrivateLinkServiceNetworkPolicies,
 		},
 		Migrated: false,
 	}
@@ -371,7 +692,7 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 
 	ngw := &NatGatewayConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
-			ResourceGroup: ia.ResourceGroupName(),
+			ResourceGroup: ia.NetworkResourceGroupName(),
 			Name:          ia.natGatewayName(),
 			Kind:          KindNatGateway,
 		},
@@ -399,22 +720,11 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 			ngw.PublicIPList = append(ngw.PublicIPList, ip)
 		}
 	} else {
-		ip := PublicIPConfig{
-			ShootInfo: ShootInfo{
-				ShootName: ia.TechnicalName(),
-			},
-			AzureResourceMetadata: AzureResourceMetadata{
-				ResourceGroup: ia.ResourceGroupName(),
-				Name:          ia.publicIPName(ngw.Name),
-				Kind:          KindPublicIP,
-			},
-			Managed:  true,
-			Location: ia.Region(),
-		}
+		var zones []string
 		if ngw.Zone != nil {
-			ip.Zones = append(ip.Zones, *ngw.Zone)
+			zones = []string{*ngw.Zone}
 		}
-		ngw.PublicIPList = append(ngw.PublicIPList, ip)
+		ngw.PublicIPList = ia.managedPublicIPs(ngw.Name, config.Networks.NatGateway.PublicIPCount, zones, config.Networks.NatGateway.PublicIPDNSLabelTemplate, config.Networks.NatGateway.PublicIPPrefix)
 	}
 	z.NatGateway = ngw
 
@@ -436,6 +746,11 @@ func (ia *InfrastructureAdapter) ManagedIpConfigs() map[string]PublicIPConfig {
 			}
 		}
 	}
+	if lb := ia.outboundLoadBalancer; lb != nil {
+		for _, ip := range lb.PublicIPList {
+			res[ip.Name] = ip
+		}
+	}
 
 	return res
 }
@@ -513,6 +828,11 @@ func (ip *PublicIPConfig) ToProvider(base *armnetwork.PublicIPAddress) *armnetwo
 		// if no zones selected, zones has to be nil, to match what the API returns - otherwise reflect.DeepEqual fails the check.
 		target.Zones = to.SliceOfPtrs(ip.Zones...)
 	}
+	if ip.DNSLabel != nil {
+		target.Properties.DNSSettings = &armnetwork.PublicIPAddressDNSSettings{
+			DomainNameLabel: ip.DNSLabel,
+		}
+	}
 
 	target.Tags = utils.MergeStringMaps(base.Tags, map[string]*string{
 		TagManagedByGardener: to.Ptr("true"),
@@ -543,7 +863,9 @@ func (nat *NatGatewayConfig) ToProvider(base *armnetwork.NatGateway) *armnetwork
 
 	// inherited from base
 	if base != nil {
-		target.Properties.PublicIPPrefixes = base.Properties.PublicIPPrefixes
+		if base.Properties != nil {
+			target.Properties.PublicIPPrefixes = base.Properties.PublicIPPrefixes
+		}
 		target.ID = base.ID
 	}
 	return target
@@ -585,6 +907,27 @@ func (s *SubnetConfig) ToProvider(base *armnetwork.Subnet) *armnetwork.Subnet {
 		target.Properties.Delegations = base.Properties.Delegations
 	}
 
+	if s.privateEndpointNetworkPolicies != nil {
+		target.Properties.PrivateEndpointNetworkPolicies = to.Ptr(armnetwork.VirtualNetworkPrivateEndpointNetworkPolicies(*s.privateEndpointNetworkPolicies))
+	}
+	if s.privateLinkServiceNetworkPolicies != nil {
+		target.Properties.PrivateLinkServiceNetworkPolicies = to.Ptr(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPolicies(*s.privateLinkServiceNetworkPolicies))
+	}
+	if s.disablePrivateLinkServiceNetworkPolicies {
+		target.Properties.PrivateLinkServiceNetworkPolicies = to.Ptr(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled)
+	}
+
+	if s.delegation != nil {
+		target.Properties.Delegations = []*armnetwork.Delegation{
+			{
+				Name: to.Ptr("pods"),
+				Properties: &armnetwork.ServiceDelegationPropertiesFormat{
+					ServiceName: s.delegation,
+				},
+			},
+		}
+	}
+
 	return target
 }
 
@@ -605,7 +948,7 @@ func (v *VirtualNetworkConfig) ToProvider(base *armnetwork.VirtualNetwork) *armn
 
 	// apply the desired changes in place.
 	desired.Properties.AddressSpace = &armnetwork.AddressSpace{
-		AddressPrefixes: []*string{v.CIDR},
+		AddressPrefixes: v.addressPrefixes(base),
 	}
 	if ddosId := v.DDoSPlanID; ddosId != nil {
 		desired.Properties.EnableDdosProtection = to.Ptr(true)
@@ -614,10 +957,63 @@ func (v *VirtualNetworkConfig) ToProvider(base *armnetwork.VirtualNetwork) *armn
 		desired.Properties.DdosProtectionPlan = nil
 		desired.Properties.EnableDdosProtection = to.Ptr(false)
 	}
+	if v.Encryption != nil {
+		desired.Properties.Encryption = v.Encryption
+	} else if encryption := desired.Properties.Encryption; encryption != nil && ptr.Deref(encryption.Enabled, false) {
+		// the encryption is disabled again once it is no longer configured.
+		desired.Properties.Encryption = &armnetwork.VirtualNetworkEncryption{Enabled: to.Ptr(false), Enforcement: encryption.Enforcement}
+	}
 
 	return desired
 }
 
+// addressPrefixes returns the desired address prefixes of the vnet. Azure refuses to remove an address prefix which is
+// still used by a subnet, hence the prefixes of the base which contain a subnet are kept until the subnet is deleted.
+func (v *VirtualNetworkConfig) addressPrefixes(base *armnetwork.VirtualNetwork) []*string {
+	prefixes := []*string{v.CIDR}
+	for _, cidr := range v.AdditionalCIDRs {
+		prefixes = append(prefixes, to.Ptr(cidr))
+	}
+
+	if base == nil || base.Properties == nil || base.Properties.AddressSpace == nil {
+		return prefixes
+	}
+
+	desired := sets.New(v.AdditionalCIDRs...).Insert(ptr.Deref(v.CIDR, ""))
+	for _, prefix := range base.Properties.AddressSpace.AddressPrefixes {
+		if prefix == nil || desired.Has(*prefix) {
+			continue
+		}
+		if isAddressPrefixInUse(*prefix, base.Properties.Subnets) {
+			prefixes = append(prefixes, to.Ptr(*prefix))
+		}
+	}
+	return prefixes
+}
+
+// isAddressPrefixInUse returns true if any of the given subnets is within the address prefix.
+func isAddressPrefixInUse(addressPrefix string, subnets []*armnetwork.Subnet) bool {
+	prefix, err := netip.ParsePrefix(addressPrefix)
+	if err != nil {
+		// keep address prefixes which cannot be checked.
+		return true
+	}
+
+	for _, subnet := range subnets {
+		if subnet == nil || subnet.Properties == nil {
+			continue
+		}
+		subnetPrefixes := append([]*string{subnet.Properties.AddressPrefix}, subnet.Properties.AddressPrefixes...)
+		for _, subnetPrefix := range subnetPrefixes {
+			s, err := netip.ParsePrefix(ptr.Deref(subnetPrefix, ""))
+			if err == nil && prefix.Overlaps(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ToProvider translates the config into the actual providerAccess object.
 func (r *SecurityGroupConfig) ToProvider(base *armnetwork.SecurityGroup) *armnetwork.SecurityGroup {
 	desired := &armnetwork.SecurityGroup{
@@ -0,0 +1,523 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("InfrastructureAdapter", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		infra   *extensionsv1alpha1.Infrastructure
+		config  *azure.InfrastructureConfig
+		cluster *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+		config = &azure.InfrastructureConfig{
+			Networks: azure.NetworkConfig{
+				VNet:    azure.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		}
+		cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+	})
+
+	Describe("#ResourceGroup", func() {
+		It("should place the resource group in the region of the shoot by default", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.ResourceGroup().Location).To(Equal("westeurope"))
+		})
+
+		It("should place the resource group in the configured location but keep the resources in the region of the shoot", func() {
+			config.ResourceGroupLocation = ptr.To("northeurope")
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.ResourceGroup().Location).To(Equal("northeurope"))
+			Expect(ia.ResourceGroup().Name).To(Equal(namespace))
+			Expect(ia.VirtualNetworkConfig().Location).To(Equal("westeurope"))
+		})
+
+		It("should place the network resources in the network resource group", func() {
+			config.NetworkResourceGroup = &azure.ResourceGroup{Name: "network-rg"}
+			config.Networks.NatGateway = &azure.NatGatewayConfig{Enabled: true}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.ResourceGroupName()).To(Equal(namespace))
+			Expect(ia.VirtualNetworkConfig().ResourceGroup).To(Equal("network-rg"))
+			Expect(ia.VirtualNetworkConfig().Managed).To(BeTrue())
+			Expect(ia.SecurityGroupConfig().ResourceGroup).To(Equal("network-rg"))
+			Expect(ia.RouteTableConfig().ResourceGroup).To(Equal("network-rg"))
+			Expect(ia.NatGatewayConfigs()).NotTo(BeEmpty())
+			for _, nat := range ia.NatGatewayConfigs() {
+				Expect(nat.ResourceGroup).To(Equal("network-rg"))
+			}
+			for _, ip := range ia.ManagedIpConfigs() {
+				Expect(ip.ResourceGroup).To(Equal(namespace))
+			}
+		})
+	})
+
+	Describe("#SecurityGroupConfig", func() {
+		It("should return the existing security group", func() {
+			config.Networks.SecurityGroup = &azure.SecurityGroupReference{Name: "existing-nsg", ResourceGroup: "security-rg"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.SecurityGroupConfig().Name).To(Equal("existing-nsg"))
+			Expect(ia.SecurityGroupConfig().ResourceGroup).To(Equal("security-rg"))
+			Expect(ia.SecurityGroupConfig().Managed).To(BeFalse())
+		})
+
+		It("should return the managed security group", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.SecurityGroupConfig().Name).To(Equal(namespace + "-workers"))
+			Expect(ia.SecurityGroupConfig().Managed).To(BeTrue())
+		})
+	})
+
+	Describe("#VirtualNetworkConfig", func() {
+		var base *armnetwork.VirtualNetwork
+
+		BeforeEach(func() {
+			config.Networks.VNet.CIDR = ptr.To("10.250.0.0/16")
+			config.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16"}
+
+			base = &armnetwork.VirtualNetwork{
+				Properties: &armnetwork.VirtualNetworkPropertiesFormat{
+					AddressSpace: &armnetwork.AddressSpace{
+						AddressPrefixes: []*string{ptr.To("10.250.0.0/16"), ptr.To("192.168.0.0/16"), ptr.To("192.168.128.0/17")},
+					},
+					Subnets: []*armnetwork.Subnet{
+						{Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.250.0.0/24")}},
+						{Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefixes: []*string{ptr.To("192.168.0.0/24")}}},
+					},
+				},
+			}
+		})
+
+		It("should set all address prefixes of the vnet", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(nil).Properties.AddressSpace.AddressPrefixes).To(Equal([]*string{ptr.To("10.250.0.0/16"), ptr.To("172.16.0.0/16")}))
+		})
+
+		It("should keep removed address prefixes as long as they are in use by a subnet", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(base).Properties.AddressSpace.AddressPrefixes).To(Equal([]*string{ptr.To("10.250.0.0/16"), ptr.To("172.16.0.0/16"), ptr.To("192.168.0.0/16")}))
+		})
+
+		It("should not set an encryption if none is configured", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(base).Properties.Encryption).To(BeNil())
+		})
+
+		It("should enable the encryption and drop unencrypted traffic by default", func() {
+			config.Networks.VNet.Encryption = &azure.VNetEncryption{Enabled: true}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(base).Properties.Encryption).To(Equal(&armnetwork.VirtualNetworkEncryption{
+				Enabled:     ptr.To(true),
+				Enforcement: ptr.To(armnetwork.VirtualNetworkEncryptionEnforcementDropUnencrypted),
+			}))
+		})
+
+		It("should set the configured enforcement", func() {
+			config.Networks.VNet.Encryption = &azure.VNetEncryption{Enabled: true, Enforcement: ptr.To(azure.VNetEncryptionEnforcementAllowUnencrypted)}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(nil).Properties.Encryption.Enforcement).To(Equal(ptr.To(armnetwork.VirtualNetworkEncryptionEnforcementAllowUnencrypted)))
+		})
+
+		It("should disable the encryption of the vnet once it is no longer configured", func() {
+			base.Properties.Encryption = &armnetwork.VirtualNetworkEncryption{
+				Enabled:     ptr.To(true),
+				Enforcement: ptr.To(armnetwork.VirtualNetworkEncryptionEnforcementDropUnencrypted),
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := ia.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(base).Properties.Encryption).To(Equal(&armnetwork.VirtualNetworkEncryption{
+				Enabled:     ptr.To(false),
+				Enforcement: ptr.To(armnetwork.VirtualNetworkEncryptionEnforcementDropUnencrypted),
+			}))
+		})
+	})
+
+	Describe("#PodSubnetConfig", func() {
+		It("should return nil if no pod subnet is configured", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.PodSubnetConfig()).To(BeNil())
+		})
+
+		It("should return the delegated pod subnet", func() {
+			config.Networks.Pods = &azure.PodSubnetConfig{CIDR: "10.1.0.0/16"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			podSubnet := ia.PodSubnetConfig()
+			Expect(podSubnet).NotTo(BeNil())
+			Expect(podSubnet.Name).To(Equal(namespace + "-pods"))
+			Expect(podSubnet.Parent).To(Equal(namespace))
+			Expect(ia.IsOwnSubnetName(ptr.To(podSubnet.Name))).To(BeTrue())
+
+			subnet := podSubnet.ToProvider(nil)
+			Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.1.0.0/16")))
+			Expect(subnet.Properties.Delegations).To(ConsistOf(&armnetwork.Delegation{
+				Name: ptr.To("pods"),
+				Properties: &armnetwork.ServiceDelegationPropertiesFormat{
+					ServiceName: ptr.To(azure.DefaultPodSubnetDelegation),
+				},
+			}))
+		})
+
+		It("should use the configured delegation", func() {
+			config.Networks.Pods = &azure.PodSubnetConfig{CIDR: "10.1.0.0/16", Delegation: ptr.To("Microsoft.Network/dnsResolvers")}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			subnet := ia.PodSubnetConfig().ToProvider(nil)
+			Expect(subnet.Properties.Delegations).To(HaveLen(1))
+			Expect(subnet.Properties.Delegations[0].Properties.ServiceName).To(Equal(ptr.To("Microsoft.Network/dnsResolvers")))
+		})
+	})
+	Describe("#Zones", func() {
+		const policyID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/serviceEndpointPolicies/policy"
+
+		It("should pass the service endpoint policies of the worker subnet", func() {
+			config.Networks.ServiceEndpointPolicies = []string{policyID}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ia.Zones()).To(HaveLen(1))
+			Expect(ia.Zones()[0].Subnet.ServiceEndpointPolicies()).To(ConsistOf(policyID))
+		})
+
+		It("should pass the service endpoint policies of the zone subnets", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", ServiceEndpointPolicies: []string{policyID}},
+				{Name: 2, CIDR: "10.250.1.0/24"},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ia.Zones()).To(HaveLen(2))
+			Expect(ia.Zones()[0].Subnet.ServiceEndpointPolicies()).To(ConsistOf(policyID))
+			Expect(ia.Zones()[1].Subnet.ServiceEndpointPolicies()).To(BeEmpty())
+		})
+
+		It("should render the configured network policies of the worker subnet", func() {
+			config.Networks.PrivateEndpointNetworkPolicies = ptr.To("Enabled")
+			config.Networks.PrivateLinkServiceNetworkPolicies = ptr.To("Disabled")
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			subnet := ia.Zones()[0].Subnet.ToProvider(nil)
+			Expect(subnet.Properties.PrivateEndpointNetworkPolicies).To(Equal(ptr.To(armnetwork.VirtualNetworkPrivateEndpointNetworkPoliciesEnabled)))
+			Expect(subnet.Properties.PrivateLinkServiceNetworkPolicies).To(Equal(ptr.To(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled)))
+		})
+
+		It("should update the network policies of an existing subnet in place", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", PrivateEndpointNetworkPolicies: ptr.To("Disabled")},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			current := &armnetwork.Subnet{
+				ID: ptr.To("subnet-id"),
+				Properties: &armnetwork.SubnetPropertiesFormat{
+					PrivateEndpointNetworkPolicies:    ptr.To(armnetwork.VirtualNetworkPrivateEndpointNetworkPoliciesEnabled),
+					PrivateLinkServiceNetworkPolicies: ptr.To(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPoliciesEnabled),
+				},
+			}
+			subnet := ia.Zones()[0].Subnet.ToProvider(current)
+			Expect(subnet.ID).To(Equal(ptr.To("subnet-id")))
+			Expect(subnet.Properties.PrivateEndpointNetworkPolicies).To(Equal(ptr.To(armnetwork.VirtualNetworkPrivateEndpointNetworkPoliciesDisabled)))
+			Expect(subnet.Properties.PrivateLinkServiceNetworkPolicies).To(Equal(ptr.To(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPoliciesEnabled)))
+		})
+	})
+	Describe("#ManagedIpConfigs", func() {
+		It("should keep the name of the single public IP of a NAT Gateway", func() {
+			config.Networks.NatGateway = &azure.NatGatewayConfig{Enabled: true}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ia.ManagedIpConfigs()).To(HaveKey(namespace + "-nat-gateway-ip"))
+			Expect(ia.ManagedIpConfigs()).To(HaveLen(1))
+		})
+
+		It("should create the configured number of public IPs for a NAT Gateway", func() {
+			config.Networks.NatGateway = &azure.NatGatewayConfig{Enabled: true, Zone: ptr.To[int32](1), PublicIPCount: ptr.To[int32](3)}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := ia.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(3))
+			Expect(ips).To(HaveKey(namespace + "-nat-gateway-ip"))
+			Expect(ips).To(HaveKey(namespace + "-nat-gateway-ip-2"))
+			Expect(ips).To(HaveKey(namespace + "-nat-gateway-ip-3"))
+			for _, ip := range ips {
+				Expect(ip.Zones).To(ConsistOf("1"))
+			}
+			Expect(ia.Zones()[0].NatGateway.PublicIPList).To(HaveLen(3))
+		})
+
+		It("should create the configured number of public IPs for the NAT Gateway of a zone", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true, PublicIPCount: ptr.To[int32](2)}},
+				{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true}},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ia.Zones()[0].NatGateway.PublicIPList).To(HaveLen(2))
+			Expect(ia.Zones()[1].NatGateway.PublicIPList).To(HaveLen(1))
+			Expect(ia.ManagedIpConfigs()).To(HaveLen(3))
+		})
+
+		It("should render the DNS labels of the public IPs of the NAT Gateways", func() {
+			cluster.Shoot.Name = "bar"
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true, PublicIPCount: ptr.To[int32](2), PublicIPDNSLabelTemplate: ptr.To("egress-{shoot}-z{zone}-{index}")}},
+				{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true}},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := ia.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(3))
+			for name, label := range map[string]string{namespace + "-nat-gateway-z1-ip": "egress-bar-z1-1", namespace + "-nat-gateway-z1-ip-2": "egress-bar-z1-2"} {
+				ip := ips[name]
+				Expect(ip.DNSLabel).To(Equal(ptr.To(label)))
+				Expect(ip.ToProvider(nil).Properties.DNSSettings).To(Equal(&armnetwork.PublicIPAddressDNSSettings{DomainNameLabel: ptr.To(label)}))
+			}
+			ip := ips[namespace+"-nat-gateway-z2-ip"]
+			Expect(ip.DNSLabel).To(BeNil())
+			Expect(ip.ToProvider(nil).Properties.DNSSettings).To(BeNil())
+		})
+
+		It("should create the zone-redundant public IPs of the outbound load balancer", func() {
+			config.Networks.OutboundLoadBalancer = &azure.OutboundLoadBalancerConfig{PublicIPCount: ptr.To[int32](2)}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := ia.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(2))
+			Expect(ips).To(HaveKey(namespace + "-outbound-lb-ip"))
+			Expect(ips).To(HaveKey(namespace + "-outbound-lb-ip-2"))
+			for _, ip := range ips {
+				Expect(ip.Zones).To(BeEmpty())
+			}
+			Expect(ia.OutboundLoadBalancer().PublicIPList).To(HaveLen(2))
+		})
+	})
+
+	Describe("#AdditionalSubnetConfigs", func() {
+		It("should share the NAT Gateway of the single subnet layout", func() {
+			config.Networks.NatGateway = &azure.NatGatewayConfig{Enabled: true}
+			config.Networks.AdditionalSubnets = []azure.AdditionalSubnet{{Name: "gpu", CIDR: "10.250.4.0/24"}}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			subnets := ia.AdditionalSubnetConfigs()
+			Expect(subnets).To(HaveLen(1))
+			Expect(subnets[0].Subnet.Name).To(Equal(namespace + "-nodes-gpu"))
+			Expect(subnets[0].NatGateway).To(Equal(ia.Zones()[0].NatGateway))
+			Expect(ia.IsOwnSubnetName(ptr.To(subnets[0].Subnet.Name))).To(BeTrue())
+			Expect(subnets[0].Subnet.ToProvider(nil).Properties.AddressPrefix).To(Equal(ptr.To("10.250.4.0/24")))
+		})
+
+		It("should share the NAT Gateway of the zone the subnet is bound to", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true}},
+				{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true}},
+			}
+			config.Networks.AdditionalSubnets = []azure.AdditionalSubnet{
+				{Name: "gpu", CIDR: "10.250.4.0/24", Zone: ptr.To[int32](2)},
+				{Name: "batch", CIDR: "10.250.5.0/24"},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			subnets := ia.AdditionalSubnetConfigs()
+			Expect(subnets).To(HaveLen(2))
+			Expect(subnets[0].NatGateway).To(Equal(ia.Zones()[1].NatGateway))
+			Expect(subnets[1].NatGateway).To(BeNil())
+		})
+
+		It("should not attach the NAT Gateway to subnets which are excluded from it", func() {
+			config.Networks.NatGateway = &azure.NatGatewayConfig{Enabled: true}
+			config.Networks.AdditionalSubnets = []azure.AdditionalSubnet{
+				{Name: "dmz", CIDR: "10.250.4.0/24", AttachNatGateway: ptr.To(false)},
+				{Name: "gpu", CIDR: "10.250.5.0/24", AttachNatGateway: ptr.To(true)},
+			}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			subnets := ia.AdditionalSubnetConfigs()
+			Expect(subnets).To(HaveLen(2))
+			Expect(subnets[0].NatGateway).To(BeNil())
+			Expect(subnets[1].NatGateway).To(Equal(ia.Zones()[0].NatGateway))
+			Expect(ia.Zones()[0].NatGateway).NotTo(BeNil())
+		})
+	})
+
+	Describe("#PrivateLinkSubnetConfig", func() {
+		It("should return nil if no private link is configured", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.PrivateLinkSubnetConfig()).To(BeNil())
+		})
+
+		It("should disable the private link service network policies of the subnet", func() {
+			config.Networks.PrivateLink = &azure.PrivateLinkConfig{CIDR: "10.250.6.0/24"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			privateLinkSubnet := ia.PrivateLinkSubnetConfig()
+			Expect(privateLinkSubnet).NotTo(BeNil())
+			Expect(privateLinkSubnet.Name).To(Equal(namespace + "-private-link"))
+			Expect(ia.IsOwnSubnetName(ptr.To(privateLinkSubnet.Name))).To(BeTrue())
+
+			subnet := privateLinkSubnet.ToProvider(nil)
+			Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.250.6.0/24")))
+			Expect(subnet.Properties.PrivateLinkServiceNetworkPolicies).To(Equal(ptr.To(armnetwork.VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled)))
+		})
+	})
+
+	Describe("#InternalLoadBalancerSubnetConfig", func() {
+		It("should return nil if the internal load balancers are placed in the worker subnet", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.InternalLoadBalancerSubnetConfig()).To(BeNil())
+		})
+
+		It("should return the dedicated subnet of the internal load balancers", func() {
+			config.Networks.InternalLoadBalancer = &azure.InternalLoadBalancerConfig{CIDR: "10.251.0.0/24"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			internalLoadBalancerSubnet := ia.InternalLoadBalancerSubnetConfig()
+			Expect(internalLoadBalancerSubnet).NotTo(BeNil())
+			Expect(internalLoadBalancerSubnet.Name).To(Equal(namespace + "-internal-lb"))
+			Expect(ia.IsOwnSubnetName(ptr.To(internalLoadBalancerSubnet.Name))).To(BeTrue())
+
+			subnet := internalLoadBalancerSubnet.ToProvider(nil)
+			Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.251.0.0/24")))
+		})
+	})
+
+	Describe("#GatewaySubnetConfig", func() {
+		It("should return nil if no gateway subnet is configured", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.GatewaySubnetConfig()).To(BeNil())
+			Expect(ia.IsOwnSubnetName(ptr.To(infraflow.GatewaySubnetName))).To(BeFalse())
+		})
+
+		It("should return the gateway subnet with the name required by Azure", func() {
+			config.Networks.GatewaySubnet = &azure.GatewaySubnetConfig{CIDR: "10.252.0.0/27"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			gatewaySubnet := ia.GatewaySubnetConfig()
+			Expect(gatewaySubnet).NotTo(BeNil())
+			Expect(gatewaySubnet.Name).To(Equal("GatewaySubnet"))
+			Expect(gatewaySubnet.IsGateway()).To(BeTrue())
+			Expect(ia.IsOwnSubnetName(ptr.To(gatewaySubnet.Name))).To(BeTrue())
+
+			subnet := gatewaySubnet.ToProvider(nil)
+			Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.252.0.0/27")))
+			Expect(subnet.Properties.NetworkSecurityGroup).To(BeNil())
+			Expect(subnet.Properties.RouteTable).To(BeNil())
+		})
+
+		It("should not own the gateway subnet of an existing vnet", func() {
+			config.Networks.VNet = azure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")}
+			config.Networks.GatewaySubnet = &azure.GatewaySubnetConfig{CIDR: "10.252.0.0/27"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.IsOwnSubnetName(ptr.To(infraflow.GatewaySubnetName))).To(BeFalse())
+		})
+	})
+
+	Describe("#RouteServerSubnetConfig", func() {
+		It("should return nil if no route server subnet is configured", func() {
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.RouteServerSubnetConfig()).To(BeNil())
+			Expect(ia.IsOwnSubnetName(ptr.To(infraflow.RouteServerSubnetName))).To(BeFalse())
+		})
+
+		It("should return the route server subnet with the name required by Azure", func() {
+			config.Networks.RouteServerSubnet = &azure.RouteServerSubnetConfig{CIDR: "10.252.1.0/27"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			routeServerSubnet := ia.RouteServerSubnetConfig()
+			Expect(routeServerSubnet).NotTo(BeNil())
+			Expect(routeServerSubnet.Name).To(Equal("RouteServerSubnet"))
+			Expect(routeServerSubnet.IsRouteServer()).To(BeTrue())
+			Expect(routeServerSubnet.IsGateway()).To(BeFalse())
+			Expect(ia.IsOwnSubnetName(ptr.To(routeServerSubnet.Name))).To(BeTrue())
+
+			subnet := routeServerSubnet.ToProvider(nil)
+			Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.252.1.0/27")))
+			Expect(subnet.Properties.NetworkSecurityGroup).To(BeNil())
+			Expect(subnet.Properties.RouteTable).To(BeNil())
+			Expect(subnet.Properties.NatGateway).To(BeNil())
+		})
+
+		It("should not own the route server subnet of an existing vnet", func() {
+			config.Networks.VNet = azure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")}
+			config.Networks.RouteServerSubnet = &azure.RouteServerSubnetConfig{CIDR: "10.252.1.0/27"}
+			ia, err := infraflow.NewInfrastructureAdapter(infra, config, nil, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ia.IsOwnSubnetName(ptr.To(infraflow.RouteServerSubnetName))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// EnsureNetworkResourceGroup checks that the dedicated resource group of the network resources exists in the region of
+// the shoot. The resource group is never created by the extension.
+func (fctx *FlowContext) EnsureNetworkResourceGroup(ctx context.Context) error {
+	c, err := fctx.factory.Group()
+	if err != nil {
+		return err
+	}
+
+	name := fctx.adapter.NetworkResourceGroupName()
+	rg, err := c.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if rg == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the network resource group %s does not exist", name),
+			gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if location := ptr.Deref(rg.Location, ""); location != fctx.adapter.Region() {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the network resource group %s is located in %s instead of the region %s of the shoot",
+			name, location, fctx.adapter.Region()), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return nil
+}
+
+// DeleteNetworkResources deletes the network resources of the inventory which are located in the dedicated network
+// resource group. Since the subnets reference the NAT gateways, the security group and the route table, the VNet is
+// deleted first.
+func (fctx *FlowContext) DeleteNetworkResources(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	rg := fctx.adapter.NetworkResourceGroupName()
+
+	for _, kind := range []AzureResourceKind{KindVirtualNetwork, KindNatGateway, KindSecurityGroup, KindRouteTable} {
+		var joinErr error
+		for _, id := range fctx.inventory.ByKind(kind) {
+			if !strings.EqualFold(id.ResourceGroupName, rg) {
+				continue
+			}
+
+			log.Info("deleting network resource", "id", id.String())
+			if err := fctx.retryWhileInUse(ctx, func() error {
+				return fctx.deleteNetworkResource(ctx, kind, id.ResourceGroupName, id.Name)
+			}); err != nil {
+				joinErr = errors.Join(joinErr, err)
+				continue
+			}
+			fctx.inventory.Delete(id.String())
+		}
+		if joinErr != nil {
+			return joinErr
+		}
+	}
+	return nil
+}
+
+func (fctx *FlowContext) deleteNetworkResource(ctx context.Context, kind AzureResourceKind, resourceGroup, name string) error {
+	switch kind {
+	case KindVirtualNetwork:
+		c, err := fctx.factory.Vnet()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, name)
+	case KindNatGateway:
+		c, err := fctx.factory.NatGateway()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, name)
+	case KindSecurityGroup:
+		c, err := fctx.factory.NetworkSecurityGroup()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, name)
+	case KindRouteTable:
+		c, err := fctx.factory.RouteTables()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, name)
+	default:
+		return fmt.Errorf("unsupported network resource kind %s", kind)
+	}
+}
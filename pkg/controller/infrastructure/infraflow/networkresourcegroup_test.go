@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("NetworkResourceGroup", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		networkGroup   = "network-rg"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		groupPrefix    = "/subscriptions/" + subscriptionID + "/resourceGroups/"
+		vnetID         = groupPrefix + networkGroup + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+		subnetID       = vnetID + "/subnets/" + namespace + "-nodes"
+		natID          = groupPrefix + networkGroup + "/providers/Microsoft.Network/natGateways/" + namespace + "-nat-gateway"
+		securityID     = groupPrefix + networkGroup + "/providers/Microsoft.Network/networkSecurityGroups/" + namespace + "-workers"
+		routeTableID   = groupPrefix + networkGroup + "/providers/Microsoft.Network/routeTables/worker_route_table"
+		publicIPID     = groupPrefix + namespace + "/providers/Microsoft.Network/publicIPAddresses/" + namespace + "-nat-ip"
+		otherNatID     = groupPrefix + "other-rg/providers/Microsoft.Network/natGateways/" + namespace + "-nat-gateway"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		factory *mockazureclient.MockFactory
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newFlowContext := func(managedItems ...string) *infraflow.FlowContext {
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			NetworkResourceGroup: &v1alpha1.ResourceGroup{Name: networkGroup},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		state := &azure.InfrastructureState{}
+		for _, id := range managedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   state,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	Describe("#EnsureNetworkResourceGroup", func() {
+		var groupClient *mockazureclient.MockResourceGroup
+
+		BeforeEach(func() {
+			groupClient = mockazureclient.NewMockResourceGroup(ctrl)
+			factory.EXPECT().Group().Return(groupClient, nil)
+		})
+
+		It("should succeed if the network resource group exists in the region of the shoot", func() {
+			groupClient.EXPECT().Get(ctx, networkGroup).Return(&armresources.ResourceGroup{Location: ptr.To(region)}, nil)
+
+			Expect(newFlowContext().EnsureNetworkResourceGroup(ctx)).To(Succeed())
+		})
+
+		It("should fail if the network resource group does not exist", func() {
+			groupClient.EXPECT().Get(ctx, networkGroup).Return(nil, nil)
+
+			err := newFlowContext().EnsureNetworkResourceGroup(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the network resource group network-rg does not exist")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should fail if the network resource group is located in another region", func() {
+			groupClient.EXPECT().Get(ctx, networkGroup).Return(&armresources.ResourceGroup{Location: ptr.To("northeurope")}, nil)
+
+			err := newFlowContext().EnsureNetworkResourceGroup(ctx)
+			Expect(err).To(MatchError(ContainSubstring("is located in northeurope instead of the region westeurope")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+	})
+
+	Describe("#EnsurePublicIps", func() {
+		const (
+			publicIPName  = namespace + "-nat-ip"
+			otherPublicIP = groupPrefix + namespace + "/providers/Microsoft.Network/publicIPAddresses/other-ip"
+		)
+
+		var (
+			ipClient  *mockazureclient.MockPublicIP
+			natClient *mockazureclient.MockNatGateway
+			publicIP  *armnetwork.PublicIPAddress
+		)
+
+		BeforeEach(func() {
+			ipClient = mockazureclient.NewMockPublicIP(ctrl)
+			natClient = mockazureclient.NewMockNatGateway(ctrl)
+			factory.EXPECT().PublicIP().Return(ipClient, nil).AnyTimes()
+			factory.EXPECT().NatGateway().Return(natClient, nil).AnyTimes()
+
+			publicIP = &armnetwork.PublicIPAddress{
+				ID:   ptr.To(publicIPID),
+				Name: ptr.To(publicIPName),
+				Tags: map[string]*string{
+					infraflow.TagManagedByGardener: ptr.To("true"),
+					infraflow.TagShootName:         ptr.To(namespace),
+				},
+				Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+					NatGateway: &armnetwork.NatGateway{ID: ptr.To(natID)},
+				},
+			}
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{publicIP}, nil)
+			ipClient.EXPECT().Get(ctx, namespace, publicIPName, ptr.To("natGateway")).Return(publicIP, nil)
+		})
+
+		It("should disassociate a surplus public IP from the NAT gateway in the network resource group before deleting it", func() {
+			gomock.InOrder(
+				natClient.EXPECT().Get(ctx, networkGroup, namespace+"-nat-gateway", nil).Return(&armnetwork.NatGateway{
+					ID: ptr.To(natID),
+					Properties: &armnetwork.NatGatewayPropertiesFormat{
+						PublicIPAddresses: []*armnetwork.SubResource{{ID: ptr.To(publicIPID)}, {ID: ptr.To(otherPublicIP)}},
+					},
+				}, nil),
+				natClient.EXPECT().CreateOrUpdate(ctx, networkGroup, namespace+"-nat-gateway", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, nat armnetwork.NatGateway) (*armnetwork.NatGateway, error) {
+						Expect(nat.Properties.PublicIPAddresses).To(ConsistOf(&armnetwork.SubResource{ID: ptr.To(otherPublicIP)}))
+						return &nat, nil
+					}),
+				ipClient.EXPECT().Delete(ctx, namespace, publicIPName).Return(nil),
+			)
+
+			Expect(newFlowContext(publicIPID).EnsurePublicIps(ctx)).To(Succeed())
+		})
+
+		It("should delete a surplus public IP if its NAT gateway no longer exists", func() {
+			gomock.InOrder(
+				natClient.EXPECT().Get(ctx, networkGroup, namespace+"-nat-gateway", nil).Return(nil, nil),
+				ipClient.EXPECT().Delete(ctx, namespace, publicIPName).Return(nil),
+			)
+
+			Expect(newFlowContext(publicIPID).EnsurePublicIps(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#DeleteNetworkResources", func() {
+		It("should only delete the network resources of the inventory in the network resource group", func() {
+			vnetClient := mockazureclient.NewMockVirtualNetwork(ctrl)
+			natClient := mockazureclient.NewMockNatGateway(ctrl)
+			securityGroupClient := mockazureclient.NewMockNetworkSecurityGroup(ctrl)
+			routeTableClient := mockazureclient.NewMockRouteTables(ctrl)
+			factory.EXPECT().Vnet().Return(vnetClient, nil)
+			factory.EXPECT().NatGateway().Return(natClient, nil)
+			factory.EXPECT().NetworkSecurityGroup().Return(securityGroupClient, nil)
+			factory.EXPECT().RouteTables().Return(routeTableClient, nil)
+
+			gomock.InOrder(
+				vnetClient.EXPECT().Delete(ctx, networkGroup, namespace).Return(nil),
+				natClient.EXPECT().Delete(ctx, networkGroup, namespace+"-nat-gateway").Return(nil),
+			)
+			securityGroupClient.EXPECT().Delete(ctx, networkGroup, namespace+"-workers").Return(nil)
+			routeTableClient.EXPECT().Delete(ctx, networkGroup, "worker_route_table").Return(nil)
+
+			fctx := newFlowContext(vnetID, subnetID, natID, securityID, routeTableID, publicIPID, otherNatID)
+			Expect(fctx.DeleteNetworkResources(ctx)).To(Succeed())
+
+			var remaining []string
+			for _, item := range fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems {
+				remaining = append(remaining, item.ID)
+			}
+			Expect(remaining).To(ConsistOf(publicIPID, otherNatID))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// NetworkWatcherResourceGroup is the resource group in which Azure places the network watchers of a subscription.
+const NetworkWatcherResourceGroup = "NetworkWatcherRG"
+
+// NetworkWatcherNotFoundError is returned when there is no network watcher in the region of the shoot and the extension
+// is not allowed to create one.
+type NetworkWatcherNotFoundError struct {
+	// Location is the region without a network watcher.
+	Location string
+}
+
+func (e *NetworkWatcherNotFoundError) Error() string {
+	return fmt.Sprintf("no network watcher exists in region %s: create one or allow its creation with networks.networkWatcher.create or networks.flowLogs.createNetworkWatcher", e.Location)
+}
+
+// FindOrCreateNetworkWatcher returns the ID of the network watcher of the given location and whether it was created. If
+// there is none and create is true, a network watcher is created in the NetworkWatcherResourceGroup.
+func FindOrCreateNetworkWatcher(ctx context.Context, watchers client.NetworkWatcher, groups client.ResourceGroup, location string, create bool) (*arm.ResourceID, bool, error) {
+	log := shared.LogFromContext(ctx)
+
+	all, err := watchers.ListAll(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list network watchers: %w", err)
+	}
+	for _, watcher := range all {
+		if watcher != nil && watcher.ID != nil && strings.EqualFold(ptr.Deref(watcher.Location, ""), location) {
+			id, err := arm.ParseResourceID(*watcher.ID)
+			return id, false, err
+		}
+	}
+
+	if !create {
+		return nil, false, &NetworkWatcherNotFoundError{Location: location}
+	}
+
+	// the resource group is shared with the network watchers of the other regions, hence an existing one is not updated.
+	group, err := groups.Get(ctx, NetworkWatcherResourceGroup)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get resource group %s: %w", NetworkWatcherResourceGroup, err)
+	}
+	if group == nil {
+		log.Info("creating resource group", "name", NetworkWatcherResourceGroup)
+		if _, err := groups.CreateOrUpdate(ctx, NetworkWatcherResourceGroup, armresources.ResourceGroup{Location: ptr.To(location)}); err != nil {
+			return nil, false, networkWatcherCreationError(fmt.Sprintf("resource group %s", NetworkWatcherResourceGroup), "Microsoft.Resources/subscriptions/resourceGroups/write", err)
+		}
+	}
+
+	name := "NetworkWatcher_" + location
+	log.Info("creating network watcher", "name", name)
+	watcher, err := watchers.CreateOrUpdate(ctx, NetworkWatcherResourceGroup, name, armnetwork.Watcher{Location: ptr.To(location)})
+	if err != nil {
+		return nil, false, networkWatcherCreationError(fmt.Sprintf("network watcher %s", name), "Microsoft.Network/networkWatchers/write", err)
+	}
+	id, err := arm.ParseResourceID(*watcher.ID)
+	return id, true, err
+}
+
+// networkWatcherCreationError names the permission the principal of the shoot lacks if the creation of the given
+// resource was forbidden.
+func networkWatcherCreationError(resource, permission string, err error) error {
+	if client.IsAzureAPIForbidden(err) {
+		return fmt.Errorf("not permitted to create %s, the credentials of the shoot require the %s permission: %w", resource, permission, err)
+	}
+	return fmt.Errorf("failed to create %s: %w", resource, err)
+}
+
+// EnsureNetworkWatcher ensures that a network watcher exists in the region of the shoot if it is configured. Only a
+// network watcher created by the extension is added to the inventory, an existing one is shared with other resources of
+// the subscription. Network watchers are never deleted, since there is only one per region which the other resources of
+// the subscription start to use as soon as it exists, hence network watchers which are no longer configured are only
+// removed from the inventory.
+func (fctx *FlowContext) EnsureNetworkWatcher(ctx context.Context) error {
+	config := fctx.cfg.Networks.NetworkWatcher
+	if config == nil {
+		fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KindNetworkWatcher.String())
+		fctx.forgetNetworkWatchers("")
+		return nil
+	}
+
+	watchers, err := fctx.factory.NetworkWatcher()
+	if err != nil {
+		return err
+	}
+	groups, err := fctx.factory.Group()
+	if err != nil {
+		return err
+	}
+
+	id, created, err := FindOrCreateNetworkWatcher(ctx, watchers, groups, fctx.adapter.Region(), ptr.Deref(config.Create, false))
+	if err != nil {
+		return err
+	}
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(KindNetworkWatcher.String(), id.String())
+
+	if created {
+		shared.LogFromContext(ctx).V(1).Info("Adding to inventory", "id", id.String())
+		if err := fctx.inventory.Insert(id.String()); err != nil {
+			return err
+		}
+	}
+
+	fctx.forgetNetworkWatchers(id.String())
+	return nil
+}
+
+// forgetNetworkWatchers removes all network watchers except the one with the given ID from the inventory without
+// deleting them.
+func (fctx *FlowContext) forgetNetworkWatchers(keep string) {
+	for _, id := range fctx.inventory.ByKind(KindNetworkWatcher) {
+		if !strings.EqualFold(id.String(), keep) {
+			fctx.inventory.Delete(id.String())
+		}
+	}
+}
+
+// networkWatcherStatus returns the status of the network watcher of the region of the shoot if it is configured.
+func (fctx *FlowContext) networkWatcherStatus() *v1alpha1.NetworkWatcherStatus {
+	id := fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindNetworkWatcher.String())
+	if fctx.cfg.Networks.NetworkWatcher == nil || id == nil {
+		return nil
+	}
+	return &v1alpha1.NetworkWatcherStatus{
+		ID:      *id,
+		Managed: fctx.inventory.Get(*id) != nil,
+	}
+}
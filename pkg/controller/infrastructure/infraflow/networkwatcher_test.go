@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("NetworkWatcher", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		watcherID      = "/subscriptions/" + subscriptionID + "/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_westeurope"
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		ctx           context.Context
+		factory       *mockazureclient.MockFactory
+		watcherClient *mockazureclient.MockNetworkWatcher
+		groupClient   *mockazureclient.MockResourceGroup
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		watcherClient = mockazureclient.NewMockNetworkWatcher(ctrl)
+		groupClient = mockazureclient.NewMockResourceGroup(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#FindOrCreateNetworkWatcher", func() {
+		It("should return the network watcher of the region", func() {
+			watcherClient.EXPECT().ListAll(ctx).Return([]*armnetwork.Watcher{
+				{ID: ptr.To("/subscriptions/" + subscriptionID + "/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_eastus"), Location: ptr.To("eastus")},
+				{ID: ptr.To(watcherID), Location: ptr.To("WestEurope")},
+			}, nil)
+
+			id, created, err := infraflow.FindOrCreateNetworkWatcher(ctx, watcherClient, groupClient, region, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.String()).To(Equal(watcherID))
+			Expect(created).To(BeFalse())
+		})
+
+		It("should fail if there is no network watcher and its creation is not permitted", func() {
+			watcherClient.EXPECT().ListAll(ctx).Return(nil, nil)
+
+			_, _, err := infraflow.FindOrCreateNetworkWatcher(ctx, watcherClient, groupClient, region, false)
+			Expect(err).To(Equal(&infraflow.NetworkWatcherNotFoundError{Location: region}))
+		})
+
+		It("should create a network watcher if there is none and its creation is permitted", func() {
+			watcherClient.EXPECT().ListAll(ctx).Return(nil, nil)
+			groupClient.EXPECT().Get(ctx, infraflow.NetworkWatcherResourceGroup).Return(nil, nil)
+			groupClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, armresources.ResourceGroup{Location: ptr.To(region)}).Return(&armresources.ResourceGroup{}, nil)
+			watcherClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, "NetworkWatcher_westeurope", armnetwork.Watcher{Location: ptr.To(region)}).
+				Return(&armnetwork.Watcher{ID: ptr.To(watcherID)}, nil)
+
+			id, created, err := infraflow.FindOrCreateNetworkWatcher(ctx, watcherClient, groupClient, region, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.String()).To(Equal(watcherID))
+			Expect(created).To(BeTrue())
+		})
+
+		It("should not update an existing resource group of the network watchers", func() {
+			watcherClient.EXPECT().ListAll(ctx).Return(nil, nil)
+			groupClient.EXPECT().Get(ctx, infraflow.NetworkWatcherResourceGroup).Return(&armresources.ResourceGroup{Location: ptr.To("eastus")}, nil)
+			watcherClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, "NetworkWatcher_westeurope", armnetwork.Watcher{Location: ptr.To(region)}).
+				Return(&armnetwork.Watcher{ID: ptr.To(watcherID)}, nil)
+
+			id, created, err := infraflow.FindOrCreateNetworkWatcher(ctx, watcherClient, groupClient, region, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.String()).To(Equal(watcherID))
+			Expect(created).To(BeTrue())
+		})
+
+		It("should name the missing permission if the creation is forbidden", func() {
+			watcherClient.EXPECT().ListAll(ctx).Return(nil, nil)
+			groupClient.EXPECT().Get(ctx, infraflow.NetworkWatcherResourceGroup).Return(nil, nil)
+			groupClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, gomock.Any()).Return(&armresources.ResourceGroup{}, nil)
+			watcherClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, "NetworkWatcher_westeurope", gomock.Any()).
+				Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden, ErrorCode: "AuthorizationFailed"})
+
+			_, _, err := infraflow.FindOrCreateNetworkWatcher(ctx, watcherClient, groupClient, region, true)
+			Expect(err).To(MatchError(ContainSubstring("require the Microsoft.Network/networkWatchers/write permission")))
+			Expect(client.IsAzureAPIForbidden(err)).To(BeTrue())
+		})
+	})
+
+	Describe("#EnsureNetworkWatcher", func() {
+		newFlowContext := func(config *azure.NetworkWatcherConfig, managedItems ...string) *infraflow.FlowContext {
+			infraConfig := &v1alpha1.InfrastructureConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+				Networks: v1alpha1.NetworkConfig{
+					VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+					Workers: ptr.To("10.250.0.0/16"),
+				},
+			}
+			if config != nil {
+				infraConfig.Networks.NetworkWatcher = &v1alpha1.NetworkWatcherConfig{Create: config.Create}
+			}
+			raw, err := json.Marshal(infraConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			state := &azure.InfrastructureState{}
+			for _, id := range managedItems {
+				state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+			}
+
+			fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+				Factory: factory,
+				Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+				Infra: &extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+					Spec: extensionsv1alpha1.InfrastructureSpec{
+						Region:      region,
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+					},
+				},
+				Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+				State:   state,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return fctx
+		}
+
+		managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+			return fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems
+		}
+
+		It("should reuse an existing network watcher without adding it to the inventory", func() {
+			factory.EXPECT().NetworkWatcher().Return(watcherClient, nil)
+			factory.EXPECT().Group().Return(groupClient, nil)
+			watcherClient.EXPECT().ListAll(ctx).Return([]*armnetwork.Watcher{{ID: ptr.To(watcherID), Location: ptr.To(region)}}, nil)
+
+			fctx := newFlowContext(&azure.NetworkWatcherConfig{Create: ptr.To(true)})
+			Expect(fctx.EnsureNetworkWatcher(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+
+		It("should create a missing network watcher and add it to the inventory", func() {
+			factory.EXPECT().NetworkWatcher().Return(watcherClient, nil)
+			factory.EXPECT().Group().Return(groupClient, nil)
+			watcherClient.EXPECT().ListAll(ctx).Return(nil, nil)
+			groupClient.EXPECT().Get(ctx, infraflow.NetworkWatcherResourceGroup).Return(nil, nil)
+			groupClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, gomock.Any()).Return(&armresources.ResourceGroup{}, nil)
+			watcherClient.EXPECT().CreateOrUpdate(ctx, infraflow.NetworkWatcherResourceGroup, "NetworkWatcher_westeurope", gomock.Any()).
+				Return(&armnetwork.Watcher{ID: ptr.To(watcherID)}, nil)
+
+			fctx := newFlowContext(&azure.NetworkWatcherConfig{Create: ptr.To(true)})
+			Expect(fctx.EnsureNetworkWatcher(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(v1alpha1.AzureResource{Kind: infraflow.KindNetworkWatcher.String(), ID: watcherID}))
+		})
+
+		It("should keep a network watcher of the inventory which is still configured", func() {
+			factory.EXPECT().NetworkWatcher().Return(watcherClient, nil)
+			factory.EXPECT().Group().Return(groupClient, nil)
+			watcherClient.EXPECT().ListAll(ctx).Return([]*armnetwork.Watcher{{ID: ptr.To(watcherID), Location: ptr.To(region)}}, nil)
+
+			fctx := newFlowContext(&azure.NetworkWatcherConfig{}, watcherID)
+			Expect(fctx.EnsureNetworkWatcher(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(HaveLen(1))
+		})
+
+		It("should only remove a network watcher from the inventory which is no longer configured", func() {
+			fctx := newFlowContext(nil, watcherID)
+			Expect(fctx.EnsureNetworkWatcher(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+	})
+})
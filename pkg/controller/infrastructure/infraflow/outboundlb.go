@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// ToProvider translates the config into the desired load balancer. The load balancer has a frontend for each public IP,
+// a single backend pool to which the worker pools add the network interfaces of their machines, and an outbound rule
+// which translates the traffic of the backend pool to the public IPs.
+func (lb *OutboundLoadBalancerConfig) ToProvider(subscriptionID string) *armnetwork.LoadBalancer {
+	id := GetIdFromTemplate(TemplateLoadBalancer, subscriptionID, lb.ResourceGroup, lb.Name)
+
+	target := &armnetwork.LoadBalancer{
+		Location: to.Ptr(lb.Location),
+		SKU: &armnetwork.LoadBalancerSKU{
+			Name: to.Ptr(armnetwork.LoadBalancerSKUNameStandard),
+			Tier: to.Ptr(armnetwork.LoadBalancerSKUTierRegional),
+		},
+		Tags: map[string]*string{
+			TagManagedByGardener: to.Ptr("true"),
+			TagShootName:         to.Ptr(lb.ShootName),
+		},
+		Properties: &armnetwork.LoadBalancerPropertiesFormat{
+			BackendAddressPools: []*armnetwork.BackendAddressPool{{Name: to.Ptr(lb.Name)}},
+		},
+	}
+
+	rule := &armnetwork.OutboundRule{
+		Name: to.Ptr(lb.Name),
+		Properties: &armnetwork.OutboundRulePropertiesFormat{
+			BackendAddressPool:     &armnetwork.SubResource{ID: to.Ptr(id + "/backendAddressPools/" + lb.Name)},
+			Protocol:               to.Ptr(armnetwork.LoadBalancerOutboundRuleProtocolAll),
+			AllocatedOutboundPorts: lb.AllocatedOutboundPorts,
+			IdleTimeoutInMinutes:   to.Ptr(lb.IdleTimeout),
+			EnableTCPReset:         to.Ptr(true),
+		},
+	}
+	for _, ip := range lb.PublicIPList {
+		target.Properties.FrontendIPConfigurations = append(target.Properties.FrontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+			Name: to.Ptr(ip.Name),
+			Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &armnetwork.PublicIPAddress{ID: to.Ptr(GetIdFromTemplate(TemplatePublicIP, subscriptionID, ip.ResourceGroup, ip.Name))},
+			},
+		})
+		rule.Properties.FrontendIPConfigurations = append(rule.Properties.FrontendIPConfigurations, &armnetwork.SubResource{
+			ID: to.Ptr(id + "/frontendIPConfigurations/" + ip.Name),
+		})
+	}
+	target.Properties.OutboundRules = []*armnetwork.OutboundRule{rule}
+	return target
+}
+
+// outboundLoadBalancerUpToDate returns true if the current load balancer has the frontends, the backend pool and the
+// outbound rule of the desired load balancer.
+func outboundLoadBalancerUpToDate(current, desired *armnetwork.LoadBalancer) bool {
+	if current == nil || current.Properties == nil {
+		return false
+	}
+
+	frontendIPs := func(lb *armnetwork.LoadBalancer) []string {
+		var ids []string
+		for _, frontend := range lb.Properties.FrontendIPConfigurations {
+			if frontend.Properties != nil && frontend.Properties.PublicIPAddress != nil {
+				ids = append(ids, strings.ToLower(ptr.Deref(frontend.Properties.PublicIPAddress.ID, "")))
+			}
+		}
+		slices.Sort(ids)
+		return ids
+	}
+	if !slices.Equal(frontendIPs(current), frontendIPs(desired)) {
+		return false
+	}
+
+	if !slices.ContainsFunc(current.Properties.BackendAddressPools, func(pool *armnetwork.BackendAddressPool) bool {
+		return ptr.Deref(pool.Name, "") == *desired.Properties.BackendAddressPools[0].Name
+	}) {
+		return false
+	}
+
+	if len(current.Properties.OutboundRules) != 1 || current.Properties.OutboundRules[0].Properties == nil {
+		return false
+	}
+	currentRule, desiredRule := current.Properties.OutboundRules[0].Properties, desired.Properties.OutboundRules[0].Properties
+	return len(currentRule.FrontendIPConfigurations) == len(desiredRule.FrontendIPConfigurations) &&
+		ptr.Deref(currentRule.Protocol, "") == *desiredRule.Protocol &&
+		// Azure allocates the ports automatically if the number is 0.
+		ptr.Deref(currentRule.AllocatedOutboundPorts, 0) == ptr.Deref(desiredRule.AllocatedOutboundPorts, 0) &&
+		ptr.Deref(currentRule.IdleTimeoutInMinutes, 0) == *desiredRule.IdleTimeoutInMinutes &&
+		ptr.Deref(currentRule.EnableTCPReset, false) == *desiredRule.EnableTCPReset
+}
+
+// EnsureOutboundLoadBalancer reconciles the outbound load balancer whose backend pool is shared by the machines of all
+// worker pools. Its public IPs are reconciled together with the other public IPs of the shoot.
+func (fctx *FlowContext) EnsureOutboundLoadBalancer(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	config := fctx.adapter.OutboundLoadBalancer()
+	if config == nil {
+		return nil
+	}
+
+	c, err := fctx.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+	ipClient, err := fctx.factory.PublicIP()
+	if err != nil {
+		return err
+	}
+
+	desired := config.ToProvider(fctx.auth.SubscriptionID)
+	current, err := c.Get(ctx, config.ResourceGroup, config.Name)
+	if err != nil {
+		return err
+	}
+	if !outboundLoadBalancerUpToDate(current, desired) {
+		log.Info("reconciling outbound load balancer", "name", config.Name)
+		if _, err := c.CreateOrUpdate(ctx, config.ResourceGroup, config.Name, *desired); err != nil {
+			return err
+		}
+	}
+
+	id := GetIdFromTemplate(TemplateLoadBalancer, fctx.auth.SubscriptionID, config.ResourceGroup, config.Name)
+	log.V(1).Info("Adding to inventory", "id", id)
+	if err := fctx.inventory.Insert(id); err != nil {
+		return err
+	}
+
+	ipAddresses := []string{}
+	for _, ipCfg := range config.PublicIPList {
+		ip, err := ipClient.Get(ctx, ipCfg.ResourceGroup, ipCfg.Name, nil)
+		if err != nil {
+			return err
+		}
+		if ip != nil && ip.Properties != nil && ip.Properties.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *ip.Properties.IPAddress)
+		}
+	}
+	fctx.whiteboard.GetChild(KindLoadBalancer.String()).SetObject(KeyPublicIPAddresses, ipAddresses)
+	return nil
+}
+
+// DeleteOutboundLoadBalancer deletes the outbound load balancer if it is in the inventory. The network interfaces are
+// removed from its backend pool first, because Azure refuses to delete a load balancer whose backend pool is in use.
+func (fctx *FlowContext) DeleteOutboundLoadBalancer(ctx context.Context) error {
+	ids := fctx.inventory.ByKind(KindLoadBalancer)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+	nics, err := fctx.factory.NetworkInterface()
+	if err != nil {
+		return err
+	}
+
+	var joinErr error
+	for _, id := range ids {
+		if err := fctx.retryWhileInUse(ctx, func() error {
+			return fctx.deleteOutboundLoadBalancer(ctx, c, nics, id)
+		}); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	return joinErr
+}
+
+func (fctx *FlowContext) deleteOutboundLoadBalancer(ctx context.Context, c client.LoadBalancer, nics client.NetworkInterface, id arm.ResourceID) error {
+	log := shared.LogFromContext(ctx)
+
+	lb, err := c.Get(ctx, id.ResourceGroupName, id.Name)
+	if err != nil || lb == nil {
+		return err
+	}
+	if lb.Properties != nil {
+		for _, pool := range lb.Properties.BackendAddressPools {
+			if err := detachNetworkInterfaces(ctx, nics, pool); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Info("deleting outbound load balancer", "id", id.String())
+	return c.Delete(ctx, id.ResourceGroupName, id.Name)
+}
+
+// detachNetworkInterfaces removes the IP configurations of network interfaces from the given backend pool.
+func detachNetworkInterfaces(ctx context.Context, nics client.NetworkInterface, pool *armnetwork.BackendAddressPool) error {
+	if pool.Properties == nil {
+		return nil
+	}
+	log := shared.LogFromContext(ctx)
+
+	var joinErr error
+	for _, ipConfig := range pool.Properties.BackendIPConfigurations {
+		ipConfigID, err := arm.ParseResourceID(ptr.Deref(ipConfig.ID, ""))
+		if err != nil || ipConfigID.Parent == nil || !strings.EqualFold(ipConfigID.Parent.ResourceType.Type, "networkInterfaces") {
+			continue
+		}
+		nic, err := nics.Get(ctx, ipConfigID.Parent.ResourceGroupName, ipConfigID.Parent.Name)
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		if nic == nil || nic.Properties == nil {
+			continue
+		}
+
+		var detached bool
+		for _, nicIPConfig := range nic.Properties.IPConfigurations {
+			if nicIPConfig.Properties == nil {
+				continue
+			}
+			pools := slices.DeleteFunc(nicIPConfig.Properties.LoadBalancerBackendAddressPools, func(p *armnetwork.BackendAddressPool) bool {
+				return strings.EqualFold(ptr.Deref(p.ID, ""), ptr.Deref(pool.ID, ""))
+			})
+			detached = detached || len(pools) != len(nicIPConfig.Properties.LoadBalancerBackendAddressPools)
+			nicIPConfig.Properties.LoadBalancerBackendAddressPools = pools
+		}
+		if !detached {
+			continue
+		}
+		log.Info("removing network interface from backend pool", "nic", ipConfigID.Parent.Name, "pool", ptr.Deref(pool.Name, ""))
+		if _, err := nics.CreateOrUpdate(ctx, ipConfigID.Parent.ResourceGroupName, ipConfigID.Parent.Name, *nic); err != nil {
+			joinErr = errors.Join(joinErr, err)
+		}
+	}
+	return joinErr
+}
+
+// outboundLoadBalancerStatus returns the status of the configured outbound load balancer.
+func (fctx *FlowContext) outboundLoadBalancerStatus() *v1alpha1.OutboundLoadBalancerStatus {
+	config := fctx.adapter.OutboundLoadBalancer()
+	if config == nil {
+		return nil
+	}
+	id := GetIdFromTemplate(TemplateLoadBalancer, fctx.auth.SubscriptionID, config.ResourceGroup, config.Name)
+	return &v1alpha1.OutboundLoadBalancerStatus{
+		ID:                   id,
+		BackendAddressPoolID: id + "/backendAddressPools/" + config.Name,
+	}
+}
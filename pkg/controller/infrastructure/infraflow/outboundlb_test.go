@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("OutboundLoadBalancer", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		lbName         = namespace + "-outbound-lb"
+		lbID           = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/loadBalancers/" + lbName
+		poolID         = lbID + "/backendAddressPools/" + lbName
+		ipID           = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/publicIPAddresses/" + lbName + "-ip"
+		nicName        = namespace + "-machine-nic"
+		nicIPConfigID  = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/networkInterfaces/" + nicName + "/ipConfigurations/" + nicName
+	)
+
+	var (
+		ctrl     *gomock.Controller
+		ctx      context.Context
+		factory  *mockazureclient.MockFactory
+		lbClient *mockazureclient.MockLoadBalancer
+		ipClient *mockazureclient.MockPublicIP
+		config   *azure.OutboundLoadBalancerConfig
+	)
+
+	newFlowContext := func(config *azure.OutboundLoadBalancerConfig, managedItems ...string) *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		}
+		if config != nil {
+			infraConfig.Networks.OutboundLoadBalancer = &v1alpha1.OutboundLoadBalancerConfig{
+				PublicIPCount:          config.PublicIPCount,
+				AllocatedOutboundPorts: config.AllocatedOutboundPorts,
+				IdleTimeoutMinutes:     config.IdleTimeoutMinutes,
+			}
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		state := &azure.InfrastructureState{}
+		for _, id := range managedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster:      &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:        state,
+			InUseBackoff: &wait.Backoff{Duration: time.Millisecond, Steps: 3},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		lbClient = mockazureclient.NewMockLoadBalancer(ctrl)
+		ipClient = mockazureclient.NewMockPublicIP(ctrl)
+		config = &azure.OutboundLoadBalancerConfig{AllocatedOutboundPorts: ptr.To[int32](1024)}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureOutboundLoadBalancer", func() {
+		BeforeEach(func() {
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+		})
+
+		It("should create the load balancer with an outbound rule for the backend pool", func() {
+			lbClient.EXPECT().Get(ctx, namespace, lbName).Return(nil, nil)
+			lbClient.EXPECT().CreateOrUpdate(ctx, namespace, lbName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+					Expect(*lb.SKU.Name).To(Equal(armnetwork.LoadBalancerSKUNameStandard))
+					Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(1))
+					Expect(*lb.Properties.FrontendIPConfigurations[0].Properties.PublicIPAddress.ID).To(Equal(ipID))
+					Expect(lb.Properties.BackendAddressPools).To(HaveLen(1))
+					Expect(*lb.Properties.BackendAddressPools[0].Name).To(Equal(lbName))
+					Expect(lb.Properties.OutboundRules).To(HaveLen(1))
+					rule := lb.Properties.OutboundRules[0].Properties
+					Expect(*rule.BackendAddressPool.ID).To(Equal(poolID))
+					Expect(*rule.Protocol).To(Equal(armnetwork.LoadBalancerOutboundRuleProtocolAll))
+					Expect(*rule.AllocatedOutboundPorts).To(Equal(int32(1024)))
+					Expect(*rule.IdleTimeoutInMinutes).To(Equal(int32(4)))
+					Expect(*rule.EnableTCPReset).To(BeTrue())
+					lb.ID = ptr.To(lbID)
+					return &lb, nil
+				})
+			ipClient.EXPECT().Get(ctx, namespace, lbName+"-ip", nil).Return(&armnetwork.PublicIPAddress{
+				Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.0.0.1")},
+			}, nil)
+
+			fctx := newFlowContext(config)
+			Expect(fctx.EnsureOutboundLoadBalancer(ctx)).To(Succeed())
+			Expect(fctx.GetEgressIpCidrs()).To(ConsistOf("20.0.0.1/32"))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.OutboundLoadBalancer).To(Equal(&v1alpha1.OutboundLoadBalancerStatus{ID: lbID, BackendAddressPoolID: poolID}))
+			Expect(status.Networks.OutboundAccessType).To(Equal(v1alpha1.OutboundAccessTypeLoadBalancer))
+		})
+
+		It("should not update a load balancer which is up to date", func() {
+			var created armnetwork.LoadBalancer
+			lbClient.EXPECT().Get(ctx, namespace, lbName).Return(nil, nil)
+			lbClient.EXPECT().CreateOrUpdate(ctx, namespace, lbName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, lb armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+					created = lb
+					return &lb, nil
+				})
+			ipClient.EXPECT().Get(ctx, namespace, lbName+"-ip", nil).Return(&armnetwork.PublicIPAddress{}, nil).Times(2)
+
+			fctx := newFlowContext(config)
+			Expect(fctx.EnsureOutboundLoadBalancer(ctx)).To(Succeed())
+
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().PublicIP().Return(ipClient, nil)
+			lbClient.EXPECT().Get(ctx, namespace, lbName).Return(&created, nil)
+			Expect(fctx.EnsureOutboundLoadBalancer(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#DeleteOutboundLoadBalancer", func() {
+		It("should do nothing if the load balancer is not in the inventory", func() {
+			Expect(newFlowContext(nil).DeleteOutboundLoadBalancer(ctx)).To(Succeed())
+		})
+
+		It("should remove the network interfaces from the backend pool before deleting the load balancer", func() {
+			nicClient := mockazureclient.NewMockNetworkInterface(ctrl)
+			factory.EXPECT().LoadBalancer().Return(lbClient, nil)
+			factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+
+			otherPool := &armnetwork.BackendAddressPool{ID: ptr.To("other-pool")}
+			nic := &armnetwork.Interface{
+				Properties: &armnetwork.InterfacePropertiesFormat{
+					IPConfigurations: []*armnetwork.InterfaceIPConfiguration{{
+						Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+							LoadBalancerBackendAddressPools: []*armnetwork.BackendAddressPool{{ID: ptr.To(poolID)}, otherPool},
+						},
+					}},
+				},
+			}
+
+			gomock.InOrder(
+				lbClient.EXPECT().Get(ctx, namespace, lbName).Return(&armnetwork.LoadBalancer{
+					Properties: &armnetwork.LoadBalancerPropertiesFormat{
+						BackendAddressPools: []*armnetwork.BackendAddressPool{{
+							ID:   ptr.To(poolID),
+							Name: ptr.To(lbName),
+							Properties: &armnetwork.BackendAddressPoolPropertiesFormat{
+								BackendIPConfigurations: []*armnetwork.InterfaceIPConfiguration{{ID: ptr.To(nicIPConfigID)}},
+							},
+						}},
+					},
+				}, nil),
+				nicClient.EXPECT().Get(ctx, namespace, nicName).Return(nic, nil),
+				nicClient.EXPECT().CreateOrUpdate(ctx, namespace, nicName, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, nic armnetwork.Interface) (*armnetwork.Interface, error) {
+						Expect(nic.Properties.IPConfigurations[0].Properties.LoadBalancerBackendAddressPools).To(ConsistOf(otherPool))
+						return &nic, nil
+					}),
+				lbClient.EXPECT().Delete(ctx, namespace, lbName).Return(nil),
+			)
+
+			fctx := newFlowContext(nil, lbID)
+			Expect(fctx.DeleteOutboundLoadBalancer(ctx)).To(Succeed())
+			Expect(fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
+)
+
+const (
+	// ResourceProviderRegistered is the registration state of a resource provider the subscription is registered with.
+	ResourceProviderRegistered = "Registered"
+)
+
+// RequiredResourceProviders are the namespaces of the Azure resource providers that the subscription must be
+// registered with so that the shoot infrastructure can be created.
+var RequiredResourceProviders = []string{
+	"Microsoft.Compute",
+	"Microsoft.Network",
+	"Microsoft.Storage",
+}
+
+// UnregisteredResourceProvidersError is returned when the subscription is not registered with some of the required
+// resource providers and the extension is not allowed to register them.
+type UnregisteredResourceProvidersError struct {
+	// Namespaces are the namespaces of the unregistered resource providers.
+	Namespaces []string
+}
+
+func (e *UnregisteredResourceProvidersError) Error() string {
+	return fmt.Sprintf("MissingSubscriptionRegistration: the subscription is not registered with the following resource providers: %s. "+
+		"Please register them or enable the %s feature gate of the extension", strings.Join(e.Namespaces, ", "), features.EnableResourceProviderRegistration)
+}
+
+// EnsureResourceProviders checks that the subscription is registered with all required resource providers. Missing
+// registrations are triggered if the EnableResourceProviderRegistration feature gate is enabled.
+func (fctx *FlowContext) EnsureResourceProviders(ctx context.Context) error {
+	c, err := fctx.factory.Providers()
+	if err != nil {
+		return err
+	}
+
+	return CheckResourceProviders(ctx, c, RequiredResourceProviders, features.ExtensionFeatureGate.Enabled(features.EnableResourceProviderRegistration))
+}
+
+// CheckResourceProviders checks that the subscription is registered with the resource providers of the given
+// namespaces. If register is true, unregistered resource providers are registered, otherwise an
+// UnregisteredResourceProvidersError listing them is returned. Without registration, credentials which may not read the
+// resource providers only skip the check, so that existing shoots whose credentials lack the permission keep working.
+func CheckResourceProviders(ctx context.Context, c client.Providers, namespaces []string, register bool) error {
+	log := shared.LogFromContext(ctx)
+
+	var unregistered []string
+	for _, namespace := range namespaces {
+		provider, err := c.Get(ctx, namespace)
+		if err != nil {
+			if !register && client.IsAzureAPIForbidden(err) {
+				log.Info("Cannot check the registration of the subscription with resource provider, skipping the check", "namespace", namespace, "error", err.Error())
+				continue
+			}
+			return err
+		}
+		if provider != nil && ptr.Deref(provider.RegistrationState, "") == ResourceProviderRegistered {
+			continue
+		}
+		unregistered = append(unregistered, namespace)
+	}
+
+	if len(unregistered) == 0 {
+		return nil
+	}
+
+	if !register {
+		return &UnregisteredResourceProvidersError{Namespaces: unregistered}
+	}
+
+	var pending []string
+	for _, namespace := range unregistered {
+		log.Info("registering subscription with resource provider", "namespace", namespace)
+		provider, err := c.Register(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("failed to register resource provider %s: %w", namespace, err)
+		}
+		if ptr.Deref(provider.RegistrationState, "") != ResourceProviderRegistered {
+			pending = append(pending, namespace)
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("registration of the following resource providers is still in progress: %s", strings.Join(pending, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		providersClient *mockazureclient.MockProviders
+
+		namespaces = []string{"Microsoft.Compute", "Microsoft.Network"}
+	)
+
+	provider := func(state string) *armresources.Provider {
+		return &armresources.Provider{RegistrationState: ptr.To(state)}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		providersClient = mockazureclient.NewMockProviders(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CheckResourceProviders", func() {
+		It("should succeed if all resource providers are registered", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(provider("Registered"), nil)
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(provider("Registered"), nil)
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, false)).To(Succeed())
+		})
+
+		It("should list the unregistered resource providers if registration is disabled", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(provider("NotRegistered"), nil)
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(nil, nil)
+
+			err := infraflow.CheckResourceProviders(ctx, providersClient, namespaces, false)
+			Expect(err).To(Equal(&infraflow.UnregisteredResourceProvidersError{Namespaces: namespaces}))
+			Expect(err.Error()).To(ContainSubstring("Microsoft.Compute, Microsoft.Network"))
+		})
+
+		It("should skip the resource providers which cannot be read if registration is disabled", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden})
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(provider("Registered"), nil)
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, false)).To(Succeed())
+		})
+
+		It("should fail if the resource providers cannot be read and registration is enabled", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden})
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, true)).To(HaveOccurred())
+		})
+
+		It("should register the unregistered resource providers if registration is enabled", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(provider("Registered"), nil)
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(provider("NotRegistered"), nil)
+			providersClient.EXPECT().Register(ctx, "Microsoft.Network").Return(provider("Registered"), nil)
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, true)).To(Succeed())
+		})
+
+		It("should fail if the registration is still in progress", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(provider("NotRegistered"), nil)
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(provider("Registered"), nil)
+			providersClient.EXPECT().Register(ctx, "Microsoft.Compute").Return(provider("Registering"), nil)
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, true)).To(MatchError(ContainSubstring("still in progress: Microsoft.Compute")))
+		})
+
+		It("should return the error if the registration fails", func() {
+			providersClient.EXPECT().Get(ctx, "Microsoft.Compute").Return(provider("Unregistered"), nil)
+			providersClient.EXPECT().Get(ctx, "Microsoft.Network").Return(provider("Registered"), nil)
+			providersClient.EXPECT().Register(ctx, "Microsoft.Compute").Return(nil, fmt.Errorf("AuthorizationFailed"))
+
+			Expect(infraflow.CheckResourceProviders(ctx, providersClient, namespaces, true)).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+})
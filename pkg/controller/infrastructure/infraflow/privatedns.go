@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// privateDNSLocation is the location of private DNS zones and their links, which are global resources.
+const privateDNSLocation = "global"
+
+// VirtualNetworkLinkSpec returns the desired link between a private DNS zone and the given virtual network.
+func VirtualNetworkLinkSpec(vnetID string, config *azure.PrivateDNSZoneConfig) client.VirtualNetworkLink {
+	return client.VirtualNetworkLink{
+		Location: ptr.To(privateDNSLocation),
+		Properties: &client.VirtualNetworkLinkProperties{
+			VirtualNetwork:      &client.VirtualNetworkReference{ID: ptr.To(vnetID)},
+			RegistrationEnabled: ptr.To(config.RegistrationEnabled),
+		},
+	}
+}
+
+// virtualNetworkLinkUpToDate returns true if the existing link references the same virtual network and has the same
+// registration setting as the desired link.
+func virtualNetworkLinkUpToDate(current *client.VirtualNetworkLink, desired client.VirtualNetworkLink) bool {
+	if current == nil || current.Properties == nil || current.Properties.VirtualNetwork == nil {
+		return false
+	}
+	return strings.EqualFold(ptr.Deref(current.Properties.VirtualNetwork.ID, ""), *desired.Properties.VirtualNetwork.ID) &&
+		ptr.Deref(current.Properties.RegistrationEnabled, false) == *desired.Properties.RegistrationEnabled
+}
+
+// EnsurePrivateDNSZone reconciles the private DNS zone and its link to the virtual network of the shoot. Zones which are
+// no longer configured are deleted together with their links.
+func (fctx *FlowContext) EnsurePrivateDNSZone(ctx context.Context) error {
+	zones, err := fctx.factory.PrivateDNSZones()
+	if err != nil {
+		return err
+	}
+	links, err := fctx.factory.VirtualNetworkLinks()
+	if err != nil {
+		return err
+	}
+
+	var current string
+	if config := fctx.cfg.Networks.PrivateDNSZone; config != nil {
+		if err := fctx.ensurePrivateDNSZone(ctx, zones, config); err != nil {
+			return err
+		}
+		if err := fctx.ensureVirtualNetworkLink(ctx, links, config); err != nil {
+			return err
+		}
+		current = fctx.privateDNSZoneID(config.Name)
+	}
+
+	return fctx.deletePrivateDNSZones(ctx, zones, links, current)
+}
+
+func (fctx *FlowContext) ensurePrivateDNSZone(ctx context.Context, zones client.PrivateDNSZones, config *azure.PrivateDNSZoneConfig) error {
+	log := shared.LogFromContext(ctx)
+	rgName := fctx.adapter.ResourceGroupName()
+
+	zone, err := zones.Get(ctx, rgName, config.Name)
+	if err != nil {
+		return err
+	}
+	if zone == nil {
+		log.Info("creating private DNS zone", "name", config.Name)
+		if _, err := zones.CreateOrUpdate(ctx, rgName, config.Name, client.PrivateDNSZone{Location: ptr.To(privateDNSLocation)}); err != nil {
+			return err
+		}
+	}
+
+	id := fctx.privateDNSZoneID(config.Name)
+	log.V(1).Info("Adding to inventory", "id", id)
+	return fctx.inventory.Insert(id)
+}
+
+func (fctx *FlowContext) ensureVirtualNetworkLink(ctx context.Context, links client.VirtualNetworkLinks, config *azure.PrivateDNSZoneConfig) error {
+	log := shared.LogFromContext(ctx)
+	rgName := fctx.adapter.ResourceGroupName()
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+	// The link name is stable, hence a reconciliation never adds a second link of the virtual network to the zone.
+	name := fctx.adapter.TechnicalName()
+
+	desired := VirtualNetworkLinkSpec(GetIdFromTemplate(TemplateVirtualNetwork, fctx.auth.SubscriptionID, vnetCfg.ResourceGroup, vnetCfg.Name), config)
+	current, err := links.Get(ctx, rgName, config.Name, name)
+	if err != nil {
+		return err
+	}
+	if !virtualNetworkLinkUpToDate(current, desired) {
+		log.Info("reconciling virtual network link of private DNS zone", "zone", config.Name, "name", name)
+		if _, err := links.CreateOrUpdate(ctx, rgName, config.Name, name, desired); err != nil {
+			return err
+		}
+	}
+
+	id := GetIdFromTemplateWithParent(TemplateVirtualNetworkLink, fctx.auth.SubscriptionID, rgName, config.Name, name)
+	log.V(1).Info("Adding to inventory", "id", id)
+	return fctx.inventory.Insert(id)
+}
+
+func (fctx *FlowContext) privateDNSZoneID(name string) string {
+	return GetIdFromTemplate(TemplatePrivateDNSZone, fctx.auth.SubscriptionID, fctx.adapter.ResourceGroupName(), name)
+}
+
+// DeletePrivateDNSZone deletes the private DNS zone of the shoot and its virtual network links. A zone cannot be deleted
+// while it is linked to a virtual network, hence the links are deleted before the resource group.
+func (fctx *FlowContext) DeletePrivateDNSZone(ctx context.Context) error {
+	if len(fctx.inventory.ByKind(KindPrivateDNSZone)) == 0 && len(fctx.inventory.ByKind(KindVirtualNetworkLink)) == 0 {
+		return nil
+	}
+
+	zones, err := fctx.factory.PrivateDNSZones()
+	if err != nil {
+		return err
+	}
+	links, err := fctx.factory.VirtualNetworkLinks()
+	if err != nil {
+		return err
+	}
+	return fctx.deletePrivateDNSZones(ctx, zones, links, "")
+}
+
+// deletePrivateDNSZones deletes all private DNS zones of the inventory and their links except the zone with the given ID.
+func (fctx *FlowContext) deletePrivateDNSZones(ctx context.Context, zones client.PrivateDNSZones, links client.VirtualNetworkLinks, keep string) error {
+	log := shared.LogFromContext(ctx)
+
+	var joinErr error
+	for _, id := range fctx.inventory.ByKind(KindVirtualNetworkLink) {
+		if id.Parent == nil || strings.EqualFold(id.Parent.String(), keep) {
+			continue
+		}
+		log.Info("deleting virtual network link of private DNS zone", "id", id.String())
+		if err := links.Delete(ctx, id.ResourceGroupName, id.Parent.Name, id.Name); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	if joinErr != nil {
+		return joinErr
+	}
+
+	for _, id := range fctx.inventory.ByKind(KindPrivateDNSZone) {
+		if strings.EqualFold(id.String(), keep) {
+			continue
+		}
+		log.Info("deleting private DNS zone", "id", id.String())
+		if err := zones.Delete(ctx, id.ResourceGroupName, id.Name); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	return joinErr
+}
+
+// privateDNSZoneStatus returns the status of the configured private DNS zone.
+func (fctx *FlowContext) privateDNSZoneStatus() *v1alpha1.PrivateDNSZoneStatus {
+	config := fctx.cfg.Networks.PrivateDNSZone
+	if config == nil {
+		return nil
+	}
+	return &v1alpha1.PrivateDNSZoneStatus{
+		Name: config.Name,
+		ID:   fctx.privateDNSZoneID(config.Name),
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("PrivateDNSZone", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		zoneName       = "internal.example.com"
+		zoneID         = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/privateDnsZones/" + zoneName
+		linkID         = zoneID + "/virtualNetworkLinks/" + namespace
+		vnetID         = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+	)
+
+	var (
+		ctrl       *gomock.Controller
+		ctx        context.Context
+		factory    *mockazureclient.MockFactory
+		zoneClient *mockazureclient.MockPrivateDNSZones
+		linkClient *mockazureclient.MockVirtualNetworkLinks
+		config     *azure.PrivateDNSZoneConfig
+	)
+
+	newFlowContext := func(config *azure.PrivateDNSZoneConfig, managedItems ...string) *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+		}
+		if config != nil {
+			infraConfig.Networks.PrivateDNSZone = &v1alpha1.PrivateDNSZoneConfig{
+				Name:                config.Name,
+				RegistrationEnabled: config.RegistrationEnabled,
+			}
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		state := &azure.InfrastructureState{}
+		for _, id := range managedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   state,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		zoneClient = mockazureclient.NewMockPrivateDNSZones(ctrl)
+		linkClient = mockazureclient.NewMockVirtualNetworkLinks(ctrl)
+		config = &azure.PrivateDNSZoneConfig{Name: zoneName, RegistrationEnabled: true}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsurePrivateDNSZone", func() {
+		BeforeEach(func() {
+			factory.EXPECT().PrivateDNSZones().Return(zoneClient, nil)
+			factory.EXPECT().VirtualNetworkLinks().Return(linkClient, nil)
+		})
+
+		It("should create the private DNS zone and link it to the vnet", func() {
+			zoneClient.EXPECT().Get(ctx, namespace, zoneName).Return(nil, nil)
+			zoneClient.EXPECT().CreateOrUpdate(ctx, namespace, zoneName, client.PrivateDNSZone{Location: ptr.To("global")}).
+				Return(&client.PrivateDNSZone{ID: ptr.To(zoneID)}, nil)
+			linkClient.EXPECT().Get(ctx, namespace, zoneName, namespace).Return(nil, nil)
+			linkClient.EXPECT().CreateOrUpdate(ctx, namespace, zoneName, namespace, infraflow.VirtualNetworkLinkSpec(vnetID, config)).
+				Return(&client.VirtualNetworkLink{ID: ptr.To(linkID)}, nil)
+
+			fctx := newFlowContext(config)
+			Expect(fctx.EnsurePrivateDNSZone(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.PrivateDNSZone).To(Equal(&v1alpha1.PrivateDNSZoneStatus{Name: zoneName, ID: zoneID}))
+
+			var managedItems []string
+			for _, item := range fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems {
+				managedItems = append(managedItems, item.ID)
+			}
+			Expect(managedItems).To(ContainElements(zoneID, linkID))
+		})
+
+		It("should not update an existing link which is up-to-date", func() {
+			zoneClient.EXPECT().Get(ctx, namespace, zoneName).Return(&client.PrivateDNSZone{ID: ptr.To(zoneID)}, nil)
+			linkClient.EXPECT().Get(ctx, namespace, zoneName, namespace).Return(&client.VirtualNetworkLink{
+				ID: ptr.To(linkID),
+				Properties: &client.VirtualNetworkLinkProperties{
+					VirtualNetwork:      &client.VirtualNetworkReference{ID: ptr.To(vnetID)},
+					RegistrationEnabled: ptr.To(true),
+				},
+			}, nil)
+
+			fctx := newFlowContext(config, zoneID, linkID)
+			Expect(fctx.EnsurePrivateDNSZone(ctx)).To(Succeed())
+		})
+
+		It("should update the link if the registration setting changes", func() {
+			config.RegistrationEnabled = false
+
+			zoneClient.EXPECT().Get(ctx, namespace, zoneName).Return(&client.PrivateDNSZone{ID: ptr.To(zoneID)}, nil)
+			linkClient.EXPECT().Get(ctx, namespace, zoneName, namespace).Return(&client.VirtualNetworkLink{
+				ID: ptr.To(linkID),
+				Properties: &client.VirtualNetworkLinkProperties{
+					VirtualNetwork:      &client.VirtualNetworkReference{ID: ptr.To(vnetID)},
+					RegistrationEnabled: ptr.To(true),
+				},
+			}, nil)
+			linkClient.EXPECT().CreateOrUpdate(ctx, namespace, zoneName, namespace, infraflow.VirtualNetworkLinkSpec(vnetID, config)).
+				Return(&client.VirtualNetworkLink{ID: ptr.To(linkID)}, nil)
+
+			fctx := newFlowContext(config, zoneID, linkID)
+			Expect(fctx.EnsurePrivateDNSZone(ctx)).To(Succeed())
+		})
+
+		It("should delete the link and the zone if the private DNS zone is no longer configured", func() {
+			gomock.InOrder(
+				linkClient.EXPECT().Delete(ctx, namespace, zoneName, namespace).Return(nil),
+				zoneClient.EXPECT().Delete(ctx, namespace, zoneName).Return(nil),
+			)
+
+			fctx := newFlowContext(nil, zoneID, linkID)
+			Expect(fctx.EnsurePrivateDNSZone(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.PrivateDNSZone).To(BeNil())
+			Expect(fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems).To(BeEmpty())
+		})
+	})
+
+	Describe("#DeletePrivateDNSZone", func() {
+		It("should delete the link before the zone", func() {
+			factory.EXPECT().PrivateDNSZones().Return(zoneClient, nil)
+			factory.EXPECT().VirtualNetworkLinks().Return(linkClient, nil)
+			gomock.InOrder(
+				linkClient.EXPECT().Delete(ctx, namespace, zoneName, namespace).Return(nil),
+				zoneClient.EXPECT().Delete(ctx, namespace, zoneName).Return(nil),
+			)
+
+			fctx := newFlowContext(config, zoneID, linkID)
+			Expect(fctx.DeletePrivateDNSZone(ctx)).To(Succeed())
+		})
+
+		It("should not call Azure if no private DNS zone was created", func() {
+			fctx := newFlowContext(config)
+			Expect(fctx.DeletePrivateDNSZone(ctx)).To(Succeed())
+		})
+	})
+})
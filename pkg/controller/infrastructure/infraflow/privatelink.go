@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// validatePrivateLink checks that the subnet of the configured Private Endpoint exists. The Private Endpoint is created
+// in the resource group of the shoot, hence the subnet must be in the subscription of the shoot. Whether the private
+// link may be configured at all is decided by the admission, so that existing private links are still reconciled if
+// the feature gate is disabled afterwards.
+func (fctx *FlowContext) validatePrivateLink(ctx context.Context) error {
+	privateLink := fctx.cfg.Networks.PrivateLink
+	if privateLink == nil {
+		return nil
+	}
+
+	resourceID, err := arm.ParseResourceID(privateLink.EndpointSubnetID)
+	if err != nil {
+		return err
+	}
+	metadata := AzureResourceMetadata{ResourceGroup: resourceID.ResourceGroupName, Name: resourceID.Name, Parent: resourceID.Parent.Name, Kind: KindSubnet}
+	if !strings.EqualFold(resourceID.SubscriptionID, fctx.auth.SubscriptionID) {
+		return NewTerminalConditionError(metadata, fmt.Errorf("subnet %s of the private endpoint must be in the subscription of the shoot", privateLink.EndpointSubnetID))
+	}
+
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+	subnet, err := c.Get(ctx, resourceID.ResourceGroupName, resourceID.Parent.Name, resourceID.Name, nil)
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		return NewTerminalConditionError(metadata, fmt.Errorf("subnet %s of the private endpoint does not exist", privateLink.EndpointSubnetID))
+	}
+	return nil
+}
+
+// retainPrivateLinkSubnet keeps the subnet of a removed private link as long as the Private Link Service still uses it.
+// The infrastructure is reconciled before the control plane, which deletes the Private Endpoint, so that the
+// cloud-controller-manager can delete the Private Link Service afterwards. The subnet is deleted with the next
+// reconciliation once the Private Link Service is gone.
+func (fctx *FlowContext) retainPrivateLinkSubnet(ctx context.Context, toDelete map[string]*armnetwork.Subnet) error {
+	name := fctx.adapter.privateLinkSubnetName()
+	if _, ok := toDelete[name]; !ok {
+		return nil
+	}
+
+	c, err := fctx.factory.PrivateLinkService()
+	if err != nil {
+		return err
+	}
+	privateLinkServiceName := fctx.infra.Namespace + consts.PrivateLinkNameSuffix
+	pls, err := c.Get(ctx, fctx.adapter.ResourceGroupName(), privateLinkServiceName)
+	if err != nil {
+		return err
+	}
+	if pls != nil {
+		shared.LogFromContext(ctx).Info("Keeping subnet until the private link service using it is deleted", "name", name, "privateLinkService", privateLinkServiceName)
+		delete(toDelete, name)
+	}
+	return nil
+}
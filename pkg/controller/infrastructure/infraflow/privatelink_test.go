@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("PrivateLink", func() {
+	const (
+		namespace         = "shoot--foo--bar"
+		region            = "westeurope"
+		subscriptionID    = "00000000-0000-0000-0000-000000000000"
+		vnetID            = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+		workerSubnet      = namespace + "-nodes"
+		privateLinkSubnet = namespace + "-private-link"
+		workerSubnetID    = vnetID + "/subnets/" + workerSubnet
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		ctx          context.Context
+		factory      *mockazureclient.MockFactory
+		subnetClient *mockazureclient.MockSubnet
+		plsClient    *mockazureclient.MockPrivateLinkService
+		privateLink  *v1alpha1.PrivateLinkConfig
+	)
+
+	newFlowContext := func() *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:               v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers:            ptr.To("10.250.0.0/16"),
+				DeletionProtection: ptr.To(false),
+				PrivateLink:        privateLink,
+			},
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	expectWorkerSubnetReconciled := func() {
+		subnetClient.EXPECT().CreateOrUpdate(ctx, namespace, namespace, workerSubnet, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _, _ string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+				subnet.ID = ptr.To(workerSubnetID)
+				return &subnet, nil
+			})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		subnetClient = mockazureclient.NewMockSubnet(ctrl)
+		plsClient = mockazureclient.NewMockPrivateLinkService(ctrl)
+		privateLink = nil
+
+		factory.EXPECT().Subnet().Return(subnetClient, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureSubnets", func() {
+		It("should refuse a private endpoint subnet in another subscription", func() {
+			privateLink = &v1alpha1.PrivateLinkConfig{
+				CIDR:             "10.251.0.0/24",
+				EndpointSubnetID: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/seed/providers/Microsoft.Network/virtualNetworks/seed/subnets/private-endpoints",
+			}
+			subnetClient.EXPECT().List(ctx, namespace, namespace).Return(nil, nil)
+
+			Expect(newFlowContext().EnsureSubnets(ctx)).To(MatchError(ContainSubstring("must be in the subscription of the shoot")))
+		})
+
+		Context("private link removed", func() {
+			BeforeEach(func() {
+				subnetClient.EXPECT().List(ctx, namespace, namespace).Return([]*armnetwork.Subnet{
+					{ID: ptr.To(workerSubnetID), Name: ptr.To(workerSubnet), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.250.0.0/16")}},
+					{ID: ptr.To(vnetID + "/subnets/" + privateLinkSubnet), Name: ptr.To(privateLinkSubnet), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.251.0.0/24")}},
+				}, nil)
+				factory.EXPECT().PrivateLinkService().Return(plsClient, nil)
+			})
+
+			It("should keep the subnet while the private link service still exists", func() {
+				plsClient.EXPECT().Get(ctx, namespace, namespace+"-connectivity").Return(&armnetwork.PrivateLinkService{Name: ptr.To(namespace + "-connectivity")}, nil)
+				expectWorkerSubnetReconciled()
+
+				Expect(newFlowContext().EnsureSubnets(ctx)).To(Succeed())
+			})
+
+			It("should delete the subnet once the private link service is deleted", func() {
+				plsClient.EXPECT().Get(ctx, namespace, namespace+"-connectivity").Return(nil, nil)
+				subnetClient.EXPECT().Delete(ctx, namespace, namespace, privateLinkSubnet).Return(nil)
+				expectWorkerSubnetReconciled()
+
+				Expect(newFlowContext().EnsureSubnets(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -7,8 +7,10 @@ package infraflow
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
 )
 
 // AzureResourceKind is a string describing the resource type.
@@ -19,12 +21,28 @@ func (a AzureResourceKind) String() string {
 }
 
 const (
+	// KindFederatedIdentityCredential is the kind for a federated identity credential of a managed identity.
+	KindFederatedIdentityCredential AzureResourceKind = "Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"
+	// KindFlowLog is the kind for a flow log of a network watcher.
+	KindFlowLog AzureResourceKind = "Microsoft.Network/networkWatchers/flowLogs"
+	// KindLoadBalancer is the kind for a load balancer.
+	KindLoadBalancer AzureResourceKind = "Microsoft.Network/loadBalancers"
 	// KindNatGateway is the kind for a NAT Gateway.
 	KindNatGateway AzureResourceKind = "Microsoft.Network/natGateways"
+	// KindNetworkWatcher is the kind for a network watcher.
+	KindNetworkWatcher AzureResourceKind = "Microsoft.Network/networkWatchers"
+	// KindPrivateDNSZone is the kind for a private DNS zone.
+	KindPrivateDNSZone AzureResourceKind = "Microsoft.Network/privateDnsZones"
 	// KindPublicIP is the kind for a public ip.
 	KindPublicIP AzureResourceKind = "Microsoft.Network/publicIPAddresses"
+	// KindPublicIPPrefix is the kind for a public ip prefix.
+	KindPublicIPPrefix AzureResourceKind = "Microsoft.Network/publicIPPrefixes"
 	// KindResourceGroup is the kind for a resource group.
 	KindResourceGroup AzureResourceKind = "Microsoft.Resources/resourceGroups"
+	// KindServiceEndpointPolicy is the kind for a service endpoint policy.
+	KindServiceEndpointPolicy AzureResourceKind = "Microsoft.Network/serviceEndpointPolicies"
+	// KindRoleAssignment is the kind for a role assignment.
+	KindRoleAssignment AzureResourceKind = "Microsoft.Authorization/roleAssignments"
 	// KindRouteTable is the kind for a route table.
 	KindRouteTable AzureResourceKind = "Microsoft.Network/routeTables"
 	// KindSecurityGroup is the kind for a security group.
@@ -33,6 +51,8 @@ const (
 	KindSubnet AzureResourceKind = "Microsoft.Network/virtualNetworks/subnets"
 	// KindVirtualNetwork is the kind for a virtual network.
 	KindVirtualNetwork AzureResourceKind = "Microsoft.Network/virtualNetworks"
+	// KindVirtualNetworkLink is the kind for a virtual network link of a private DNS zone.
+	KindVirtualNetworkLink AzureResourceKind = "Microsoft.Network/privateDnsZones/virtualNetworkLinks"
 )
 
 const (
@@ -41,10 +61,18 @@ const (
 )
 
 const (
+	// TemplateLoadBalancer is the template for the id of a load balancer.
+	TemplateLoadBalancer = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s"
 	// TemplateNatGateway the template for the id of a NAT Gateway.
 	TemplateNatGateway = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s"
+	// TemplatePrivateDNSZone is the template for the id of a private DNS zone.
+	TemplatePrivateDNSZone = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s"
+	// TemplateVirtualNetworkLink is the template for the id of a virtual network link of a private DNS zone.
+	TemplateVirtualNetworkLink = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s/virtualNetworkLinks/%s"
 	// TemplatePublicIP the template for the id of a public IP.
 	TemplatePublicIP = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"
+	// TemplatePublicIPPrefix the template for the id of a public IP prefix.
+	TemplatePublicIPPrefix = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPPrefixes/%s"
 	// TemplateResourceGroup is the template for the id of a resource group.
 	TemplateResourceGroup = "/subscriptions/%s/resourceGroups/%s"
 	// TemplateRouteTable is the template for the id of a route table.
@@ -91,10 +119,22 @@ func ForceNewIp(current, target *armnetwork.PublicIPAddress) (bool, string, any)
 	if !reflect.DeepEqual(current.Properties.PublicIPAllocationMethod, target.Properties.PublicIPAllocationMethod) {
 		return true, "PublicIPAllocationMethod", current.Properties.PublicIPAllocationMethod
 	}
+	// the prefix of a public IP cannot be changed, Azure only allocates public IPs from a prefix on creation.
+	if currentPrefix, targetPrefix := publicIPPrefixID(current), publicIPPrefixID(target); !strings.EqualFold(currentPrefix, targetPrefix) {
+		return true, "PublicIPPrefix", currentPrefix
+	}
 	return false, "", nil
 }
 
+func publicIPPrefixID(ip *armnetwork.PublicIPAddress) string {
+	if ip.Properties == nil || ip.Properties.PublicIPPrefix == nil {
+		return ""
+	}
+	return ptr.Deref(ip.Properties.PublicIPPrefix.ID, "")
+}
+
 // ForceNewNat checks if the resource can be reconciled. If not, returns the name of the field and value that couldn't be updated.
+// The idle timeout and the public IPs of a NAT Gateway are updated in place, so that changing them keeps the egress IPs.
 func ForceNewNat(current, target *armnetwork.NatGateway) (bool, string, any) {
 	if !reflect.DeepEqual(current.Location, target.Location) {
 		return true, "Location", *current.Location
@@ -111,3 +151,40 @@ func ForceNewNat(current, target *armnetwork.NatGateway) (bool, string, any) {
 func ForceNewSubnet(_, _ *armnetwork.Subnet) (bool, string, any) {
 	return false, "", nil
 }
+
+// MergeServiceEndpointPolicies returns the service endpoint policies which should be attached to a subnet. Policies that were
+// attached by the extension before (previous) but are no longer desired are detached, whereas policies that were attached
+// by third parties are kept.
+func MergeServiceEndpointPolicies(current []*armnetwork.ServiceEndpointPolicy, previous, desired []string) []*armnetwork.ServiceEndpointPolicy {
+	contains := func(ids []string, id string) bool {
+		for _, i := range ids {
+			if strings.EqualFold(i, id) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var (
+		res []*armnetwork.ServiceEndpointPolicy
+		ids []string
+	)
+	for _, policy := range current {
+		if policy == nil || policy.ID == nil || contains(ids, *policy.ID) {
+			continue
+		}
+		if contains(previous, *policy.ID) && !contains(desired, *policy.ID) {
+			continue
+		}
+		res = append(res, &armnetwork.ServiceEndpointPolicy{ID: policy.ID})
+		ids = append(ids, *policy.ID)
+	}
+	for _, id := range desired {
+		if contains(ids, id) {
+			continue
+		}
+		res = append(res, &armnetwork.ServiceEndpointPolicy{ID: &id})
+		ids = append(ids, id)
+	}
+	return res
+}
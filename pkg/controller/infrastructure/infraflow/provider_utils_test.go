@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("ProviderUtils", func() {
+	Describe("#MergeServiceEndpointPolicies", func() {
+		const (
+			policyA  = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/serviceEndpointPolicies/a"
+			policyB  = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/serviceEndpointPolicies/b"
+			external = "/subscriptions/sub/resourceGroups/other/providers/Microsoft.Network/serviceEndpointPolicies/external"
+		)
+
+		ids := func(policies []*armnetwork.ServiceEndpointPolicy) []string {
+			var res []string
+			for _, p := range policies {
+				res = append(res, *p.ID)
+			}
+			return res
+		}
+		policies := func(ids ...string) []*armnetwork.ServiceEndpointPolicy {
+			var res []*armnetwork.ServiceEndpointPolicy
+			for _, id := range ids {
+				res = append(res, &armnetwork.ServiceEndpointPolicy{ID: ptr.To(id)})
+			}
+			return res
+		}
+
+		It("should attach the desired policies to a new subnet", func() {
+			Expect(ids(infraflow.MergeServiceEndpointPolicies(nil, nil, []string{policyA, policyB}))).To(Equal([]string{policyA, policyB}))
+		})
+
+		It("should attach additional policies to an existing subnet", func() {
+			Expect(ids(infraflow.MergeServiceEndpointPolicies(policies(policyA), []string{policyA}, []string{policyA, policyB}))).To(Equal([]string{policyA, policyB}))
+		})
+
+		It("should detach policies which are no longer desired", func() {
+			Expect(ids(infraflow.MergeServiceEndpointPolicies(policies(policyA, policyB), []string{policyA, policyB}, []string{policyB}))).To(Equal([]string{policyB}))
+			Expect(infraflow.MergeServiceEndpointPolicies(policies(policyA, policyB), []string{policyA, policyB}, nil)).To(BeEmpty())
+		})
+
+		It("should keep policies which were attached externally", func() {
+			Expect(ids(infraflow.MergeServiceEndpointPolicies(policies(external, policyA), []string{policyA}, nil))).To(Equal([]string{external}))
+		})
+
+		It("should compare the policy IDs case-insensitively", func() {
+			Expect(ids(infraflow.MergeServiceEndpointPolicies(policies(strings.ToUpper(policyA)), nil, []string{policyA}))).To(Equal([]string{strings.ToUpper(policyA)}))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// ensurePublicIPPrefixes checks that the existing public IP prefixes from which the managed public IPs of the NAT
+// gateways are allocated exist in the region of the shoot, cover the zones of the zonal NAT gateways and have enough
+// free addresses for the public IPs which are not yet allocated from them. The public IPs which are about to be deleted
+// release their addresses, hence they are counted as free. The check runs before any public IP is deleted, so that the
+// public IPs of the shoot are kept if a prefix cannot provide the new ones. The prefixes are shared with other shoots,
+// hence they are neither created nor updated and not added to the inventory, so that they are not deleted with the shoot.
+func (fctx *FlowContext) ensurePublicIPPrefixes(ctx context.Context, current map[string]*armnetwork.PublicIPAddress, deleted map[string]string) error {
+	required := map[AzureResourceMetadata]*publicIPPrefixDemand{}
+	for name, ip := range fctx.adapter.ManagedIpConfigs() {
+		if ip.PublicIPPrefix == nil {
+			continue
+		}
+		prefixID := GetIdFromTemplate(TemplatePublicIPPrefix, fctx.auth.SubscriptionID, ip.PublicIPPrefix.ResourceGroup, ip.PublicIPPrefix.Name)
+		if currentIP, ok := current[name]; ok && deleted[name] == "" && strings.EqualFold(publicIPPrefixID(currentIP), prefixID) {
+			continue
+		}
+		demand, ok := required[*ip.PublicIPPrefix]
+		if !ok {
+			demand = &publicIPPrefixDemand{zones: sets.New[string]()}
+			required[*ip.PublicIPPrefix] = demand
+		}
+		demand.count++
+		demand.zones.Insert(ip.Zones...)
+	}
+	if len(required) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.PublicIPPrefix()
+	if err != nil {
+		return err
+	}
+
+	var joinError error
+	for prefix, demand := range required {
+		joinError = errors.Join(joinError, fctx.checkPublicIPPrefix(ctx, c, prefix, demand, deleted))
+	}
+	return joinError
+}
+
+// publicIPPrefixDemand is the number of public IPs which are allocated from a public IP prefix and their zones.
+type publicIPPrefixDemand struct {
+	count int
+	zones sets.Set[string]
+}
+
+// checkPublicIPPrefix checks that the given public IP prefix can provide the public IPs of the given demand. The
+// addresses of the given public IPs which are deleted are released and hence available for the demand.
+func (fctx *FlowContext) checkPublicIPPrefix(ctx context.Context, c client.PublicIPPrefix, prefix AzureResourceMetadata, demand *publicIPPrefixDemand, deleted map[string]string) error {
+	ipPrefix, err := c.Get(ctx, prefix.ResourceGroup, prefix.Name)
+	if err != nil {
+		return err
+	}
+	if ipPrefix == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the public IP prefix %s in resource group %s does not exist", prefix.Name, prefix.ResourceGroup),
+			gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if location := ptr.Deref(ipPrefix.Location, ""); location != fctx.adapter.Region() {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the public IP prefix %s is located in %s instead of the region %s of the shoot",
+			prefix.Name, location, fctx.adapter.Region()), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	// the public IPs of a zonal NAT gateway are allocated in its zone, which the prefix must cover.
+	prefixZones := sets.New[string]()
+	for _, zone := range ipPrefix.Zones {
+		prefixZones.Insert(ptr.Deref(zone, ""))
+	}
+	if missing := demand.zones.Difference(prefixZones); missing.Len() > 0 {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the public IP prefix %s does not cover the zones %s of the NAT gateways of the shoot",
+			prefix.Name, strings.Join(sets.List(missing), ", ")), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if ipPrefix.Properties == nil || ipPrefix.Properties.PrefixLength == nil {
+		return nil
+	}
+	if ptr.Deref(ipPrefix.Properties.PublicIPAddressVersion, armnetwork.IPVersionIPv4) != armnetwork.IPVersionIPv4 {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the public IP prefix %s is not an IPv4 prefix, which is required for the public IPs of NAT gateways",
+			prefix.Name), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	var (
+		size      = 1 << (32 - *ipPrefix.Properties.PrefixLength)
+		allocated = len(ipPrefix.Properties.PublicIPAddresses)
+		released  int
+	)
+	for _, address := range ipPrefix.Properties.PublicIPAddresses {
+		if address == nil {
+			continue
+		}
+		for _, id := range deleted {
+			if strings.EqualFold(id, ptr.Deref(address.ID, "")) {
+				released++
+				break
+			}
+		}
+	}
+	if free := size - allocated + released; free < demand.count {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the public IP prefix %s in resource group %s is exhausted: %d of its %d addresses are allocated, but %d more are required for the NAT gateways of the shoot",
+			prefix.Name, prefix.ResourceGroup, allocated-released, size, demand.count), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return nil
+}
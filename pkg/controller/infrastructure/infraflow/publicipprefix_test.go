@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("PublicIPPrefix", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		prefixName     = "egress-prefix"
+		prefixRG       = "egress"
+		prefixID       = "/subscriptions/" + subscriptionID + "/resourceGroups/" + prefixRG + "/providers/Microsoft.Network/publicIPPrefixes/" + prefixName
+		firstIPName    = namespace + "-nat-gateway-ip"
+		secondIPName   = namespace + "-nat-gateway-ip-2"
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		ctx          context.Context
+		factory      *mockazureclient.MockFactory
+		ipClient     *mockazureclient.MockPublicIP
+		prefixClient *mockazureclient.MockPublicIPPrefix
+		fctx         *infraflow.FlowContext
+	)
+
+	ipID := func(name string) string {
+		return "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace + "/providers/Microsoft.Network/publicIPAddresses/" + name
+	}
+
+	publicIP := func(name string) *armnetwork.PublicIPAddress {
+		return &armnetwork.PublicIPAddress{
+			ID:       ptr.To(ipID(name)),
+			Name:     ptr.To(name),
+			Location: ptr.To(region),
+			Tags: map[string]*string{
+				infraflow.TagManagedByGardener: ptr.To("true"),
+				infraflow.TagShootName:         ptr.To(namespace),
+			},
+			Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+				PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic),
+				PublicIPPrefix:           &armnetwork.SubResource{ID: ptr.To(prefixID)},
+			},
+		}
+	}
+
+	ipPrefix := func(length int32, allocated ...string) *armnetwork.PublicIPPrefix {
+		prefix := &armnetwork.PublicIPPrefix{
+			ID:       ptr.To(prefixID),
+			Location: ptr.To(region),
+			Properties: &armnetwork.PublicIPPrefixPropertiesFormat{
+				PrefixLength:           ptr.To(length),
+				PublicIPAddressVersion: ptr.To(armnetwork.IPVersionIPv4),
+			},
+		}
+		for _, id := range allocated {
+			prefix.Properties.PublicIPAddresses = append(prefix.Properties.PublicIPAddresses, &armnetwork.ReferencedPublicIPAddress{ID: ptr.To(id)})
+		}
+		return prefix
+	}
+
+	newFlowContext := func(publicIPCount int32, zones ...int32) *infraflow.FlowContext {
+		networks := v1alpha1.NetworkConfig{
+			VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+			Workers: ptr.To("10.250.0.0/16"),
+			NatGateway: &v1alpha1.NatGatewayConfig{
+				Enabled:        true,
+				PublicIPCount:  ptr.To(publicIPCount),
+				PublicIPPrefix: &v1alpha1.PublicIPPrefixReference{Name: prefixName, ResourceGroup: prefixRG},
+			},
+		}
+		if len(zones) > 0 {
+			networks.Workers, networks.NatGateway = nil, nil
+			for i, zone := range zones {
+				networks.Zones = append(networks.Zones, v1alpha1.Zone{
+					Name: zone,
+					CIDR: fmt.Sprintf("10.250.%d.0/24", i),
+					NatGateway: &v1alpha1.ZonedNatGatewayConfig{
+						Enabled:        true,
+						PublicIPCount:  ptr.To(publicIPCount),
+						PublicIPPrefix: &v1alpha1.PublicIPPrefixReference{Name: prefixName, ResourceGroup: prefixRG},
+					},
+				})
+			}
+		}
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: networks,
+			Zoned:    len(zones) > 0,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	expectCreate := func(name string) {
+		ipClient.EXPECT().CreateOrUpdate(ctx, namespace, name, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, ip armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+			Expect(ip.Properties.PublicIPPrefix).To(Equal(&armnetwork.SubResource{ID: ptr.To(prefixID)}))
+			return publicIP(name), nil
+		})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = logr.NewContext(context.TODO(), logr.Discard())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		ipClient = mockazureclient.NewMockPublicIP(ctrl)
+		prefixClient = mockazureclient.NewMockPublicIPPrefix(ctrl)
+		factory.EXPECT().PublicIP().Return(ipClient, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsurePublicIps", func() {
+		It("should allocate the public IPs from the shared prefix", func() {
+			fctx = newFlowContext(2)
+			ipClient.EXPECT().List(ctx, namespace).Return(nil, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(ipPrefix(30, "/subscriptions/other/publicIPAddresses/other"), nil)
+			expectCreate(firstIPName)
+			expectCreate(secondIPName)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+			ipClient.EXPECT().Get(ctx, namespace, secondIPName, nil).Return(publicIP(secondIPName), nil)
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+			managedItems := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems
+			Expect(managedItems).To(ContainElement(HaveField("ID", ipID(firstIPName))))
+			Expect(managedItems).NotTo(ContainElement(HaveField("ID", prefixID)))
+		})
+
+		It("should not check the shared prefix if the public IPs are already allocated from it", func() {
+			fctx = newFlowContext(1)
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{publicIP(firstIPName)}, nil)
+			expectCreate(firstIPName)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+		})
+
+		It("should fail without creating public IPs if the shared prefix is exhausted", func() {
+			fctx = newFlowContext(2)
+			ipClient.EXPECT().List(ctx, namespace).Return(nil, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(ipPrefix(31, "/subscriptions/other/publicIPAddresses/other"), nil)
+			ipClient.EXPECT().Get(ctx, namespace, gomock.Any(), nil).Return(publicIP(firstIPName), nil).Times(2)
+
+			err := fctx.EnsurePublicIps(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the public IP prefix egress-prefix in resource group egress is exhausted: 1 of its 2 addresses are allocated, but 2 more are required")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should keep the current public IPs if the shared prefix cannot provide the recreated ones", func() {
+			fctx = newFlowContext(1)
+			current := publicIP(firstIPName)
+			current.Properties.PublicIPPrefix = nil
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{current}, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(ipPrefix(32, "/subscriptions/other/publicIPAddresses/other"), nil)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(current, nil)
+
+			err := fctx.EnsurePublicIps(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the public IP prefix egress-prefix in resource group egress is exhausted: 1 of its 1 addresses are allocated, but 1 more are required")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should count the addresses of the recreated public IPs as free", func() {
+			fctx = newFlowContext(1)
+			current := publicIP(firstIPName)
+			current.Zones = []*string{ptr.To("1")}
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{current}, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(ipPrefix(32, ipID(firstIPName)), nil)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, ptr.To("natGateway")).Return(current, nil)
+			ipClient.EXPECT().Delete(ctx, namespace, firstIPName).Return(nil)
+			expectCreate(firstIPName)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+		})
+
+		It("should fail if the shared prefix does not cover the zone of a zonal NAT gateway", func() {
+			fctx = newFlowContext(1, 1, 2)
+			prefix := ipPrefix(30)
+			prefix.Zones = []*string{ptr.To("1")}
+			ipClient.EXPECT().List(ctx, namespace).Return(nil, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(prefix, nil)
+			ipClient.EXPECT().Get(ctx, namespace, gomock.Any(), nil).Return(publicIP(firstIPName), nil).Times(2)
+
+			err := fctx.EnsurePublicIps(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the public IP prefix egress-prefix does not cover the zones 2 of the NAT gateways of the shoot")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should fail if the shared prefix is located in another region", func() {
+			fctx = newFlowContext(1)
+			prefix := ipPrefix(30)
+			prefix.Location = ptr.To("northeurope")
+			ipClient.EXPECT().List(ctx, namespace).Return(nil, nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(prefix, nil)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+
+			err := fctx.EnsurePublicIps(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the public IP prefix egress-prefix is located in northeurope instead of the region westeurope")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should release the addresses of surplus public IPs without touching the shared prefix", func() {
+			fctx = newFlowContext(1)
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{publicIP(firstIPName), publicIP(secondIPName)}, nil)
+			ipClient.EXPECT().Get(ctx, namespace, secondIPName, ptr.To("natGateway")).Return(publicIP(secondIPName), nil)
+			ipClient.EXPECT().Delete(ctx, namespace, secondIPName).Return(nil)
+			expectCreate(firstIPName)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+			managedItems := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems
+			Expect(managedItems).To(ContainElement(HaveField("ID", ipID(firstIPName))))
+			Expect(managedItems).NotTo(ContainElement(HaveField("ID", ipID(secondIPName))))
+		})
+
+		It("should recreate a public IP which is not allocated from the shared prefix", func() {
+			fctx = newFlowContext(1)
+			current := publicIP(firstIPName)
+			current.Properties.PublicIPPrefix = nil
+			ipClient.EXPECT().List(ctx, namespace).Return([]*armnetwork.PublicIPAddress{current}, nil)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, ptr.To("natGateway")).Return(current, nil)
+			ipClient.EXPECT().Delete(ctx, namespace, firstIPName).Return(nil)
+			factory.EXPECT().PublicIPPrefix().Return(prefixClient, nil)
+			prefixClient.EXPECT().Get(ctx, prefixRG, prefixName).Return(ipPrefix(30), nil)
+			expectCreate(firstIPName)
+			ipClient.EXPECT().Get(ctx, namespace, firstIPName, nil).Return(publicIP(firstIPName), nil)
+
+			Expect(fctx.EnsurePublicIps(ctx)).To(Succeed())
+		})
+	})
+})
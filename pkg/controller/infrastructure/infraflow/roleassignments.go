@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// RoleAssignmentWritePermission is the permission which the principal of the shoot requires to assign roles.
+const RoleAssignmentWritePermission = "Microsoft.Authorization/roleAssignments/write"
+
+// RoleDefinitionNotFoundError is returned when a role which is to be assigned to the managed identity does not exist.
+type RoleDefinitionNotFoundError struct {
+	// ID is the resource ID of the role definition.
+	ID string
+}
+
+func (e *RoleDefinitionNotFoundError) Error() string {
+	return fmt.Sprintf("the role definition %s of the role assignments of the identity does not exist", e.ID)
+}
+
+// RoleAssignmentNotPermittedError is returned when the principal of the shoot is not permitted to assign roles at the
+// scope of a role assignment of the managed identity.
+type RoleAssignmentNotPermittedError struct {
+	// Scope is the scope of the role assignment.
+	Scope string
+}
+
+func (e *RoleAssignmentNotPermittedError) Error() string {
+	return fmt.Sprintf("not permitted to assign roles at scope %s, the credentials of the shoot require the %s permission", e.Scope, RoleAssignmentWritePermission)
+}
+
+// EnsureRoleAssignments assigns the configured roles to the managed identity. The role assignments have deterministic
+// names, hence existing ones are not created again. Role assignments of the inventory which are no longer configured
+// are deleted. A role which is already assigned to the identity by another role assignment is neither created nor
+// added to the inventory.
+func (fctx *FlowContext) EnsureRoleAssignments(ctx context.Context) error {
+	if fctx.cfg.Identity == nil || len(fctx.cfg.Identity.RoleAssignments) == 0 {
+		return fctx.DeleteRoleAssignments(ctx)
+	}
+
+	principalID := fctx.whiteboard.Get(KeyManagedIdentityPrincipalId)
+	if principalID == nil {
+		return fmt.Errorf("the principal ID of the managed identity %s is unknown", fctx.cfg.Identity.Name)
+	}
+
+	c, err := fctx.factory.RoleAssignments()
+	if err != nil {
+		return err
+	}
+
+	current := sets.New[string]()
+	for _, assignment := range fctx.cfg.Identity.RoleAssignments {
+		id, err := fctx.ensureRoleAssignment(ctx, c, *principalID, assignment)
+		if err != nil {
+			return err
+		}
+		current.Insert(strings.ToLower(id))
+	}
+
+	return fctx.deleteRoleAssignments(ctx, c, current)
+}
+
+// ensureRoleAssignment creates the given role assignment of the principal with the given ID if it does not exist yet
+// and returns its ID. The ID is empty if the role is assigned to the principal by another role assignment.
+func (fctx *FlowContext) ensureRoleAssignment(ctx context.Context, c client.RoleAssignments, principalID string, assignment azure.IdentityRoleAssignment) (string, error) {
+	log := shared.LogFromContext(ctx)
+
+	resourceGroupID := ResourceGroupIdFromTemplate(fctx.auth.SubscriptionID, fctx.adapter.ResourceGroupName())
+	scope := ptr.Deref(assignment.Scope, resourceGroupID)
+	if !strings.EqualFold(scope, resourceGroupID) && !strings.HasPrefix(strings.ToLower(scope), strings.ToLower(resourceGroupID)+"/") {
+		return "", fmt.Errorf("the scope %s of the role assignment must be the resource group %s of the shoot or a resource in it", scope, resourceGroupID)
+	}
+
+	name := roleAssignmentName(scope, assignment.RoleDefinitionID, principalID)
+	existing, err := c.Get(ctx, scope, name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil && existing.ID != nil {
+		return *existing.ID, fctx.inventory.Insert(*existing.ID)
+	}
+
+	definition, err := c.GetRoleDefinition(ctx, assignment.RoleDefinitionID)
+	if err != nil {
+		return "", err
+	}
+	if definition == nil {
+		return "", &RoleDefinitionNotFoundError{ID: assignment.RoleDefinitionID}
+	}
+
+	permissions, err := c.ListPermissions(ctx, scope)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the permissions at scope %s: %w", scope, err)
+	}
+	if !IsPermitted(permissions, RoleAssignmentWritePermission) {
+		return "", &RoleAssignmentNotPermittedError{Scope: scope}
+	}
+
+	log.Info("assigning role to managed identity", "role", ptr.Deref(definition.Properties.RoleName, assignment.RoleDefinitionID), "scope", scope)
+	created, err := c.Create(ctx, scope, name, client.RoleAssignment{
+		Properties: client.RoleAssignmentProperties{
+			RoleDefinitionID: ptr.To(assignment.RoleDefinitionID),
+			PrincipalID:      ptr.To(principalID),
+			// the principal type avoids failures while the identity is not yet replicated in Microsoft Entra ID.
+			PrincipalType: ptr.To("ServicePrincipal"),
+		},
+	})
+	if client.IsRoleAssignmentExistsError(err) {
+		log.Info("role is already assigned to managed identity", "role", assignment.RoleDefinitionID, "scope", scope)
+		return "", nil
+	}
+	if client.IsAzureAPIForbidden(err) {
+		return "", fmt.Errorf("%w: %w", &RoleAssignmentNotPermittedError{Scope: scope}, err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	log.V(1).Info("Adding to inventory", "id", *created.ID)
+	return *created.ID, fctx.inventory.Insert(*created.ID)
+}
+
+// DeleteRoleAssignments deletes the role assignments of the managed identity which were created by the extension.
+func (fctx *FlowContext) DeleteRoleAssignments(ctx context.Context) error {
+	if len(fctx.inventory.ByKind(KindRoleAssignment)) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.RoleAssignments()
+	if err != nil {
+		return err
+	}
+	return fctx.deleteRoleAssignments(ctx, c, nil)
+}
+
+// deleteRoleAssignments deletes all role assignments of the inventory except the ones with the given lower case IDs.
+func (fctx *FlowContext) deleteRoleAssignments(ctx context.Context, c client.RoleAssignments, keep sets.Set[string]) error {
+	log := shared.LogFromContext(ctx)
+
+	var joinErr error
+	for _, id := range fctx.inventory.ByKind(KindRoleAssignment) {
+		if keep.Has(strings.ToLower(id.String())) {
+			continue
+		}
+
+		log.Info("deleting role assignment", "id", id.String())
+		if err := c.Delete(ctx, id.Parent.String(), id.Name); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.inventory.Delete(id.String())
+	}
+	return joinErr
+}
+
+// roleAssignmentName returns the name of the role assignment of the given role to the given principal at the given
+// scope. Azure requires the names of role assignments to be GUIDs.
+func roleAssignmentName(scope, roleDefinitionID, principalID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(strings.ToLower(scope+"|"+roleDefinitionID+"|"+principalID))).String()
+}
+
+// IsPermitted returns true if the given permissions permit the given action. Actions and NotActions of the permissions
+// may contain wildcards.
+func IsPermitted(permissions []client.Permission, action string) bool {
+	for _, permission := range permissions {
+		if permission.Permits(action) {
+			return true
+		}
+	}
+	return false
+}
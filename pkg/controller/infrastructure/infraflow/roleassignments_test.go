@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("RoleAssignments", func() {
+	const (
+		namespace        = "shoot--foo--bar"
+		subscriptionID   = "00000000-0000-0000-0000-000000000000"
+		principalID      = "11111111-1111-1111-1111-111111111111"
+		roleDefinitionID = "/subscriptions/" + subscriptionID + "/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"
+		resourceGroupID  = "/subscriptions/" + subscriptionID + "/resourceGroups/" + namespace
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		ctx     context.Context
+		factory *mockazureclient.MockFactory
+		fake    *mockazureclient.MockRoleAssignments
+
+		permitted = []client.Permission{{Actions: []string{"*"}, NotActions: []string{"Microsoft.Authorization/*/Delete"}}}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		factory = mockazureclient.NewMockFactory(ctrl)
+		fake = mockazureclient.NewMockRoleAssignments(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newFlowContext := func(assignments []v1alpha1.IdentityRoleAssignment, managedItems ...string) *infraflow.FlowContext {
+		infraConfig := &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:    v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers: ptr.To("10.250.0.0/16"),
+			},
+			Identity: &v1alpha1.IdentityConfig{Name: "identity", ResourceGroup: "identity-rg", RoleAssignments: assignments},
+		}
+		raw, err := json.Marshal(infraConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		state := &azure.InfrastructureState{Data: map[string]string{infraflow.KeyManagedIdentityPrincipalId: principalID}}
+		for _, id := range managedItems {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+
+		fctx, err := infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   state,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		return fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	Describe("#EnsureRoleAssignments", func() {
+		It("should assign the role at the resource group of the shoot and add it to the inventory", func() {
+			var name string
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, resourceGroupID, gomock.Any()).DoAndReturn(func(_ context.Context, _, n string) (*client.RoleAssignment, error) {
+				name = n
+				return nil, nil
+			})
+			fake.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(&client.RoleDefinition{ID: ptr.To(roleDefinitionID)}, nil)
+			fake.EXPECT().ListPermissions(ctx, resourceGroupID).Return(permitted, nil)
+			fake.EXPECT().Create(ctx, resourceGroupID, gomock.Any(), client.RoleAssignment{
+				Properties: client.RoleAssignmentProperties{
+					RoleDefinitionID: ptr.To(roleDefinitionID),
+					PrincipalID:      ptr.To(principalID),
+					PrincipalType:    ptr.To("ServicePrincipal"),
+				},
+			}).DoAndReturn(func(_ context.Context, scope, n string, _ client.RoleAssignment) (*client.RoleAssignment, error) {
+				Expect(n).To(Equal(name))
+				return &client.RoleAssignment{ID: ptr.To(scope + "/providers/Microsoft.Authorization/roleAssignments/" + n)}, nil
+			})
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(v1alpha1.AzureResource{
+				Kind: infraflow.KindRoleAssignment.String(),
+				ID:   resourceGroupID + "/providers/Microsoft.Authorization/roleAssignments/" + name,
+			}))
+		})
+
+		It("should not create an existing role assignment again", func() {
+			scope := resourceGroupID + "/providers/Microsoft.Network/virtualNetworks/" + namespace
+			assignmentID := scope + "/providers/Microsoft.Authorization/roleAssignments/22222222-2222-2222-2222-222222222222"
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, scope, gomock.Any()).Return(&client.RoleAssignment{ID: ptr.To(assignmentID)}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID, Scope: ptr.To(scope)}}, assignmentID)
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(HaveLen(1))
+		})
+
+		It("should fail if the scope is not located in the resource group of the shoot", func() {
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID, Scope: ptr.To(resourceGroupID + "-other")}})
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(MatchError(ContainSubstring("must be the resource group " + resourceGroupID + " of the shoot or a resource in it")))
+		})
+
+		It("should fail if the role definition does not exist", func() {
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, resourceGroupID, gomock.Any()).Return(nil, nil)
+			fake.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(nil, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Equal(&infraflow.RoleDefinitionNotFoundError{ID: roleDefinitionID}))
+		})
+
+		It("should fail if the principal of the shoot is not permitted to assign roles", func() {
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, resourceGroupID, gomock.Any()).Return(nil, nil)
+			fake.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(&client.RoleDefinition{ID: ptr.To(roleDefinitionID)}, nil)
+			fake.EXPECT().ListPermissions(ctx, resourceGroupID).Return([]client.Permission{{Actions: []string{"Microsoft.Network/*"}}}, nil)
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Equal(&infraflow.RoleAssignmentNotPermittedError{Scope: resourceGroupID}))
+		})
+
+		It("should name the missing permission if the creation is forbidden", func() {
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, resourceGroupID, gomock.Any()).Return(nil, nil)
+			fake.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(&client.RoleDefinition{ID: ptr.To(roleDefinitionID)}, nil)
+			fake.EXPECT().ListPermissions(ctx, resourceGroupID).Return(permitted, nil)
+			fake.EXPECT().Create(ctx, resourceGroupID, gomock.Any(), gomock.Any()).
+				Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden, ErrorCode: "AuthorizationFailed"})
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			err := fctx.EnsureRoleAssignments(ctx)
+			Expect(err).To(MatchError(ContainSubstring("require the " + infraflow.RoleAssignmentWritePermission + " permission")))
+			Expect(client.IsAzureAPIForbidden(err)).To(BeTrue())
+		})
+
+		It("should not add a role assignment to the inventory if the role is already assigned otherwise", func() {
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Get(ctx, resourceGroupID, gomock.Any()).Return(nil, nil)
+			fake.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(&client.RoleDefinition{ID: ptr.To(roleDefinitionID)}, nil)
+			fake.EXPECT().ListPermissions(ctx, resourceGroupID).Return(permitted, nil)
+			fake.EXPECT().Create(ctx, resourceGroupID, gomock.Any(), gomock.Any()).
+				Return(nil, &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: "RoleAssignmentExists"})
+
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+
+		It("should delete role assignments of the inventory which are no longer configured", func() {
+			assignmentID := resourceGroupID + "/providers/Microsoft.Authorization/roleAssignments/22222222-2222-2222-2222-222222222222"
+			factory.EXPECT().RoleAssignments().Return(fake, nil)
+			fake.EXPECT().Delete(ctx, resourceGroupID, "22222222-2222-2222-2222-222222222222").Return(nil)
+
+			fctx := newFlowContext(nil, assignmentID)
+			Expect(fctx.EnsureRoleAssignments(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(BeEmpty())
+		})
+	})
+
+	Describe("#DeleteRoleAssignments", func() {
+		It("should not do anything if no role was assigned by the extension", func() {
+			fctx := newFlowContext([]v1alpha1.IdentityRoleAssignment{{RoleDefinitionID: roleDefinitionID}})
+			Expect(fctx.DeleteRoleAssignments(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#IsPermitted", func() {
+		It("should match the actions with wildcards", func() {
+			Expect(infraflow.IsPermitted([]client.Permission{{Actions: []string{"Microsoft.Authorization/*/Write"}}}, infraflow.RoleAssignmentWritePermission)).To(BeTrue())
+			Expect(infraflow.IsPermitted([]client.Permission{{Actions: []string{"Microsoft.Authorization/*/read"}}}, infraflow.RoleAssignmentWritePermission)).To(BeFalse())
+		})
+
+		It("should exclude the not actions", func() {
+			Expect(infraflow.IsPermitted([]client.Permission{
+				{Actions: []string{"*"}, NotActions: []string{"Microsoft.Authorization/*/Write"}},
+			}, infraflow.RoleAssignmentWritePermission)).To(BeFalse())
+			Expect(infraflow.IsPermitted([]client.Permission{
+				{Actions: []string{"*"}, NotActions: []string{"Microsoft.Authorization/*/Write"}},
+				{Actions: []string{"Microsoft.Authorization/roleAssignments/*"}},
+			}, infraflow.RoleAssignmentWritePermission)).To(BeTrue())
+		})
+	})
+})
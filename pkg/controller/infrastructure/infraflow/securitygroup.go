@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// requiredTraffic is a traffic flow of the nodes which the default rules of a security group allow.
+type requiredTraffic struct {
+	direction armnetwork.SecurityRuleDirection
+	// remote is the address prefix of the other side of the traffic, i.e. the source of inbound and the destination of
+	// outbound traffic.
+	remote      string
+	description string
+}
+
+var requiredSecurityGroupTraffic = []requiredTraffic{
+	{direction: armnetwork.SecurityRuleDirectionInbound, remote: "VirtualNetwork", description: "inbound traffic from the virtual network"},
+	{direction: armnetwork.SecurityRuleDirectionOutbound, remote: "VirtualNetwork", description: "outbound traffic to the virtual network"},
+	{direction: armnetwork.SecurityRuleDirectionOutbound, remote: "Internet", description: "outbound traffic to the internet"},
+}
+
+// EnsureExistingSecurityGroup checks that the existing security group which is referenced by the InfrastructureConfig
+// exists in the region of the shoot. The security group is neither created nor updated, and it is not added to the
+// inventory, so that it is not deleted with the shoot. Rules which deny traffic required by the nodes are only logged,
+// since the security group is managed by its owner.
+func (fctx *FlowContext) EnsureExistingSecurityGroup(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	sgCfg := fctx.adapter.SecurityGroupConfig()
+
+	c, err := fctx.factory.NetworkSecurityGroup()
+	if err != nil {
+		return err
+	}
+
+	sg, err := c.Get(ctx, sgCfg.ResourceGroup, sgCfg.Name)
+	if err != nil {
+		return err
+	}
+	if sg == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the security group %s in resource group %s does not exist", sgCfg.Name, sgCfg.ResourceGroup),
+			gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if location := ptr.Deref(sg.Location, ""); location != sgCfg.Location {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the security group %s is located in %s instead of the region %s of the shoot",
+			sgCfg.Name, location, sgCfg.Location), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	if denied := deniedSecurityGroupTraffic(sg); len(denied) > 0 {
+		log.Info("the existing security group denies traffic which is required by the nodes", "name", sgCfg.Name, "denied", denied)
+	}
+
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(KindSecurityGroup.String(), *sg.ID)
+	return nil
+}
+
+// deniedSecurityGroupTraffic returns the required traffic flows which the given security group denies. For each flow,
+// the rule with the highest precedence which applies to all protocols and ports is evaluated.
+func deniedSecurityGroupTraffic(sg *armnetwork.SecurityGroup) []string {
+	if sg.Properties == nil {
+		return nil
+	}
+
+	rules := slices.Concat(sg.Properties.SecurityRules, sg.Properties.DefaultSecurityRules)
+	rules = slices.DeleteFunc(rules, func(rule *armnetwork.SecurityRule) bool {
+		return rule == nil || rule.Properties == nil
+	})
+	slices.SortStableFunc(rules, func(a, b *armnetwork.SecurityRule) int {
+		return cmp.Compare(ptr.Deref(a.Properties.Priority, 0), ptr.Deref(b.Properties.Priority, 0))
+	})
+
+	var denied []string
+	for _, traffic := range requiredSecurityGroupTraffic {
+		for _, rule := range rules {
+			if !ruleAppliesToTraffic(rule.Properties, traffic) {
+				continue
+			}
+			if ptr.Deref(rule.Properties.Access, "") == armnetwork.SecurityRuleAccessDeny {
+				denied = append(denied, fmt.Sprintf("%s is denied by rule %s", traffic.description, ptr.Deref(rule.Name, "")))
+			}
+			break
+		}
+	}
+	return denied
+}
+
+func ruleAppliesToTraffic(rule *armnetwork.SecurityRulePropertiesFormat, traffic requiredTraffic) bool {
+	if ptr.Deref(rule.Direction, "") != traffic.direction ||
+		ptr.Deref(rule.Protocol, "") != armnetwork.SecurityRuleProtocolAsterisk ||
+		ptr.Deref(rule.DestinationPortRange, "") != "*" {
+		return false
+	}
+
+	remote, local := rule.SourceAddressPrefix, rule.DestinationAddressPrefix
+	remotes, locals := rule.SourceAddressPrefixes, rule.DestinationAddressPrefixes
+	if traffic.direction == armnetwork.SecurityRuleDirectionOutbound {
+		remote, local = local, remote
+		remotes, locals = locals, remotes
+	}
+	return matchesAddressPrefix(remote, remotes, traffic.remote) && matchesAddressPrefix(local, locals, "VirtualNetwork")
+}
+
+func matchesAddressPrefix(prefix *string, prefixes []*string, tag string) bool {
+	for _, p := range append([]*string{prefix}, prefixes...) {
+		if v := ptr.Deref(p, ""); v == "*" || v == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("SecurityGroup", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		region         = "westeurope"
+		securityGroup  = "existing-nsg"
+		securityRG     = "security-rg"
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		securityID     = "/subscriptions/" + subscriptionID + "/resourceGroups/" + securityRG + "/providers/Microsoft.Network/networkSecurityGroups/" + securityGroup
+	)
+
+	var (
+		ctrl                *gomock.Controller
+		ctx                 context.Context
+		logs                []string
+		factory             *mockazureclient.MockFactory
+		securityGroupClient *mockazureclient.MockNetworkSecurityGroup
+		fctx                *infraflow.FlowContext
+	)
+
+	rule := func(name string, priority int32, direction armnetwork.SecurityRuleDirection, access armnetwork.SecurityRuleAccess, source, destination string) *armnetwork.SecurityRule {
+		return &armnetwork.SecurityRule{
+			Name: ptr.To(name),
+			Properties: &armnetwork.SecurityRulePropertiesFormat{
+				Priority:                 ptr.To(priority),
+				Direction:                ptr.To(direction),
+				Access:                   ptr.To(access),
+				Protocol:                 ptr.To(armnetwork.SecurityRuleProtocolAsterisk),
+				SourceAddressPrefix:      ptr.To(source),
+				SourcePortRange:          ptr.To("*"),
+				DestinationAddressPrefix: ptr.To(destination),
+				DestinationPortRange:     ptr.To("*"),
+			},
+		}
+	}
+
+	newSecurityGroup := func(rules ...*armnetwork.SecurityRule) *armnetwork.SecurityGroup {
+		return &armnetwork.SecurityGroup{
+			ID:       ptr.To(securityID),
+			Location: ptr.To(region),
+			Properties: &armnetwork.SecurityGroupPropertiesFormat{
+				SecurityRules: rules,
+				DefaultSecurityRules: []*armnetwork.SecurityRule{
+					rule("AllowVnetInBound", 65000, armnetwork.SecurityRuleDirectionInbound, armnetwork.SecurityRuleAccessAllow, "VirtualNetwork", "VirtualNetwork"),
+					rule("DenyAllInBound", 65500, armnetwork.SecurityRuleDirectionInbound, armnetwork.SecurityRuleAccessDeny, "*", "*"),
+					rule("AllowVnetOutBound", 65000, armnetwork.SecurityRuleDirectionOutbound, armnetwork.SecurityRuleAccessAllow, "VirtualNetwork", "VirtualNetwork"),
+					rule("AllowInternetOutBound", 65001, armnetwork.SecurityRuleDirectionOutbound, armnetwork.SecurityRuleAccessAllow, "*", "Internet"),
+					rule("DenyAllOutBound", 65500, armnetwork.SecurityRuleDirectionOutbound, armnetwork.SecurityRuleAccessDeny, "*", "*"),
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		logs = nil
+		ctx = logr.NewContext(context.TODO(), funcr.New(func(_, args string) { logs = append(logs, args) }, funcr.Options{}))
+		factory = mockazureclient.NewMockFactory(ctrl)
+		securityGroupClient = mockazureclient.NewMockNetworkSecurityGroup(ctrl)
+		factory.EXPECT().NetworkSecurityGroup().Return(securityGroupClient, nil)
+
+		raw, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.NetworkConfig{
+				VNet:          v1alpha1.VNet{CIDR: ptr.To("10.0.0.0/8")},
+				Workers:       ptr.To("10.250.0.0/16"),
+				SecurityGroup: &v1alpha1.SecurityGroupReference{Name: securityGroup, ResourceGroup: securityRG},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fctx, err = infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &client.ClientAuth{SubscriptionID: subscriptionID},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}},
+			State:   &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureSecurityGroup", func() {
+		It("should use the existing security group without updating it or adding it to the inventory", func() {
+			securityGroupClient.EXPECT().Get(ctx, securityRG, securityGroup).Return(newSecurityGroup(), nil)
+
+			Expect(fctx.EnsureSecurityGroup(ctx)).To(Succeed())
+			Expect(fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems).To(BeEmpty())
+			Expect(logs).To(BeEmpty())
+		})
+
+		It("should fail if the existing security group does not exist", func() {
+			securityGroupClient.EXPECT().Get(ctx, securityRG, securityGroup).Return(nil, nil)
+
+			err := fctx.EnsureSecurityGroup(ctx)
+			Expect(err).To(MatchError(ContainSubstring("the security group existing-nsg in resource group security-rg does not exist")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should fail if the existing security group is located in another region", func() {
+			sg := newSecurityGroup()
+			sg.Location = ptr.To("northeurope")
+			securityGroupClient.EXPECT().Get(ctx, securityRG, securityGroup).Return(sg, nil)
+
+			err := fctx.EnsureSecurityGroup(ctx)
+			Expect(err).To(MatchError(ContainSubstring("is located in northeurope instead of the region westeurope")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should warn about rules which deny traffic required by the nodes", func() {
+			securityGroupClient.EXPECT().Get(ctx, securityRG, securityGroup).Return(newSecurityGroup(
+				rule("AllowVnet", 100, armnetwork.SecurityRuleDirectionInbound, armnetwork.SecurityRuleAccessAllow, "VirtualNetwork", "*"),
+				rule("DenyAll", 4096, armnetwork.SecurityRuleDirectionInbound, armnetwork.SecurityRuleAccessDeny, "*", "*"),
+				rule("DenyInternet", 4096, armnetwork.SecurityRuleDirectionOutbound, armnetwork.SecurityRuleAccessDeny, "*", "Internet"),
+			), nil)
+
+			Expect(fctx.EnsureSecurityGroup(ctx)).To(Succeed())
+			Expect(logs).To(ConsistOf(And(
+				ContainSubstring("the existing security group denies traffic which is required by the nodes"),
+				ContainSubstring("outbound traffic to the internet is denied by rule DenyInternet"),
+				Not(ContainSubstring("inbound traffic from the virtual network")),
+			)))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package shared
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -41,6 +42,7 @@ type TaskOption struct {
 	Dependencies []flow.TaskIDer
 	Timeout      time.Duration
 	DoIf         *bool
+	Checkpoint   bool
 }
 
 // Dependencies creates a TaskOption for dependencies
@@ -58,6 +60,19 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: ptr.To(condition)}
 }
 
+// Checkpoint creates a TaskOption which allows to skip the task if it completed in a previous run of the flow.
+func Checkpoint() TaskOption {
+	return TaskOption{Checkpoint: true}
+}
+
+// Checkpoints records the tasks which completed in previous runs of a flow.
+type Checkpoints interface {
+	// Completed returns true if the task with the given name completed in a previous run and can be skipped.
+	Completed(taskName string) bool
+	// Complete records that the task with the given name completed.
+	Complete(taskName string)
+}
+
 // BasicFlowContext provides logic for persisting the state and add tasks to the flow graph.
 type BasicFlowContext struct {
 	log             logr.Logger
@@ -65,6 +80,8 @@ type BasicFlowContext struct {
 	persistorLock   sync.Mutex
 	span            bool
 	persistFn       flow.TaskFn
+	taskTimeouts    map[string]time.Duration
+	checkpoints     Checkpoints
 	PersistInterval time.Duration
 }
 
@@ -95,6 +112,19 @@ func (c *BasicFlowContext) WithPersist(task flow.TaskFn) *BasicFlowContext {
 	return c
 }
 
+// WithTaskTimeouts overrides the timeouts of the tasks with the given names.
+func (c *BasicFlowContext) WithTaskTimeouts(timeouts map[string]time.Duration) *BasicFlowContext {
+	c.taskTimeouts = timeouts
+	return c
+}
+
+// WithCheckpoints records the completion of the tasks added with the Checkpoint option in the given checkpoints, and
+// skips them if they completed in a previous run.
+func (c *BasicFlowContext) WithCheckpoints(checkpoints Checkpoints) *BasicFlowContext {
+	c.checkpoints = checkpoints
+	return c
+}
+
 // PersistState persists the internal state to the provider status.
 func (c *BasicFlowContext) PersistState(ctx context.Context) error {
 	c.persistorLock.Lock()
@@ -121,15 +151,16 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 			condition = condition && *opt.DoIf
 			allOptions.DoIf = ptr.To(condition)
 		}
+		allOptions.Checkpoint = allOptions.Checkpoint || opt.Checkpoint
 	}
 
-	tunedFn := fn
-	if allOptions.Timeout > 0 {
-		tunedFn = tunedFn.Timeout(allOptions.Timeout)
+	if timeout, ok := c.taskTimeouts[name]; ok && timeout > 0 {
+		allOptions.Timeout = timeout
 	}
+
 	task := flow.Task{
 		Name:   name,
-		Fn:     c.wrapTaskFn(g.Name(), name, tunedFn),
+		Fn:     c.wrapTaskFn(g.Name(), name, allOptions.Timeout, allOptions.Checkpoint, fn),
 		SkipIf: allOptions.DoIf != nil && !*allOptions.DoIf,
 	}
 
@@ -140,10 +171,17 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 	return g.Add(task)
 }
 
-// wrapTaskFn sets up the task function fn. It wraps it with the hooks
-func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn) flow.TaskFn {
+// wrapTaskFn sets up the task function fn. It wraps it with the hooks and limits its execution to the given timeout.
+// If checkpoint is true, the task is skipped if it completed in a previous run, and its completion is recorded before
+// the state is persisted.
+func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, timeout time.Duration, checkpoint bool, fn flow.TaskFn) flow.TaskFn {
+	checkpoint = checkpoint && c.checkpoints != nil
 	return func(ctx context.Context) error {
 		log := c.log.WithValues("flow", flowName, "task", taskName)
+		if checkpoint && c.checkpoints.Completed(taskName) {
+			log.Info("skipping task which completed in a previous run")
+			return nil
+		}
 		ctx = logf.IntoContext(ctx, log)
 		if c.persistFn != nil {
 			defer func() {
@@ -162,7 +200,7 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 		if c.span {
 			beforeTs = c.timer.Now()
 		}
-		err := fn(ctx)
+		err := runWithTimeout(ctx, timeout, fn)
 		if c.span {
 			log.Info(fmt.Sprintf("task finished - total execution time: %v", c.timer.Now().Sub(beforeTs)))
 		}
@@ -172,10 +210,31 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 			return err
 		}
 
+		if checkpoint {
+			c.checkpoints.Complete(taskName)
+		}
 		return nil
 	}
 }
 
+// runWithTimeout runs fn with a context that is cancelled after the given timeout, if any. Cancelling the context also
+// stops in-flight pollers of long-running Azure operations. If the task exceeded its own timeout (and not the one of the
+// whole flow), the returned error says so explicitly.
+func runWithTimeout(ctx context.Context, timeout time.Duration, fn flow.TaskFn) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	taskCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := fn(taskCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(taskCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("task timed out after %s, it will be retried with the next reconciliation: %w", timeout, err)
+	}
+	return err
+}
+
 // LogFromContext returns the log from the context when called within a task function added with the `AddTask` method. If no logger is present, a new noop-logger will be returned.
 func LogFromContext(ctx context.Context) logr.Logger {
 	if log, err := logr.FromContext(ctx); err == nil {
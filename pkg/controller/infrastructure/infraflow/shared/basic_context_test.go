@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
@@ -31,6 +32,11 @@ func newTestFlowContext(log logr.Logger, state shared.Whiteboard, persistor flow
 	}
 }
 
+type testCheckpoints map[string]bool
+
+func (c testCheckpoints) Completed(taskName string) bool { return c[taskName] }
+func (c testCheckpoints) Complete(taskName string)       { c[taskName] = true }
+
 var _ = Describe("BasicFlowContext", func() {
 	It("should create and run a graph flow", func() {
 		var (
@@ -102,4 +108,113 @@ var _ = Describe("BasicFlowContext", func() {
 			Expect(persistedData["task3"]).To(Equal("done"))
 		})
 	})
+
+	Context("task timeouts", func() {
+		var (
+			c           *testFlowContext
+			ctx         context.Context
+			waitForDone = func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+		)
+
+		BeforeEach(func() {
+			c = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), func(_ context.Context) error { return nil })
+			ctx = context.Background()
+		})
+
+		It("should fail only the slow task with a clear message and cancel its context", func() {
+			var otherTaskDone bool
+
+			g := flow.NewGraph("test")
+			_ = c.AddTask(g, "slow task", waitForDone, shared.Timeout(10*time.Millisecond))
+			_ = c.AddTask(g, "other task", func(_ context.Context) error {
+				otherTaskDone = true
+				return nil
+			})
+
+			err := flow.Causes(g.Compile().Run(ctx, flow.Opts{}))
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`failed to "slow task"`),
+				ContainSubstring("task timed out after 10ms, it will be retried with the next reconciliation"),
+			)))
+			Expect(otherTaskDone).To(BeTrue())
+		})
+
+		It("should override the timeout of a task by its name", func() {
+			c.WithTaskTimeouts(map[string]time.Duration{"slow task": 20 * time.Millisecond})
+
+			g := flow.NewGraph("test")
+			_ = c.AddTask(g, "slow task", waitForDone, shared.Timeout(time.Hour))
+
+			Expect(g.Compile().Run(ctx, flow.Opts{})).To(MatchError(ContainSubstring("task timed out after 20ms")))
+		})
+
+		It("should not report a task timeout if the flow itself is cancelled", func() {
+			flowCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+
+			g := flow.NewGraph("test")
+			_ = c.AddTask(g, "slow task", waitForDone, shared.Timeout(time.Hour))
+
+			err := g.Compile().Run(flowCtx, flow.Opts{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("task timed out"))
+		})
+	})
+
+	Context("checkpoints", func() {
+		var (
+			c           *testFlowContext
+			ctx         context.Context
+			checkpoints testCheckpoints
+			runs        map[string]int
+			failTask2   bool
+		)
+
+		buildGraph := func() *flow.Graph {
+			task := func(name string, fail *bool) flow.TaskFn {
+				return func(_ context.Context) error {
+					runs[name]++
+					if fail != nil && *fail {
+						return fmt.Errorf("forced error")
+					}
+					return nil
+				}
+			}
+
+			g := flow.NewGraph("test")
+			task1 := c.AddTask(g, "task1", task("task1", nil), shared.Checkpoint())
+			task2 := c.AddTask(g, "task2", task("task2", &failTask2), shared.Checkpoint(), shared.Dependencies(task1))
+			_ = c.AddTask(g, "task3", task("task3", nil), shared.Dependencies(task2))
+			return g
+		}
+
+		BeforeEach(func() {
+			checkpoints = testCheckpoints{}
+			c = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), func(_ context.Context) error { return nil })
+			c.WithCheckpoints(checkpoints)
+			ctx = context.Background()
+			runs = map[string]int{}
+			failTask2 = true
+		})
+
+		It("should resume a failed flow after the last completed task", func() {
+			Expect(buildGraph().Compile().Run(ctx, flow.Opts{})).To(MatchError(ContainSubstring(`failed to "task2"`)))
+			Expect(checkpoints).To(Equal(testCheckpoints{"task1": true}))
+
+			failTask2 = false
+			Expect(buildGraph().Compile().Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(runs).To(Equal(map[string]int{"task1": 1, "task2": 2, "task3": 1}))
+			Expect(checkpoints).To(Equal(testCheckpoints{"task1": true, "task2": true}))
+		})
+
+		It("should always run tasks without the checkpoint option", func() {
+			failTask2 = false
+			Expect(buildGraph().Compile().Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(buildGraph().Compile().Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(runs).To(Equal(map[string]int{"task1": 1, "task2": 1, "task3": 2}))
+		})
+	})
 })
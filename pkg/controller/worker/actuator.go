@@ -6,24 +6,32 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
@@ -32,27 +40,57 @@ type delegateFactory struct {
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
 	gardenReader client.Reader
+	recorder     record.EventRecorder
+
+	marketplaceAgreements *config.MarketplaceAgreements
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, marketplaceAgreements *config.MarketplaceAgreements) worker.Actuator {
 	var (
 		workerDelegate = &delegateFactory{
 			seedClient:   mgr.GetClient(),
 			restConfig:   mgr.GetConfig(),
 			scheme:       mgr.GetScheme(),
 			gardenReader: gardenCluster.GetAPIReader(),
+			recorder:     mgr.GetEventRecorderFor(azure.Name + "-worker-controller"),
+
+			marketplaceAgreements: marketplaceAgreements,
 		}
 	)
 
-	return genericactuator.NewActuator(
-		mgr,
-		gardenCluster,
-		workerDelegate,
-		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
-		},
-	)
+	return &actuator{
+		Actuator: genericactuator.NewActuator(
+			mgr,
+			gardenCluster,
+			workerDelegate,
+			func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		),
+		client:  mgr.GetClient(),
+		decoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		clock:   clock.RealClock{},
+	}
+}
+
+// actuator is an Actuator that additionally reports machine allocation failures as condition of the Worker.
+type actuator struct {
+	worker.Actuator
+	client  client.Client
+	decoder runtime.Decoder
+	clock   clock.Clock
+}
+
+// Reconcile reconciles the given Worker by delegating to the composed Actuator. Afterwards, it updates the
+// MachineAllocation condition so that allocation failures are surfaced even while the reconciliation keeps failing, and
+// the EmptyWorkerPools condition so that worker pools which are scaled to zero are not mistaken for unhealthy ones.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	reconcileErr := a.Actuator.Reconcile(ctx, log, worker, cluster)
+	if err := a.updateConditions(ctx, worker); err != nil {
+		log.Error(err, "Failed to update the conditions of the Worker")
+	}
+	return reconcileErr
 }
 
 func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (genericactuator.WorkerDelegate, error) {
@@ -71,6 +109,16 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
+	clientFactory, err := newClientFactory(ctx, d.seedClient, worker, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkerDelegate(d.seedClient, d.scheme, seedChartApplier, serverVersion.GitVersion, worker, cluster, clientFactory, d.marketplaceAgreements, d.recorder)
+}
+
+// newClientFactory creates a factory for the Azure clients with the credentials of the given Worker.
+func newClientFactory(ctx context.Context, c client.Client, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (azureclient.Factory, error) {
 	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
 		return nil, err
@@ -86,18 +134,17 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
-	clientFactory, err := azureclient.NewAzureClientFactoryFromSecret(
-		ctx,
-		d.seedClient,
-		worker.Spec.SecretRef,
-		false,
-		azureclient.WithCloudConfiguration(azCloudConfiguration),
-	)
+	auth, _, err := azureclient.GetClientAuthData(ctx, c, worker.Spec.SecretRef, false)
 	if err != nil {
 		return nil, err
 	}
+	// The machine-controller-manager reads the client secret from the referenced secret itself.
+	if auth.KeyVaultSecretRef != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("the client secret of secret %s/%s is stored in a Key Vault, which is not supported for the machine-controller-manager",
+			worker.Spec.SecretRef.Namespace, worker.Spec.SecretRef.Name), gardencorev1beta1.ErrorConfigurationProblem)
+	}
 
-	return NewWorkerDelegate(d.seedClient, d.scheme, seedChartApplier, serverVersion.GitVersion, worker, cluster, clientFactory)
+	return azureclient.NewAzureClientFactory(auth, azureclient.WithCloudConfiguration(azCloudConfiguration))
 }
 
 type workerDelegate struct {
@@ -116,8 +163,14 @@ type workerDelegate struct {
 	machineClasses     []map[string]interface{}
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
+	fallbackZones      []api.WorkerPoolFallbackZones
+
+	currentMachineDeployments map[string]machinev1alpha1.MachineDeployment
 
 	clientFactory azureclient.Factory
+
+	marketplaceAgreements *config.MarketplaceAgreements
+	recorder              record.EventRecorder
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -129,6 +182,8 @@ func NewWorkerDelegate(
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
 	factory azureclient.Factory,
+	marketplaceAgreements *config.MarketplaceAgreements,
+	recorder record.EventRecorder,
 ) (genericactuator.WorkerDelegate, error) {
 	config, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -149,5 +204,8 @@ func NewWorkerDelegate(
 		worker:             worker,
 
 		clientFactory: factory,
+
+		marketplaceAgreements: marketplaceAgreements,
+		recorder:              recorder,
 	}, nil
 }
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -34,6 +35,9 @@ type AddOptions struct {
 	ExtensionClass extensionsv1alpha1.ExtensionClass
 	// AutonomousShootCluster indicates whether the extension runs in an autonomous shoot cluster.
 	AutonomousShootCluster bool
+	// MarketplaceAgreements enables the verification of the agreements of the marketplace plans of the machine images.
+	// The agreements are not verified by the extension if it is nil.
+	MarketplaceAgreements *config.MarketplaceAgreements
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -47,14 +51,18 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		return err
 	}
 
-	return worker.Add(ctx, mgr, worker.AddArgs{
-		Actuator:               NewActuator(mgr, opts.GardenCluster),
+	if err := worker.Add(ctx, mgr, worker.AddArgs{
+		Actuator:               NewActuator(mgr, opts.GardenCluster, opts.MarketplaceAgreements),
 		ControllerOptions:      opts.Controller,
 		Predicates:             worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:                   azure.Type,
 		ExtensionClass:         opts.ExtensionClass,
 		AutonomousShootCluster: opts.AutonomousShootCluster,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return AddOutboundPoolControllerToManager(mgr, opts.Controller)
 }
 
 // AddToManager adds a controller with the default Options.
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+)
+
+const (
+	// ConditionTypeMachineAllocation is the type of the Worker condition which reports whether Azure could allocate
+	// the machines of all worker pools.
+	ConditionTypeMachineAllocation gardencorev1beta1.ConditionType = "MachineAllocation"
+
+	// ReasonAllocationFailed is the condition reason used when Azure rejected at least one machine due to missing capacity.
+	ReasonAllocationFailed = "AllocationFailed"
+	// ReasonAllocationSucceeded is the condition reason used when no allocation failures are reported (anymore).
+	ReasonAllocationSucceeded = "AllocationSucceeded"
+)
+
+// MachineAllocationCondition computes the MachineAllocation condition of the given Worker based on the failed machines
+// reported by its machine deployments, including those of the given fallback zones which the worker pools use. It
+// returns nil if there is neither an allocation failure nor an existing condition that needs to be reset.
+func MachineAllocationCondition(clock clock.Clock, worker *extensionsv1alpha1.Worker, machineDeployments []machinev1alpha1.MachineDeployment, fallbackZones []api.WorkerPoolFallbackZones) *gardencorev1beta1.Condition {
+	deployments := make(map[string]machinev1alpha1.MachineDeployment, len(machineDeployments))
+	for _, deployment := range machineDeployments {
+		deployments[deployment.Name] = deployment
+	}
+
+	var (
+		failures []string
+		zonal    bool
+		nonZonal bool
+	)
+
+	for _, pool := range worker.Spec.Pools {
+		deploymentName := fmt.Sprintf("%s-%s", worker.Namespace, pool.Name)
+
+		if len(pool.Zones) == 0 {
+			if failure := allocationFailure(deployments[deploymentName]); failure != "" {
+				failures = append(failures, fmt.Sprintf("worker pool %q could not allocate machines in its availability set: %s", pool.Name, failure))
+				nonZonal = true
+			}
+			continue
+		}
+
+		for _, zone := range pool.Zones {
+			if failure := allocationFailure(deployments[fmt.Sprintf("%s-z%s", deploymentName, zone)]); failure != "" {
+				failures = append(failures, fmt.Sprintf("worker pool %q could not allocate machines in zone %s: %s", pool.Name, zone, failure))
+				zonal = true
+			}
+		}
+
+		for _, fallback := range fallbackZones {
+			if fallback.Name != pool.Name {
+				continue
+			}
+			for _, zone := range fallback.Zones {
+				if failure := allocationFailure(deployments[fmt.Sprintf("%s-z%s", deploymentName, zone)]); failure != "" {
+					failures = append(failures, fmt.Sprintf("worker pool %q could not allocate machines in fallback zone %s: %s", pool.Name, zone, failure))
+					zonal = true
+				}
+			}
+		}
+	}
+
+	existing := v1beta1helper.GetCondition(worker.Status.Conditions, ConditionTypeMachineAllocation)
+	if len(failures) == 0 {
+		if existing == nil {
+			return nil
+		}
+		condition := v1beta1helper.UpdatedConditionWithClock(clock, *existing, gardencorev1beta1.ConditionTrue, ReasonAllocationSucceeded, "Azure allocated the machines of all worker pools.")
+		return &condition
+	}
+
+	var recommendations []string
+	if nonZonal {
+		recommendations = append(recommendations, "migrate non-zonal worker pools to availability zones")
+	}
+	if zonal {
+		recommendations = append(recommendations, "remove the affected zones from the worker pools")
+	}
+	recommendations = append(recommendations, "choose a machine type with available capacity")
+
+	message := fmt.Sprintf("Azure has no capacity left for some machines, retrying will not succeed until capacity is restored. %s. Consider to %s.",
+		strings.Join(failures, "; "), strings.Join(recommendations, " or "))
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, worker.Status.Conditions, ConditionTypeMachineAllocation)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionFalse, ReasonAllocationFailed, message, gardencorev1beta1.ErrorInfraResourcesDepleted)
+	return &condition
+}
+
+// allocationFailedMachines returns the number of machines of the given deployment which failed due to missing capacity.
+func allocationFailedMachines(deployment machinev1alpha1.MachineDeployment) int32 {
+	var count int32
+	for _, machine := range deployment.Status.FailedMachines {
+		if machine != nil && helper.IsAllocationFailure(machine.LastOperation.Description) {
+			count++
+		}
+	}
+	return count
+}
+
+// allocationFailure returns a summary of the machines of the given deployment which failed due to missing capacity.
+func allocationFailure(deployment machinev1alpha1.MachineDeployment) string {
+	var (
+		machines    []string
+		description string
+	)
+
+	for _, machine := range deployment.Status.FailedMachines {
+		if machine == nil || !helper.IsAllocationFailure(machine.LastOperation.Description) {
+			continue
+		}
+		machines = append(machines, fmt.Sprintf("%q", machine.Name))
+		description = machine.LastOperation.Description
+	}
+
+	if len(machines) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("machine(s) %s failed with %s", strings.Join(machines, ", "), description)
+}
+
+func (a *actuator) updateConditions(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, machineDeployments, client.InNamespace(worker.Namespace)); err != nil {
+		return fmt.Errorf("failed to list machine deployments: %w", err)
+	}
+
+	workerStatus := &api.WorkerStatus{}
+	if worker.Status.ProviderStatus != nil {
+		if _, _, err := a.decoder.Decode(worker.Status.ProviderStatus.Raw, nil, workerStatus); err != nil {
+			return fmt.Errorf("could not decode the worker provider status of worker '%s': %w", client.ObjectKeyFromObject(worker), err)
+		}
+	}
+
+	var updated []gardencorev1beta1.Condition
+	for _, condition := range []*gardencorev1beta1.Condition{
+		MachineAllocationCondition(a.clock, worker, machineDeployments.Items, workerStatus.FallbackZones),
+		EmptyWorkerPoolsCondition(a.clock, worker, machineDeployments.Items),
+	} {
+		if condition != nil {
+			updated = append(updated, *condition)
+		}
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+
+	conditions := v1beta1helper.MergeConditions(worker.Status.Conditions, updated...)
+	if !v1beta1helper.ConditionsNeedUpdate(worker.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.Conditions = conditions
+	return a.client.Status().Patch(ctx, worker, patch)
+}
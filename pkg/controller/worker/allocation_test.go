@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("MachineAllocation", func() {
+	const namespace = "shoot--foobar--azure"
+
+	var (
+		fakeClock *testclock.FakeClock
+		w         *extensionsv1alpha1.Worker
+
+		failedDeployment = func(name string, descriptions ...string) machinev1alpha1.MachineDeployment {
+			deployment := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			for i, description := range descriptions {
+				deployment.Status.FailedMachines = append(deployment.Status.FailedMachines, &machinev1alpha1.MachineSummary{
+					Name: name + "-" + string(rune('a'+i)),
+					LastOperation: machinev1alpha1.LastOperation{
+						Description: description,
+						State:       machinev1alpha1.MachineStateFailed,
+					},
+				})
+			}
+			return deployment
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{Name: "non-zonal"},
+					{Name: "zonal", Zones: []string{"1", "2"}},
+				},
+			},
+		}
+	})
+
+	Describe("#MachineAllocationCondition", func() {
+		It("should return nil if there are no failures and no existing condition", func() {
+			Expect(MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace + "-non-zonal"),
+			}, nil)).To(BeNil())
+		})
+
+		It("should ignore machines which failed for other reasons", func() {
+			Expect(MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace+"-non-zonal", "Code=\"InvalidParameter\" Message=\"invalid image\""),
+			}, nil)).To(BeNil())
+		})
+
+		It("should report an allocation failure of a non-zonal pool", func() {
+			condition := MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace+"-non-zonal", "Code=\"AllocationFailed\" Message=\"Allocation failed.\""),
+			}, nil)
+
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Type).To(Equal(ConditionTypeMachineAllocation))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonAllocationFailed))
+			Expect(condition.Codes).To(ConsistOf(gardencorev1beta1.ErrorInfraResourcesDepleted))
+			Expect(condition.Message).To(ContainSubstring(`worker pool "non-zonal" could not allocate machines in its availability set`))
+			Expect(condition.Message).To(ContainSubstring("AllocationFailed"))
+			Expect(condition.Message).To(ContainSubstring("migrate non-zonal worker pools to availability zones"))
+			Expect(condition.Message).NotTo(ContainSubstring("remove the affected zones"))
+		})
+
+		It("should report an allocation failure of a zone", func() {
+			condition := MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace + "-zonal-z1"),
+				failedDeployment(namespace+"-zonal-z2", "Code=\"ZonalAllocationFailed\"", "Code=\"OverconstrainedZonalAllocationRequest\""),
+			}, nil)
+
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring(`worker pool "zonal" could not allocate machines in zone 2`))
+			Expect(condition.Message).To(ContainSubstring(`"` + namespace + `-zonal-z2-a", "` + namespace + `-zonal-z2-b"`))
+			Expect(condition.Message).NotTo(ContainSubstring("zone 1"))
+			Expect(condition.Message).To(ContainSubstring("remove the affected zones from the worker pools"))
+		})
+
+		It("should report an allocation failure of a fallback zone", func() {
+			condition := MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace+"-zonal-z1", "Code=\"ZonalAllocationFailed\""),
+				failedDeployment(namespace+"-zonal-z2", "Code=\"ZonalAllocationFailed\""),
+				failedDeployment(namespace+"-zonal-z3", "Code=\"ZonalAllocationFailed\""),
+				failedDeployment(namespace+"-non-zonal-z3", "Code=\"ZonalAllocationFailed\""),
+			}, []apiazure.WorkerPoolFallbackZones{{Name: "zonal", Zones: []string{"3"}}})
+
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring(`worker pool "zonal" could not allocate machines in zone 2`))
+			Expect(condition.Message).To(ContainSubstring(`worker pool "zonal" could not allocate machines in fallback zone 3`))
+			Expect(condition.Message).NotTo(ContainSubstring(`worker pool "non-zonal"`))
+		})
+
+		It("should ignore machine deployments which do not belong to a worker pool", func() {
+			Expect(MachineAllocationCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				failedDeployment(namespace+"-removed", "Code=\"AllocationFailed\""),
+			}, nil)).To(BeNil())
+		})
+
+		It("should reset an existing condition once the failures are gone", func() {
+			w.Status.Conditions = []gardencorev1beta1.Condition{{
+				Type:   ConditionTypeMachineAllocation,
+				Status: gardencorev1beta1.ConditionFalse,
+				Reason: ReasonAllocationFailed,
+			}}
+
+			condition := MachineAllocationCondition(fakeClock, w, nil, nil)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonAllocationSucceeded))
+			Expect(condition.LastTransitionTime.Time).To(Equal(fakeClock.Now()))
+		})
+	})
+})
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"k8s.io/utils/ptr"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// bootDiagnosticsContainerPrefix is the prefix of the containers in which Azure stores the boot diagnostics of virtual
+// machines. The lifecycle rule is restricted to these containers, so that other blobs of the storage account are kept.
+const bootDiagnosticsContainerPrefix = "bootdiagnostics-"
+
+// reconcileBootDiagnosticsRetention adds a lifecycle rule which deletes old boot diagnostics blobs to the storage
+// accounts of the worker pools which configure a retention. The rule is removed from the storage accounts which are no
+// longer configured, and from all storage accounts once the Worker is deleted. The storage accounts which carry the rule
+// are recorded in the provider status, since the configuration of the worker pools does not tell them after a change.
+func (w *workerDelegate) reconcileBootDiagnosticsRetention(ctx context.Context, workerStatus *azureapi.WorkerStatus) error {
+	retentions, err := w.bootDiagnosticsRetentions()
+	if err != nil {
+		return err
+	}
+	if len(retentions) == 0 && len(workerStatus.BootDiagnosticsStorageAccounts) == 0 {
+		return nil
+	}
+
+	policiesClient, err := w.clientFactory.ManagementPolicies()
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs     []error
+		accounts = slices.SortedFunc(maps.Keys(retentions), compareStorageAccounts)
+	)
+	for _, account := range accounts {
+		if err := w.setBootDiagnosticsRule(ctx, policiesClient, account, ptr.To(retentions[account])); err != nil {
+			errs = append(errs, fmt.Errorf("failed to configure the retention of the boot diagnostics in storage account %s: %w", account.Name, err))
+		}
+	}
+	for _, account := range workerStatus.BootDiagnosticsStorageAccounts {
+		if _, ok := retentions[account]; ok {
+			continue
+		}
+		if err := w.setBootDiagnosticsRule(ctx, policiesClient, account, nil); err != nil {
+			// the storage account is kept in the status, so that the removal is retried.
+			accounts = append(accounts, account)
+			errs = append(errs, fmt.Errorf("failed to remove the retention of the boot diagnostics from storage account %s: %w", account.Name, err))
+		}
+	}
+
+	if !slices.Equal(accounts, workerStatus.BootDiagnosticsStorageAccounts) {
+		workerStatus.BootDiagnosticsStorageAccounts = accounts
+		if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// bootDiagnosticsRetentions returns the retention days of the boot diagnostics per storage account. Worker pools which
+// share a storage account get the longest of their retentions, so that no blob is deleted earlier than configured.
+func (w *workerDelegate) bootDiagnosticsRetentions() (map[azureapi.StorageAccountReference]int32, error) {
+	retentions := map[azureapi.StorageAccountReference]int32{}
+	if w.worker.DeletionTimestamp != nil {
+		return retentions, nil
+	}
+
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, err
+		}
+		profile := workerConfig.DiagnosticsProfile
+		if profile == nil || !profile.Enabled || profile.StorageURI == nil || profile.Retention == nil {
+			continue
+		}
+
+		name, err := storageAccountName(*profile.StorageURI)
+		if err != nil {
+			return nil, err
+		}
+		account := azureapi.StorageAccountReference{Name: name, ResourceGroup: profile.Retention.ResourceGroup}
+		retentions[account] = max(retentions[account], profile.Retention.Days)
+	}
+	return retentions, nil
+}
+
+// setBootDiagnosticsRule sets the lifecycle rule of the Worker in the lifecycle policy of the given storage account to
+// the given retention days or removes it if no retention is given. The other rules of the policy are kept.
+func (w *workerDelegate) setBootDiagnosticsRule(ctx context.Context, policiesClient azureclient.ManagementPolicies, account azureapi.StorageAccountReference, days *int32) error {
+	policy, err := policiesClient.Get(ctx, account.ResourceGroup, account.Name)
+	if err != nil {
+		return err
+	}
+
+	var rules []*armstorage.ManagementPolicyRule
+	if policy != nil && policy.Properties != nil && policy.Properties.Policy != nil {
+		rules = policy.Properties.Policy.Rules
+	}
+
+	name := w.bootDiagnosticsRuleName()
+	index := slices.IndexFunc(rules, func(rule *armstorage.ManagementPolicyRule) bool {
+		return rule != nil && ptr.Deref(rule.Name, "") == name
+	})
+
+	switch {
+	case days == nil && index < 0:
+		return nil
+	case days == nil:
+		rules = slices.Delete(slices.Clone(rules), index, index+1)
+	case index < 0:
+		rules = append(slices.Clone(rules), bootDiagnosticsRule(name, *days))
+	case reflect.DeepEqual(rules[index], bootDiagnosticsRule(name, *days)):
+		return nil
+	default:
+		rules = slices.Clone(rules)
+		rules[index] = bootDiagnosticsRule(name, *days)
+	}
+
+	// Azure rejects lifecycle policies without rules.
+	if len(rules) == 0 {
+		return policiesClient.Delete(ctx, account.ResourceGroup, account.Name)
+	}
+	return policiesClient.Update(ctx, account.ResourceGroup, account.Name, armstorage.ManagementPolicy{
+		Properties: &armstorage.ManagementPolicyProperties{
+			Policy: &armstorage.ManagementPolicySchema{Rules: rules},
+		},
+	})
+}
+
+// bootDiagnosticsRuleName returns the name of the lifecycle rule of the Worker, which is unique among the shoots which
+// share a storage account.
+func (w *workerDelegate) bootDiagnosticsRuleName() string {
+	return fmt.Sprintf("%s-boot-diagnostics", w.worker.Namespace)
+}
+
+// bootDiagnosticsRule returns a lifecycle rule which deletes the blobs in the boot diagnostics containers the given
+// number of days after their last modification.
+func bootDiagnosticsRule(name string, days int32) *armstorage.ManagementPolicyRule {
+	return &armstorage.ManagementPolicyRule{
+		Name:    ptr.To(name),
+		Type:    ptr.To(armstorage.RuleTypeLifecycle),
+		Enabled: ptr.To(true),
+		Definition: &armstorage.ManagementPolicyDefinition{
+			Actions: &armstorage.ManagementPolicyAction{
+				BaseBlob: &armstorage.ManagementPolicyBaseBlob{
+					Delete: &armstorage.DateAfterModification{
+						DaysAfterModificationGreaterThan: ptr.To(float32(days)),
+					},
+				},
+			},
+			Filters: &armstorage.ManagementPolicyFilter{
+				// the SDK does not expose constants for the blob types
+				BlobTypes:   []*string{ptr.To("blockBlob"), ptr.To("appendBlob")},
+				PrefixMatch: []*string{ptr.To(bootDiagnosticsContainerPrefix)},
+			},
+		},
+	}
+}
+
+// storageAccountName returns the name of the storage account of the given blob storage URI.
+func storageAccountName(storageURI string) (string, error) {
+	u, err := url.Parse(storageURI)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the storage URI %q: %w", storageURI, err)
+	}
+	name, _, found := strings.Cut(u.Hostname(), ".")
+	if !found || name == "" {
+		return "", fmt.Errorf("the storage URI %q does not contain a storage account", storageURI)
+	}
+	return name, nil
+}
+
+func compareStorageAccounts(a, b azureapi.StorageAccountReference) int {
+	return cmp.Or(cmp.Compare(a.ResourceGroup, b.ResourceGroup), cmp.Compare(a.Name, b.Name))
+}
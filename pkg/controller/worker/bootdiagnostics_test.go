@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("BootDiagnosticsRetention", func() {
+	const (
+		namespace     = "shoot--foobar--azure"
+		region        = "westeurope"
+		resourceGroup = "diagnostics-rg"
+		account       = "diagnostics"
+		ruleName      = namespace + "-boot-diagnostics"
+	)
+
+	var (
+		ctx            context.Context
+		ctrl           *gomock.Controller
+		c              *mockclient.MockClient
+		statusWriter   *mockclient.MockStatusWriter
+		factory        *mockazureclient.MockFactory
+		policiesClient *mockazureclient.MockManagementPolicies
+
+		cluster *extensionscontroller.Cluster
+		w       *extensionsv1alpha1.Worker
+	)
+
+	newWorkerDelegate := func() genericactuator.WorkerDelegate {
+		expectGetSecretCallToWork(c, w)
+
+		scheme := runtime.NewScheme()
+		Expect(apiazure.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		workerDelegate, err := NewWorkerDelegate(c, scheme, nil, "", w, cluster, factory, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return workerDelegate
+	}
+
+	workerPool := func(name string, retention *v1alpha1.BootDiagnosticsRetention) extensionsv1alpha1.WorkerPool {
+		raw, err := json.Marshal(&v1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+			DiagnosticsProfile: &v1alpha1.DiagnosticsProfile{
+				Enabled:    true,
+				StorageURI: ptr.To("https://" + account + ".blob.core.windows.net/"),
+				Retention:  retention,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return extensionsv1alpha1.WorkerPool{
+			Name:           name,
+			MachineImage:   extensionsv1alpha1.MachineImage{Name: "image", Version: "1.0.0"},
+			ProviderConfig: &runtime.RawExtension{Raw: raw},
+		}
+	}
+
+	rule := func(name string, days float32) *armstorage.ManagementPolicyRule {
+		return &armstorage.ManagementPolicyRule{
+			Name:    ptr.To(name),
+			Type:    ptr.To(armstorage.RuleTypeLifecycle),
+			Enabled: ptr.To(true),
+			Definition: &armstorage.ManagementPolicyDefinition{
+				Actions: &armstorage.ManagementPolicyAction{
+					BaseBlob: &armstorage.ManagementPolicyBaseBlob{
+						Delete: &armstorage.DateAfterModification{DaysAfterModificationGreaterThan: ptr.To(days)},
+					},
+				},
+				Filters: &armstorage.ManagementPolicyFilter{
+					BlobTypes:   []*string{ptr.To("blockBlob"), ptr.To("appendBlob")},
+					PrefixMatch: []*string{ptr.To("bootdiagnostics-")},
+				},
+			},
+		}
+	}
+
+	policy := func(rules ...*armstorage.ManagementPolicyRule) *armstorage.ManagementPolicy {
+		return &armstorage.ManagementPolicy{Properties: &armstorage.ManagementPolicyProperties{
+			Policy: &armstorage.ManagementPolicySchema{Rules: rules},
+		}}
+	}
+
+	setWorkerStatus := func(accounts ...v1alpha1.StorageAccountReference) {
+		raw, err := json.Marshal(&v1alpha1.WorkerStatus{
+			TypeMeta:                       metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+			BootDiagnosticsStorageAccounts: accounts,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		w.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+	}
+
+	expectWorkerStatus := func(accounts ...v1alpha1.StorageAccountReference) {
+		statusWriter.EXPECT().Patch(ctx, w, gomock.Any()).DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+			Expect(obj.Status.ProviderStatus.Object.(*v1alpha1.WorkerStatus).BootDiagnosticsStorageAccounts).To(ConsistOf(accounts))
+			return nil
+		})
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
+		factory = mockazureclient.NewMockFactory(ctrl)
+		policiesClient = mockazureclient.NewMockManagementPolicies(ctrl)
+
+		c.EXPECT().Status().AnyTimes().Return(statusWriter)
+
+		cluster = makeCluster("", region, nil, []v1alpha1.MachineImages{{
+			Name:     "image",
+			Versions: []v1alpha1.MachineImageVersion{{Version: "1.0.0", ID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/images/image")}},
+		}}, 3)
+		w = makeWorker(namespace, region, nil, nil, workerPool("pool", nil))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not configure any storage account if no retention is configured", func() {
+		Expect(newWorkerDelegate().PreReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should add the rule to the lifecycle policy and keep the rules of others", func() {
+		w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+			workerPool("pool-a", &v1alpha1.BootDiagnosticsRetention{ResourceGroup: resourceGroup, Days: 7}),
+			workerPool("pool-b", &v1alpha1.BootDiagnosticsRetention{ResourceGroup: resourceGroup, Days: 30}),
+		}
+
+		factory.EXPECT().ManagementPolicies().Return(policiesClient, nil)
+		policiesClient.EXPECT().Get(ctx, resourceGroup, account).Return(policy(rule("other", 1)), nil)
+		policiesClient.EXPECT().Update(ctx, resourceGroup, account, *policy(rule("other", 1), rule(ruleName, 30))).Return(nil)
+		expectWorkerStatus(v1alpha1.StorageAccountReference{Name: account, ResourceGroup: resourceGroup})
+
+		Expect(newWorkerDelegate().PreReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should not update the lifecycle policy if the rule is up to date", func() {
+		w.Spec.Pools = []extensionsv1alpha1.WorkerPool{workerPool("pool", &v1alpha1.BootDiagnosticsRetention{ResourceGroup: resourceGroup, Days: 7})}
+		setWorkerStatus(v1alpha1.StorageAccountReference{Name: account, ResourceGroup: resourceGroup})
+
+		factory.EXPECT().ManagementPolicies().Return(policiesClient, nil)
+		policiesClient.EXPECT().Get(ctx, resourceGroup, account).Return(policy(rule(ruleName, 7)), nil)
+
+		Expect(newWorkerDelegate().PreReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should remove the rule from storage accounts which are no longer configured", func() {
+		setWorkerStatus(v1alpha1.StorageAccountReference{Name: "old", ResourceGroup: resourceGroup})
+
+		factory.EXPECT().ManagementPolicies().Return(policiesClient, nil)
+		policiesClient.EXPECT().Get(ctx, resourceGroup, "old").Return(policy(rule("other", 1), rule(ruleName, 7)), nil)
+		policiesClient.EXPECT().Update(ctx, resourceGroup, "old", *policy(rule("other", 1))).Return(nil)
+		expectWorkerStatus()
+
+		Expect(newWorkerDelegate().PreReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should keep the storage account in the status if the rule cannot be removed", func() {
+		setWorkerStatus(v1alpha1.StorageAccountReference{Name: "old", ResourceGroup: resourceGroup})
+
+		factory.EXPECT().ManagementPolicies().Return(policiesClient, nil)
+		policiesClient.EXPECT().Get(ctx, resourceGroup, "old").Return(nil, errors.New("forbidden"))
+
+		Expect(newWorkerDelegate().PreReconcileHook(ctx)).To(MatchError(ContainSubstring("forbidden")))
+	})
+
+	It("should delete the lifecycle policy on deletion if only the rule of the Worker is left", func() {
+		w.Spec.Pools = []extensionsv1alpha1.WorkerPool{workerPool("pool", &v1alpha1.BootDiagnosticsRetention{ResourceGroup: resourceGroup, Days: 7})}
+		w.DeletionTimestamp = ptr.To(metav1.Now())
+		setWorkerStatus(v1alpha1.StorageAccountReference{Name: account, ResourceGroup: resourceGroup})
+
+		factory.EXPECT().ManagementPolicies().Return(policiesClient, nil)
+		policiesClient.EXPECT().Get(ctx, resourceGroup, account).Return(policy(rule(ruleName, 7)), nil)
+		policiesClient.EXPECT().Delete(ctx, resourceGroup, account).Return(nil)
+		expectWorkerStatus()
+
+		Expect(newWorkerDelegate().PostDeleteHook(ctx)).To(Succeed())
+	})
+})
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// diskPerformance is the provisioned performance of a data disk.
+type diskPerformance struct {
+	iops       *int64
+	throughput *int64
+}
+
+// reconcileDataDiskPerformance adjusts the provisioned IOPS and throughput of the Ultra data disks of the machines to the
+// values of the WorkerConfig of their worker pool. Azure adjusts the performance of Ultra disks online, hence the
+// machines are neither replaced nor restarted. Only the machines of the current machine classes are considered, since
+// the data disks of machines which are still to be replaced might differ, and disks which already provide the
+// configured performance are not updated.
+func (w *workerDelegate) reconcileDataDiskPerformance(ctx context.Context) error {
+	performanceByPool := map[string]map[int32]diskPerformance{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		if performance := dataDiskPerformanceByLun(pool, workerConfig.DataVolumes); len(performance) > 0 {
+			performanceByPool[pool.Name] = performance
+		}
+	}
+	if len(performanceByPool) == 0 {
+		return nil
+	}
+
+	machineDeployments, err := w.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	performanceByClass := map[string]map[int32]diskPerformance{}
+	for _, machineDeployment := range machineDeployments {
+		if performance, ok := performanceByPool[machineDeployment.PoolName]; ok {
+			performanceByClass[machineDeployment.ClassName] = performance
+		}
+	}
+
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	if err != nil {
+		return err
+	}
+	machines := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machines, client.InNamespace(w.worker.Namespace)); err != nil {
+		return err
+	}
+
+	var (
+		vmClient   azureclient.VirtualMachine
+		diskClient azureclient.Disk
+	)
+	for _, machine := range machines.Items {
+		performance, ok := performanceByClass[machine.Spec.Class.Name]
+		if !ok {
+			continue
+		}
+
+		if vmClient == nil {
+			if vmClient, err = w.clientFactory.VirtualMachine(); err != nil {
+				return err
+			}
+			if diskClient, err = w.clientFactory.Disk(); err != nil {
+				return err
+			}
+		}
+
+		vm, err := vmClient.Get(ctx, infrastructureStatus.ResourceGroup.Name, machine.Name, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get the virtual machine of machine %q: %w", machine.Name, err)
+		}
+		// The virtual machine might not be created yet, its disks are adjusted with the next reconciliation.
+		if vm == nil || vm.Properties == nil || vm.Properties.StorageProfile == nil {
+			continue
+		}
+
+		for _, dataDisk := range vm.Properties.StorageProfile.DataDisks {
+			diskPerformance, ok := performance[ptr.Deref(dataDisk.Lun, -1)]
+			if !ok || dataDisk.ManagedDisk == nil || dataDisk.ManagedDisk.ID == nil {
+				continue
+			}
+			if err := reconcileDiskPerformance(ctx, diskClient, *dataDisk.ManagedDisk.ID, diskPerformance); err != nil {
+				return fmt.Errorf("failed to adjust the performance of the data disk with LUN %d of machine %q: %w", *dataDisk.Lun, machine.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileDiskPerformance updates the provisioned IOPS and throughput of the disk with the given ID if they differ from
+// the given performance.
+func reconcileDiskPerformance(ctx context.Context, diskClient azureclient.Disk, diskID string, performance diskPerformance) error {
+	resourceID, err := arm.ParseResourceID(diskID)
+	if err != nil {
+		return err
+	}
+	disk, err := diskClient.Get(ctx, resourceID.ResourceGroupName, resourceID.Name)
+	if err != nil || disk == nil || disk.Properties == nil {
+		return err
+	}
+
+	var (
+		properties = &armcompute.DiskUpdateProperties{}
+		changed    bool
+	)
+	if performance.iops != nil && ptr.Deref(disk.Properties.DiskIOPSReadWrite, 0) != *performance.iops {
+		properties.DiskIOPSReadWrite, changed = performance.iops, true
+	}
+	if performance.throughput != nil && ptr.Deref(disk.Properties.DiskMBpsReadWrite, 0) != *performance.throughput {
+		properties.DiskMBpsReadWrite, changed = performance.throughput, true
+	}
+	if !changed {
+		return nil
+	}
+
+	_, err = diskClient.Update(ctx, resourceID.ResourceGroupName, resourceID.Name, armcompute.DiskUpdate{Properties: properties})
+	return err
+}
+
+// dataDiskPerformanceByLun returns the provisioned performance of the data volumes of the given worker pool by the LUN
+// of their data disks. The LUNs are assigned in the order of the names of the data volumes like for the machine class.
+func dataDiskPerformanceByLun(pool extensionsv1alpha1.WorkerPool, dataVolumeConfigs []azureapi.DataVolume) map[int32]diskPerformance {
+	dataVolumes := append([]extensionsv1alpha1.DataVolume(nil), pool.DataVolumes...)
+	sort.Slice(dataVolumes, func(i, j int) bool {
+		return dataVolumes[i].Name < dataVolumes[j].Name
+	})
+
+	performance := map[int32]diskPerformance{}
+	for i, dataVolume := range dataVolumes {
+		for _, config := range dataVolumeConfigs {
+			if config.Name == dataVolume.Name && (config.ProvisionedIops != nil || config.ProvisionedThroughput != nil) {
+				performance[int32(i)] = diskPerformance{iops: config.ProvisionedIops, throughput: config.ProvisionedThroughput} // #nosec: G115 - The number of data volumes is limited.
+			}
+		}
+	}
+	return performance
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// getFallbackZones returns the fallback zones which the given worker pool uses in addition to its zones. The fallback
+// zones which are recorded in the worker status stay in use as long as they are configured. The next fallback zone is
+// taken into use if Azure cannot allocate machines in any of the zones which are used so far.
+func (w *workerDelegate) getFallbackZones(ctx context.Context, pool extensionsv1alpha1.WorkerPool, workerConfig *azureapi.WorkerConfig, workerStatus *azureapi.WorkerStatus) ([]string, error) {
+	if len(workerConfig.FallbackZones) == 0 || len(pool.Zones) == 0 {
+		return nil, nil
+	}
+
+	var active []string
+	for _, fallback := range workerStatus.FallbackZones {
+		if fallback.Name == pool.Name {
+			active = fallback.Zones
+		}
+	}
+
+	deployments, err := w.getMachineDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return selectFallbackZones(fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name), pool.Zones, workerConfig.FallbackZones, active, deployments), nil
+}
+
+// getFallbackDemand returns the number of machines which the given fallback zones of the worker pool get, i.e. the
+// machines which Azure cannot allocate in the zones of the worker pool. The machine-controller-manager replaces the
+// failed machines of the zones of the worker pool, hence they come and go while the capacity shortage lasts. The demand
+// is therefore recorded in the worker status and only raised while the zones of the worker pool report failed
+// machines, and it is only lowered once the machines of the zones of the worker pool are available. The fallback zones
+// thereby only carry the additional demand and are scaled down again once the zones of the worker pool allocated the
+// machines.
+func (w *workerDelegate) getFallbackDemand(ctx context.Context, pool extensionsv1alpha1.WorkerPool, fallbackZones []string, workerStatus *azureapi.WorkerStatus) (int32, error) {
+	if len(fallbackZones) == 0 {
+		return 0, nil
+	}
+
+	var recorded int32
+	for _, fallback := range workerStatus.FallbackZones {
+		if fallback.Name == pool.Name {
+			recorded = fallback.Demand
+		}
+	}
+
+	deployments, err := w.getMachineDeployments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var failed, missing int32
+	for _, zone := range pool.Zones {
+		deployment := deployments[fmt.Sprintf("%s-%s-z%s", w.worker.Namespace, pool.Name, zone)]
+		failed += allocationFailedMachines(deployment)
+		missing += missingMachines(deployment)
+	}
+	return nextFallbackDemand(recorded, failed, missing), nil
+}
+
+// getMachineDeployments returns the current machine deployments of the worker by their name.
+func (w *workerDelegate) getMachineDeployments(ctx context.Context) (map[string]machinev1alpha1.MachineDeployment, error) {
+	if w.currentMachineDeployments != nil {
+		return w.currentMachineDeployments, nil
+	}
+
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.client.List(ctx, machineDeployments, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list machine deployments: %w", err)
+	}
+
+	w.currentMachineDeployments = make(map[string]machinev1alpha1.MachineDeployment, len(machineDeployments.Items))
+	for _, deployment := range machineDeployments.Items {
+		w.currentMachineDeployments[deployment.Name] = deployment
+	}
+	return w.currentMachineDeployments, nil
+}
+
+// selectFallbackZones returns the fallback zones of the worker pool with the given machine deployment name prefix. The
+// active fallback zones are kept in the configured order, and the next configured fallback zone is added if all zones
+// which are in use report machines that failed due to missing capacity.
+func selectFallbackZones(deploymentName string, zones, fallbackZones, active []string, deployments map[string]machinev1alpha1.MachineDeployment) []string {
+	var selected []string
+	for _, zone := range fallbackZones {
+		if slices.Contains(active, zone) {
+			selected = append(selected, zone)
+		}
+	}
+	if len(selected) == len(fallbackZones) {
+		return selected
+	}
+
+	for _, zone := range append(slices.Clone(zones), selected...) {
+		if allocationFailure(deployments[fmt.Sprintf("%s-z%s", deploymentName, zone)]) == "" {
+			return selected
+		}
+	}
+
+	for _, zone := range fallbackZones {
+		if !slices.Contains(selected, zone) {
+			// the fallback zones keep their configured order, independent of when they were taken into use.
+			return slices.DeleteFunc(slices.Clone(fallbackZones), func(z string) bool {
+				return z != zone && !slices.Contains(selected, z)
+			})
+		}
+	}
+	return selected
+}
+
+// missingMachines returns the number of machines of the given deployment which are not available, but at least the
+// number of machines which failed due to missing capacity.
+func missingMachines(deployment machinev1alpha1.MachineDeployment) int32 {
+	return max(deployment.Spec.Replicas-deployment.Status.AvailableReplicas, allocationFailedMachines(deployment), 0)
+}
+
+// nextFallbackDemand returns the demand of the fallback zones based on the recorded demand and the machines of the
+// zones of the worker pool which failed due to missing capacity or are missing. The demand is raised to the missing
+// machines while machines fail, and it is lowered to them while no machine fails, so it is neither lowered because the
+// failed machines are replaced nor raised because machines are created during a scale-out or rolling update.
+func nextFallbackDemand(recorded, failed, missing int32) int32 {
+	if failed > 0 {
+		return max(recorded, missing)
+	}
+	return min(recorded, missing)
+}
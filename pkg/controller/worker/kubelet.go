@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// kubeletExtraArgsPath is the path of the environment file of the kubelet unit, whose KUBELET_EXTRA_ARGS variable is
+// appended to the command line flags of the kubelet.
+const kubeletExtraArgsPath = "/var/lib/kubelet/extra_args"
+
+// injectKubeletConfig adds the given kubelet configuration to the given user data. The settings are passed to the
+// kubelet as command line flags, which take precedence over the kubelet configuration file rendered by Gardener. The
+// user data must be a shell script. The kubelet configuration must be validated beforehand, because it is embedded into
+// the script.
+func injectKubeletConfig(userData []byte, kubeletConfig *azureapi.KubeletConfig) ([]byte, error) {
+	flags := kubeletFlags(kubeletConfig)
+	if len(flags) == 0 {
+		return userData, nil
+	}
+	if !bytes.HasPrefix(userData, []byte("#!")) {
+		return nil, fmt.Errorf("the kubelet configuration can only be overridden if the user data is a shell script")
+	}
+
+	shebang, rest, _ := bytes.Cut(userData, []byte("\n"))
+
+	var script strings.Builder
+	script.Write(shebang)
+	script.WriteString("\n")
+	fmt.Fprintf(&script, `# override the kubelet configuration of the worker pool
+mkdir -p "$(dirname %[1]s)"
+cat <<'KUBELET_EOF' > %[1]s
+KUBELET_EXTRA_ARGS="%[2]s"
+KUBELET_EOF
+`, kubeletExtraArgsPath, strings.Join(flags, " "))
+	script.Write(rest)
+	return []byte(script.String()), nil
+}
+
+func kubeletFlags(kubeletConfig *azureapi.KubeletConfig) []string {
+	if kubeletConfig == nil {
+		return nil
+	}
+
+	var flags []string
+	if kubeletConfig.MaxPods != nil {
+		flags = append(flags, "--max-pods="+strconv.Itoa(int(*kubeletConfig.MaxPods)))
+	}
+	if len(kubeletConfig.EvictionHard) > 0 {
+		flags = append(flags, "--eviction-hard="+joinSorted(kubeletConfig.EvictionHard, "<"))
+	}
+	if len(kubeletConfig.SystemReserved) > 0 {
+		flags = append(flags, "--system-reserved="+joinSorted(kubeletConfig.SystemReserved, "="))
+	}
+	if len(kubeletConfig.KubeReserved) > 0 {
+		flags = append(flags, "--kube-reserved="+joinSorted(kubeletConfig.KubeReserved, "="))
+	}
+	return flags
+}
+
+// joinSorted joins the entries of the given map sorted by their keys, so that the user data does not change between
+// reconciliations.
+func joinSorted(m map[string]string, separator string) string {
+	entries := make([]string, 0, len(m))
+	for key, value := range m {
+		entries = append(entries, key+separator+value)
+	}
+	slices.Sort(entries)
+	return strings.Join(entries, ",")
+}
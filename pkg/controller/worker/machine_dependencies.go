@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 // DeployMachineDependencies implements genericactuator.WorkerDelegate.
@@ -24,6 +25,10 @@ func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
+	if err := w.reconcileMarketplaceAgreements(ctx); err != nil {
+		return err
+	}
+
 	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
 	if err != nil {
 		return err
@@ -33,11 +38,15 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 		return err
 	}
 
+	if err := w.reconcileBootDiagnosticsRetention(ctx, workerProviderStatus); err != nil {
+		return err
+	}
+
 	if helper.IsVmoRequired(infrastructureStatus) {
 		vmoDependencies, err := w.reconcileVmoDependencies(ctx, infrastructureStatus, workerProviderStatus)
 		workerProviderStatus.VmoDependencies = vmoDependencies
 		if err != nil {
-			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
+			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, azureclient.DescribeScopeLockedError(ctx, w.clientFactory, err))
 		}
 		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 	}
@@ -47,7 +56,16 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
-	return w.cleanupMachineDependencies(ctx)
+	if err := w.cleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcileDataDiskPerformance(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcileVMExtensions(ctx); err != nil {
+		return err
+	}
+	return w.reconcileVMSettings(ctx)
 }
 
 // PreDeleteHook implements genericactuator.WorkerDelegate.
@@ -57,6 +75,14 @@ func (w *workerDelegate) PreDeleteHook(_ context.Context) error {
 
 // PostDeleteHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
+	workerProviderStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return err
+	}
+	if err := w.reconcileBootDiagnosticsRetention(ctx, workerProviderStatus); err != nil {
+		return err
+	}
+
 	return w.cleanupMachineDependencies(ctx)
 }
 
@@ -81,7 +107,7 @@ func (w *workerDelegate) cleanupMachineDependencies(ctx context.Context) error {
 		vmoDependencies, err := w.cleanupVmoDependencies(ctx, infrastructureStatus, workerProviderStatus)
 		workerProviderStatus.VmoDependencies = vmoDependencies
 		if err != nil {
-			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
+			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, azureclient.DescribeScopeLockedError(ctx, w.clientFactory, err))
 		}
 		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 	}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"time"
 
@@ -15,8 +16,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -24,11 +28,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	factorymock "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 	vmssmock "github.com/gardener/gardener-extension-provider-azure/pkg/mock/vmss"
 )
 
@@ -66,8 +73,11 @@ var _ = Describe("MachinesDependencies", func() {
 	Describe("VMO Dependencies", func() {
 		var (
 			vmoClient *vmssmock.MockVmss
+			vmClient  *factorymock.MockVirtualMachine
+			vms       []*armcompute.VirtualMachine
 
 			vmoName, vmoID   string
+			vmoTags          map[string]*string
 			faultDomainCount int32
 
 			cluster              *extensionscontroller.Cluster
@@ -80,6 +90,12 @@ var _ = Describe("MachinesDependencies", func() {
 			// Create a vmo seed client mock and let the factory always return the mocked vmo seed client.
 			vmoClient = vmssmock.NewMockVmss(ctrl)
 			factory.EXPECT().Vmss().AnyTimes().Return(vmoClient, nil)
+			vmClient = factorymock.NewMockVirtualMachine(ctrl)
+			factory.EXPECT().VirtualMachine().AnyTimes().Return(vmClient, nil)
+			vms = nil
+			vmClient.EXPECT().ListByVmss(ctx, resourceGroupName, gomock.Any()).AnyTimes().DoAndReturn(func(_ context.Context, _, _ string) ([]*armcompute.VirtualMachine, error) {
+				return vms, nil
+			})
 
 			faultDomainCount = 3
 			cluster = makeCluster("", "westeurope", nil, nil, faultDomainCount)
@@ -95,6 +111,13 @@ var _ = Describe("MachinesDependencies", func() {
 				Name:     vmoName,
 				PoolName: pool.Name,
 			}
+			vmoTags = map[string]*string{
+				"Name":                               ptr.To(namespace),
+				"kubernetes.io-cluster-" + namespace: ptr.To("1"),
+				"kubernetes.io-role-node":            ptr.To("1"),
+				azure.MachineSetTagKey:               ptr.To("1"),
+				azure.MachineSetWorkerNameTagKey:     ptr.To(pool.Name),
+			}
 		})
 
 		Context("#PreReconcileHook", func() {
@@ -128,7 +151,7 @@ var _ = Describe("MachinesDependencies", func() {
 				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
 				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
 
-				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount)
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, vmoTags)
 				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
 				err := workerDelegate.PreReconcileHook(ctx)
 				Expect(err).NotTo(HaveOccurred())
@@ -147,7 +170,7 @@ var _ = Describe("MachinesDependencies", func() {
 				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
 
 				var oldFaultDomainCoaunt int32 = 2
-				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, oldFaultDomainCoaunt)
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, oldFaultDomainCoaunt, vmoTags)
 				expectVmoCreateToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID)
 				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
 				err := workerDelegate.PreReconcileHook(ctx)
@@ -160,6 +183,695 @@ var _ = Describe("MachinesDependencies", func() {
 					"PoolName": Equal(vmoDependency.PoolName),
 				})))
 			})
+			It("should deploy a new vmo dependency with a single placement group if configured", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","singlePlacementGroup":true}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.SinglePlacementGroup).To(PointTo(BeTrue()))
+						Expect(vmo.Properties.PlatformFaultDomainCount).To(PointTo(Equal(faultDomainCount)))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency with the fault domain count of the worker pool", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","faultDomainCount":2}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.PlatformFaultDomainCount).To(PointTo(Equal(int32(2))))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency with the fault domain count of the worker pool in a region unknown to the CloudProfile", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","faultDomainCount":2}`),
+				}
+				cluster = makeCluster("", "northeurope", nil, nil, faultDomainCount)
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.PlatformFaultDomainCount).To(PointTo(Equal(int32(2))))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should fail without a fault domain count of the worker pool in a region unknown to the CloudProfile", func() {
+				cluster = makeCluster("", "northeurope", nil, nil, faultDomainCount)
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring("could not find a domain count for region westeurope")))
+			})
+
+			It("should keep the vmo dependency if it has the fault domain count of the worker pool", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","faultDomainCount":1}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, 1, vmoTags)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal(vmoID),
+					"Name": Equal(vmoName),
+				})))
+			})
+
+			It("should deploy a new vmo dependency without a single placement group by default", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.SinglePlacementGroup).To(PointTo(BeFalse()))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency without overprovisioning", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.Overprovision).To(PointTo(BeFalse()))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency without a scale-in policy by default", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.ScaleInPolicy).To(BeNil())
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency with the scale-in policy of the worker pool", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","scaleInPolicy":{"rule":"OldestVM","forceDeletion":true}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.ScaleInPolicy).To(Equal(&armcompute.ScaleInPolicy{
+							Rules:         []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRulesOldestVM)},
+							ForceDeletion: ptr.To(true),
+						}))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should update the scale-in policy of the existing vmo", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","scaleInPolicy":{"rule":"NewestVM"}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, vmoTags)
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.ScaleInPolicy).To(Equal(&armcompute.ScaleInPolicy{
+							Rules:         []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRulesNewestVM)},
+							ForceDeletion: ptr.To(false),
+						}))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should reset the scale-in policy of the existing vmo if it was removed from the worker pool", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+					ID:   ptr.To(vmoID),
+					Name: ptr.To(vmoName),
+					Tags: vmoTags,
+					Properties: &armcompute.VirtualMachineScaleSetProperties{
+						PlatformFaultDomainCount: &faultDomainCount,
+						ScaleInPolicy: &armcompute.ScaleInPolicy{
+							Rules: []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRulesOldestVM)},
+						},
+					},
+				}, nil)
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.ScaleInPolicy).To(Equal(&armcompute.ScaleInPolicy{
+							Rules:         []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRulesDefault)},
+							ForceDeletion: ptr.To(false),
+						}))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should disable the overprovisioning of the existing vmo", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+					ID:   ptr.To(vmoID),
+					Name: ptr.To(vmoName),
+					Tags: vmoTags,
+					Properties: &armcompute.VirtualMachineScaleSetProperties{
+						PlatformFaultDomainCount: &faultDomainCount,
+						Overprovision:            ptr.To(true),
+					},
+				}, nil)
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.Overprovision).To(PointTo(BeFalse()))
+						Expect(vmo.Properties.PlatformFaultDomainCount).To(PointTo(Equal(faultDomainCount)))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal(vmoID),
+					"Name": Equal(vmoName),
+				})))
+			})
+
+			It("should deploy a new vmo dependency as the single placement group changes", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","singlePlacementGroup":true}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, vmoTags)
+				expectVmoCreateToSucceed(ctx, vmoClient, resourceGroupName, "new-"+vmoName, "new-"+vmoID)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal("new-" + vmoID),
+					"Name":     Equal("new-" + vmoName),
+					"PoolName": Equal(pool.Name),
+				})))
+			})
+
+			It("should refuse to disable the single placement group of a vmo in a proximity placement group", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().Get(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+					ID:   ptr.To(vmoID),
+					Name: ptr.To(vmoName),
+					Tags: vmoTags,
+					Properties: &armcompute.VirtualMachineScaleSetProperties{
+						PlatformFaultDomainCount: ptr.To(faultDomainCount),
+						SinglePlacementGroup:     ptr.To(true),
+						ProximityPlacementGroup:  &armcompute.SubResource{ID: ptr.To("ppg-id")},
+					},
+				}, nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				err := workerDelegate.PreReconcileHook(ctx)
+				Expect(err).To(MatchError(ContainSubstring("is in the proximity placement group ppg-id, which requires a single placement group")))
+				Expect(gardencorev1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+
+			It("should deploy a new vmo dependency with automatic repairs but without the health extension", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","automaticRepairs":{"enabled":true,"gracePeriodMinutes":30},"healthProbe":{"protocol":"HTTP","port":8080,"path":"/healthz","intervalSeconds":10}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.AutomaticRepairsPolicy).To(Equal(&armcompute.AutomaticRepairsPolicy{
+							Enabled:     ptr.To(true),
+							GracePeriod: ptr.To("PT30M"),
+						}))
+						Expect(vmo.Properties.VirtualMachineProfile).To(BeNil())
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency with automatic repairs without a grace period", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","automaticRepairs":{"enabled":true},"healthProbe":{"protocol":"TCP","port":22}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.AutomaticRepairsPolicy).To(Equal(&armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(true)}))
+						Expect(vmo.Properties.VirtualMachineProfile).To(BeNil())
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should deploy a new vmo dependency without automatic repairs and health extension by default", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.AutomaticRepairsPolicy).To(Equal(&armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(false)}))
+						Expect(vmo.Properties.VirtualMachineProfile).To(BeNil())
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should remove the health extension from the extension profile of an existing vmo dependency", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","automaticRepairs":{"enabled":true},"healthProbe":{"protocol":"TCP","port":10250}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+					ID:   ptr.To(vmoID),
+					Name: ptr.To(vmoName),
+					Properties: &armcompute.VirtualMachineScaleSetProperties{
+						PlatformFaultDomainCount: &faultDomainCount,
+						AutomaticRepairsPolicy:   &armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(true)},
+						VirtualMachineProfile: &armcompute.VirtualMachineScaleSetVMProfile{
+							ExtensionProfile: &armcompute.VirtualMachineScaleSetExtensionProfile{
+								Extensions: []*armcompute.VirtualMachineScaleSetExtension{{
+									Name:       ptr.To("HealthExtension"),
+									Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{Settings: map[string]any{"protocol": "tcp", "port": float64(22)}},
+								}},
+							},
+						},
+					},
+				}, nil)
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Properties.AutomaticRepairsPolicy).To(Equal(&armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(true)}))
+						Expect(vmo.Properties.VirtualMachineProfile.ExtensionProfile.Extensions).To(BeEmpty())
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal(vmoID),
+					"Name": Equal(vmoName),
+				})))
+			})
+
+			Context("azure monitor agent", func() {
+				const (
+					identityID = "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"
+					ruleID     = "/subscriptions/sample-subscription/resourceGroups/monitoring/providers/Microsoft.Insights/dataCollectionRules/dcr"
+				)
+
+				var dcrClient *factorymock.MockDataCollectionRules
+
+				BeforeEach(func() {
+					dcrClient = factorymock.NewMockDataCollectionRules(ctrl)
+					factory.EXPECT().DataCollectionRules().AnyTimes().Return(dcrClient, nil)
+
+					infrastructureStatus = makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", false, nil, ptr.To(identityID))
+					pool.ProviderConfig = &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","azureMonitorAgent":{"dataCollectionRuleID":"` + ruleID + `"}}`),
+					}
+				})
+
+				It("should deploy a new vmo dependency without the azure monitor agent and its association", func() {
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					dcrClient.EXPECT().Get(ctx, ruleID).Return(&azureclient.DataCollectionRule{ID: ptr.To(ruleID)}, nil)
+					vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+						DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+							Expect(vmo.Properties.VirtualMachineProfile).To(BeNil())
+							return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+						})
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should fail if the data collection rule does not exist", func() {
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					dcrClient.EXPECT().Get(ctx, ruleID).Return(nil, nil)
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring("does not exist")))
+				})
+
+				It("should fail if the machines have no identity", func() {
+					infrastructureStatus.Identity = nil
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(MatchError(ContainSubstring("requires an identity")))
+				})
+
+				It("should remove the azure monitor agent and the association from the existing vmo", func() {
+					pool.ProviderConfig = nil
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+						ID:   ptr.To(vmoID),
+						Name: ptr.To(vmoName),
+						Tags: vmoTags,
+						Properties: &armcompute.VirtualMachineScaleSetProperties{
+							PlatformFaultDomainCount: &faultDomainCount,
+							VirtualMachineProfile: &armcompute.VirtualMachineScaleSetVMProfile{
+								ExtensionProfile: &armcompute.VirtualMachineScaleSetExtensionProfile{
+									Extensions: []*armcompute.VirtualMachineScaleSetExtension{{
+										Name:       ptr.To("AzureMonitorLinuxAgent"),
+										Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{Settings: generateMonitorAgentSettings(identityID)},
+									}},
+								},
+							},
+						},
+					}, nil)
+					deleteAssociation := dcrClient.EXPECT().DeleteAssociation(ctx, vmoID, "gardener-azure-monitor-agent")
+					vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).After(deleteAssociation).
+						DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+							Expect(vmo.Properties.VirtualMachineProfile.ExtensionProfile.Extensions).To(BeEmpty())
+							return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+						})
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should remove the azure monitor agent and the association from the existing vmo if the agent is configured", func() {
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					dcrClient.EXPECT().Get(ctx, ruleID).Return(&azureclient.DataCollectionRule{ID: ptr.To(ruleID)}, nil)
+					vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+						ID:   ptr.To(vmoID),
+						Name: ptr.To(vmoName),
+						Tags: vmoTags,
+						Properties: &armcompute.VirtualMachineScaleSetProperties{
+							PlatformFaultDomainCount: &faultDomainCount,
+							VirtualMachineProfile: &armcompute.VirtualMachineScaleSetVMProfile{
+								ExtensionProfile: &armcompute.VirtualMachineScaleSetExtensionProfile{
+									Extensions: []*armcompute.VirtualMachineScaleSetExtension{{
+										Name:       ptr.To("AzureMonitorLinuxAgent"),
+										Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{Settings: generateMonitorAgentSettings(identityID)},
+									}},
+								},
+							},
+						},
+					}, nil)
+					deleteAssociation := dcrClient.EXPECT().DeleteAssociation(ctx, vmoID, "gardener-azure-monitor-agent")
+					vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, vmoName, gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).After(deleteAssociation).
+						DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+							Expect(vmo.Properties.VirtualMachineProfile.ExtensionProfile.Extensions).To(BeEmpty())
+							return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+						})
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should not update the existing vmo without the azure monitor agent if the agent is configured", func() {
+					w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					dcrClient.EXPECT().Get(ctx, ruleID).Return(&azureclient.DataCollectionRule{ID: ptr.To(ruleID)}, nil)
+					vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+						ID:         ptr.To(vmoID),
+						Name:       ptr.To(vmoName),
+						Tags:       vmoTags,
+						Properties: &armcompute.VirtualMachineScaleSetProperties{PlatformFaultDomainCount: &faultDomainCount},
+					}, nil)
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+			})
+
+			It("should deploy a new vmo dependency with the tags of the machines", func() {
+				pool.Labels = map[string]string{"cost-center": "1234"}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).
+					DoAndReturn(func(_ context.Context, _, _ string, vmo armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(vmo.Tags).To(Equal(withTag(vmoTags, "cost-center", "1234")))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should add and update the tags of the existing vmo", func() {
+				pool.Labels = map[string]string{"cost-center": "5678", "team": "ml"}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, withTag(vmoTags, "cost-center", "1234"))
+				vmoClient.EXPECT().UpdateTags(ctx, resourceGroupName, vmoName, withTag(withTag(vmoTags, "cost-center", "5678"), "team", "ml")).
+					Return(&armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal(vmoID),
+					"Name": Equal(vmoName),
+				})))
+			})
+
+			It("should remove deleted tags from the existing vmo but keep the managed tags", func() {
+				vmoDependency.TagKeys = []string{"cost-center"}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, withTag(vmoTags, "cost-center", "1234"))
+				vmoClient.EXPECT().UpdateTags(ctx, resourceGroupName, vmoName, vmoTags).
+					Return(&armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"TagKeys": ConsistOf("Name", "kubernetes.io-cluster-"+namespace, "kubernetes.io-role-node", azure.MachineSetTagKey, azure.MachineSetWorkerNameTagKey),
+				})))
+			})
+
+			It("should keep the tags of the existing vmo which were not added by the extension", func() {
+				vmoDependency.TagKeys = []string{"Name", "kubernetes.io-cluster-" + namespace, "kubernetes.io-role-node", azure.MachineSetTagKey, azure.MachineSetWorkerNameTagKey}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, withTag(vmoTags, "policy-owner", "platform"))
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should propagate the tags to the virtual machines of the existing vmo", func() {
+				vmoDependency.TagKeys = []string{"cost-center"}
+				pool.Labels = map[string]string{"team": "ml"}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmTags := map[string]*string{
+					"Name":                               ptr.To(namespace),
+					"kubernetes.io-cluster-" + namespace: ptr.To("1"),
+					"kubernetes.io-role-node":            ptr.To("1"),
+					"team":                               ptr.To("ml"),
+				}
+				vms = []*armcompute.VirtualMachine{
+					{Name: ptr.To("machine-0"), Tags: withTag(withTag(vmTags, "cost-center", "1234"), "policy-owner", "platform")},
+					{Name: ptr.To("machine-1"), Tags: vmTags},
+				}
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, withTag(vmoTags, "team", "ml"))
+				vmClient.EXPECT().UpdateTags(ctx, resourceGroupName, "machine-0", withTag(vmTags, "policy-owner", "platform")).
+					Return(&armcompute.VirtualMachine{Name: ptr.To("machine-0")}, nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should restore a changed managed tag of the existing vmo", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, withTag(vmoTags, azure.MachineSetWorkerNameTagKey, "other-pool"))
+				vmoClient.EXPECT().UpdateTags(ctx, resourceGroupName, vmoName, vmoTags).
+					Return(&armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			Context("capacity", func() {
+				var w *extensionsv1alpha1.Worker
+
+				BeforeEach(func() {
+					pool.ProviderConfig = &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","capacityPolicy":"Report"}`),
+					}
+					w = makeWorker(namespace, region, nil, infrastructureStatus, pool)
+					w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+					expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, faultDomainCount, vmoTags)
+				})
+
+				expectMachineDeployment := func(replicas, currentReplicas int32) {
+					c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{}), client.InNamespace(namespace)).DoAndReturn(
+						func(_ context.Context, list *machinev1alpha1.MachineDeploymentList, _ ...client.ListOption) error {
+							list.Items = []machinev1alpha1.MachineDeployment{{
+								ObjectMeta: metav1.ObjectMeta{Name: namespace + "-" + pool.Name, Generation: 2},
+								Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+								Status: machinev1alpha1.MachineDeploymentStatus{
+									ObservedGeneration: 2,
+									Replicas:           currentReplicas,
+									UpdatedReplicas:    currentReplicas,
+								},
+							}}
+							return nil
+						})
+				}
+
+				expectVmoCapacity := func(capacity int64, provisioningState string) {
+					vmoClient.EXPECT().Get(ctx, resourceGroupName, vmoName, nil).Return(&armcompute.VirtualMachineScaleSet{
+						ID:         ptr.To(vmoID),
+						Name:       ptr.To(vmoName),
+						SKU:        &armcompute.SKU{Capacity: ptr.To(capacity)},
+						Properties: &armcompute.VirtualMachineScaleSetProperties{ProvisioningState: ptr.To(provisioningState)},
+					}, nil)
+				}
+
+				expectCapacityDriftCondition := func(status gardencorev1beta1.ConditionStatus, reason, message string) {
+					statusWriter.EXPECT().Patch(ctx, w, gomock.Any()).DoAndReturn(func(_ context.Context, obj *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						Expect(obj.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+							"Type":    Equal(ConditionTypeCapacityDrift),
+							"Status":  Equal(status),
+							"Reason":  Equal(reason),
+							"Message": ContainSubstring(message),
+						})))
+						return nil
+					})
+				}
+
+				It("should report the drift of the capacity of the vmo without changing it", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(5, 5)
+					expectVmoCapacity(3, "Succeeded")
+					expectCapacityDriftCondition(gardencorev1beta1.ConditionTrue, ReasonCapacityDrifted, fmt.Sprintf(`the VMSS Flex %s of worker pool %q has a capacity of 3, but 5 machines are expected`, vmoName, pool.Name))
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should report the drift of the capacity of the vmo which is scaled to zero", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(0, 0)
+					expectVmoCapacity(2, "Succeeded")
+					expectCapacityDriftCondition(gardencorev1beta1.ConditionTrue, ReasonCapacityDrifted, "has a capacity of 2, but 0 machines are expected")
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					workerStatus := decodeWorkerProviderStatus(w)
+					Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"ID":       Equal(vmoDependency.ID),
+						"PoolName": Equal(vmoDependency.PoolName),
+					})))
+				})
+
+				It("should reset the condition once the capacity of the vmo matches the replicas of the machine deployment again", func() {
+					w.Status.Conditions = []gardencorev1beta1.Condition{{Type: ConditionTypeCapacityDrift, Status: gardencorev1beta1.ConditionTrue, Reason: ReasonCapacityDrifted}}
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(3, 3)
+					expectVmoCapacity(3, "Succeeded")
+					expectCapacityDriftCondition(gardencorev1beta1.ConditionFalse, ReasonNoCapacityDrift, "matches the machines of its worker pool")
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should not report a drift if the capacity of the vmo matches the replicas of the machine deployment", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(3, 3)
+					expectVmoCapacity(3, "Succeeded")
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should not report a drift while the machine deployment is scaled", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(5, 3)
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should not report a drift while the vmo is updated by Azure", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+					expectMachineDeployment(5, 5)
+					expectVmoCapacity(3, "Updating")
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+			})
 		})
 
 		Context("#PostReconcileHook", func() {
@@ -366,11 +1078,12 @@ var _ = Describe("MachinesDependencies", func() {
 	})
 })
 
-func expectVmoGetToSucceed(ctx context.Context, c *vmssmock.MockVmss, resourceGroupName, name, id string, faultDomainCount int32) {
+func expectVmoGetToSucceed(ctx context.Context, c *vmssmock.MockVmss, resourceGroupName, name, id string, faultDomainCount int32, tags map[string]*string) {
 	// As the vmo name (parameter 3) contains a random suffix, we use simply anything of type string for the mock.
 	c.EXPECT().Get(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
 		ID:   ptr.To(id),
 		Name: ptr.To(name),
+		Tags: tags,
 		Properties: &armcompute.VirtualMachineScaleSetProperties{
 			PlatformFaultDomainCount: &faultDomainCount,
 		},
@@ -418,3 +1131,21 @@ func generateExpectedVmo(name, id string) *armcompute.VirtualMachineScaleSet {
 		},
 	}
 }
+
+// generateMonitorAgentSettings returns the settings of the azure monitor agent extension as returned by Azure.
+func generateMonitorAgentSettings(identityID string) map[string]any {
+	return map[string]any{
+		"authentication": map[string]any{
+			"managedIdentity": map[string]any{
+				"identifier-name":  "mi_res_id",
+				"identifier-value": identityID,
+			},
+		},
+	}
+}
+
+func withTag(tags map[string]*string, key, value string) map[string]*string {
+	result := maps.Clone(tags)
+	result[key] = ptr.To(value)
+	return result
+}
@@ -30,6 +30,7 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	}
 
 	workerStatus.MachineImages = w.machineImages
+	workerStatus.FallbackZones = w.fallbackZones
 	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
 	}
@@ -5,7 +5,9 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"regexp"
@@ -22,6 +24,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -74,6 +79,9 @@ type zoneInfo struct {
 	name  string
 	index int32
 	count int32
+	// minimum and maximum are the number of machines which are distributed over the count zones.
+	minimum int32
+	maximum int32
 }
 
 type machineSetInfo struct {
@@ -87,6 +95,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments        = worker.MachineDeployments{}
 		machineClasses            []map[string]interface{}
 		machineImages             []azureapi.MachineImage
+		fallbackZones             []azureapi.WorkerPoolFallbackZones
 	)
 
 	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
@@ -104,9 +113,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	userDataEncryptionKeys := map[string]*azureclient.KeyVaultKey{}
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		// A pinned version replaces the version of the Shoot for the image lookup, the machine class and the hash, so
+		// that changing the pinned version rolls the machines of the pool.
+		if workerConfig.MachineImageVersion != nil {
+			pool.MachineImage.Version = *workerConfig.MachineImageVersion
+		}
+
 		// Get the vmo dependency from the worker status if exists.
-		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool.Name, pool.UpdateStrategy)
+		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool, workerConfig)
 		if err != nil {
 			return err
 		}
@@ -134,8 +154,10 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		image := map[string]interface{}{}
 		if machineImage.URN != nil {
 			image["urn"] = *machineImage.URN
-			if ok := ptr.Deref(machineImage.SkipMarketplaceAgreement, false); ok {
-				image["skipMarketplaceAgreement"] = ok
+			// The machine-controller-manager accepts the marketplace agreement of the image implicitly unless it is
+			// skipped. Agreements are only accepted by the worker controller, which records them, if it is configured to.
+			if ptr.Deref(machineImage.SkipMarketplaceAgreement, false) || !w.acceptsMarketplaceAgreements() {
+				image["skipMarketplaceAgreement"] = true
 			}
 		} else if machineImage.CommunityGalleryImageID != nil {
 			image["communityGalleryImageID"] = *machineImage.CommunityGalleryImageID
@@ -145,13 +167,6 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			image["id"] = *machineImage.ID
 		}
 
-		workerConfig := azureapi.WorkerConfig{}
-		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
-			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, &workerConfig); err != nil {
-				return fmt.Errorf("could not decode provider config: %+v", err)
-			}
-		}
-
 		disks, err := computeDisks(pool, workerConfig.DataVolumes, workerConfig.Volume)
 		if err != nil {
 			return err
@@ -161,6 +176,32 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		userData, err = injectPrePullImages(userData, workerConfig.PrePullImages)
+		if err != nil {
+			return fmt.Errorf("failed to configure the pre-pulled images of worker pool %q: %w", pool.Name, err)
+		}
+		userData, err = injectKubeletConfig(userData, workerConfig.KubeletConfig)
+		if err != nil {
+			return fmt.Errorf("failed to configure the kubelet of worker pool %q: %w", pool.Name, err)
+		}
+		userData, err = injectTimeConfig(userData, workerConfig.Time)
+		if err != nil {
+			return fmt.Errorf("failed to configure the time settings of worker pool %q: %w", pool.Name, err)
+		}
+		userDataEncryptionKey, err := w.getUserDataEncryptionKey(ctx, workerConfig, infrastructureStatus.Identity, userDataEncryptionKeys)
+		if err != nil {
+			return err
+		}
+		if userDataEncryptionKey != nil {
+			userData, err = w.encryptPoolUserData(ctx, pool.Name, userData, userDataEncryptionKey, infrastructureStatus.Identity)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt the user data of worker pool %q: %w", pool.Name, err)
+			}
+		}
+		userData, err = prepareUserData(userData, ptr.Deref(workerConfig.CompressUserData, false))
+		if err != nil {
+			return fmt.Errorf("failed to prepare the user data of worker pool %q: %w", pool.Name, err)
+		}
 
 		generateMachineClassAndDeployment := func(zone *zoneInfo, machineSet *machineSetInfo, subnetName, workerPoolHash string, workerConfig *azureapi.WorkerConfig) (worker.MachineDeployment, map[string]interface{}) {
 			var (
@@ -169,7 +210,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					Minimum:              pool.Minimum,
 					Maximum:              pool.Maximum,
 					Priority:             pool.Priority,
-					Labels:               addTopologyLabel(pool.Labels, w.worker.Spec.Region, zone),
+					Labels:               addNodeLabels(pool.Labels, w.worker.Spec.Region, pool.MachineType, zone),
 					Annotations:          pool.Annotations,
 					Taints:               pool.Taints,
 					MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
@@ -212,17 +253,18 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 			machineClassSpec["network"] = networkConfig
 
+			maxSurge, maxUnavailable := rollingUpdateValues(pool, workerConfig.RollingUpdate)
 			updateConfiguration := machinev1alpha1.UpdateConfiguration{
-				MaxUnavailable: &pool.MaxUnavailable,
-				MaxSurge:       &pool.MaxSurge,
+				MaxUnavailable: &maxUnavailable,
+				MaxSurge:       &maxSurge,
 			}
 
 			if zone != nil {
-				machineDeployment.Minimum = worker.DistributeOverZones(zone.index, pool.Minimum, zone.count)
-				machineDeployment.Maximum = worker.DistributeOverZones(zone.index, pool.Maximum, zone.count)
+				machineDeployment.Minimum = worker.DistributeOverZones(zone.index, zone.minimum, zone.count)
+				machineDeployment.Maximum = worker.DistributeOverZones(zone.index, zone.maximum, zone.count)
 				updateConfiguration = machinev1alpha1.UpdateConfiguration{
-					MaxUnavailable: ptr.To(worker.DistributePositiveIntOrPercent(zone.index, pool.MaxUnavailable, zone.count, pool.Minimum)),
-					MaxSurge:       ptr.To(worker.DistributePositiveIntOrPercent(zone.index, pool.MaxSurge, zone.count, pool.Maximum)),
+					MaxUnavailable: ptr.To(worker.DistributePositiveIntOrPercent(zone.index, maxUnavailable, zone.count, zone.minimum)),
+					MaxSurge:       ptr.To(worker.DistributePositiveIntOrPercent(zone.index, maxSurge, zone.count, zone.maximum)),
 				}
 				machineClassSpec["zone"] = zone.name
 			}
@@ -340,26 +382,83 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return machineDeployment, machineClassSpec
 		}
 
-		workerPoolHash, err := w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, nil)
+		// a worker pool which is assigned to an additional subnet is placed in it regardless of the network layout.
+		var (
+			assignedSubnet     *azureapi.Subnet
+			assignedSubnetName *string
+		)
+		if workerConfig.SubnetName != nil {
+			assignedSubnet, err = findAdditionalSubnet(infrastructureStatus, *workerConfig.SubnetName)
+			if err != nil {
+				return err
+			}
+			assignedSubnetName = &assignedSubnet.Name
+		}
+
+		workerPoolHash, err := w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, assignedSubnetName)
 		if err != nil {
 			return err
 		}
 
 		// VMO
 		if vmoDependency != nil {
+			subnetName := nodesSubnet.Name
+			if assignedSubnet != nil {
+				subnetName = assignedSubnet.Name
+			}
 			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(nil, &machineSetInfo{
 				id:   vmoDependency.ID,
 				kind: "vmo",
-			}, nodesSubnet.Name, workerPoolHash, &workerConfig)
+			}, subnetName, workerPoolHash, workerConfig)
 			machineDeployments = append(machineDeployments, machineDeployment)
 			machineClasses = append(machineClasses, machineClassSpec)
 			continue
 		}
 
 		// Availability Zones
-		zoneCount := len(pool.Zones)
+		poolFallbackZones, err := w.getFallbackZones(ctx, pool, workerConfig, workerStatus)
+		if err != nil {
+			return err
+		}
+		fallbackDemand, err := w.getFallbackDemand(ctx, pool, poolFallbackZones, workerStatus)
+		if err != nil {
+			return err
+		}
+		if len(poolFallbackZones) > 0 {
+			fallbackZones = append(fallbackZones, azureapi.WorkerPoolFallbackZones{Name: pool.Name, Zones: poolFallbackZones, Demand: fallbackDemand})
+		}
+
+		// the minimum and maximum of the worker pool are distributed over its zones only, the fallback zones only get
+		// the machines which cannot be allocated in the zones of the worker pool.
+		zones := make([]*zoneInfo, 0, len(pool.Zones)+len(poolFallbackZones))
 		for zoneIndex, zone := range pool.Zones {
-			if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet {
+			zones = append(zones, &zoneInfo{
+				name:    zone,
+				index:   int32(zoneIndex),       // #nosec: G115 - We validate if pool zones exceeds max_int32.
+				count:   int32(len(pool.Zones)), // #nosec: G115 - We validate if pool zones exceeds max_int32.
+				minimum: pool.Minimum,
+				maximum: pool.Maximum,
+			})
+		}
+		for zoneIndex, zone := range poolFallbackZones {
+			zones = append(zones, &zoneInfo{
+				name:    zone,
+				index:   int32(zoneIndex),              // #nosec: G115 - The fallback zones are zones of the region.
+				count:   int32(len(poolFallbackZones)), // #nosec: G115 - The fallback zones are zones of the region.
+				minimum: fallbackDemand,
+				maximum: fallbackDemand,
+			})
+		}
+
+		for _, zoneInfo := range zones {
+			zone := zoneInfo.name
+			subnetName := nodesSubnet.Name
+			if assignedSubnet != nil {
+				if assignedSubnet.Zone != nil && *assignedSubnet.Zone != zone {
+					return fmt.Errorf("subnet %q of worker pool %q belongs to zone %q, but the pool uses zone %q", *workerConfig.SubnetName, pool.Name, *assignedSubnet.Zone, zone)
+				}
+				subnetName = assignedSubnet.Name
+			} else if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet {
 				_, nodesSubnet, err = azureapihelper.FindSubnetByPurposeAndZone(infrastructureStatus.Networks.Subnets, azureapi.PurposeNodes, &zone)
 				if err != nil {
 					return err
@@ -376,12 +475,9 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						return err
 					}
 				}
+				subnetName = nodesSubnet.Name
 			}
-			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(&zoneInfo{
-				name:  zone,
-				index: int32(zoneIndex), // #nosec: G115 - We validate if pool zones exceeds max_int32.
-				count: int32(zoneCount), // #nosec: G115 - We validate if pool zones exceeds max_int32.
-			}, nil, nodesSubnet.Name, workerPoolHash, &workerConfig)
+			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(zoneInfo, nil, subnetName, workerPoolHash, workerConfig)
 			machineDeployments = append(machineDeployments, machineDeployment)
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
@@ -390,10 +486,23 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.fallbackZones = fallbackZones
 
 	return nil
 }
 
+// findAdditionalSubnet returns the subnet of the infrastructure status which belongs to the additional subnet with the
+// given name.
+func findAdditionalSubnet(infrastructureStatus *azureapi.InfrastructureStatus, name string) (*azureapi.Subnet, error) {
+	subnetName := azureapihelper.AdditionalSubnetName(infrastructureStatus.ResourceGroup.Name, name)
+	for _, subnet := range infrastructureStatus.Networks.Subnets {
+		if subnet.Purpose == azureapi.PurposeNodes && subnet.Name == subnetName {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find additional subnet %q in the infrastructure status", name)
+}
+
 // isMachineTypeSupportingAcceleratedNetworking checks if the passed machine type is supporting Azure accelerated networking.
 func (w *workerDelegate) isMachineTypeSupportingAcceleratedNetworking(machineTypeName string) bool {
 	for _, machType := range w.cloudProfileConfig.MachineTypes {
@@ -404,6 +513,18 @@ func (w *workerDelegate) isMachineTypeSupportingAcceleratedNetworking(machineTyp
 	return false
 }
 
+// decodeWorkerConfig decodes the WorkerConfig of the given worker pool. An empty WorkerConfig is returned if the pool
+// has no provider config.
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*azureapi.WorkerConfig, error) {
+	workerConfig := &azureapi.WorkerConfig{}
+	if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+		if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+	return workerConfig, nil
+}
+
 // getVMTags returns a map of vm tags
 func (w *workerDelegate) getVMTags(pool extensionsv1alpha1.WorkerPool) map[string]string {
 	vmTags := map[string]string{
@@ -417,6 +538,22 @@ func (w *workerDelegate) getVMTags(pool extensionsv1alpha1.WorkerPool) map[strin
 	return vmTags
 }
 
+// rollingUpdateValues returns the maximum surge and unavailability of the machines of the given worker pool. The
+// rolling update of the WorkerConfig overrides the values of the worker pool unless the machines are updated in-place.
+func rollingUpdateValues(pool extensionsv1alpha1.WorkerPool, rollingUpdate *azureapi.RollingUpdate) (intstr.IntOrString, intstr.IntOrString) {
+	maxSurge, maxUnavailable := pool.MaxSurge, pool.MaxUnavailable
+	if rollingUpdate == nil || gardencorev1beta1helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
+		return maxSurge, maxUnavailable
+	}
+	if rollingUpdate.MaxSurge != nil {
+		maxSurge = *rollingUpdate.MaxSurge
+	}
+	if rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *rollingUpdate.MaxUnavailable
+	}
+	return maxSurge, maxUnavailable
+}
+
 func computeDisks(pool extensionsv1alpha1.WorkerPool, dataVolumesConfig []azureapi.DataVolume, osDiskConfig *azureapi.Volume) (map[string]interface{}, error) {
 	// handle root disk
 	volumeSize, err := worker.DiskSize(pool.Volume.Size)
@@ -502,16 +639,30 @@ func SanitizeAzureVMTag(label string) string {
 	return tagRegex.ReplaceAllString(strings.ToLower(label), "_")
 }
 
-func addTopologyLabel(labels map[string]string, region string, zone *zoneInfo) map[string]string {
+// addNodeLabels adds the well-known labels describing the placement and the VMs of the machines to the labels of the
+// worker pool. As they are part of the machine deployment, they are present as soon as the node registers and do not
+// depend on the cloud-controller-manager initializing the node.
+func addNodeLabels(labels map[string]string, region, machineType string, zone *zoneInfo) map[string]string {
+	nodeLabels := map[string]string{
+		corev1.LabelInstanceTypeStable: machineType,
+		azure.CapacityTypeLabel:        azure.CapacityTypeOnDemand,
+	}
 	if zone != nil {
-		return utils.MergeStringMaps(labels, map[string]string{azure.AzureCSIDiskDriverTopologyKey: region + "-" + zone.name})
+		nodeLabels[corev1.LabelTopologyZone] = region + "-" + zone.name
+		nodeLabels[azure.AzureCSIDiskDriverTopologyKey] = region + "-" + zone.name
 	}
-	return labels
+	return utils.MergeStringMaps(labels, nodeLabels)
 }
 
 func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPool, infrastructureStatus *azureapi.InfrastructureStatus, vmoDependency *azureapi.VmoDependency, subnetName *string) (string, error) {
 	var additionalHashData []string
 
+	// Settings which are applied to the existing machines, e.g. how they are replaced, must not replace them.
+	pool, err := withoutSettingsAppliedToExistingMachines(pool)
+	if err != nil {
+		return "", err
+	}
+
 	// Integrate data disks/volumes in the hash.
 	for _, dv := range pool.DataVolumes {
 		additionalHashData = append(additionalHashData, dv.Size)
@@ -555,6 +706,54 @@ func (w workerDelegate) workerPoolHashDataV2(pool extensionsv1alpha1.WorkerPool)
 	return nil
 }
 
+// withoutSettingsAppliedToExistingMachines returns the given worker pool without the settings of its provider config
+// which are applied to the existing machines or do not affect them at all, i.e. the rolling update, the
+// scale-in policy, the automatic repairs and the health probe of the VMO and the provisioned
+// performance of the data volumes. The provider config is only re-encoded if it contains such a
+// setting, so that the hashes of all other worker pools are kept.
+func withoutSettingsAppliedToExistingMachines(pool extensionsv1alpha1.WorkerPool) (extensionsv1alpha1.WorkerPool, error) {
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return pool, nil
+	}
+
+	var providerConfig map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(pool.ProviderConfig.Raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&providerConfig); err != nil {
+		return pool, fmt.Errorf("failed to decode the provider config of worker pool %q: %w", pool.Name, err)
+	}
+
+	var changed bool
+	for _, key := range []string{"rollingUpdate", "scaleInPolicy", "automaticRepairs", "healthProbe"} {
+		if _, ok := providerConfig[key]; ok {
+			delete(providerConfig, key)
+			changed = true
+		}
+	}
+	if dataVolumes, ok := providerConfig["dataVolumes"].([]interface{}); ok {
+		for _, dataVolume := range dataVolumes {
+			if dataVolume, ok := dataVolume.(map[string]interface{}); ok {
+				for _, key := range []string{"provisionedIops", "provisionedThroughput"} {
+					if _, ok := dataVolume[key]; ok {
+						delete(dataVolume, key)
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	if !changed {
+		return pool, nil
+	}
+
+	raw, err := json.Marshal(providerConfig)
+	if err != nil {
+		return pool, fmt.Errorf("failed to encode the provider config of worker pool %q: %w", pool.Name, err)
+	}
+	pool.ProviderConfig = &runtime.RawExtension{Raw: raw}
+	return pool, nil
+}
+
 // TODO: Remove when we have support for VM Capabilities
 func isConfidentialVM(pool extensionsv1alpha1.WorkerPool) bool {
 	for _, v := range azure.ConfidentialVMFamilyPrefixes {
@@ -5,19 +5,37 @@
 package worker_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"maps"
+	"math/big"
+	"net/url"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
@@ -38,7 +56,10 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/charts"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 )
 
@@ -136,6 +157,7 @@ var _ = Describe("Machines", func() {
 				maxUnavailablePool4 intstr.IntOrString
 
 				labels, zone1Labels, zone2Labels map[string]string
+				zone1NodeLabels, zone2NodeLabels map[string]string
 
 				nodeCapacity      corev1.ResourceList
 				nodeTemplateZone1 machinev1alpha1.NodeTemplate
@@ -206,6 +228,16 @@ var _ = Describe("Machines", func() {
 				labels = map[string]string{"component": "TiDB"}
 				zone1Labels = utils.MergeStringMaps(labels, map[string]string{azuretypes.AzureCSIDiskDriverTopologyKey: regionAndZone1})
 				zone2Labels = utils.MergeStringMaps(labels, map[string]string{azuretypes.AzureCSIDiskDriverTopologyKey: regionAndZone2})
+				zone1NodeLabels = utils.MergeStringMaps(zone1Labels, map[string]string{
+					corev1.LabelTopologyZone:       regionAndZone1,
+					corev1.LabelInstanceTypeStable: machineType,
+					azuretypes.CapacityTypeLabel:   azuretypes.CapacityTypeOnDemand,
+				})
+				zone2NodeLabels = utils.MergeStringMaps(zone2Labels, map[string]string{
+					corev1.LabelTopologyZone:       regionAndZone2,
+					corev1.LabelInstanceTypeStable: machineType,
+					azuretypes.CapacityTypeLabel:   azuretypes.CapacityTypeOnDemand,
+				})
 
 				nodeCapacity = corev1.ResourceList{
 					"cpu":    resource.MustParse("8"),
@@ -660,7 +692,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -681,7 +713,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -701,7 +733,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -721,7 +753,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -904,7 +936,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -924,7 +956,7 @@ var _ = Describe("Machines", func() {
 									},
 								},
 							},
-							Labels:                       zone2Labels,
+							Labels:                       zone2NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -1001,6 +1033,1021 @@ var _ = Describe("Machines", func() {
 					Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
 					Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.5"))
 				})
+
+				Context("pre-pulled images", func() {
+					BeforeEach(func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","prePullImages":["nvcr.io/nvidia/pytorch:24.01-py3","busybox"]}`),
+						}
+					})
+
+					It("should add the pre-pulling of the images to the user data", func() {
+						userData = []byte("#!/bin/bash\necho provision\n")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									cloudConfig := class["secret"].(map[string]interface{})["cloudConfig"].(string)
+									Expect(cloudConfig).To(HavePrefix("#!/bin/bash\n# pre-pull the container images of the worker pool on a best-effort basis\n"))
+									Expect(cloudConfig).To(ContainSubstring("for image in 'nvcr.io/nvidia/pytorch:24.01-py3' 'busybox'; do"))
+									Expect(cloudConfig).To(ContainSubstring(`timeout 600 crictl --runtime-endpoint unix:///run/containerd/containerd.sock pull "$image"`))
+									Expect(cloudConfig).To(ContainSubstring("systemctl start --no-block azure-prepull-images.service || true\n"))
+									Expect(cloudConfig).To(HaveSuffix("\necho provision\n"))
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+
+					It("should fail if the user data is no shell script", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("images can only be pre-pulled if the user data is a shell script")))
+						Expect(result).To(BeNil())
+					})
+				})
+
+				Context("kubelet configuration", func() {
+					BeforeEach(func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","kubeletConfig":{"maxPods":250,"evictionHard":{"nodefs.available":"10%","memory.available":"200Mi"},"systemReserved":{"memory":"1Gi","cpu":"100m"},"kubeReserved":{"pid":"1000"}}}`),
+						}
+					})
+
+					It("should add the kubelet configuration to the user data", func() {
+						userData = []byte("#!/bin/bash\necho provision\n")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									cloudConfig := class["secret"].(map[string]interface{})["cloudConfig"].(string)
+									Expect(cloudConfig).To(Equal(`#!/bin/bash
+# override the kubelet configuration of the worker pool
+mkdir -p "$(dirname /var/lib/kubelet/extra_args)"
+cat <<'KUBELET_EOF' > /var/lib/kubelet/extra_args
+KUBELET_EXTRA_ARGS="--max-pods=250 --eviction-hard=memory.available<200Mi,nodefs.available<10% --system-reserved=cpu=100m,memory=1Gi --kube-reserved=pid=1000"
+KUBELET_EOF
+echo provision
+`))
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+
+					It("should fail if the user data is no shell script", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("the kubelet configuration can only be overridden if the user data is a shell script")))
+						Expect(result).To(BeNil())
+					})
+				})
+
+				Context("time configuration", func() {
+					BeforeEach(func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","time":{"ntpServers":["ntp1.example.com","10.0.0.1"],"timezone":"Europe/Berlin"}}`),
+						}
+					})
+
+					It("should add the NTP servers and the timezone to the user data", func() {
+						userData = []byte("#!/bin/bash\necho provision\n")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									cloudConfig := class["secret"].(map[string]interface{})["cloudConfig"].(string)
+									Expect(cloudConfig).To(Equal(`#!/bin/bash
+# configure the NTP servers of the worker pool
+mkdir -p "$(dirname /etc/systemd/timesyncd.conf.d/90-worker-pool.conf)" "$(dirname /etc/chrony/sources.d/worker-pool.sources)"
+cat <<'TIME_EOF' > /etc/systemd/timesyncd.conf.d/90-worker-pool.conf
+[Time]
+NTP=ntp1.example.com 10.0.0.1
+FallbackNTP=
+TIME_EOF
+cat <<'TIME_EOF' > /etc/chrony/sources.d/worker-pool.sources
+server ntp1.example.com iburst
+server 10.0.0.1 iburst
+TIME_EOF
+if [ -f /etc/chrony/chrony.conf ]; then
+  sed -i -E 's/^(pool|server) /# &/' /etc/chrony/chrony.conf
+fi
+systemctl try-restart systemd-timesyncd.service chrony.service chronyd.service || true
+# configure the timezone of the worker pool
+ln -sf /usr/share/zoneinfo/Europe/Berlin /etc/localtime
+echo 'Europe/Berlin' > /etc/timezone
+echo provision
+`))
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+
+					It("should fail if the user data is no shell script", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("the time configuration can only be applied if the user data is a shell script")))
+						Expect(result).To(BeNil())
+					})
+				})
+
+				Context("user data size", func() {
+					It("should accept user data at the custom data limit", func() {
+						userData = bytes.Repeat([]byte("a"), 65535)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						_, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should fail if the user data exceeds the custom data limit", func() {
+						userData = bytes.Repeat([]byte("a"), 65536)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("user data has 65536 bytes, which exceeds the custom data limit of 65535 bytes")))
+						Expect(result).To(BeNil())
+					})
+
+					Context("compression", func() {
+						BeforeEach(func() {
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+								Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","compressUserData":true}`),
+							}
+						})
+
+						It("should compress user data which exceeds the custom data limit", func() {
+							userData = bytes.Repeat([]byte("#!/bin/bash\necho provision\n"), 10000)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							expectedUserDataSecretRefRead()
+
+							chartApplier.
+								EXPECT().
+								ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									applyOptions := &kubernetes.ApplyOptions{}
+									for _, opt := range opts {
+										opt.MutateApplyOptions(applyOptions)
+									}
+									classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+									Expect(classes).To(HaveLen(2))
+									for _, class := range classes {
+										cloudConfig := class["secret"].(map[string]interface{})["cloudConfig"].(string)
+										Expect(len(cloudConfig)).To(BeNumerically("<=", 65535))
+
+										zr, err := gzip.NewReader(strings.NewReader(cloudConfig))
+										Expect(err).NotTo(HaveOccurred())
+										decompressed, err := io.ReadAll(zr)
+										Expect(err).NotTo(HaveOccurred())
+										Expect(decompressed).To(Equal(userData))
+									}
+									return nil
+								})
+
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+						})
+
+						It("should fail if the compressed user data still exceeds the custom data limit", func() {
+							userData = make([]byte, 70000)
+							_, err := rand.Read(userData)
+							Expect(err).NotTo(HaveOccurred())
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							expectedUserDataSecretRefRead()
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring("after compression, which exceeds the custom data limit of 65535 bytes")))
+							Expect(result).To(BeNil())
+						})
+					})
+				})
+
+				Context("user data encryption", func() {
+					const (
+						keyID             = "https://my-vault.vault.azure.net/keys/my-key"
+						machineIdentityID = "/subscriptions/sub/resourceGroups/identity-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/machines"
+						vaultID           = "/subscriptions/sub/resourceGroups/vault-rg/providers/Microsoft.KeyVault/vaults/my-vault"
+						roleDefinitionID  = "/subscriptions/sub/providers/Microsoft.Authorization/roleDefinitions/crypto-user"
+					)
+
+					var (
+						factory               *mockazureclient.MockFactory
+						keysClient            *mockazureclient.MockKeyVaultKeys
+						identityClient        *mockazureclient.MockManagedUserIdentity
+						resourceClient        *mockazureclient.MockResource
+						roleAssignmentsClient *mockazureclient.MockRoleAssignments
+						privateKey            *rsa.PrivateKey
+					)
+
+					expectKey := func(keyOps ...string) {
+						factory.EXPECT().KeyVaultKeys().Return(keysClient, nil)
+						keysClient.EXPECT().GetKey(ctx, keyID).Return(&azureclient.KeyVaultKey{Key: azureclient.JSONWebKey{
+							KID:    keyID + "/0123456789abcdef0123456789abcdef",
+							KTY:    "RSA",
+							KeyOps: keyOps,
+							N:      base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+							E:      base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+						}}, nil)
+					}
+
+					expectKeyVault := func(properties map[string]any) {
+						factory.EXPECT().ManagedUserIdentity().Return(identityClient, nil)
+						identityClient.EXPECT().Get(ctx, "identity-rg", "machines").Return(&armmsi.UserAssignedIdentitiesClientGetResponse{Identity: armmsi.Identity{
+							Properties: &armmsi.UserAssignedIdentityProperties{PrincipalID: ptr.To("principal")},
+						}}, nil)
+						factory.EXPECT().Resource().Return(resourceClient, nil)
+						resourceClient.EXPECT().List(ctx, &armresources.ClientListOptions{Filter: ptr.To("resourceType eq 'Microsoft.KeyVault/vaults' and name eq 'my-vault'")}).
+							Return([]*armresources.GenericResourceExpanded{{ID: ptr.To(vaultID)}}, nil)
+						resourceClient.EXPECT().GetByID(ctx, vaultID, "2023-07-01").Return(&armresources.GenericResource{ID: ptr.To(vaultID), Properties: properties}, nil)
+					}
+
+					expectRole := func(scope string, dataActions ...string) {
+						factory.EXPECT().RoleAssignments().Return(roleAssignmentsClient, nil)
+						roleAssignmentsClient.EXPECT().ListForPrincipal(ctx, vaultID, "principal").Return([]azureclient.RoleAssignment{{
+							Properties: azureclient.RoleAssignmentProperties{Scope: ptr.To(scope), RoleDefinitionID: ptr.To(roleDefinitionID)},
+						}}, nil)
+						roleAssignmentsClient.EXPECT().GetRoleDefinition(ctx, roleDefinitionID).Return(&azureclient.RoleDefinition{
+							Properties: azureclient.RoleDefinitionProperties{Permissions: []azureclient.Permission{{DataActions: dataActions}}},
+						}, nil)
+					}
+
+					// decryptUserData decrypts the user data in the same way as the script which is run by the machines.
+					decryptUserData := func(script string) []byte {
+						wrappedKey := regexp.MustCompile(`"value":"([^"]+)"`).FindStringSubmatch(script)
+						Expect(wrappedKey).To(HaveLen(2))
+						mac := regexp.MustCompile(`\[ "\$mac" = '([0-9a-f]+)' \]`).FindStringSubmatch(script)
+						Expect(mac).To(HaveLen(2))
+						_, payload, _ := strings.Cut(script, "<<'ENCRYPTED_USER_DATA_EOF'\n")
+						payload, _, _ = strings.Cut(payload, "\nENCRYPTED_USER_DATA_EOF\n")
+
+						wrapped, err := base64.RawURLEncoding.DecodeString(wrappedKey[1])
+						Expect(err).NotTo(HaveOccurred())
+						keyMaterial, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, wrapped, nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(keyMaterial).To(HaveLen(64))
+
+						encrypted, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(payload, "\n", ""))
+						Expect(err).NotTo(HaveOccurred())
+						hash := hmac.New(sha256.New, keyMaterial[32:])
+						hash.Write(encrypted)
+						Expect(hex.EncodeToString(hash.Sum(nil))).To(Equal(mac[1]))
+
+						block, err := aes.NewCipher(keyMaterial[:32])
+						Expect(err).NotTo(HaveOccurred())
+						compressed := make([]byte, len(encrypted)-aes.BlockSize)
+						cipher.NewCBCDecrypter(block, encrypted[:aes.BlockSize]).CryptBlocks(compressed, encrypted[aes.BlockSize:])
+						compressed = compressed[:len(compressed)-int(compressed[len(compressed)-1])]
+
+						zr, err := gzip.NewReader(bytes.NewReader(compressed))
+						Expect(err).NotTo(HaveOccurred())
+						decrypted, err := io.ReadAll(zr)
+						Expect(err).NotTo(HaveOccurred())
+						return decrypted
+					}
+
+					BeforeEach(func() {
+						factory = mockazureclient.NewMockFactory(ctrl)
+						keysClient = mockazureclient.NewMockKeyVaultKeys(ctrl)
+						identityClient = mockazureclient.NewMockManagedUserIdentity(ctrl)
+						resourceClient = mockazureclient.NewMockResource(ctrl)
+						roleAssignmentsClient = mockazureclient.NewMockRoleAssignments(ctrl)
+
+						var err error
+						privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+						Expect(err).NotTo(HaveOccurred())
+
+						userData = []byte("#!/bin/bash\necho provision\n")
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","userDataEncryption":{"keyID":"` + keyID + `"}}`),
+						}
+						infrastructureStatus.Identity.ID = machineIdentityID
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+					})
+
+					It("should replace the user data by a script which decrypts it with the Key Vault key", func() {
+						expectKey("wrapKey", "unwrapKey")
+						expectKeyVault(map[string]any{"enableRbacAuthorization": true})
+						expectRole(vaultID, "Microsoft.KeyVault/vaults/keys/*")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									cloudConfig := class["secret"].(map[string]interface{})["cloudConfig"].(string)
+									Expect(cloudConfig).To(HavePrefix("#!/bin/bash\n# The user data of this machine is encrypted with the Key Vault key " + keyID + "/0123456789abcdef0123456789abcdef.\n"))
+									Expect(cloudConfig).To(ContainSubstring("msi_res_id=" + url.QueryEscape(machineIdentityID) + "&resource=https%3A%2F%2Fvault.azure.net"))
+									Expect(cloudConfig).To(ContainSubstring("'" + keyID + "/0123456789abcdef0123456789abcdef/unwrapKey?api-version=7.4'"))
+									Expect(cloudConfig).NotTo(ContainSubstring("echo provision"))
+									Expect(decryptUserData(cloudConfig)).To(Equal(userData))
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+
+					It("should reuse the encrypted user data as long as the user data and the key are unchanged", func() {
+						cloudConfigs := func() []string {
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+							expectedUserDataSecretRefRead()
+
+							var cloudConfigs []string
+							chartApplier.
+								EXPECT().
+								ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									applyOptions := &kubernetes.ApplyOptions{}
+									for _, opt := range opts {
+										opt.MutateApplyOptions(applyOptions)
+									}
+									for _, class := range applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{}) {
+										cloudConfigs = append(cloudConfigs, class["secret"].(map[string]interface{})["cloudConfig"].(string))
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+							return cloudConfigs
+						}
+
+						expectKey("unwrapKey")
+						expectKeyVault(map[string]any{"accessPolicies": []any{map[string]any{"objectId": "principal", "permissions": map[string]any{"keys": []any{"Get", "UnwrapKey"}}}}})
+						encrypted := cloudConfigs()
+
+						expectKey("unwrapKey")
+						Expect(cloudConfigs()).To(Equal(encrypted))
+
+						userData = []byte("#!/bin/bash\necho changed\n")
+						expectKey("unwrapKey")
+						expectKeyVault(map[string]any{"accessPolicies": []any{map[string]any{"objectId": "principal", "permissions": map[string]any{"keys": []any{"all"}}}}})
+						changed := cloudConfigs()
+						Expect(changed).NotTo(Equal(encrypted))
+						Expect(decryptUserData(changed[0])).To(Equal(userData))
+					})
+
+					It("should fail if the identity is not permitted to unwrap keys with the key", func() {
+						expectKey("unwrapKey")
+						expectKeyVault(map[string]any{"enableRbacAuthorization": true})
+						// the role is assigned at another scope, hence it is not considered.
+						factory.EXPECT().RoleAssignments().Return(roleAssignmentsClient, nil)
+						roleAssignmentsClient.EXPECT().ListForPrincipal(ctx, vaultID, "principal").Return([]azureclient.RoleAssignment{{
+							Properties: azureclient.RoleAssignmentProperties{Scope: ptr.To("/subscriptions/sub/resourceGroups/other-rg"), RoleDefinitionID: ptr.To(roleDefinitionID)},
+						}}, nil)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("the identity " + machineIdentityID + " of the machines is not permitted to unwrap keys")))
+						Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+						Expect(result).To(BeNil())
+					})
+
+					It("should fail if the role of the identity does not permit unwrapping keys", func() {
+						expectKey("unwrapKey")
+						expectKeyVault(map[string]any{"enableRbacAuthorization": true})
+						expectRole(vaultID+"/keys/my-key", "Microsoft.KeyVault/vaults/keys/read")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						_, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("is not permitted to unwrap keys")))
+					})
+
+					It("should not verify the access of the identity if the Key Vault is not in the subscription of the shoot", func() {
+						expectKey("unwrapKey")
+						factory.EXPECT().ManagedUserIdentity().Return(identityClient, nil)
+						identityClient.EXPECT().Get(ctx, "identity-rg", "machines").Return(&armmsi.UserAssignedIdentitiesClientGetResponse{Identity: armmsi.Identity{
+							Properties: &armmsi.UserAssignedIdentityProperties{PrincipalID: ptr.To("principal")},
+						}}, nil)
+						factory.EXPECT().Resource().Return(resourceClient, nil)
+						resourceClient.EXPECT().List(ctx, gomock.Any()).Return(nil, nil)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						_, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should fail if the key does not permit unwrapping", func() {
+						expectKey("sign", "verify")
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("does not permit the unwrapKey operation")))
+						Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+						Expect(result).To(BeNil())
+					})
+
+					It("should fail if the key does not exist", func() {
+						factory.EXPECT().KeyVaultKeys().Return(keysClient, nil)
+						keysClient.EXPECT().GetKey(ctx, keyID).Return(nil, nil)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring(`user data encryption key "` + keyID + `" does not exist`)))
+						Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+						Expect(result).To(BeNil())
+					})
+
+					It("should fail if the machines have no identity", func() {
+						infrastructureStatus.Identity = nil
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring("requires an identity for the machines")))
+						Expect(result).To(BeNil())
+					})
+				})
+
+				Context("fallback zones", func() {
+					const zone3 = "3"
+
+					var deploymentName string
+
+					failedDeployment := func(zone string) machinev1alpha1.MachineDeployment {
+						return machinev1alpha1.MachineDeployment{
+							ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-z%s", deploymentName, zone)},
+							Status: machinev1alpha1.MachineDeploymentStatus{FailedMachines: []*machinev1alpha1.MachineSummary{{
+								Name:          "machine-" + zone,
+								LastOperation: machinev1alpha1.LastOperation{Description: `Code="ZonalAllocationFailed" Message="Allocation failed."`},
+							}}},
+						}
+					}
+
+					expectMachineDeployments := func(deployments ...machinev1alpha1.MachineDeployment) {
+						c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{}), client.InNamespace(namespace)).DoAndReturn(
+							func(_ context.Context, list *machinev1alpha1.MachineDeploymentList, _ ...client.ListOption) error {
+								list.Items = deployments
+								return nil
+							})
+					}
+
+					zonesOf := func(deployments worker.MachineDeployments) []string {
+						var zones []string
+						for _, deployment := range deployments {
+							zones = append(zones, deployment.Labels[corev1.LabelTopologyZone])
+						}
+						return zones
+					}
+
+					BeforeEach(func() {
+						deploymentName = fmt.Sprintf("%s-%s", namespace, namePoolZones)
+						infrastructureStatus.Networks.Subnets = append(infrastructureStatus.Networks.Subnets, apisazure.Subnet{
+							Name:    "subnet3",
+							Purpose: apisazure.PurposeNodes,
+							Zone:    ptr.To(zone3),
+						})
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","fallbackZones":["3"]}`),
+						}
+					})
+
+					It("should only use the zones of the worker pool as long as they can allocate machines", func() {
+						expectMachineDeployments(failedDeployment(zone1), machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName + "-z" + zone2}})
+						expectedUserDataSecretRefRead()
+
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(zonesOf(result)).To(Equal([]string{regionAndZone1, regionAndZone2}))
+					})
+
+					It("should expand into the fallback zone if the zones of the worker pool are exhausted", func() {
+						expectMachineDeployments(failedDeployment(zone1), failedDeployment(zone2))
+						expectedUserDataSecretRefRead()
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(zonesOf(result)).To(Equal([]string{regionAndZone1, regionAndZone2, region + "-" + zone3}))
+						Expect(result[2].Name).To(Equal(deploymentName + "-z" + zone3))
+						// the zones of the worker pool keep their share of the minimum and maximum of the worker pool
+						for i := range 2 {
+							Expect(result[i].Minimum).To(Equal(worker.DistributeOverZones(int32(i), w.Spec.Pools[0].Minimum, 2)))
+							Expect(result[i].Maximum).To(Equal(worker.DistributeOverZones(int32(i), w.Spec.Pools[0].Maximum, 2)))
+						}
+						// the fallback zone only gets the machines which cannot be allocated in the zones of the worker pool
+						Expect(result[2].Minimum).To(Equal(int32(2)))
+						Expect(result[2].Maximum).To(Equal(int32(2)))
+
+						statusWriter.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.Any()).DoAndReturn(
+							func(_ context.Context, worker *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+								Expect(worker.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus).FallbackZones).To(ConsistOf(apiv1alpha1.WorkerPoolFallbackZones{
+									Name:   namePoolZones,
+									Zones:  []string{zone3},
+									Demand: 2,
+								}))
+								return nil
+							})
+						Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+					})
+
+					It("should keep using the fallback zone of the worker status", func() {
+						w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta:      metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							FallbackZones: []apiv1alpha1.WorkerPoolFallbackZones{{Name: namePoolZones, Zones: []string{zone3}}},
+						})}
+						expectMachineDeployments()
+						expectedUserDataSecretRefRead()
+
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(zonesOf(result)).To(Equal([]string{regionAndZone1, regionAndZone2, region + "-" + zone3}))
+						// the zones of the worker pool allocate all machines again, hence the fallback zone is scaled down
+						Expect(result[2].Minimum).To(BeZero())
+						Expect(result[2].Maximum).To(BeZero())
+					})
+
+					Context("with the demand of the worker status", func() {
+						withDemand := func(demand int32) {
+							w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+								TypeMeta:      metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+								FallbackZones: []apiv1alpha1.WorkerPoolFallbackZones{{Name: namePoolZones, Zones: []string{zone3}, Demand: demand}},
+							})}
+						}
+
+						deployment := func(zone string, replicas, available int32) machinev1alpha1.MachineDeployment {
+							return machinev1alpha1.MachineDeployment{
+								ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-z%s", deploymentName, zone)},
+								Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+								Status:     machinev1alpha1.MachineDeploymentStatus{AvailableReplicas: available},
+							}
+						}
+
+						fallbackDemand := func() int32 {
+							expectedUserDataSecretRefRead()
+
+							result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(zonesOf(result)).To(Equal([]string{regionAndZone1, regionAndZone2, region + "-" + zone3}))
+							Expect(result[2].Minimum).To(Equal(result[2].Maximum))
+							return result[2].Minimum
+						}
+
+						It("should keep the fallback machines while the zones of the worker pool miss machines even if no machine failed", func() {
+							withDemand(2)
+							// the machine-controller-manager replaced the failed machines, they are not available yet
+							expectMachineDeployments(deployment(zone1, 3, 2), deployment(zone2, 3, 2))
+
+							Expect(fallbackDemand()).To(Equal(int32(2)))
+						})
+
+						It("should scale down the fallback zone as the machines of the zones of the worker pool become available", func() {
+							withDemand(2)
+							expectMachineDeployments(deployment(zone1, 3, 3), deployment(zone2, 3, 2))
+
+							Expect(fallbackDemand()).To(Equal(int32(1)))
+						})
+
+						It("should raise the demand to the missing machines while machines fail", func() {
+							withDemand(1)
+							failedZone1, failedZone2 := failedDeployment(zone1), failedDeployment(zone2)
+							failedZone1.Spec.Replicas, failedZone2.Spec.Replicas = 3, 3
+							failedZone1.Status.AvailableReplicas, failedZone2.Status.AvailableReplicas = 1, 2
+							expectMachineDeployments(failedZone1, failedZone2)
+
+							Expect(fallbackDemand()).To(Equal(int32(3)))
+						})
+
+						It("should not raise the demand for machines which are missing without failing", func() {
+							withDemand(1)
+							// the zones of the worker pool are scaled out or rolled
+							expectMachineDeployments(deployment(zone1, 3, 1), deployment(zone2, 3, 1))
+
+							Expect(fallbackDemand()).To(Equal(int32(1)))
+						})
+					})
+
+					It("should stop using the fallback zone once it is removed from the configuration", func() {
+						w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta:      metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							FallbackZones: []apiv1alpha1.WorkerPoolFallbackZones{{Name: namePoolZones, Zones: []string{zone3}}},
+						})}
+						w.Spec.Pools[0].ProviderConfig = nil
+						expectedUserDataSecretRefRead()
+
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(zonesOf(result)).To(Equal([]string{regionAndZone1, regionAndZone2}))
+					})
+				})
+
+				Context("additional subnets", func() {
+					var additionalSubnet string
+
+					BeforeEach(func() {
+						additionalSubnet = resourceGroupName + "-nodes-gpu"
+						infrastructureStatus.Networks.Subnets = append(infrastructureStatus.Networks.Subnets, apisazure.Subnet{
+							Name:    additionalSubnet,
+							Purpose: apisazure.PurposeNodes,
+						})
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","subnetName":"gpu"}`),
+						}
+					})
+
+					It("should place the machines of all zones in the assigned subnet", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						additionalData := []string{identityID, additionalSubnet}
+						workerPoolHash, _ := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, additionalData, append(additionalData, string(w.Spec.Pools[0].ProviderConfig.Raw)), nil)
+						Expect(result).To(HaveLen(2))
+						Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+						Expect(result[1].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone2)))
+					})
+
+					It("should fail if the assigned subnet belongs to another zone", func() {
+						infrastructureStatus.Networks.Subnets[2].Zone = &zone1
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("belongs to zone %q, but the pool uses zone %q", zone1, zone2))))
+						Expect(result).To(BeNil())
+					})
+
+					It("should fail if the assigned subnet does not exist", func() {
+						w.Spec.Pools[0].ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","subnetName":"batch"}`)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring(`cannot find additional subnet "batch"`)))
+						Expect(result).To(BeNil())
+					})
+				})
+
+				Context("marketplace agreements", func() {
+					BeforeEach(func() {
+						for i := range w.Spec.Pools {
+							w.Spec.Pools[i].MachineImage.Version = machineImageVersion
+							w.Spec.Pools[i].Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
+						}
+					})
+
+					expectMarketplaceAgreementSkipped := func(skipped bool) {
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									image := class["image"].(map[string]interface{})
+									Expect(image).To(HaveKeyWithValue("urn", machineImageURN))
+									if skipped {
+										Expect(image).To(HaveKeyWithValue("skipMarketplaceAgreement", true))
+									} else {
+										Expect(image).NotTo(HaveKey("skipMarketplaceAgreement"))
+									}
+								}
+								return nil
+							})
+					}
+
+					It("should not let the machine-controller-manager accept the agreements by default", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+						expectMarketplaceAgreementSkipped(true)
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+
+					It("should let the machine-controller-manager verify the agreements if the worker controller accepts them", func() {
+						expectGetSecretCallToWork(c, w)
+						scheme := runtime.NewScheme()
+						Expect(apisazure.AddToScheme(scheme)).To(Succeed())
+						Expect(apiv1alpha1.AddToScheme(scheme)).To(Succeed())
+						workerDelegate, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &config.MarketplaceAgreements{Accept: true}, nil)
+						Expect(err).NotTo(HaveOccurred())
+
+						expectedUserDataSecretRefRead()
+						expectMarketplaceAgreementSkipped(false)
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					})
+				})
+
+				Context("rolling update", func() {
+					It("should override the maximum surge and unavailability of the worker pool", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","rollingUpdate":{"maxSurge":"50%","maxUnavailable":2}}`),
+						}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+						for _, machineDeployment := range result {
+							Expect(machineDeployment.Strategy.Type).To(Equal(machinev1alpha1.RollingUpdateMachineDeploymentStrategyType))
+							Expect(machineDeployment.Strategy.RollingUpdate.UpdateConfiguration).To(Equal(machinev1alpha1.UpdateConfiguration{
+								MaxSurge:       ptr.To(intstr.FromString("50%")),
+								MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+							}))
+						}
+					})
+
+					It("should not replace the machines if only the rolling update changes", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"}`),
+						}
+						expectedUserDataSecretRefRead()
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","rollingUpdate":{"maxSurge":"50%"}}`),
+						}
+						expectedUserDataSecretRefRead()
+						resultWithRollingUpdate, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(resultWithRollingUpdate).To(HaveLen(2))
+						for i := range resultWithRollingUpdate {
+							Expect(resultWithRollingUpdate[i].ClassName).To(Equal(result[i].ClassName))
+						}
+					})
+
+					It("should not replace the machines if only the settings of the VMSS Flex change", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"}`),
+						}
+						expectedUserDataSecretRefRead()
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","scaleInPolicy":{"rule":"NewestVM"}}`),
+						}
+						expectedUserDataSecretRefRead()
+						resultWithVmoSettings, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(resultWithVmoSettings).To(HaveLen(2))
+						for i := range resultWithVmoSettings {
+							Expect(resultWithVmoSettings[i].ClassName).To(Equal(result[i].ClassName))
+						}
+					})
+
+					It("should keep the values of the worker pool which are not overridden", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","rollingUpdate":{"maxSurge":4}}`),
+						}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+						for _, machineDeployment := range result {
+							Expect(machineDeployment.Strategy.RollingUpdate.UpdateConfiguration).To(Equal(machinev1alpha1.UpdateConfiguration{
+								MaxSurge:       ptr.To(intstr.FromInt32(2)),
+								MaxUnavailable: &maxUnavailablePoolZones,
+							}))
+						}
+					})
+				})
+
+				Context("data disk performance", func() {
+					var (
+						factory    *mockazureclient.MockFactory
+						vmClient   *mockazureclient.MockVirtualMachine
+						diskClient *mockazureclient.MockDisk
+					)
+
+					diskID := func(name string) string {
+						return "/subscriptions/sub/resourceGroups/" + resourceGroupName + "/providers/Microsoft.Compute/disks/" + name
+					}
+
+					virtualMachine := func(name string) *armcompute.VirtualMachine {
+						return &armcompute.VirtualMachine{Properties: &armcompute.VirtualMachineProperties{StorageProfile: &armcompute.StorageProfile{
+							DataDisks: []*armcompute.DataDisk{
+								{Lun: ptr.To[int32](0), ManagedDisk: &armcompute.ManagedDiskParameters{ID: ptr.To(diskID(name + "-premium"))}},
+								{Lun: ptr.To[int32](1), ManagedDisk: &armcompute.ManagedDiskParameters{ID: ptr.To(diskID(name + "-ultra"))}},
+							},
+						}}}
+					}
+
+					disk := func(iops, throughput int64) *armcompute.Disk {
+						return &armcompute.Disk{Properties: &armcompute.DiskProperties{DiskIOPSReadWrite: ptr.To(iops), DiskMBpsReadWrite: ptr.To(throughput)}}
+					}
+
+					expectMachines := func() {
+						expectedUserDataSecretRefRead()
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).DoAndReturn(
+							func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+								list.Items = []machinev1alpha1.Machine{
+									{ObjectMeta: metav1.ObjectMeta{Name: "machine-1"}, Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: result[0].ClassName}}},
+									{ObjectMeta: metav1.ObjectMeta{Name: "machine-2"}, Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: "outdated-class"}}},
+									{ObjectMeta: metav1.ObjectMeta{Name: "machine-3"}, Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: result[1].ClassName}}},
+								}
+								return nil
+							})
+					}
+
+					BeforeEach(func() {
+						factory = mockazureclient.NewMockFactory(ctrl)
+						vmClient = mockazureclient.NewMockVirtualMachine(ctrl)
+						diskClient = mockazureclient.NewMockDisk(ctrl)
+
+						w.Spec.Pools[0].DataVolumes = []extensionsv1alpha1.DataVolume{
+							{Name: "ultra", Type: ptr.To("UltraSSD_LRS"), Size: "100Gi"},
+							{Name: "premium", Type: ptr.To("Premium_LRS"), Size: "10Gi"},
+						}
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","dataVolumes":[{"name":"ultra","provisionedIops":5000,"provisionedThroughput":200}]}`),
+						}
+					})
+
+					It("should adjust the performance of the Ultra disks of the current machines online", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+						expectMachines()
+
+						factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+						factory.EXPECT().Disk().Return(diskClient, nil)
+						vmClient.EXPECT().Get(ctx, resourceGroupName, "machine-1", nil).Return(virtualMachine("machine-1"), nil)
+						vmClient.EXPECT().Get(ctx, resourceGroupName, "machine-3", nil).Return(nil, nil)
+						diskClient.EXPECT().Get(ctx, resourceGroupName, "machine-1-ultra").Return(disk(2000, 200), nil)
+						diskClient.EXPECT().Update(ctx, resourceGroupName, "machine-1-ultra", armcompute.DiskUpdate{
+							Properties: &armcompute.DiskUpdateProperties{DiskIOPSReadWrite: ptr.To[int64](5000)},
+						}).Return(disk(5000, 200), nil)
+
+						Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+					})
+
+					It("should not update disks which already provide the configured performance", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+						expectMachines()
+
+						factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+						factory.EXPECT().Disk().Return(diskClient, nil)
+						vmClient.EXPECT().Get(ctx, resourceGroupName, "machine-1", nil).Return(virtualMachine("machine-1"), nil)
+						vmClient.EXPECT().Get(ctx, resourceGroupName, "machine-3", nil).Return(virtualMachine("machine-3"), nil)
+						diskClient.EXPECT().Get(ctx, resourceGroupName, "machine-1-ultra").Return(disk(5000, 200), nil)
+						diskClient.EXPECT().Get(ctx, resourceGroupName, "machine-3-ultra").Return(disk(5000, 200), nil)
+
+						Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+					})
+
+					It("should not replace the machines if only the provisioned performance changes", func() {
+						expectedUserDataSecretRefRead()
+						result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						w.Spec.Pools[0].ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","dataVolumes":[{"name":"ultra","provisionedIops":20000}]}`)
+						updatedResult, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(updatedResult[0].ClassName).To(Equal(result[0].ClassName))
+						Expect(updatedResult[1].ClassName).To(Equal(result[1].ClassName))
+					})
+
+					It("should not adjust any disk if no performance is configured", func() {
+						w.Spec.Pools[0].ProviderConfig = nil
+
+						Expect(wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory).PostReconcileHook(ctx)).To(Succeed())
+					})
+				})
+
+				Context("pinned machine image version", func() {
+					BeforeEach(func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","machineImageVersion":"` + machineImageVersionCommunityID + `"}`),
+						}
+					})
+
+					It("should use the pinned version instead of the version of the Shoot", func() {
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						expectedUserDataSecretRefRead()
+
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								classes := applyOptions.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+								Expect(classes).To(HaveLen(2))
+								for _, class := range classes {
+									Expect(class["image"]).To(Equal(map[string]interface{}{"communityGalleryImageID": machineImageCommunityID}))
+									Expect(class["operatingSystem"]).To(HaveKeyWithValue("operatingSystemVersion", strings.ReplaceAll(machineImageVersionCommunityID, "+", "_")))
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+						pinnedPool := *w.Spec.Pools[0].DeepCopy()
+						pinnedPool.MachineImage.Version = machineImageVersionCommunityID
+						additionalData := []string{identityID}
+						workerPoolHash, _ := worker.WorkerPoolHash(pinnedPool, cluster, additionalData, append(additionalData, string(pinnedPool.ProviderConfig.Raw)), nil)
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+					})
+
+					It("should fail if the pinned version has no image in the CloudProfile", func() {
+						w.Spec.Pools[0].ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","machineImageVersion":"0.0.1"}`)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(MatchError(ContainSubstring(machineImageName + "/0.0.1/")))
+						Expect(result).To(BeNil())
+					})
+				})
 			})
 
 			Describe("workers with in-place updates strategy", func() {
@@ -1077,7 +2124,7 @@ var _ = Describe("Machines", func() {
 									OrchestrationType: machinev1alpha1.OrchestrationTypeAuto,
 								},
 							},
-							Labels:                       zone1Labels,
+							Labels:                       zone1NodeLabels,
 							MachineConfiguration:         &machinev1alpha1.MachineConfiguration{},
 							ClusterAutoscalerAnnotations: emptyClusterAutoscalerAnnotations,
 						},
@@ -5,7 +5,9 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -14,7 +16,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -31,30 +35,55 @@ func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastru
 		return vmoDependencies, err
 	}
 
-	faultDomainCount, err := azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
-	if err != nil {
-		return nil, err
-	}
+	var capacityDrifts []string
 
 	// Deploy workerpool dependencies and store their status to be persistent in the worker provider status.
 	for _, workerPool := range w.worker.Spec.Pools {
-		vmoDependencyStatus, err := w.reconcileVMO(ctx, vmoClient, vmoDependencies, infrastructureStatus.ResourceGroup.Name, workerPool.Name, faultDomainCount)
+		workerConfig, err := w.decodeWorkerConfig(workerPool)
+		if err != nil {
+			return vmoDependencies, err
+		}
+
+		faultDomainCount, err := w.vmoFaultDomainCount(workerConfig)
+		if err != nil {
+			return vmoDependencies, err
+		}
+
+		vmoDependencyStatus, err := w.reconcileVMO(ctx, vmoClient, vmoDependencies, infrastructureStatus, workerPool, faultDomainCount, workerConfig)
 		if err != nil {
 			return vmoDependencies, err
 		}
 		vmoDependencies = appendVmoDependency(vmoDependencies, vmoDependencyStatus)
+
+		drift, err := w.vmoCapacityDrift(ctx, vmoClient, infrastructureStatus.ResourceGroup.Name, vmoDependencyStatus.Name, workerPool, workerConfig)
+		if err != nil {
+			return vmoDependencies, err
+		}
+		if drift != "" {
+			capacityDrifts = append(capacityDrifts, drift)
+		}
 	}
 
-	return vmoDependencies, nil
+	return vmoDependencies, w.updateCapacityDriftCondition(ctx, capacityDrifts)
 }
 
-func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vmss, dependencies []azureapi.VmoDependency, resourceGroupName, workerPoolName string, faultDomainCount int32) (*azureapi.VmoDependency, error) {
+func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vmss, dependencies []azureapi.VmoDependency, infrastructureStatus *azureapi.InfrastructureStatus, workerPool extensionsv1alpha1.WorkerPool, faultDomainCount int32, workerConfig *azureapi.WorkerConfig) (*azureapi.VmoDependency, error) {
 	var (
 		existingDependency *azureapi.VmoDependency
 		vmo                *armcompute.VirtualMachineScaleSet
 		err                error
+
+		workerPoolName    = workerPool.Name
+		resourceGroupName = infrastructureStatus.ResourceGroup.Name
+		identity          = infrastructureStatus.Identity
+		vmTags            = w.getVMTags(workerPool)
+		tags              = generateVmoTags(workerPoolName, vmTags)
 	)
 
+	if err := w.checkAzureMonitorAgent(ctx, workerConfig, identity); err != nil {
+		return nil, err
+	}
+
 	// Check if there is already a VMO dependency object for the workerpool in the status.
 	for _, dep := range dependencies {
 		if dep.PoolName == workerPoolName {
@@ -71,26 +100,120 @@ func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vm
 		}
 	}
 
+	// VMOs of earlier versions of the extension had the Azure Monitor Agent installed and were associated with the data
+	// collection rule. The association is removed before the agent, so that it is not forgotten if the update fails.
+	if vmo != nil && vmoExtensionSettings(vmo, monitorAgentExtensionName) != nil {
+		if err := w.deleteAzureMonitorAgentAssociation(ctx, *vmo.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	var managedTagKeys []string
+	if existingDependency != nil {
+		managedTagKeys = existingDependency.TagKeys
+	}
+	dependency, err := w.reconcileVMOProperties(ctx, client, vmo, resourceGroupName, workerPoolName, faultDomainCount, workerConfig, tags, managedTagKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// The machine-controller-manager only sets the tags when it creates the virtual machines, hence the tags of the
+	// existing virtual machines of the VMO are reconciled as well.
+	if vmo != nil {
+		if err := w.reconcileVMTags(ctx, resourceGroupName, *vmo.ID, toTags(vmTags), managedTagKeys); err != nil {
+			return nil, err
+		}
+	}
+	return dependency, nil
+}
+
+// reconcileVMTags applies the given tags to the virtual machines of the VMO with the given ID and removes the tags
+// which the extension managed before but are no longer desired. The virtual machines are patched, so that they are
+// not touched otherwise.
+func (w *workerDelegate) reconcileVMTags(ctx context.Context, resourceGroupName, vmoID string, tags map[string]*string, managedTagKeys []string) error {
+	vmClient, err := w.clientFactory.VirtualMachine()
+	if err != nil {
+		return err
+	}
+	vms, err := vmClient.ListByVmss(ctx, resourceGroupName, vmoID)
+	if err != nil {
+		return fmt.Errorf("failed to list the virtual machines of VMO %s: %w", vmoID, err)
+	}
+
+	for _, vm := range vms {
+		if vm == nil || vm.Name == nil {
+			continue
+		}
+		merged := mergeManagedTags(vm.Tags, tags, managedTagKeys)
+		if tagsEqual(vm.Tags, merged) {
+			continue
+		}
+		if _, err := vmClient.UpdateTags(ctx, resourceGroupName, *vm.Name, merged); err != nil {
+			return fmt.Errorf("failed to update the tags of virtual machine %s: %w", *vm.Name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileVMOProperties creates the VMO if it does not exist or has to be replaced, and updates the properties of the
+// existing VMO which can be changed. Only the tags with the given managed keys and the desired tags are managed on the
+// existing VMO, tags which were added by other means are kept.
+func (w *workerDelegate) reconcileVMOProperties(ctx context.Context, client azureclient.Vmss, vmo *armcompute.VirtualMachineScaleSet, resourceGroupName, workerPoolName string, faultDomainCount int32, workerConfig *azureapi.WorkerConfig, tags map[string]*string, managedTagKeys []string) (*azureapi.VmoDependency, error) {
 	// VMO does not exists. Create it.
 	if vmo == nil {
-		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount)
+		return generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount, workerConfig, tags)
+	}
+
+	// A VMO which was added to a proximity placement group by other means requires a single placement group. Disabling
+	// it would recreate the VMO outside of the proximity placement group, hence it is refused.
+	if vmo.Properties.ProximityPlacementGroup != nil && !ptr.Deref(workerConfig.SinglePlacementGroup, false) {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the VMSS Flex %s of worker pool %s is in the proximity placement group %s, which requires a single placement group, hence the single placement group of the worker pool must be enabled",
+			ptr.Deref(vmo.Name, ""), workerPoolName, ptr.Deref(vmo.Properties.ProximityPlacementGroup.ID, "")), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	// VMO already exists. Check if the fault domain count or single placement group configuration has been changed.
+	// If yes then it is required to create a new VMO with the correct configuration, as Azure does not allow to enable
+	// the single placement group of an existing scale set.
+	if *vmo.Properties.PlatformFaultDomainCount != faultDomainCount || ptr.Deref(vmo.Properties.SinglePlacementGroup, false) != ptr.Deref(workerConfig.SinglePlacementGroup, false) {
+		return generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount, workerConfig, tags)
+	}
+
+	// The overprovisioning, the scale-in policy and the automatic repairs can be changed on the
+	// existing VMO. The VM extensions are installed on the virtual machines of the VMO, hence the VM extensions which
+	// VMOs of earlier versions of the extension still have are removed.
+	upToDate := !ptr.Deref(vmo.Properties.Overprovision, false) &&
+		scaleInPolicyUpToDate(vmo.Properties.ScaleInPolicy, workerConfig.ScaleInPolicy) &&
+		automaticRepairsUpToDate(vmo.Properties.AutomaticRepairsPolicy, workerConfig) &&
+		!vmoHasExtensions(vmo)
+	if !upToDate {
+		desired := generateVmo(workerPoolName, w.worker.Spec.Region, faultDomainCount, workerConfig, mergeManagedTags(vmo.Tags, tags, managedTagKeys))
+		if vmoHasExtensions(vmo) {
+			// Remove the VM extensions of the VMO.
+			desired.Properties.VirtualMachineProfile = &armcompute.VirtualMachineScaleSetVMProfile{
+				ExtensionProfile: &armcompute.VirtualMachineScaleSetExtensionProfile{Extensions: []*armcompute.VirtualMachineScaleSetExtension{}},
+			}
+		}
+		if desired.Properties.ScaleInPolicy == nil && vmo.Properties.ScaleInPolicy != nil {
+			// Reset the scale-in policy of the VMO.
+			desired.Properties.ScaleInPolicy = generateScaleInPolicy(&azureapi.ScaleInPolicy{})
+		}
+		updatedVMO, err := client.CreateOrUpdate(ctx, resourceGroupName, *vmo.Name, desired)
 		if err != nil {
 			return nil, err
 		}
-		return newVMO, nil
+		return generateVmoDependency(updatedVMO, workerPoolName, tags), nil
 	}
 
-	// VMO already exists. Check if the fault domain count configuration has been changed.
-	// If yes then it is required to create a new VMO with the correct configuration.
-	if *vmo.Properties.PlatformFaultDomainCount != faultDomainCount {
-		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount)
+	// The tags are patched, so that the machines of the VMO are not touched.
+	if merged := mergeManagedTags(vmo.Tags, tags, managedTagKeys); !tagsEqual(vmo.Tags, merged) {
+		updatedVMO, err := client.UpdateTags(ctx, resourceGroupName, *vmo.Name, merged)
 		if err != nil {
 			return nil, err
 		}
-		return newVMO, nil
+		return generateVmoDependency(updatedVMO, workerPoolName, tags), nil
 	}
 
-	return generateVmoDependency(vmo, workerPoolName), nil
+	return generateVmoDependency(vmo, workerPoolName, tags), nil
 }
 
 func (w *workerDelegate) cleanupVmoDependencies(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus) ([]azureapi.VmoDependency, error) {
@@ -162,12 +285,13 @@ func cleanupOrphanVMODependencies(ctx context.Context, client azureclient.Vmss,
 	return nil
 }
 
-func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, workerPoolName string, updateStrategy *gardencorev1beta1.MachineUpdateStrategy) (*azureapi.VmoDependency, error) {
+func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, workerPool extensionsv1alpha1.WorkerPool, workerConfig *azureapi.WorkerConfig) (*azureapi.VmoDependency, error) {
 	if !azureapihelper.IsVmoRequired(infrastructureStatus) {
 		return nil, nil
 	}
 
-	if gardencorev1beta1helper.IsUpdateStrategyInPlace(updateStrategy) {
+	workerPoolName := workerPool.Name
+	if gardencorev1beta1helper.IsUpdateStrategyInPlace(workerPool.UpdateStrategy) {
 		// TODO(KA): Remove when support for in-place update strategy with VMSS Flex is added.
 		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("worker pools with in-place update strategy is not supported when VMSS Flex are used"), gardencorev1beta1.ErrorConfigurationProblem)
 	}
@@ -211,7 +335,7 @@ func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, i
 		}
 	}
 	if existingVmo != nil {
-		existingVmoDependency := generateVmoDependency(existingVmo, workerPoolName)
+		existingVmoDependency := generateVmoDependency(existingVmo, workerPoolName, generateVmoTags(workerPoolName, w.getVMTags(workerPool)))
 		workerStatus.VmoDependencies = append(workerStatus.VmoDependencies, *existingVmoDependency)
 		if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
 			return nil, err
@@ -220,12 +344,12 @@ func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, i
 	}
 
 	// Third: No vmo for the worker pool was found on Azure. Need to create it.
-	faultDomainCount, err := azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
+	faultDomainCount, err := w.vmoFaultDomainCount(workerConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	newDependency, err := generateAndCreateVmo(ctx, vmoClient, workerPoolName, infrastructureStatus.ResourceGroup.Name, w.worker.Spec.Region, faultDomainCount)
+	newDependency, err := generateAndCreateVmo(ctx, vmoClient, workerPoolName, infrastructureStatus.ResourceGroup.Name, w.worker.Spec.Region, faultDomainCount, workerConfig, generateVmoTags(workerPoolName, w.getVMTags(workerPool)))
 	if err != nil {
 		return nil, err
 	}
@@ -237,18 +361,19 @@ func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, i
 }
 
 // VMO Helper
-func generateAndCreateVmo(ctx context.Context, client azureclient.Vmss, workerPoolName, resourceGroupName, region string, faultDomainCount int32) (*azureapi.VmoDependency, error) {
-	var properties = armcompute.VirtualMachineScaleSet{
-		Location: &region,
-		Properties: &armcompute.VirtualMachineScaleSetProperties{
-			SinglePlacementGroup:     ptr.To(false),
-			PlatformFaultDomainCount: &faultDomainCount,
-		},
-		Tags: map[string]*string{
-			azure.MachineSetTagKey:           ptr.To("1"),
-			azure.MachineSetWorkerNameTagKey: ptr.To(workerPoolName),
-		},
+
+// vmoFaultDomainCount returns the fault domain count of the VMO of a worker pool. It is the fault domain count of the
+// region in the CloudProfile unless the worker config of the pool configures a lower one, in which case the region
+// does not need to be listed in the CloudProfile.
+func (w *workerDelegate) vmoFaultDomainCount(workerConfig *azureapi.WorkerConfig) (int32, error) {
+	if workerConfig != nil && workerConfig.FaultDomainCount != nil {
+		return *workerConfig.FaultDomainCount, nil
 	}
+	return azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
+}
+
+func generateAndCreateVmo(ctx context.Context, client azureclient.Vmss, workerPoolName, resourceGroupName, region string, faultDomainCount int32, workerConfig *azureapi.WorkerConfig, tags map[string]*string) (*azureapi.VmoDependency, error) {
+	properties := generateVmo(workerPoolName, region, faultDomainCount, workerConfig, tags)
 
 	randomString, err := utils.GenerateRandomString(8)
 	if err != nil {
@@ -260,7 +385,207 @@ func generateAndCreateVmo(ctx context.Context, client azureclient.Vmss, workerPo
 		return nil, err
 	}
 
-	return generateVmoDependency(newVMO, workerPoolName), nil
+	return generateVmoDependency(newVMO, workerPoolName, tags), nil
+}
+
+func generateVmo(workerPoolName, region string, faultDomainCount int32, workerConfig *azureapi.WorkerConfig, tags map[string]*string) armcompute.VirtualMachineScaleSet {
+	return armcompute.VirtualMachineScaleSet{
+		Location: &region,
+		Properties: &armcompute.VirtualMachineScaleSetProperties{
+			SinglePlacementGroup:     ptr.To(ptr.Deref(workerConfig.SinglePlacementGroup, false)),
+			PlatformFaultDomainCount: &faultDomainCount,
+			// The machine-controller-manager tracks the machines of the worker pool individually, hence it would neither
+			// know nor remove the surplus virtual machines of an overprovisioned scale set.
+			Overprovision:          ptr.To(false),
+			AutomaticRepairsPolicy: generateAutomaticRepairsPolicy(workerConfig.AutomaticRepairs),
+			ScaleInPolicy:          generateScaleInPolicy(workerConfig.ScaleInPolicy),
+		},
+		Tags: tags,
+	}
+}
+
+// generateVmoTags returns the tags of the VMO of a worker pool, which are the tags of its machines and the tags that
+// mark the VMO as managed by Gardener.
+func generateVmoTags(workerPoolName string, vmTags map[string]string) map[string]*string {
+	tags := toTags(vmTags)
+	// The managed tags take precedence, as they are needed to find the VMOs of the worker pools.
+	tags[azure.MachineSetTagKey] = ptr.To("1")
+	tags[azure.MachineSetWorkerNameTagKey] = ptr.To(workerPoolName)
+	return tags
+}
+
+func toTags(values map[string]string) map[string]*string {
+	tags := make(map[string]*string, len(values)+2)
+	for k, v := range values {
+		tags[k] = ptr.To(v)
+	}
+	return tags
+}
+
+// mergeManagedTags returns the current tags with the desired tags applied and without the tags with the given managed
+// keys which are no longer desired. Tags which were added by other means, e.g. by Azure policies, are kept.
+func mergeManagedTags(current, desired map[string]*string, managedTagKeys []string) map[string]*string {
+	merged := make(map[string]*string, len(current)+len(desired))
+	for k, v := range current {
+		merged[k] = v
+	}
+	for _, k := range managedTagKeys {
+		if _, ok := desired[k]; !ok {
+			delete(merged, k)
+		}
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+	return merged
+}
+
+// tagsEqual checks if the given tags have the same keys and values.
+func tagsEqual(a, b map[string]*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range b {
+		value, ok := a[k]
+		if !ok || ptr.Deref(value, "") != ptr.Deref(v, "") {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	// healthExtensionName is the name of the application health extension of the virtual machines of a VMO.
+	healthExtensionName = "HealthExtension"
+	// healthExtensionPublisher is the publisher of the application health extension.
+	healthExtensionPublisher = "Microsoft.ManagedServices"
+	// healthExtensionType is the type of the application health extension for Linux machines.
+	healthExtensionType = "ApplicationHealthLinux"
+	// healthExtensionTypeHandlerVersion is the version of the application health extension which supports the rich
+	// health states required by automatic repairs.
+	healthExtensionTypeHandlerVersion = "2.0"
+)
+
+func generateAutomaticRepairsPolicy(automaticRepairs *azureapi.AutomaticRepairs) *armcompute.AutomaticRepairsPolicy {
+	if automaticRepairs == nil || !automaticRepairs.Enabled {
+		return &armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(false)}
+	}
+
+	policy := &armcompute.AutomaticRepairsPolicy{Enabled: ptr.To(true)}
+	if automaticRepairs.GracePeriodMinutes != nil {
+		policy.GracePeriod = ptr.To(fmt.Sprintf("PT%dM", *automaticRepairs.GracePeriodMinutes))
+	}
+	return policy
+}
+
+// generateScaleInPolicy returns the scale-in policy of a VMO, or nil if the WorkerConfig does not configure one, in
+// which case Azure applies the default rule.
+func generateScaleInPolicy(scaleInPolicy *azureapi.ScaleInPolicy) *armcompute.ScaleInPolicy {
+	if scaleInPolicy == nil {
+		return nil
+	}
+
+	rule := ptr.Deref(scaleInPolicy.Rule, azureapi.ScaleInPolicyRuleDefault)
+	return &armcompute.ScaleInPolicy{
+		Rules:         []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRules(rule))},
+		ForceDeletion: ptr.To(ptr.Deref(scaleInPolicy.ForceDeletion, false)),
+	}
+}
+
+// scaleInPolicyUpToDate checks if the scale-in policy of a VMO matches the WorkerConfig. A missing policy is equal to
+// the default rule without forced deletion.
+func scaleInPolicyUpToDate(current *armcompute.ScaleInPolicy, scaleInPolicy *azureapi.ScaleInPolicy) bool {
+	desired := generateScaleInPolicy(scaleInPolicy)
+	if desired == nil {
+		desired = generateScaleInPolicy(&azureapi.ScaleInPolicy{})
+	}
+	if current == nil {
+		current = &armcompute.ScaleInPolicy{}
+	}
+
+	currentRule := armcompute.VirtualMachineScaleSetScaleInRulesDefault
+	if len(current.Rules) > 0 && current.Rules[0] != nil {
+		currentRule = *current.Rules[0]
+	}
+	return currentRule == *desired.Rules[0] && ptr.Deref(current.ForceDeletion, false) == *desired.ForceDeletion
+}
+
+func generateHealthExtension(healthProbe *azureapi.HealthProbe) armcompute.VirtualMachineExtension {
+	return armcompute.VirtualMachineExtension{
+		Name: ptr.To(healthExtensionName),
+		Properties: &armcompute.VirtualMachineExtensionProperties{
+			Publisher:               ptr.To(healthExtensionPublisher),
+			Type:                    ptr.To(healthExtensionType),
+			TypeHandlerVersion:      ptr.To(healthExtensionTypeHandlerVersion),
+			AutoUpgradeMinorVersion: ptr.To(true),
+			Settings:                generateHealthExtensionSettings(healthProbe),
+		},
+	}
+}
+
+func generateHealthExtensionSettings(healthProbe *azureapi.HealthProbe) map[string]any {
+	settings := map[string]any{
+		"protocol": strings.ToLower(string(healthProbe.Protocol)),
+		"port":     healthProbe.Port,
+	}
+	if healthProbe.Path != nil {
+		settings["requestPath"] = *healthProbe.Path
+	}
+	if healthProbe.IntervalSeconds != nil {
+		settings["intervalInSeconds"] = *healthProbe.IntervalSeconds
+	}
+	return settings
+}
+
+// automaticRepairsUpToDate checks if the given automatic repairs policy of a VMO matches the WorkerConfig.
+func automaticRepairsUpToDate(current *armcompute.AutomaticRepairsPolicy, workerConfig *azureapi.WorkerConfig) bool {
+	desired := generateAutomaticRepairsPolicy(workerConfig.AutomaticRepairs)
+	if current == nil {
+		current = &armcompute.AutomaticRepairsPolicy{}
+	}
+	if ptr.Deref(current.Enabled, false) != *desired.Enabled {
+		return false
+	}
+	return desired.GracePeriod == nil || ptr.Deref(current.GracePeriod, "") == *desired.GracePeriod
+}
+
+// vmoHasExtensions checks if the extension profile of the given VMO contains one of the VM extensions which the
+// extension installs on the virtual machines of the VMO.
+func vmoHasExtensions(vmo *armcompute.VirtualMachineScaleSet) bool {
+	return vmoExtensionSettings(vmo, healthExtensionName) != nil || vmoExtensionSettings(vmo, monitorAgentExtensionName) != nil
+}
+
+// vmoExtensionSettings returns the settings of the VM extension of the VMO with the given name, or nil if the VMO does
+// not have the extension.
+func vmoExtensionSettings(vmo *armcompute.VirtualMachineScaleSet, name string) any {
+	profile := vmo.Properties.VirtualMachineProfile
+	if profile == nil || profile.ExtensionProfile == nil {
+		return nil
+	}
+	for _, extension := range profile.ExtensionProfile.Extensions {
+		if extension != nil && ptr.Deref(extension.Name, "") == name && extension.Properties != nil {
+			return extension.Properties.Settings
+		}
+	}
+	return nil
+}
+
+// vmoExtensionSettingsEqual compares the current settings of a VM extension with the desired ones in their JSON
+// representation, as Azure returns them as generic JSON objects. Nil settings mean that the extension does not exist.
+func vmoExtensionSettingsEqual(current, desired any) (bool, error) {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil, nil
+	}
+
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false, err
+	}
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(currentJSON, desiredJSON), nil
 }
 
 func copyVmoDependencies(workerStatus *azureapi.WorkerStatus) []azureapi.VmoDependency {
@@ -312,10 +637,13 @@ func filterGardenerManagedVmos(list []*armcompute.VirtualMachineScaleSet) []*arm
 	return filteredList
 }
 
-func generateVmoDependency(vmo *armcompute.VirtualMachineScaleSet, workerPoolName string) *azureapi.VmoDependency {
+// generateVmoDependency returns the dependency of a worker pool to the given VMO, which records the keys of the given
+// tags as the tags managed by the extension.
+func generateVmoDependency(vmo *armcompute.VirtualMachineScaleSet, workerPoolName string, tags map[string]*string) *azureapi.VmoDependency {
 	return &azureapi.VmoDependency{
 		ID:       *vmo.ID,
 		Name:     *vmo.Name,
 		PoolName: workerPoolName,
+		TagKeys:  sets.List(sets.KeySet(tags)),
 	}
 }
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// AnnotationAcceptedMarketplaceAgreements is the annotation of the Worker which records the marketplace agreements
+	// accepted by the extension as JSON list of AcceptedMarketplaceAgreement.
+	AnnotationAcceptedMarketplaceAgreements = "azure.provider.extensions.gardener.cloud/accepted-marketplace-agreements"
+	// EventReasonMarketplaceAgreementAccepted is the reason of the event of the Worker which is recorded when the
+	// extension accepts a marketplace agreement.
+	EventReasonMarketplaceAgreementAccepted = "MarketplaceAgreementAccepted"
+)
+
+// AcceptedMarketplaceAgreement is the audit record of a marketplace agreement accepted by the extension.
+type AcceptedMarketplaceAgreement struct {
+	// Publisher is the publisher of the plan.
+	Publisher string `json:"publisher"`
+	// Product is the product, i.e. the offer, of the plan.
+	Product string `json:"product"`
+	// Plan is the name of the plan.
+	Plan string `json:"plan"`
+	// SubscriptionID is the subscription in which the agreement was accepted.
+	SubscriptionID string `json:"subscriptionID"`
+	// AcceptedBy is the client ID of the credentials which accepted the agreement.
+	AcceptedBy string `json:"acceptedBy"`
+	// AcceptedAt is the time at which the agreement was accepted.
+	AcceptedAt metav1.Time `json:"acceptedAt"`
+}
+
+// reconcileMarketplaceAgreements verifies that the agreements of the marketplace plans of the URN images of the worker
+// pools are accepted in the subscription of the Worker. Images without a plan do not have an agreement. If the
+// acceptance is enabled, agreements which are not accepted yet are accepted and recorded in an event and an
+// annotation of the Worker. Otherwise, the reconciliation fails with a configuration problem. Nothing is verified if
+// the marketplace agreements are not configured.
+func (w *workerDelegate) reconcileMarketplaceAgreements(ctx context.Context) error {
+	if w.marketplaceAgreements == nil {
+		return nil
+	}
+
+	urns := sets.New[string]()
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		version := pool.MachineImage.Version
+		if workerConfig.MachineImageVersion != nil {
+			version = *workerConfig.MachineImageVersion
+		}
+		arch := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
+
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, version, &arch)
+		if err != nil {
+			return err
+		}
+		if machineImage.URN != nil && !ptr.Deref(machineImage.SkipMarketplaceAgreement, false) {
+			urns.Insert(*machineImage.URN)
+		}
+	}
+	if urns.Len() == 0 {
+		return nil
+	}
+
+	imagesClient, err := w.clientFactory.VirtualMachineImages()
+	if err != nil {
+		return err
+	}
+	agreementsClient, err := w.clientFactory.MarketplaceAgreements()
+	if err != nil {
+		return err
+	}
+
+	var (
+		accepted []AcceptedMarketplaceAgreement
+		verified = sets.New[string]()
+	)
+	for _, urn := range sets.List(urns) {
+		parts := strings.Split(urn, ":")
+		if len(parts) != 4 {
+			return fmt.Errorf("invalid machine image URN %q, expected format publisher:offer:sku:version", urn)
+		}
+
+		image, err := imagesClient.Get(ctx, w.worker.Spec.Region, parts[0], parts[1], parts[2], parts[3])
+		if err != nil {
+			return fmt.Errorf("failed to get the machine image %q: %w", urn, err)
+		}
+		if image == nil || image.Properties == nil || image.Properties.Plan == nil {
+			continue
+		}
+		plan := image.Properties.Plan
+		publisher, product, name := ptr.Deref(plan.Publisher, ""), ptr.Deref(plan.Product, ""), ptr.Deref(plan.Name, "")
+		key := strings.Join([]string{publisher, product, name}, ":")
+		if verified.Has(key) {
+			continue
+		}
+		verified.Insert(key)
+
+		agreement, err := agreementsClient.Get(ctx, publisher, product, name)
+		if err != nil {
+			return fmt.Errorf("failed to get the marketplace agreement of plan %q of product %q of publisher %q: %w", name, product, publisher, err)
+		}
+		if ptr.Deref(agreement.Properties.Accepted, false) {
+			continue
+		}
+
+		if !w.marketplaceAgreements.Accept {
+			return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf(
+				"the terms of plan %q of product %q of publisher %q required by machine image %q are not accepted in the subscription, "+
+					"please accept them manually, e.g. with 'az vm image terms accept --publisher %s --offer %s --plan %s', "+
+					"or ask the operator to enable the acceptance of marketplace agreements",
+				name, product, publisher, urn, publisher, product, name,
+			), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+
+		if _, err := agreementsClient.Accept(ctx, *agreement); err != nil {
+			return fmt.Errorf("failed to accept the marketplace agreement of plan %q of product %q of publisher %q: %w", name, product, publisher, err)
+		}
+		accepted = append(accepted, AcceptedMarketplaceAgreement{Publisher: publisher, Product: product, Plan: name, AcceptedAt: metav1.Now()})
+	}
+
+	if len(accepted) == 0 {
+		return nil
+	}
+	return w.recordAcceptedMarketplaceAgreements(ctx, accepted)
+}
+
+// acceptsMarketplaceAgreements checks if the worker controller is configured to accept the marketplace agreements of
+// the machine images.
+func (w *workerDelegate) acceptsMarketplaceAgreements() bool {
+	return w.marketplaceAgreements != nil && w.marketplaceAgreements.Accept
+}
+
+// recordAcceptedMarketplaceAgreements records the given accepted marketplace agreements in events and in the
+// annotation of the Worker. The annotation keeps the latest acceptance of each plan.
+func (w *workerDelegate) recordAcceptedMarketplaceAgreements(ctx context.Context, accepted []AcceptedMarketplaceAgreement) error {
+	auth, _, err := azureclient.GetClientAuthData(ctx, w.client, w.worker.Spec.SecretRef, false)
+	if err != nil {
+		return err
+	}
+
+	var records []AcceptedMarketplaceAgreement
+	if value, ok := w.worker.Annotations[AnnotationAcceptedMarketplaceAgreements]; ok {
+		// a malformed annotation is replaced, it is only an audit record
+		_ = json.Unmarshal([]byte(value), &records)
+	}
+
+	for _, agreement := range accepted {
+		agreement.SubscriptionID, agreement.AcceptedBy = auth.SubscriptionID, auth.ClientID
+		if w.recorder != nil {
+			w.recorder.Eventf(w.worker, corev1.EventTypeNormal, EventReasonMarketplaceAgreementAccepted,
+				"Accepted the marketplace agreement of plan %q of product %q of publisher %q in subscription %q with client %q",
+				agreement.Plan, agreement.Product, agreement.Publisher, agreement.SubscriptionID, agreement.AcceptedBy)
+		}
+
+		records = slices.DeleteFunc(records, func(record AcceptedMarketplaceAgreement) bool {
+			return record.Publisher == agreement.Publisher && record.Product == agreement.Product && record.Plan == agreement.Plan
+		})
+		records = append(records, agreement)
+	}
+	slices.SortFunc(records, func(a, b AcceptedMarketplaceAgreement) int {
+		return cmp.Or(cmp.Compare(a.Publisher, b.Publisher), cmp.Compare(a.Product, b.Product), cmp.Compare(a.Plan, b.Plan))
+	})
+
+	value, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	metav1.SetMetaDataAnnotation(&w.worker.ObjectMeta, AnnotationAcceptedMarketplaceAgreements, string(value))
+	return w.client.Patch(ctx, w.worker, patch)
+}
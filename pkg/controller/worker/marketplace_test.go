@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("MarketplaceAgreements", func() {
+	const (
+		namespace = "shoot--foobar--azure"
+		region    = "westeurope"
+	)
+
+	var (
+		ctx              context.Context
+		ctrl             *gomock.Controller
+		c                *mockclient.MockClient
+		factory          *mockazureclient.MockFactory
+		imagesClient     *mockazureclient.MockVirtualMachineImages
+		agreementsClient *mockazureclient.MockMarketplaceAgreements
+		recorder         *record.FakeRecorder
+
+		cluster *extensionscontroller.Cluster
+		w       *extensionsv1alpha1.Worker
+		image   *armcompute.VirtualMachineImage
+	)
+
+	newWorkerDelegate := func(marketplaceAgreements *config.MarketplaceAgreements) genericactuator.WorkerDelegate {
+		expectGetSecretCallToWork(c, w)
+
+		scheme := runtime.NewScheme()
+		Expect(apiazure.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		workerDelegate, err := NewWorkerDelegate(c, scheme, nil, "", w, cluster, factory, marketplaceAgreements, recorder)
+		Expect(err).NotTo(HaveOccurred())
+		return workerDelegate
+	}
+
+	agreement := func(accepted bool) *azureclient.MarketplaceAgreement {
+		return &azureclient.MarketplaceAgreement{Properties: azureclient.MarketplaceAgreementProperties{
+			Publisher: ptr.To("publisher"),
+			Product:   ptr.To("offer"),
+			Plan:      ptr.To("plan"),
+			Accepted:  ptr.To(accepted),
+		}}
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		factory = mockazureclient.NewMockFactory(ctrl)
+		imagesClient = mockazureclient.NewMockVirtualMachineImages(ctrl)
+		agreementsClient = mockazureclient.NewMockMarketplaceAgreements(ctrl)
+		recorder = record.NewFakeRecorder(10)
+
+		cluster = makeCluster("", region, nil, []v1alpha1.MachineImages{{
+			Name: "image",
+			Versions: []v1alpha1.MachineImageVersion{
+				{Version: "1.0.0", URN: ptr.To("publisher:offer:sku:1.0.0")},
+				{Version: "2.0.0", URN: ptr.To("publisher:offer:sku:2.0.0"), SkipMarketplaceAgreement: ptr.To(true)},
+				{Version: "3.0.0", ID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/images/image")},
+			},
+		}}, 3)
+		w = makeWorker(namespace, region, nil, nil, extensionsv1alpha1.WorkerPool{
+			Name:         "pool",
+			MachineImage: extensionsv1alpha1.MachineImage{Name: "image", Version: "1.0.0"},
+		})
+		image = &armcompute.VirtualMachineImage{Properties: &armcompute.VirtualMachineImageProperties{
+			Plan: &armcompute.PurchasePlan{Publisher: ptr.To("publisher"), Product: ptr.To("offer"), Name: ptr.To("plan")},
+		}}
+	})
+
+	It("should not verify the agreements if they are not configured", func() {
+		Expect(newWorkerDelegate(nil).PreReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should not verify the agreements of images which skip them or are no marketplace images", func() {
+		for _, version := range []string{"2.0.0", "3.0.0"} {
+			w.Spec.Pools[0].MachineImage.Version = version
+			Expect(newWorkerDelegate(&config.MarketplaceAgreements{Accept: true}).PreReconcileHook(ctx)).To(Succeed())
+		}
+	})
+
+	Context("marketplace images", func() {
+		BeforeEach(func() {
+			factory.EXPECT().VirtualMachineImages().Return(imagesClient, nil)
+			factory.EXPECT().MarketplaceAgreements().Return(agreementsClient, nil)
+		})
+
+		It("should succeed if the image has no plan", func() {
+			imagesClient.EXPECT().Get(ctx, region, "publisher", "offer", "sku", "1.0.0").Return(&armcompute.VirtualMachineImage{Properties: &armcompute.VirtualMachineImageProperties{}}, nil)
+
+			Expect(newWorkerDelegate(&config.MarketplaceAgreements{Accept: true}).PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should succeed if the agreement is already accepted", func() {
+			imagesClient.EXPECT().Get(ctx, region, "publisher", "offer", "sku", "1.0.0").Return(image, nil)
+			agreementsClient.EXPECT().Get(ctx, "publisher", "offer", "plan").Return(agreement(true), nil)
+
+			Expect(newWorkerDelegate(&config.MarketplaceAgreements{}).PreReconcileHook(ctx)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should fail with a configuration problem if the agreement is not accepted and the acceptance is disabled", func() {
+			imagesClient.EXPECT().Get(ctx, region, "publisher", "offer", "sku", "1.0.0").Return(image, nil)
+			agreementsClient.EXPECT().Get(ctx, "publisher", "offer", "plan").Return(agreement(false), nil)
+
+			err := newWorkerDelegate(&config.MarketplaceAgreements{}).PreReconcileHook(ctx)
+			Expect(err).To(MatchError(ContainSubstring("az vm image terms accept --publisher publisher --offer offer --plan plan")))
+			Expect(gardencorev1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should accept the agreement and record the acceptance", func() {
+			imagesClient.EXPECT().Get(ctx, region, "publisher", "offer", "sku", "1.0.0").Return(image, nil)
+			agreementsClient.EXPECT().Get(ctx, "publisher", "offer", "plan").Return(agreement(false), nil)
+			agreementsClient.EXPECT().Accept(ctx, *agreement(false)).Return(agreement(true), nil)
+			c.EXPECT().Patch(ctx, w, gomock.Any()).Return(nil)
+
+			Expect(newWorkerDelegate(&config.MarketplaceAgreements{Accept: true}).PreReconcileHook(ctx)).To(Succeed())
+
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonMarketplaceAgreementAccepted)))
+			var records []AcceptedMarketplaceAgreement
+			Expect(json.Unmarshal([]byte(w.Annotations[AnnotationAcceptedMarketplaceAgreements]), &records)).To(Succeed())
+			Expect(records).To(ConsistOf(And(
+				HaveField("Publisher", "publisher"),
+				HaveField("Product", "offer"),
+				HaveField("Plan", "plan"),
+				HaveField("SubscriptionID", "1234"),
+				HaveField("AcceptedBy", "seedClient-id"),
+			)))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// monitorAgentExtensionName is the name of the Azure Monitor Agent extension of the virtual machines of a VMO.
+	monitorAgentExtensionName = "AzureMonitorLinuxAgent"
+	// monitorAgentExtensionPublisher is the publisher of the Azure Monitor Agent extension.
+	monitorAgentExtensionPublisher = "Microsoft.Azure.Monitor"
+	// monitorAgentExtensionType is the type of the Azure Monitor Agent extension for Linux machines.
+	monitorAgentExtensionType = "AzureMonitorLinuxAgent"
+	// monitorAgentExtensionTypeHandlerVersion is the major version of the Azure Monitor Agent extension.
+	monitorAgentExtensionTypeHandlerVersion = "1.0"
+	// monitorAgentAssociationName is the name of the association between a virtual machine and the data collection rule.
+	monitorAgentAssociationName = "gardener-azure-monitor-agent"
+)
+
+// checkAzureMonitorAgent checks that the machines of the worker pool can send data to the data collection rule, i.e.
+// that the rule exists and that the machines have a managed identity with which the agent authenticates.
+func (w *workerDelegate) checkAzureMonitorAgent(ctx context.Context, workerConfig *azureapi.WorkerConfig, identity *azureapi.IdentityStatus) error {
+	if workerConfig.AzureMonitorAgent == nil {
+		return nil
+	}
+	if identity == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the azure monitor agent requires an identity for the machines, but the infrastructure has none"), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	dcrClient, err := w.clientFactory.DataCollectionRules()
+	if err != nil {
+		return err
+	}
+	rule, err := dcrClient.Get(ctx, workerConfig.AzureMonitorAgent.DataCollectionRuleID)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("data collection rule %q does not exist", workerConfig.AzureMonitorAgent.DataCollectionRuleID), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return nil
+}
+
+// reconcileAzureMonitorAgentAssociation associates the virtual machine with the given resource ID with the data
+// collection rule of the Azure Monitor Agent, unless it is already associated with it.
+func reconcileAzureMonitorAgentAssociation(ctx context.Context, dcrClient azureclient.DataCollectionRules, vmID string, azureMonitorAgent *azureapi.AzureMonitorAgent) error {
+	association, err := dcrClient.GetAssociation(ctx, vmID, monitorAgentAssociationName)
+	if err != nil {
+		return err
+	}
+	if association != nil && strings.EqualFold(ptr.Deref(association.Properties.DataCollectionRuleID, ""), azureMonitorAgent.DataCollectionRuleID) {
+		return nil
+	}
+	return dcrClient.CreateOrUpdateAssociation(ctx, vmID, monitorAgentAssociationName, azureclient.DataCollectionRuleAssociation{
+		Properties: azureclient.DataCollectionRuleAssociationProperties{
+			DataCollectionRuleID: ptr.To(azureMonitorAgent.DataCollectionRuleID),
+			Description:          ptr.To("Association of the Azure Monitor Agent of the worker pool managed by Gardener."),
+		},
+	})
+}
+
+// deleteAzureMonitorAgentAssociation removes the association of the resource with the given ID with the data
+// collection rule of the Azure Monitor Agent.
+func (w *workerDelegate) deleteAzureMonitorAgentAssociation(ctx context.Context, resourceID string) error {
+	dcrClient, err := w.clientFactory.DataCollectionRules()
+	if err != nil {
+		return err
+	}
+	return dcrClient.DeleteAssociation(ctx, resourceID, monitorAgentAssociationName)
+}
+
+// generateAzureMonitorAgentExtension returns the Azure Monitor Agent extension of the virtual machines of a worker
+// pool.
+func generateAzureMonitorAgentExtension(identity *azureapi.IdentityStatus) armcompute.VirtualMachineExtension {
+	return armcompute.VirtualMachineExtension{
+		Name: ptr.To(monitorAgentExtensionName),
+		Properties: &armcompute.VirtualMachineExtensionProperties{
+			Publisher:               ptr.To(monitorAgentExtensionPublisher),
+			Type:                    ptr.To(monitorAgentExtensionType),
+			TypeHandlerVersion:      ptr.To(monitorAgentExtensionTypeHandlerVersion),
+			AutoUpgradeMinorVersion: ptr.To(true),
+			EnableAutomaticUpgrade:  ptr.To(true),
+			Settings:                generateAzureMonitorAgentExtensionSettings(identity),
+		},
+	}
+}
+
+// generateAzureMonitorAgentExtensionSettings returns the settings with which the agent authenticates with the
+// user-assigned identity of the machines.
+func generateAzureMonitorAgentExtensionSettings(identity *azureapi.IdentityStatus) map[string]any {
+	return map[string]any{
+		"authentication": map[string]any{
+			"managedIdentity": map[string]any{
+				"identifier-name":  "mi_res_id",
+				"identifier-value": identity.ID,
+			},
+		},
+	}
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// outboundPoolControllerName is the name of the controller which adds the machines to the outbound backend pool.
+	outboundPoolControllerName = "azure-outbound-pool"
+	// outboundPoolRequeueInterval is the interval after which a machine is reconciled again if its network interface does
+	// not exist yet.
+	outboundPoolRequeueInterval = 10 * time.Second
+)
+
+// OutboundPoolReconciler adds the network interfaces of the machines of all worker pools to the backend pool of the
+// outbound load balancer of the infrastructure and enables IP forwarding on the network interfaces of the machines of
+// the worker pools which configure it. The machine classes can neither reference a backend pool nor enable IP
+// forwarding, hence the network interfaces are updated after the machine-controller-manager created them.
+type OutboundPoolReconciler struct {
+	Client client.Client
+	// Decoder decodes the WorkerConfig of the worker pools.
+	Decoder runtime.Decoder
+	// ClientFactory returns the factory for the Azure clients with the credentials of the given Worker.
+	ClientFactory func(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (azureclient.Factory, error)
+
+	factoriesMutex sync.Mutex
+	factories      map[types.UID]cachedClientFactory
+}
+
+// cachedClientFactory is the factory for the Azure clients of a Worker, which is reused for the machines of the Worker
+// until the Worker changes, e.g. because it is reconciled after its credentials were rotated.
+type cachedClientFactory struct {
+	resourceVersion string
+	factory         azureclient.Factory
+}
+
+// AddOutboundPoolControllerToManager adds the OutboundPoolReconciler to the given manager.
+func AddOutboundPoolControllerToManager(mgr manager.Manager, options controller.Options) error {
+	r := &OutboundPoolReconciler{
+		Client:  mgr.GetClient(),
+		Decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		ClientFactory: func(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (azureclient.Factory, error) {
+			return newClientFactory(ctx, mgr.GetClient(), worker, cluster)
+		},
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(outboundPoolControllerName).
+		WithOptions(options).
+		For(&machinev1alpha1.Machine{}, builder.WithPredicates(machinePhaseChangedPredicate())).
+		Watches(&extensionsv1alpha1.Worker{}, handler.EnqueueRequestsFromMapFunc(r.machinesOfWorker), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// machinePhaseChangedPredicate only lets the events of new machines and of machines whose phase changed pass, because
+// the network interface of a machine is created once and the status of the machines is updated frequently.
+func machinePhaseChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMachine, ok := e.ObjectOld.(*machinev1alpha1.Machine)
+			if !ok {
+				return false
+			}
+			newMachine, ok := e.ObjectNew.(*machinev1alpha1.Machine)
+			if !ok {
+				return false
+			}
+			return oldMachine.Status.CurrentStatus.Phase != newMachine.Status.CurrentStatus.Phase
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+func (r *OutboundPoolReconciler) machinesOfWorker(ctx context.Context, obj client.Object) []reconcile.Request {
+	worker, ok := obj.(*extensionsv1alpha1.Worker)
+	if !ok || worker.Spec.Type != azure.Type {
+		return nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(worker.Namespace)); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list machines", "namespace", worker.Namespace)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(machineList.Items))
+	for _, machine := range machineList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&machine)})
+	}
+	return requests
+}
+
+// Reconcile adds the network interface of the given machine to the outbound backend pool if the infrastructure has an
+// outbound load balancer, and enables IP forwarding on it if the worker pool of the machine configures it.
+func (r *OutboundPoolReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	machine := &machinev1alpha1.Machine{}
+	if err := r.Client.Get(ctx, request.NamespacedName, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if machine.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	worker, err := r.getWorker(ctx, machine.Namespace)
+	if err != nil || worker == nil {
+		return reconcile.Result{}, err
+	}
+	if worker.DeletionTimestamp != nil {
+		r.forgetClientFactory(worker.UID)
+		return reconcile.Result{}, nil
+	}
+	if worker.Spec.InfrastructureProviderStatus == nil {
+		return reconcile.Result{}, nil
+	}
+	infraStatus, err := helper.InfrastructureStatusFromRaw(worker.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	var poolID string
+	if infraStatus.Networks.OutboundLoadBalancer != nil {
+		poolID = infraStatus.Networks.OutboundLoadBalancer.BackendAddressPoolID
+	}
+	enableIPForwarding, err := r.enablesIPForwarding(worker, machine)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if poolID == "" && !enableIPForwarding {
+		return reconcile.Result{}, nil
+	}
+
+	factory, err := r.clientFactory(ctx, worker)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	nics, err := factory.NetworkInterface()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	nicName := machineNetworkInterfaceName(machine.Name)
+	nic, err := nics.Get(ctx, infraStatus.ResourceGroup.Name, nicName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if nic == nil {
+		log.V(1).Info("Network interface of machine does not exist yet", "machine", machine.Name, "nic", nicName)
+		return reconcile.Result{RequeueAfter: outboundPoolRequeueInterval}, nil
+	}
+
+	var changed bool
+	if poolID != "" && addToBackendPool(nic, poolID) {
+		log.Info("Adding network interface of machine to the outbound backend pool", "machine", machine.Name, "nic", nicName)
+		changed = true
+	}
+	if enableIPForwarding && enableIPForwardingOfNIC(nic) {
+		log.Info("Enabling IP forwarding on network interface of machine", "machine", machine.Name, "nic", nicName)
+		changed = true
+	}
+	if !changed {
+		return reconcile.Result{}, nil
+	}
+	if _, err := nics.CreateOrUpdate(ctx, infraStatus.ResourceGroup.Name, nicName, *nic); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update network interface %s: %w", nicName, err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// enablesIPForwarding checks if the WorkerConfig of the worker pool of the given machine enables IP forwarding. The
+// worker pool is identified by the pool label of the node template of the machine.
+func (r *OutboundPoolReconciler) enablesIPForwarding(worker *extensionsv1alpha1.Worker, machine *machinev1alpha1.Machine) (bool, error) {
+	poolName := machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]
+	for _, pool := range worker.Spec.Pools {
+		if pool.Name != poolName || pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+			continue
+		}
+		workerConfig := &azureapi.WorkerConfig{}
+		if _, _, err := r.Decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return false, fmt.Errorf("could not decode provider config of worker pool %q: %w", pool.Name, err)
+		}
+		return ptr.Deref(workerConfig.EnableIPForwarding, false), nil
+	}
+	return false, nil
+}
+
+// clientFactory returns the factory for the Azure clients of the given Worker. The factory is cached per Worker, so that
+// the credentials are not read for every machine event, and it is only created again once the Worker changed.
+func (r *OutboundPoolReconciler) clientFactory(ctx context.Context, worker *extensionsv1alpha1.Worker) (azureclient.Factory, error) {
+	r.factoriesMutex.Lock()
+	defer r.factoriesMutex.Unlock()
+
+	if cached, ok := r.factories[worker.UID]; ok && cached.resourceVersion == worker.ResourceVersion {
+		return cached.factory, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.Client, worker.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := r.ClientFactory(ctx, worker, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.factories == nil {
+		r.factories = map[types.UID]cachedClientFactory{}
+	}
+	r.factories[worker.UID] = cachedClientFactory{resourceVersion: worker.ResourceVersion, factory: factory}
+	return factory, nil
+}
+
+// forgetClientFactory removes the cached factory for the Azure clients of the Worker with the given UID.
+func (r *OutboundPoolReconciler) forgetClientFactory(uid types.UID) {
+	r.factoriesMutex.Lock()
+	defer r.factoriesMutex.Unlock()
+
+	delete(r.factories, uid)
+}
+
+// getWorker returns the Azure Worker in the given namespace or nil if there is none.
+func (r *OutboundPoolReconciler) getWorker(ctx context.Context, namespace string) (*extensionsv1alpha1.Worker, error) {
+	workerList := &extensionsv1alpha1.WorkerList{}
+	if err := r.Client.List(ctx, workerList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, worker := range workerList.Items {
+		if worker.Spec.Type == azure.Type {
+			return &worker, nil
+		}
+	}
+	return nil, nil
+}
+
+// machineNetworkInterfaceName returns the name of the network interface which the machine-controller-manager creates for
+// the machine with the given name.
+func machineNetworkInterfaceName(machineName string) string {
+	return machineName + "-nic"
+}
+
+// addToBackendPool adds the primary IP configuration of the given network interface to the backend pool with the given
+// ID. It returns false if the network interface is already in the backend pool.
+func addToBackendPool(nic *armnetwork.Interface, poolID string) bool {
+	if nic.Properties == nil || len(nic.Properties.IPConfigurations) == 0 {
+		return false
+	}
+
+	ipConfig := nic.Properties.IPConfigurations[0]
+	for _, c := range nic.Properties.IPConfigurations {
+		if c.Properties != nil && ptr.Deref(c.Properties.Primary, false) {
+			ipConfig = c
+			break
+		}
+	}
+	if ipConfig.Properties == nil {
+		ipConfig.Properties = &armnetwork.InterfaceIPConfigurationPropertiesFormat{}
+	}
+
+	for _, pool := range ipConfig.Properties.LoadBalancerBackendAddressPools {
+		if strings.EqualFold(ptr.Deref(pool.ID, ""), poolID) {
+			return false
+		}
+	}
+	ipConfig.Properties.LoadBalancerBackendAddressPools = append(ipConfig.Properties.LoadBalancerBackendAddressPools, &armnetwork.BackendAddressPool{ID: ptr.To(poolID)})
+	return true
+}
+
+// enableIPForwardingOfNIC enables IP forwarding on the given network interface. It returns false if IP forwarding is
+// already enabled.
+func enableIPForwardingOfNIC(nic *armnetwork.Interface) bool {
+	if nic.Properties == nil {
+		nic.Properties = &armnetwork.InterfacePropertiesFormat{}
+	}
+	if ptr.Deref(nic.Properties.EnableIPForwarding, false) {
+		return false
+	}
+	nic.Properties.EnableIPForwarding = ptr.To(true)
+	return true
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("OutboundPoolReconciler", func() {
+	const (
+		namespace     = "shoot--foobar--azure"
+		resourceGroup = namespace
+		poolID        = "/subscriptions/sub/resourceGroups/" + namespace + "/providers/Microsoft.Network/loadBalancers/" + namespace + "-outbound-lb/backendAddressPools/" + namespace + "-outbound-lb"
+	)
+
+	var (
+		ctx         context.Context
+		ctrl        *gomock.Controller
+		factory     *mockazureclient.MockFactory
+		nicClient   *mockazureclient.MockNetworkInterface
+		c           client.Client
+		reconciler  *OutboundPoolReconciler
+		infraStatus *v1alpha1.InfrastructureStatus
+		pools       []extensionsv1alpha1.WorkerPool
+	)
+
+	newMachine := func(name, pool string) *machinev1alpha1.Machine {
+		return &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: machinev1alpha1.MachineSpec{
+				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1beta1constants.LabelWorkerPool: pool}},
+				},
+			},
+		}
+	}
+	newNIC := func(pools ...*armnetwork.BackendAddressPool) *armnetwork.Interface {
+		return &armnetwork.Interface{
+			Properties: &armnetwork.InterfacePropertiesFormat{
+				IPConfigurations: []*armnetwork.InterfaceIPConfiguration{{
+					Name: ptr.To("primary"),
+					Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+						Primary:                         ptr.To(true),
+						LoadBalancerBackendAddressPools: pools,
+					},
+				}},
+			},
+		}
+	}
+	reconcileMachine := func(name string) (reconcile.Result, error) {
+		return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: name}})
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		nicClient = mockazureclient.NewMockNetworkInterface(ctrl)
+
+		infraStatus = &v1alpha1.InfrastructureStatus{
+			TypeMeta:      metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"},
+			ResourceGroup: v1alpha1.ResourceGroup{Name: resourceGroup},
+			Networks: v1alpha1.NetworkStatus{
+				OutboundLoadBalancer: &v1alpha1.OutboundLoadBalancerStatus{BackendAddressPoolID: poolID},
+			},
+		}
+		pools = []extensionsv1alpha1.WorkerPool{{Name: "pool-a"}, {Name: "pool-b"}}
+	})
+
+	JustBeforeEach(func() {
+		raw, err := json.Marshal(infraStatus)
+		Expect(err).NotTo(HaveOccurred())
+
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+			&extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					DefaultSpec:                  extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+					InfrastructureProviderStatus: &runtime.RawExtension{Raw: raw},
+					Pools:                        pools,
+				},
+			},
+			newMachine("pool-a-machine", "pool-a"),
+			newMachine("pool-b-machine", "pool-b"),
+		).Build()
+
+		decoderScheme := runtime.NewScheme()
+		Expect(apiazure.AddToScheme(decoderScheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(decoderScheme)).To(Succeed())
+
+		reconciler = &OutboundPoolReconciler{
+			Client:  c,
+			Decoder: serializer.NewCodecFactory(decoderScheme, serializer.EnableStrict).UniversalDecoder(),
+			ClientFactory: func(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (azureclient.Factory, error) {
+				return factory, nil
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should add the machines of all worker pools to the same backend pool", func() {
+		factory.EXPECT().NetworkInterface().Return(nicClient, nil).Times(2)
+		for _, machine := range []string{"pool-a-machine", "pool-b-machine"} {
+			otherPool := &armnetwork.BackendAddressPool{ID: ptr.To("internal-pool")}
+			nicClient.EXPECT().Get(ctx, resourceGroup, machine+"-nic").Return(newNIC(otherPool), nil)
+			nicClient.EXPECT().CreateOrUpdate(ctx, resourceGroup, machine+"-nic", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, nic armnetwork.Interface) (*armnetwork.Interface, error) {
+					Expect(nic.Properties.IPConfigurations[0].Properties.LoadBalancerBackendAddressPools).To(ConsistOf(
+						otherPool,
+						&armnetwork.BackendAddressPool{ID: ptr.To(poolID)},
+					))
+					return &nic, nil
+				})
+		}
+
+		for _, machine := range []string{"pool-a-machine", "pool-b-machine"} {
+			result, err := reconcileMachine(machine)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+		}
+	})
+
+	It("should create the client factory only once per version of the Worker", func() {
+		var factories int
+		reconciler.ClientFactory = func(context.Context, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) (azureclient.Factory, error) {
+			factories++
+			return factory, nil
+		}
+		factory.EXPECT().NetworkInterface().Return(nicClient, nil).Times(3)
+		nicClient.EXPECT().Get(ctx, resourceGroup, gomock.Any()).Return(newNIC(&armnetwork.BackendAddressPool{ID: ptr.To(poolID)}), nil).Times(3)
+
+		Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+		Expect(reconcileMachine("pool-b-machine")).To(Equal(reconcile.Result{}))
+		Expect(factories).To(Equal(1))
+
+		worker := &extensionsv1alpha1.Worker{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "worker"}, worker)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&worker.ObjectMeta, "foo", "bar")
+		Expect(c.Update(ctx, worker)).To(Succeed())
+
+		Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+		Expect(factories).To(Equal(2))
+	})
+
+	It("should not update a network interface which is already in the backend pool", func() {
+		factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+		nicClient.EXPECT().Get(ctx, resourceGroup, "pool-a-machine-nic").Return(newNIC(&armnetwork.BackendAddressPool{ID: ptr.To(poolID)}), nil)
+
+		Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue the machine if its network interface does not exist yet", func() {
+		factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+		nicClient.EXPECT().Get(ctx, resourceGroup, "pool-a-machine-nic").Return(nil, nil)
+
+		result, err := reconcileMachine("pool-a-machine")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).NotTo(BeZero())
+	})
+
+	It("should ignore machines which do not exist", func() {
+		Expect(reconcileMachine("unknown")).To(Equal(reconcile.Result{}))
+	})
+
+	Context("without outbound load balancer", func() {
+		BeforeEach(func() {
+			infraStatus.Networks.OutboundLoadBalancer = nil
+		})
+
+		It("should not touch the network interfaces", func() {
+			Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+		})
+
+		Context("with IP forwarding", func() {
+			BeforeEach(func() {
+				pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","enableIPForwarding":true}`)}
+			})
+
+			It("should only enable IP forwarding on the network interfaces of the worker pools which configure it", func() {
+				factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+				nicClient.EXPECT().Get(ctx, resourceGroup, "pool-a-machine-nic").Return(newNIC(), nil)
+				nicClient.EXPECT().CreateOrUpdate(ctx, resourceGroup, "pool-a-machine-nic", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, nic armnetwork.Interface) (*armnetwork.Interface, error) {
+						Expect(nic.Properties.EnableIPForwarding).To(PointTo(BeTrue()))
+						Expect(nic.Properties.IPConfigurations[0].Properties.LoadBalancerBackendAddressPools).To(BeEmpty())
+						return &nic, nil
+					})
+
+				Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+				Expect(reconcileMachine("pool-b-machine")).To(Equal(reconcile.Result{}))
+			})
+		})
+	})
+
+	Context("with IP forwarding", func() {
+		BeforeEach(func() {
+			pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","enableIPForwarding":true}`)}
+		})
+
+		It("should enable IP forwarding and add the network interface to the backend pool with one update", func() {
+			factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+			nicClient.EXPECT().Get(ctx, resourceGroup, "pool-a-machine-nic").Return(newNIC(), nil)
+			nicClient.EXPECT().CreateOrUpdate(ctx, resourceGroup, "pool-a-machine-nic", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, nic armnetwork.Interface) (*armnetwork.Interface, error) {
+					Expect(nic.Properties.EnableIPForwarding).To(PointTo(BeTrue()))
+					Expect(nic.Properties.IPConfigurations[0].Properties.LoadBalancerBackendAddressPools).To(ConsistOf(
+						&armnetwork.BackendAddressPool{ID: ptr.To(poolID)},
+					))
+					return &nic, nil
+				})
+
+			Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+		})
+
+		It("should not update a network interface which already forwards and is in the backend pool", func() {
+			nic := newNIC(&armnetwork.BackendAddressPool{ID: ptr.To(poolID)})
+			nic.Properties.EnableIPForwarding = ptr.To(true)
+			factory.EXPECT().NetworkInterface().Return(nicClient, nil)
+			nicClient.EXPECT().Get(ctx, resourceGroup, "pool-a-machine-nic").Return(nic, nil)
+
+			Expect(reconcileMachine("pool-a-machine")).To(Equal(reconcile.Result{}))
+		})
+	})
+})
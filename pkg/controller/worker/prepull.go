@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// prePullUnitName is the name of the systemd unit which pulls the configured images when a machine boots.
+	prePullUnitName = "azure-prepull-images.service"
+	// prePullScriptPath is the path of the script which is run by the pre-pull unit.
+	prePullScriptPath = "/var/lib/azure-prepull-images/prepull.sh"
+	// prePullTimeoutSeconds bounds the time the pre-pull unit waits for containerd and pulls the images. It is only a
+	// safeguard, because the kubelet does not wait for the unit.
+	prePullTimeoutSeconds = 1800
+	// prePullImageTimeoutSeconds bounds the time which is spent for pulling a single image.
+	prePullImageTimeoutSeconds = 600
+	// prePullRuntimeEndpoint is the CRI endpoint of containerd which the images are pulled with.
+	prePullRuntimeEndpoint = "unix:///run/containerd/containerd.sock"
+)
+
+// injectPrePullImages adds a systemd unit to the given user data which pulls the given images through the CRI of
+// containerd, so that they are already present when the first pods of the node start. Pulling through the CRI, like the
+// kubelet does, normalizes short image references and uses the registry mirrors and credentials of containerd. The unit is started without
+// waiting for it and the kubelet is not ordered after it, hence a slow or failing pull never delays the node readiness.
+// The user data must be a shell script. The image references must be validated beforehand, because they are embedded
+// into the script.
+func injectPrePullImages(userData []byte, images []string) ([]byte, error) {
+	if len(images) == 0 {
+		return userData, nil
+	}
+	if !bytes.HasPrefix(userData, []byte("#!")) {
+		return nil, fmt.Errorf("images can only be pre-pulled if the user data is a shell script")
+	}
+
+	shebang, rest, _ := bytes.Cut(userData, []byte("\n"))
+
+	var script strings.Builder
+	script.Write(shebang)
+	script.WriteString("\n")
+	script.WriteString(prePullSnippet(images))
+	script.Write(rest)
+	return []byte(script.String()), nil
+}
+
+func prePullSnippet(images []string) string {
+	quoted := make([]string, 0, len(images))
+	for _, image := range images {
+		quoted = append(quoted, "'"+image+"'")
+	}
+
+	return fmt.Sprintf(`# pre-pull the container images of the worker pool on a best-effort basis
+mkdir -p "$(dirname %[1]s)"
+cat <<'PREPULL_EOF' > %[1]s
+#!/bin/bash
+export PATH="$PATH:/opt/bin"
+for _ in $(seq 120); do
+  crictl --runtime-endpoint %[6]s info >/dev/null 2>&1 && break
+  sleep 5
+done
+for image in %[2]s; do
+  timeout %[3]d crictl --runtime-endpoint %[6]s pull "$image" >/dev/null || echo "failed to pre-pull image $image"
+done
+PREPULL_EOF
+cat <<'PREPULL_EOF' > /etc/systemd/system/%[4]s
+[Unit]
+Description=Pre-pull the container images of the worker pool
+After=network-online.target
+
+[Service]
+Type=oneshot
+TimeoutStartSec=%[5]d
+ExecStart=-/bin/bash %[1]s
+PREPULL_EOF
+systemctl daemon-reload || true
+systemctl start --no-block %[4]s || true
+`, prePullScriptPath, strings.Join(quoted, " "), prePullImageTimeoutSeconds, prePullUnitName, prePullTimeoutSeconds, prePullRuntimeEndpoint)
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// ConditionTypeEmptyWorkerPools is the type of the Worker condition which reports the worker pools that are scaled
+	// to zero. These worker pools have no nodes on purpose, hence they must not be mistaken for unhealthy worker pools.
+	ConditionTypeEmptyWorkerPools gardencorev1beta1.ConditionType = "EmptyWorkerPools"
+
+	// ReasonWorkerPoolsScaledToZero is the condition reason used when at least one worker pool is scaled to zero.
+	ReasonWorkerPoolsScaledToZero = "WorkerPoolsScaledToZero"
+	// ReasonNoWorkerPoolScaledToZero is the condition reason used when no worker pool is scaled to zero (anymore).
+	ReasonNoWorkerPoolScaledToZero = "NoWorkerPoolScaledToZero"
+)
+
+// EmptyWorkerPoolsCondition computes the EmptyWorkerPools condition of the given Worker based on the replicas of its
+// machine deployments. A worker pool is scaled to zero once all of its machine deployments neither want nor have any
+// machine. It returns nil if no worker pool is scaled to zero and there is no existing condition that needs to be reset.
+func EmptyWorkerPoolsCondition(clock clock.Clock, worker *extensionsv1alpha1.Worker, machineDeployments []machinev1alpha1.MachineDeployment) *gardencorev1beta1.Condition {
+	deployments := make(map[string]machinev1alpha1.MachineDeployment, len(machineDeployments))
+	for _, deployment := range machineDeployments {
+		deployments[deployment.Name] = deployment
+	}
+
+	var empty []string
+	for _, pool := range worker.Spec.Pools {
+		deploymentName := fmt.Sprintf("%s-%s", worker.Namespace, pool.Name)
+
+		deploymentNames := []string{deploymentName}
+		if len(pool.Zones) > 0 {
+			deploymentNames = nil
+			for _, zone := range pool.Zones {
+				deploymentNames = append(deploymentNames, fmt.Sprintf("%s-z%s", deploymentName, zone))
+			}
+		}
+
+		if scaledToZero(deployments, deploymentNames) {
+			empty = append(empty, fmt.Sprintf("%q", pool.Name))
+		}
+	}
+
+	existing := v1beta1helper.GetCondition(worker.Status.Conditions, ConditionTypeEmptyWorkerPools)
+	if len(empty) == 0 {
+		if existing == nil {
+			return nil
+		}
+		condition := v1beta1helper.UpdatedConditionWithClock(clock, *existing, gardencorev1beta1.ConditionFalse, ReasonNoWorkerPoolScaledToZero, "No worker pool is scaled to zero.")
+		return &condition
+	}
+
+	message := fmt.Sprintf("Worker pool(s) %s are scaled to zero and have no nodes on purpose. Their scale sets are kept, so that they can be scaled up again.", strings.Join(empty, ", "))
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, worker.Status.Conditions, ConditionTypeEmptyWorkerPools)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, ReasonWorkerPoolsScaledToZero, message)
+	return &condition
+}
+
+// scaledToZero checks if all the given machine deployments exist and neither want nor have any machine. Machine
+// deployments which do not exist yet are not considered to be scaled to zero, since their worker pool is still being
+// created.
+func scaledToZero(deployments map[string]machinev1alpha1.MachineDeployment, names []string) bool {
+	for _, name := range names {
+		deployment, ok := deployments[name]
+		if !ok || deployment.Spec.Replicas != 0 || deployment.Status.Replicas != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("EmptyWorkerPools", func() {
+	const namespace = "shoot--foobar--azure"
+
+	var (
+		fakeClock *testclock.FakeClock
+		w         *extensionsv1alpha1.Worker
+
+		deployment = func(name string, replicas, currentReplicas int32) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: currentReplicas},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{Name: "non-zonal"},
+					{Name: "zonal", Zones: []string{"1", "2"}},
+				},
+			},
+		}
+	})
+
+	Describe("#EmptyWorkerPoolsCondition", func() {
+		It("should return nil if no worker pool is scaled to zero and there is no existing condition", func() {
+			Expect(EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-non-zonal", 1, 1),
+				deployment(namespace+"-zonal-z1", 0, 0),
+				deployment(namespace+"-zonal-z2", 1, 1),
+			})).To(BeNil())
+		})
+
+		It("should report a non-zonal worker pool which is scaled to zero", func() {
+			condition := EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-non-zonal", 0, 0),
+				deployment(namespace+"-zonal-z1", 1, 1),
+				deployment(namespace+"-zonal-z2", 0, 0),
+			})
+
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Type).To(Equal(ConditionTypeEmptyWorkerPools))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonWorkerPoolsScaledToZero))
+			Expect(condition.Codes).To(BeEmpty())
+			Expect(condition.Message).To(ContainSubstring(`Worker pool(s) "non-zonal" are scaled to zero`))
+			Expect(condition.Message).NotTo(ContainSubstring(`"zonal"`))
+		})
+
+		It("should report a zonal worker pool once all of its zones are scaled to zero", func() {
+			condition := EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-non-zonal", 2, 2),
+				deployment(namespace+"-zonal-z1", 0, 0),
+				deployment(namespace+"-zonal-z2", 0, 0),
+			})
+
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring(`Worker pool(s) "zonal" are scaled to zero`))
+		})
+
+		It("should not report a worker pool whose machines are still being deleted", func() {
+			Expect(EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-non-zonal", 0, 1),
+			})).To(BeNil())
+		})
+
+		It("should not report a worker pool whose machine deployments do not exist yet", func() {
+			Expect(EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-zonal-z1", 0, 0),
+			})).To(BeNil())
+		})
+
+		It("should reset an existing condition once the worker pools are scaled up again", func() {
+			w.Status.Conditions = []gardencorev1beta1.Condition{{
+				Type:   ConditionTypeEmptyWorkerPools,
+				Status: gardencorev1beta1.ConditionTrue,
+				Reason: ReasonWorkerPoolsScaledToZero,
+			}}
+
+			condition := EmptyWorkerPoolsCondition(fakeClock, w, []machinev1alpha1.MachineDeployment{
+				deployment(namespace+"-non-zonal", 3, 3),
+			})
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonNoWorkerPoolScaledToZero))
+			Expect(condition.LastTransitionTime.Time).To(Equal(fakeClock.Now()))
+		})
+	})
+})
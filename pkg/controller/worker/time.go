@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+const (
+	// timesyncdConfigPath is the path of the drop-in of systemd-timesyncd with the NTP servers of the worker pool.
+	timesyncdConfigPath = "/etc/systemd/timesyncd.conf.d/90-worker-pool.conf"
+	// chronyConfigPath is the path of the sources file of chrony with the NTP servers of the worker pool.
+	chronyConfigPath = "/etc/chrony/sources.d/worker-pool.sources"
+)
+
+// injectTimeConfig adds the given time configuration to the given user data. The NTP servers are configured for both
+// systemd-timesyncd and chrony, since it depends on the machine image which of them synchronizes the clock. The servers
+// of the machine image are disabled, while reference clocks like the PTP clock of Hyper-V are kept. The user data must
+// be a shell script. The time configuration must be validated beforehand, because it is embedded into the script.
+func injectTimeConfig(userData []byte, timeConfig *azureapi.TimeConfig) ([]byte, error) {
+	snippet := timeConfigSnippet(timeConfig)
+	if snippet == "" {
+		return userData, nil
+	}
+	if !bytes.HasPrefix(userData, []byte("#!")) {
+		return nil, fmt.Errorf("the time configuration can only be applied if the user data is a shell script")
+	}
+
+	shebang, rest, _ := bytes.Cut(userData, []byte("\n"))
+
+	var script strings.Builder
+	script.Write(shebang)
+	script.WriteString("\n")
+	script.WriteString(snippet)
+	script.Write(rest)
+	return []byte(script.String()), nil
+}
+
+func timeConfigSnippet(timeConfig *azureapi.TimeConfig) string {
+	if timeConfig == nil {
+		return ""
+	}
+
+	var snippet strings.Builder
+	if len(timeConfig.NTPServers) > 0 {
+		chronySources := make([]string, 0, len(timeConfig.NTPServers))
+		for _, server := range timeConfig.NTPServers {
+			chronySources = append(chronySources, "server "+server+" iburst")
+		}
+
+		fmt.Fprintf(&snippet, `# configure the NTP servers of the worker pool
+mkdir -p "$(dirname %[1]s)" "$(dirname %[3]s)"
+cat <<'TIME_EOF' > %[1]s
+[Time]
+NTP=%[2]s
+FallbackNTP=
+TIME_EOF
+cat <<'TIME_EOF' > %[3]s
+%[4]s
+TIME_EOF
+if [ -f /etc/chrony/chrony.conf ]; then
+  sed -i -E 's/^(pool|server) /# &/' /etc/chrony/chrony.conf
+fi
+systemctl try-restart systemd-timesyncd.service chrony.service chronyd.service || true
+`, timesyncdConfigPath, strings.Join(timeConfig.NTPServers, " "), chronyConfigPath, strings.Join(chronySources, "\n"))
+	}
+	if timeConfig.Timezone != nil {
+		fmt.Fprintf(&snippet, `# configure the timezone of the worker pool
+ln -sf /usr/share/zoneinfo/%[1]s /etc/localtime
+echo '%[1]s' > /etc/timezone
+`, *timeConfig.Timezone)
+	}
+	return snippet.String()
+}
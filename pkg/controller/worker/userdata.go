@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+)
+
+// maxCustomDataBytes is the maximum size of the custom data of an Azure virtual machine after it was decoded from base64.
+const maxCustomDataBytes = 65535
+
+// prepareUserData returns the user data which is passed as custom data to the machines. If compression is requested,
+// the user data is compressed with gzip. The machine controller manager encodes the result with base64, hence it is
+// returned as is. An error is returned if the user data exceeds the custom data size limit of Azure.
+func prepareUserData(userData []byte, compress bool) ([]byte, error) {
+	if compress {
+		var buf bytes.Buffer
+		// the gzip header does not contain a modification time, hence the result is stable for the same user data.
+		zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := zw.Write(userData); err != nil {
+			return nil, fmt.Errorf("failed to compress the user data: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress the user data: %w", err)
+		}
+
+		if buf.Len() > maxCustomDataBytes {
+			return nil, fmt.Errorf("user data has %d bytes after compression, which exceeds the custom data limit of %d bytes", buf.Len(), maxCustomDataBytes)
+		}
+		return buf.Bytes(), nil
+	}
+
+	if len(userData) > maxCustomDataBytes {
+		return nil, fmt.Errorf("user data has %d bytes, which exceeds the custom data limit of %d bytes, consider enabling compressUserData if the machine image supports it", len(userData), maxCustomDataBytes)
+	}
+	return userData, nil
+}
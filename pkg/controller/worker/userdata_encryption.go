@@ -0,0 +1,432 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// userDataKeyBytes is the size of the random key material which is wrapped with the Key Vault key. It consists of
+	// the AES-256 key followed by the HMAC-SHA256 key.
+	userDataKeyBytes = 64
+	// userDataUnwrapAlgorithm is the algorithm with which the key material is wrapped with the Key Vault key.
+	userDataUnwrapAlgorithm = "RSA-OAEP-256"
+	// userDataKeyVaultAPIVersion is the API version of the Key Vault which is used by the machines to unwrap the key material.
+	userDataKeyVaultAPIVersion = "7.4"
+	// userDataUnwrapDataAction is the data action of the Azure RBAC roles which permits unwrapping keys with a Key Vault key.
+	userDataUnwrapDataAction = "Microsoft.KeyVault/vaults/keys/unwrap/action"
+	// keyVaultResourceAPIVersion is the API version of the Microsoft.KeyVault resource provider with which the Key Vault of
+	// the user data encryption key is read.
+	keyVaultResourceAPIVersion = "2023-07-01"
+)
+
+// encryptedUserDataEntry is the encrypted user data of a worker pool together with what it was encrypted from.
+type encryptedUserDataEntry struct {
+	userDataHash [sha256.Size]byte
+	key          azureclient.JSONWebKey
+	identityID   string
+	encrypted    []byte
+}
+
+var (
+	encryptedUserDataMutex sync.Mutex
+	// encryptedUserData caches the encrypted user data per worker pool. The user data is only encrypted again if the user
+	// data, the version of the key or the identity changed, since the encryption chooses a random key each time and would
+	// otherwise change the machine class secrets with every reconciliation. The cache does not survive restarts of the
+	// extension, the user data is encrypted once again then.
+	encryptedUserData = map[string]encryptedUserDataEntry{}
+)
+
+// getUserDataEncryptionKey reads the Key Vault key with which the user data is encrypted if the user data
+// encryption is configured. Keys are cached in the given map, so that each key is read only once per reconciliation.
+// The key is read with the Key Vault REST API, since the azkeys module of the Azure SDK is not a dependency of the
+// extension.
+func (w *workerDelegate) getUserDataEncryptionKey(ctx context.Context, workerConfig *azureapi.WorkerConfig, identity *azureapi.IdentityStatus, keys map[string]*azureclient.KeyVaultKey) (*azureclient.KeyVaultKey, error) {
+	if workerConfig.UserDataEncryption == nil {
+		return nil, nil
+	}
+	if identity == nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the encryption of the user data requires an identity for the machines, but the infrastructure has none"), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	keyID := workerConfig.UserDataEncryption.KeyID
+	if key, ok := keys[keyID]; ok {
+		return key, nil
+	}
+
+	keysClient, err := w.clientFactory.KeyVaultKeys()
+	if err != nil {
+		return nil, err
+	}
+	key, err := keysClient.GetKey(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the user data encryption key %q: %w", keyID, err)
+	}
+	if key == nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("user data encryption key %q does not exist", keyID), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	keys[keyID] = key
+	return key, nil
+}
+
+// encryptPoolUserData returns the encrypted user data of the given worker pool, see encryptUserData. The user data
+// which was encrypted before for the worker pool is reused as long as the user data, the version of the key and the
+// identity are unchanged. Whenever the user data is encrypted again, the access of the identity to the key is verified.
+func (w *workerDelegate) encryptPoolUserData(ctx context.Context, poolName string, userData []byte, key *azureclient.KeyVaultKey, identity *azureapi.IdentityStatus) ([]byte, error) {
+	cacheKey := w.worker.Namespace + "/" + poolName
+	userDataHash := sha256.Sum256(userData)
+
+	encryptedUserDataMutex.Lock()
+	cached, ok := encryptedUserData[cacheKey]
+	encryptedUserDataMutex.Unlock()
+	if ok && cached.userDataHash == userDataHash && cached.identityID == identity.ID &&
+		cached.key.KID == key.Key.KID && cached.key.N == key.Key.N && cached.key.E == key.Key.E {
+		return cached.encrypted, nil
+	}
+
+	encrypted, err := encryptUserData(userData, key, identity)
+	if err != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if err := w.verifyUserDataEncryptionKeyAccess(ctx, key, identity); err != nil {
+		return nil, err
+	}
+
+	encryptedUserDataMutex.Lock()
+	defer encryptedUserDataMutex.Unlock()
+	encryptedUserData[cacheKey] = encryptedUserDataEntry{userDataHash: userDataHash, key: key.Key, identityID: identity.ID, encrypted: encrypted}
+	return encrypted, nil
+}
+
+// verifyUserDataEncryptionKeyAccess verifies that the given managed identity of the machines is permitted to unwrap keys
+// with the given Key Vault key, either by an access policy of the Key Vault or by a role which is assigned to the
+// identity itself. The access cannot be determined, and is hence not verified, if the identity or the Key Vault is not
+// in the subscription of the shoot or if the credentials of the shoot are not permitted to read them.
+func (w *workerDelegate) verifyUserDataEncryptionKeyAccess(ctx context.Context, key *azureclient.KeyVaultKey, identity *azureapi.IdentityStatus) error {
+	log := logf.FromContext(ctx)
+
+	vaultName, keyName, err := keyVaultAndKeyName(key.Key.KID)
+	if err != nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	identityID, err := arm.ParseResourceID(identity.ID)
+	if err != nil {
+		return fmt.Errorf("identity of the machines has an invalid resource ID %q: %w", identity.ID, err)
+	}
+
+	identityClient, err := w.clientFactory.ManagedUserIdentity()
+	if err != nil {
+		return err
+	}
+	managedIdentity, err := identityClient.Get(ctx, identityID.ResourceGroupName, identityID.Name)
+	if azureclient.IsAzureAPIForbidden(err) || err == nil && (managedIdentity == nil || managedIdentity.Properties == nil || managedIdentity.Properties.PrincipalID == nil) {
+		log.Info("Cannot verify the access of the identity of the machines to the user data encryption key, the identity cannot be read", "identity", identity.ID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the identity %s of the machines: %w", identity.ID, err)
+	}
+	principalID := *managedIdentity.Properties.PrincipalID
+
+	resourceClient, err := w.clientFactory.Resource()
+	if err != nil {
+		return err
+	}
+	vaults, err := resourceClient.List(ctx, &armresources.ClientListOptions{
+		Filter: ptr.To(fmt.Sprintf("resourceType eq 'Microsoft.KeyVault/vaults' and name eq '%s'", vaultName)),
+	})
+	if err == nil && len(vaults) == 0 {
+		log.Info("Cannot verify the access of the identity of the machines to the user data encryption key, the Key Vault is not in the subscription of the shoot", "keyVault", vaultName)
+		return nil
+	}
+	var vault *armresources.GenericResource
+	if err == nil {
+		vault, err = resourceClient.GetByID(ctx, ptr.Deref(vaults[0].ID, ""), keyVaultResourceAPIVersion)
+	}
+	if azureclient.IsAzureAPIForbidden(err) || err == nil && vault == nil {
+		log.Info("Cannot verify the access of the identity of the machines to the user data encryption key, the Key Vault cannot be read", "keyVault", vaultName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the Key Vault %s of the user data encryption key: %w", vaultName, err)
+	}
+
+	properties := keyVaultProperties{}
+	if raw, err := json.Marshal(vault.Properties); err != nil || json.Unmarshal(raw, &properties) != nil {
+		return fmt.Errorf("failed to decode the properties of the Key Vault %s", vaultName)
+	}
+
+	permitted := false
+	if ptr.Deref(properties.EnableRbacAuthorization, false) {
+		if permitted, err = w.permittedToUnwrapByRole(ctx, *vault.ID, keyName, principalID); azureclient.IsAzureAPIForbidden(err) {
+			log.Info("Cannot verify the access of the identity of the machines to the user data encryption key, the role assignments cannot be read", "keyVault", vaultName)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read the roles of the identity %s of the machines: %w", identity.ID, err)
+		}
+	} else {
+		for _, policy := range properties.AccessPolicies {
+			if strings.EqualFold(policy.ObjectID, principalID) && slices.ContainsFunc(policy.Permissions.Keys, func(permission string) bool {
+				return strings.EqualFold(permission, "unwrapKey") || strings.EqualFold(permission, "all")
+			}) {
+				permitted = true
+				break
+			}
+		}
+	}
+	if !permitted {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the identity %s of the machines is not permitted to unwrap keys with the user data encryption key %s, the machines could not decrypt their user data", identity.ID, key.Key.KID), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return nil
+}
+
+// permittedToUnwrapByRole checks if a role is assigned to the principal with the given object ID at the Key Vault with
+// the given resource ID, at a scope above it or at the key with the given name, which permits unwrapping keys.
+func (w *workerDelegate) permittedToUnwrapByRole(ctx context.Context, vaultID, keyName, principalID string) (bool, error) {
+	roleAssignmentsClient, err := w.clientFactory.RoleAssignments()
+	if err != nil {
+		return false, err
+	}
+	assignments, err := roleAssignmentsClient.ListForPrincipal(ctx, vaultID, principalID)
+	if err != nil {
+		return false, err
+	}
+
+	vaultID = strings.ToLower(vaultID)
+	for _, assignment := range assignments {
+		scope := strings.ToLower(strings.TrimSuffix(ptr.Deref(assignment.Properties.Scope, ""), "/"))
+		if scope != "" && scope != vaultID && !strings.HasPrefix(vaultID, scope+"/") && scope != vaultID+"/keys/"+strings.ToLower(keyName) {
+			continue
+		}
+
+		definition, err := roleAssignmentsClient.GetRoleDefinition(ctx, ptr.Deref(assignment.Properties.RoleDefinitionID, ""))
+		if err != nil {
+			return false, err
+		}
+		if definition != nil && slices.ContainsFunc(definition.Properties.Permissions, func(permission azureclient.Permission) bool {
+			return permission.PermitsDataAction(userDataUnwrapDataAction)
+		}) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// keyVaultProperties are the properties of a Key Vault which determine the access to its keys. The armkeyvault module
+// of the Azure SDK is not a dependency of the extension, hence the Key Vault is read as generic resource.
+type keyVaultProperties struct {
+	EnableRbacAuthorization *bool                  `json:"enableRbacAuthorization,omitempty"`
+	AccessPolicies          []keyVaultAccessPolicy `json:"accessPolicies,omitempty"`
+}
+
+type keyVaultAccessPolicy struct {
+	ObjectID    string `json:"objectId"`
+	Permissions struct {
+		Keys []string `json:"keys,omitempty"`
+	} `json:"permissions"`
+}
+
+// keyVaultAndKeyName returns the name of the Key Vault and of the key of the given key identifier, e.g.
+// https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef.
+func keyVaultAndKeyName(kid string) (string, string, error) {
+	u, err := url.Parse(kid)
+	if err != nil {
+		return "", "", fmt.Errorf("key vault key has an invalid identifier %q", kid)
+	}
+	vaultName, _, _ := strings.Cut(u.Hostname(), ".")
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if vaultName == "" || len(segments) < 2 || segments[0] != "keys" || segments[1] == "" {
+		return "", "", fmt.Errorf("key vault key has an invalid identifier %q", kid)
+	}
+	return vaultName, segments[1], nil
+}
+
+// encryptUserData encrypts the given user data with a random key, which is wrapped with the given Key Vault key, and
+// returns a shell script which decrypts and runs it when the machine boots. The machine unwraps the key with the
+// Key Vault, authenticating with the given managed identity, hence the identity must be permitted to unwrap keys with
+// the Key Vault key. The user data must be a shell script.
+//
+// The result differs on each call, because the key and the initialization vector are chosen randomly, hence the result
+// is cached by encryptPoolUserData.
+func encryptUserData(userData []byte, key *azureclient.KeyVaultKey, identity *azureapi.IdentityStatus) ([]byte, error) {
+	if !bytes.HasPrefix(userData, []byte("#!")) {
+		return nil, fmt.Errorf("the user data can only be encrypted if it is a shell script")
+	}
+	publicKey, err := userDataEncryptionPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	kid, err := url.Parse(key.Key.KID)
+	if err != nil || kid.Scheme != "https" || strings.ContainsAny(key.Key.KID, "'\"\\ ") {
+		return nil, fmt.Errorf("key vault key has an invalid identifier %q", key.Key.KID)
+	}
+	_, vaultDNSSuffix, found := strings.Cut(kid.Hostname(), ".")
+	if !found {
+		return nil, fmt.Errorf("key vault key has an invalid identifier %q", key.Key.KID)
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(userData); err != nil {
+		return nil, fmt.Errorf("failed to compress the user data: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress the user data: %w", err)
+	}
+
+	keyMaterial := make([]byte, userDataKeyBytes)
+	if _, err := rand.Read(keyMaterial); err != nil {
+		return nil, err
+	}
+	aesKey, macKey := keyMaterial[:32], keyMaterial[32:]
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := pkcs7Pad(compressed.Bytes(), aes.BlockSize)
+	payload := make([]byte, aes.BlockSize+len(plaintext))
+	iv := payload[:aes.BlockSize]
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(payload[aes.BlockSize:], plaintext)
+
+	mac := hmac.New(sha256.New, macKey)
+	mac.Write(payload)
+
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, keyMaterial, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap the user data key: %w", err)
+	}
+
+	return []byte(userDataDecryptionScript(
+		key.Key.KID,
+		"https://"+vaultDNSSuffix,
+		identity.ID,
+		base64.RawURLEncoding.EncodeToString(wrappedKey),
+		hex.EncodeToString(mac.Sum(nil)),
+		base64.StdEncoding.EncodeToString(payload),
+	)), nil
+}
+
+// userDataEncryptionPublicKey returns the RSA public key of the given Key Vault key. The key must permit unwrapping,
+// because the machines unwrap the key material with it.
+func userDataEncryptionPublicKey(key *azureclient.KeyVaultKey) (*rsa.PublicKey, error) {
+	jwk := key.Key
+	if jwk.KTY != "RSA" && jwk.KTY != "RSA-HSM" {
+		return nil, fmt.Errorf("key vault key %q has type %q, but only RSA keys are supported", jwk.KID, jwk.KTY)
+	}
+	if !slices.Contains(jwk.KeyOps, "unwrapKey") {
+		return nil, fmt.Errorf("key vault key %q does not permit the unwrapKey operation", jwk.KID)
+	}
+
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.N, "="))
+	if err != nil {
+		return nil, fmt.Errorf("key vault key %q has an invalid modulus: %w", jwk.KID, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.E, "="))
+	if err != nil {
+		return nil, fmt.Errorf("key vault key %q has an invalid exponent: %w", jwk.KID, err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("key vault key %q is not a valid RSA key", jwk.KID)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	padding := blockSize - len(data)%blockSize
+	return append(data, bytes.Repeat([]byte{byte(padding)}, padding)...)
+}
+
+// userDataDecryptionScript returns the script which replaces the encrypted user data. It retries to fetch a token and
+// to unwrap the key because the network and the identity might not be ready right after the machine was created. The
+// decrypted user data is only stored on a tmpfs and removed after it was run.
+func userDataDecryptionScript(kid, vaultResource, identityID, wrappedKey, mac, payload string) string {
+	tokenURL := "http://169.254.169.254/metadata/identity/oauth2/token?" + url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {vaultResource},
+		"msi_res_id":  {identityID},
+	}.Encode()
+
+	return fmt.Sprintf(`#!/bin/bash
+# The user data of this machine is encrypted with the Key Vault key %[1]s.
+set -o errexit -o nounset -o pipefail
+
+workdir="$(mktemp -d -p /run)"
+trap 'rm -rf "$workdir"' EXIT
+
+token=""
+for _ in $(seq 60); do
+  token="$(curl -sf -H Metadata:true '%[2]s' | sed -n 's/.*"access_token":"\([^"]*\)".*/\1/p')" && [ -n "$token" ] && break
+  sleep 5
+done
+[ -n "$token" ] || { echo "failed to fetch a token for the Key Vault"; exit 1; }
+
+key=""
+for _ in $(seq 60); do
+  key="$(curl -sf -X POST -H "Authorization: Bearer $token" -H 'Content-Type: application/json' \
+    -d '{"alg":"%[3]s","value":"%[4]s"}' '%[1]s/unwrapKey?api-version=%[5]s' | sed -n 's/.*"value":"\([^"]*\)".*/\1/p')" && [ -n "$key" ] && break
+  sleep 5
+done
+[ -n "$key" ] || { echo "failed to unwrap the user data key with the Key Vault"; exit 1; }
+
+key="$(printf '%%s' "$key" | tr '_-' '/+')"
+while [ $(( ${#key} %% 4 )) -ne 0 ]; do key="$key="; done
+key="$(printf '%%s' "$key" | base64 -d | od -An -v -tx1 | tr -d ' \n')"
+
+base64 -d > "$workdir/payload" <<'ENCRYPTED_USER_DATA_EOF'
+%[6]s
+ENCRYPTED_USER_DATA_EOF
+
+mac="$(openssl dgst -sha256 -mac HMAC -macopt "hexkey:${key:64:64}" "$workdir/payload" | sed 's/.*= //')"
+[ "$mac" = '%[7]s' ] || { echo "the user data was modified"; exit 1; }
+
+iv="$(head -c 16 "$workdir/payload" | od -An -v -tx1 | tr -d ' \n')"
+tail -c +17 "$workdir/payload" | openssl enc -d -aes-256-cbc -K "${key:0:64}" -iv "$iv" | gunzip > "$workdir/user-data"
+unset key
+bash "$workdir/user-data"
+`, kid, tokenURL, userDataUnwrapAlgorithm, wrappedKey, userDataKeyVaultAPIVersion, wrapLines(payload, 76), mac)
+}
+
+func wrapLines(s string, width int) string {
+	var lines []string
+	for len(s) > width {
+		lines = append(lines, s[:width])
+		s = s[width:]
+	}
+	return strings.Join(append(lines, s), "\n")
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// reconcileVMExtensions installs the VM extensions which the WorkerConfig of their worker pool configures on the
+// virtual machines of the machines, and associates the virtual machines with the data collection rule of the Azure
+// Monitor Agent. The machine-controller-manager creates the virtual machines of a VMSS Flex individually, hence they
+// neither get the extensions of the extension profile of the VMSS Flex nor are covered by its associations. Only the
+// machines of the current machine classes are considered, machines which are created after the reconciliation get the
+// extensions with the next one. Extensions which are already installed with the desired settings are not updated.
+func (w *workerDelegate) reconcileVMExtensions(ctx context.Context) error {
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	if err != nil {
+		return err
+	}
+	if !helper.IsVmoRequired(infrastructureStatus) {
+		return nil
+	}
+
+	workerConfigByPool := map[string]*azureapi.WorkerConfig{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		if len(generateVMExtensions(workerConfig, infrastructureStatus.Identity)) > 0 {
+			workerConfigByPool[pool.Name] = workerConfig
+		}
+	}
+	if len(workerConfigByPool) == 0 {
+		return nil
+	}
+
+	machineDeployments, err := w.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	workerConfigByClass := map[string]*azureapi.WorkerConfig{}
+	for _, machineDeployment := range machineDeployments {
+		if workerConfig, ok := workerConfigByPool[machineDeployment.PoolName]; ok {
+			workerConfigByClass[machineDeployment.ClassName] = workerConfig
+		}
+	}
+
+	machines := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machines, client.InNamespace(w.worker.Namespace)); err != nil {
+		return err
+	}
+
+	var (
+		vmClient         azureclient.VirtualMachine
+		extensionsClient azureclient.VirtualMachineExtensions
+		dcrClient        azureclient.DataCollectionRules
+	)
+	for _, machine := range machines.Items {
+		workerConfig, ok := workerConfigByClass[machine.Spec.Class.Name]
+		if !ok {
+			continue
+		}
+
+		if vmClient == nil {
+			if vmClient, err = w.clientFactory.VirtualMachine(); err != nil {
+				return err
+			}
+			if extensionsClient, err = w.clientFactory.VirtualMachineExtensions(); err != nil {
+				return err
+			}
+		}
+		if workerConfig.AzureMonitorAgent != nil && dcrClient == nil {
+			if dcrClient, err = w.clientFactory.DataCollectionRules(); err != nil {
+				return err
+			}
+		}
+
+		vm, err := vmClient.Get(ctx, infrastructureStatus.ResourceGroup.Name, machine.Name, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get the virtual machine of machine %q: %w", machine.Name, err)
+		}
+		// The virtual machine might not be created yet, its extensions are installed with the next reconciliation.
+		if vm == nil {
+			continue
+		}
+
+		for _, extension := range generateVMExtensions(workerConfig, infrastructureStatus.Identity) {
+			upToDate, err := vmExtensionUpToDate(vm, extension)
+			if err != nil {
+				return err
+			}
+			if upToDate {
+				continue
+			}
+
+			extension.Location = vm.Location
+			if _, err := extensionsClient.CreateOrUpdate(ctx, infrastructureStatus.ResourceGroup.Name, machine.Name, *extension.Name, extension); err != nil {
+				return fmt.Errorf("failed to install the VM extension %s on machine %q: %w", *extension.Name, machine.Name, err)
+			}
+		}
+
+		if workerConfig.AzureMonitorAgent != nil {
+			if err := reconcileAzureMonitorAgentAssociation(ctx, dcrClient, *vm.ID, workerConfig.AzureMonitorAgent); err != nil {
+				return fmt.Errorf("failed to associate machine %q with the data collection rule of the Azure Monitor Agent: %w", machine.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// generateVMExtensions returns the VM extensions of the virtual machines of a worker pool with the given WorkerConfig.
+// The Azure Monitor Agent authenticates with the given identity of the machines, without it the reconciliation of the
+// VMO of the worker pool fails before.
+func generateVMExtensions(workerConfig *azureapi.WorkerConfig, identity *azureapi.IdentityStatus) []armcompute.VirtualMachineExtension {
+	var extensions []armcompute.VirtualMachineExtension
+	if workerConfig.HealthProbe != nil {
+		extensions = append(extensions, generateHealthExtension(workerConfig.HealthProbe))
+	}
+	if workerConfig.AzureMonitorAgent != nil && identity != nil {
+		extensions = append(extensions, generateAzureMonitorAgentExtension(identity))
+	}
+	return extensions
+}
+
+// vmExtensionUpToDate checks if the given VM extension is installed on the given virtual machine with the same version
+// and settings.
+func vmExtensionUpToDate(vm *armcompute.VirtualMachine, desired armcompute.VirtualMachineExtension) (bool, error) {
+	for _, extension := range vm.Resources {
+		if extension == nil || ptr.Deref(extension.Name, "") != *desired.Name || extension.Properties == nil {
+			continue
+		}
+		if ptr.Deref(extension.Properties.TypeHandlerVersion, "") != ptr.Deref(desired.Properties.TypeHandlerVersion, "") {
+			return false, nil
+		}
+		return vmoExtensionSettingsEqual(extension.Properties.Settings, desired.Properties.Settings)
+	}
+	return false, nil
+}
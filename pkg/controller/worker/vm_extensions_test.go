@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	vmssmock "github.com/gardener/gardener-extension-provider-azure/pkg/mock/vmss"
+)
+
+var _ = Describe("VMExtensions", func() {
+	const (
+		namespace = "shoot--foobar--azure"
+		region    = "westeurope"
+		vmoName   = "vmo-pool-12345678"
+		vmoID     = "/subscriptions/sub/resourceGroups/" + namespace + "/providers/Microsoft.Compute/virtualMachineScaleSets/" + vmoName
+		vmID      = "/subscriptions/sub/resourceGroups/" + namespace + "/providers/Microsoft.Compute/virtualMachines/machine"
+
+		identityID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity"
+		ruleID     = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.Insights/dataCollectionRules/dcr"
+	)
+
+	var (
+		ctx              context.Context
+		ctrl             *gomock.Controller
+		c                *mockclient.MockClient
+		statusWriter     *mockclient.MockStatusWriter
+		factory          *mockazureclient.MockFactory
+		vmoClient        *vmssmock.MockVmss
+		vmClient         *mockazureclient.MockVirtualMachine
+		extensionsClient *mockazureclient.MockVirtualMachineExtensions
+		dcrClient        *mockazureclient.MockDataCollectionRules
+
+		cluster *extensionscontroller.Cluster
+		w       *extensionsv1alpha1.Worker
+	)
+
+	newWorkerDelegate := func() genericactuator.WorkerDelegate {
+		return wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+	}
+
+	workerPool := func(name, providerConfig string) extensionsv1alpha1.WorkerPool {
+		return extensionsv1alpha1.WorkerPool{
+			Name:         name,
+			MachineType:  "Standard_D4s_v5",
+			Maximum:      2,
+			MachineImage: extensionsv1alpha1.MachineImage{Name: "image", Version: "1.0.0"},
+			Volume:       &extensionsv1alpha1.Volume{Size: "50Gi"},
+			UserDataSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "user-data"},
+				Key:                  "data",
+			},
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"` + providerConfig + `}`)},
+		}
+	}
+
+	machineClassName := func(workerDelegate genericactuator.WorkerDelegate, pool string) string {
+		machineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		for _, machineDeployment := range machineDeployments {
+			if machineDeployment.PoolName == pool {
+				return machineDeployment.ClassName
+			}
+		}
+		Fail("no machine deployment for worker pool " + pool)
+		return ""
+	}
+
+	expectMachines := func(classNameByMachine map[string]string) {
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).DoAndReturn(
+			func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+				for name, className := range classNameByMachine {
+					list.Items = append(list.Items, machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+						Spec:       machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: className}},
+					})
+				}
+				return nil
+			})
+	}
+
+	expectVmoCleanup := func() {
+		expectVmoListToSucceed(ctx, vmoClient, namespace, generateExpectedVmo(vmoName, vmoID), generateExpectedVmo(vmoName+"-other", vmoID+"-other"))
+		expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		statusWriter = mockclient.NewMockStatusWriter(ctrl)
+		factory = mockazureclient.NewMockFactory(ctrl)
+		vmoClient = vmssmock.NewMockVmss(ctrl)
+		vmClient = mockazureclient.NewMockVirtualMachine(ctrl)
+		extensionsClient = mockazureclient.NewMockVirtualMachineExtensions(ctrl)
+		dcrClient = mockazureclient.NewMockDataCollectionRules(ctrl)
+
+		c.EXPECT().Status().AnyTimes().Return(statusWriter)
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "user-data"}, gomock.AssignableToTypeOf(&corev1.Secret{})).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+				secret.Data = map[string][]byte{"data": []byte("#!/bin/bash")}
+				return nil
+			})
+		factory.EXPECT().Vmss().AnyTimes().Return(vmoClient, nil)
+
+		cluster = makeCluster("1.33.0", region, nil, []v1alpha1.MachineImages{{
+			Name:     "image",
+			Versions: []v1alpha1.MachineImageVersion{{Version: "1.0.0", ID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/images/image")}},
+		}}, 3)
+		w = makeWorker(namespace, region, nil, makeInfrastructureStatus(namespace, "vnet-name", "subnet-name", false, nil, ptr.To(identityID)),
+			workerPool("pool", `,"automaticRepairs":{"enabled":true},"healthProbe":{"protocol":"TCP","port":22}`),
+			workerPool("other", ""),
+		)
+		w.Status.ProviderStatus = generateWorkerStatusWithVmo(
+			v1alpha1.VmoDependency{ID: vmoID, Name: vmoName, PoolName: "pool"},
+			v1alpha1.VmoDependency{ID: vmoID + "-other", Name: vmoName + "-other", PoolName: "other"},
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should install the health extension on the virtual machines of the worker pools with a health probe", func() {
+		workerDelegate := newWorkerDelegate()
+		className := machineClassName(workerDelegate, "pool")
+
+		expectMachines(map[string]string{
+			"machine-new":      className,
+			"machine-ready":    className,
+			"machine-creating": className,
+			"machine-old":      "outdated-class",
+			"machine-other":    machineClassName(workerDelegate, "other"),
+		})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		factory.EXPECT().VirtualMachineExtensions().Return(extensionsClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-new", nil).Return(&armcompute.VirtualMachine{Location: ptr.To(region)}, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-ready", nil).Return(&armcompute.VirtualMachine{
+			Location: ptr.To(region),
+			Resources: []*armcompute.VirtualMachineExtension{{
+				Name: ptr.To("HealthExtension"),
+				Properties: &armcompute.VirtualMachineExtensionProperties{
+					TypeHandlerVersion: ptr.To("2.0"),
+					Settings:           map[string]any{"protocol": "tcp", "port": float64(22)},
+				},
+			}},
+		}, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-creating", nil).Return(nil, nil)
+		extensionsClient.EXPECT().CreateOrUpdate(ctx, namespace, "machine-new", "HealthExtension", gomock.AssignableToTypeOf(armcompute.VirtualMachineExtension{})).
+			DoAndReturn(func(_ context.Context, _, _, _ string, extension armcompute.VirtualMachineExtension) (*armcompute.VirtualMachineExtension, error) {
+				Expect(extension).To(MatchFields(IgnoreExtras, Fields{
+					"Location": PointTo(Equal(region)),
+					"Properties": PointTo(MatchFields(IgnoreExtras, Fields{
+						"Publisher":          PointTo(Equal("Microsoft.ManagedServices")),
+						"Type":               PointTo(Equal("ApplicationHealthLinux")),
+						"TypeHandlerVersion": PointTo(Equal("2.0")),
+						"Settings":           Equal(map[string]any{"protocol": "tcp", "port": int32(22)}),
+					})),
+				}))
+				return &extension, nil
+			})
+
+		expectVmoCleanup()
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should update the health extension if the health probe changed", func() {
+		w.Spec.Pools[0] = workerPool("pool", `,"automaticRepairs":{"enabled":true},"healthProbe":{"protocol":"HTTP","port":8080,"path":"/healthz"}`)
+		workerDelegate := newWorkerDelegate()
+
+		expectMachines(map[string]string{"machine": machineClassName(workerDelegate, "pool")})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		factory.EXPECT().VirtualMachineExtensions().Return(extensionsClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine", nil).Return(&armcompute.VirtualMachine{
+			Location: ptr.To(region),
+			Resources: []*armcompute.VirtualMachineExtension{{
+				Name: ptr.To("HealthExtension"),
+				Properties: &armcompute.VirtualMachineExtensionProperties{
+					TypeHandlerVersion: ptr.To("2.0"),
+					Settings:           map[string]any{"protocol": "tcp", "port": float64(22)},
+				},
+			}},
+		}, nil)
+		extensionsClient.EXPECT().CreateOrUpdate(ctx, namespace, "machine", "HealthExtension", gomock.AssignableToTypeOf(armcompute.VirtualMachineExtension{})).
+			DoAndReturn(func(_ context.Context, _, _, _ string, extension armcompute.VirtualMachineExtension) (*armcompute.VirtualMachineExtension, error) {
+				Expect(extension.Properties.Settings).To(Equal(map[string]any{"protocol": "http", "port": int32(8080), "requestPath": "/healthz"}))
+				return &extension, nil
+			})
+
+		expectVmoCleanup()
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should install the azure monitor agent on the virtual machines and associate them with the data collection rule", func() {
+		w.Spec.Pools[0] = workerPool("pool", `,"azureMonitorAgent":{"dataCollectionRuleID":"`+ruleID+`"}`)
+		workerDelegate := newWorkerDelegate()
+
+		expectMachines(map[string]string{"machine": machineClassName(workerDelegate, "pool")})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		factory.EXPECT().VirtualMachineExtensions().Return(extensionsClient, nil)
+		factory.EXPECT().DataCollectionRules().Return(dcrClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine", nil).Return(&armcompute.VirtualMachine{ID: ptr.To(vmID), Location: ptr.To(region)}, nil)
+		extensionsClient.EXPECT().CreateOrUpdate(ctx, namespace, "machine", "AzureMonitorLinuxAgent", gomock.AssignableToTypeOf(armcompute.VirtualMachineExtension{})).
+			DoAndReturn(func(_ context.Context, _, _, _ string, extension armcompute.VirtualMachineExtension) (*armcompute.VirtualMachineExtension, error) {
+				Expect(extension.Properties).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Publisher":              PointTo(Equal("Microsoft.Azure.Monitor")),
+					"Type":                   PointTo(Equal("AzureMonitorLinuxAgent")),
+					"TypeHandlerVersion":     PointTo(Equal("1.0")),
+					"EnableAutomaticUpgrade": PointTo(BeTrue()),
+					"Settings":               Equal(generateMonitorAgentSettings(identityID)),
+				})))
+				return &extension, nil
+			})
+		dcrClient.EXPECT().GetAssociation(ctx, vmID, "gardener-azure-monitor-agent").Return(nil, nil)
+		dcrClient.EXPECT().CreateOrUpdateAssociation(ctx, vmID, "gardener-azure-monitor-agent", gomock.AssignableToTypeOf(azureclient.DataCollectionRuleAssociation{})).
+			DoAndReturn(func(_ context.Context, _, _ string, association azureclient.DataCollectionRuleAssociation) error {
+				Expect(association.Properties.DataCollectionRuleID).To(PointTo(Equal(ruleID)))
+				return nil
+			})
+
+		expectVmoCleanup()
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should not change the virtual machines which already run the azure monitor agent for the data collection rule", func() {
+		w.Spec.Pools[0] = workerPool("pool", `,"azureMonitorAgent":{"dataCollectionRuleID":"`+ruleID+`"}`)
+		workerDelegate := newWorkerDelegate()
+
+		expectMachines(map[string]string{"machine": machineClassName(workerDelegate, "pool")})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		factory.EXPECT().VirtualMachineExtensions().Return(extensionsClient, nil)
+		factory.EXPECT().DataCollectionRules().Return(dcrClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine", nil).Return(&armcompute.VirtualMachine{
+			ID:       ptr.To(vmID),
+			Location: ptr.To(region),
+			Resources: []*armcompute.VirtualMachineExtension{{
+				Name: ptr.To("AzureMonitorLinuxAgent"),
+				Properties: &armcompute.VirtualMachineExtensionProperties{
+					TypeHandlerVersion: ptr.To("1.0"),
+					Settings:           generateMonitorAgentSettings(identityID),
+				},
+			}},
+		}, nil)
+		dcrClient.EXPECT().GetAssociation(ctx, vmID, "gardener-azure-monitor-agent").Return(&azureclient.DataCollectionRuleAssociation{
+			Properties: azureclient.DataCollectionRuleAssociationProperties{DataCollectionRuleID: ptr.To(ruleID)},
+		}, nil)
+
+		expectVmoCleanup()
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should not replace the machines if only the health probe changes", func() {
+		className := machineClassName(newWorkerDelegate(), "pool")
+
+		w.Spec.Pools[0] = workerPool("pool", `,"automaticRepairs":{"enabled":true,"gracePeriodMinutes":30},"healthProbe":{"protocol":"TCP","port":10250}`)
+		Expect(machineClassName(newWorkerDelegate(), "pool")).To(Equal(className))
+	})
+
+	It("should not look up any virtual machine if no worker pool configures a health probe", func() {
+		w.Spec.Pools[0] = workerPool("pool", "")
+
+		expectVmoCleanup()
+		Expect(newWorkerDelegate().PostReconcileHook(ctx)).To(Succeed())
+	})
+})
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// reconcileVMSettings applies the settings of the WorkerConfig of their worker pool which the machine classes cannot
+// configure to the virtual machines of the machines, i.e. the license type. The machine-controller-manager creates the
+// virtual machines without them, hence they are patched afterwards. Only the machines of the current machine classes
+// are considered, machines which are created after the reconciliation get the settings with the next one. Virtual
+// machines which already have the desired settings are not updated.
+func (w *workerDelegate) reconcileVMSettings(ctx context.Context) error {
+	workerConfigByPool := map[string]*azureapi.WorkerConfig{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+		if workerConfig.LicenseType != nil {
+			workerConfigByPool[pool.Name] = workerConfig
+		}
+	}
+	if len(workerConfigByPool) == 0 {
+		return nil
+	}
+
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	if err != nil {
+		return err
+	}
+
+	machineDeployments, err := w.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	workerConfigByClass := map[string]*azureapi.WorkerConfig{}
+	for _, machineDeployment := range machineDeployments {
+		if workerConfig, ok := workerConfigByPool[machineDeployment.PoolName]; ok {
+			workerConfigByClass[machineDeployment.ClassName] = workerConfig
+		}
+	}
+
+	machines := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machines, client.InNamespace(w.worker.Namespace)); err != nil {
+		return err
+	}
+
+	var vmClient azureclient.VirtualMachine
+	for _, machine := range machines.Items {
+		workerConfig, ok := workerConfigByClass[machine.Spec.Class.Name]
+		if !ok {
+			continue
+		}
+
+		if vmClient == nil {
+			if vmClient, err = w.clientFactory.VirtualMachine(); err != nil {
+				return err
+			}
+		}
+
+		vm, err := vmClient.Get(ctx, infrastructureStatus.ResourceGroup.Name, machine.Name, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get the virtual machine of machine %q: %w", machine.Name, err)
+		}
+		// The virtual machine might not be created yet, its settings are applied with the next reconciliation.
+		if vm == nil {
+			continue
+		}
+
+		update, ok := generateVMSettingsUpdate(vm, workerConfig)
+		if !ok {
+			continue
+		}
+		if _, err := vmClient.Update(ctx, infrastructureStatus.ResourceGroup.Name, machine.Name, update); err != nil {
+			return fmt.Errorf("failed to apply the settings of the worker pool to the virtual machine of machine %q: %w", machine.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// generateVMSettingsUpdate returns the update which applies the settings of the given WorkerConfig to the given virtual
+// machine. It returns false if the virtual machine already has the desired settings.
+func generateVMSettingsUpdate(vm *armcompute.VirtualMachine, workerConfig *azureapi.WorkerConfig) (armcompute.VirtualMachineUpdate, bool) {
+	var (
+		properties = &armcompute.VirtualMachineProperties{}
+		changed    bool
+	)
+
+	if workerConfig.LicenseType != nil && (vm.Properties == nil || ptr.Deref(vm.Properties.LicenseType, "") != string(*workerConfig.LicenseType)) {
+		properties.LicenseType = ptr.To(string(*workerConfig.LicenseType))
+		changed = true
+	}
+
+	return armcompute.VirtualMachineUpdate{Properties: properties}, changed
+}
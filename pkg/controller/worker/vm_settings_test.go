@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("VMSettings", func() {
+	const (
+		namespace = "shoot--foobar--azure"
+		region    = "westeurope"
+	)
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		c        *mockclient.MockClient
+		factory  *mockazureclient.MockFactory
+		vmClient *mockazureclient.MockVirtualMachine
+
+		cluster *extensionscontroller.Cluster
+		w       *extensionsv1alpha1.Worker
+	)
+
+	newWorkerDelegate := func() genericactuator.WorkerDelegate {
+		return wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+	}
+
+	workerPool := func(name, providerConfig string) extensionsv1alpha1.WorkerPool {
+		return extensionsv1alpha1.WorkerPool{
+			Name:         name,
+			MachineType:  "Standard_D4s_v5",
+			Maximum:      2,
+			Zones:        []string{"1"},
+			MachineImage: extensionsv1alpha1.MachineImage{Name: "image", Version: "1.0.0"},
+			Volume:       &extensionsv1alpha1.Volume{Size: "50Gi"},
+			UserDataSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "user-data"},
+				Key:                  "data",
+			},
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"` + providerConfig + `}`)},
+		}
+	}
+
+	machineClassName := func(workerDelegate genericactuator.WorkerDelegate, pool string) string {
+		machineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		for _, machineDeployment := range machineDeployments {
+			if machineDeployment.PoolName == pool {
+				return machineDeployment.ClassName
+			}
+		}
+		Fail("no machine deployment for worker pool " + pool)
+		return ""
+	}
+
+	expectMachines := func(classNameByMachine map[string]string) {
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).DoAndReturn(
+			func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+				for name, className := range classNameByMachine {
+					list.Items = append(list.Items, machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+						Spec:       machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: className}},
+					})
+				}
+				return nil
+			})
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		factory = mockazureclient.NewMockFactory(ctrl)
+		vmClient = mockazureclient.NewMockVirtualMachine(ctrl)
+
+		c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "user-data"}, gomock.AssignableToTypeOf(&corev1.Secret{})).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+				secret.Data = map[string][]byte{"data": []byte("#!/bin/bash")}
+				return nil
+			})
+
+		cluster = makeCluster("1.33.0", region, nil, []v1alpha1.MachineImages{{
+			Name:     "image",
+			Versions: []v1alpha1.MachineImageVersion{{Version: "1.0.0", ID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/images/image")}},
+		}}, 3)
+		w = makeWorker(namespace, region, nil, makeInfrastructureStatus(namespace, "vnet-name", "subnet-name", true, nil, nil),
+			workerPool("pool", `,"licenseType":"RHEL_BYOS"`),
+			workerPool("other", ""),
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should apply the license type to the virtual machines of the worker pools which configure it", func() {
+		workerDelegate := newWorkerDelegate()
+		className := machineClassName(workerDelegate, "pool")
+
+		expectMachines(map[string]string{
+			"machine-new":      className,
+			"machine-ready":    className,
+			"machine-creating": className,
+			"machine-old":      "outdated-class",
+			"machine-other":    machineClassName(workerDelegate, "other"),
+		})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-new", nil).Return(&armcompute.VirtualMachine{Properties: &armcompute.VirtualMachineProperties{}}, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-ready", nil).Return(&armcompute.VirtualMachine{
+			Properties: &armcompute.VirtualMachineProperties{LicenseType: ptr.To("RHEL_BYOS")},
+		}, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine-creating", nil).Return(nil, nil)
+		vmClient.EXPECT().Update(ctx, namespace, "machine-new", armcompute.VirtualMachineUpdate{
+			Properties: &armcompute.VirtualMachineProperties{LicenseType: ptr.To("RHEL_BYOS")},
+		}).Return(&armcompute.VirtualMachine{}, nil)
+
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should update the license type if it changed", func() {
+		w.Spec.Pools[0] = workerPool("pool", `,"licenseType":"SLES_BYOS"`)
+		workerDelegate := newWorkerDelegate()
+
+		expectMachines(map[string]string{"machine": machineClassName(workerDelegate, "pool")})
+		factory.EXPECT().VirtualMachine().Return(vmClient, nil)
+		vmClient.EXPECT().Get(ctx, namespace, "machine", nil).Return(&armcompute.VirtualMachine{
+			Properties: &armcompute.VirtualMachineProperties{LicenseType: ptr.To("RHEL_BYOS")},
+		}, nil)
+		vmClient.EXPECT().Update(ctx, namespace, "machine", armcompute.VirtualMachineUpdate{
+			Properties: &armcompute.VirtualMachineProperties{LicenseType: ptr.To("SLES_BYOS")},
+		}).Return(&armcompute.VirtualMachine{}, nil)
+
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+
+	It("should not look up any virtual machine if no worker pool configures a license type", func() {
+		w.Spec.Pools[0] = workerPool("pool", "")
+		workerDelegate := newWorkerDelegate()
+
+		Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+	})
+})
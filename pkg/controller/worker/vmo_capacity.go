@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// ConditionTypeCapacityDrift is the type of the Worker condition which reports the worker pools whose VMSS Flex has
+	// another capacity than the number of machines which the machine-controller-manager expects.
+	ConditionTypeCapacityDrift gardencorev1beta1.ConditionType = "CapacityDrift"
+
+	// ReasonCapacityDrifted is the condition reason used when the capacity of at least one VMSS Flex drifted.
+	ReasonCapacityDrifted = "CapacityDrifted"
+	// ReasonNoCapacityDrift is the condition reason used when no capacity drift is reported (anymore).
+	ReasonNoCapacityDrift = "NoCapacityDrift"
+
+	// vmoProvisioningStateSucceeded is the provisioning state of a VMO which is not being updated or scaled.
+	vmoProvisioningStateSucceeded = "Succeeded"
+)
+
+// vmoCapacityDrift returns a description of the drift of the capacity of the VMO of the given worker pool from the
+// replicas of its machine deployment, if the WorkerConfig of the pool configures to report it. The capacity is only
+// compared once the machine-controller-manager and Azure have settled, since the replicas and the capacity differ
+// while the worker pool is scaled. The capacity is never changed, as Azure chooses the virtual machines which are
+// removed when it is reduced, which are not necessarily the machines that the machine-controller-manager deletes.
+func (w *workerDelegate) vmoCapacityDrift(ctx context.Context, client azureclient.Vmss, resourceGroupName, vmoName string, workerPool extensionsv1alpha1.WorkerPool, workerConfig *azureapi.WorkerConfig) (string, error) {
+	if ptr.Deref(workerConfig.CapacityPolicy, azureapi.CapacityPolicyIgnore) != azureapi.CapacityPolicyReport {
+		return "", nil
+	}
+
+	deployments, err := w.getMachineDeployments(ctx)
+	if err != nil {
+		return "", err
+	}
+	deployment, ok := deployments[fmt.Sprintf("%s-%s", w.worker.Namespace, workerPool.Name)]
+	if !ok || !machineDeploymentSettled(deployment) {
+		return "", nil
+	}
+
+	vmo, err := client.Get(ctx, resourceGroupName, vmoName, nil)
+	if err != nil {
+		return "", err
+	}
+	if vmo == nil || vmo.SKU == nil || vmo.SKU.Capacity == nil ||
+		vmo.Properties == nil || ptr.Deref(vmo.Properties.ProvisioningState, "") != vmoProvisioningStateSucceeded {
+		return "", nil
+	}
+
+	if capacity := *vmo.SKU.Capacity; capacity != int64(deployment.Spec.Replicas) {
+		return fmt.Sprintf("the VMSS Flex %s of worker pool %q has a capacity of %d, but %d machines are expected", vmoName, workerPool.Name, capacity, deployment.Spec.Replicas), nil
+	}
+	return "", nil
+}
+
+// machineDeploymentSettled checks if the machine-controller-manager has observed the latest spec of the given machine
+// deployment and is neither scaling nor rolling it.
+func machineDeploymentSettled(deployment machinev1alpha1.MachineDeployment) bool {
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.Replicas == deployment.Spec.Replicas &&
+		deployment.Status.UpdatedReplicas == deployment.Spec.Replicas
+}
+
+// updateCapacityDriftCondition updates the CapacityDrift condition of the Worker with the given capacity drifts.
+func (w *workerDelegate) updateCapacityDriftCondition(ctx context.Context, drifts []string) error {
+	condition := CapacityDriftCondition(clock.RealClock{}, w.worker, drifts)
+	if condition == nil {
+		return nil
+	}
+
+	conditions := v1beta1helper.MergeConditions(w.worker.Status.Conditions, *condition)
+	if !v1beta1helper.ConditionsNeedUpdate(w.worker.Status.Conditions, conditions) {
+		return nil
+	}
+
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	w.worker.Status.Conditions = conditions
+	return w.client.Status().Patch(ctx, w.worker, patch)
+}
+
+// CapacityDriftCondition computes the CapacityDrift condition of the given Worker based on the given capacity drifts.
+// It returns nil if there is neither a drift nor an existing condition that needs to be reset.
+func CapacityDriftCondition(clock clock.Clock, worker *extensionsv1alpha1.Worker, drifts []string) *gardencorev1beta1.Condition {
+	existing := v1beta1helper.GetCondition(worker.Status.Conditions, ConditionTypeCapacityDrift)
+	if len(drifts) == 0 {
+		if existing == nil {
+			return nil
+		}
+		condition := v1beta1helper.UpdatedConditionWithClock(clock, *existing, gardencorev1beta1.ConditionFalse, ReasonNoCapacityDrift, "The capacity of every VMSS Flex matches the machines of its worker pool.")
+		return &condition
+	}
+
+	message := fmt.Sprintf("The capacity of some VMSS Flex drifted from the machines of their worker pools: %s. The capacity is not changed by the extension.", strings.Join(drifts, "; "))
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, worker.Status.Conditions, ConditionTypeCapacityDrift)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, ReasonCapacityDrifted, message)
+	return &condition
+}
@@ -12,6 +12,14 @@ const (
 	// EnableImmutableBuckets controls whether the controller would react to immutable bucket configuration. Extra permissions from Azure are necessary for this feature to work.
 	// alpha: v1.52.0
 	EnableImmutableBuckets featuregate.Feature = "EnableImmutableBuckets"
+	// EnableResourceProviderRegistration controls whether the infrastructure controller registers the subscription with required Azure resource providers that are not yet registered.
+	// Extra permissions from Azure are necessary for this feature to work.
+	// alpha: v1.56.0
+	EnableResourceProviderRegistration featuregate.Feature = "EnableResourceProviderRegistration"
+	// EnablePrivateLinkConnectivity controls whether shoots can configure a Private Link connectivity between the control plane and the nodes.
+	// Extra permissions from Azure are necessary for this feature to work.
+	// alpha: v1.57.0
+	EnablePrivateLinkConnectivity featuregate.Feature = "EnablePrivateLinkConnectivity"
 )
 
 // ExtensionFeatureGate is the feature gate for the extension controllers.
@@ -29,4 +37,10 @@ func RegisterExtensionFeatureGate() {
 	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		EnableImmutableBuckets: {Default: false, PreRelease: featuregate.Alpha},
 	}))
+	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		EnableResourceProviderRegistration: {Default: false, PreRelease: featuregate.Alpha},
+	}))
+	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		EnablePrivateLinkConnectivity: {Default: false, PreRelease: featuregate.Alpha},
+	}))
 }
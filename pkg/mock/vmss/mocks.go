@@ -99,3 +99,18 @@ func (mr *MockVmssMockRecorder) List(ctx, resourceGroupName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVmss)(nil).List), ctx, resourceGroupName)
 }
+
+// UpdateTags mocks base method.
+func (m *MockVmss) UpdateTags(arg0 context.Context, arg1, arg2 string, arg3 map[string]*string) (*armcompute.VirtualMachineScaleSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.VirtualMachineScaleSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockVmssMockRecorder) UpdateTags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockVmss)(nil).UpdateTags), arg0, arg1, arg2, arg3)
+}